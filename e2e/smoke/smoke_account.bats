@@ -28,6 +28,13 @@ setup_file() {
   assert_json_not_null '.data.id'
 }
 
+@test "people activity summarizes recent activity" {
+  run_smoke basecamp people activity me --since 1w --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_not_null '.data.person.id'
+}
+
 @test "templates show returns template detail" {
   local out
   out=$(basecamp templates list --json 2>/dev/null) || mark_unverifiable "Cannot list templates"
@@ -19,6 +19,15 @@ setup_file() {
   echo "$output" | jq -r '.data.id' > "$BATS_FILE_TMPDIR/question_id"
 }
 
+@test "checkins create creates a scheduled question" {
+  run_smoke basecamp checkins create --question "Smoke scheduled question $(date +%s)?" \
+    --schedule "every friday at 4pm" --participants me \
+    --questionnaire "$QA_QUESTIONNAIRE" -p "$QA_PROJECT" --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_value '.data.schedule.frequency' 'every_week'
+}
+
 @test "checkins question update updates a question" {
   local id_file="$BATS_FILE_TMPDIR/question_id"
   [[ -f "$id_file" ]] || mark_unverifiable "No question created in prior test"
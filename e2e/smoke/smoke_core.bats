@@ -51,6 +51,12 @@ setup_file() {
   assert_json_value '.ok' 'true'
 }
 
+@test "stats shows local usage statistics" {
+  run_smoke basecamp stats --json
+  assert_success
+  assert_json_value '.ok' 'true'
+}
+
 @test "config init creates local config" {
   run_smoke basecamp config init --json
   assert_success
@@ -14,6 +14,12 @@ setup_file() {
   assert_json_value '.ok' 'true'
 }
 
+@test "files tree returns folder hierarchy" {
+  run_smoke basecamp files tree -p "$QA_PROJECT" --depth 2 --json
+  assert_success
+  assert_json_value '.ok' 'true'
+}
+
 @test "vaults list returns vaults" {
   run_smoke basecamp vaults list -p "$QA_PROJECT" --json
   assert_success
@@ -78,6 +84,16 @@ setup_file() {
   assert_success
 }
 
+@test "files download --recursive mirrors a folder" {
+  ensure_vault || return 0
+
+  run_smoke basecamp files download "$QA_VAULT" -p "$QA_PROJECT" --recursive \
+    --exclude "*.mov" --exclude "*.mp4" -o "$BATS_FILE_TMPDIR/smoke_folder_download" --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_not_null '.data.files'
+}
+
 @test "docs download downloads a document" {
   # Use provisioned doc or ensure helper
   local doc_id="${QA_DOC:-}"
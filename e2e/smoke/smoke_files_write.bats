@@ -37,6 +37,36 @@ setup_file() {
   echo "$output" | jq -r '.data.id' > "$BATS_FILE_TMPDIR/upload_id"
 }
 
+@test "upload --recursive uploads a directory" {
+  local dir="$BATS_FILE_TMPDIR/smoke_upload_dir"
+  mkdir -p "$dir/sub" "$dir/node_modules/pkg"
+  echo "smoke recursive root $(date +%s)" > "$dir/root.txt"
+  echo "smoke recursive nested $(date +%s)" > "$dir/sub/nested.txt"
+  echo "ignored" > "$dir/node_modules/pkg/index.js"
+  echo "ignored" > "$dir/debug.log"
+  echo "*.log" > "$dir/.basecampignore"
+
+  run_smoke basecamp upload "$dir" --recursive --exclude node_modules -p "$QA_PROJECT" --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_value '.data.files | length' '2'
+  assert_json_value '.data.skipped | length' '2'
+}
+
+@test "files sync publishes a markdown directory" {
+  local dir="$BATS_FILE_TMPDIR/smoke_docs_sync"
+  mkdir -p "$dir"
+  printf '# Smoke sync %s\n\nBody text.\n' "$(date +%s)" > "$dir/readme.md"
+
+  run_smoke basecamp files sync "$dir" -p "$QA_PROJECT" --json
+  assert_success
+  assert_json_value '.data.counts.create' '1'
+
+  run_smoke basecamp files sync "$dir" --dry-run --json
+  assert_success
+  assert_json_value '.data.counts.unchanged' '1'
+}
+
 @test "uploads create creates an upload" {
   local tmpfile="$BATS_FILE_TMPDIR/smoke_uploads_create.txt"
   echo "smoke uploads create content $(date +%s)" > "$tmpfile"
@@ -139,7 +169,7 @@ setup_file() {
   local file_id
   file_id=$(<"$id_file")
 
-  run_smoke basecamp files trash "$file_id" -p "$QA_PROJECT" --json
+  run_smoke basecamp files trash "$file_id" --force -p "$QA_PROJECT" --json
   assert_success
   assert_json_value '.ok' 'true'
 }
@@ -38,6 +38,14 @@ setup_file() {
   assert_json_value '.ok' 'true'
 }
 
+@test "reports time returns logged time totals" {
+  ensure_project || mark_unverifiable "Cannot discover project"
+  run_smoke basecamp reports time --in "$QA_PROJECT" --since 1w --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_not_null '.data.total_minutes'
+}
+
 @test "reports assignable returns assignable people" {
   run_smoke basecamp reports assignable --json
   assert_success
@@ -36,6 +36,20 @@ setup_file() {
   assert_json_not_null '.data.id'
 }
 
+@test "todos blocked lists blocked todos" {
+  run_smoke basecamp todos blocked -p "$QA_PROJECT" --json
+  assert_success
+  assert_json_value '.ok' 'true'
+}
+
+@test "todos deps returns dependency chain" {
+  ensure_todo || mark_unverifiable "No todo in project"
+  run_smoke basecamp todos deps "$QA_TODO" --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_value '.data.todo.id' "$QA_TODO"
+}
+
 @test "todolists show returns todolist detail" {
   ensure_todolist || mark_unverifiable "No todolist in project"
   run_smoke basecamp todolists show "$QA_TODOLIST" -p "$QA_PROJECT" --json
@@ -30,6 +30,19 @@ setup_file() {
   assert_json_value '.ok' 'true'
 }
 
+@test "todos log-time logs time as a comment" {
+  local id_file="$BATS_FILE_TMPDIR/direct_todo_id"
+  [[ -f "$id_file" ]] || mark_unverifiable "No direct todo created in prior test"
+  local todo_id
+  todo_id=$(<"$id_file")
+
+  run_smoke basecamp todos log-time "$todo_id" --minutes 15 --note "smoke" --json
+  assert_success
+  assert_json_value '.ok' 'true'
+  assert_json_value '.data.minutes' '15'
+  assert_json_not_null '.data.comment_id'
+}
+
 @test "todos uncomplete marks todo active" {
   local id_file="$BATS_FILE_TMPDIR/direct_todo_id"
   [[ -f "$id_file" ]] || mark_unverifiable "No direct todo created in prior test"
@@ -13,12 +13,15 @@ import (
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 
+	"github.com/basecamp/basecamp-cli/internal/audit"
 	"github.com/basecamp/basecamp-cli/internal/auth"
 	"github.com/basecamp/basecamp-cli/internal/config"
 	"github.com/basecamp/basecamp-cli/internal/names"
 	"github.com/basecamp/basecamp-cli/internal/observability"
+	"github.com/basecamp/basecamp-cli/internal/outbox"
 	"github.com/basecamp/basecamp-cli/internal/output"
 	"github.com/basecamp/basecamp-cli/internal/resilience"
+	"github.com/basecamp/basecamp-cli/internal/strict"
 	"github.com/basecamp/basecamp-cli/internal/tui/resolve"
 	"github.com/basecamp/basecamp-cli/internal/version"
 )
@@ -36,11 +39,34 @@ type App struct {
 	Names  *names.Resolver
 	Output *output.Writer
 
+	// UploadSDK is a twin of SDK with a long timeout for attachment
+	// uploads; use UploadAccount() rather than reading it directly.
+	UploadSDK *basecamp.Client
+
 	// Observability
 	Collector *observability.SessionCollector
 	Hooks     *observability.CLIHooks
 	Tracer    *observability.Tracer
 
+	// Invocation times this run and holds the correlation ID sent on each
+	// of its API requests.
+	Invocation *observability.Invocation
+
+	// Audit records mutating API calls when audit_log is on; nil otherwise.
+	Audit *audit.Log
+
+	// Requests tells whether a failed command may be queued for retry
+	// (--queue-on-failure).
+	Requests *outbox.Tracker
+
+	// ReadStdin is set once the command has read piped input from stdin,
+	// which a replay from the outbox couldn't supply.
+	ReadStdin bool
+
+	// Strict keeps API responses to check parsed results against
+	// (--strict).
+	Strict *strict.Recorder
+
 	// Flags holds the global flag values
 	Flags GlobalFlags
 }
@@ -56,6 +82,11 @@ type GlobalFlags struct {
 	Count    bool
 	Agent    bool
 	JQFilter string // Built-in jq filter expression (via gojq)
+	Template string // Go template for each item of the data (--template)
+	Columns  string // Comma-separated list columns for styled/Markdown output
+
+	// Interactive numbers breadcrumb hints and offers to run one afterwards
+	Interactive bool
 
 	// Context flags
 	Project  string
@@ -63,6 +94,38 @@ type GlobalFlags struct {
 	Todolist string
 	Profile  string // Named profile
 
+	// ExplainContext prints which config layer set the account, project,
+	// and profile before the command runs
+	ExplainContext bool
+
+	// Redact masks emails, signed URLs, and other configured values in
+	// output so transcripts can be shared
+	Redact bool
+
+	// NoBreadcrumbs and NoContext drop those sections from the output
+	// envelope, for token-constrained agents
+	NoBreadcrumbs bool
+	NoContext     bool
+
+	// Yes answers confirmation prompts with yes (see config confirm)
+	Yes bool
+
+	// OutputFile writes list data to a file (JSON array, or JSONL for
+	// .jsonl paths) and prints only the summary and meta
+	OutputFile string
+
+	// QueueOnFailure saves the command to the outbox when it fails on the
+	// network before any write reached Basecamp (see basecamp outbox)
+	QueueOnFailure bool
+
+	// Locale translates labels in styled and Markdown output and sets
+	// their date and number formats (see presenter.SetLocale)
+	Locale string
+
+	// Strict fails a command whose parsed API data lost or lacks fields
+	// the response had or the SDK expects
+	Strict bool
+
 	// Behavior flags
 	Verbose  int // 0=off, 1=operations, 2=operations+requests (stacks with -v -v or -vv)
 	Stats    bool
@@ -70,6 +133,7 @@ type GlobalFlags struct {
 	Hints    bool
 	NoHints  bool // Explicit disable (overrides --hints and dev default)
 	CacheDir string
+	Timezone string // IANA zone for reading and showing dates (overrides config timezone)
 }
 
 // authAdapter wraps auth.Manager to implement basecamp.TokenProvider.
@@ -123,6 +187,9 @@ func NewApp(cfg *config.Config) *App {
 	resilienceDir := resolveResilienceDir(cfg)
 	resilienceStore := resilience.NewStore(resilienceDir)
 	resilienceCfg := resilience.DefaultConfig()
+	if cfg.HTTP.MaxConcurrent > 0 {
+		resilienceCfg.Bulkhead = resilienceCfg.Bulkhead.WithMaxConcurrent(cfg.HTTP.MaxConcurrent)
+	}
 	gatingHooks := resilience.NewGatingHooksFromConfig(resilienceStore, resilienceCfg)
 
 	// Chain hooks: gating hooks first (to gate requests), then CLI hooks (for observability)
@@ -132,7 +199,28 @@ func NewApp(cfg *config.Config) *App {
 	// Create a shared transport for both the SDK and manual HTTP requests.
 	// This ensures connection pooling, proxy settings, and custom CA/mTLS
 	// are consistent across all HTTP calls.
-	transport := http.DefaultTransport
+	// The progress wrapper is inert unless a request context carries
+	// WithUploadProgress.
+	transport := NewUploadProgressTransport(http.DefaultTransport)
+
+	// Every request this invocation makes carries the same correlation ID.
+	invocation := observability.NewInvocation()
+	transport = &observability.CorrelationTransport{Inner: transport, ID: invocation.ID}
+
+	// Opt-in audit trail of every call that changes data.
+	var auditLog *audit.Log
+	if cfg.AuditLog != nil && *cfg.AuditLog {
+		auditLog = audit.NewLog(audit.DefaultPath())
+		transport = &audit.Transport{Inner: transport, Log: auditLog}
+	}
+
+	// Watches for network failures that leave a command safe to retry.
+	requests := &outbox.Tracker{Inner: transport}
+	transport = requests
+
+	// Keeps responses for --strict; inert until ApplyFlags enables it.
+	strictRecorder := &strict.Recorder{Inner: transport}
+	transport = strictRecorder
 
 	// Create SDK client with auth adapter and chained hooks
 	// Note: AccountID is NOT set here - use app.Account() for account-scoped operations
@@ -143,11 +231,15 @@ func NewApp(cfg *config.Config) *App {
 		CacheDir:     cfg.CacheDir,
 		CacheEnabled: cfg.CacheEnabled,
 	}
-	sdkClient := basecamp.NewClient(sdkCfg, &authAdapter{mgr: authMgr},
+	sdkOpts := []basecamp.ClientOption{
 		basecamp.WithHooks(hooks),
 		basecamp.WithTransport(transport),
-		basecamp.WithUserAgent(version.UserAgent()+" "+basecamp.DefaultUserAgent),
-	)
+		basecamp.WithUserAgent(version.UserAgent() + " " + basecamp.DefaultUserAgent),
+	}
+	sdkOpts = append(sdkOpts, httpClientOptions(cfg.HTTP)...)
+	sdkClient := basecamp.NewClient(sdkCfg, &authAdapter{mgr: authMgr}, sdkOpts...)
+	uploadClient := basecamp.NewClient(sdkCfg, &authAdapter{mgr: authMgr},
+		append(sdkOpts, basecamp.WithTimeout(UploadTimeout))...)
 
 	// Create name resolver using SDK client and account ID
 	nameResolver := names.NewResolver(sdkClient, authMgr, cfg.AccountID)
@@ -164,63 +256,122 @@ func NewApp(cfg *config.Config) *App {
 	}
 
 	return &App{
-		Config:    cfg,
-		Auth:      authMgr,
-		SDK:       sdkClient,
-		Names:     nameResolver,
-		Collector: collector,
-		Hooks:     cliHooks,
+		Config:     cfg,
+		Auth:       authMgr,
+		SDK:        sdkClient,
+		UploadSDK:  uploadClient,
+		Names:      nameResolver,
+		Collector:  collector,
+		Hooks:      cliHooks,
+		Invocation: invocation,
+		Audit:      auditLog,
+		Requests:   requests,
+		Strict:     strictRecorder,
 		Output: output.New(output.Options{
-			Format: format,
-			Writer: os.Stdout,
+			Format:      format,
+			Writer:      os.Stdout,
+			ColumnPrefs: cfg.Columns,
 		}),
 	}
 }
 
 // ApplyFlags applies global flag values to the app configuration.
 func (a *App) ApplyFlags() {
+	if a.Strict != nil {
+		a.Strict.Enabled = a.Flags.Strict
+	}
+
+	// Column selection only affects styled and Markdown lists.
+	columns := config.ParseColumns(a.Flags.Columns)
+	var columnPrefs map[string][]string
+	if a.Config != nil {
+		columnPrefs = a.Config.Columns
+	}
+
+	// --redact masks the configured sensitive values in every format.
+	var redact *output.Redactor
+	if a.Flags.Redact {
+		rules := config.DefaultRedact
+		if a.Config != nil && len(a.Config.Redact) > 0 {
+			rules = a.Config.Redact
+		}
+		redact = output.NewRedactor(rules)
+	}
+
 	// Apply output format from flags (order matters: specific modes first)
 	if a.Flags.Agent {
 		// Agent mode = quiet JSON (data only, no envelope)
 		a.Output = output.New(output.Options{
 			Format:   output.FormatQuiet,
 			Writer:   os.Stdout,
+			Redact:   redact,
 			JQFilter: a.Flags.JQFilter,
+			Template: a.Flags.Template,
 		})
 	} else if a.Flags.IDsOnly {
 		a.Output = output.New(output.Options{
 			Format: output.FormatIDs,
 			Writer: os.Stdout,
+			Redact: redact,
 		})
 	} else if a.Flags.Count {
 		a.Output = output.New(output.Options{
 			Format: output.FormatCount,
 			Writer: os.Stdout,
+			Redact: redact,
 		})
 	} else if a.Flags.Quiet {
 		a.Output = output.New(output.Options{
 			Format:   output.FormatQuiet,
 			Writer:   os.Stdout,
+			Redact:   redact,
 			JQFilter: a.Flags.JQFilter,
+			Template: a.Flags.Template,
 		})
-	} else if a.Flags.JSON || a.Flags.JQFilter != "" {
+	} else if a.Flags.JSON || a.Flags.JQFilter != "" || a.Flags.Template != "" {
 		a.Output = output.New(output.Options{
 			Format:   output.FormatJSON,
 			Writer:   os.Stdout,
+			Redact:   redact,
 			JQFilter: a.Flags.JQFilter,
+			Template: a.Flags.Template,
 		})
 	} else if a.Flags.Styled {
 		// Force ANSI styled output (even when piped)
 		a.Output = output.New(output.Options{
-			Format: output.FormatStyled,
-			Writer: os.Stdout,
+			Format:            output.FormatStyled,
+			Writer:            os.Stdout,
+			Redact:            redact,
+			Columns:           columns,
+			ColumnPrefs:       columnPrefs,
+			NumberBreadcrumbs: a.Flags.Interactive,
 		})
 	} else if a.Flags.MD {
 		// Literal Markdown syntax (portable, pipeable to glow/bat)
 		a.Output = output.New(output.Options{
-			Format: output.FormatMarkdown,
-			Writer: os.Stdout,
+			Format:      output.FormatMarkdown,
+			Writer:      os.Stdout,
+			Redact:      redact,
+			Columns:     columns,
+			ColumnPrefs: columnPrefs,
 		})
+	} else if (len(columns) > 0 || a.Flags.Interactive || redact != nil) && a.Output != nil {
+		// --columns, --interactive, or --redact alone keeps the configured (or detected) format
+		a.Output = output.New(output.Options{
+			Format:            a.Output.EffectiveFormat(),
+			Writer:            os.Stdout,
+			Redact:            redact,
+			Columns:           columns,
+			ColumnPrefs:       columnPrefs,
+			NumberBreadcrumbs: a.Flags.Interactive,
+		})
+	}
+
+	if a.Output != nil && a.Config != nil {
+		a.Output.SetChrome(
+			a.Config.MinimalOutput != nil && *a.Config.MinimalOutput,
+			a.Config.Emoji != nil && *a.Config.Emoji,
+		)
 	}
 
 	// Determine verbosity level from flags and BASECAMP_DEBUG env var
@@ -260,13 +411,29 @@ func (a *App) Close() {
 
 // OK outputs a success response, automatically including stats if --stats flag is set.
 func (a *App) OK(data any, opts ...output.ResponseOption) error {
+	if err := a.Strict.Verify(data); err != nil {
+		return err
+	}
 	if a.Flags.Stats && !a.Flags.NoStats && a.Collector != nil {
 		stats := a.Collector.Summary()
 		opts = append(opts, output.WithStats(&stats))
 	}
-	if !a.Flags.Hints || a.Flags.NoHints {
+	// --interactive needs the hints it offers to run, even with hints off.
+	if a.Flags.NoBreadcrumbs || ((!a.Flags.Hints || a.Flags.NoHints) && !a.Flags.Interactive) {
 		opts = append(opts, output.WithoutBreadcrumbs())
 	}
+	if a.Flags.NoContext {
+		opts = append(opts, output.WithoutContext())
+	}
+	if a.Flags.OutputFile != "" {
+		opts = append(opts, output.WithOutputFile(a.Flags.OutputFile))
+	}
+	if a.Invocation != nil {
+		opts = append(opts,
+			output.WithMeta("correlation_id", a.Invocation.ID),
+			output.WithMeta("duration_ms", a.Invocation.Elapsed().Milliseconds()),
+		)
+	}
 	return a.Output.OK(data, opts...)
 }
 
@@ -279,6 +446,12 @@ func (a *App) Err(err error) error {
 		stats := a.Collector.Summary()
 		opts = append(opts, output.WithErrorStats(&stats))
 	}
+	if a.Invocation != nil {
+		opts = append(opts,
+			output.WithErrorMeta("correlation_id", a.Invocation.ID),
+			output.WithErrorMeta("duration_ms", a.Invocation.Elapsed().Milliseconds()),
+		)
+	}
 
 	// Print the error response
 	if outputErr := a.Output.Err(err, opts...); outputErr != nil {
@@ -336,7 +509,7 @@ func (a *App) shouldPrintStatsToStderr() bool {
 // Use this to suppress human-friendly notices (like truncation warnings) in machine output.
 func (a *App) IsMachineOutput() bool {
 	// Flag-driven machine output modes
-	if a.Flags.Agent || a.Flags.Quiet || a.Flags.IDsOnly || a.Flags.Count || a.Flags.JSON || a.Flags.JQFilter != "" {
+	if a.Flags.Agent || a.Flags.Quiet || a.Flags.IDsOnly || a.Flags.Count || a.Flags.JSON || a.Flags.JQFilter != "" || a.Flags.Template != "" {
 		return true
 	}
 	// Config-driven machine output formats
@@ -370,7 +543,7 @@ func (a *App) IsInteractive() bool {
 	}
 
 	// Not interactive if any non-interactive output mode is set
-	if a.Flags.Agent || a.Flags.JSON || a.Flags.Quiet || a.Flags.IDsOnly || a.Flags.Count || a.Flags.JQFilter != "" {
+	if a.Flags.Agent || a.Flags.JSON || a.Flags.Quiet || a.Flags.IDsOnly || a.Flags.Count || a.Flags.JQFilter != "" || a.Flags.Template != "" {
 		return false
 	}
 
@@ -451,7 +624,7 @@ func (a *App) Resolve() *resolve.Resolver {
 			Todolist: a.Flags.Todolist,
 			// Machine output flags - disable interactive prompts
 			Agent:   a.Flags.Agent,
-			JSON:    a.Flags.JSON || a.Flags.JQFilter != "",
+			JSON:    a.Flags.JSON || a.Flags.JQFilter != "" || a.Flags.Template != "",
 			Quiet:   a.Flags.Quiet,
 			IDsOnly: a.Flags.IDsOnly,
 			Count:   a.Flags.Count,
@@ -470,3 +643,23 @@ func resolveResilienceDir(cfg *config.Config) string {
 	}
 	return ""
 }
+
+// httpClientOptions maps the config's http section to SDK client options.
+// Unset values keep the SDK defaults. Only GETs are retried on transient
+// failures; the SDK never retries mutations beyond a token refresh.
+func httpClientOptions(h config.HTTPConfig) []basecamp.ClientOption {
+	var opts []basecamp.ClientOption
+	if h.Timeout > 0 {
+		opts = append(opts, basecamp.WithTimeout(h.Timeout))
+	}
+	if h.MaxRetries > 0 {
+		opts = append(opts, basecamp.WithMaxRetries(h.MaxRetries))
+	}
+	if h.BaseDelay > 0 {
+		opts = append(opts, basecamp.WithBaseDelay(h.BaseDelay))
+	}
+	if h.MaxJitter > 0 {
+		opts = append(opts, basecamp.WithMaxJitter(h.MaxJitter))
+	}
+	return opts
+}
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"path/filepath"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -16,6 +17,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/observability"
 	"github.com/basecamp/basecamp-cli/internal/output"
 	"github.com/basecamp/basecamp-cli/internal/version"
 )
@@ -50,6 +52,47 @@ func TestNewAppSetsCombinedUserAgent(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestNewAppSendsCorrelationID(t *testing.T) {
+	t.Setenv("BASECAMP_TOKEN", "test-token")
+
+	var seen []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = append(seen, r.Header.Get(observability.CorrelationHeader))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	app := NewApp(&config.Config{BaseURL: server.URL})
+	require.NotNil(t, app.Invocation)
+
+	for range 2 {
+		_, err := app.SDK.Get(context.Background(), "/test.json")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, []string{app.Invocation.ID, app.Invocation.ID}, seen)
+}
+
+func TestAppOKAndErrIncludeInvocationMeta(t *testing.T) {
+	app := NewApp(&config.Config{})
+	var buf bytes.Buffer
+	app.Output = output.New(output.Options{Format: output.FormatJSON, Writer: &buf})
+
+	require.NoError(t, app.OK(map[string]string{"test": "data"}))
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	meta := resp["meta"].(map[string]any)
+	assert.Equal(t, app.Invocation.ID, meta["correlation_id"])
+	assert.Contains(t, meta, "duration_ms")
+
+	buf.Reset()
+	require.NoError(t, app.Err(output.ErrAPI(500, "test error")))
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	meta = resp["meta"].(map[string]any)
+	assert.Equal(t, app.Invocation.ID, meta["correlation_id"])
+	assert.Contains(t, meta, "duration_ms")
+}
+
 // TestCheckAuthClientRedirect_StopsLoop verifies the auth client's redirect
 // guard caps idempotent (GET) follows at Go's default 10-hop limit. A looping
 // endpoint would otherwise spin until the 30s client timeout instead of failing
@@ -741,3 +784,87 @@ func TestAppRequireAccount(t *testing.T) {
 		})
 	}
 }
+
+func TestOKDropsEnvelopeSections(t *testing.T) {
+	tests := []struct {
+		name            string
+		flags           GlobalFlags
+		wantBreadcrumbs bool
+		wantContext     bool
+	}{
+		{"hints on", GlobalFlags{Hints: true}, true, true},
+		{"no breadcrumbs", GlobalFlags{Hints: true, NoBreadcrumbs: true}, false, true},
+		{"no breadcrumbs beats interactive", GlobalFlags{Interactive: true, NoBreadcrumbs: true}, false, true},
+		{"no context", GlobalFlags{Hints: true, NoContext: true}, true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			app := NewApp(&config.Config{})
+			app.Flags = tt.flags
+			app.Output = output.New(output.Options{Format: output.FormatJSON, Writer: &buf})
+
+			require.NoError(t, app.OK(map[string]any{"id": 1},
+				output.WithBreadcrumbs(output.Breadcrumb{Action: "show", Cmd: "basecamp show 1"}),
+				output.WithContext("project_id", 2),
+			))
+
+			var env map[string]any
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+			_, hasBreadcrumbs := env["breadcrumbs"]
+			_, hasContext := env["context"]
+			assert.Equal(t, tt.wantBreadcrumbs, hasBreadcrumbs, "breadcrumbs")
+			assert.Equal(t, tt.wantContext, hasContext, "context")
+			assert.Contains(t, env, "data")
+		})
+	}
+}
+
+func TestAppOKWritesOutputFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "todos.jsonl")
+	var buf bytes.Buffer
+	app := NewApp(&config.Config{})
+	app.Flags = GlobalFlags{OutputFile: path}
+	app.Output = output.New(output.Options{Format: output.FormatJSON, Writer: &buf})
+
+	require.NoError(t, app.OK([]map[string]any{{"id": 1}, {"id": 2}}, output.WithSummary("2 todos")))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":1}\n{\"id\":2}\n", string(raw))
+
+	var env map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+	assert.Equal(t, "2 todos", env["summary"])
+	assert.Equal(t, float64(2), env["data"].(map[string]any)["count"])
+}
+
+func TestAppOKStrictFailsOnUnparsedFields(t *testing.T) {
+	t.Setenv("BASECAMP_TOKEN", "test-token")
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id": 5, "name": "Ada", "pronouns": "she/her"}`))
+	}))
+	defer server.Close()
+
+	for _, strict := range []bool{false, true} {
+		var buf bytes.Buffer
+		app := NewApp(&config.Config{BaseURL: server.URL})
+		app.Flags = GlobalFlags{JSON: true, Strict: strict}
+		app.ApplyFlags()
+		app.Output = output.New(output.Options{Format: output.FormatJSON, Writer: &buf})
+
+		_, err := app.SDK.Get(context.Background(), "/people/5.json")
+		require.NoError(t, err)
+
+		err = app.OK(basecamp.Person{ID: 5, Name: "Ada"})
+		if !strict {
+			assert.NoError(t, err)
+			continue
+		}
+		require.Error(t, err)
+		assert.Contains(t, output.AsError(err).Hint, "#5.pronouns is not in the SDK type")
+		assert.Empty(t, buf.String(), "nothing is printed when the check fails")
+	}
+}
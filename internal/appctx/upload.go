@@ -0,0 +1,96 @@
+package appctx
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+// UploadTimeout is the HTTP client timeout for attachment uploads. The
+// default SDK timeout (30s) is far too short for large files, so uploads use
+// a dedicated client; callers detect stalled transfers themselves via
+// WithUploadProgress rather than relying on a wall-clock limit.
+//
+// The SDK reads the whole file into memory before it sends anything, so
+// progress is measured at the HTTP transport, not on the source file.
+const UploadTimeout = 12 * time.Hour
+
+// UploadProgressFunc receives the bytes sent so far and the request's total
+// size (-1 when unknown). It is first called with 0 as the request reaches
+// the transport, then as the transport writes the body to the connection.
+// It is called from the HTTP transport goroutine.
+type UploadProgressFunc func(sent, total int64)
+
+type uploadProgressKey struct{}
+
+// WithUploadProgress returns a context that reports request body progress to
+// fn for any request made with it through the App's SDK transport.
+func WithUploadProgress(ctx context.Context, fn UploadProgressFunc) context.Context {
+	return context.WithValue(ctx, uploadProgressKey{}, fn)
+}
+
+func uploadProgressFromContext(ctx context.Context) UploadProgressFunc {
+	fn, _ := ctx.Value(uploadProgressKey{}).(UploadProgressFunc)
+	return fn
+}
+
+// NewUploadProgressTransport wraps inner so requests made with a
+// WithUploadProgress context report body progress. Other requests pass
+// through untouched.
+func NewUploadProgressTransport(inner http.RoundTripper) http.RoundTripper {
+	return &progressTransport{inner: inner}
+}
+
+// progressTransport wraps request bodies so the caller's UploadProgressFunc
+// observes bytes as they are written to the wire. The transport reads the
+// body only as fast as the connection takes it, so a stalled network stops
+// the reads.
+type progressTransport struct {
+	inner http.RoundTripper
+}
+
+func (t *progressTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fn := uploadProgressFromContext(req.Context())
+	if fn == nil || req.Body == nil || req.Body == http.NoBody {
+		return t.inner.RoundTrip(req)
+	}
+
+	// Report the start of sending: time spent before this (reading the file,
+	// waiting on the bulkhead) is not network time.
+	fn(0, req.ContentLength)
+
+	// RoundTrippers must not mutate the caller's request.
+	clone := req.Clone(req.Context())
+	clone.Body = &progressBody{ReadCloser: req.Body, total: req.ContentLength, fn: fn}
+	return t.inner.RoundTrip(clone)
+}
+
+type progressBody struct {
+	io.ReadCloser
+	total int64
+	sent  atomic.Int64
+	fn    UploadProgressFunc
+}
+
+func (b *progressBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.fn(b.sent.Add(int64(n)), b.total)
+	}
+	return n, err
+}
+
+// UploadAccount returns an account client for attachment uploads: same auth,
+// hooks, and transport as Account(), but with UploadTimeout instead of the
+// default request timeout. Falls back to Account() when no upload client was
+// configured (e.g. in tests).
+func (a *App) UploadAccount() *basecamp.AccountClient {
+	if a.UploadSDK == nil {
+		return a.Account()
+	}
+	return a.UploadSDK.ForAccount(a.Config.AccountID)
+}
@@ -0,0 +1,77 @@
+package appctx
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/config"
+)
+
+type drainingTransport struct {
+	gotBody string
+}
+
+func (d *drainingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		b, err := io.ReadAll(req.Body)
+		if err != nil {
+			return nil, err
+		}
+		d.gotBody = string(b)
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("{}")), Header: make(http.Header)}, nil
+}
+
+func TestProgressTransportReportsBodyBytes(t *testing.T) {
+	inner := &drainingTransport{}
+	rt := &progressTransport{inner: inner}
+
+	var calls []int64
+	var last, total int64
+	ctx := WithUploadProgress(context.Background(), func(sent, size int64) {
+		calls = append(calls, sent)
+		last, total = sent, size
+	})
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com/upload", strings.NewReader("hello world"))
+	require.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "hello world", inner.gotBody)
+	require.NotEmpty(t, calls)
+	assert.Equal(t, int64(0), calls[0], "the start of sending is reported before any bytes")
+	assert.Equal(t, int64(11), last)
+	assert.Equal(t, int64(11), total)
+}
+
+func TestProgressTransportPassesThroughWithoutCallback(t *testing.T) {
+	inner := &drainingTransport{}
+	rt := &progressTransport{inner: inner}
+
+	req, err := http.NewRequest(http.MethodPost, "https://example.com/upload", strings.NewReader("data"))
+	require.NoError(t, err)
+
+	resp, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, "data", inner.gotBody)
+}
+
+func TestUploadAccountUsesDedicatedClient(t *testing.T) {
+	app := NewApp(&config.Config{AccountID: "12345"})
+	require.NotNil(t, app.UploadSDK)
+	assert.NotSame(t, app.SDK, app.UploadSDK)
+	assert.NotNil(t, app.UploadAccount())
+
+	// Without an upload client (as in tests), fall back to the main SDK.
+	app.UploadSDK = nil
+	assert.NotNil(t, app.UploadAccount())
+}
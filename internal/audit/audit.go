@@ -0,0 +1,217 @@
+// Package audit keeps an append-only log of the API calls that change data.
+//
+// The log is written only when the user opts in (audit_log config key). Each
+// mutating request (anything but GET, HEAD, and OPTIONS) made through the
+// CLI's HTTP transport appends one JSON line: when, which command, the method
+// and path, a hash of the payload, and the outcome. Payloads themselves are
+// never stored, so the log holds no message content, and keys in a path are
+// masked (see RedactPath).
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"hash"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// FileName is the log file name within the state directory.
+const FileName = "audit.jsonl"
+
+// Entry is one logged request.
+type Entry struct {
+	Time    time.Time `json:"ts"`
+	Command string    `json:"command,omitempty"` // e.g. "todos create"
+	Method  string    `json:"method"`
+	Path    string    `json:"path"`
+
+	// PayloadSHA256 is the hex SHA-256 of the request body as sent; empty
+	// when there was no body.
+	PayloadSHA256 string `json:"payload_sha256,omitempty"`
+
+	Status     int    `json:"status,omitempty"` // HTTP status; 0 when no response
+	Error      string `json:"error,omitempty"`  // transport error, if any
+	DurationMs int64  `json:"duration_ms"`
+}
+
+// DefaultPath returns the default log location. On Linux/BSD it follows
+// XDG_STATE_HOME (~/.local/state/basecamp/audit.jsonl); elsewhere it uses the
+// platform cache directory, as there is no state convention there.
+func DefaultPath() string {
+	if runtime.GOOS == "linux" || runtime.GOOS == "freebsd" || runtime.GOOS == "openbsd" {
+		if stateDir := os.Getenv("XDG_STATE_HOME"); stateDir != "" {
+			return filepath.Join(filepath.Clean(stateDir), "basecamp", FileName)
+		}
+		if home, err := os.UserHomeDir(); err == nil && home != "" {
+			return filepath.Join(home, ".local", "state", "basecamp", FileName)
+		}
+	}
+	if cacheDir, err := os.UserCacheDir(); err == nil && cacheDir != "" {
+		return filepath.Join(filepath.Clean(cacheDir), "basecamp", FileName)
+	}
+	return filepath.Join(os.TempDir(), "basecamp", FileName)
+}
+
+// Log appends entries to an audit file.
+type Log struct {
+	path string
+
+	mu      sync.Mutex
+	command string
+}
+
+// NewLog creates a log writing to path (see DefaultPath).
+func NewLog(path string) *Log {
+	return &Log{path: path}
+}
+
+// Path returns the log file path.
+func (l *Log) Path() string {
+	return l.path
+}
+
+// SetCommand names the command that subsequent entries are attributed to.
+func (l *Log) SetCommand(command string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.command = command
+}
+
+func (l *Log) currentCommand() string {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.command
+}
+
+// Append writes e as one line. The file is opened in append mode for each
+// entry, so concurrent CLI processes interleave whole lines.
+func (l *Log) Append(e Entry) error {
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	if err := os.MkdirAll(filepath.Dir(l.path), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(l.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// IsMutating reports whether method changes data.
+func IsMutating(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return false
+	}
+	return true
+}
+
+// Transport logs each mutating request that passes through it to a Log.
+// Logging failures are silent: the audit trail must never change the
+// outcome of the request it records.
+type Transport struct {
+	Inner http.RoundTripper
+	Log   *Log
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !IsMutating(req.Method) {
+		return t.Inner.RoundTrip(req)
+	}
+
+	// Hash the body as the inner transport reads it, so large uploads are
+	// never buffered just to be logged.
+	var body *hashingBody
+	if req.Body != nil && req.Body != http.NoBody {
+		// RoundTrippers must not mutate the caller's request.
+		clone := req.Clone(req.Context())
+		body = &hashingBody{ReadCloser: req.Body, h: sha256.New()}
+		clone.Body = body
+		req = clone
+	}
+
+	started := time.Now()
+	resp, err := t.Inner.RoundTrip(req)
+
+	entry := Entry{
+		Time:       started.UTC(),
+		Command:    t.Log.currentCommand(),
+		Method:     req.Method,
+		Path:       RedactPath(req.URL.Path),
+		DurationMs: time.Since(started).Milliseconds(),
+	}
+	if body != nil {
+		entry.PayloadSHA256 = body.sum()
+	}
+	if err != nil {
+		// Transport errors quote the URL, secret segments and all.
+		entry.Error = strings.ReplaceAll(err.Error(), req.URL.Path, entry.Path)
+	} else {
+		entry.Status = resp.StatusCode
+	}
+	_ = t.Log.Append(entry)
+
+	return resp, err
+}
+
+// secretSegments are the path segments followed by a credential: the
+// chatbot key in /integrations/<key>/... authenticates on its own.
+var secretSegments = []string{"integrations"}
+
+// RedactedSegment replaces a credential in a logged path.
+const RedactedSegment = "[REDACTED]"
+
+// RedactPath returns path with each credential segment replaced by
+// RedactedSegment, so the log never holds a key that could post as its
+// owner.
+func RedactPath(path string) string {
+	parts := strings.Split(path, "/")
+	for i := 0; i < len(parts)-1; i++ {
+		if slices.Contains(secretSegments, parts[i]) && parts[i+1] != "" {
+			parts[i+1] = RedactedSegment
+			i++
+		}
+	}
+	return strings.Join(parts, "/")
+}
+
+type hashingBody struct {
+	io.ReadCloser
+	mu sync.Mutex
+	h  hash.Hash
+}
+
+func (b *hashingBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if n > 0 {
+		b.mu.Lock()
+		b.h.Write(p[:n])
+		b.mu.Unlock()
+	}
+	return n, err
+}
+
+func (b *hashingBody) sum() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return hex.EncodeToString(b.h.Sum(nil))
+}
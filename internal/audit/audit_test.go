@@ -0,0 +1,170 @@
+package audit
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type stubTransport struct {
+	status int
+	err    error
+	bodies []string
+}
+
+func (s *stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		s.bodies = append(s.bodies, string(data))
+	}
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &http.Response{StatusCode: s.status, Body: http.NoBody, Request: req}, nil
+}
+
+func readEntries(t *testing.T, path string) []Entry {
+	t.Helper()
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	require.NoError(t, err)
+	defer f.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var e Entry
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &e))
+		entries = append(entries, e)
+	}
+	return entries
+}
+
+func TestTransportLogsMutations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "state", FileName)
+	log := NewLog(path)
+	log.SetCommand("todos create")
+	inner := &stubTransport{status: 201}
+	rt := &Transport{Inner: inner, Log: log}
+
+	payload := `{"content":"Ship it"}`
+	req, err := http.NewRequest(http.MethodPost, "https://3.basecampapi.com/99/buckets/1/todolists/2/todos.json", strings.NewReader(payload))
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{payload}, inner.bodies, "body passes through unchanged")
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	e := entries[0]
+	sum := sha256.Sum256([]byte(payload))
+	assert.Equal(t, "todos create", e.Command)
+	assert.Equal(t, "POST", e.Method)
+	assert.Equal(t, "/99/buckets/1/todolists/2/todos.json", e.Path)
+	assert.Equal(t, hex.EncodeToString(sum[:]), e.PayloadSHA256)
+	assert.Equal(t, 201, e.Status)
+	assert.Empty(t, e.Error)
+	assert.False(t, e.Time.IsZero())
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	if os.PathSeparator == '/' {
+		assert.Equal(t, os.FileMode(0600), info.Mode().Perm())
+	}
+}
+
+func TestTransportRedactsChatbotKey(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	url := "https://3.basecampapi.com/99/integrations/s3cr3tKey/buckets/1/chats/2/lines.json"
+	rt := &Transport{Inner: &stubTransport{err: errors.New(`Post "` + url + `": dial tcp: connection refused`)}, Log: NewLog(path)}
+
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"content":"hi"}`))
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req) //nolint:bodyclose // no response on failure
+	require.Error(t, err)
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "/99/integrations/[REDACTED]/buckets/1/chats/2/lines.json", entries[0].Path)
+	assert.NotContains(t, entries[0].Error, "s3cr3tKey")
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "s3cr3tKey")
+}
+
+func TestRedactPath(t *testing.T) {
+	assert.Equal(t, "/99/buckets/1/todos.json", RedactPath("/99/buckets/1/todos.json"))
+	assert.Equal(t, "/99/integrations/[REDACTED]/buckets/1/chats/2/lines", RedactPath("/99/integrations/key/buckets/1/chats/2/lines"))
+	assert.Equal(t, "/99/integrations/", RedactPath("/99/integrations/"))
+}
+
+func TestTransportSkipsReads(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	rt := &Transport{Inner: &stubTransport{status: 200}, Log: NewLog(path)}
+
+	req, err := http.NewRequest(http.MethodGet, "https://3.basecampapi.com/99/projects.json", nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.NoError(t, err)
+
+	assert.Empty(t, readEntries(t, path))
+}
+
+func TestTransportLogsFailuresAndAppends(t *testing.T) {
+	path := filepath.Join(t.TempDir(), FileName)
+	log := NewLog(path)
+
+	_, err := (&Transport{Inner: &stubTransport{err: errors.New("connection reset")}, Log: log}).
+		RoundTrip(mustRequest(t, http.MethodDelete, "https://3.basecampapi.com/99/buckets/1/recordings/5.json"))
+	require.Error(t, err)
+
+	_, err = (&Transport{Inner: &stubTransport{status: 204}, Log: log}).
+		RoundTrip(mustRequest(t, http.MethodPut, "https://3.basecampapi.com/99/buckets/1/recordings/5/status/trashed.json"))
+	require.NoError(t, err)
+
+	entries := readEntries(t, path)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "connection reset", entries[0].Error)
+	assert.Zero(t, entries[0].Status)
+	assert.Empty(t, entries[0].PayloadSHA256, "no body, no hash")
+	assert.Equal(t, 204, entries[1].Status)
+}
+
+func TestIsMutating(t *testing.T) {
+	for _, m := range []string{"POST", "PUT", "PATCH", "DELETE"} {
+		assert.True(t, IsMutating(m), m)
+	}
+	for _, m := range []string{"", "GET", "HEAD", "OPTIONS"} {
+		assert.False(t, IsMutating(m), m)
+	}
+}
+
+func TestDefaultPathUsesXDGStateHome(t *testing.T) {
+	if runtime.GOOS != "linux" && runtime.GOOS != "freebsd" && runtime.GOOS != "openbsd" {
+		t.Skip("XDG state dir applies to Linux/BSD only")
+	}
+	t.Setenv("XDG_STATE_HOME", "/tmp/xdg-state")
+	assert.Equal(t, filepath.Join("/tmp/xdg-state", "basecamp", FileName), DefaultPath())
+}
+
+func mustRequest(t *testing.T, method, url string) *http.Request {
+	t.Helper()
+	req, err := http.NewRequest(method, url, nil)
+	require.NoError(t, err)
+	return req
+}
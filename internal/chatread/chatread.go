@@ -0,0 +1,136 @@
+// Package chatread remembers how far the user has read each chat.
+//
+// Positions are the newest chat line ID seen per account and chat, kept on
+// this machine only. The CLI (chat messages --unread) and the TUI chat view
+// share them, so lines read in one don't show up as new in the other.
+package chatread
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+const (
+	// FileName is the positions file name within the store directory.
+	FileName = "read.json"
+
+	// DirName is the subdirectory within the cache dir.
+	DirName = "chat"
+
+	// LockTimeout bounds how long a write waits for the file lock before
+	// proceeding without it.
+	LockTimeout = 100 * time.Millisecond
+)
+
+// Position is the newest line read in one chat.
+type Position struct {
+	LineID int64     `json:"line_id"`
+	ReadAt time.Time `json:"read_at"`
+}
+
+// Store reads and writes read positions with file locking.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store rooted at dir (typically <cache_dir>/chat).
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Path returns the full path to the positions file.
+func (s *Store) Path() string {
+	return filepath.Join(s.dir, FileName)
+}
+
+// key identifies a chat; chat IDs are only unique within an account.
+func key(accountID string, chatID int64) string {
+	return accountID + "/" + strconv.FormatInt(chatID, 10)
+}
+
+// LastRead returns the newest line ID read in the chat, or 0 when the chat
+// has never been read here.
+func (s *Store) LastRead(accountID string, chatID int64) int64 {
+	return s.load()[key(accountID, chatID)].LineID
+}
+
+// MarkRead records lineID as read in the chat. Positions only move forward,
+// so an older view finishing late can't mark newer lines unread again.
+func (s *Store) MarkRead(accountID string, chatID, lineID int64) error {
+	if lineID <= 0 {
+		return nil
+	}
+	return s.withLock(func() error {
+		positions := s.load()
+		k := key(accountID, chatID)
+		if positions[k].LineID >= lineID {
+			return nil
+		}
+		positions[k] = Position{LineID: lineID, ReadAt: time.Now()}
+		return s.save(positions)
+	})
+}
+
+// withLock runs fn while holding the store lock. Like the usage store, it
+// fails open: if the lock isn't acquired within LockTimeout, fn runs anyway.
+func (s *Store) withLock(fn func() error) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+
+	fl := flock.New(filepath.Join(s.dir, ".lock"))
+	ctx, cancel := context.WithTimeout(context.Background(), LockTimeout)
+	defer cancel()
+
+	locked, err := fl.TryLockContext(ctx, 10*time.Millisecond)
+	if err != nil && ctx.Err() != context.DeadlineExceeded {
+		return err
+	}
+	if locked {
+		defer func() { _ = fl.Unlock() }()
+	}
+
+	return fn()
+}
+
+// load reads the positions file. Missing or corrupt files yield no
+// positions: everything simply reads as unread once.
+func (s *Store) load() map[string]Position {
+	positions := make(map[string]Position)
+	if data, err := os.ReadFile(s.Path()); err == nil {
+		_ = json.Unmarshal(data, &positions)
+	}
+	return positions
+}
+
+// save writes the positions file atomically.
+func (s *Store) save(positions map[string]Position) error {
+	data, err := json.MarshalIndent(positions, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := fmt.Sprintf("%s.%d.%d.tmp", s.Path(), os.Getpid(), time.Now().UnixNano())
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+
+	// On Windows, os.Rename fails if the destination exists.
+	if runtime.GOOS == "windows" {
+		_ = os.Remove(s.Path())
+	}
+
+	if err := os.Rename(tmpPath, s.Path()); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
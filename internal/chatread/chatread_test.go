@@ -0,0 +1,45 @@
+package chatread
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMarkReadOnlyMovesForward(t *testing.T) {
+	s := NewStore(t.TempDir())
+	assert.Zero(t, s.LastRead("1", 42), "unread chats start at zero")
+
+	require.NoError(t, s.MarkRead("1", 42, 100))
+	assert.Equal(t, int64(100), s.LastRead("1", 42))
+
+	require.NoError(t, s.MarkRead("1", 42, 90))
+	assert.Equal(t, int64(100), s.LastRead("1", 42), "an older line must not move the position back")
+
+	require.NoError(t, s.MarkRead("1", 42, 120))
+	assert.Equal(t, int64(120), s.LastRead("1", 42))
+}
+
+func TestPositionsAreScopedByAccountAndChat(t *testing.T) {
+	s := NewStore(t.TempDir())
+	require.NoError(t, s.MarkRead("1", 42, 100))
+	require.NoError(t, s.MarkRead("2", 42, 7))
+	require.NoError(t, s.MarkRead("1", 43, 5))
+
+	// A fresh store over the same directory sees the persisted positions.
+	s = NewStore(s.dir)
+	assert.Equal(t, int64(100), s.LastRead("1", 42))
+	assert.Equal(t, int64(7), s.LastRead("2", 42))
+	assert.Equal(t, int64(5), s.LastRead("1", 43))
+}
+
+func TestLastReadToleratesCorruptFile(t *testing.T) {
+	s := NewStore(t.TempDir())
+	require.NoError(t, os.WriteFile(s.Path(), []byte("{not json"), 0600))
+
+	assert.Zero(t, s.LastRead("1", 42))
+	require.NoError(t, s.MarkRead("1", 42, 3))
+	assert.Equal(t, int64(3), s.LastRead("1", 42))
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/config"
+)
+
+// traceProfile records why profileName was selected, mirroring the order
+// resolveProfile checks.
+func traceProfile(cfg *config.Config, flags appctx.GlobalFlags, profileName string) {
+	switch {
+	case profileName == "":
+		return
+	case flags.Profile != "":
+		cfg.TraceContext("profile", profileName, string(config.SourceFlag), "--profile")
+	case os.Getenv("BASECAMP_PROFILE") != "":
+		cfg.TraceContext("profile", profileName, string(config.SourceEnv), "BASECAMP_PROFILE")
+	case cfg.DefaultProfile == profileName:
+		source := cfg.Sources["default_profile"]
+		cfg.TraceContext("profile", profileName, source, "default_profile")
+	default:
+		cfg.TraceContext("profile", profileName, string(config.SourceDefault), "the only configured profile")
+	}
+}
+
+// writeContextExplanation prints which layer set each context key and
+// which conflicting values it overrode, for --explain-context. Conflicts
+// are what make a write land in the wrong project, so they are spelled out.
+func writeContextExplanation(w io.Writer, cfg *config.Config) {
+	fmt.Fprintln(w, "Context (precedence: flag > env > profile > local > repo > global > system):")
+	for _, e := range cfg.ExplainContext() {
+		value := e.Value
+		if value == "" {
+			value = "(unset)"
+		}
+
+		line := fmt.Sprintf("  %-12s %s", e.Key, value)
+		switch {
+		case e.Winner != nil:
+			line += " — from " + describeContextSetting(*e.Winner, false)
+		case e.Value != "":
+			line += " — default"
+		}
+		if len(e.Overridden) > 0 {
+			overridden := make([]string, len(e.Overridden))
+			for i, s := range e.Overridden {
+				overridden[i] = describeContextSetting(s, true)
+			}
+			line += "; overrides " + strings.Join(overridden, ", ")
+		}
+		fmt.Fprintln(w, line)
+	}
+}
+
+func describeContextSetting(s config.ContextSetting, withValue bool) string {
+	var desc string
+	switch s.Source {
+	case string(config.SourceFlag), string(config.SourceEnv):
+		desc = s.Origin
+	case "profile":
+		desc = fmt.Sprintf("profile %q", s.Origin)
+	case string(config.SourceDefault):
+		desc = s.Origin
+	default:
+		desc = s.Source + " config " + s.Origin
+		if s.Origin == "default_profile" {
+			desc = "default_profile in " + s.Source + " config"
+		}
+	}
+	if withValue {
+		desc += "=" + s.Value
+	}
+	return desc
+}
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/config"
+)
+
+func TestWriteContextExplanation(t *testing.T) {
+	t.Setenv("BASECAMP_PROFILE", "")
+
+	cfg := config.Default()
+	cfg.ActiveProfile = "work"
+	cfg.AccountID = "7"
+	cfg.ProjectID = "333"
+	traceProfile(cfg, appctx.GlobalFlags{Profile: "work"}, "work")
+	cfg.TraceContext("account_id", "7", "profile", "work")
+	cfg.TraceContext("project_id", "111", string(config.SourceLocal), "/repo/.basecamp/config.json")
+	cfg.TraceContext("project_id", "333", string(config.SourceFlag), "--project")
+
+	var buf bytes.Buffer
+	writeContextExplanation(&buf, cfg)
+	out := buf.String()
+
+	assert.Contains(t, out, "profile      work — from --profile\n")
+	assert.Contains(t, out, "base_url     https://3.basecampapi.com — default\n")
+	assert.Contains(t, out, `account_id   7 — from profile "work"`+"\n")
+	assert.Contains(t, out, "project_id   333 — from --project; overrides local config /repo/.basecamp/config.json=111\n")
+	assert.Contains(t, out, "todolist_id  (unset)\n")
+}
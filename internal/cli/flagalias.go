@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// FlagAlias maps an old flag spelling to the flag that replaced it.
+type FlagAlias struct {
+	Old string // retired spelling, without dashes
+	New string // current spelling, without dashes
+}
+
+// flagAliases is the single record of renamed flags. An alias only applies
+// to commands that define New but not Old, so a command that still owns the
+// old spelling is never rewritten. Add an entry here instead of registering
+// a duplicate flag when renaming one.
+var flagAliases = []FlagAlias{
+	{Old: "body", New: "content"},
+	{Old: "content", New: "body"},
+}
+
+// rewriteFlagAliases resolves the command args target and replaces any
+// aliased flag spellings with their current names. Each alias warns once on
+// w, however many times it appears.
+func rewriteFlagAliases(root *cobra.Command, args []string, w io.Writer) []string {
+	return applyFlagAliases(root, args, flagAliases, w)
+}
+
+func applyFlagAliases(root *cobra.Command, args []string, aliases []FlagAlias, w io.Writer) []string {
+	target, _, err := root.Find(args)
+	if err != nil || target == nil {
+		return args
+	}
+
+	active := make(map[string]string)
+	for _, a := range aliases {
+		if lookupFlag(target, a.Old) == nil && lookupFlag(target, a.New) != nil {
+			active[a.Old] = a.New
+		}
+	}
+	if len(active) == 0 {
+		return args
+	}
+
+	out := make([]string, len(args))
+	copy(out, args)
+	warned := make(map[string]bool)
+	for i, arg := range out {
+		if arg == "--" {
+			break
+		}
+		name, ok := strings.CutPrefix(arg, "--")
+		if !ok {
+			continue
+		}
+		name, value, hasValue := strings.Cut(name, "=")
+		replacement, ok := active[name]
+		if !ok {
+			continue
+		}
+		out[i] = "--" + replacement
+		if hasValue {
+			out[i] += "=" + value
+		}
+		if !warned[name] {
+			warned[name] = true
+			fmt.Fprintf(w, "Warning: --%s is deprecated for %s, use --%s\n", name, target.CommandPath(), replacement)
+		}
+	}
+	return out
+}
+
+func lookupFlag(cmd *cobra.Command, name string) *pflag.Flag {
+	if f := cmd.Flags().Lookup(name); f != nil {
+		return f
+	}
+	return cmd.InheritedFlags().Lookup(name)
+}
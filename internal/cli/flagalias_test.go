@@ -0,0 +1,120 @@
+package cli
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/commands"
+)
+
+func newAliasTestRoot() *cobra.Command {
+	root := &cobra.Command{Use: "basecamp"}
+	root.PersistentFlags().String("project", "", "")
+
+	docs := &cobra.Command{Use: "docs"}
+	docsUpdate := &cobra.Command{Use: "update", RunE: func(*cobra.Command, []string) error { return nil }}
+	docsUpdate.Flags().String("content", "", "")
+	docs.AddCommand(docsUpdate)
+
+	messages := &cobra.Command{Use: "messages"}
+	messagesUpdate := &cobra.Command{Use: "update", RunE: func(*cobra.Command, []string) error { return nil }}
+	messagesUpdate.Flags().String("body", "", "")
+	messagesUpdate.Flags().String("content", "", "")
+	messages.AddCommand(messagesUpdate)
+
+	root.AddCommand(docs, messages)
+	return root
+}
+
+func TestApplyFlagAliases(t *testing.T) {
+	aliases := []FlagAlias{
+		{Old: "body", New: "content"},
+		{Old: "in", New: "project"},
+	}
+
+	tests := []struct {
+		name     string
+		args     []string
+		want     []string
+		wantWarn string
+	}{
+		{
+			name:     "separate value",
+			args:     []string{"docs", "update", "1", "--body", "hello"},
+			want:     []string{"docs", "update", "1", "--content", "hello"},
+			wantWarn: "Warning: --body is deprecated for basecamp docs update, use --content\n",
+		},
+		{
+			name:     "inline value",
+			args:     []string{"docs", "update", "1", "--body=hello"},
+			want:     []string{"docs", "update", "1", "--content=hello"},
+			wantWarn: "Warning: --body is deprecated for basecamp docs update, use --content\n",
+		},
+		{
+			name:     "inherited new flag",
+			args:     []string{"docs", "update", "1", "--in", "Launch", "--in=Other"},
+			want:     []string{"docs", "update", "1", "--project", "Launch", "--project=Other"},
+			wantWarn: "Warning: --in is deprecated for basecamp docs update, use --project\n",
+		},
+		{
+			name: "command still owns old spelling",
+			args: []string{"messages", "update", "1", "--body", "hello"},
+			want: []string{"messages", "update", "1", "--body", "hello"},
+		},
+		{
+			name: "after terminator",
+			args: []string{"docs", "update", "--", "--body"},
+			want: []string{"docs", "update", "--", "--body"},
+		},
+		{
+			name: "unknown command",
+			args: []string{"nope", "--body", "x"},
+			want: []string{"nope", "--body", "x"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var warn bytes.Buffer
+			got := applyFlagAliases(newAliasTestRoot(), tt.args, aliases, &warn)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantWarn, warn.String())
+		})
+	}
+}
+
+func TestApplyFlagAliasesWarnsOncePerAlias(t *testing.T) {
+	var warn bytes.Buffer
+	args := []string{"docs", "update", "1", "--body", "a", "--body", "b"}
+	got := applyFlagAliases(newAliasTestRoot(), args, []FlagAlias{{Old: "body", New: "content"}}, &warn)
+
+	assert.Equal(t, []string{"docs", "update", "1", "--content", "a", "--content", "b"}, got)
+	assert.Equal(t, 1, bytes.Count(warn.Bytes(), []byte("Warning:")))
+}
+
+// TestFlagAliasesTargetRealFlags keeps the alias table honest: every alias
+// must rewrite to a flag that some registered command actually defines.
+func TestFlagAliasesTargetRealFlags(t *testing.T) {
+	root := NewRootCmd()
+	root.AddCommand(commands.NewMessagesCmd(), commands.NewCardsCmd(), commands.NewFilesCmd(), commands.NewChatCmd())
+
+	defined := map[string]bool{}
+	var walk func(*cobra.Command)
+	walk = func(c *cobra.Command) {
+		c.LocalFlags().VisitAll(func(f *pflag.Flag) { defined[f.Name] = true })
+		for _, sub := range c.Commands() {
+			walk(sub)
+		}
+	}
+	walk(root)
+
+	for _, a := range flagAliases {
+		require.NotEqual(t, a.Old, a.New)
+		assert.True(t, defined[a.New], "alias --%s targets undefined flag --%s", a.Old, a.New)
+	}
+}
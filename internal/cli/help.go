@@ -179,7 +179,7 @@ func renderRootHelp(w io.Writer, cmd *cobra.Command) {
 		{"", "--jq", "Filter JSON with jq expression"},
 		{"-m", "--md", "Output as Markdown"},
 		{"-q", "--quiet", "Quiet output"},
-		{"-p", "--project", "Project ID or name"},
+		{"-p", "--project", "Project name, ID, or URL"},
 		{"-v", "--verbose", "Verbose output"},
 		{"", "--help", "Show help for command"},
 		{"", "--version", "Show version"},
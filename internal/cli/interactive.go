@@ -0,0 +1,184 @@
+package cli
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/term"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/commands"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// breadcrumbPlaceholderRe matches a <placeholder> in a breadcrumb command.
+var breadcrumbPlaceholderRe = regexp.MustCompile(`<([^<>\s][^<>]*)>`)
+
+// interactiveCarryFlags are global flags re-passed to a breadcrumb run so it
+// talks to the same account with the same output behavior.
+var interactiveCarryFlags = append(slices.Clone(commands.ContextCarryFlags), "columns", "styled")
+
+// runInteractiveBreadcrumbs offers the hints of a finished command by number
+// (--interactive). The chosen hint runs as a child process with --interactive
+// still set, so each result offers its own next steps. When the command was a
+// list shown as a table, the picked row's show command runs instead. Returns
+// the child's exit code and true when a command ran.
+func runInteractiveBreadcrumbs(root, executed *cobra.Command) (int, bool) {
+	app := appctx.FromContext(executed.Context())
+	if app == nil || !app.Flags.Interactive || app.Output.EffectiveFormat() != output.FormatStyled {
+		return 0, false
+	}
+	if !term.IsTerminal(os.Stdin.Fd()) || !term.IsTerminal(os.Stdout.Fd()) {
+		return 0, false
+	}
+	if next := app.Output.NextCommand(); next != "" {
+		args, err := breadcrumbArgs(next)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Can't show the picked item: %v\n", err)
+			return 0, false
+		}
+		return runInteractiveChild(root, args, "the picked item")
+	}
+
+	crumbs := app.Output.LastBreadcrumbs()
+	if len(crumbs) == 0 {
+		return 0, false
+	}
+
+	choice, ok := promptBreadcrumbChoice(len(crumbs))
+	if !ok {
+		return 0, false
+	}
+
+	label := fmt.Sprintf("hint %d", choice)
+	args, err := breadcrumbArgs(crumbs[choice-1].Cmd)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't run %s: %v\n", label, err)
+		return 0, false
+	}
+	args, ok = fillBreadcrumbPlaceholders(args, bufio.NewReader(os.Stdin), os.Stderr)
+	if !ok {
+		return 0, false
+	}
+	return runInteractiveChild(root, args, label)
+}
+
+// runInteractiveChild runs this binary with args plus the carried global
+// flags, echoing the command first. label names what runs in error messages.
+func runInteractiveChild(root *cobra.Command, args []string, label string) (int, bool) {
+	args = append(args, carriedFlags(root, args)...)
+
+	self, err := os.Executable()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Can't run %s: %v\n", label, err)
+		return 0, false
+	}
+	fmt.Fprintf(os.Stderr, "\n$ %s %s\n\n", output.InvokedBinary(), strings.Join(args, " "))
+
+	child := exec.Command(self, args...) //nolint:gosec // G204: re-invokes this binary with a command the user picked
+	child.Stdin, child.Stdout, child.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := child.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return exitErr.ExitCode(), true
+		}
+		fmt.Fprintf(os.Stderr, "Can't run %s: %v\n", label, err)
+		return 1, true
+	}
+	return 0, true
+}
+
+// promptBreadcrumbChoice asks for a hint number on stderr. With nine or fewer
+// hints a single keypress picks one; otherwise the number is typed and
+// confirmed with Enter. Any other input quits.
+func promptBreadcrumbChoice(n int) (int, bool) {
+	fmt.Fprintf(os.Stderr, "\nRun a hint [1-%d], or any other key to quit: ", n)
+
+	var input string
+	if n <= 9 {
+		state, err := term.MakeRaw(os.Stdin.Fd())
+		if err != nil {
+			return 0, false
+		}
+		buf := make([]byte, 1)
+		_, err = os.Stdin.Read(buf)
+		_ = term.Restore(os.Stdin.Fd(), state)
+		if err != nil {
+			return 0, false
+		}
+		input = string(buf)
+		fmt.Fprintln(os.Stderr)
+	} else {
+		line, err := bufio.NewReader(os.Stdin).ReadString('\n')
+		if err != nil && line == "" {
+			return 0, false
+		}
+		input = line
+	}
+	return parseBreadcrumbChoice(input, n)
+}
+
+// parseBreadcrumbChoice parses a 1-based hint number in [1, n].
+func parseBreadcrumbChoice(input string, n int) (int, bool) {
+	choice, err := strconv.Atoi(strings.TrimSpace(input))
+	if err != nil || choice < 1 || choice > n {
+		return 0, false
+	}
+	return choice, true
+}
+
+// breadcrumbArgs splits a breadcrumb command into arguments for this binary.
+// Only single "basecamp ..." (or "bcq ...", when invoked as bcq) invocations
+// can run; pipelines and other programs can't.
+func breadcrumbArgs(cmd string) ([]string, error) {
+	args, err := commands.SplitCommandLine(cmd)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) == 0 || (args[0] != output.CanonicalBinary && args[0] != output.InvokedBinary()) {
+		return nil, fmt.Errorf("%q is not a basecamp command", cmd)
+	}
+	return args[1:], nil
+}
+
+// fillBreadcrumbPlaceholders prompts for each distinct <placeholder> in args
+// and substitutes the answers. An answer is used verbatim as (part of) one
+// argument, so "<text>" can take a whole sentence. An empty answer cancels.
+func fillBreadcrumbPlaceholders(args []string, in *bufio.Reader, prompt io.Writer) ([]string, bool) {
+	answers := make(map[string]string)
+	filled := make([]string, len(args))
+	for i, arg := range args {
+		for _, m := range breadcrumbPlaceholderRe.FindAllStringSubmatch(arg, -1) {
+			name := m[1]
+			if _, ok := answers[name]; ok {
+				continue
+			}
+			fmt.Fprintf(prompt, "%s: ", name)
+			line, err := in.ReadString('\n')
+			line = strings.TrimRight(line, "\r\n")
+			if strings.TrimSpace(line) == "" || (err != nil && line == "") {
+				return nil, false
+			}
+			answers[name] = line
+		}
+		filled[i] = breadcrumbPlaceholderRe.ReplaceAllStringFunc(arg, func(ph string) string {
+			return answers[ph[1:len(ph)-1]]
+		})
+	}
+	return filled, true
+}
+
+// carriedFlags returns --interactive plus the global flags set on this run
+// that the breadcrumb doesn't already pass.
+func carriedFlags(root *cobra.Command, args []string) []string {
+	return append([]string{"--interactive"}, commands.CarryFlags(root, args, interactiveCarryFlags)...)
+}
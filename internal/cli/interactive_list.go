@@ -0,0 +1,186 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/term"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
+)
+
+// listTitleKeys are the row fields tried, in order, for a row's title.
+var listTitleKeys = []string{"title", "name", "content", "subject", "summary"}
+
+// enableListTable shows list results as a table under --interactive, when
+// both ends of the terminal can drive one.
+func enableListTable(app *appctx.App) {
+	if !app.Flags.Interactive || !term.IsTerminal(os.Stdin.Fd()) || !term.IsTerminal(os.Stdout.Fd()) {
+		return
+	}
+	app.Output.SetListView(showListTable)
+}
+
+// showListTable is the output.ListView for --interactive: list responses
+// open as a filterable table, and enter picks a row to show.
+func showListTable(resp *output.Response) (bool, string, error) {
+	data := resp.DisplayData
+	if data == nil {
+		data = resp.Data
+	}
+	rows, ok := output.NormalizeData(data).([]map[string]any)
+	if !ok || len(rows) == 0 {
+		return false, "", nil
+	}
+	items := listTableItems(rows)
+	if len(items) < len(rows) {
+		return false, "", nil // rows without IDs can't be shown
+	}
+
+	styles := tui.NewStyles()
+	list := widget.NewList(styles)
+	list.SetItems(items)
+	list.SetFocused(true)
+
+	final, err := tea.NewProgram(listTableModel{
+		list:   list,
+		styles: styles,
+		title:  richtext.SanitizeSingleLine(resp.Summary),
+	}).Run()
+	if err != nil {
+		return true, "", err
+	}
+	m := final.(listTableModel) //nolint:errcheck // type assertion always succeeds here
+	if m.picked == nil {
+		return true, "", nil
+	}
+	for _, row := range rows {
+		if rowID(row["id"]) == m.picked.ID {
+			return true, rowShowCommand(resp.Breadcrumbs, row), nil
+		}
+	}
+	return true, "", nil
+}
+
+// listTableItems turns rows into list items: the row's title, its project,
+// and its ID. Rows without an ID are skipped.
+func listTableItems(rows []map[string]any) []widget.ListItem {
+	items := make([]widget.ListItem, 0, len(rows))
+	for _, row := range rows {
+		id := rowID(row["id"])
+		if id == "" {
+			continue
+		}
+		item := widget.ListItem{ID: id, Title: "#" + id, Extra: "#" + id}
+		for _, key := range listTitleKeys {
+			if s, ok := row[key].(string); ok && strings.TrimSpace(s) != "" {
+				item.Title = richtext.SanitizeSingleLine(s)
+				break
+			}
+		}
+		if bucket, ok := row["bucket"].(map[string]any); ok {
+			if name, ok := bucket["name"].(string); ok {
+				item.Description = richtext.SanitizeSingleLine(name)
+			}
+		}
+		items = append(items, item)
+	}
+	return items
+}
+
+// rowShowCommand is the command that shows row: the response's own "show"
+// hint with the row's ID (and project) filled in, or the generic show.
+func rowShowCommand(crumbs []output.Breadcrumb, row map[string]any) string {
+	id := rowID(row["id"])
+	for _, bc := range crumbs {
+		if bc.Action != "show" || !strings.Contains(bc.Cmd, "<id>") {
+			continue
+		}
+		cmd := strings.ReplaceAll(bc.Cmd, "<id>", id)
+		if bucket, ok := row["bucket"].(map[string]any); ok {
+			if projectID := rowID(bucket["id"]); projectID != "" {
+				cmd = strings.ReplaceAll(cmd, "<project_id>", projectID)
+			}
+		}
+		if !breadcrumbPlaceholderRe.MatchString(cmd) {
+			return cmd
+		}
+	}
+	return output.CanonicalBinary + " show " + id
+}
+
+// rowID renders a row's ID, whichever way the JSON number was decoded.
+func rowID(v any) string {
+	switch id := v.(type) {
+	case json.Number:
+		return id.String()
+	case float64:
+		return strconv.FormatFloat(id, 'f', -1, 64)
+	case int64:
+		return strconv.FormatInt(id, 10)
+	case int:
+		return strconv.Itoa(id)
+	case string:
+		return id
+	}
+	return ""
+}
+
+// listTableModel is the --interactive list table.
+type listTableModel struct {
+	list   *widget.List
+	styles *tui.Styles
+	title  string
+	picked *widget.ListItem
+}
+
+func (m listTableModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m listTableModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		// Title and help lines.
+		m.list.SetSize(msg.Width, max(1, msg.Height-4))
+		return m, nil
+	case tea.KeyPressMsg:
+		if m.list.Filtering() {
+			return m, m.list.Update(msg)
+		}
+		switch msg.String() {
+		case "q", "esc", "ctrl+c":
+			return m, tea.Quit
+		case "/":
+			m.list.StartFilter()
+			return m, nil
+		case "enter":
+			if m.picked = m.list.Selected(); m.picked != nil {
+				return m, tea.Quit
+			}
+			return m, nil
+		}
+		return m, m.list.Update(msg)
+	}
+	return m, nil
+}
+
+func (m listTableModel) View() tea.View {
+	theme := m.styles.Theme()
+	title := lipgloss.NewStyle().Bold(true).Foreground(theme.Primary).Render(m.title)
+	help := lipgloss.NewStyle().Foreground(theme.Muted).
+		Render(fmt.Sprintf("↑↓/jk navigate • / filter • enter show • q quit  (%d items)", m.list.Len()))
+
+	v := tea.NewView(lipgloss.JoinVertical(lipgloss.Left, title, "", m.list.View(), "", help))
+	v.AltScreen = true
+	return v
+}
@@ -0,0 +1,74 @@
+package cli
+
+import (
+	"encoding/json"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
+)
+
+func TestListTableItems(t *testing.T) {
+	rows := []map[string]any{
+		{"id": json.Number("12"), "title": "Ship it", "bucket": map[string]any{"id": json.Number("3"), "name": "Launch"}},
+		{"id": float64(13), "name": "Alice"},
+		{"id": float64(14), "content": "  "},
+		{"title": "No ID"},
+	}
+	items := listTableItems(rows)
+	require.Len(t, items, 3)
+	assert.Equal(t, widget.ListItem{ID: "12", Title: "Ship it", Description: "Launch", Extra: "#12"}, items[0])
+	assert.Equal(t, "Alice", items[1].Title)
+	assert.Equal(t, "#14", items[2].Title, "blank titles fall back to the ID")
+}
+
+func TestRowShowCommand(t *testing.T) {
+	row := map[string]any{"id": float64(12), "bucket": map[string]any{"id": float64(3)}}
+
+	crumbs := []output.Breadcrumb{
+		{Action: "create", Cmd: "basecamp todo <content>"},
+		{Action: "show", Cmd: "basecamp todos show <id> --in <project_id>"},
+	}
+	assert.Equal(t, "basecamp todos show 12 --in 3", rowShowCommand(crumbs, row))
+
+	crumbs = []output.Breadcrumb{{Action: "show", Cmd: "basecamp cards show <id> --column <column>"}}
+	assert.Equal(t, "basecamp show 12", rowShowCommand(crumbs, row), "unfillable hints fall back to show")
+	assert.Equal(t, "basecamp show 12", rowShowCommand(nil, row))
+}
+
+func TestListTableModelKeys(t *testing.T) {
+	newModel := func() listTableModel {
+		styles := tui.NewStyles()
+		list := widget.NewList(styles)
+		list.SetItems([]widget.ListItem{{ID: "1", Title: "First"}, {ID: "2", Title: "Second"}})
+		list.SetFocused(true)
+		list.SetSize(80, 10)
+		return listTableModel{list: list, styles: styles}
+	}
+	press := func(m listTableModel, k tea.KeyPressMsg) (listTableModel, tea.Cmd) {
+		next, cmd := m.Update(k)
+		return next.(listTableModel), cmd
+	}
+
+	m, _ := press(newModel(), tea.KeyPressMsg{Code: 'j', Text: "j"})
+	m, cmd := press(m, tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotNil(t, m.picked)
+	assert.Equal(t, "2", m.picked.ID)
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+
+	m, _ = press(newModel(), tea.KeyPressMsg{Code: '/', Text: "/"})
+	assert.True(t, m.list.Filtering())
+	m, _ = press(m, tea.KeyPressMsg{Code: 'q', Text: "q"})
+	assert.True(t, m.list.Filtering(), "q types into the filter")
+
+	m, cmd = press(newModel(), tea.KeyPressMsg{Code: 'q', Text: "q"})
+	assert.Nil(t, m.picked)
+	require.NotNil(t, cmd)
+	assert.IsType(t, tea.QuitMsg{}, cmd())
+}
@@ -0,0 +1,86 @@
+package cli
+
+import (
+	"bufio"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBreadcrumbArgs(t *testing.T) {
+	tests := []struct {
+		cmd  string
+		want []string
+	}{
+		{"basecamp todos show 123", []string{"todos", "show", "123"}},
+		{`basecamp todo "Ship it" --in 42`, []string{"todo", "Ship it", "--in", "42"}},
+		{`basecamp comment 1 'it\'s'`, nil}, // unterminated: \ is literal in single quotes
+		{`basecamp comment 1 it\'s`, []string{"comment", "1", "it's"}},
+		{"basecamp cards move 5 --to <column>", []string{"cards", "move", "5", "--to", "<column>"}},
+	}
+	for _, tt := range tests {
+		got, err := breadcrumbArgs(tt.cmd)
+		if tt.want == nil {
+			assert.Error(t, err, tt.cmd)
+			continue
+		}
+		require.NoError(t, err, tt.cmd)
+		assert.Equal(t, tt.want, got, tt.cmd)
+	}
+}
+
+func TestBreadcrumbArgsRejectsNonBasecampCommands(t *testing.T) {
+	for _, cmd := range []string{
+		"",
+		"open https://3.basecamp.com",
+		"basecamp todos list | head",
+		"basecamp todos list && rm -rf /",
+	} {
+		_, err := breadcrumbArgs(cmd)
+		assert.Error(t, err, cmd)
+	}
+}
+
+func TestParseBreadcrumbChoice(t *testing.T) {
+	got, ok := parseBreadcrumbChoice("2", 3)
+	assert.True(t, ok)
+	assert.Equal(t, 2, got)
+
+	got, ok = parseBreadcrumbChoice(" 12\n", 12)
+	assert.True(t, ok)
+	assert.Equal(t, 12, got)
+
+	for _, input := range []string{"0", "4", "q", "\r", ""} {
+		_, ok := parseBreadcrumbChoice(input, 3)
+		assert.False(t, ok, "%q", input)
+	}
+}
+
+func TestFillBreadcrumbPlaceholders(t *testing.T) {
+	args := []string{"comments", "create", "<id>", "<text>", "--in", "<id>"}
+	var prompts bytes.Buffer
+	in := bufio.NewReader(strings.NewReader("789\nLooks good to me\n"))
+
+	got, ok := fillBreadcrumbPlaceholders(args, in, &prompts)
+	require.True(t, ok)
+	assert.Equal(t, []string{"comments", "create", "789", "Looks good to me", "--in", "789"}, got)
+	assert.Equal(t, "id: text: ", prompts.String(), "each placeholder is asked once")
+}
+
+func TestFillBreadcrumbPlaceholdersEmptyAnswerCancels(t *testing.T) {
+	in := bufio.NewReader(strings.NewReader("\n"))
+	_, ok := fillBreadcrumbPlaceholders([]string{"todos", "show", "<id>"}, in, &bytes.Buffer{})
+	assert.False(t, ok)
+}
+
+func TestCarriedFlags(t *testing.T) {
+	root := NewRootCmd()
+	require.NoError(t, root.PersistentFlags().Set("account", "99"))
+	require.NoError(t, root.PersistentFlags().Set("profile", "work"))
+
+	got := carriedFlags(root, []string{"todos", "list", "--profile=home"})
+	assert.Equal(t, []string{"--interactive", "--account=99"}, got)
+}
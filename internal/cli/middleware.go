@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+)
+
+// startCommand runs before each command once the app is built: it names the
+// invocation (and the audit log's entries) after the command and, with -v,
+// traces the start along with the correlation ID its requests will carry.
+func startCommand(cmd *cobra.Command, app *appctx.App) {
+	command := strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" ")
+	if app.Audit != nil {
+		app.Audit.SetCommand(command)
+	}
+	if app.Invocation == nil {
+		return
+	}
+	app.Invocation.SetCommand(command)
+	if app.Hooks != nil {
+		app.Hooks.OnCommandStart(app.Invocation)
+	}
+}
+
+// finishCommand runs after each command, successful or not, tracing its
+// duration with -v.
+func finishCommand(app *appctx.App, err error) {
+	if app == nil || app.Invocation == nil || app.Hooks == nil {
+		return
+	}
+	app.Hooks.OnCommandEnd(app.Invocation, err)
+}
+
+// interruptContext is canceled by the first Ctrl-C (or SIGTERM), so in-flight
+// requests stop and fan-out commands can report what they collected. Once it
+// fires the signals get their default behavior back: a second Ctrl-C exits
+// at once.
+func interruptContext() (context.Context, context.CancelFunc) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	go func() {
+		<-ctx.Done()
+		stop()
+	}()
+	return ctx, stop
+}
@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/outbox"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// queueFailedCommand saves a command run with --queue-on-failure to the
+// outbox when it failed on the network before any write reached Basecamp,
+// and says so in the returned error's hint. Other failures pass through.
+func queueFailedCommand(cmd *cobra.Command, args []string, err error) error {
+	app := appctx.FromContext(cmd.Context())
+	if app == nil || !app.Flags.QueueOnFailure || !app.Requests.Retryable() {
+		return err
+	}
+	if app.Config == nil || app.Config.CacheDir == "" {
+		return err
+	}
+
+	if !changesData(cmd) {
+		return withHint(err, "Not queued: only commands that change data are queued")
+	}
+	replay := outboxArgs(args)
+	if app.ReadStdin || slices.ContainsFunc(replay, readsInput) {
+		return withHint(err, "Not queued: input read from stdin or an editor can't be replayed")
+	}
+
+	dir, _ := os.Getwd()
+	store := outbox.NewStore(filepath.Join(app.Config.CacheDir, outbox.DirName))
+	entry, addErr := store.Add(outbox.Entry{
+		Args:     replay,
+		Account:  app.Config.AccountID,
+		Profile:  app.Config.ActiveProfile,
+		Dir:      dir,
+		Error:    err.Error(),
+		Attempts: 1,
+	})
+	if addErr != nil {
+		return withHint(err, "Not queued: "+addErr.Error())
+	}
+	return withHint(err, fmt.Sprintf("Queued as outbox #%d; retry with: basecamp outbox flush", entry.ID))
+}
+
+// mutatingCommands are the command names that change data in Basecamp.
+// Reads are never queued: a replay later has no one to show the result to.
+var mutatingCommands = map[string]bool{
+	"add": true, "answer": true, "archive": true, "assign": true, "attach": true,
+	"color": true, "complete": true, "copy": true, "create": true, "delete": true,
+	"disable": true, "done": true, "enable": true, "invite": true, "log-time": true,
+	"move": true, "no-on-hold": true, "on-hold": true, "pin": true, "position": true,
+	"post": true, "publish": true, "put": true, "remove": true, "rename": true,
+	"reply": true, "reposition": true, "restore": true, "set": true, "subscribe": true,
+	"sweep": true, "sync": true, "track": true, "trash": true, "unassign": true,
+	"uncomplete": true, "unpin": true, "unsubscribe": true, "untrack": true,
+	"unwatch": true, "update": true, "upload": true, "visibility": true, "watch": true,
+}
+
+// localCommands are the top-level commands whose changes stay on this
+// machine, so none of their subcommands is queued.
+var localCommands = map[string]bool{
+	"auth": true, "bonfire": true, "completion": true, "config": true, "outbox": true,
+	"plugins": true, "profile": true, "setup": true, "skill": true,
+}
+
+// changesData reports whether cmd is one that changes data in Basecamp.
+func changesData(cmd *cobra.Command) bool {
+	if !mutatingCommands[cmd.Name()] {
+		return false
+	}
+	top := cmd
+	for top.HasParent() && top.Parent().HasParent() {
+		top = top.Parent()
+	}
+	return !localCommands[top.Name()]
+}
+
+// readsInput reports whether arg makes a command read input that isn't on
+// its command line: "-" or a "--flag=-" value for stdin, or --edit.
+func readsInput(arg string) bool {
+	return arg == "-" || strings.HasSuffix(arg, "=-") || arg == "--edit" || strings.HasPrefix(arg, "--edit=")
+}
+
+// outboxArgs drops --queue-on-failure from args: a replay that fails again
+// stays in the outbox rather than being queued a second time.
+func outboxArgs(args []string) []string {
+	out := make([]string, 0, len(args))
+	for i, arg := range args {
+		if arg == "--" {
+			return append(out, args[i:]...)
+		}
+		if arg == "--queue-on-failure" || strings.HasPrefix(arg, "--queue-on-failure=") {
+			continue
+		}
+		out = append(out, arg)
+	}
+	return out
+}
+
+// withHint puts note ahead of err's existing hint. The result doesn't wrap
+// err: rendering would otherwise find the SDK error inside and show its
+// hint instead.
+func withHint(err error, note string) error {
+	e := *output.AsError(err)
+	if e.Hint != "" {
+		note += " (" + e.Hint + ")"
+	}
+	e.Hint = note
+	e.Cause = nil
+	return &e
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/outbox"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+type offlineTransport struct{}
+
+func (offlineTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("dial tcp: connection refused")
+}
+
+// offlineCommand returns a command whose app saw a network failure, with
+// --queue-on-failure set as given.
+func offlineCommand(t *testing.T, queue bool) (*cobra.Command, *outbox.Store) {
+	t.Helper()
+	cfg := &config.Config{AccountID: "99999", CacheDir: t.TempDir(), ActiveProfile: "work"}
+	app := &appctx.App{
+		Config:   cfg,
+		Requests: &outbox.Tracker{Inner: offlineTransport{}},
+		Flags:    appctx.GlobalFlags{QueueOnFailure: queue},
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://3.basecampapi.com/99999/projects.json", nil)
+	require.NoError(t, err)
+	_, err = app.Requests.RoundTrip(req) //nolint:bodyclose // no response on failure
+	require.Error(t, err)
+
+	cmd := &cobra.Command{Use: "create"}
+	cmd.SetContext(appctx.WithApp(context.Background(), app))
+	return cmd, outbox.NewStore(filepath.Join(cfg.CacheDir, outbox.DirName))
+}
+
+func TestQueueFailedCommand(t *testing.T) {
+	cmd, store := offlineCommand(t, true)
+	args := []string{"todos", "create", "Draft", "--queue-on-failure", "--in", "Launch"}
+
+	err := queueFailedCommand(cmd, args, output.ErrNetwork(errors.New("dial tcp: connection refused")))
+
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, output.CodeNetwork, e.Code, "the failure is still reported")
+	assert.Equal(t, "Queued as outbox #1; retry with: basecamp outbox flush (dial tcp: connection refused)", e.Hint)
+
+	entries := store.List()
+	require.Len(t, entries, 1)
+	assert.Equal(t, []string{"todos", "create", "Draft", "--in", "Launch"}, entries[0].Args)
+	assert.Equal(t, "99999", entries[0].Account)
+	assert.Equal(t, "work", entries[0].Profile)
+	assert.NotEmpty(t, entries[0].Dir)
+	assert.Equal(t, 1, entries[0].Attempts)
+}
+
+func TestQueueFailedCommandWithoutFlag(t *testing.T) {
+	cmd, store := offlineCommand(t, false)
+	netErr := output.ErrNetwork(errors.New("dial tcp: connection refused"))
+
+	assert.Same(t, netErr, queueFailedCommand(cmd, []string{"todos", "create", "Draft"}, netErr))
+	assert.Empty(t, store.List())
+}
+
+func TestQueueFailedCommandRefusesStdin(t *testing.T) {
+	cmd, store := offlineCommand(t, true)
+
+	err := queueFailedCommand(cmd, []string{"chat", "post", "-", "--queue-on-failure"}, output.ErrNetwork(errors.New("timeout")))
+
+	assert.Contains(t, err.Error(), "Not queued: input read from stdin or an editor can't be replayed")
+	assert.Empty(t, store.List())
+}
+
+func TestQueueFailedCommandRefusesStdinFlagAndEditor(t *testing.T) {
+	for _, args := range [][]string{
+		{"messages", "create", "Notes", "--content=-"},
+		{"files", "upload", "--file", "-"},
+		{"comment", "123", "--edit"},
+	} {
+		cmd, store := offlineCommand(t, true)
+		err := queueFailedCommand(cmd, args, output.ErrNetwork(errors.New("timeout")))
+		assert.Contains(t, err.Error(), "Not queued: input read from stdin or an editor", args)
+		assert.Empty(t, store.List())
+	}
+
+	cmd, store := offlineCommand(t, true)
+	appctx.FromContext(cmd.Context()).ReadStdin = true
+	err := queueFailedCommand(cmd, []string{"comment", "123"}, output.ErrNetwork(errors.New("timeout")))
+	assert.Contains(t, err.Error(), "Not queued: input read from stdin", "piped content")
+	assert.Empty(t, store.List())
+}
+
+func TestQueueFailedCommandOnlyQueuesChanges(t *testing.T) {
+	root := &cobra.Command{Use: "basecamp"}
+	todos := &cobra.Command{Use: "todos"}
+	list := &cobra.Command{Use: "list"}
+	config := &cobra.Command{Use: "config"}
+	set := &cobra.Command{Use: "set"}
+	todos.AddCommand(list)
+	config.AddCommand(set)
+	root.AddCommand(todos, config)
+
+	cmd, store := offlineCommand(t, true)
+	for _, c := range []*cobra.Command{list, set} {
+		c.SetContext(cmd.Context())
+		err := queueFailedCommand(c, []string{c.Parent().Name(), c.Name()}, output.ErrNetwork(errors.New("timeout")))
+		assert.Contains(t, err.Error(), "Not queued: only commands that change data are queued")
+	}
+	assert.Empty(t, store.List())
+}
+
+func TestOutboxArgs(t *testing.T) {
+	assert.Equal(t,
+		[]string{"api", "post", "/x.json", "--", "--queue-on-failure"},
+		outboxArgs([]string{"api", "--queue-on-failure=true", "post", "/x.json", "--", "--queue-on-failure"}))
+}
@@ -1,13 +1,17 @@
 package cli
 
 import (
+	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/itchyny/gojq"
 	"github.com/spf13/cobra"
@@ -20,6 +24,7 @@ import (
 	"github.com/basecamp/basecamp-cli/internal/harness"
 	"github.com/basecamp/basecamp-cli/internal/hostutil"
 	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/presenter"
 	"github.com/basecamp/basecamp-cli/internal/tui"
 	"github.com/basecamp/basecamp-cli/internal/version"
 )
@@ -30,7 +35,7 @@ func NewRootCmd() *cobra.Command {
 	var updateCheck *commands.UpdateCheck
 
 	cmd := &cobra.Command{
-		Use:                        "basecamp",
+		Use:                        output.InvokedBinary(), // so usage lines run as printed under bcq
 		Short:                      "Command-line interface for Basecamp",
 		Long:                       "basecamp is a CLI tool for interacting with Basecamp projects, todos, messages, and more.",
 		Version:                    version.Version,
@@ -93,6 +98,7 @@ func NewRootCmd() *cobra.Command {
 				Project:  flags.Project,
 				Todolist: flags.Todolist,
 				CacheDir: flags.CacheDir,
+				Timezone: flags.Timezone,
 			})
 			if err != nil {
 				if bareRoot {
@@ -111,6 +117,7 @@ func NewRootCmd() *cobra.Command {
 				}
 				return err
 			}
+			traceProfile(cfg, flags, profileName)
 			if profileName != "" {
 				if err := cfg.ApplyProfile(profileName); err != nil {
 					return err
@@ -128,6 +135,7 @@ func NewRootCmd() *cobra.Command {
 					Project:  flags.Project,
 					Todolist: flags.Todolist,
 					CacheDir: flags.CacheDir,
+					Timezone: flags.Timezone,
 				})
 				// Profile-scoped cache (only if cache dir was not explicitly set via flag or env)
 				if flags.CacheDir == "" && os.Getenv("BASECAMP_CACHE_DIR") == "" {
@@ -152,6 +160,16 @@ func NewRootCmd() *cobra.Command {
 				}
 			}
 
+			// Read and show dates in the configured timezone. Config
+			// subcommands skip a bad value so it can still be fixed.
+			if err := cfg.ApplyTimezone(); err != nil && !isConfigCmd(cmd) {
+				if bareRoot {
+					initBareRootApp(cfg)
+					return nil
+				}
+				return output.ErrUsage(err.Error())
+			}
+
 			// Note: llm_endpoint is deliberately NOT validated here. It is
 			// consumed only by the dev-gated TUI's summarize path, which
 			// fail-closes via summarize.ValidateEndpoint in
@@ -161,10 +179,21 @@ func NewRootCmd() *cobra.Command {
 			// Resolve behavior preferences: explicit flag > config > version.IsDev()
 			resolvePreferences(cmd, cfg, &flags)
 
+			// User presenter schemas (~/.config/basecamp/schemas/*.json)
+			// extend or override the embedded ones.
+			presenter.SetUserSchemaDir(filepath.Join(config.GlobalConfigDir(), "schemas"))
+			presenter.SetLocale(flags.Locale)
+
+			if flags.ExplainContext {
+				writeContextExplanation(os.Stderr, cfg)
+			}
+
 			// Create app and store in context
 			app := appctx.NewApp(cfg)
 			app.Flags = flags
 			app.ApplyFlags()
+			startCommand(cmd, app)
+			enableListTable(app)
 
 			// Early jq validation: parse + compile before RunE so invalid
 			// expressions are rejected with no side effects.
@@ -184,13 +213,48 @@ func NewRootCmd() *cobra.Command {
 				}
 			}
 
+			// Early template validation, for the same reason as jq.
+			if flags.Template != "" {
+				if _, err := output.ParseTemplate(flags.Template); err != nil {
+					return err
+				}
+				if flags.JQFilter != "" {
+					return output.ErrUsage("cannot use --template with --jq")
+				}
+				if flags.IDsOnly {
+					return output.ErrUsage("cannot use --template with --ids-only")
+				}
+				if flags.Count {
+					return output.ErrUsage("cannot use --template with --count")
+				}
+				if flags.OutputFile != "" {
+					return output.ErrUsage("cannot use --template with --output-file")
+				}
+			}
+
+			// --output-file replaces the data; there are no IDs left to list
+			// or count on stdout.
+			if flags.OutputFile != "" {
+				if flags.IDsOnly {
+					return output.ErrUsage("cannot use --output-file with --ids-only")
+				}
+				if flags.Count {
+					return output.ErrUsage("cannot use --output-file with --count")
+				}
+			}
+
 			cmd.SetContext(appctx.WithApp(cmd.Context(), app))
+
+			// Overlap the project-list fetch behind name resolution with
+			// the rest of the command's startup.
+			warmProjectNames(cmd, app)
 			return nil
 		},
 	}
 
 	cmd.PersistentPostRunE = func(cmd *cobra.Command, args []string) error {
 		app := appctx.FromContext(cmd.Context())
+		finishCommand(app, nil)
 		if app != nil {
 			app.Close()
 		}
@@ -232,13 +296,25 @@ func NewRootCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&flags.Count, "count", false, "Output only count")
 	cmd.PersistentFlags().BoolVar(&flags.Agent, "agent", false, "Agent mode (JSON + quiet)")
 	cmd.PersistentFlags().StringVar(&flags.JQFilter, "jq", "", "Apply jq filter to JSON output (built-in, no external jq required; implies --json)")
+	cmd.PersistentFlags().StringVar(&flags.Template, "template", "", "Format each item of the data with a Go template, e.g. '{{.id}} {{.title}}'")
+	cmd.PersistentFlags().StringVar(&flags.Locale, "locale", "", "Language for labels, dates, and numbers in styled and Markdown output (e.g. de, fr-FR)")
+	cmd.PersistentFlags().StringVar(&flags.Columns, "columns", "", "List columns for styled/Markdown output (comma-separated, e.g. title,due_on,assignees)")
+	cmd.PersistentFlags().BoolVar(&flags.Interactive, "interactive", false, "Browse lists in a filterable table and offer to run a hint after the command (terminal only)")
+	cmd.PersistentFlags().BoolVar(&flags.Redact, "redact", false, "Mask emails, signed URLs, and other fields listed in config redact, for sharing output")
+	cmd.PersistentFlags().BoolVar(&flags.NoBreadcrumbs, "no-breadcrumbs", false, "Omit breadcrumbs from the output envelope (persisted via: basecamp config set no_breadcrumbs true)")
+	cmd.PersistentFlags().BoolVar(&flags.NoContext, "no-context", false, "Omit context from the output envelope (persisted via: basecamp config set no_context true)")
+	cmd.PersistentFlags().BoolVarP(&flags.Yes, "yes", "y", false, "Skip confirmation prompts for trash, delete, and bulk operations (see: basecamp config set confirm)")
+	cmd.PersistentFlags().StringVar(&flags.OutputFile, "output-file", "", "Write the result data to a file (JSON array, or JSONL for .jsonl) instead of stdout and print only the summary; pair with --all")
+	cmd.PersistentFlags().BoolVar(&flags.QueueOnFailure, "queue-on-failure", false, "If the network fails before any change is sent, save the command to retry with: basecamp outbox flush")
+	cmd.PersistentFlags().BoolVar(&flags.Strict, "strict", false, "Fail when an API response has fields the CLI didn't parse, or lacks fields it expects")
 
 	// Context flags
-	cmd.PersistentFlags().StringVarP(&flags.Project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&flags.Project, "in", "", "Project ID or name (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&flags.Project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&flags.Project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVarP(&flags.Account, "account", "a", "", "Account ID")
 	cmd.PersistentFlags().StringVar(&flags.Todolist, "todolist", "", "Todolist ID or name")
 	cmd.PersistentFlags().StringVarP(&flags.Profile, "profile", "P", "", "Named profile")
+	cmd.PersistentFlags().BoolVar(&flags.ExplainContext, "explain-context", false, "Show which flag, env var, profile, or config file set the account and project")
 
 	// Behavior flags
 	cmd.PersistentFlags().CountVarP(&flags.Verbose, "verbose", "v", "Verbose output (-v for ops, -vv for requests)")
@@ -249,6 +325,7 @@ func NewRootCmd() *cobra.Command {
 	cmd.PersistentFlags().BoolVar(&flags.NoHints, "no-hints", false, "Disable follow-up hints")
 	cmd.MarkFlagsMutuallyExclusive("hints", "no-hints")
 	cmd.PersistentFlags().StringVar(&flags.CacheDir, "cache-dir", "", "Cache directory")
+	cmd.PersistentFlags().StringVar(&flags.Timezone, "tz", "", "Timezone for reading and showing dates, e.g. Europe/Berlin (persisted via: basecamp config set timezone <zone>)")
 
 	// Register tab completion for flags.
 	// DefaultCacheDirFunc checks --cache-dir flag, then app context, then env vars.
@@ -289,6 +366,8 @@ func Execute() {
 	cmd.AddCommand(commands.NewPeopleCmd())
 	cmd.AddCommand(commands.NewQuickStartCmd())
 	cmd.AddCommand(commands.NewAPICmd())
+	cmd.AddCommand(commands.NewRunCmd())
+	cmd.AddCommand(commands.NewAccessCmd())
 	cmd.AddCommand(commands.NewShowCmd())
 	cmd.AddCommand(commands.NewTodolistsCmd())
 	cmd.AddCommand(commands.NewCommentsCmd())
@@ -309,6 +388,7 @@ func Execute() {
 	cmd.AddCommand(commands.NewWebhooksCmd())
 	cmd.AddCommand(commands.NewEventsCmd())
 	cmd.AddCommand(commands.NewSubscriptionsCmd())
+	cmd.AddCommand(commands.NewVisibilityCmd())
 	cmd.AddCommand(commands.NewForwardsCmd())
 	cmd.AddCommand(commands.NewMessageboardsCmd())
 	cmd.AddCommand(commands.NewMessagetypesCmd())
@@ -323,6 +403,7 @@ func Execute() {
 	cmd.AddCommand(commands.NewTodolistgroupsCmd())
 	cmd.AddCommand(commands.NewCommandsCmd())
 	cmd.AddCommand(commands.NewVersionCmd())
+	cmd.AddCommand(commands.NewSchemaCmd())
 	cmd.AddCommand(commands.NewTimelineCmd())
 	cmd.AddCommand(commands.NewReportsCmd())
 	cmd.AddCommand(commands.NewCompletionCmd())
@@ -330,6 +411,8 @@ func Execute() {
 	cmd.AddCommand(commands.NewLoginCmd())
 	cmd.AddCommand(commands.NewLogoutCmd())
 	cmd.AddCommand(commands.NewDoctorCmd())
+	cmd.AddCommand(commands.NewVerifyCmd())
+	cmd.AddCommand(commands.NewStatsCmd())
 	cmd.AddCommand(commands.NewUpgradeCmd())
 	cmd.AddCommand(commands.NewMigrateCmd())
 	cmd.AddCommand(commands.NewProfileCmd())
@@ -343,9 +426,21 @@ func Execute() {
 	cmd.AddCommand(commands.NewTUICmd())
 	cmd.AddCommand(commands.NewBonfireCmd())
 	cmd.AddCommand(commands.NewAgentHookCmd())
+	cmd.AddCommand(commands.NewPluginsCmd())
+	cmd.AddCommand(commands.NewOutboxCmd())
+
+	args := rewriteFlagAliases(cmd, os.Args[1:], os.Stderr)
+	// "basecamp foo" runs basecamp-foo from PATH when foo isn't built in.
+	if plugin := commands.NewPluginCmd(cmd, args); plugin != nil {
+		cmd.AddCommand(plugin)
+	}
+	cmd.SetArgs(args)
 
 	// Use ExecuteC to get the executed command (for correct context access)
-	executedCmd, err := cmd.ExecuteC()
+	started := time.Now()
+	ctx, stop := interruptContext()
+	defer stop()
+	executedCmd, err := cmd.ExecuteContextC(ctx)
 
 	// Bare group command with explicit flags (e.g. "cards --in X"): the help
 	// function suppressed output. Convert to a usage error.
@@ -356,7 +451,28 @@ func Execute() {
 		)
 	}
 
+	recordUsage(executedCmd, time.Since(started), err)
+
+	if err == nil {
+		if code, ran := runInteractiveBreadcrumbs(cmd, executedCmd); ran {
+			os.Exit(code)
+		}
+	}
+
 	if err != nil {
+		// A plugin that failed has said why; pass its status through.
+		var pluginExit *commands.PluginExitError
+		if errors.As(err, &pluginExit) {
+			os.Exit(pluginExit.Code)
+		}
+
+		// A command stopped by Ctrl-C fails with whatever the cancellation
+		// surfaced as; report the interrupt itself. Other failures that
+		// happened to land after Ctrl-C keep their own error.
+		if ctx.Err() != nil && errors.Is(err, context.Canceled) {
+			err = output.ErrInterrupted()
+		}
+
 		// When a command receives zero args but requires some, show help instead of an error —
 		// but only for interactive human users. Machine consumers (--agent, --json, piped stdout)
 		// need the structured error to flow through transformCobraError.
@@ -370,6 +486,12 @@ func Execute() {
 		// Transform Cobra errors to match Bash CLI error format
 		err = transformCobraError(err)
 
+		// --queue-on-failure: save a command the network stopped for retry.
+		err = queueFailedCommand(executedCmd, args, err)
+
+		// PersistentPostRunE is skipped when a command fails.
+		finishCommand(appctx.FromContext(executedCmd.Context()), err)
+
 		// Convert error to structured output
 		apiErr := output.AsError(err)
 
@@ -380,7 +502,7 @@ func Execute() {
 		if !disableJQ {
 			if app := appctx.FromContext(executedCmd.Context()); app != nil {
 				if writeErr := app.Err(err); writeErr == nil {
-					os.Exit(apiErr.ExitCode())
+					os.Exit(output.ExitCodeFor(apiErr.Code))
 				}
 				// app.Err() write failed (e.g. jq runtime error on the error
 				// envelope, or broken pipe). Disable jq in the fallback writer
@@ -401,6 +523,12 @@ func Execute() {
 		jsonFlag, _ := pf.GetBool("json")
 		jqFilter, _ := pf.GetString("jq")
 		hadJQ := jqFilter != ""
+		tmpl, _ := pf.GetString("template")
+		var redact *output.Redactor
+		if on, _ := pf.GetBool("redact"); on {
+			// Config may be what failed to load; mask the defaults.
+			redact = output.NewRedactor(config.DefaultRedact)
+		}
 
 		// Strip jq filter when disabled (jq-about-jq errors OR app.Err() write failure).
 		// hadJQ preserves the "--jq implies --json" format decision even after zeroing.
@@ -418,7 +546,7 @@ func Execute() {
 			format = output.FormatStyled
 		} else if md {
 			format = output.FormatMarkdown
-		} else if jsonFlag || hadJQ {
+		} else if jsonFlag || hadJQ || tmpl != "" {
 			format = output.FormatJSON
 		}
 
@@ -426,10 +554,11 @@ func Execute() {
 			Format:   format,
 			Writer:   os.Stdout,
 			JQFilter: jqFilter,
+			Redact:   redact,
 		})
 		_ = writer.Err(err)
 
-		os.Exit(apiErr.ExitCode())
+		os.Exit(output.ExitCodeFor(apiErr.Code))
 	}
 }
 
@@ -513,7 +642,7 @@ func isInteractiveTTY(flags appctx.GlobalFlags) bool {
 	}
 
 	// Not interactive if any machine-output mode is set (--jq implies --json)
-	if flags.Agent || flags.JSON || flags.Quiet || flags.IDsOnly || flags.Count || flags.JQFilter != "" {
+	if flags.Agent || flags.JSON || flags.Quiet || flags.IDsOnly || flags.Count || flags.JQFilter != "" || flags.Template != "" {
 		return false
 	}
 
@@ -596,8 +725,10 @@ func isMachineConsumer(root *cobra.Command) bool {
 			return true
 		}
 	}
-	if jq, _ := pf.GetString("jq"); jq != "" {
-		return true
+	for _, flag := range []string{"jq", "template"} {
+		if v, _ := pf.GetString(flag); v != "" {
+			return true
+		}
 	}
 	fi, err := os.Stdout.Stat()
 	if err == nil && (fi.Mode()&os.ModeCharDevice) == 0 {
@@ -701,6 +832,18 @@ func resolvePreferences(cmd *cobra.Command, cfg *config.Config, flags *appctx.Gl
 	if !pf.Changed("verbose") && cfg.Verbose != nil {
 		flags.Verbose = *cfg.Verbose
 	}
+
+	if !pf.Changed("interactive") && cfg.Interactive != nil {
+		flags.Interactive = *cfg.Interactive
+	}
+
+	if !pf.Changed("no-breadcrumbs") && cfg.NoBreadcrumbs != nil {
+		flags.NoBreadcrumbs = *cfg.NoBreadcrumbs
+	}
+
+	if !pf.Changed("no-context") && cfg.NoContext != nil {
+		flags.NoContext = *cfg.NoContext
+	}
 }
 
 // agentHelpInfo is the structured help output for --help --agent.
@@ -818,3 +961,24 @@ func emitAgentHelp(cmd *cobra.Command) {
 
 	_ = json.NewEncoder(cmd.OutOrStdout()).Encode(info)
 }
+
+// warmProjectNames starts fetching the project list in the background when
+// the invocation targets a project by name and can already reach the API,
+// so ResolveProject finds the names cached instead of adding a round trip.
+func warmProjectNames(cmd *cobra.Command, app *appctx.App) {
+	project := app.Config.ProjectID
+	// Commands that declare their own --project/--in shadow the root flag.
+	if f := cmd.Flags().Lookup("project"); f != nil && f.Value.String() != "" {
+		project = f.Value.String()
+	}
+	if project == "" || app.Config.AccountID == "" {
+		return
+	}
+	if _, err := strconv.ParseInt(project, 10, 64); err == nil {
+		return
+	}
+	if !app.Auth.IsAuthenticated() {
+		return
+	}
+	app.Names.WarmProjects(cmd.Context())
+}
@@ -156,6 +156,30 @@ func TestResolvePreferences(t *testing.T) {
 	}
 }
 
+func TestResolvePreferencesEnvelopeSections(t *testing.T) {
+	on := true
+	cfg := &config.Config{NoBreadcrumbs: &on, NoContext: &on}
+
+	cmd := &cobra.Command{}
+	var noBreadcrumbs, noContext bool
+	cmd.PersistentFlags().BoolVar(&noBreadcrumbs, "no-breadcrumbs", false, "")
+	cmd.PersistentFlags().BoolVar(&noContext, "no-context", false, "")
+	flags := &appctx.GlobalFlags{}
+	resolvePreferences(cmd, cfg, flags)
+	assert.True(t, flags.NoBreadcrumbs, "config default applies")
+	assert.True(t, flags.NoContext, "config default applies")
+
+	// An explicit flag wins over config
+	cmd = &cobra.Command{}
+	cmd.PersistentFlags().BoolVar(&noBreadcrumbs, "no-breadcrumbs", false, "")
+	cmd.PersistentFlags().BoolVar(&noContext, "no-context", false, "")
+	require.NoError(t, cmd.PersistentFlags().Set("no-breadcrumbs", "false"))
+	flags = &appctx.GlobalFlags{}
+	resolvePreferences(cmd, cfg, flags)
+	assert.False(t, flags.NoBreadcrumbs, "--no-breadcrumbs=false overrides config")
+	assert.True(t, flags.NoContext)
+}
+
 // isolateRootTest sets env vars for hermetic root tests.
 func isolateRootTest(t *testing.T) {
 	t.Helper()
@@ -285,3 +309,31 @@ func TestVersionWithJQReturnsUsageError(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "--jq is not supported by the version command")
 }
+
+func TestTemplateInvalidRejectedBeforeRunE(t *testing.T) {
+	isolateRootTest(t)
+
+	root := NewRootCmd()
+	root.AddCommand(commands.NewConfigCmd())
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs([]string{"config", "show", "--template", "{{.id"})
+
+	err := root.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --template")
+}
+
+func TestTemplateWithJQConflict(t *testing.T) {
+	isolateRootTest(t)
+
+	root := NewRootCmd()
+	root.AddCommand(commands.NewConfigCmd())
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+	root.SetArgs([]string{"config", "show", "--template", "{{.id}}", "--jq", ".data"})
+
+	err := root.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot use --template with --jq")
+}
@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/usage"
+)
+
+// recordUsage folds one invocation into the local usage stats when the user
+// has opted in via usage_stats. Failures are silent: stats must never affect
+// the command's own outcome.
+func recordUsage(cmd *cobra.Command, elapsed time.Duration, err error) {
+	if cmd == nil || !cmd.HasParent() {
+		return
+	}
+	name := cmd.Name()
+	if name == "help" || strings.HasPrefix(name, "__") {
+		return
+	}
+
+	app := appctx.FromContext(cmd.Context())
+	if app == nil || app.Config == nil || app.Config.UsageStats == nil || !*app.Config.UsageStats {
+		return
+	}
+
+	ev := usage.Event{
+		Command:  strings.TrimPrefix(cmd.CommandPath(), cmd.Root().Name()+" "),
+		Duration: elapsed,
+	}
+	if err != nil {
+		ev.ErrorCode = output.AsError(transformCobraError(err)).Code
+		if ev.ErrorCode == "" {
+			ev.ErrorCode = "error"
+		}
+	}
+
+	_ = usage.NewStore(filepath.Join(app.Config.CacheDir, usage.DirName)).Record(ev)
+}
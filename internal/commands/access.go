@@ -0,0 +1,227 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// Probe outcomes for a dock tool.
+const (
+	accessOK        = "ok"
+	accessForbidden = "forbidden"
+	accessNotFound  = "not_found"
+	accessDisabled  = "disabled"
+	accessError     = "error"
+)
+
+// readOnlyDockTools are dock tools whose contents can't be created through
+// the API even when they can be read.
+var readOnlyDockTools = map[string]bool{
+	"inbox": true, // Email forwards arrive by email only
+}
+
+// accessTool is the probed access to one dock tool.
+type accessTool struct {
+	Tool   string `json:"tool"`
+	Title  string `json:"title"`
+	ID     int64  `json:"id"`
+	Status string `json:"status"`
+	Read   bool   `json:"read"`
+	Write  bool   `json:"write"`
+	Note   string `json:"note,omitempty"`
+}
+
+// accessReport is what the current token can do in a project.
+type accessReport struct {
+	ProjectID    int64           `json:"project_id"`
+	Project      string          `json:"project"`
+	PersonID     int64           `json:"person_id"`
+	Person       string          `json:"person"`
+	Role         string          `json:"role"`
+	Tools        []accessTool    `json:"tools"`
+	Capabilities map[string]bool `json:"capabilities"`
+}
+
+// NewAccessCmd creates the access command group.
+func NewAccessCmd() *cobra.Command {
+	var project string
+
+	cmd := &cobra.Command{
+		Use:   "access",
+		Short: "Check what you can do in a project",
+		Long: `Check what the current token can do in a project.
+
+Scripts can run 'basecamp access check' up front and skip the tools they
+can't use instead of failing mid-run with 403s.`,
+		Annotations: map[string]string{
+			"agent_notes": "access check probes each dock tool with a read-only GET. Write access is inferred from read access and your role; nothing is ever created or changed.",
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
+
+	cmd.AddCommand(newAccessCheckCmd(&project))
+
+	return cmd
+}
+
+func newAccessCheckCmd(project *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "check",
+		Short: "Report read/write access per tool in a project",
+		Long: `Report what the current token can do in a project, tool by tool.
+
+Each enabled dock tool is probed with a GET, which never changes anything:
+a success means you can read it, a 403 means you can't. Write access can't
+be probed safely, so it is inferred: project members can write to every
+tool they can read, except email forwards. Project-wide capabilities
+(managing the project or its people, hill charts, timesheets) come from
+your person record.
+
+  basecamp access check --in MyProject
+  basecamp access check --in MyProject --jq '.data.tools[] | select(.write) | .tool'`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runAccessCheck(cmd, *project)
+		},
+	}
+}
+
+func runAccessCheck(cmd *cobra.Command, project string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return err
+	}
+	projectID, err := strconv.ParseInt(resolvedProjectID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+
+	me, err := app.Account().People().Me(cmd.Context())
+	if err != nil {
+		return convertSDKError(err)
+	}
+	proj, err := app.Account().Projects().Get(cmd.Context(), projectID)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	report := accessReport{
+		ProjectID:    proj.ID,
+		Project:      proj.Name,
+		PersonID:     me.ID,
+		Person:       me.Name,
+		Role:         personRole(me),
+		Tools:        make([]accessTool, 0, len(proj.Dock)),
+		Capabilities: personCapabilities(me),
+	}
+
+	readable, writable, probed := 0, 0, 0
+	for _, item := range proj.Dock {
+		tool := probeDockTool(cmd, app, item)
+		if tool.Status != accessDisabled {
+			probed++
+		}
+		if tool.Read {
+			readable++
+		}
+		if tool.Write {
+			writable++
+		}
+		report.Tools = append(report.Tools, tool)
+	}
+
+	summary := fmt.Sprintf("%s in %s: read %d of %d %s, write %d",
+		report.Role, proj.Name, readable, probed, pluralize(probed, "tool", "tools"), writable)
+
+	return app.OK(report,
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "project",
+				Cmd:         fmt.Sprintf("basecamp projects show %d", proj.ID),
+				Description: "View project",
+			},
+			output.Breadcrumb{
+				Action:      "tools",
+				Cmd:         fmt.Sprintf("basecamp tools show <id> --in %d", proj.ID),
+				Description: "Show a dock tool",
+			},
+		),
+	)
+}
+
+// probeDockTool checks read access to a dock tool with a GET of its URL.
+// Disabled tools aren't probed.
+func probeDockTool(cmd *cobra.Command, app *appctx.App, item basecamp.DockItem) accessTool {
+	tool := accessTool{Tool: item.Name, Title: item.Title, ID: item.ID}
+
+	if !item.Enabled {
+		tool.Status = accessDisabled
+		return tool
+	}
+	if item.URL == "" {
+		tool.Status = accessError
+		tool.Note = "no API URL in the project dock"
+		return tool
+	}
+
+	_, err := app.Account().Get(cmd.Context(), item.URL)
+	var sdkErr *basecamp.Error
+	switch {
+	case err == nil:
+		tool.Status = accessOK
+		tool.Read = true
+		tool.Write = !readOnlyDockTools[item.Name]
+		if !tool.Write {
+			tool.Note = "read-only through the API"
+		}
+	case errors.As(err, &sdkErr) && sdkErr.Code == basecamp.CodeForbidden:
+		tool.Status = accessForbidden
+	case errors.As(err, &sdkErr) && sdkErr.Code == basecamp.CodeNotFound:
+		tool.Status = accessNotFound
+	default:
+		tool.Status = accessError
+		tool.Note = err.Error()
+	}
+	return tool
+}
+
+// personRole names the account role of a person, most privileged first.
+func personRole(p *basecamp.Person) string {
+	switch {
+	case p.Owner:
+		return "owner"
+	case p.Admin:
+		return "admin"
+	case p.Client:
+		return "client"
+	default:
+		return "member"
+	}
+}
+
+// personCapabilities lists the project-wide permissions on a person record.
+func personCapabilities(p *basecamp.Person) map[string]bool {
+	return map[string]bool{
+		"manage_project": p.Owner || p.Admin || p.CanManageProjects,
+		"manage_people":  p.Owner || p.Admin || p.CanManagePeople,
+		"hill_charts":    p.CanAccessHillCharts,
+		"timesheet":      p.CanAccessTimesheet,
+		"ping":           p.CanPing,
+	}
+}
@@ -0,0 +1,83 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// accessTransport serves a project whose dock has a readable message board,
+// a forbidden schedule, readable email forwards, and a disabled chat.
+type accessTransport struct {
+	methods []string
+}
+
+func (t *accessTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.methods = append(t.methods, req.Method)
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	status, body := http.StatusOK, `{}`
+	switch path := req.URL.Path; {
+	case strings.HasSuffix(path, "/my/profile.json"):
+		body = `{"id": 7, "name": "Ada", "client": false, "can_manage_projects": true}`
+	case strings.HasSuffix(path, "/projects.json"):
+		body = `[{"id": 123, "name": "Launch"}]`
+	case strings.HasSuffix(path, "/projects/123"):
+		body = `{"id": 123, "name": "Launch", "dock": [` +
+			`{"id": 1, "name": "message_board", "title": "Message Board", "enabled": true, "url": "https://3.basecampapi.com/99999/buckets/123/message_boards/1.json"},` +
+			`{"id": 2, "name": "schedule", "title": "Schedule", "enabled": true, "url": "https://3.basecampapi.com/99999/buckets/123/schedules/2.json"},` +
+			`{"id": 3, "name": "inbox", "title": "Email Forwards", "enabled": true, "url": "https://3.basecampapi.com/99999/buckets/123/inboxes/3.json"},` +
+			`{"id": 4, "name": "chat", "title": "Chat", "enabled": false, "url": "https://3.basecampapi.com/99999/buckets/123/chats/4.json"}]}`
+	case strings.HasSuffix(path, "/schedules/2.json"):
+		status, body = http.StatusForbidden, `{"error": "forbidden"}`
+	case strings.HasSuffix(path, "/message_boards/1.json"), strings.HasSuffix(path, "/inboxes/3.json"):
+		body = `{"id": 1}`
+	default:
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, path)
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestAccessCheckReportsPerToolAccess(t *testing.T) {
+	transport := &accessTransport{}
+	app, buf := setupCommentsWriteTestApp(t, transport)
+
+	cmd := NewAccessCmd()
+	require.NoError(t, executeCommand(cmd, app, "check", "--in", "123"))
+
+	var resp struct {
+		Summary string       `json:"summary"`
+		Data    accessReport `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+
+	assert.Equal(t, "member", resp.Data.Role)
+	assert.True(t, resp.Data.Capabilities["manage_project"])
+	assert.False(t, resp.Data.Capabilities["manage_people"])
+
+	byTool := make(map[string]accessTool)
+	for _, tool := range resp.Data.Tools {
+		byTool[tool.Tool] = tool
+	}
+	assert.Equal(t, accessTool{Tool: "message_board", Title: "Message Board", ID: 1, Status: accessOK, Read: true, Write: true}, byTool["message_board"])
+	assert.Equal(t, accessForbidden, byTool["schedule"].Status)
+	assert.False(t, byTool["schedule"].Read)
+	assert.False(t, byTool["schedule"].Write)
+	assert.True(t, byTool["inbox"].Read)
+	assert.False(t, byTool["inbox"].Write, "email forwards can't be created through the API")
+	assert.Equal(t, accessDisabled, byTool["chat"].Status)
+
+	assert.Equal(t, "member in Launch: read 2 of 3 tools, write 1", resp.Summary)
+
+	for _, method := range transport.methods {
+		assert.Equal(t, http.MethodGet, method, "probing must never write")
+	}
+}
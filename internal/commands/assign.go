@@ -51,8 +51,8 @@ func NewAssignCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&assignee, "to", "", "Person to assign (ID, email, or 'me'); prompts interactively if omitted")
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.Flags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.Flags().BoolVar(&isCard, "card", false, "Assign to a card instead of a to-do")
 	cmd.Flags().BoolVar(&isStep, "step", false, "Assign to a card step instead of a to-do")
 
@@ -100,8 +100,8 @@ func NewUnassignCmd() *cobra.Command {
 	}
 
 	cmd.Flags().StringVar(&assignee, "from", "", "Person to remove (ID, email, or 'me'); prompts interactively if omitted")
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.Flags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.Flags().BoolVar(&isCard, "card", false, "Unassign from a card instead of a to-do")
 	cmd.Flags().BoolVar(&isStep, "step", false, "Unassign from a card step instead of a to-do")
 
@@ -462,29 +462,6 @@ func resolveAssignee(cmd *cobra.Command, app *appctx.App, assignee *string, reso
 	return assigneeID, assigneeIDInt, nil
 }
 
-// resolveProjectID resolves the project ID from flags, config, or interactive prompt.
-func resolveProjectID(cmd *cobra.Command, app *appctx.App, project string) (string, error) {
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return "", err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
-	if err != nil {
-		return "", err
-	}
-	return resolvedProjectID, nil
-}
-
 // validateTodo fetches a to-do to verify it exists before showing the person picker.
 func validateTodo(cmd *cobra.Command, app *appctx.App, todoIDStr string) (*basecamp.Todo, error) {
 	todoID, err := strconv.ParseInt(todoIDStr, 10, 64)
@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// assigneeChange describes how an update will change a recording's
+// assignees, by name.
+type assigneeChange struct {
+	Before   []string
+	After    []string
+	Added    []string
+	Removed  []string
+	Notified []string
+}
+
+// previewAssigneeChange writes who an update will add, remove, and notify to
+// stderr before the write happens, so a mistyped --assignee doesn't silently
+// reassign someone's work. It only speaks up when the assignees actually
+// change, and only for styled output or --verbose; machine output stays clean.
+// notifyAdded reports whether the new assignees will be notified.
+func previewAssigneeChange(cmd *cobra.Command, app *appctx.App, currentIDs, newIDs []int64, notifyAdded bool) {
+	if app.Output.EffectiveFormat() != output.FormatStyled && app.Flags.Verbose == 0 {
+		return
+	}
+	change, ok := diffAssignees(cmd.Context(), app, currentIDs, newIDs, notifyAdded)
+	if !ok {
+		return
+	}
+	renderAssigneeChange(cmd.ErrOrStderr(), change)
+}
+
+// diffAssignees compares assignee IDs and names the people involved. It
+// reports false when the set of assignees is unchanged.
+func diffAssignees(ctx context.Context, app *appctx.App, currentIDs, newIDs []int64, notifyAdded bool) (assigneeChange, bool) {
+	var added, removed []int64
+	for _, id := range newIDs {
+		if !slices.Contains(currentIDs, id) && !slices.Contains(added, id) {
+			added = append(added, id)
+		}
+	}
+	for _, id := range currentIDs {
+		if !slices.Contains(newIDs, id) && !slices.Contains(removed, id) {
+			removed = append(removed, id)
+		}
+	}
+	if len(added) == 0 && len(removed) == 0 {
+		return assigneeChange{}, false
+	}
+
+	// Names are best effort: an unknown person still shows up by ID.
+	names := make(map[int64]string)
+	if people, err := app.Names.GetPeople(ctx); err == nil {
+		for _, p := range people {
+			names[p.ID] = p.Name
+		}
+	}
+	name := func(ids []int64) []string {
+		out := make([]string, 0, len(ids))
+		for _, id := range ids {
+			if n := names[id]; n != "" {
+				out = append(out, n)
+			} else {
+				out = append(out, fmt.Sprintf("#%d", id))
+			}
+		}
+		return out
+	}
+
+	change := assigneeChange{
+		Before:  name(currentIDs),
+		After:   name(newIDs),
+		Added:   name(added),
+		Removed: name(removed),
+	}
+	if notifyAdded {
+		change.Notified = change.Added
+	}
+	return change, true
+}
+
+func renderAssigneeChange(w io.Writer, c assigneeChange) {
+	list := func(names []string) string {
+		if len(names) == 0 {
+			return "(none)"
+		}
+		return strings.Join(names, ", ")
+	}
+
+	fmt.Fprintf(w, "Assignees: %s → %s\n", list(c.Before), list(c.After))
+	for _, n := range c.Added {
+		fmt.Fprintf(w, "  + %s\n", n)
+	}
+	for _, n := range c.Removed {
+		fmt.Fprintf(w, "  - %s\n", n)
+	}
+	if len(c.Notified) == 0 {
+		fmt.Fprintln(w, "No one will be notified")
+	} else {
+		fmt.Fprintf(w, "Notified: %s\n", list(c.Notified))
+	}
+}
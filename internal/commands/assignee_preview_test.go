@@ -0,0 +1,76 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+)
+
+func TestRenderAssigneeChange(t *testing.T) {
+	var buf bytes.Buffer
+	renderAssigneeChange(&buf, assigneeChange{
+		Before:   []string{"Ada", "Grace"},
+		After:    []string{"Grace", "Linus"},
+		Added:    []string{"Linus"},
+		Removed:  []string{"Ada"},
+		Notified: []string{"Linus"},
+	})
+	assert.Equal(t, "Assignees: Ada, Grace → Grace, Linus\n  + Linus\n  - Ada\nNotified: Linus\n", buf.String())
+
+	buf.Reset()
+	renderAssigneeChange(&buf, assigneeChange{Before: []string{"Ada"}, Removed: []string{"Ada"}})
+	assert.Equal(t, "Assignees: Ada → (none)\n  - Ada\nNo one will be notified\n", buf.String())
+}
+
+func executeTodosUpdateCapturingStderr(t *testing.T, app *appctx.App, args ...string) string {
+	t.Helper()
+	cmd := NewTodosCmd()
+	var stderr bytes.Buffer
+	cmd.SetArgs(append([]string{"update"}, args...))
+	cmd.SetContext(appctx.WithApp(context.Background(), app))
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&stderr)
+	require.NoError(t, cmd.Execute())
+	return stderr.String()
+}
+
+func TestTodosUpdateVerbosePreviewsAssigneeChange(t *testing.T) {
+	transport := &mockTodoUpdateTransport{}
+	app := setupTodoUpdateApp(t, transport)
+	app.Flags.Verbose = 1
+
+	stderr := executeTodosUpdateCapturingStderr(t, app, "999", "--assignee", "43", "--notify")
+	assert.Contains(t, stderr, "Assignees: #42 → #43\n  + #43\n  - #42\nNotified: #43\n")
+}
+
+func TestTodosUpdateAssigneePreviewWithoutNotify(t *testing.T) {
+	transport := &mockTodoUpdateTransport{}
+	app := setupTodoUpdateApp(t, transport)
+	app.Flags.Verbose = 1
+
+	stderr := executeTodosUpdateCapturingStderr(t, app, "999", "--assignee", "42,43")
+	assert.Contains(t, stderr, "  + #43\nNo one will be notified\n")
+	assert.NotContains(t, stderr, "  - ")
+}
+
+func TestTodosUpdateAssigneePreviewSkippedForMachineOutput(t *testing.T) {
+	transport := &mockTodoUpdateTransport{}
+	app := setupTodoUpdateApp(t, transport)
+
+	stderr := executeTodosUpdateCapturingStderr(t, app, "999", "--assignee", "43")
+	assert.NotContains(t, stderr, "Assignees:")
+}
+
+func TestTodosUpdateUnchangedAssigneesSkipPreview(t *testing.T) {
+	transport := &mockTodoUpdateTransport{}
+	app := setupTodoUpdateApp(t, transport)
+	app.Flags.Verbose = 1
+
+	stderr := executeTodosUpdateCapturingStderr(t, app, "999", "--assignee", "42")
+	assert.NotContains(t, stderr, "Assignees:")
+}
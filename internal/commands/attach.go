@@ -3,7 +3,6 @@ package commands
 import (
 	"fmt"
 	"html"
-	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -95,15 +94,9 @@ func uploadAttachments(cmd *cobra.Command, app *appctx.App, paths []string) ([]r
 		contentType := richtext.DetectMIME(normalized)
 		filename := filepath.Base(normalized)
 
-		f, err := os.Open(normalized)
+		resp, err := uploadAttachmentFile(cmd.Context(), app, normalized, filename, contentType)
 		if err != nil {
-			return nil, fmt.Errorf("%s: %w", path, err)
-		}
-
-		resp, err := app.Account().Attachments().Create(cmd.Context(), filename, contentType, f)
-		f.Close()
-		if err != nil {
-			return nil, convertSDKError(err)
+			return nil, err
 		}
 
 		refs = append(refs, richtext.AttachmentRef{
@@ -121,11 +114,19 @@ func uploadAttachments(cmd *cobra.Command, app *appctx.App, paths []string) ([]r
 //
 // Behavior per src type:
 //   - Remote URLs (http://, https://): skip
-//   - Non-file URIs (data:, cid:, blob:, etc.): skip
+//   - Non-file URIs (cid:, blob:, etc.): skip
 //   - Local path exists: upload, replace <img> with <bc-attachment>
 //   - Local path missing: error
 //   - Placeholder (? or empty): error
+//
+// Every rich text body passes through here on its way out, so it also runs
+// guardContent: data: images are dropped and oversized bodies refused.
 func resolveLocalImages(cmd *cobra.Command, app *appctx.App, htmlStr string) (string, error) {
+	htmlStr, err := guardContent(cmd, htmlStr)
+	if err != nil {
+		return "", err
+	}
+
 	// Quick bail: no images
 	if !hasImgTag(htmlStr) {
 		return htmlStr, nil
@@ -171,15 +172,9 @@ func resolveLocalImages(cmd *cobra.Command, app *appctx.App, htmlStr string) (st
 		contentType := richtext.DetectMIME(src)
 		filename := filepath.Base(src)
 
-		f, err := os.Open(src)
-		if err != nil {
-			return "", fmt.Errorf("%s: %w", src, err)
-		}
-
-		resp, err := app.Account().Attachments().Create(cmd.Context(), filename, contentType, f)
-		f.Close()
+		resp, err := uploadAttachmentFile(cmd.Context(), app, src, filename, contentType)
 		if err != nil {
-			return "", convertSDKError(err)
+			return "", err
 		}
 
 		// Replace <img> with <bc-attachment>
@@ -0,0 +1,223 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+const (
+	// uploadMaxAttempts is the total number of tries for one attachment.
+	uploadMaxAttempts = 4
+
+	// uploadProgressMinSize is the smallest file that gets a progress line.
+	uploadProgressMinSize = 1 << 20
+)
+
+// Upload tuning seams, overridden in tests.
+var (
+	uploadRetryDelay = func(attempt int) time.Duration {
+		return time.Duration(1<<(attempt-1)) * 2 * time.Second // 2s, 4s, 8s
+	}
+	uploadStallTimeout = 2 * time.Minute
+)
+
+// errUploadStalled cancels an attempt that has started sending and then sent
+// no bytes for uploadStallTimeout.
+var errUploadStalled = errors.New("upload stalled")
+
+// uploadAttachmentFile uploads the file at path as an attachment.
+//
+// Attachments are a single-request upload with no resumable protocol, so a
+// failed transfer restarts from the first byte. Each attempt reopens the file
+// from disk, reports progress on stderr for interactive sessions, and is
+// cancelled if the transport stops writing the body to the connection.
+// Transient failures (network errors, stalls, 429 and 5xx responses) are
+// retried with backoff.
+func uploadAttachmentFile(ctx context.Context, app *appctx.App, path, filename, contentType string) (*basecamp.AttachmentResponse, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	var progress io.Writer
+	if info.Size() >= uploadProgressMinSize && app.IsInteractive() && !app.IsMachineOutput() {
+		progress = os.Stderr
+	}
+
+	var lastErr error
+	for attempt := 1; attempt <= uploadMaxAttempts; attempt++ {
+		if attempt > 1 {
+			delay := uploadRetryDelay(attempt - 1)
+			if progress != nil {
+				fmt.Fprintf(progress, "Upload of %s failed (%s); retrying in %s (attempt %d/%d)\n",
+					filename, output.AsError(lastErr).Message, delay, attempt, uploadMaxAttempts)
+			}
+			select {
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			case <-time.After(delay):
+			}
+		}
+
+		resp, err := uploadAttachmentAttempt(ctx, app, path, filename, contentType, info.Size(), progress)
+		if err == nil {
+			return resp, nil
+		}
+		lastErr = err
+		if ctx.Err() != nil || !isTransientUploadError(err) {
+			break
+		}
+	}
+
+	return nil, convertSDKError(lastErr)
+}
+
+func uploadAttachmentAttempt(ctx context.Context, app *appctx.App, path, filename, contentType string, size int64, progress io.Writer) (*basecamp.AttachmentResponse, error) {
+	f, err := os.Open(path) //nolint:gosec // G304: path is user-supplied upload source
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	defer f.Close()
+
+	attemptCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	meter := newUploadMeter(filename, size, progress)
+	stopWatch := meter.watch(uploadStallTimeout, func() { cancel(errUploadStalled) })
+	defer stopWatch()
+
+	resp, err := app.UploadAccount().Attachments().Create(
+		appctx.WithUploadProgress(attemptCtx, meter.update), filename, contentType, f)
+	meter.finish(err == nil)
+
+	if err != nil && errors.Is(context.Cause(attemptCtx), errUploadStalled) {
+		return nil, fmt.Errorf("%s: %w (no progress for %s)", filename, errUploadStalled, uploadStallTimeout)
+	}
+	return resp, err
+}
+
+// isTransientUploadError reports whether an upload failure is worth retrying.
+func isTransientUploadError(err error) bool {
+	if errors.Is(err, errUploadStalled) || errors.Is(err, basecamp.ErrRateLimited) {
+		return true
+	}
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+	var sdkErr *basecamp.Error
+	if errors.As(err, &sdkErr) {
+		return sdkErr.Retryable
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return true
+	}
+	return errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// uploadMeter tracks bytes sent for one attempt: it renders a progress line
+// and records the last time the transfer moved, for stall detection.
+type uploadMeter struct {
+	name    string
+	size    int64
+	w       io.Writer
+	mu      sync.Mutex
+	last    time.Time
+	shown   int  // last rendered percentage, -1 before the first render
+	started bool // the transport has begun sending the request
+	done    bool
+}
+
+func newUploadMeter(name string, size int64, w io.Writer) *uploadMeter {
+	return &uploadMeter{name: name, size: size, w: w, shown: -1}
+}
+
+// update is the appctx.UploadProgressFunc for the attempt. The file is sent
+// as the raw request body, so the file size is the denominator.
+func (m *uploadMeter) update(sent, _ int64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.last = time.Now()
+	m.started = true
+	if sent >= m.size {
+		// Body fully written; the server may take a while to respond for
+		// large files, which is not a stall.
+		m.done = true
+	}
+	if m.w == nil || m.size <= 0 {
+		return
+	}
+	pct := int(min(sent*100/m.size, 100))
+	if pct == m.shown {
+		return
+	}
+	m.shown = pct
+	fmt.Fprintf(m.w, "\rUploading %s: %3d%% (%s / %s)", m.name, pct, formatUploadBytes(sent), formatUploadBytes(m.size))
+}
+
+func (m *uploadMeter) finish(ok bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.w == nil || m.shown < 0 {
+		return
+	}
+	if ok {
+		fmt.Fprintln(m.w, " done")
+	} else {
+		fmt.Fprintln(m.w)
+	}
+}
+
+// watch calls onStall if no progress is reported for timeout while the body
+// is being sent. It stays quiet until the transport starts sending, so a
+// slow read of the file into memory is never taken for a stall. The
+// returned func stops the watcher.
+func (m *uploadMeter) watch(timeout time.Duration, onStall func()) func() {
+	stop := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(min(timeout/4, time.Second))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				m.mu.Lock()
+				stalled := m.started && !m.done && time.Since(m.last) > timeout
+				m.mu.Unlock()
+				if stalled {
+					onStall()
+					return
+				}
+			}
+		}
+	}()
+	var once sync.Once
+	return func() { once.Do(func() { close(stop) }) }
+}
+
+// formatUploadBytes renders a byte count as a short human-readable size.
+func formatUploadBytes(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for v := n / unit; v >= unit; v /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,173 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+)
+
+// flakyAttachmentTransport answers POST /attachments.json with the scripted
+// statuses in order, then 201 with an sgid. A status of 0 reads the first
+// byte of the body and then blocks until the request is cancelled,
+// simulating a connection that stalls mid-upload.
+type flakyAttachmentTransport struct {
+	statuses []int
+	calls    atomic.Int32
+	bodies   []string
+}
+
+func (f *flakyAttachmentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost || !strings.HasSuffix(req.URL.Path, "/attachments.json") {
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+	}
+	n := int(f.calls.Add(1))
+
+	if n <= len(f.statuses) && f.statuses[n-1] == 0 {
+		_, _ = req.Body.Read(make([]byte, 1))
+		<-req.Context().Done()
+		return nil, req.Context().Err()
+	}
+
+	body, _ := io.ReadAll(req.Body)
+	f.bodies = append(f.bodies, string(body))
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	if n <= len(f.statuses) {
+		status := f.statuses[n-1]
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(`{"error": "nope"}`)), Header: header}, nil
+	}
+	return &http.Response{StatusCode: http.StatusCreated, Body: io.NopCloser(strings.NewReader(`{"attachable_sgid": "sgid-ok"}`)), Header: header}, nil
+}
+
+func stubUploadTiming(t *testing.T, stall time.Duration) {
+	t.Helper()
+	origDelay, origStall := uploadRetryDelay, uploadStallTimeout
+	uploadRetryDelay = func(int) time.Duration { return time.Millisecond }
+	uploadStallTimeout = stall
+	t.Cleanup(func() {
+		uploadRetryDelay, uploadStallTimeout = origDelay, origStall
+	})
+}
+
+func writeUploadFixture(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "clip.mp4")
+	require.NoError(t, os.WriteFile(path, []byte("video bytes"), 0o600))
+	return path
+}
+
+func TestUploadAttachmentFileRetriesTransientFailures(t *testing.T) {
+	stubUploadTiming(t, time.Minute)
+	transport := &flakyAttachmentTransport{statuses: []int{http.StatusServiceUnavailable, http.StatusBadGateway}}
+	app := showTestApp(t, transport)
+
+	resp, err := uploadAttachmentFile(context.Background(), app, writeUploadFixture(t), "clip.mp4", "video/mp4")
+	require.NoError(t, err)
+	assert.Equal(t, "sgid-ok", resp.AttachableSGID)
+	assert.Equal(t, int32(3), transport.calls.Load())
+
+	// Each attempt re-reads the whole file from disk.
+	for _, b := range transport.bodies {
+		assert.Equal(t, "video bytes", b)
+	}
+}
+
+func TestUploadAttachmentFileDoesNotRetryClientErrors(t *testing.T) {
+	stubUploadTiming(t, time.Minute)
+	transport := &flakyAttachmentTransport{statuses: []int{http.StatusUnprocessableEntity}}
+	app := showTestApp(t, transport)
+
+	_, err := uploadAttachmentFile(context.Background(), app, writeUploadFixture(t), "clip.mp4", "video/mp4")
+	require.Error(t, err)
+	assert.Equal(t, int32(1), transport.calls.Load())
+}
+
+func TestUploadAttachmentFileGivesUpAfterMaxAttempts(t *testing.T) {
+	stubUploadTiming(t, time.Minute)
+	statuses := make([]int, uploadMaxAttempts)
+	for i := range statuses {
+		statuses[i] = http.StatusServiceUnavailable
+	}
+	transport := &flakyAttachmentTransport{statuses: statuses}
+	app := showTestApp(t, transport)
+
+	_, err := uploadAttachmentFile(context.Background(), app, writeUploadFixture(t), "clip.mp4", "video/mp4")
+	require.Error(t, err)
+	assert.Equal(t, int32(uploadMaxAttempts), transport.calls.Load())
+}
+
+func TestUploadAttachmentFileRetriesStalledTransfer(t *testing.T) {
+	stubUploadTiming(t, 40*time.Millisecond)
+	transport := &flakyAttachmentTransport{statuses: []int{0}}
+	app := showTestApp(t, appctx.NewUploadProgressTransport(transport))
+
+	resp, err := uploadAttachmentFile(context.Background(), app, writeUploadFixture(t), "clip.mp4", "video/mp4")
+	require.NoError(t, err)
+	assert.Equal(t, "sgid-ok", resp.AttachableSGID)
+	assert.Equal(t, int32(2), transport.calls.Load())
+}
+
+func TestUploadMeterWatchWaitsForTransport(t *testing.T) {
+	m := newUploadMeter("clip.mp4", 2048, nil)
+	var stalled atomic.Bool
+	stop := m.watch(20*time.Millisecond, func() { stalled.Store(true) })
+	defer stop()
+
+	// Before the transport starts sending (the SDK is still reading the
+	// file), silence is not a stall.
+	time.Sleep(80 * time.Millisecond)
+	assert.False(t, stalled.Load())
+
+	m.update(0, 2048)
+	assert.Eventually(t, stalled.Load, time.Second, 5*time.Millisecond)
+}
+
+func TestUploadAttachmentFileMissingFile(t *testing.T) {
+	app := showTestApp(t, &flakyAttachmentTransport{})
+
+	_, err := uploadAttachmentFile(context.Background(), app, filepath.Join(t.TempDir(), "missing.mp4"), "missing.mp4", "video/mp4")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "missing.mp4")
+}
+
+func TestIsTransientUploadError(t *testing.T) {
+	assert.True(t, isTransientUploadError(errUploadStalled))
+	assert.True(t, isTransientUploadError(&basecamp.Error{Code: basecamp.CodeAPI, Retryable: true}))
+	assert.False(t, isTransientUploadError(&basecamp.Error{Code: basecamp.CodeValidation}))
+	assert.False(t, isTransientUploadError(errors.New("boom")))
+}
+
+func TestUploadMeterRendersProgress(t *testing.T) {
+	var buf strings.Builder
+	m := newUploadMeter("clip.mp4", 2048, &buf)
+	m.update(1024, 2048)
+	m.update(1024, 2048) // same percentage: no redraw
+	m.update(2048, 2048)
+	m.finish(true)
+
+	out := buf.String()
+	assert.Equal(t, 1, strings.Count(out, " 50%"))
+	assert.Contains(t, out, "100% (2.0 KB / 2.0 KB) done")
+}
+
+func TestFormatUploadBytes(t *testing.T) {
+	assert.Equal(t, "512 B", formatUploadBytes(512))
+	assert.Equal(t, "1.5 KB", formatUploadBytes(1536))
+	assert.Equal(t, "2.0 GB", formatUploadBytes(2<<30))
+}
@@ -33,8 +33,8 @@ Tip: In the TUI, press 'b' on any item to boost interactively.`,
 		Annotations: map[string]string{"agent_notes": "Boosts are tiny messages of support (16 chars max), not just emoji\nIn TUI mode, press 'b' on any item to boost interactively"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newBoostListCmd(&project),
@@ -78,23 +78,10 @@ func runBoostList(cmd *cobra.Command, app *appctx.App, recording, project, event
 	recordingID, urlProjectID := extractWithProject(recording)
 
 	projectID := project
-	if projectID == "" && urlProjectID != "" {
-		projectID = urlProjectID
-	}
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
 	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+		projectID = urlProjectID
 	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 	if err != nil {
 		return err
 	}
@@ -167,23 +154,10 @@ You can pass either a boost ID or a Basecamp URL:
 			boostID, urlProjectID := extractWithProject(args[0])
 
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
 			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -258,23 +232,10 @@ func runBoostCreate(cmd *cobra.Command, app *appctx.App, recording, project, con
 	recordingID, urlProjectID := extractWithProject(recording)
 
 	projectID := project
-	if projectID == "" && urlProjectID != "" {
-		projectID = urlProjectID
-	}
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
 	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+		projectID = urlProjectID
 	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 	if err != nil {
 		return err
 	}
@@ -356,6 +317,10 @@ You can pass either a boost ID or a Basecamp URL:
 				return output.ErrUsage("Invalid boost ID")
 			}
 
+			if !confirmAction(cmd, confirmPermanent, fmt.Sprintf("Delete boost #%d?", boostIDInt), false) {
+				return nil
+			}
+
 			err = app.Account().Boosts().Delete(cmd.Context(), boostIDInt)
 			if err != nil {
 				return convertSDKError(err)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -27,11 +28,11 @@ func NewCardsCmd() *cobra.Command {
 		Use:         "cards",
 		Short:       "Manage cards in Card Tables",
 		Long:        "List, show, create, and manage cards in Card Tables (Kanban boards).",
-		Annotations: map[string]string{"agent_notes": "Cards do NOT support --assignee filtering like todos — fetch all and filter client-side\nIf a project has multiple card tables, you must specify --card-table <id>\nAssign/unassign shortcuts work on cards: basecamp assign <card_id> --to <person>\nCross-project cards: basecamp recordings cards --json"},
+		Annotations: map[string]string{"agent_notes": "cards list --assignee, --due-before, --due-after, --overdue, and --filter <saved> narrow cards client-side (saved filters: config card_filters.<name>)\nIf a project has multiple card tables, you must specify --card-table <id> (cards move finds it from the card)\nAssign/unassign shortcuts work on cards: basecamp assign <card_id> --to <person>\nCross-project cards: basecamp recordings cards --json"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVar(&cardTable, "card-table", "", "Card table ID (required if project has multiple)")
 
 	cmd.AddCommand(
@@ -43,6 +44,7 @@ func NewCardsCmd() *cobra.Command {
 		newCardsDoneCmd(&project, &cardTable),
 		newCardsColumnsCmd(&project, &cardTable),
 		newCardsColumnCmd(&project, &cardTable),
+		newCardsMetricsCmd(&project, &cardTable),
 		newCardsStepsCmd(&project),
 		newCardsStepCmd(&project),
 		newRecordableTrashCmd("card"),
@@ -60,27 +62,53 @@ func newCardsListCmd(project, cardTable *string) *cobra.Command {
 	var all bool
 	var sortField string
 	var reverse bool
+	var filters cardsListFilterFlags
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List cards",
-		Long:  "List all cards in a project's card table.",
+		Long: `List all cards in a project's card table.
+
+Every column is followed through all of its pages. --limit caps the total
+across the board (or within --column); --page fetches only the first page
+of a single --column.
+
+--assignee, --due-before, --due-after, --overdue, --created-by, and
+--updated-since narrow the fetched cards. Due bounds are exclusive, and
+--overdue keeps incomplete cards due before today. --filter applies a filter saved in config, combining column,
+assignee, due window, and a title pattern:
+
+  basecamp config set card_filters.mine-due-soon assignee=me,due_within=7d
+  basecamp cards list --filter mine-due-soon
+
+--watch polls every --interval seconds and, after the first list, prints
+only cards that are new or changed.`,
+		Example: `  basecamp cards list --assignee Alice --due-after yesterday --due-before +7
+  basecamp cards list --overdue --assignee me
+  basecamp cards list --column "In Progress" --watch --interval 60`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runCardsList(cmd, *project, column, *cardTable, limit, page, all, sortField, reverse)
+			return runCardsList(cmd, *project, column, *cardTable, limit, page, all, sortField, reverse, filters)
 		},
 	}
 
 	cmd.Flags().StringVarP(&column, "column", "c", "", "Filter by column ID or name")
-	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum number of cards to fetch (0 = all)")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum number of cards to fetch, across all columns unless --column (0 = all)")
 	cmd.Flags().BoolVar(&all, "all", false, "Fetch all cards (no limit)")
-	cmd.Flags().IntVar(&page, "page", 0, "Fetch a single page (use --all for everything)")
+	cmd.Flags().IntVar(&page, "page", 0, "Fetch a single page of one --column (use --all for everything)")
 	cmd.Flags().StringVar(&sortField, "sort", "", "Sort by field (title, created, updated, position, due)")
 	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse sort order")
+	cmd.Flags().StringVar(&filters.name, "filter", "", "Apply a saved filter (config card_filters.<name>)")
+	cmd.Flags().StringVar(&filters.assignee, "assignee", "", "Only cards assigned to this person (ID, name, or \"me\")")
+	cmd.Flags().StringVar(&filters.dueBefore, "due-before", "", "Only cards due before this date (YYYY-MM-DD or natural date)")
+	cmd.Flags().StringVar(&filters.dueAfter, "due-after", "", "Only cards due after this date (YYYY-MM-DD or natural date)")
+	cmd.Flags().BoolVar(&filters.overdue, "overdue", false, "Only incomplete cards past their due date")
+	addRecordingFilterFlags(cmd, &filters.recording)
+	addWatchFlags(cmd)
 
 	return cmd
 }
 
-func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit, page int, all bool, sortField string, reverse bool) error {
+func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit, page int, all bool, sortField string, reverse bool, filters cardsListFilterFlags) error {
 	app := appctx.FromContext(cmd.Context())
 
 	// Validate flag combinations
@@ -102,35 +130,28 @@ func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit,
 		}
 	}
 
-	// Pagination flags only make sense when listing a single column
-	// When aggregating across columns, pagination is per-column which is confusing
-	if column == "" && (page > 0 || limit > 0 || all) {
+	// A single page only makes sense for one column; --limit and --all
+	// apply to the whole board when aggregating across columns.
+	if column == "" && page > 0 {
 		return output.ErrUsageHint(
-			"Pagination flags require --column",
-			"When listing all columns, pagination applies per-column. Use --column <id> to paginate a single column.",
+			"--page requires --column",
+			"Each column paginates separately. Use --limit or --all to cap or fetch the whole board.",
 		)
 	}
 
-	// Resolve account (enables interactive prompt if needed)
-	if err := ensureAccount(cmd, app); err != nil {
+	saved, err := savedCardFilter(app.Config, filters.name)
+	if err != nil {
 		return err
 	}
 
-	// Resolve project from CLI flags and config, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
+	// Resolve account (enables interactive prompt if needed)
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
 	}
 
-	// If no project specified, try interactive resolution
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+	filter, err := buildCardListFilter(cmd.Context(), app, saved, filters, column != "", time.Now())
+	if err != nil {
+		return err
 	}
 
 	// Column name (non-numeric) requires --card-table for resolution
@@ -139,7 +160,7 @@ func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit,
 		return output.ErrUsage("--card-table is required when using --column with a name")
 	}
 
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -168,13 +189,15 @@ func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit,
 			return convertSDKError(err)
 		}
 
+		cards := filter.apply(cardsResult.Cards)
 		if sortField != "" {
-			sortCards(cardsResult.Cards, sortField, reverse)
+			sortCards(cards, sortField, reverse)
 		}
 
-		return app.OK(cardsResult.Cards,
-			output.WithSummary(fmt.Sprintf("%d cards", len(cardsResult.Cards))),
+		return app.OK(cards,
+			output.WithSummary(cardsListSummary(len(cards), len(cardsResult.Cards), filter.active())),
 			output.WithBreadcrumbs(cardsListBreadcrumbs(resolvedProjectID)...),
+			listPagination(cardsResult.Meta, page, len(cardsResult.Cards)),
 		)
 	}
 
@@ -197,6 +220,8 @@ func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit,
 
 	// Get cards from all columns or specific column
 	var allCards []basecamp.Card
+	var meta basecamp.ListMeta
+	interrupted := false
 	if column != "" {
 		// Find column by ID or name
 		columnID := resolveColumn(cardTableData.Lists, column)
@@ -211,32 +236,27 @@ func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit,
 			return convertSDKError(err)
 		}
 		allCards = cardsResult.Cards
-
-		if sortField != "" {
-			sortCards(allCards, sortField, reverse)
-		}
+		meta = cardsResult.Meta
 	} else {
 		// No position in aggregate — it's only meaningful within a single column
 		if sortField == "position" {
 			return output.ErrUsage("--sort position requires --column (position is per-column)")
 		}
 
-		// Get cards from all columns (no pagination - already validated above)
-		for _, col := range cardTableData.Lists {
-			cardsResult, err := app.Account().Cards().List(cmd.Context(), col.ID, nil)
-			if err != nil {
-				continue // Skip columns with errors
-			}
-			allCards = append(allCards, cardsResult.Cards...)
+		allCards, meta, interrupted, err = listBoardCards(cmd.Context(), app.Account().Cards(), cardTableData.Lists, opts.Limit)
+		if err != nil {
+			return convertSDKError(err)
 		}
+	}
 
-		if sortField != "" {
-			sortCards(allCards, sortField, reverse)
-		}
+	fetched := len(allCards)
+	allCards = filter.apply(allCards)
+	if sortField != "" {
+		sortCards(allCards, sortField, reverse)
 	}
 
-	return app.OK(allCards,
-		output.WithSummary(fmt.Sprintf("%d cards", len(allCards))),
+	respOpts := []output.ResponseOption{
+		output.WithSummary(cardsListSummary(len(allCards), fetched, filter.active())),
 		output.WithBreadcrumbs(append(cardsListBreadcrumbs(resolvedProjectID),
 			output.Breadcrumb{
 				Action:      "columns",
@@ -244,7 +264,55 @@ func runCardsList(cmd *cobra.Command, project, column, cardTable string, limit,
 				Description: "List columns with IDs",
 			},
 		)...),
-	)
+		listPagination(meta, page, fetched),
+	}
+	if interrupted {
+		respOpts = append(respOpts, output.WithInterrupted())
+	}
+	return app.OK(allCards, respOpts...)
+}
+
+// listBoardCards fetches the cards in every column, following each column's
+// Link-header pagination. A positive limit caps the total across the board;
+// columns past the cap are not fetched and the result is marked truncated
+// when any of them hold cards. Ctrl-C stops the fan-out: the columns read so
+// far are returned with interrupted set.
+func listBoardCards(ctx context.Context, cards *basecamp.CardsService, columns []basecamp.CardColumn, limit int) (all []basecamp.Card, meta basecamp.ListMeta, interrupted bool, err error) {
+	counted := true // every column reported X-Total-Count
+	for i, col := range columns {
+		opts := &basecamp.CardListOptions{}
+		if limit > 0 {
+			remaining := limit - len(all)
+			if remaining <= 0 {
+				for _, rest := range columns[i:] {
+					meta.TotalCount += rest.CardsCount
+					if rest.CardsCount > 0 {
+						meta.Truncated = true
+					}
+				}
+				break
+			}
+			opts.Limit = remaining
+		}
+		result, listErr := cards.List(ctx, col.ID, opts)
+		if listErr != nil {
+			if ctx.Err() != nil {
+				interrupted = true
+				break
+			}
+			return nil, basecamp.ListMeta{}, false, listErr
+		}
+		all = append(all, result.Cards...)
+		meta.TotalCount += result.Meta.TotalCount
+		meta.Truncated = meta.Truncated || result.Meta.Truncated
+		if result.Meta.TotalCount == 0 && len(result.Cards) > 0 {
+			counted = false
+		}
+	}
+	if !counted || interrupted {
+		meta.TotalCount = 0
+	}
+	return all, meta, interrupted, nil
 }
 
 func cardsListBreadcrumbs(resolvedProjectID string) []output.Breadcrumb {
@@ -381,14 +449,21 @@ func resolvePersonRoleID(ctx context.Context, app *appctx.App, input, role strin
 
 func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 	var column string
+	var createColumn bool
+	var columnColor string
 	var assignee string
 	var attachFiles []string
 
 	cmd := &cobra.Command{
 		Use:   "create <title> [body]",
 		Short: "Create a new card",
-		Long:  "Create a new card in a project's card table.",
+		Long: `Create a new card in a project's card table.
+
+When --column names a column the card table doesn't have, --create-column
+creates it first (with --column-color, in that color), so a new board stage
+takes one command instead of two.`,
 		Example: `  basecamp cards create "My card" --in myproject
+  basecamp cards create "Verify fix" --column QA --create-column --column-color green --card-table 777 --in myproject
   basecamp cards create --in myproject -- "--title with dashes"`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			// Show help when invoked with no title
@@ -416,35 +491,43 @@ func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 			if column != "" && !isNumericID(column) && *cardTable == "" {
 				return output.ErrUsage("--card-table is required when using --column with a name")
 			}
-
-			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
+			if createColumn && (column == "" || isNumericID(column)) {
+				return output.ErrUsage("--create-column requires --column with a column name")
 			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+			if columnColor != "" && !createColumn {
+				return output.ErrUsage("--column-color requires --create-column")
 			}
 
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			// Resolve project, with interactive fallback
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
 
+			// Pre-resolve assignee before side-effectful work, including --create-column (fail early on bad input)
+			var assigneeID int64
+			if cmd.Flags().Changed("assignee") || cmd.Flags().Changed("to") {
+				assigneeID, err = resolveAssigneeID(cmd.Context(), app, assignee)
+				if err != nil {
+					return err
+				}
+				if err := ensureProjectAssignees(cmd.Context(), app, resolvedProjectID, []int64{assigneeID}); err != nil {
+					return err
+				}
+			}
+
 			// If column is a numeric ID, use it directly without card table discovery
 			var columnID int64
 			var cardTableIDVal string
+			var newColumn *basecamp.CardColumn
 			if column != "" && isNumericID(column) {
 				columnID, err = strconv.ParseInt(column, 10, 64)
 				if err != nil {
 					return output.ErrUsage("Invalid column ID")
 				}
+				if err := validateColumnTarget(cmd, app, columnID, resolvedProjectID, *cardTable); err != nil {
+					return err
+				}
 				cardTableIDVal = "" // Not needed for numeric column ID
 			} else {
 				// Need to discover card table and resolve column
@@ -467,10 +550,17 @@ func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 				// Find target column
 				if column != "" {
 					columnID = resolveColumn(cardTableData.Lists, column)
+					if columnID == 0 && createColumn {
+						newColumn, err = createCardColumn(cmd, app, resolvedProjectID, cardTableIDInt, column, columnColor)
+						if err != nil {
+							return err
+						}
+						columnID = newColumn.ID
+					}
 					if columnID == 0 {
 						return output.ErrUsageHint(
 							fmt.Sprintf("Column '%s' not found", column),
-							"Use column ID or exact name",
+							"Use column ID or exact name, or add --create-column to create it",
 						)
 					}
 				} else {
@@ -482,15 +572,6 @@ func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 				}
 			}
 
-			// Pre-resolve assignee before side-effectful work (fail early on bad input)
-			var assigneeID int64
-			if cmd.Flags().Changed("assignee") || cmd.Flags().Changed("to") {
-				assigneeID, err = resolveAssigneeID(cmd.Context(), app, assignee)
-				if err != nil {
-					return err
-				}
-			}
-
 			// Convert content through rich text pipeline
 			var mentionNotice string
 			if content != "" {
@@ -571,8 +652,18 @@ func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 				Description: "List cards",
 			})
 
+			summary := fmt.Sprintf("Created card #%d", card.ID)
+			if newColumn != nil {
+				summary = fmt.Sprintf("Created card #%d in new column: %s", card.ID, newColumn.Title)
+				breadcrumbs = append(breadcrumbs, output.Breadcrumb{
+					Action:      "column",
+					Cmd:         fmt.Sprintf("basecamp cards column show %d --in %s", newColumn.ID, resolvedProjectID),
+					Description: "View column",
+				})
+			}
+
 			respOpts := []output.ResponseOption{
-				output.WithSummary(fmt.Sprintf("Created card #%d", card.ID)),
+				output.WithSummary(summary),
 				output.WithBreadcrumbs(breadcrumbs...),
 			}
 			if mentionNotice != "" {
@@ -583,9 +674,12 @@ func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&column, "column", "c", "", "Column ID or name (defaults to first column)")
+	cmd.Flags().BoolVar(&createColumn, "create-column", false, "Create the --column named if the card table doesn't have it")
+	cmd.Flags().StringVar(&columnColor, "column-color", "", "Color for a column made by --create-column")
 	cmd.Flags().StringVar(&assignee, "assignee", "", "Assignee ID or name")
 	cmd.Flags().StringVar(&assignee, "to", "", "Assignee (alias for --assignee)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
+	addContentForceFlag(cmd)
 
 	completer := completion.NewCompleter(nil)
 	_ = cmd.RegisterFlagCompletionFunc("assignee", completer.PeopleNameCompletion())
@@ -594,11 +688,43 @@ func newCardsCreateCmd(project, cardTable *string) *cobra.Command {
 	return cmd
 }
 
+// createCardColumn adds a column for cards create --create-column, coloring
+// it when a color is given. A failed color leaves the column in place, so
+// the error names it: a rerun without --create-column will find it.
+func createCardColumn(cmd *cobra.Command, app *appctx.App, projectID string, cardTableID int64, title, color string) (*basecamp.CardColumn, error) {
+	col, err := app.Account().CardColumns().Create(cmd.Context(), cardTableID, &basecamp.CreateColumnRequest{Title: title})
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	if color == "" {
+		return col, nil
+	}
+
+	bucketID, err := strconv.ParseInt(projectID, 10, 64)
+	if err != nil {
+		return nil, output.ErrUsage("Invalid project ID")
+	}
+	colored, err := app.Account().CardColumns().SetColor(cmd.Context(), bucketID, col.ID, color)
+	if err != nil {
+		sdkErr := convertSDKError(err)
+		var e *output.Error
+		if errors.As(sdkErr, &e) {
+			e.Message = fmt.Sprintf("column %d created but setting its color failed: %s", col.ID, e.Message)
+			return nil, e
+		}
+		return nil, fmt.Errorf("column %d created but setting its color failed: %w", col.ID, sdkErr)
+	}
+	return colored, nil
+}
+
 func newCardsUpdateCmd() *cobra.Command {
 	var title string
 	var content string
 	var due string
 	var assignee string
+	var assignees string
+	var addAssignees string
+	var removeAssignees string
 	var attachFiles []string
 
 	cmd := &cobra.Command{
@@ -608,12 +734,29 @@ func newCardsUpdateCmd() *cobra.Command {
 
 You can pass either a card ID or a Basecamp URL:
   basecamp cards update 789 --title "new title"
-  basecamp cards update 789 --body "new body"`,
+  basecamp cards update 789 --body "new body"
+
+--assignee and --assignees replace who is assigned; --assignees "" unassigns
+everyone. --add-assignee and --remove-assignee adjust the current assignees
+and leave everyone else in place:
+  basecamp cards update 789 --assignees "Ann,Bo"
+  basecamp cards update 789 --add-assignee me --remove-assignee Bo
+
+When the assignees change, styled output (or --verbose) shows who is added,
+removed, and notified before the card is saved.`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if strings.TrimSpace(title) == "" && strings.TrimSpace(content) == "" && due == "" && !cmd.Flags().Changed("assignee") && len(attachFiles) == 0 {
+			replaceAssignees := cmd.Flags().Changed("assignee") || cmd.Flags().Changed("assignees")
+			adjustAssignees := addAssignees != "" || removeAssignees != ""
+			if strings.TrimSpace(title) == "" && strings.TrimSpace(content) == "" && due == "" && !replaceAssignees && !adjustAssignees && len(attachFiles) == 0 {
 				return noChanges(cmd)
 			}
+			if cmd.Flags().Changed("assignee") && cmd.Flags().Changed("assignees") {
+				return output.ErrUsage("--assignee and --assignees are mutually exclusive")
+			}
+			if replaceAssignees && adjustAssignees {
+				return output.ErrUsage("--assignee/--assignees replace the assignees; use --add-assignee/--remove-assignee without them")
+			}
 
 			app := appctx.FromContext(cmd.Context())
 
@@ -629,6 +772,21 @@ You can pass either a card ID or a Basecamp URL:
 				return output.ErrUsage("Invalid card ID")
 			}
 
+			// Validate due date and assignee before any uploads or writes
+			var dueOn string
+			if due != "" {
+				if dueOn, err = parseDueFlag(due); err != nil {
+					return err
+				}
+			}
+			var assigneeIDs []int64
+			if replaceAssignees || adjustAssignees {
+				assigneeIDs, err = cardUpdateAssigneeIDs(cmd, app, cardID, assignee, assignees, addAssignees, removeAssignees)
+				if err != nil {
+					return err
+				}
+			}
+
 			req := &basecamp.UpdateCardRequest{}
 			if title != "" {
 				req.Title = title
@@ -661,16 +819,8 @@ You can pass either a card ID or a Basecamp URL:
 			if html != "" {
 				req.Content = html
 			}
-			if due != "" {
-				req.DueOn = dateparse.Parse(due)
-			}
-			if cmd.Flags().Changed("assignee") {
-				assigneeID, err := resolveAssigneeID(cmd.Context(), app, assignee)
-				if err != nil {
-					return err
-				}
-				req.AssigneeIDs = []int64{assigneeID}
-			}
+			req.DueOn = dueOn
+			req.AssigneeIDs = assigneeIDs
 
 			card, err := app.Account().Cards().Update(cmd.Context(), cardID, req)
 			if err != nil {
@@ -697,16 +847,86 @@ You can pass either a card ID or a Basecamp URL:
 	cmd.Flags().StringVarP(&title, "title", "t", "", "New title")
 	cmd.Flags().StringVarP(&content, "body", "b", "", "New body content")
 	cmd.Flags().StringVarP(&due, "due", "d", "", "Due date (natural language or YYYY-MM-DD)")
-	cmd.Flags().StringVar(&assignee, "assignee", "", "Assignee ID or name")
+	cmd.Flags().StringVar(&assignee, "assignee", "", "Assignee ID or name (replaces current assignees)")
+	cmd.Flags().StringVar(&assignees, "assignees", "", "Assignees (IDs or names, comma-separated; replaces current, \"\" unassigns all)")
+	cmd.Flags().StringVar(&addAssignees, "add-assignee", "", "Add assignees, keeping current ones (IDs or names, comma-separated)")
+	cmd.Flags().StringVar(&removeAssignees, "remove-assignee", "", "Remove assignees, keeping the rest (IDs or names, comma-separated)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
+	addContentForceFlag(cmd)
 
-	// Register tab completion for assignee flag
+	// Register tab completion for assignee flags
 	completer := completion.NewCompleter(nil)
-	_ = cmd.RegisterFlagCompletionFunc("assignee", completer.PeopleNameCompletion())
+	for _, name := range []string{"assignee", "assignees", "add-assignee", "remove-assignee"} {
+		_ = cmd.RegisterFlagCompletionFunc(name, completer.PeopleNameCompletion())
+	}
 
 	return cmd
 }
 
+// cardUpdateAssigneeIDs works out a card's assignees after cards update:
+// --assignee or --assignees replace them, --add-assignee and
+// --remove-assignee adjust the current ones. People being added must be on
+// the card's project. The result is never nil, so an empty list unassigns.
+func cardUpdateAssigneeIDs(cmd *cobra.Command, app *appctx.App, cardID int64, assignee, assignees, add, remove string) ([]int64, error) {
+	ctx := cmd.Context()
+
+	var replaceIDs, addIDs, removeIDs []int64
+	var err error
+	switch {
+	case cmd.Flags().Changed("assignee"):
+		id, err := resolveAssigneeID(ctx, app, assignee)
+		if err != nil {
+			return nil, err
+		}
+		replaceIDs = []int64{id}
+	case cmd.Flags().Changed("assignees") && strings.TrimSpace(assignees) != "":
+		if replaceIDs, err = resolveAssigneeIDs(ctx, app, assignees); err != nil {
+			return nil, err
+		}
+	}
+	if add != "" {
+		if addIDs, err = resolveAssigneeIDs(ctx, app, add); err != nil {
+			return nil, err
+		}
+	}
+	if remove != "" {
+		if removeIDs, err = resolveAssigneeIDs(ctx, app, remove); err != nil {
+			return nil, err
+		}
+	}
+
+	current, err := app.Account().Cards().Get(ctx, cardID)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	currentIDs := existingAssigneeIDs(current.Assignees)
+
+	ids := make([]int64, 0, len(currentIDs)+len(addIDs))
+	if cmd.Flags().Changed("assignee") || cmd.Flags().Changed("assignees") {
+		addIDs = replaceIDs
+	} else {
+		ids = append(ids, currentIDs...)
+	}
+	for _, id := range addIDs {
+		if !containsID(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	for _, id := range removeIDs {
+		ids = removeID(ids, id)
+	}
+
+	if current.Bucket != nil && len(addIDs) > 0 {
+		if err := ensureProjectAssignees(ctx, app, strconv.FormatInt(current.Bucket.ID, 10), addIDs); err != nil {
+			return nil, err
+		}
+	}
+
+	// Basecamp notifies people newly assigned to a card.
+	previewAssigneeChange(cmd, app, currentIDs, ids, true)
+	return ids, nil
+}
+
 func newCardsMoveCmd(project, cardTable *string) *cobra.Command {
 	var targetColumn string
 	var position int
@@ -717,8 +937,11 @@ func newCardsMoveCmd(project, cardTable *string) *cobra.Command {
 		Short: "Move a card to another column",
 		Long: `Move a card to a different column in the card table.
 
+A column name is looked up in the card's own card table, found by fetching
+the card, so --card-table and --in are only needed to override it.
+
 You can pass either a card ID or a Basecamp URL:
-  basecamp cards move 789 --to "Done" --in my-project
+  basecamp cards move 789 --to "Done"
   basecamp cards move https://3.basecamp.com/123/buckets/456/card_tables/cards/789 --to "Done"
   basecamp cards move 789 --to "Done" --position 1 --in my-project
   basecamp cards move 789 --on-hold --in my-project
@@ -756,36 +979,36 @@ You can pass either a card ID or a Basecamp URL:
 				return output.ErrUsage("Invalid card ID")
 			}
 
+			// A column name or --position needs the card table. Without
+			// --card-table, follow the card's parent chain to find it.
 			isNumericColumn := targetColumn != "" && isNumericID(targetColumn)
-			if targetColumn != "" && !isNumericColumn && *cardTable == "" {
-				return output.ErrUsage("--card-table is required when --to is a column name")
+			cardTableFlag := *cardTable
+			var cardBucketID string
+			if cardTableFlag == "" && ((targetColumn != "" && !isNumericColumn) || positionSet) {
+				cardTableFlag, cardBucketID, err = cardTableForCard(cmd.Context(), app, cardID)
+				if err != nil {
+					return err
+				}
 			}
 
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
+			if projectID == "" {
 				projectID = urlProjectID
 			}
 			if projectID == "" {
 				projectID = app.Flags.Project
 			}
 			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = cardBucketID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
 
 			// --on-hold: move card to on-hold section of current or target column
 			if onHold {
-				return moveCardOnHold(cmd, app, cardID, cardIDStr, resolvedProjectID, targetColumn, *cardTable)
+				return moveCardOnHold(cmd, app, cardID, cardIDStr, resolvedProjectID, targetColumn, cardTableFlag)
 			}
 
 			var columnID int64
@@ -795,8 +1018,11 @@ You can pass either a card ID or a Basecamp URL:
 				if err != nil {
 					return output.ErrUsage("Invalid column ID")
 				}
+				if err := validateColumnTarget(cmd, app, columnID, resolvedProjectID, cardTableFlag); err != nil {
+					return err
+				}
 			} else {
-				cardTableIDVal, err = getCardTableID(cmd, app, resolvedProjectID, *cardTable)
+				cardTableIDVal, err = getCardTableID(cmd, app, resolvedProjectID, cardTableFlag)
 				if err != nil {
 					return err
 				}
@@ -821,7 +1047,7 @@ You can pass either a card ID or a Basecamp URL:
 			}
 
 			if positionSet && position > 0 && cardTableIDVal == "" {
-				cardTableIDVal, err = getCardTableID(cmd, app, resolvedProjectID, *cardTable)
+				cardTableIDVal, err = getCardTableID(cmd, app, resolvedProjectID, cardTableFlag)
 				if err != nil {
 					return err
 				}
@@ -930,26 +1156,13 @@ You can pass either a card ID or a Basecamp URL:
 			}
 
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
+			if projectID == "" {
 				projectID = urlProjectID
 			}
 			if projectID == "" && card.Bucket != nil && card.Bucket.ID != 0 {
 				projectID = fmt.Sprintf("%d", card.Bucket.ID)
 			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -1113,21 +1326,7 @@ func newCardsColumnsCmd(project, cardTable *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -1218,23 +1417,10 @@ You can pass either a column ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
 			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -1286,21 +1472,7 @@ func newCardsColumnCreateCmd(project, cardTable *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -1434,23 +1606,10 @@ You can pass either a column ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -1718,11 +1877,14 @@ You can pass either a column ID or a Basecamp URL:
 // newCardsStepsCmd creates the steps listing subcommand.
 func newCardsStepsCmd(project *string) *cobra.Command {
 	var cardID string
+	var overdue bool
 
 	cmd := &cobra.Command{
 		Use:   "steps <card-id|url>",
 		Short: "List steps on a card",
-		Long:  "Display all steps (checklist items) on a card.",
+		Long: `Display all steps (checklist items) on a card with their due dates and
+assignees. The summary reports how much of the card is done; --overdue keeps
+only incomplete steps past their due date.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
 
@@ -1744,21 +1906,7 @@ func newCardsStepsCmd(project *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -1769,8 +1917,14 @@ func newCardsStepsCmd(project *string) *cobra.Command {
 				return convertSDKError(err)
 			}
 
-			return app.OK(card.Steps,
-				output.WithSummary(fmt.Sprintf("%d steps on card #%s", len(card.Steps), cardID)),
+			steps := card.Steps
+			if overdue {
+				steps = overdueSteps(card.Steps, time.Now().Format("2006-01-02"))
+			}
+
+			return app.OK(steps,
+				output.WithSummary(cardStepsSummary(card.Steps, len(steps), overdue, cardID)),
+				output.WithEntity("card_step"),
 				output.WithBreadcrumbs(
 					output.Breadcrumb{
 						Action:      "create",
@@ -1788,10 +1942,44 @@ func newCardsStepsCmd(project *string) *cobra.Command {
 	}
 
 	cmd.Flags().StringVarP(&cardID, "card", "c", "", "Card ID")
+	cmd.Flags().BoolVar(&overdue, "overdue", false, "Only incomplete steps past their due date")
 
 	return cmd
 }
 
+// overdueSteps returns the incomplete steps due before today (YYYY-MM-DD).
+func overdueSteps(steps []basecamp.CardStep, today string) []basecamp.CardStep {
+	var result []basecamp.CardStep
+	for _, step := range steps {
+		// Compare date strings directly (timezone-safe)
+		if step.Completed || step.DueOn == "" || step.DueOn >= today {
+			continue
+		}
+		result = append(result, step)
+	}
+	return result
+}
+
+// cardStepsSummary reports the step count and how much of the card is done,
+// e.g. "5 steps on card #123 (3 done, 60%)". The completion figures always
+// cover every step, even when --overdue narrowed the list.
+func cardStepsSummary(all []basecamp.CardStep, shown int, overdue bool, cardID string) string {
+	summary := fmt.Sprintf("%d steps on card #%s", len(all), cardID)
+	if overdue {
+		summary = fmt.Sprintf("%d overdue of %d steps on card #%s", shown, len(all), cardID)
+	}
+	if len(all) == 0 {
+		return summary
+	}
+	done := 0
+	for _, step := range all {
+		if step.Completed {
+			done++
+		}
+	}
+	return fmt.Sprintf("%s (%d done, %d%%)", summary, done, done*100/len(all))
+}
+
 // newCardsStepCmd creates the step management subcommand.
 func newCardsStepCmd(project *string) *cobra.Command {
 	cmd := &cobra.Command{
@@ -1845,21 +2033,7 @@ func newCardsStepCreateCmd(project *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -1868,13 +2042,18 @@ func newCardsStepCreateCmd(project *string) *cobra.Command {
 				Title: title,
 			}
 			if dueOn != "" {
-				req.DueOn = dateparse.Parse(dueOn)
+				if req.DueOn, err = parseDueFlag(dueOn); err != nil {
+					return err
+				}
 			}
 			if assignees != "" {
 				assigneeIDs, err := resolveAssigneeIDs(cmd.Context(), app, assignees)
 				if err != nil {
 					return err
 				}
+				if err := ensureProjectAssignees(cmd.Context(), app, resolvedProjectID, assigneeIDs); err != nil {
+					return err
+				}
 				req.AssigneeIDs = assigneeIDs
 			}
 
@@ -1948,27 +2127,34 @@ You can pass either a step ID or a Basecamp URL:
 				return noChanges(cmd)
 			}
 
-			req := &basecamp.UpdateStepRequest{}
-			if title != "" {
-				req.Title = title
-			} else {
-				// The API rejects step updates without a title, so carry
-				// over the current one when only other fields change.
-				current, err := app.Account().CardSteps().Get(cmd.Context(), stepID)
-				if err != nil {
-					return convertSDKError(err)
-				}
-				req.Title = current.Title
-			}
+			req := &basecamp.UpdateStepRequest{Title: title}
 			if dueOn != "" {
-				req.DueOn = dateparse.Parse(dueOn)
+				if req.DueOn, err = parseDueFlag(dueOn); err != nil {
+					return err
+				}
 			}
 			if assignees != "" {
-				assigneeIDs, err := resolveAssigneeIDs(cmd.Context(), app, assignees)
-				if err != nil {
+				if req.AssigneeIDs, err = resolveAssigneeIDs(cmd.Context(), app, assignees); err != nil {
 					return err
 				}
-				req.AssigneeIDs = assigneeIDs
+			}
+
+			// The API rejects step updates without a title, so carry over
+			// the current one when only other fields change. The current
+			// step also names the project new assignees must belong to.
+			if title == "" || len(req.AssigneeIDs) > 0 {
+				current, err := app.Account().CardSteps().Get(cmd.Context(), stepID)
+				if err != nil {
+					return convertSDKError(err)
+				}
+				if title == "" {
+					req.Title = current.Title
+				}
+				if len(req.AssigneeIDs) > 0 && current.Bucket != nil {
+					if err := ensureProjectAssignees(cmd.Context(), app, strconv.FormatInt(current.Bucket.ID, 10), req.AssigneeIDs); err != nil {
+						return err
+					}
+				}
 			}
 
 			step, err := app.Account().CardSteps().Update(cmd.Context(), stepID, req)
@@ -2146,6 +2332,10 @@ You can pass either a step ID or a Basecamp URL:
 				return output.ErrUsage("Invalid step ID")
 			}
 
+			if !confirmAction(cmd, confirmDestructive, fmt.Sprintf("Delete step #%d?", stepID), false) {
+				return nil
+			}
+
 			err = app.Account().CardSteps().Delete(cmd.Context(), stepID)
 			if err != nil {
 				return convertSDKError(err)
@@ -2199,6 +2389,38 @@ func getCardTableID(cmd *cobra.Command, app *appctx.App, projectID, explicitCard
 	return "", ambiguousCardTablesError(cardTables)
 }
 
+// cardTableForCard finds the card table a card belongs to by walking its
+// parent chain (card → column → card table) through the generic recordings
+// endpoint. It also returns the card's project ID.
+func cardTableForCard(ctx context.Context, app *appctx.App, cardID int64) (cardTableID, projectID string, err error) {
+	card, err := app.Account().Recordings().Get(ctx, cardID)
+	if err != nil {
+		return "", "", convertSDKError(err)
+	}
+	if card.Parent == nil {
+		return "", "", output.ErrUsageHint(
+			fmt.Sprintf("Card %d has no parent column", cardID),
+			"Pass the card table explicitly with --card-table <id>",
+		)
+	}
+
+	column, err := app.Account().Recordings().Get(ctx, card.Parent.ID)
+	if err != nil {
+		return "", "", convertSDKError(err)
+	}
+	if column.Parent == nil {
+		return "", "", output.ErrUsageHint(
+			fmt.Sprintf("Column %d has no parent card table", card.Parent.ID),
+			"Pass the card table explicitly with --card-table <id>",
+		)
+	}
+
+	if card.Bucket != nil {
+		projectID = strconv.FormatInt(card.Bucket.ID, 10)
+	}
+	return strconv.FormatInt(column.Parent.ID, 10), projectID, nil
+}
+
 // resolveColumnBucketID resolves the numeric project (bucket) ID for a column
 // command that takes a column ID or URL plus the --in/--project flag.
 //
@@ -2211,20 +2433,7 @@ func resolveColumnBucketID(cmd *cobra.Command, app *appctx.App, project, urlProj
 	if projectID == "" {
 		projectID = project
 	}
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return 0, err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 	if err != nil {
 		return 0, err
 	}
@@ -2466,6 +2675,75 @@ func resolveColumn(columns []basecamp.CardColumn, identifier string) int64 {
 	return 0
 }
 
+// parseDueFlag turns a --due value into YYYY-MM-DD. Values that don't parse
+// are a usage error rather than being passed through for the API to reject.
+func parseDueFlag(input string) (string, error) {
+	if !dateparse.IsValid(input) {
+		return "", output.ErrUsageHint(
+			fmt.Sprintf("Invalid due date: %q", input),
+			"Use YYYY-MM-DD or a phrase like tomorrow, friday, +3, or in 2 weeks",
+		)
+	}
+	return dateparse.Parse(input), nil
+}
+
+// ensureProjectAssignees checks that every assignee is on the project.
+// Basecamp silently drops assignees who aren't, so without this check an
+// assignment would appear to succeed.
+func ensureProjectAssignees(ctx context.Context, app *appctx.App, projectID string, ids []int64) error {
+	bucketID, err := strconv.ParseInt(projectID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+	result, err := app.Account().People().ListProjectPeople(ctx, bucketID, nil)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	members := make(map[int64]bool, len(result.People))
+	for _, p := range result.People {
+		members[p.ID] = true
+	}
+	for _, id := range ids {
+		if !members[id] {
+			return output.ErrUsageHint(
+				fmt.Sprintf("Assignee %d is not on this project", id),
+				fmt.Sprintf("See who is: basecamp people list --in %s", projectID),
+			)
+		}
+	}
+	return nil
+}
+
+// validateColumnTarget checks that a numeric column ID names a column in the
+// targeted card table, or in the targeted project when no card table was
+// given, so a stray ID is a usage error before anything is written.
+func validateColumnTarget(cmd *cobra.Command, app *appctx.App, columnID int64, projectID, cardTableID string) error {
+	col, err := app.Account().CardColumns().Get(cmd.Context(), columnID)
+	if err != nil {
+		var sdkErr *basecamp.Error
+		if errors.As(err, &sdkErr) && sdkErr.Code == basecamp.CodeNotFound {
+			return output.ErrUsageHint(
+				fmt.Sprintf("Column %d not found", columnID),
+				fmt.Sprintf("List columns: basecamp cards columns --in %s", projectID),
+			)
+		}
+		return convertSDKError(err)
+	}
+
+	hint := fmt.Sprintf("List columns: basecamp cards columns --in %s", projectID)
+	if cardTableID != "" && col.Parent != nil && strconv.FormatInt(col.Parent.ID, 10) != cardTableID {
+		return output.ErrUsageHint(
+			fmt.Sprintf("Column %d is not in card table %s", columnID, cardTableID),
+			hint+" --card-table "+cardTableID,
+		)
+	}
+	if col.Bucket != nil && strconv.FormatInt(col.Bucket.ID, 10) != projectID {
+		return output.ErrUsageHint(fmt.Sprintf("Column %d is not in project %s", columnID, projectID), hint)
+	}
+	return nil
+}
+
 func resolveAssigneeIDs(ctx context.Context, app *appctx.App, input string) ([]int64, error) {
 	return resolvePersonRoleIDs(ctx, app, input, "Assignee")
 }
@@ -0,0 +1,188 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// cardsListFilterFlags are the cards list flags that narrow the fetched
+// cards client-side.
+type cardsListFilterFlags struct {
+	name      string // --filter: a saved card_filters.<name>
+	assignee  string // --assignee
+	dueBefore string // --due-before
+	dueAfter  string // --due-after
+	overdue   bool   // --overdue
+	recording recordingFilterFlags
+}
+
+// cardListFilter narrows a fetched card list. Zero fields match everything.
+type cardListFilter struct {
+	column     string
+	assigneeID int64
+	dueBefore  string // exclusive YYYY-MM-DD bound
+	dueAfter   string // exclusive YYYY-MM-DD bound
+	incomplete bool   // skip completed cards (--overdue)
+	title      *regexp.Regexp
+	recording  recordingFilter
+}
+
+// savedCardFilter looks up --filter in the config, failing with the saved
+// names when it isn't there.
+func savedCardFilter(cfg *config.Config, name string) (config.CardFilter, error) {
+	if name == "" {
+		return config.CardFilter{}, nil
+	}
+	if f, ok := cfg.CardFilters[name]; ok {
+		return f, nil
+	}
+	hint := "Save one: basecamp config set card_filters." + name + " assignee=me,due_within=7d"
+	if names := cfg.CardFilterNames(); len(names) > 0 {
+		hint = "Saved filters: " + strings.Join(names, ", ")
+	}
+	return config.CardFilter{}, output.ErrUsageHint(fmt.Sprintf("Unknown card filter %q", name), hint)
+}
+
+// buildCardListFilter combines a saved filter with the --assignee,
+// --due-before, and --overdue flags, which take precedence over the saved
+// fields, and the --due-after, --created-by, and --updated-since flags. A
+// saved column is dropped when --column already picked one.
+func buildCardListFilter(ctx context.Context, app *appctx.App, saved config.CardFilter, flags cardsListFilterFlags, hasColumnFlag bool, now time.Time) (cardListFilter, error) {
+	if flags.overdue && flags.dueBefore != "" {
+		return cardListFilter{}, output.ErrUsage("--overdue and --due-before are mutually exclusive")
+	}
+
+	var f cardListFilter
+	if !hasColumnFlag {
+		f.column = saved.Column
+	}
+
+	assignee := saved.Assignee
+	if flags.assignee != "" {
+		assignee = flags.assignee
+	}
+	if assignee != "" {
+		id, err := resolveAssigneeID(ctx, app, assignee)
+		if err != nil {
+			return cardListFilter{}, err
+		}
+		f.assigneeID = id
+	}
+
+	switch {
+	case flags.overdue:
+		f.dueBefore = now.Format("2006-01-02")
+		f.incomplete = true
+	case flags.dueBefore != "":
+		date, err := parseDueFlag(flags.dueBefore)
+		if err != nil {
+			return cardListFilter{}, err
+		}
+		f.dueBefore = date
+	case saved.DueWithin != "":
+		days, err := config.ParseDueWindow(saved.DueWithin)
+		if err != nil {
+			return cardListFilter{}, output.ErrUsage(err.Error())
+		}
+		f.dueBefore = now.AddDate(0, 0, days+1).Format("2006-01-02")
+	}
+
+	if flags.dueAfter != "" {
+		date, err := parseDueFlag(flags.dueAfter)
+		if err != nil {
+			return cardListFilter{}, err
+		}
+		f.dueAfter = date
+		if f.dueBefore != "" && f.dueAfter >= f.dueBefore {
+			return cardListFilter{}, output.ErrUsage(fmt.Sprintf("--due-after %s leaves no dates before %s", f.dueAfter, f.dueBefore))
+		}
+	}
+
+	if saved.Title != "" {
+		re, err := regexp.Compile("(?i)" + saved.Title)
+		if err != nil {
+			return cardListFilter{}, output.ErrUsage(fmt.Sprintf("invalid title pattern %q: %v", saved.Title, err))
+		}
+		f.title = re
+	}
+
+	recording, err := flags.recording.resolve(ctx, app, now)
+	if err != nil {
+		return cardListFilter{}, err
+	}
+	f.recording = recording
+	return f, nil
+}
+
+func (f cardListFilter) active() bool {
+	return f.column != "" || f.assigneeID != 0 || f.dueBefore != "" || f.dueAfter != "" || f.incomplete || f.title != nil || f.recording.active()
+}
+
+func (f cardListFilter) match(card basecamp.Card) bool {
+	if f.column != "" && !cardInColumn(card, f.column) {
+		return false
+	}
+	if f.assigneeID != 0 && !slices.ContainsFunc(card.Assignees, func(p basecamp.Person) bool {
+		return p.ID == f.assigneeID
+	}) {
+		return false
+	}
+	if f.dueBefore != "" && (card.DueOn == "" || card.DueOn >= f.dueBefore) {
+		return false
+	}
+	if f.dueAfter != "" && (card.DueOn == "" || card.DueOn <= f.dueAfter) {
+		return false
+	}
+	if f.incomplete && card.Completed {
+		return false
+	}
+	if f.title != nil && !f.title.MatchString(card.Title) {
+		return false
+	}
+	return f.recording.match(cardRecordingFields(card))
+}
+
+// apply returns the cards that match, in their original order.
+func (f cardListFilter) apply(cards []basecamp.Card) []basecamp.Card {
+	if !f.active() {
+		return cards
+	}
+	kept := make([]basecamp.Card, 0, len(cards))
+	for _, card := range cards {
+		if f.match(card) {
+			kept = append(kept, card)
+		}
+	}
+	return kept
+}
+
+// cardInColumn matches a column by ID or case-insensitive name.
+func cardInColumn(card basecamp.Card, column string) bool {
+	if card.Parent == nil {
+		return false
+	}
+	if id, err := strconv.ParseInt(column, 10, 64); err == nil {
+		return card.Parent.ID == id
+	}
+	return strings.EqualFold(card.Parent.Title, column)
+}
+
+// cardsListSummary reports the card count, noting how many were fetched
+// when a filter dropped some.
+func cardsListSummary(kept, fetched int, filtered bool) string {
+	if !filtered {
+		return fmt.Sprintf("%d cards", kept)
+	}
+	return fmt.Sprintf("%d cards (filtered from %d)", kept, fetched)
+}
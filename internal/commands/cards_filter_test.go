@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// mockCardsListTransport serves a two-column card table for cards list.
+type mockCardsListTransport struct{}
+
+func (mockCardsListTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != "GET" {
+		return nil, errors.New("unexpected request")
+	}
+	var body string
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test Project"}]`
+	case strings.Contains(req.URL.Path, "/projects/123"):
+		body = `{"id": 123, "dock": [{"name": "kanban_board", "id": 555, "title": "Board", "enabled": true}]}`
+	case strings.Contains(req.URL.Path, "/lists/701/cards"):
+		body = `[
+			{"id": 1, "title": "Bug: login loop", "due_on": "` + daysFromNow(2) + `", "parent": {"id": 701, "title": "Doing"}, "assignees": [{"id": 42, "name": "Ann"}], "creator": {"id": 42, "name": "Ann"}, "updated_at": "2025-09-01T10:00:00Z"},
+			{"id": 2, "title": "Write docs", "due_on": "` + daysFromNow(30) + `", "parent": {"id": 701, "title": "Doing"}, "assignees": [{"id": 42, "name": "Ann"}], "creator": {"id": 7, "name": "Bo"}, "updated_at": "2025-09-02T10:00:00Z"}
+		]`
+	case strings.Contains(req.URL.Path, "/lists/702/cards"):
+		body = `[
+			{"id": 3, "title": "bug: crash on save", "due_on": "` + daysFromNow(-1) + `", "parent": {"id": 702, "title": "Triage"}, "assignees": [{"id": 7, "name": "Bo"}], "creator": {"id": 42, "name": "Ann"}, "updated_at": "2025-01-15T10:00:00Z"},
+			{"id": 4, "title": "Bug: no due date", "parent": {"id": 702, "title": "Triage"}, "assignees": [{"id": 42, "name": "Ann"}], "updated_at": "2025-09-03T10:00:00Z"}
+		]`
+	case strings.Contains(req.URL.Path, "/card_tables/555"):
+		body = `{"id": 555, "lists": [{"id": 701, "title": "Doing"}, {"id": 702, "title": "Triage"}]}`
+	default:
+		body = `{}`
+	}
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func daysFromNow(n int) string {
+	return time.Now().AddDate(0, 0, n).Format("2006-01-02")
+}
+
+func runCardsListForIDs(t *testing.T, cfg func(*config.Config), args ...string) ([]int64, error) {
+	t.Helper()
+	app := setupCardsMockApp(t, mockCardsListTransport{})
+	if cfg != nil {
+		cfg(app.Config)
+	}
+	var buf bytes.Buffer
+	app.Output = output.New(output.Options{Format: output.FormatJSON, Writer: &buf})
+
+	if err := executeCommand(NewCardsCmd(), app, append([]string{"list"}, args...)...); err != nil {
+		return nil, err
+	}
+	var env struct {
+		Data []basecamp.Card `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+	ids := make([]int64, 0, len(env.Data))
+	for _, c := range env.Data {
+		ids = append(ids, c.ID)
+	}
+	return ids, nil
+}
+
+func TestCardsListSavedFilter(t *testing.T) {
+	ids, err := runCardsListForIDs(t, func(c *config.Config) {
+		c.CardFilters = map[string]config.CardFilter{
+			"mine-due-soon": {Assignee: "42", DueWithin: "7d"},
+		}
+	}, "--filter", "mine-due-soon")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids, "only Ann's card due within a week")
+}
+
+func TestCardsListSavedFilterColumnAndTitle(t *testing.T) {
+	ids, err := runCardsListForIDs(t, func(c *config.Config) {
+		c.CardFilters = map[string]config.CardFilter{
+			"triage-bugs": {Column: "triage", Title: "^bug:"},
+		}
+	}, "--filter", "triage-bugs")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3, 4}, ids)
+}
+
+func TestCardsListFlagsOverrideSavedFilter(t *testing.T) {
+	ids, err := runCardsListForIDs(t, func(c *config.Config) {
+		c.CardFilters = map[string]config.CardFilter{
+			"mine-due-soon": {Assignee: "42", DueWithin: "7d"},
+		}
+	}, "--filter", "mine-due-soon", "--assignee", "7")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, ids, "overdue counts as due soon")
+
+	ids, err = runCardsListForIDs(t, nil, "--due-before", daysFromNow(2))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, ids, "--due-before is exclusive and skips undated cards")
+}
+
+func TestCardsListUnknownFilter(t *testing.T) {
+	_, err := runCardsListForIDs(t, func(c *config.Config) {
+		c.CardFilters = map[string]config.CardFilter{"bugs": {Title: "bug"}}
+	}, "--filter", "nope")
+
+	var e *output.Error
+	require.True(t, errors.As(err, &e), "expected *output.Error, got %T: %v", err, err)
+	assert.Equal(t, `Unknown card filter "nope"`, e.Message)
+	assert.Contains(t, e.Hint, "bugs")
+}
+
+func TestCardsListCreatedByAndUpdatedSince(t *testing.T) {
+	ids, err := runCardsListForIDs(t, nil, "--created-by", "42")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 3}, ids, "cards Ann created; card 4 has no creator")
+
+	ids, err = runCardsListForIDs(t, nil, "--updated-since", "2025-06-01")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, 4}, ids)
+
+	ids, err = runCardsListForIDs(t, nil, "--created-by", "42", "--updated-since", "2025-06-01")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+}
+
+func TestCardsListDueAfterAndOverdue(t *testing.T) {
+	ids, err := runCardsListForIDs(t, nil, "--due-after", daysFromNow(-1))
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ids, "--due-after is exclusive and skips undated cards")
+
+	ids, err = runCardsListForIDs(t, nil, "--due-after", daysFromNow(0), "--due-before", daysFromNow(7), "--assignee", "42")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1}, ids)
+
+	ids, err = runCardsListForIDs(t, nil, "--overdue")
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3}, ids)
+
+	_, err = runCardsListForIDs(t, nil, "--overdue", "--due-before", daysFromNow(7))
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, "mutually exclusive")
+
+	_, err = runCardsListForIDs(t, nil, "--due-after", daysFromNow(7), "--due-before", daysFromNow(7))
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, "leaves no dates")
+}
@@ -0,0 +1,200 @@
+package commands
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// cardColumnMetrics is one column's flow numbers. Ages are in days: age
+// since the card was created, idle since it last changed.
+type cardColumnMetrics struct {
+	ID          int64   `json:"id"`
+	Title       string  `json:"title"`
+	Count       int     `json:"count"`
+	AvgAgeDays  float64 `json:"avg_age_days"`
+	AvgIdleDays float64 `json:"avg_idle_days"`
+	MaxAgeDays  float64 `json:"max_age_days"`
+}
+
+// cardAge is one card in the oldest-cards list.
+type cardAge struct {
+	ID       int64   `json:"id"`
+	Title    string  `json:"title"`
+	Column   string  `json:"column"`
+	AgeDays  float64 `json:"age_days"`
+	IdleDays float64 `json:"idle_days"`
+	AppURL   string  `json:"app_url,omitempty"`
+}
+
+// cardMetrics is the cards metrics result.
+type cardMetrics struct {
+	CardTableID int64               `json:"card_table_id"`
+	Total       int                 `json:"total"`
+	AvgAgeDays  float64             `json:"avg_age_days"`
+	Columns     []cardColumnMetrics `json:"columns"`
+	Oldest      []cardAge           `json:"oldest"`
+}
+
+func newCardsMetricsCmd(project, cardTable *string) *cobra.Command {
+	var oldest int
+
+	cmd := &cobra.Command{
+		Use:   "metrics",
+		Short: "Card counts and aging per column",
+		Long: `Report flow metrics for a card table: the number of cards in each column,
+their average age (since created) and idle time (since last updated), and
+the oldest cards on the board.
+
+Metrics are computed from every card in the table, so large boards take a
+request per column page.`,
+		Example: `  basecamp cards metrics --in my-project
+  basecamp cards metrics --in my-project --oldest 10 --json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if oldest < 0 {
+				return output.ErrUsage("--oldest must not be negative")
+			}
+
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
+			if err != nil {
+				return err
+			}
+
+			cardTableID, err := getCardTableID(cmd, app, resolvedProjectID, *cardTable)
+			if err != nil {
+				return err
+			}
+			cardTableIDInt, err := strconv.ParseInt(cardTableID, 10, 64)
+			if err != nil {
+				return output.ErrUsage("Invalid card table ID")
+			}
+
+			cardTableData, err := app.Account().CardTables().Get(cmd.Context(), cardTableIDInt)
+			if err != nil {
+				return convertSDKError(err)
+			}
+
+			// Ctrl-C stops the fan-out; metrics cover the columns read so far.
+			columns := cardTableData.Lists
+			cardsByColumn := make(map[int64][]basecamp.Card, len(columns))
+			interrupted := false
+			for i, col := range columns {
+				result, err := app.Account().Cards().List(cmd.Context(), col.ID, &basecamp.CardListOptions{Limit: -1})
+				if err != nil {
+					if cmd.Context().Err() != nil {
+						columns = columns[:i]
+						interrupted = true
+						break
+					}
+					return convertSDKError(err)
+				}
+				cardsByColumn[col.ID] = result.Cards
+			}
+
+			metrics := computeCardMetrics(columns, cardsByColumn, oldest, time.Now())
+			metrics.CardTableID = cardTableIDInt
+
+			summary := fmt.Sprintf("%d %s in %d %s, average age %s",
+				metrics.Total, pluralize(metrics.Total, "card", "cards"),
+				len(metrics.Columns), pluralize(len(metrics.Columns), "column", "columns"),
+				formatDays(metrics.AvgAgeDays))
+			if len(metrics.Oldest) > 0 {
+				o := metrics.Oldest[0]
+				summary += fmt.Sprintf("; oldest #%d %q (%s in %s)", o.ID, o.Title, formatDays(o.AgeDays), o.Column)
+			}
+
+			opts := []output.ResponseOption{
+				output.WithSummary(summary),
+				output.WithDisplayData(metrics.Columns),
+				output.WithBreadcrumbs(
+					output.Breadcrumb{
+						Action:      "list",
+						Cmd:         fmt.Sprintf("basecamp cards list --in %s --card-table %s --column <id> --sort created --reverse", resolvedProjectID, cardTableID),
+						Description: "List a column's cards, oldest first",
+					},
+				),
+			}
+			if interrupted {
+				opts = append(opts, output.WithInterrupted())
+			}
+			return app.OK(metrics, opts...)
+		},
+	}
+
+	cmd.Flags().IntVar(&oldest, "oldest", 5, "Number of oldest cards to report")
+
+	return cmd
+}
+
+// computeCardMetrics works out per-column counts and ages, and the n oldest
+// cards across the table, as of now.
+func computeCardMetrics(columns []basecamp.CardColumn, cardsByColumn map[int64][]basecamp.Card, n int, now time.Time) cardMetrics {
+	metrics := cardMetrics{Columns: []cardColumnMetrics{}, Oldest: []cardAge{}}
+	var all []cardAge
+	var totalAge float64
+	for _, col := range columns {
+		m := cardColumnMetrics{ID: col.ID, Title: col.Title}
+		var ageSum, idleSum float64
+		for _, card := range cardsByColumn[col.ID] {
+			age := daysSince(now, card.CreatedAt)
+			idle := daysSince(now, card.UpdatedAt)
+			ageSum += age
+			idleSum += idle
+			m.MaxAgeDays = math.Max(m.MaxAgeDays, age)
+			m.Count++
+			all = append(all, cardAge{ID: card.ID, Title: card.Title, Column: col.Title, AgeDays: age, IdleDays: idle, AppURL: card.AppURL})
+		}
+		if m.Count > 0 {
+			m.AvgAgeDays = roundDays(ageSum / float64(m.Count))
+			m.AvgIdleDays = roundDays(idleSum / float64(m.Count))
+		}
+		metrics.Total += m.Count
+		totalAge += ageSum
+		metrics.Columns = append(metrics.Columns, m)
+	}
+	if metrics.Total > 0 {
+		metrics.AvgAgeDays = roundDays(totalAge / float64(metrics.Total))
+	}
+
+	sort.SliceStable(all, func(i, j int) bool { return all[i].AgeDays > all[j].AgeDays })
+	if n < len(all) {
+		all = all[:n]
+	}
+	metrics.Oldest = append(metrics.Oldest, all...)
+	return metrics
+}
+
+// daysSince is the days from t to now, to a tenth of a day. A zero or
+// future time counts as no time.
+func daysSince(now, t time.Time) float64 {
+	if t.IsZero() || t.After(now) {
+		return 0
+	}
+	return roundDays(now.Sub(t).Hours() / 24)
+}
+
+func roundDays(d float64) float64 {
+	return math.Round(d*10) / 10
+}
+
+// formatDays renders a day count for summaries ("3.5 days", "1 day").
+func formatDays(d float64) string {
+	if d == 1 {
+		return "1 day"
+	}
+	return strconv.FormatFloat(d, 'f', -1, 64) + " days"
+}
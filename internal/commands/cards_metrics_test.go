@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+func TestComputeCardMetrics(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d float64) time.Time { return now.Add(-time.Duration(d * 24 * float64(time.Hour))) }
+
+	columns := []basecamp.CardColumn{{ID: 1, Title: "Triage"}, {ID: 2, Title: "Doing"}, {ID: 3, Title: "Done"}}
+	cards := map[int64][]basecamp.Card{
+		1: {
+			{ID: 10, Title: "New", CreatedAt: daysAgo(1), UpdatedAt: daysAgo(1)},
+			{ID: 11, Title: "Stale", CreatedAt: daysAgo(30), UpdatedAt: daysAgo(20)},
+		},
+		2: {
+			{ID: 20, Title: "Working", CreatedAt: daysAgo(5), UpdatedAt: daysAgo(0.5)},
+		},
+	}
+
+	m := computeCardMetrics(columns, cards, 2, now)
+
+	assert.Equal(t, 3, m.Total)
+	assert.Equal(t, 12.0, m.AvgAgeDays)
+	require.Len(t, m.Columns, 3)
+	assert.Equal(t, cardColumnMetrics{ID: 1, Title: "Triage", Count: 2, AvgAgeDays: 15.5, AvgIdleDays: 10.5, MaxAgeDays: 30}, m.Columns[0])
+	assert.Equal(t, cardColumnMetrics{ID: 2, Title: "Doing", Count: 1, AvgAgeDays: 5, AvgIdleDays: 0.5, MaxAgeDays: 5}, m.Columns[1])
+	assert.Equal(t, cardColumnMetrics{ID: 3, Title: "Done"}, m.Columns[2], "empty columns are still reported")
+
+	require.Len(t, m.Oldest, 2)
+	assert.Equal(t, int64(11), m.Oldest[0].ID)
+	assert.Equal(t, "Triage", m.Oldest[0].Column)
+	assert.Equal(t, int64(20), m.Oldest[1].ID)
+}
+
+func TestComputeCardMetricsEmpty(t *testing.T) {
+	m := computeCardMetrics(nil, nil, 5, time.Now())
+	assert.Zero(t, m.Total)
+	assert.NotNil(t, m.Columns)
+	assert.NotNil(t, m.Oldest)
+}
+
+// mockCardMetricsTransport serves a card table with two columns of cards.
+type mockCardMetricsTransport struct{}
+
+func (mockCardMetricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	old := time.Now().Add(-10 * 24 * time.Hour).UTC().Format(time.RFC3339)
+	recent := time.Now().Add(-2 * 24 * time.Hour).UTC().Format(time.RFC3339)
+
+	var body string
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test Project"}]`
+	case strings.Contains(req.URL.Path, "/projects/123"):
+		body = `{"id": 123, "dock": [{"name": "kanban_board", "id": 555, "title": "Board"}]}`
+	case strings.HasSuffix(req.URL.Path, "/card_tables/lists/777/cards.json"):
+		body = fmt.Sprintf(`[{"id": 1, "title": "Old card", "created_at": %q, "updated_at": %q}]`, old, recent)
+	case strings.HasSuffix(req.URL.Path, "/card_tables/lists/778/cards.json"):
+		body = `[]`
+	case strings.Contains(req.URL.Path, "/card_tables/555"):
+		body = `{"id": 555, "lists": [{"id": 777, "title": "Doing"}, {"id": 778, "title": "Done"}]}`
+	default:
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestCardsMetrics(t *testing.T) {
+	app, buf := newTestAppWithTransport(t, mockCardMetricsTransport{})
+
+	require.NoError(t, executeCommand(NewCardsCmd(), app, "metrics", "--in", "123"))
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	data := resp.Data.(map[string]any)
+	assert.Equal(t, float64(1), data["total"])
+	assert.Equal(t, float64(555), data["card_table_id"])
+	columns := data["columns"].([]any)
+	require.Len(t, columns, 2)
+	doing := columns[0].(map[string]any)
+	assert.Equal(t, "Doing", doing["title"])
+	assert.Equal(t, float64(10), doing["avg_age_days"])
+	assert.Equal(t, float64(2), doing["avg_idle_days"])
+	assert.Contains(t, resp.Summary, `1 card in 2 columns, average age 10 days; oldest #1 "Old card" (10 days in Doing)`)
+}
+
+func TestCardsMetricsRejectsNegativeOldest(t *testing.T) {
+	app, _ := setupTestApp(t)
+	err := executeCommand(NewCardsCmd(), app, "metrics", "--oldest", "-1", "--in", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--oldest")
+}
+
+// interruptingTransport cancels the command's context (as Ctrl-C does) once
+// it has served the request whose path ends with after.
+type interruptingTransport struct {
+	inner  http.RoundTripper
+	after  string
+	cancel context.CancelFunc
+}
+
+func (t interruptingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+	resp, err := t.inner.RoundTrip(req)
+	if strings.HasSuffix(req.URL.Path, t.after) {
+		t.cancel()
+	}
+	return resp, err
+}
+
+func TestCardsMetricsInterruptedReportsColumnsSoFar(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app, buf := newTestAppWithTransport(t, interruptingTransport{
+		inner:  mockCardMetricsTransport{},
+		after:  "/card_tables/lists/777/cards.json",
+		cancel: cancel,
+	})
+
+	cmd := NewCardsCmd()
+	cmd.SetContext(appctx.WithApp(ctx, app))
+	cmd.SetArgs([]string{"metrics", "--in", "123"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, true, resp.Meta["interrupted"])
+	assert.Equal(t, true, resp.Meta["truncated"])
+	assert.Contains(t, resp.Notice, "Interrupted")
+	columns := resp.Data.(map[string]any)["columns"].([]any)
+	require.Len(t, columns, 1, "only the column read before the interrupt")
+	assert.Equal(t, "Doing", columns[0].(map[string]any)["title"])
+}
+
+func TestCardsMetricsKeepsColumnReadBeforeInterrupt(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app, buf := newTestAppWithTransport(t, interruptingTransport{
+		inner:  mockCardMetricsTransport{},
+		after:  "/card_tables/lists/778/cards.json",
+		cancel: cancel,
+	})
+
+	cmd := NewCardsCmd()
+	cmd.SetContext(appctx.WithApp(ctx, app))
+	cmd.SetArgs([]string{"metrics", "--in", "123"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Nil(t, resp.Meta["interrupted"], "every column was read before Ctrl-C")
+	columns := resp.Data.(map[string]any)["columns"].([]any)
+	assert.Len(t, columns, 2)
+}
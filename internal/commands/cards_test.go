@@ -414,23 +414,24 @@ func TestCardsMoveShowsHelpWithoutTo(t *testing.T) {
 	assert.NoError(t, err)
 }
 
-// TestCardsMoveRequiresCardTable tests that --card-table is required for cards move when using --to with a column name.
-func TestCardsMoveRequiresCardTable(t *testing.T) {
-	app, _ := setupTestApp(t)
-	app.Config.ProjectID = "123"
+// TestCardsMoveDerivesCardTable tests that cards move --to <name> finds the
+// card table and project from the card itself when neither is given.
+func TestCardsMoveDerivesCardTable(t *testing.T) {
+	transport := &mockCardMoveTransport{}
+	app, _ := newTestAppWithTransport(t, transport)
+	app.Config.ProjectID = ""
 
 	project := ""
 	cardTable := "" // empty card table
 	cmd := newCardsMoveCmd(&project, &cardTable)
 
-	// Card ID with --to (column name) but no --card-table
 	err := executeCommand(cmd, app, "456", "--to", "Done")
-	require.NotNil(t, err, "expected error, got nil")
+	require.NoError(t, err)
 
-	var e *output.Error
-	if assert.True(t, errors.As(err, &e), "expected *output.Error, got %T: %v", err, err) {
-		assert.Equal(t, "--card-table is required when --to is a column name", e.Message)
-	}
+	assert.Contains(t, transport.capturedPath, "/card_tables/cards/456/moves.json")
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(transport.capturedBody, &body))
+	assert.Equal(t, float64(777), body["column_id"])
 }
 
 // TestCardsMovePositionWithOnHoldRejected tests that --position and --on-hold cannot be used together.
@@ -741,26 +742,26 @@ func TestCardsMoveWithNumericTo(t *testing.T) {
 	}
 }
 
-// TestCardsMovePartialNumericRequiresCardTable tests that partial numeric strings
-// like "123abc" are NOT treated as numeric IDs and DO require --card-table.
+// TestCardsMovePartialNumericIsColumnName tests that partial numeric strings
+// like "123abc" are NOT treated as numeric IDs but looked up as column names.
 // This prevents incorrect partial matching (e.g., Sscanf matching "123" from "123abc").
-func TestCardsMovePartialNumericRequiresCardTable(t *testing.T) {
-	app, _ := setupTestApp(t)
-	app.Config.ProjectID = "123"
+func TestCardsMovePartialNumericIsColumnName(t *testing.T) {
+	transport := &mockCardMoveTransport{}
+	app, _ := newTestAppWithTransport(t, transport)
 
 	project := ""
 	cardTable := "" // empty - no card table specified
 	cmd := newCardsMoveCmd(&project, &cardTable)
 
-	// "123abc" looks like a number but isn't - should require --card-table
 	err := executeCommand(cmd, app, "456", "--to", "123abc")
 	require.NotNil(t, err, "expected error, got nil")
 
 	var e *output.Error
 	if assert.True(t, errors.As(err, &e), "expected *output.Error, got %T: %v", err, err) {
-		// MUST be the card-table error - partial numeric is NOT a valid ID
-		assert.Equal(t, "--card-table is required when --to is a column name", e.Message)
+		// MUST be a column-name miss - partial numeric is NOT a valid ID
+		assert.Equal(t, "Column '123abc' not found", e.Message)
 	}
+	assert.Empty(t, transport.capturedPath, "nothing is moved")
 }
 
 // TestCardsColumnNameVariations tests various column name formats.
@@ -983,6 +984,10 @@ func (t *mockCardMoveTransport) RoundTrip(req *http.Request) (*http.Response, er
 			body = `{"id": 123, "dock": [{"name": "kanban_board", "id": 555, "title": "Board"}]}`
 		case strings.Contains(req.URL.Path, "/card_tables/555"):
 			body = `{"id": 555, "lists": [{"id": 777, "title": "Done", "position": 1}]}`
+		case strings.Contains(req.URL.Path, "/recordings/456"):
+			body = `{"id": 456, "type": "Kanban::Card", "parent": {"id": 701, "type": "Kanban::Column"}, "bucket": {"id": 123}}`
+		case strings.Contains(req.URL.Path, "/recordings/701"):
+			body = `{"id": 701, "type": "Kanban::Column", "parent": {"id": 555, "type": "Kanban::Board"}, "bucket": {"id": 123}}`
 		default:
 			body = `{}`
 		}
@@ -1143,8 +1148,11 @@ func TestCardsMovePositionRejectsZero(t *testing.T) {
 	}
 }
 
-// mockMultiCardTableTransport returns a project with multiple card tables.
-type mockMultiCardTableTransport struct{}
+// mockMultiCardTableTransport returns a project with multiple card tables,
+// with card 456 in the second one, and captures the move POST.
+type mockMultiCardTableTransport struct {
+	capturedPath string
+}
 
 func (t *mockMultiCardTableTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	header := make(http.Header)
@@ -1160,6 +1168,10 @@ func (t *mockMultiCardTableTransport) RoundTrip(req *http.Request) (*http.Respon
 				`{"name": "kanban_board", "id": 555, "title": "Board A"},` +
 				`{"name": "kanban_board", "id": 666, "title": "Board B"}` +
 				`]}`
+		case strings.Contains(req.URL.Path, "/recordings/456"):
+			body = `{"id": 456, "parent": {"id": 777}, "bucket": {"id": 123}}`
+		case strings.Contains(req.URL.Path, "/recordings/777"):
+			body = `{"id": 777, "parent": {"id": 666}, "bucket": {"id": 123}}`
 		default:
 			body = `{}`
 		}
@@ -1170,13 +1182,22 @@ func (t *mockMultiCardTableTransport) RoundTrip(req *http.Request) (*http.Respon
 		}, nil
 	}
 
+	if req.Method == "POST" {
+		t.capturedPath = req.URL.Path
+		return &http.Response{
+			StatusCode: 204,
+			Body:       io.NopCloser(strings.NewReader("")),
+			Header:     header,
+		}, nil
+	}
+
 	return nil, errors.New("unexpected request")
 }
 
-// TestCardsMovePositionNumericToMultiTableAmbiguous verifies that a positioned move
-// with a numeric --to and no --card-table returns an ambiguous error when the project
-// has multiple card tables.
-func TestCardsMovePositionNumericToMultiTableAmbiguous(t *testing.T) {
+// TestCardsMovePositionNumericToMultiTableUsesCardTable verifies that a
+// positioned move with no --card-table uses the card's own card table when
+// the project has several, instead of reporting them as ambiguous.
+func TestCardsMovePositionNumericToMultiTableUsesCardTable(t *testing.T) {
 	transport := &mockMultiCardTableTransport{}
 	app, _ := newTestAppWithTransport(t, transport)
 
@@ -1185,16 +1206,8 @@ func TestCardsMovePositionNumericToMultiTableAmbiguous(t *testing.T) {
 	cmd := newCardsMoveCmd(&project, &cardTable)
 
 	err := executeCommand(cmd, app, "456", "--to", "777", "--position", "1")
-	require.NotNil(t, err)
-
-	var e *output.Error
-	if assert.True(t, errors.As(err, &e)) {
-		assert.Equal(t, output.CodeAmbiguous, e.Code)
-		assert.Equal(t, "Multiple card tables found", e.Message)
-		assert.Contains(t, e.Hint, "Specify one with --card-table <id>:")
-		assert.Contains(t, e.Hint, "  555  Board A")
-		assert.Contains(t, e.Hint, "  666  Board B")
-	}
+	require.NoError(t, err)
+	assert.Contains(t, transport.capturedPath, "/card_tables/666/moves.json")
 }
 
 func TestGetCardTableIDRejectsPartialNumericExplicitID(t *testing.T) {
@@ -1683,14 +1696,15 @@ func (t *mockCardAssignTransport) RoundTrip(req *http.Request) (*http.Response,
 
 	if req.Method == "GET" {
 		var body string
-		if strings.Contains(req.URL.Path, "/projects.json") {
+		if strings.Contains(req.URL.Path, "/people.json") {
+			// Account and project people alike
+			body = `[{"id": 42, "name": "Annie Bryan"}]`
+		} else if strings.Contains(req.URL.Path, "/projects.json") {
 			body = `[{"id": 123, "name": "Test Project"}]`
 		} else if strings.Contains(req.URL.Path, "/projects/") {
 			body = `{"id": 123, "dock": [{"name": "kanban_board", "id": 789, "title": "Card Table"}]}`
 		} else if strings.Contains(req.URL.Path, "/card_tables/") {
 			body = `{"id": 789, "lists": [{"id": 111, "title": "Backlog"}]}`
-		} else if strings.Contains(req.URL.Path, "/people.json") {
-			body = `[{"id": 42, "name": "Annie Bryan"}]`
 		} else {
 			body = `{}`
 		}
@@ -1942,3 +1956,445 @@ func TestCardsColumnColorURLBucketBeatsFlag(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, tr.mutatePath, "/buckets/123/card_tables/columns/789/color.json")
 }
+
+// mockCardValidationTransport serves project 123's people (only person 42)
+// and column 777 in card table 555 of project 123. Any write fails the test.
+type mockCardValidationTransport struct {
+	writes int
+}
+
+func (t *mockCardValidationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	if req.Method != "GET" {
+		t.writes++
+		return nil, fmt.Errorf("unexpected write: %s %s", req.Method, req.URL.Path)
+	}
+
+	status, body := 200, `{}`
+	switch path := req.URL.Path; {
+	case strings.HasSuffix(path, "/projects/123/people.json"):
+		body = `[{"id": 42, "name": "Annie Bryan"}]`
+	case strings.HasSuffix(path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test Project"}]`
+	case strings.Contains(path, "/card_tables/columns/777"):
+		body = `{"id": 777, "title": "Doing", "parent": {"id": 555, "type": "Kanban::Board"}, "bucket": {"id": 123}}`
+	case strings.Contains(path, "/card_tables/columns/"):
+		status, body = 404, `{"error": "not found"}`
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestCardsStepCreateRejectsInvalidDueBeforeWriting(t *testing.T) {
+	transport := &mockCardValidationTransport{}
+	app := setupCardsMockApp(t, transport)
+
+	project := ""
+	cmd := newCardsStepCreateCmd(&project)
+	err := executeCommand(cmd, app, "Review", "--card", "456", "--due", "the day after never")
+	require.Error(t, err)
+
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeUsage, e.Code)
+	assert.Equal(t, `Invalid due date: "the day after never"`, e.Message)
+	assert.Zero(t, transport.writes)
+}
+
+func TestCardsStepCreateRejectsAssigneeOutsideProject(t *testing.T) {
+	transport := &mockCardValidationTransport{}
+	app := setupCardsMockApp(t, transport)
+
+	project := ""
+	cmd := newCardsStepCreateCmd(&project)
+	err := executeCommand(cmd, app, "Review", "--card", "456", "--assignees", "42,99")
+	require.Error(t, err)
+
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeUsage, e.Code)
+	assert.Equal(t, "Assignee 99 is not on this project", e.Message)
+	assert.Zero(t, transport.writes)
+}
+
+func TestCardsMoveRejectsColumnOutsideCardTable(t *testing.T) {
+	tests := []struct {
+		name      string
+		to        string
+		cardTable string
+		want      string
+	}{
+		{"other card table", "777", "666", "Column 777 is not in card table 666"},
+		{"unknown column", "888", "", "Column 888 not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &mockCardValidationTransport{}
+			app := setupCardsMockApp(t, transport)
+
+			project := ""
+			cardTable := tt.cardTable
+			cmd := newCardsMoveCmd(&project, &cardTable)
+			err := executeCommand(cmd, app, "456", "--to", tt.to)
+			require.Error(t, err)
+
+			var e *output.Error
+			require.True(t, errors.As(err, &e))
+			assert.Equal(t, output.CodeUsage, e.Code)
+			assert.Equal(t, tt.want, e.Message)
+			assert.Zero(t, transport.writes)
+		})
+	}
+}
+
+func TestOverdueSteps(t *testing.T) {
+	steps := []basecamp.CardStep{
+		{ID: 1, Title: "Late", DueOn: "2026-03-01"},
+		{ID: 2, Title: "Late but done", DueOn: "2026-03-01", Completed: true},
+		{ID: 3, Title: "Due today", DueOn: "2026-03-10"},
+		{ID: 4, Title: "No date"},
+	}
+	got := overdueSteps(steps, "2026-03-10")
+	require.Len(t, got, 1)
+	assert.Equal(t, int64(1), got[0].ID)
+}
+
+func TestCardStepsSummary(t *testing.T) {
+	steps := []basecamp.CardStep{{Completed: true}, {Completed: true}, {}, {}, {Completed: true}}
+	assert.Equal(t, "5 steps on card #42 (3 done, 60%)", cardStepsSummary(steps, 5, false, "42"))
+	assert.Equal(t, "1 overdue of 5 steps on card #42 (3 done, 60%)", cardStepsSummary(steps, 1, true, "42"))
+	assert.Equal(t, "0 steps on card #42", cardStepsSummary(nil, 0, false, "42"))
+}
+
+type mockCardStepsTransport struct{}
+
+func (mockCardStepsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	if strings.HasSuffix(req.URL.Path, "/projects.json") {
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`[{"id": 123, "name": "Launch"}]`)), Header: header}, nil
+	}
+	if req.Method != "GET" || !strings.HasSuffix(req.URL.Path, "/card_tables/cards/42") {
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+	}
+	body := `{"id": 42, "title": "Launch", "steps": [
+		{"id": 1, "title": "Draft", "completed": true, "due_on": "2000-01-01"},
+		{"id": 2, "title": "Review", "completed": false, "due_on": "2000-01-02", "assignees": [{"id": 7, "name": "Ana"}]},
+		{"id": 3, "title": "Ship", "completed": false, "due_on": "2999-01-01"}
+	]}`
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestCardsStepsOverdue(t *testing.T) {
+	app := setupCardsMockApp(t, mockCardStepsTransport{})
+	buf := &bytes.Buffer{}
+	app.Output = output.New(output.Options{Format: output.FormatJSON, Writer: buf})
+
+	require.NoError(t, executeCommand(newCardsStepsCmd(new(string)), app, "42", "--overdue"))
+
+	var resp struct {
+		Data    []basecamp.CardStep `json:"data"`
+		Summary string              `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, "Review", resp.Data[0].Title)
+	assert.Equal(t, "1 overdue of 3 steps on card #42 (1 done, 33%)", resp.Summary)
+}
+
+func TestCardsStepsStyledShowsDueAndAssignees(t *testing.T) {
+	app := setupCardsMockApp(t, mockCardStepsTransport{})
+	buf := &bytes.Buffer{}
+	app.Output = output.New(output.Options{Format: output.FormatStyled, Writer: buf})
+
+	require.NoError(t, executeCommand(newCardsStepsCmd(new(string)), app, "42"))
+
+	out := buf.String()
+	assert.Contains(t, out, "Ana")
+	assert.Contains(t, out, "Jan 2, 2000")
+	assert.Contains(t, out, "3 steps on card #42 (1 done, 33%)")
+}
+
+// mockCardCreateColumnTransport serves a card table with a single "Triage"
+// column and records the column create, color, and card create requests.
+type mockCardCreateColumnTransport struct {
+	requests   []string
+	colorBody  string
+	colorFails bool
+}
+
+func (m *mockCardCreateColumnTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	respond := func(status int, body string) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: status,
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Header:     header,
+		}, nil
+	}
+
+	path := req.URL.Path
+	if req.Method != "GET" {
+		m.requests = append(m.requests, req.Method+" "+path)
+	}
+	switch {
+	case req.Method == "GET" && strings.Contains(path, "/projects.json"):
+		return respond(200, `[{"id":123,"name":"Test Project"}]`)
+	case req.Method == "GET" && strings.Contains(path, "/projects/"):
+		return respond(200, `{"id":123,"dock":[{"name":"kanban_board","id":777,"enabled":true}]}`)
+	case req.Method == "GET" && strings.Contains(path, "/card_tables/777"):
+		return respond(200, `{"id":777,"title":"Board","lists":[{"id":100,"title":"Triage"}]}`)
+	case strings.HasSuffix(path, "/color.json"):
+		if req.Body != nil {
+			body, _ := io.ReadAll(req.Body)
+			m.colorBody = string(body)
+		}
+		if m.colorFails {
+			return respond(422, `{"error":"Invalid color"}`)
+		}
+		return respond(200, `{"id":555,"title":"QA","color":"green"}`)
+	case req.Method == "POST" && strings.HasSuffix(path, "/columns.json"):
+		return respond(201, `{"id":555,"title":"QA"}`)
+	case req.Method == "POST" && strings.HasSuffix(path, "/cards.json"):
+		return respond(201, `{"id":999,"title":"Verify fix","status":"active"}`)
+	default:
+		return respond(404, `{"error":"Not Found"}`)
+	}
+}
+
+func TestCardsCreateMissingColumnSuggestsCreateColumn(t *testing.T) {
+	tr := &mockCardCreateColumnTransport{}
+	app := setupCardsMockApp(t, tr)
+
+	err := executeCommand(NewCardsCmd(), app, "create", "Verify fix", "--column", "QA", "--card-table", "777")
+	require.Error(t, err)
+
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, "Column 'QA' not found", e.Message)
+	assert.Contains(t, e.Hint, "--create-column")
+	assert.Empty(t, tr.requests, "nothing should be created on a miss")
+}
+
+func TestCardsCreateCreatesMissingColumn(t *testing.T) {
+	tr := &mockCardCreateColumnTransport{}
+	app := setupCardsMockApp(t, tr)
+
+	err := executeCommand(NewCardsCmd(), app, "create", "Verify fix",
+		"--column", "QA", "--create-column", "--column-color", "green", "--card-table", "777")
+	require.NoError(t, err)
+
+	require.Len(t, tr.requests, 3)
+	assert.Contains(t, tr.requests[0], "POST /99999/card_tables/777/columns.json")
+	assert.Contains(t, tr.requests[1], "/card_tables/columns/555/color.json")
+	assert.Contains(t, tr.colorBody, `"green"`)
+	assert.Contains(t, tr.requests[2], "/card_tables/lists/555/cards.json")
+}
+
+func TestCardsCreateExistingColumnIgnoresCreateColumn(t *testing.T) {
+	tr := &mockCardCreateColumnTransport{}
+	app := setupCardsMockApp(t, tr)
+
+	err := executeCommand(NewCardsCmd(), app, "create", "Verify fix",
+		"--column", "Triage", "--create-column", "--card-table", "777")
+	require.NoError(t, err)
+
+	require.Len(t, tr.requests, 1)
+	assert.Contains(t, tr.requests[0], "/card_tables/lists/100/cards.json")
+}
+
+func TestCardsCreateColumnColorFailureNamesColumn(t *testing.T) {
+	tr := &mockCardCreateColumnTransport{colorFails: true}
+	app := setupCardsMockApp(t, tr)
+
+	err := executeCommand(NewCardsCmd(), app, "create", "Verify fix",
+		"--column", "QA", "--create-column", "--column-color", "plaid", "--card-table", "777")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "column 555 created but setting its color failed")
+	for _, r := range tr.requests {
+		assert.NotContains(t, r, "/cards.json", "no card should be created")
+	}
+}
+
+func TestCardsCreateColumnFlagValidation(t *testing.T) {
+	tests := []struct {
+		name string
+		args []string
+		want string
+	}{
+		{"create-column without column", []string{"--create-column", "--card-table", "777"}, "--create-column requires --column with a column name"},
+		{"create-column with numeric column", []string{"--column", "12345", "--create-column"}, "--create-column requires --column with a column name"},
+		{"color without create-column", []string{"--column", "QA", "--column-color", "green", "--card-table", "777"}, "--column-color requires --create-column"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tr := &mockCardCreateColumnTransport{}
+			app := setupCardsMockApp(t, tr)
+
+			args := append([]string{"create", "Verify fix"}, tt.args...)
+			err := executeCommand(NewCardsCmd(), app, args...)
+			require.Error(t, err)
+			var e *output.Error
+			require.True(t, errors.As(err, &e))
+			assert.Equal(t, tt.want, e.Message)
+			assert.Empty(t, tr.requests)
+		})
+	}
+}
+
+// =============================================================================
+// Cards List Pagination Tests
+// =============================================================================
+
+// mockPaginatedCardsTransport serves a two-column board whose first column
+// spans two pages linked by a Link header.
+type mockPaginatedCardsTransport struct{}
+
+func (mockPaginatedCardsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test Project"}]`
+	case strings.Contains(req.URL.Path, "/projects/123"):
+		body = `{"id": 123, "dock": [{"name": "kanban_board", "id": 555, "title": "Board"}]}`
+	case strings.HasSuffix(req.URL.Path, "/card_tables/lists/777/cards.json"):
+		if req.URL.Query().Get("page") == "2" {
+			body = `[{"id": 3, "title": "Three"}]`
+			break
+		}
+		header.Set("Link", `<https://3.basecampapi.com/99999/card_tables/lists/777/cards.json?page=2>; rel="next"`)
+		body = `[{"id": 1, "title": "One"}, {"id": 2, "title": "Two"}]`
+	case strings.HasSuffix(req.URL.Path, "/card_tables/lists/778/cards.json"):
+		body = `[{"id": 4, "title": "Four"}]`
+	case strings.Contains(req.URL.Path, "/card_tables/555"):
+		body = `{"id": 555, "lists": [{"id": 777, "title": "Doing", "cards_count": 3}, {"id": 778, "title": "Done", "cards_count": 1}]}`
+	default:
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header, Request: req}, nil
+}
+
+func TestCardsListFollowsPaginationAcrossColumns(t *testing.T) {
+	app, buf := newTestAppWithTransport(t, mockPaginatedCardsTransport{})
+
+	require.NoError(t, executeCommand(NewCardsCmd(), app, "list", "--in", "123"))
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	cards := resp.Data.([]any)
+	require.Len(t, cards, 4, "second page of the first column should be fetched")
+	assert.Equal(t, false, resp.Meta["truncated"])
+}
+
+func TestCardsListLimitSpansColumns(t *testing.T) {
+	app, buf := newTestAppWithTransport(t, mockPaginatedCardsTransport{})
+
+	require.NoError(t, executeCommand(NewCardsCmd(), app, "list", "--in", "123", "--limit", "3"))
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	cards := resp.Data.([]any)
+	require.Len(t, cards, 3)
+	assert.Equal(t, true, resp.Meta["truncated"], "the Done column was never fetched")
+}
+
+func TestCardsListInterruptedReturnsColumnsSoFar(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	app, buf := newTestAppWithTransport(t, interruptingTransport{
+		inner:  mockCardMetricsTransport{},
+		after:  "/card_tables/lists/777/cards.json",
+		cancel: cancel,
+	})
+
+	cmd := NewCardsCmd()
+	cmd.SetContext(appctx.WithApp(ctx, app))
+	cmd.SetArgs([]string{"list", "--in", "123"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, true, resp.Meta["interrupted"])
+	assert.Equal(t, true, resp.Meta["truncated"])
+	cards := resp.Data.([]any)
+	require.Len(t, cards, 1, "the Doing column was read before the interrupt")
+	assert.Equal(t, "Old card", cards[0].(map[string]any)["title"])
+}
+
+func TestCardsListPageRequiresColumn(t *testing.T) {
+	app, _ := setupTestApp(t)
+	err := executeCommand(NewCardsCmd(), app, "list", "--in", "123", "--page", "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--page requires --column")
+}
+
+// mockCardAssigneesTransport serves card 999 assigned to people 1 and 2 in
+// project 123 (whose members are 1, 2, and 3) and captures the update PUT.
+type mockCardAssigneesTransport struct {
+	capturedBody []byte
+}
+
+func (t *mockCardAssigneesTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch {
+	case req.Method == "PUT" && strings.Contains(req.URL.Path, "/card_tables/cards/999"):
+		t.capturedBody, _ = io.ReadAll(req.Body)
+		body = `{"id": 999, "title": "Card"}`
+	case strings.Contains(req.URL.Path, "/card_tables/cards/999"):
+		body = `{"id": 999, "title": "Card", "bucket": {"id": 123}, "assignees": [{"id": 1, "name": "Ann"}, {"id": 2, "name": "Bo"}]}`
+	case strings.Contains(req.URL.Path, "/projects/123/people"):
+		body = `[{"id": 1, "name": "Ann"}, {"id": 2, "name": "Bo"}, {"id": 3, "name": "Cy"}]`
+	default:
+		return nil, errors.New("unexpected request: " + req.Method + " " + req.URL.Path)
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func cardsUpdateAssigneeIDs(t *testing.T, args ...string) []any {
+	t.Helper()
+	transport := &mockCardAssigneesTransport{}
+	app := setupCardsMockApp(t, transport)
+
+	err := executeCommand(NewCardsCmd(), app, append([]string{"update", "999"}, args...)...)
+	require.NoError(t, err)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(transport.capturedBody, &body))
+	ids, ok := body["assignee_ids"].([]any)
+	require.True(t, ok, "assignee_ids is sent: %s", transport.capturedBody)
+	return ids
+}
+
+func TestCardsUpdateAdjustsAssignees(t *testing.T) {
+	assert.Equal(t, []any{float64(1), float64(2), float64(3)}, cardsUpdateAssigneeIDs(t, "--add-assignee", "3"))
+	assert.Equal(t, []any{float64(2), float64(3)}, cardsUpdateAssigneeIDs(t, "--add-assignee", "3,2", "--remove-assignee", "1"))
+}
+
+func TestCardsUpdateReplacesAssignees(t *testing.T) {
+	assert.Equal(t, []any{float64(3), float64(1)}, cardsUpdateAssigneeIDs(t, "--assignees", "3,1"))
+	assert.Equal(t, []any{float64(3)}, cardsUpdateAssigneeIDs(t, "--assignee", "3"))
+	assert.Empty(t, cardsUpdateAssigneeIDs(t, "--assignees", ""), "an empty --assignees unassigns everyone")
+}
+
+func TestCardsUpdateAssigneeFlagConflicts(t *testing.T) {
+	app := setupCardsMockApp(t, &mockCardAssigneesTransport{})
+
+	err := executeCommand(NewCardsCmd(), app, "update", "999", "--assignees", "1", "--add-assignee", "3")
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, "replace the assignees")
+
+	err = executeCommand(NewCardsCmd(), app, "update", "999", "--assignee", "1", "--assignees", "3")
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "--assignee and --assignees are mutually exclusive", e.Message)
+}
@@ -12,10 +12,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/chatread"
 	"github.com/basecamp/basecamp-cli/internal/hostutil"
 	"github.com/basecamp/basecamp-cli/internal/output"
 	"github.com/basecamp/basecamp-cli/internal/richtext"
-	"github.com/basecamp/basecamp-cli/internal/tui"
 	"github.com/basecamp/basecamp-cli/internal/urlarg"
 )
 
@@ -33,12 +33,15 @@ func NewChatCmd() *cobra.Command {
 
 Use 'basecamp chat list' to see chats in a project.
 Use 'basecamp chat messages' to view recent messages.
-Use 'basecamp chat post "message"' to post a message.`,
+Use 'basecamp chat post "message"' to post a message.
+Use 'basecamp chat search "query"' to find messages in a room.
+Use 'basecamp chat export' to save the history as a transcript.
+Use 'basecamp chat bots' to list or create the room's chatbots.`,
 		Annotations: map[string]string{"agent_notes": "Projects may have multiple chats — use --room to target a specific one\nContent is sent as plain text by default; use --content-type text/html for rich text\nChat is project-scoped, no cross-project chat queries\n@mentions: prefer [@Name](mention:SGID) for zero API calls, or [@Name](person:ID) for one lookup; @Name/@First.Last for fuzzy matching (auto-promotes to text/html)\nUse --content-type text/plain to bypass mention resolution"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVarP(&chatID, "room", "r", "", "Campfire room ID (for projects with multiple rooms)")
 	cmd.AddCommand(
 		newChatListCmd(&project, &chatID),
@@ -48,6 +51,9 @@ Use 'basecamp chat post "message"' to post a message.`,
 		newChatLineShowCmd(&project, &chatID),
 		newChatLineUpdateCmd(&project, &chatID, &contentType),
 		newChatLineDeleteCmd(&project, &chatID),
+		newChatExportCmd(&project, &chatID),
+		newChatSearchCmd(&project, &chatID),
+		newChatBotsCmd(&project, &chatID),
 	)
 
 	return cmd
@@ -103,21 +109,7 @@ func runChatList(cmd *cobra.Command, app *appctx.App, project, chatID string, al
 	}
 
 	// Resolve project
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -203,42 +195,48 @@ func chatListBreadcrumbs(chatID, projectID string) []output.Breadcrumb {
 
 func newChatMessagesCmd(project, chatID *string) *cobra.Command {
 	var limit int
+	var unread bool
 
 	cmd := &cobra.Command{
 		Use:   "messages",
 		Short: "View recent messages",
-		Long:  "View recent messages from a chat.",
+		Long: `View recent messages from a chat.
+
+The newest message shown is remembered locally as read (shared with the TUI).
+--unread shows only messages posted since then.
+
+--watch tails the chat: it polls every --interval seconds and, after the
+first page, prints only messages that are new or edited.`,
+		Example: `  basecamp chat messages --room <id> --in <project>
+  basecamp chat messages --watch --interval 10 --in <project>`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
 			if err := ensureAccount(cmd, app); err != nil {
 				return err
 			}
-			return runChatMessages(cmd, app, *chatID, *project, limit)
+			return runChatMessages(cmd, app, *chatID, *project, limit, unread)
 		},
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "n", 25, "Number of messages to show")
+	cmd.Flags().BoolVar(&unread, "unread", false, "Only messages since the last one read")
+	addWatchFlags(cmd)
 
 	return cmd
 }
 
-func runChatMessages(cmd *cobra.Command, app *appctx.App, chatID, project string, limit int) error {
-	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+// chatReadStore returns the local chat read-position store, or nil when
+// there is no cache dir to keep it in.
+func chatReadStore(app *appctx.App) *chatread.Store {
+	if app.Config.CacheDir == "" {
+		return nil
 	}
+	return chatread.NewStore(filepath.Join(app.Config.CacheDir, chatread.DirName))
+}
 
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+func runChatMessages(cmd *cobra.Command, app *appctx.App, chatID, project string, limit int, unread bool) error {
+	// Resolve project, with interactive fallback
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -275,8 +273,37 @@ func runChatMessages(cmd *cobra.Command, app *appctx.App, chatID, project string
 	slices.Reverse(lines)
 
 	summary := fmt.Sprintf("%d messages", len(lines))
+	var notice string
+	store := chatReadStore(app)
+	if unread {
+		var lastRead int64
+		if store != nil {
+			lastRead = store.LastRead(app.Config.AccountID, chatIDInt)
+		}
+		fetched := len(lines)
+		if lastRead > 0 {
+			lines = slices.DeleteFunc(lines, func(l basecamp.CampfireLine) bool { return l.ID <= lastRead })
+		}
+		summary = fmt.Sprintf("%d unread messages", len(lines))
+		switch {
+		case lastRead == 0:
+			notice = "No read position for this chat yet; showing the latest messages"
+		case limit > 0 && fetched == limit && len(lines) == fetched:
+			notice = fmt.Sprintf("Showing the newest %d unread messages; older ones may also be unread (raise --limit to see them)", len(lines))
+		}
+	}
 
-	return app.OK(lines,
+	// Remember the newest line shown. Best-effort: a read position that
+	// fails to save only means the same lines show as unread next time.
+	if store != nil {
+		var newest int64
+		for _, l := range lines {
+			newest = max(newest, l.ID)
+		}
+		_ = store.MarkRead(app.Config.AccountID, chatIDInt, newest)
+	}
+
+	respOpts := []output.ResponseOption{
 		output.WithSummary(summary),
 		output.WithEntity("chat_line"),
 		output.WithDisplayData(chatLinesDisplayData(lines)),
@@ -292,7 +319,12 @@ func runChatMessages(cmd *cobra.Command, app *appctx.App, chatID, project string
 				Description: "Load more",
 			},
 		),
-	)
+	}
+	if notice != "" {
+		respOpts = append(respOpts, output.WithNotice(notice))
+	}
+
+	return app.OK(lines, respOpts...)
 }
 
 func newChatPostCmd(project, chatID, contentType *string) *cobra.Command {
@@ -308,7 +340,12 @@ By default, messages are sent as plain text. Use --content-type text/html
 for rich text (HTML) messages.
 
 @mentions (@Name or @First.Last) are resolved automatically and the
-content type is promoted to text/html when mentions are present.`,
+content type is promoted to text/html when mentions are present.
+
+Pass - as the message (or --content -) to read it from stdin. Input longer
+than a chat line is split into several sequential lines, keeping fenced
+code blocks intact in each:
+  make test 2>&1 | basecamp chat post - --room 789`,
 		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
@@ -328,37 +365,32 @@ content type is promoted to text/html when mentions are present.`,
 				return err
 			}
 
-			return runChatPost(cmd, app, *chatID, *project, messageContent, *contentType, attachFiles)
+			chunks := func(post func(string) error) error { return post(messageContent) }
+			if messageContent == "-" {
+				chunks = func(post func(string) error) error {
+					return streamChatChunks(cmd.InOrStdin(), chatLineMaxChars, post)
+				}
+			}
+			return runChatPost(cmd, app, *chatID, *project, chunks, *contentType, attachFiles)
 		},
 	}
 
-	cmd.Flags().StringVar(&content, "content", "", "Message content")
+	cmd.Flags().StringVar(&content, "content", "", "Message content (- reads stdin)")
 	cmd.Flags().StringVar(contentType, "content-type", "", "Content type (text/html for rich text)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
 
 	return cmd
 }
 
-func runChatPost(cmd *cobra.Command, app *appctx.App, chatID, project, content, contentType string, attachFiles []string) error {
+// runChatPost posts each chunk produced by chunks as its own chat line, then
+// any attachments. chunks calls post once for a plain message, or once per
+// filled chunk when streaming stdin.
+func runChatPost(cmd *cobra.Command, app *appctx.App, chatID, project string, chunks func(post func(string) error) error, contentType string, attachFiles []string) error {
 	// Resolve project only when needed (chat ID not provided, or for breadcrumbs)
 	var resolvedProjectID string
 	if chatID == "" {
-		projectID := project
-		if projectID == "" {
-			projectID = app.Flags.Project
-		}
-		if projectID == "" {
-			projectID = app.Config.ProjectID
-		}
-		if projectID == "" {
-			if err := ensureProject(cmd, app); err != nil {
-				return err
-			}
-			projectID = app.Config.ProjectID
-		}
-
 		var err error
-		resolvedProjectID, _, err = app.Names.ResolveProject(cmd.Context(), projectID)
+		resolvedProjectID, err = resolveProjectID(cmd, app, project)
 		if err != nil {
 			return err
 		}
@@ -374,44 +406,42 @@ func runChatPost(cmd *cobra.Command, app *appctx.App, chatID, project, content,
 		return output.ErrUsage("Invalid chat room ID")
 	}
 
-	// Resolve @mentions — skip if user explicitly set a non-HTML content type.
-	// When contentType is unset, convert Markdown to HTML first so the mention
-	// resolver operates on HTML input.
-	var mentionNotice string
-	if contentType == "" || contentType == "text/html" {
-		mentionInput := content
-		if contentType == "" {
-			mentionInput = richtext.MarkdownToHTML(content)
-		}
-		result, resolveErr := resolveMentions(cmd.Context(), app.Names, mentionInput)
-		if resolveErr != nil {
-			return resolveErr
-		}
-		if result.HTML != mentionInput || len(result.Unresolved) > 0 {
-			content = result.HTML
-			if contentType == "" {
-				contentType = "text/html"
-			}
-		}
-		mentionNotice = unresolvedMentionWarning(result.Unresolved)
-	}
-
-	// Post message using SDK
-	var line *basecamp.CampfireLine
+	// Post message lines using SDK
+	var lines []*basecamp.CampfireLine
+	var unresolved []string
 	var uploadIDs []int64
 
-	// Post text message if there's content
-	if content != "" {
-		var opts *basecamp.CreateLineOptions
-		if contentType != "" {
-			opts = &basecamp.CreateLineOptions{ContentType: contentType}
+	err = chunks(func(content string) error {
+		// Post text message if there's content
+		if content == "" {
+			return nil
 		}
-		var err error
-		line, err = app.Account().Campfires().CreateLine(cmd.Context(), chatIDInt, content, opts)
+		line, missing, err := postChatLine(cmd, app, chatIDInt, content, contentType)
 		if err != nil {
-			return convertSDKError(err)
+			return err
+		}
+		lines = append(lines, line)
+		for _, name := range missing {
+			if !slices.Contains(unresolved, name) {
+				unresolved = append(unresolved, name)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if len(lines) > 0 {
+			if outErr, ok := err.(*output.Error); ok {
+				outErr.Message = fmt.Sprintf("after posting %d message(s): %s", len(lines), outErr.Message)
+				return outErr
+			}
+			return fmt.Errorf("after posting %d message(s): %w", len(lines), err)
 		}
+		return err
+	}
+	if len(lines) == 0 && len(attachFiles) == 0 {
+		return output.ErrUsage("No message content to post")
 	}
+	mentionNotice := unresolvedMentionWarning(unresolved)
 
 	// Upload attachments using CreateUpload
 	for _, filePath := range attachFiles {
@@ -441,9 +471,18 @@ func runChatPost(cmd *cobra.Command, app *appctx.App, chatID, project, content,
 		uploadIDs = append(uploadIDs, uploadLine.ID)
 	}
 
+	var line *basecamp.CampfireLine
+	if len(lines) == 1 {
+		line = lines[0]
+	}
+
 	// Build summary
 	var summary string
-	if line != nil && len(uploadIDs) > 0 {
+	if len(lines) > 1 && len(uploadIDs) > 0 {
+		summary = fmt.Sprintf("Posted %d messages with %d attachment(s)", len(lines), len(uploadIDs))
+	} else if len(lines) > 1 {
+		summary = fmt.Sprintf("Posted %d messages (#%d–#%d)", len(lines), lines[0].ID, lines[len(lines)-1].ID)
+	} else if line != nil && len(uploadIDs) > 0 {
 		summary = fmt.Sprintf("Posted message #%d with %d attachment(s)", line.ID, len(uploadIDs))
 	} else if line != nil {
 		summary = fmt.Sprintf("Posted message #%d", line.ID)
@@ -498,17 +537,60 @@ func runChatPost(cmd *cobra.Command, app *appctx.App, chatID, project, content,
 		return app.OK(line, respOpts...)
 	}
 
-	// Uploads involved: return composite result
+	// Split message or uploads involved: return composite result
 	result := map[string]any{}
 	if line != nil {
 		result["message_id"] = line.ID
 	}
+	if len(lines) > 1 {
+		messageIDs := make([]int64, len(lines))
+		for i, l := range lines {
+			messageIDs[i] = l.ID
+		}
+		result["message_ids"] = messageIDs
+	}
 	if len(uploadIDs) > 0 {
 		result["upload_ids"] = uploadIDs
 	}
 	return app.OK(result, respOpts...)
 }
 
+// postChatLine resolves @mentions in content and posts it as one chat line,
+// returning the mentions that couldn't be resolved. Resolution is skipped if
+// the user explicitly set a non-HTML content type. When contentType is unset,
+// Markdown is converted to HTML first so the mention resolver operates on
+// HTML input.
+func postChatLine(cmd *cobra.Command, app *appctx.App, chatID int64, content, contentType string) (*basecamp.CampfireLine, []string, error) {
+	var unresolved []string
+	if contentType == "" || contentType == "text/html" {
+		mentionInput := content
+		if contentType == "" {
+			mentionInput = richtext.MarkdownToHTML(content)
+		}
+		result, resolveErr := resolveMentions(cmd.Context(), app.Names, mentionInput)
+		if resolveErr != nil {
+			return nil, nil, resolveErr
+		}
+		if result.HTML != mentionInput || len(result.Unresolved) > 0 {
+			content = result.HTML
+			if contentType == "" {
+				contentType = "text/html"
+			}
+		}
+		unresolved = result.Unresolved
+	}
+
+	var opts *basecamp.CreateLineOptions
+	if contentType != "" {
+		opts = &basecamp.CreateLineOptions{ContentType: contentType}
+	}
+	line, err := app.Account().Campfires().CreateLine(cmd.Context(), chatID, content, opts)
+	if err != nil {
+		return nil, nil, convertSDKError(err)
+	}
+	return line, unresolved, nil
+}
+
 func newChatUploadCmd(project, chatID *string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upload <file>",
@@ -539,22 +621,8 @@ func runChatUpload(cmd *cobra.Command, app *appctx.App, chatID, project, filePat
 	// Resolve project — required when chat ID not provided, optional for breadcrumbs
 	var resolvedProjectID string
 	if chatID == "" {
-		projectID := project
-		if projectID == "" {
-			projectID = app.Flags.Project
-		}
-		if projectID == "" {
-			projectID = app.Config.ProjectID
-		}
-		if projectID == "" {
-			if err := ensureProject(cmd, app); err != nil {
-				return err
-			}
-			projectID = app.Config.ProjectID
-		}
-
 		var err error
-		resolvedProjectID, _, err = app.Names.ResolveProject(cmd.Context(), projectID)
+		resolvedProjectID, err = resolveProjectID(cmd, app, project)
 		if err != nil {
 			return err
 		}
@@ -653,23 +721,10 @@ You can pass either a line ID or a Basecamp line URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -846,13 +901,7 @@ edit to rich text.`,
 			if effectiveChatID == "" {
 				// No room in hand — resolve a project (prompting as a last resort)
 				// so we can discover the project's default chat.
-				if projectHint == "" {
-					if err = ensureProject(cmd, app); err != nil {
-						return err
-					}
-					projectHint = app.Config.ProjectID
-				}
-				resolvedProjectID, _, err = app.Names.ResolveProject(cmd.Context(), projectHint)
+				resolvedProjectID, err = resolveProjectID(cmd, app, projectHint)
 				if err != nil {
 					return err
 				}
@@ -989,23 +1038,10 @@ You can pass either a line ID or a Basecamp line URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
 			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -1028,15 +1064,8 @@ You can pass either a line ID or a Basecamp line URL:
 				return output.ErrUsage("Invalid line ID")
 			}
 
-			// Confirm destructive action in interactive mode
-			if !force && !isNonInteractiveCommand(cmd) {
-				confirmed, err := tui.ConfirmDangerous("Permanently delete this chat line?")
-				if err != nil {
-					return nil //nolint:nilerr // user canceled prompt
-				}
-				if !confirmed {
-					return nil
-				}
+			if !confirmAction(cmd, confirmPermanent, "Permanently delete this chat line?", force) {
+				return nil
 			}
 
 			// Delete line using SDK
@@ -0,0 +1,189 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// Chatbots ("integrations") post lines under their own name and avatar
+// instead of a person's, so automation stands apart in the room and never
+// pings anyone as a user. Each bot has a secret key embedded in its lines
+// URL, which an outside integration posts with. The CLI only manages bots:
+// the SDK has no wrapper for posting a bot line, and chatbot-key auth is out
+// of scope (see API-COVERAGE.md).
+
+var chatbotKeyRe = regexp.MustCompile(`/integrations/([^/]+)/`)
+
+// chatbotInfo is a chatbot with the key needed to post as it.
+type chatbotInfo struct {
+	basecamp.Chatbot
+	Key string `json:"key,omitempty"`
+}
+
+func newChatbotInfo(bot basecamp.Chatbot) chatbotInfo {
+	info := chatbotInfo{Chatbot: bot}
+	if m := chatbotKeyRe.FindStringSubmatch(bot.LinesURL); m != nil {
+		info.Key = m[1]
+	}
+	return info
+}
+
+func newChatBotsCmd(project, chatID *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bots",
+		Short: "Manage chatbots",
+		Long: `Manage chatbots in a chat room.
+
+A chatbot posts under its own name, so automated messages stand apart from
+people's and never notify as a user. An outside integration posts as the
+bot with the key shown by 'basecamp chat bots list'; the CLI doesn't post
+as a bot itself.`,
+	}
+
+	cmd.AddCommand(
+		newChatBotsListCmd(project, chatID),
+		newChatBotsCreateCmd(project, chatID),
+	)
+
+	return cmd
+}
+
+func newChatBotsListCmd(project, chatID *string) *cobra.Command {
+	return &cobra.Command{
+		Use:     "list",
+		Short:   "List chatbots",
+		Long:    "List the chatbots in a chat room, with the key each one posts with.",
+		Example: `  basecamp chat bots list --in my-project`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+
+			projectID, room, err := resolveChatRoom(cmd, app, *project, *chatID)
+			if err != nil {
+				return err
+			}
+
+			result, err := app.Account().Campfires().ListChatbots(cmd.Context(), room, nil)
+			if err != nil {
+				return convertSDKError(err)
+			}
+			bots := make([]chatbotInfo, 0, len(result.Chatbots))
+			for _, bot := range result.Chatbots {
+				bots = append(bots, newChatbotInfo(bot))
+			}
+
+			summary := fmt.Sprintf("%d chatbots", len(bots))
+			if len(bots) == 1 {
+				summary = "1 chatbot"
+			}
+			return app.OK(bots,
+				output.WithSummary(summary),
+				output.WithBreadcrumbs(output.Breadcrumb{
+					Action:      "create",
+					Cmd:         "basecamp chat bots create <name>" + chatRoomFlags(room, projectID),
+					Description: "Create a chatbot",
+				}),
+			)
+		},
+	}
+}
+
+func newChatBotsCreateCmd(project, chatID *string) *cobra.Command {
+	var commandURL string
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a chatbot",
+		Long: `Create a chatbot in a chat room.
+
+The name is what the bot posts as and how people address it; it can't
+contain spaces or punctuation. Give --command-url to have Basecamp call an
+HTTPS endpoint when someone addresses the bot.`,
+		Example: `  basecamp chat bots create Deploybot --in my-project
+  basecamp chat bots create Oncall --command-url https://example.com/oncall --room 789`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if len(args) == 0 {
+				return missingArg(cmd, "<name>")
+			}
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+
+			projectID, room, err := resolveChatRoom(cmd, app, *project, *chatID)
+			if err != nil {
+				return err
+			}
+
+			bot, err := app.Account().Campfires().CreateChatbot(cmd.Context(), room, &basecamp.CreateChatbotRequest{
+				ServiceName: args[0],
+				CommandURL:  commandURL,
+			})
+			if err != nil {
+				return convertSDKError(err)
+			}
+			info := newChatbotInfo(*bot)
+
+			return app.OK(info,
+				output.WithSummary(fmt.Sprintf("Created chatbot %s (#%d)", info.ServiceName, info.ID)),
+				output.WithBreadcrumbs(output.Breadcrumb{
+					Action:      "list",
+					Cmd:         "basecamp chat bots list" + chatRoomFlags(room, projectID),
+					Description: "List chatbots and their keys",
+				}),
+			)
+		},
+	}
+
+	cmd.Flags().StringVar(&commandURL, "command-url", "", "HTTPS URL Basecamp calls when the bot is addressed")
+
+	return cmd
+}
+
+// resolveChatRoom returns the chat room to act on: the --room ID when given,
+// otherwise the project's chat. The project ID is "" when only --room was
+// given and no project was named.
+func resolveChatRoom(cmd *cobra.Command, app *appctx.App, project, chatID string) (string, int64, error) {
+	var resolvedProjectID string
+	if chatID == "" || project != "" {
+		var err error
+		resolvedProjectID, err = resolveProjectID(cmd, app, project)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	if chatID == "" {
+		var err error
+		chatID, err = getChatID(cmd, app, resolvedProjectID)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	room, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return "", 0, output.ErrUsage("Invalid chat room ID")
+	}
+	return resolvedProjectID, room, nil
+}
+
+// chatRoomFlags renders the --room and --in flags for breadcrumbs.
+func chatRoomFlags(room int64, projectID string) string {
+	flags := fmt.Sprintf(" --room %d", room)
+	if projectID != "" {
+		flags += " --in " + projectID
+	}
+	return flags
+}
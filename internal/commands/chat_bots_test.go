@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+const testChatbotJSON = `{"id": 1049715958, "service_name": "Deploybot",
+	"url": "https://3.basecampapi.com/99999/buckets/123/chats/789/integrations/1049715958.json",
+	"lines_url": "https://3.basecampapi.com/99999/integrations/B5JQYvHsNWCoDvYGZfH1xNR9/buckets/123/chats/789/lines"}`
+
+// mockChatbotTransport serves a project with one chat room and its chatbots,
+// recording the write requests.
+type mockChatbotTransport struct {
+	posts  []*http.Request
+	bodies []map[string]any
+}
+
+func (t *mockChatbotTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	respond := func(status int, body string) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+	}
+
+	switch req.Method {
+	case "GET":
+		switch {
+		case strings.Contains(req.URL.Path, "/projects.json"):
+			return respond(200, `[{"id": 123, "name": "Test Project"}]`)
+		case strings.Contains(req.URL.Path, "/projects/"):
+			return respond(200, `{"id": 123, "dock": [{"name": "chat", "id": 789, "enabled": true}]}`)
+		case strings.HasSuffix(req.URL.Path, "/chats/789/integrations.json"):
+			return respond(200, "["+testChatbotJSON+"]")
+		}
+		return respond(200, `{}`)
+	case "POST":
+		var body map[string]any
+		if req.Body != nil {
+			data, _ := io.ReadAll(req.Body)
+			req.Body.Close()
+			_ = json.Unmarshal(data, &body)
+		}
+		t.posts = append(t.posts, req)
+		t.bodies = append(t.bodies, body)
+		return respond(201, testChatbotJSON)
+	}
+	return nil, errors.New("unexpected request")
+}
+
+func TestChatBotsListIncludesKey(t *testing.T) {
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	app, buf := newChatDeleteTestApp(&mockChatbotTransport{})
+	require.NoError(t, executeChatCommand(NewChatCmd(), app, "bots", "list", "--in", "123"))
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	bots, ok := resp.Data.([]any)
+	require.True(t, ok, "data should be a list: %s", buf.String())
+	require.Len(t, bots, 1)
+	bot := bots[0].(map[string]any)
+	assert.Equal(t, "Deploybot", bot["service_name"])
+	assert.Equal(t, "B5JQYvHsNWCoDvYGZfH1xNR9", bot["key"])
+	assert.Equal(t, "1 chatbot", resp.Summary)
+}
+
+func TestChatBotsCreate(t *testing.T) {
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	transport := &mockChatbotTransport{}
+	app, buf := newChatDeleteTestApp(transport)
+	app.Flags.Hints = true
+	require.NoError(t, executeChatCommand(NewChatCmd(), app, "bots", "create", "Deploybot",
+		"--command-url", "https://example.com/deploy", "--in", "123"))
+
+	require.Len(t, transport.posts, 1)
+	assert.True(t, strings.HasSuffix(transport.posts[0].URL.Path, "/chats/789/integrations.json"), transport.posts[0].URL.Path)
+	assert.Equal(t, "Deploybot", transport.bodies[0]["service_name"])
+	assert.Equal(t, "https://example.com/deploy", transport.bodies[0]["command_url"])
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "B5JQYvHsNWCoDvYGZfH1xNR9", resp.Data.(map[string]any)["key"])
+	require.NotEmpty(t, resp.Breadcrumbs)
+	assert.Equal(t, "basecamp chat bots list --room 789 --in 123", resp.Breadcrumbs[0].Cmd)
+}
@@ -0,0 +1,167 @@
+package commands
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// chatLineMaxChars caps a single chat line posted from stdin. Longer input
+// is split into several sequential lines so piped command output reads as a
+// run of messages instead of failing or landing as one unreadable wall.
+const chatLineMaxChars = 4000
+
+// chatChunker groups lines of text into chunks of at most max characters,
+// breaking only between lines where it can. A chunk that ends inside a
+// fenced code block gets a closing fence, and the next chunk reopens it with
+// the same info string, so every posted line renders on its own.
+type chatChunker struct {
+	max   int
+	lines []string
+	size  int
+
+	fence       string // opening fence line while inside a code block
+	fenceMarker string // the ``` or ~~~ run that closes it
+}
+
+func newChatChunker(max int) *chatChunker {
+	return &chatChunker{max: max}
+}
+
+// Add appends one line (without its trailing newline) and returns any chunks
+// that filled up as a result.
+func (c *chatChunker) Add(line string) []string {
+	var chunks []string
+	for _, piece := range c.splitLong(line) {
+		if len(c.lines) > 0 && c.size+c.cost(piece)+c.closeCost() > c.max {
+			if chunk := c.cut(); chunk != "" {
+				chunks = append(chunks, chunk)
+			}
+		}
+		c.lines = append(c.lines, piece)
+		c.size += c.cost(piece)
+		c.trackFence(piece)
+	}
+	return chunks
+}
+
+// Flush returns whatever is buffered as a final chunk, or "" when there is
+// nothing worth posting.
+func (c *chatChunker) Flush() string {
+	chunk := strings.TrimRight(strings.Join(c.lines, "\n"), "\n")
+	c.lines, c.size = nil, 0
+	if strings.TrimSpace(chunk) == "" || chunk == c.fence {
+		return ""
+	}
+	return chunk
+}
+
+// cut closes the buffered chunk and starts the next one, reopening an
+// unterminated code fence on both sides of the break.
+func (c *chatChunker) cut() string {
+	lines := c.lines
+	if c.fence != "" {
+		lines = append(lines, c.fenceMarker)
+	}
+	chunk := strings.TrimRight(strings.Join(lines, "\n"), "\n")
+	if strings.TrimSpace(chunk) == "" {
+		chunk = ""
+	}
+
+	c.lines, c.size = nil, 0
+	if c.fence != "" {
+		c.lines = append(c.lines, c.fence)
+		c.size = c.cost(c.fence)
+	}
+	return chunk
+}
+
+func (c *chatChunker) cost(line string) int {
+	return utf8.RuneCountInString(line) + 1
+}
+
+// closeCost is the room a chunk must keep free for a closing fence.
+func (c *chatChunker) closeCost() int {
+	if c.fence == "" {
+		return 0
+	}
+	return c.cost(c.fenceMarker)
+}
+
+// splitLong breaks a line that could never fit in one chunk, leaving room
+// for a reopened and closed fence around each piece.
+func (c *chatChunker) splitLong(line string) []string {
+	limit := c.max - 1
+	if c.fence != "" {
+		limit -= c.cost(c.fence) + c.cost(c.fenceMarker)
+	}
+	if limit < 1 {
+		limit = 1
+	}
+	if utf8.RuneCountInString(line) <= limit {
+		return []string{line}
+	}
+
+	var pieces []string
+	runes := []rune(line)
+	for len(runes) > limit {
+		pieces = append(pieces, string(runes[:limit]))
+		runes = runes[limit:]
+	}
+	return append(pieces, string(runes))
+}
+
+func (c *chatChunker) trackFence(line string) {
+	trimmed := strings.TrimSpace(line)
+	if c.fence != "" {
+		if strings.HasPrefix(trimmed, c.fenceMarker) && strings.Trim(trimmed, c.fenceMarker[:1]) == "" {
+			c.fence, c.fenceMarker = "", ""
+		}
+		return
+	}
+	if marker := fenceMarker(trimmed); marker != "" {
+		c.fence, c.fenceMarker = line, marker
+	}
+}
+
+// fenceMarker returns the run of backticks or tildes opening a fenced code
+// block, or "" when line doesn't open one.
+func fenceMarker(line string) string {
+	for _, ch := range []string{"`", "~"} {
+		n := len(line) - len(strings.TrimLeft(line, ch))
+		if n >= 3 {
+			return strings.Repeat(ch, n)
+		}
+	}
+	return ""
+}
+
+// streamChatChunks reads r line by line and calls post with each chunk as
+// soon as it fills, so long-running pipes are posted while they are still
+// producing output.
+func streamChatChunks(r io.Reader, max int, post func(string) error) error {
+	chunker := newChatChunker(max)
+	reader := bufio.NewReader(r)
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil && !errors.Is(err, io.EOF) {
+			return err
+		}
+		if line != "" || err == nil {
+			for _, chunk := range chunker.Add(strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")) {
+				if postErr := post(chunk); postErr != nil {
+					return postErr
+				}
+			}
+		}
+		if err != nil {
+			break
+		}
+	}
+	if chunk := chunker.Flush(); chunk != "" {
+		return post(chunk)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func collectChatChunks(t *testing.T, input string, max int) []string {
+	t.Helper()
+	var chunks []string
+	err := streamChatChunks(strings.NewReader(input), max, func(chunk string) error {
+		chunks = append(chunks, chunk)
+		return nil
+	})
+	require.NoError(t, err)
+	return chunks
+}
+
+func TestStreamChatChunksShortInputIsOneChunk(t *testing.T) {
+	assert.Equal(t, []string{"hello\nworld"}, collectChatChunks(t, "hello\nworld\n", 100))
+	assert.Empty(t, collectChatChunks(t, "\n  \n", 100))
+}
+
+func TestStreamChatChunksBreaksBetweenLines(t *testing.T) {
+	chunks := collectChatChunks(t, "aaaa\nbbbb\ncccc\ndddd\n", 10)
+	assert.Equal(t, []string{"aaaa\nbbbb", "cccc\ndddd"}, chunks)
+}
+
+func TestStreamChatChunksSplitsLongLines(t *testing.T) {
+	chunks := collectChatChunks(t, strings.Repeat("é", 25), 10)
+	require.Len(t, chunks, 3)
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, len([]rune(chunk)), 10)
+	}
+	assert.Equal(t, strings.Repeat("é", 25), strings.Join(chunks, ""))
+}
+
+func TestStreamChatChunksReopensCodeFences(t *testing.T) {
+	input := "Build log:\n```text\none\ntwo\nthree\nfour\n```\ndone\n"
+	chunks := collectChatChunks(t, input, 24)
+
+	require.Greater(t, len(chunks), 1)
+	for _, chunk := range chunks {
+		assert.LessOrEqual(t, len(chunk), 24, "chunk %q", chunk)
+		assert.Equal(t, 0, strings.Count(chunk, "```")%2, "unbalanced fence in %q", chunk)
+	}
+	assert.True(t, strings.HasPrefix(chunks[1], "```text\n"), "continuation reopens the fence with its info string")
+	assert.Equal(t, "done", chunks[len(chunks)-1][len(chunks[len(chunks)-1])-4:])
+}
@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/auth"
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/names"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// newChatExportTestApp serves a two-page chat history: an old plain-text
+// line on the last page, and recent rich-text and upload lines on the first.
+func newChatExportTestApp(t *testing.T) (*appctx.App, *bytes.Buffer, *int) {
+	t.Helper()
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	recent := time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+	pages := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/lines.json"):
+			pages++
+			if r.URL.Query().Get("page") == "2" {
+				fmt.Fprint(w, `[{"id": 1, "content": "ancient history", "created_at": "2020-01-01T09:00:00Z", "creator": {"id": 7, "name": "Ada"}}]`)
+				return
+			}
+			w.Header().Set("Link", fmt.Sprintf(`<http://%s%s?page=2>; rel="next"`, r.Host, r.URL.Path))
+			fmt.Fprintf(w, `[
+				{"id": 2, "content": "<div>Ship it <strong>today</strong></div>", "created_at": %q, "creator": {"id": 8, "name": "Grace"}},
+				{"id": 3, "title": "plan.pdf", "created_at": %q, "creator": {"id": 7, "name": "Ada"},
+				 "attachments": [{"filename": "plan.pdf", "byte_size": 2048, "download_url": "https://example.com/plan.pdf"}]}
+			]`, recent, recent)
+		case strings.HasSuffix(r.URL.Path, "/chats/789"):
+			fmt.Fprint(w, `{"id": 789, "title": "Campfire", "bucket": {"id": 123, "name": "Launch"}}`)
+		case strings.Contains(r.URL.Path, "/projects.json"):
+			fmt.Fprint(w, `[{"id": 123, "name": "Launch"}]`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	buf := &bytes.Buffer{}
+	cfg := &config.Config{AccountID: "99999", ProjectID: "123"}
+	sdkClient := basecamp.NewClient(&basecamp.Config{BaseURL: server.URL}, &chatTestTokenProvider{},
+		basecamp.WithMaxRetries(1),
+	)
+	authMgr := auth.NewManager(cfg, nil)
+	app := &appctx.App{
+		Config: cfg,
+		Auth:   authMgr,
+		SDK:    sdkClient,
+		Names:  names.NewResolver(sdkClient, authMgr, cfg.AccountID),
+		Output: output.New(output.Options{Format: output.FormatJSON, Writer: buf}),
+	}
+	return app, buf, &pages
+}
+
+func TestChatExportMarkdownFollowsAllPages(t *testing.T) {
+	app, _, pages := newChatExportTestApp(t)
+
+	cmd := NewChatCmd()
+	var out bytes.Buffer
+	cmd.SetArgs([]string{"export", "--room", "789"})
+	cmd.SetContext(appctx.WithApp(context.Background(), app))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	transcript := out.String()
+	assert.Equal(t, 2, *pages, "every page must be fetched")
+	assert.True(t, strings.HasPrefix(transcript, "# Campfire — Launch\n\n3 messages\n"))
+	assert.Contains(t, transcript, "**Ada** · ")
+	assert.Contains(t, transcript, "ancient history")
+	assert.Contains(t, transcript, "Ship it **today**")
+	assert.Contains(t, transcript, "📎 [plan.pdf](https://example.com/plan.pdf) (2.0kb)")
+	assert.Less(t, strings.Index(transcript, "ancient history"), strings.Index(transcript, "Ship it"),
+		"messages must be oldest first")
+}
+
+func TestChatExportSinceWritesFile(t *testing.T) {
+	app, buf, _ := newChatExportTestApp(t)
+	path := filepath.Join(t.TempDir(), "transcript.html")
+
+	cmd := NewChatCmd()
+	require.NoError(t, executeChatCommand(cmd, app, "export", "--room", "789", "--since", "30d", "--out", path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	transcript := string(data)
+	assert.True(t, strings.HasPrefix(transcript, "<!DOCTYPE html>"), "format follows the .html extension")
+	assert.NotContains(t, transcript, "ancient history")
+	assert.Contains(t, transcript, "<strong>Grace</strong>")
+	assert.Contains(t, transcript, `href="https://example.com/plan.pdf"`)
+
+	var resp struct {
+		Summary string `json:"summary"`
+		Data    struct {
+			Format   string `json:"format"`
+			Messages int    `json:"messages"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "html", resp.Data.Format)
+	assert.Equal(t, 2, resp.Data.Messages)
+	assert.Equal(t, "Exported 2 messages to "+path, resp.Summary)
+}
+
+func TestChatExportRejectsUnknownFormat(t *testing.T) {
+	app, _, _ := newChatExportTestApp(t)
+
+	cmd := NewChatCmd()
+	err := executeChatCommand(cmd, app, "export", "--room", "789", "--format", "pdf")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Unsupported format: "pdf"`)
+}
@@ -0,0 +1,160 @@
+package commands
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+func newChatSearchCmd(project, chatID *string) *cobra.Command {
+	var useRegex bool
+	var since string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "search <query>",
+		Short: "Search messages in a chat room",
+		Long: `Search a chat room's messages and show the matching lines with their
+author and timestamp, newest first.
+
+The room's history is paged through and each message is matched as text
+(rich text converted to markdown, attachment names included). The query
+matches case-insensitively as plain text; with --regex it is a regular
+expression instead (RE2 syntax, case-sensitive unless it starts with (?i)).
+--since limits the search to a relative window (24h, 3d, 1w, 2m) or a date.
+
+  basecamp chat search "deploy" --in MyProject
+  basecamp chat search "v[0-9]+\.[0-9]+" --regex --in MyProject --room 456`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+			return runChatSearch(cmd, app, *chatID, *project, args[0], useRegex, since, limit)
+		},
+	}
+
+	cmd.Flags().BoolVar(&useRegex, "regex", false, "Treat the query as a regular expression")
+	cmd.Flags().StringVar(&since, "since", "", "Only search messages since this window or date (default: all)")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 50, "Maximum number of matches to show (0 = all)")
+
+	return cmd
+}
+
+func runChatSearch(cmd *cobra.Command, app *appctx.App, chatID, project, query string, useRegex bool, sinceArg string, limit int) error {
+	if strings.TrimSpace(query) == "" {
+		return output.ErrUsage("Search query cannot be empty")
+	}
+	if limit < 0 {
+		return output.ErrUsage("--limit must not be negative")
+	}
+	match, err := chatSearchMatcher(query, useRegex)
+	if err != nil {
+		return err
+	}
+
+	var since time.Time
+	if sinceArg != "" {
+		if since, err = parseActivitySince(sinceArg, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return err
+	}
+	if chatID == "" {
+		chatID, err = getChatID(cmd, app, resolvedProjectID)
+		if err != nil {
+			return err
+		}
+	}
+	chatIDInt, err := strconv.ParseInt(chatID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid chat room ID")
+	}
+
+	// The search API can't be scoped to one room, so page through the
+	// room's lines and match locally.
+	result, err := app.Account().Campfires().ListLines(cmd.Context(), chatIDInt, &basecamp.CampfireLineListOptions{
+		Sort:      "created_at",
+		Direction: "desc",
+		Limit:     -1,
+	})
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	matches := make([]basecamp.CampfireLine, 0)
+	for i := range result.Lines {
+		line := &result.Lines[i]
+		if !since.IsZero() && line.CreatedAt.Before(since) {
+			continue
+		}
+		if match(chatLineDisplayContent(line)) {
+			matches = append(matches, *line)
+		}
+	}
+	slices.SortStableFunc(matches, func(a, b basecamp.CampfireLine) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	total := len(matches)
+	respOpts := []output.ResponseOption{
+		output.WithSummary(fmt.Sprintf("%d %s for %q", total, pluralize(total, "match", "matches"), query)),
+		output.WithEntity("chat_line"),
+	}
+	if limit > 0 && total > limit {
+		matches = matches[:limit]
+		respOpts = append(respOpts, output.WithNotice(fmt.Sprintf("Showing the newest %d of %d matches (raise --limit to see more)", limit, total)))
+	}
+	respOpts = append(respOpts, output.WithDisplayData(chatLinesDisplayData(matches)))
+
+	breadcrumbs := []output.Breadcrumb{
+		{
+			Action:      "messages",
+			Cmd:         fmt.Sprintf("basecamp chat messages --room %s --in %s", chatID, resolvedProjectID),
+			Description: "View recent messages",
+		},
+	}
+	if len(matches) > 0 {
+		breadcrumbs = append(breadcrumbs, output.Breadcrumb{
+			Action:      "show",
+			Cmd:         fmt.Sprintf("basecamp chat line %d --room %s --in %s", matches[0].ID, chatID, resolvedProjectID),
+			Description: "Show the newest match",
+		})
+	}
+	respOpts = append(respOpts, output.WithBreadcrumbs(breadcrumbs...))
+
+	return app.OK(matches, respOpts...)
+}
+
+// chatSearchMatcher returns the predicate a message's text must satisfy:
+// a case-insensitive substring match, or the compiled --regex pattern.
+func chatSearchMatcher(query string, useRegex bool) (func(string) bool, error) {
+	if useRegex {
+		re, err := regexp.Compile(query)
+		if err != nil {
+			return nil, output.ErrUsageHint(
+				fmt.Sprintf("Invalid regular expression: %v", err),
+				"Patterns use RE2 syntax; drop --regex to search for the literal text",
+			)
+		}
+		return re.MatchString, nil
+	}
+	needle := strings.ToLower(query)
+	return func(text string) bool {
+		return strings.Contains(strings.ToLower(text), needle)
+	}, nil
+}
@@ -0,0 +1,81 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+type chatSearchResponse struct {
+	Summary string `json:"summary"`
+	Notice  string `json:"notice"`
+	Data    []struct {
+		ID      int64  `json:"id"`
+		Content string `json:"content"`
+		Creator struct {
+			Name string `json:"name"`
+		} `json:"creator"`
+		CreatedAt string `json:"created_at"`
+	} `json:"data"`
+}
+
+func TestChatSearchMatchesAcrossPages(t *testing.T) {
+	app, buf, pages := newChatExportTestApp(t)
+
+	cmd := NewChatCmd()
+	require.NoError(t, executeChatCommand(cmd, app, "search", "HISTORY", "--room", "789"))
+
+	var resp chatSearchResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, 2, *pages, "every page must be searched")
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, int64(1), resp.Data[0].ID)
+	assert.Equal(t, "Ada", resp.Data[0].Creator.Name)
+	assert.NotEmpty(t, resp.Data[0].CreatedAt)
+	assert.Equal(t, `1 match for "HISTORY"`, resp.Summary)
+}
+
+func TestChatSearchRegexNewestFirstWithLimit(t *testing.T) {
+	app, buf, _ := newChatExportTestApp(t)
+
+	cmd := NewChatCmd()
+	require.NoError(t, executeChatCommand(cmd, app, "search", `(?i)^(ancient|ship|📎)`, "--regex", "--room", "789", "--limit", "2"))
+
+	var resp chatSearchResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 2)
+	assert.NotEqual(t, int64(1), resp.Data[0].ID, "the oldest match is cut by --limit")
+	assert.NotEqual(t, int64(1), resp.Data[1].ID)
+	assert.Equal(t, `3 matches for "(?i)^(ancient|ship|📎)"`, resp.Summary)
+	assert.Contains(t, resp.Notice, "newest 2 of 3")
+}
+
+func TestChatSearchSinceAndAttachmentNames(t *testing.T) {
+	app, buf, _ := newChatExportTestApp(t)
+
+	cmd := NewChatCmd()
+	require.NoError(t, executeChatCommand(cmd, app, "search", "plan.pdf", "--room", "789", "--since", "30d"))
+
+	var resp chatSearchResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, int64(3), resp.Data[0].ID)
+}
+
+func TestChatSearchRejectsBadInput(t *testing.T) {
+	app, _, _ := newChatExportTestApp(t)
+
+	err := executeChatCommand(NewChatCmd(), app, "search", "(unclosed", "--regex", "--room", "789")
+	require.Error(t, err)
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, "Invalid regular expression")
+
+	err = executeChatCommand(NewChatCmd(), app, "search", "  ", "--room", "789")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Search query cannot be empty")
+}
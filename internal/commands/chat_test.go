@@ -36,7 +36,8 @@ func (t *chatTestTokenProvider) AccessToken(_ context.Context) (string, error) {
 
 // mockChatCreateTransport handles resolver API calls and captures the create request.
 type mockChatCreateTransport struct {
-	capturedBody []byte
+	capturedBody   []byte
+	capturedBodies [][]byte
 }
 
 func (t *mockChatCreateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
@@ -70,6 +71,7 @@ func (t *mockChatCreateTransport) RoundTrip(req *http.Request) (*http.Response,
 		if req.Body != nil {
 			body, _ := io.ReadAll(req.Body)
 			t.capturedBody = body
+			t.capturedBodies = append(t.capturedBodies, body)
 			req.Body.Close()
 		}
 		// Return a mock line response
@@ -227,6 +229,70 @@ func TestChatPostContentIsPlainText(t *testing.T) {
 		"Chat content should not contain </p> tags")
 }
 
+func TestChatPostStdinSplitsLongContent(t *testing.T) {
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	transport := &mockChatCreateTransport{}
+	app, buf := newChatDeleteTestApp(transport)
+
+	var input strings.Builder
+	for i := 0; i < 120; i++ {
+		fmt.Fprintf(&input, "line %03d %s\n", i, strings.Repeat("x", 60))
+	}
+
+	cmd := NewChatCmd()
+	cmd.SetIn(strings.NewReader(input.String()))
+	err := executeChatCommand(cmd, app, "post", "--content", "-", "--content-type", "text/plain")
+	require.NoError(t, err)
+	require.Len(t, transport.capturedBodies, 3, "~8.5k characters should post as three lines")
+
+	var posted []string
+	for _, body := range transport.capturedBodies {
+		var req map[string]any
+		require.NoError(t, json.Unmarshal(body, &req))
+		content := req["content"].(string)
+		assert.LessOrEqual(t, len(content), chatLineMaxChars)
+		posted = append(posted, content)
+	}
+	assert.Equal(t, strings.TrimSuffix(input.String(), "\n"), strings.Join(posted, "\n"))
+
+	var envelope struct {
+		Data    map[string]any `json:"data"`
+		Summary string         `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Len(t, envelope.Data["message_ids"], 3)
+	assert.Contains(t, envelope.Summary, "Posted 3 messages")
+}
+
+func TestChatPostStdinShortContentIsOneLine(t *testing.T) {
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	transport := &mockChatCreateTransport{}
+	app, buf := newChatDeleteTestApp(transport)
+
+	cmd := NewChatCmd()
+	cmd.SetIn(strings.NewReader("build passed\n"))
+	err := executeChatCommand(cmd, app, "post", "-", "--content-type", "text/plain")
+	require.NoError(t, err)
+	require.Len(t, transport.capturedBodies, 1)
+	assert.Contains(t, string(transport.capturedBody), `"content":"build passed"`)
+	assert.Contains(t, buf.String(), `"id": 999`, "a single line keeps the line object as data")
+}
+
+func TestChatPostStdinEmptyIsUsageError(t *testing.T) {
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	transport := &mockChatCreateTransport{}
+	app, _ := newChatDeleteTestApp(transport)
+
+	cmd := NewChatCmd()
+	cmd.SetIn(strings.NewReader("\n\n"))
+	err := executeChatCommand(cmd, app, "post", "-")
+	require.Error(t, err)
+	assert.Empty(t, transport.capturedBodies)
+}
+
 // TestChatPostContentTypeSentInPayload verifies that --content-type is passed through
 // to the API request body as content_type.
 func TestChatPostContentTypeSentInPayload(t *testing.T) {
@@ -698,6 +764,106 @@ func TestChatMessagesLimitReturnsNewest(t *testing.T) {
 	assert.Equal(t, []int64{3, 2, 1}, ids, "should display in chronological order (oldest to newest)")
 }
 
+// mockChatUnreadTransport serves a chat whose newest line ID can grow
+// between invocations. Line IDs increase with time, as in Basecamp.
+type mockChatUnreadTransport struct {
+	newest int64
+}
+
+func (t *mockChatUnreadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	if req.Method != "GET" {
+		return nil, errors.New("unexpected request")
+	}
+	body := `[{"id": 123, "name": "Test Project"}]`
+	if strings.Contains(req.URL.Path, "/lines.json") {
+		var lines []string
+		for id := t.newest; id > 0; id-- {
+			lines = append(lines, fmt.Sprintf(`{"id": %d, "content": "msg%d"}`, id, id))
+		}
+		body = "[" + strings.Join(lines, ",") + "]"
+	}
+	return &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     header,
+		Request:    req,
+	}, nil
+}
+
+func TestChatMessagesUnreadShowsLinesSinceLastRun(t *testing.T) {
+	transport := &mockChatUnreadTransport{newest: 3}
+	app, buf := newTestAppWithTransport(t, transport)
+	app.Config.CacheDir = t.TempDir()
+
+	type envelope struct {
+		Data []struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+		Summary string `json:"summary"`
+		Notice  string `json:"notice"`
+	}
+	run := func(args ...string) envelope {
+		t.Helper()
+		buf.Reset()
+		require.NoError(t, executeChatCommand(NewChatCmd(), app, append([]string{"messages", "--room", "789"}, args...)...))
+		var env envelope
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+		return env
+	}
+	ids := func(env envelope) []int64 {
+		var out []int64
+		for _, d := range env.Data {
+			out = append(out, d.ID)
+		}
+		return out
+	}
+
+	// First run: no read position yet, so the latest lines are shown
+	env := run("--unread")
+	assert.Equal(t, []int64{1, 2, 3}, ids(env))
+	assert.Contains(t, env.Notice, "No read position")
+
+	// Nothing new since
+	env = run("--unread")
+	assert.Empty(t, env.Data)
+	assert.Equal(t, "0 unread messages", env.Summary)
+
+	// Two lines arrive
+	transport.newest = 5
+	env = run("--unread")
+	assert.Equal(t, []int64{4, 5}, ids(env))
+	assert.Equal(t, "2 unread messages", env.Summary)
+	assert.Empty(t, env.Notice)
+
+	// A plain run also advances the read position
+	transport.newest = 6
+	run()
+	env = run("--unread")
+	assert.Empty(t, env.Data)
+}
+
+func TestChatMessagesUnreadWarnsWhenLimitCutsOff(t *testing.T) {
+	transport := &mockChatUnreadTransport{newest: 2}
+	app, buf := newTestAppWithTransport(t, transport)
+	app.Config.CacheDir = t.TempDir()
+
+	require.NoError(t, executeChatCommand(NewChatCmd(), app, "messages", "--room", "789"))
+	transport.newest = 10
+	buf.Reset()
+	require.NoError(t, executeChatCommand(NewChatCmd(), app, "messages", "--room", "789", "--unread", "--limit", "3"))
+
+	var env struct {
+		Data   []json.RawMessage `json:"data"`
+		Notice string            `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+	assert.Len(t, env.Data, 3)
+	assert.Contains(t, env.Notice, "older ones may also be unread")
+}
+
 // TestChatMessagesLimitPaginates verifies that requesting more than one
 // page of results actually follows pagination via Link headers.
 func TestChatMessagesLimitPaginates(t *testing.T) {
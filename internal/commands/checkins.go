@@ -32,12 +32,13 @@ on a schedule (e.g., "What did you work on today?").`,
 		Annotations: map[string]string{"agent_notes": "Each project has one questionnaire (check-in container)\nQuestions are asked on a recurring schedule\nAnswers are posted by team members in response"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVar(&questionnaireID, "questionnaire", "", "Questionnaire ID (auto-detected)")
 
 	cmd.AddCommand(
 		newCheckinsQuestionsCmd(&project, &questionnaireID),
+		newCheckinsCreateCmd(&project, &questionnaireID),
 		newCheckinsQuestionCmd(&project),
 		newCheckinsAnswersCmd(&project),
 		newCheckinsAnswerCmd(&project),
@@ -73,21 +74,7 @@ func newCheckinsQuestionsCmd(project, questionnaireID *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -125,6 +112,7 @@ func newCheckinsQuestionsCmd(project, questionnaireID *string) *cobra.Command {
 
 			return app.OK(questions,
 				output.WithSummary(fmt.Sprintf("%d check-in questions", len(questions))),
+				listPagination(questionsResult.Meta, page, len(questions)),
 				output.WithBreadcrumbs(
 					output.Breadcrumb{
 						Action:      "question",
@@ -148,6 +136,142 @@ func newCheckinsQuestionsCmd(project, questionnaireID *string) *cobra.Command {
 	return cmd
 }
 
+func newCheckinsCreateCmd(project, questionnaireID *string) *cobra.Command {
+	var question string
+	var schedule string
+	var participants string
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a check-in question with a schedule",
+		Long: `Create an automatic check-in question from a plain-English schedule.
+
+Schedule examples:
+  every day at 9am
+  every weekday at 17:00
+  every monday, wednesday and friday at 10:30
+  every other friday at 4pm
+  every month on the first monday at 9:00
+
+Participants are subscribed to the question so they are asked to answer.
+They accept names, emails, IDs, or "me".`,
+		Example: `basecamp checkins create --question "What did you work on?" --schedule "every weekday at 17:00" --participants me,alice@example.com --in my-project`,
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+
+			title := strings.TrimSpace(question)
+			if title == "" {
+				return missingArg(cmd, "--question")
+			}
+
+			if schedule == "" {
+				schedule = "every weekday at 17:00"
+			}
+			questionSchedule, err := parseCheckinSchedule(schedule)
+			if err != nil {
+				return output.ErrUsage(err.Error())
+			}
+
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+
+			// Resolve project, with interactive fallback
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
+			if err != nil {
+				return err
+			}
+
+			// Resolve participants before creating anything so a typo
+			// doesn't leave a half-provisioned question behind.
+			var participantIDs []int64
+			if cmd.Flags().Changed("participants") {
+				participantIDs, err = resolvePersonIDs(cmd.Context(), app.Names, participants)
+				if err != nil {
+					return err
+				}
+				if len(participantIDs) == 0 {
+					return output.ErrUsage("--participants requires at least one person")
+				}
+			}
+
+			// Get questionnaire ID
+			resolvedQuestionnaireID := *questionnaireID
+			if resolvedQuestionnaireID == "" {
+				resolvedQuestionnaireID, err = getQuestionnaireID(cmd, app, resolvedProjectID)
+				if err != nil {
+					return err
+				}
+			}
+
+			qID, err := strconv.ParseInt(resolvedQuestionnaireID, 10, 64)
+			if err != nil {
+				return output.ErrUsage("Invalid questionnaire ID")
+			}
+
+			created, err := app.Account().Checkins().CreateQuestion(cmd.Context(), qID, &basecamp.CreateQuestionRequest{
+				Title:    title,
+				Schedule: questionSchedule,
+			})
+			if err != nil {
+				return convertSDKError(err)
+			}
+
+			// The question exists now; a failed subscription is reported on it
+			// rather than as an error, so a retry doesn't create a duplicate.
+			summary := fmt.Sprintf("Created check-in #%d: %s", created.ID, created.Title)
+			var notice string
+			if len(participantIDs) > 0 {
+				_, err := app.Account().Subscriptions().Update(cmd.Context(), created.ID, &basecamp.UpdateSubscriptionRequest{
+					Subscriptions: participantIDs,
+				})
+				if err != nil {
+					ids := make([]string, len(participantIDs))
+					for i, id := range participantIDs {
+						ids[i] = strconv.FormatInt(id, 10)
+					}
+					notice = fmt.Sprintf("could not add the participants: %s; retry with: basecamp subscriptions add %d --people %s",
+						convertSDKError(err).Error(), created.ID, strings.Join(ids, ","))
+				} else {
+					summary += fmt.Sprintf(" (%d participants)", len(participantIDs))
+				}
+			}
+
+			opts := []output.ResponseOption{
+				output.WithSummary(summary),
+				output.WithBreadcrumbs(
+					output.Breadcrumb{
+						Action:      "question",
+						Cmd:         fmt.Sprintf("basecamp checkins question %d --in %s", created.ID, resolvedProjectID),
+						Description: "View question",
+					},
+					output.Breadcrumb{
+						Action:      "participants",
+						Cmd:         fmt.Sprintf("basecamp subscriptions show %d", created.ID),
+						Description: "View participants",
+					},
+					output.Breadcrumb{
+						Action:      "questions",
+						Cmd:         fmt.Sprintf("basecamp checkins questions --in %s", resolvedProjectID),
+						Description: "View all questions",
+					},
+				),
+			}
+			if notice != "" {
+				opts = append(opts, output.WithDiagnostic(notice))
+			}
+			return app.OK(created, opts...)
+		},
+	}
+
+	cmd.Flags().StringVar(&question, "question", "", "Question to ask (required)")
+	cmd.Flags().StringVar(&schedule, "schedule", "", "When to ask, e.g. \"every weekday at 17:00\" (default: every weekday at 17:00)")
+	cmd.Flags().StringVar(&participants, "participants", "", "People to ask, comma-separated (names, emails, IDs, or \"me\")")
+
+	return cmd
+}
+
 func newCheckinsQuestionCmd(project *string) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "question <id|url>",
@@ -207,23 +331,10 @@ func runCheckinsQuestionShow(cmd *cobra.Command, project, questionIDStr string,
 
 	// Resolve project - use URL > flag > config, with interactive fallback
 	projectID := project
-	if projectID == "" && urlProjectID != "" {
-		projectID = urlProjectID
-	}
 	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+		projectID = urlProjectID
 	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 	if err != nil {
 		return err
 	}
@@ -291,21 +402,7 @@ Days format: comma-separated (0=Sun, 1=Mon, 2=Tue, 3=Wed, 4=Thu, 5=Fri, 6=Sat)`,
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -432,23 +529,10 @@ You can pass either a question ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
 			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -566,23 +650,10 @@ Use --by to filter answers by a specific person (name, email, ID, or "me"):
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -609,6 +680,7 @@ Use --by to filter answers by a specific person (name, email, ID, or "me"):
 			}
 
 			var answers []basecamp.QuestionAnswer
+			var meta basecamp.ListMeta
 			if trimmedBy != "" {
 				personIDStr, _, err := app.Names.ResolvePerson(cmd.Context(), trimmedBy)
 				if err != nil {
@@ -622,17 +694,18 @@ Use --by to filter answers by a specific person (name, email, ID, or "me"):
 				if err != nil {
 					return convertSDKError(err)
 				}
-				answers = answersResult.Answers
+				answers, meta = answersResult.Answers, answersResult.Meta
 			} else {
 				answersResult, err := app.Account().Checkins().ListAnswers(cmd.Context(), questionID, opts)
 				if err != nil {
 					return convertSDKError(err)
 				}
-				answers = answersResult.Answers
+				answers, meta = answersResult.Answers, answersResult.Meta
 			}
 
 			return app.OK(answers,
 				output.WithSummary(fmt.Sprintf("%d answers", len(answers))),
+				listPagination(meta, page, len(answers)),
 				output.WithBreadcrumbs(
 					output.Breadcrumb{
 						Action:      "answer",
@@ -716,23 +789,10 @@ func runCheckinsAnswerShow(cmd *cobra.Command, project, answerIDStr string, cf *
 
 	// Resolve project - use URL > flag > config, with interactive fallback
 	projectID := project
-	if projectID == "" && urlProjectID != "" {
-		projectID = urlProjectID
-	}
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
 	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+		projectID = urlProjectID
 	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 	if err != nil {
 		return err
 	}
@@ -811,21 +871,7 @@ func newCheckinsAnswerCreateCmd(project *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -891,6 +937,7 @@ func newCheckinsAnswerCreateCmd(project *string) *cobra.Command {
 
 	cmd.Flags().StringVar(&groupOn, "date", "", "Date to group answer (ISO 8601, e.g., 2024-01-22; defaults to today)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
+	addContentForceFlag(cmd)
 
 	return cmd
 }
@@ -924,23 +971,10 @@ You can pass either an answer ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -994,6 +1028,8 @@ You can pass either an answer ID or a Basecamp URL:
 		},
 	}
 
+	addContentForceFlag(cmd)
+
 	return cmd
 }
 
@@ -1051,3 +1087,133 @@ func parseTimeOfDay(t string) (int, int, error) {
 
 	return hour, minute, nil
 }
+
+// checkinWeekdays maps day names (and common abbreviations) to the
+// 0=Sunday..6=Saturday numbering used by question schedules.
+var checkinWeekdays = map[string]int{
+	"sunday": 0, "sun": 0,
+	"monday": 1, "mon": 1,
+	"tuesday": 2, "tue": 2, "tues": 2,
+	"wednesday": 3, "wed": 3,
+	"thursday": 4, "thu": 4, "thurs": 4,
+	"friday": 5, "fri": 5,
+	"saturday": 6, "sat": 6,
+}
+
+// checkinWeekInstances maps ordinals to the week_instance used by monthly schedules.
+var checkinWeekInstances = map[string]int{
+	"first": 1, "1st": 1,
+	"second": 2, "2nd": 2,
+	"third": 3, "3rd": 3,
+	"fourth": 4, "4th": 4,
+}
+
+// parseCheckinSchedule turns a plain-English schedule such as
+// "every weekday at 17:00" into a question schedule. The time defaults
+// to 5:00pm when no "at" clause is given.
+func parseCheckinSchedule(spec string) (*basecamp.QuestionSchedule, error) {
+	s := strings.ToLower(strings.Join(strings.Fields(spec), " "))
+	if s == "" {
+		return nil, fmt.Errorf("schedule cannot be blank")
+	}
+
+	recurrence, at, hasTime := strings.Cut(s, " at ")
+	if !hasTime {
+		if rest, ok := strings.CutPrefix(s, "at "); ok {
+			recurrence, at, hasTime = "", rest, true
+		}
+	}
+
+	hour, minute := 17, 0
+	if hasTime {
+		var err error
+		hour, minute, err = parseTimeOfDay(at)
+		if err != nil || hour < 0 || hour > 23 || minute < 0 || minute > 59 {
+			return nil, fmt.Errorf("invalid time %q in schedule", strings.TrimSpace(at))
+		}
+	}
+
+	recurrence = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(recurrence), "every"))
+
+	schedule := &basecamp.QuestionSchedule{Hour: &hour, Minute: &minute}
+
+	switch {
+	case recurrence == "" || recurrence == "day":
+		schedule.Frequency = "every_day"
+		schedule.Days = []int{0, 1, 2, 3, 4, 5, 6}
+	case recurrence == "weekday" || recurrence == "weekdays":
+		schedule.Frequency = "every_day"
+		schedule.Days = []int{1, 2, 3, 4, 5}
+	case strings.HasPrefix(recurrence, "other "):
+		days, err := parseCheckinDays(strings.TrimPrefix(strings.TrimPrefix(recurrence, "other "), "week on "))
+		if err != nil {
+			return nil, err
+		}
+		schedule.Frequency = "every_other_week"
+		schedule.Days = days
+	case strings.HasPrefix(recurrence, "week on "):
+		days, err := parseCheckinDays(strings.TrimPrefix(recurrence, "week on "))
+		if err != nil {
+			return nil, err
+		}
+		schedule.Frequency = "every_week"
+		schedule.Days = days
+	case strings.HasPrefix(recurrence, "month on "):
+		fields := strings.Fields(strings.TrimPrefix(strings.TrimPrefix(recurrence, "month on "), "the "))
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("monthly schedules look like \"every month on the first monday\"")
+		}
+		instance, ok := checkinWeekInstances[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("unknown week %q (use first, second, third, or fourth)", fields[0])
+		}
+		days, err := parseCheckinDays(fields[1])
+		if err != nil {
+			return nil, err
+		}
+		schedule.Frequency = "every_month"
+		schedule.Days = days
+		schedule.WeekInstance = &instance
+	default:
+		days, err := parseCheckinDays(recurrence)
+		if err != nil {
+			return nil, err
+		}
+		schedule.Frequency = "on_certain_days"
+		if len(days) == 1 {
+			schedule.Frequency = "every_week"
+		}
+		schedule.Days = days
+	}
+
+	return schedule, nil
+}
+
+// parseCheckinDays parses a list of day names separated by commas and/or "and".
+func parseCheckinDays(s string) ([]int, error) {
+	s = strings.ReplaceAll(s, " and ", ",")
+	var days []int
+	seen := make(map[int]bool)
+	for part := range strings.SplitSeq(s, ",") {
+		name := strings.TrimSuffix(strings.TrimSpace(part), "s")
+		if name == "" {
+			continue
+		}
+		day, ok := checkinWeekdays[name]
+		if !ok {
+			// "tues" and "thurs" lose their trailing s above
+			day, ok = checkinWeekdays[name+"s"]
+		}
+		if !ok {
+			return nil, fmt.Errorf("unknown day %q in schedule", strings.TrimSpace(part))
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	if len(days) == 0 {
+		return nil, fmt.Errorf("schedule must name at least one day")
+	}
+	return days, nil
+}
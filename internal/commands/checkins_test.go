@@ -183,3 +183,180 @@ func TestCheckinsAnswerCreatePreservesExplicitDate(t *testing.T) {
 	require.NotNil(t, transport.recordedBody)
 	assert.Equal(t, "2026-03-25", transport.recordedBody["group_on"])
 }
+
+func TestParseCheckinSchedule(t *testing.T) {
+	s, err := parseCheckinSchedule("every weekday at 17:00")
+	require.NoError(t, err)
+	assert.Equal(t, "every_day", s.Frequency)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, s.Days)
+	assert.Equal(t, 17, *s.Hour)
+	assert.Equal(t, 0, *s.Minute)
+
+	s, err = parseCheckinSchedule("Every day at 9am")
+	require.NoError(t, err)
+	assert.Equal(t, "every_day", s.Frequency)
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6}, s.Days)
+	assert.Equal(t, 9, *s.Hour)
+
+	s, err = parseCheckinSchedule("every monday, wednesday and friday at 10:30")
+	require.NoError(t, err)
+	assert.Equal(t, "on_certain_days", s.Frequency)
+	assert.Equal(t, []int{1, 3, 5}, s.Days)
+	assert.Equal(t, 10, *s.Hour)
+	assert.Equal(t, 30, *s.Minute)
+
+	s, err = parseCheckinSchedule("every friday")
+	require.NoError(t, err)
+	assert.Equal(t, "every_week", s.Frequency)
+	assert.Equal(t, []int{5}, s.Days)
+	assert.Equal(t, 17, *s.Hour)
+
+	s, err = parseCheckinSchedule("every other tues at 4pm")
+	require.NoError(t, err)
+	assert.Equal(t, "every_other_week", s.Frequency)
+	assert.Equal(t, []int{2}, s.Days)
+	assert.Equal(t, 16, *s.Hour)
+
+	s, err = parseCheckinSchedule("every month on the first monday at 9:00")
+	require.NoError(t, err)
+	assert.Equal(t, "every_month", s.Frequency)
+	assert.Equal(t, []int{1}, s.Days)
+	require.NotNil(t, s.WeekInstance)
+	assert.Equal(t, 1, *s.WeekInstance)
+}
+
+func TestParseCheckinScheduleErrors(t *testing.T) {
+	for _, spec := range []string{"", "every blursday", "every day at 25:00", "every month on the fifth monday"} {
+		_, err := parseCheckinSchedule(spec)
+		assert.Error(t, err, spec)
+	}
+}
+
+type mockCheckinsCreateTransport struct {
+	questionBody     map[string]any
+	subscriptionBody map[string]any
+	subscriptionFail bool
+}
+
+func (m *mockCheckinsCreateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	decode := func(into *map[string]any) error {
+		defer req.Body.Close()
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(body, into)
+	}
+
+	switch {
+	case req.Method == "GET" && strings.Contains(req.URL.Path, "/projects.json"):
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`[{"id":123,"name":"Test Project"}]`)),
+			Header:     header,
+		}, nil
+	case req.Method == "GET" && strings.Contains(req.URL.Path, "/people.json"):
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`[{"id":456,"name":"Alice Smith","email_address":"alice@example.com"}]`)),
+			Header:     header,
+		}, nil
+	case req.Method == "POST" && req.URL.Path == "/99999/questionnaires/555/questions.json":
+		if err := decode(&m.questionBody); err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: 201,
+			Body:       io.NopCloser(strings.NewReader(`{"id": 777, "title": "What did you work on?", "status": "active", "type": "Question"}`)),
+			Header:     header,
+		}, nil
+	case req.Method == "PUT" && req.URL.Path == "/99999/recordings/777/subscription.json" && m.subscriptionFail:
+		return &http.Response{
+			StatusCode: 422,
+			Body:       io.NopCloser(strings.NewReader(`{"error":"Unprocessable"}`)),
+			Header:     header,
+		}, nil
+	case req.Method == "PUT" && req.URL.Path == "/99999/recordings/777/subscription.json":
+		if err := decode(&m.subscriptionBody); err != nil {
+			return nil, err
+		}
+		return &http.Response{
+			StatusCode: 200,
+			Body:       io.NopCloser(strings.NewReader(`{"subscribed": false, "count": 1, "subscribers": [{"id": 456, "name": "Alice Smith"}]}`)),
+			Header:     header,
+		}, nil
+	default:
+		return &http.Response{
+			StatusCode: 404,
+			Body:       io.NopCloser(strings.NewReader(`{"error":"Not Found"}`)),
+			Header:     header,
+		}, nil
+	}
+}
+
+func TestCheckinsCreateWithScheduleAndParticipants(t *testing.T) {
+	transport := &mockCheckinsCreateTransport{}
+	app, _ := newTestAppWithTransport(t, transport)
+
+	project := ""
+	questionnaireID := "555"
+	cmd := newCheckinsCreateCmd(&project, &questionnaireID)
+
+	err := executeCommand(cmd, app,
+		"--question", "What did you work on?",
+		"--schedule", "every weekday at 17:00",
+		"--participants", "alice@example.com")
+	require.NoError(t, err)
+
+	require.NotNil(t, transport.questionBody)
+	assert.Equal(t, "What did you work on?", transport.questionBody["title"])
+	schedule, ok := transport.questionBody["schedule"].(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "every_day", schedule["frequency"])
+	assert.Equal(t, []any{float64(1), float64(2), float64(3), float64(4), float64(5)}, schedule["days"])
+	assert.Equal(t, float64(17), schedule["hour"])
+
+	require.NotNil(t, transport.subscriptionBody)
+	assert.Equal(t, []any{float64(456)}, transport.subscriptionBody["subscriptions"])
+}
+
+func TestCheckinsCreateReportsFailedSubscriptionOnQuestion(t *testing.T) {
+	transport := &mockCheckinsCreateTransport{subscriptionFail: true}
+	app, buf := newTestAppWithTransport(t, transport)
+
+	project := ""
+	questionnaireID := "555"
+	cmd := newCheckinsCreateCmd(&project, &questionnaireID)
+
+	// The question was created, so the command succeeds and says what to
+	// retry instead of inviting a rerun that would duplicate it.
+	err := executeCommand(cmd, app, "--question", "What did you work on?", "--participants", "alice@example.com")
+	require.NoError(t, err)
+	require.NotNil(t, transport.questionBody)
+
+	var resp struct {
+		Data   map[string]any `json:"data"`
+		Notice string         `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, float64(777), resp.Data["id"])
+	assert.Contains(t, resp.Notice, "could not add the participants")
+	assert.Contains(t, resp.Notice, "basecamp subscriptions add 777 --people 456")
+}
+
+func TestCheckinsCreateRejectsBadScheduleBeforeRequest(t *testing.T) {
+	transport := &mockCheckinsCreateTransport{}
+	app, _ := newTestAppWithTransport(t, transport)
+
+	project := ""
+	questionnaireID := "555"
+	cmd := newCheckinsCreateCmd(&project, &questionnaireID)
+
+	err := executeCommand(cmd, app, "--question", "Q?", "--schedule", "every blursday")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "blursday")
+	assert.Nil(t, transport.questionBody)
+}
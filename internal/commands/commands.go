@@ -35,17 +35,17 @@ func CommandCategories() []CommandCategory {
 			Name: "Core Commands",
 			Commands: []CommandInfo{
 				{Name: "projects", Category: "core", Description: "Manage projects", Actions: []string{"list", "show", "create", "update", "delete"}},
-				{Name: "todos", Category: "core", Description: "Manage to-dos", Actions: []string{"list", "show", "create", "update", "complete", "uncomplete", "position", "trash", "archive", "restore"}},
+				{Name: "todos", Category: "core", Description: "Manage to-dos", Actions: []string{"list", "show", "create", "update", "complete", "uncomplete", "position", "copy", "move", "blocked", "deps", "tree", "log-time", "trash", "archive", "restore"}},
 				{Name: "todolists", Category: "core", Description: "Manage to-do lists", Actions: []string{"list", "show", "create", "update", "trash", "archive", "restore"}},
 				{Name: "todosets", Category: "core", Description: "Manage to-do set containers", Actions: []string{"list", "show"}},
 				{Name: "hillcharts", Category: "core", Description: "Manage hill charts", Actions: []string{"show", "track", "untrack"}},
 				{Name: "gauges", Category: "core", Description: "Manage gauges", Actions: []string{"list", "needles", "needle", "create", "update", "delete", "enable", "disable"}},
 				{Name: "todolistgroups", Category: "core", Description: "Manage to-do list groups", Actions: []string{"list", "show", "create", "update", "position"}},
 				{Name: "messages", Category: "core", Description: "Manage messages", Actions: []string{"list", "show", "create", "update", "publish", "pin", "unpin", "trash", "archive", "restore"}},
-				{Name: "chat", Category: "core", Description: "Chat in real-time", Actions: []string{"list", "messages", "post", "upload", "line", "update", "delete"}},
-				{Name: "cards", Category: "core", Description: "Manage Kanban cards", Actions: []string{"list", "show", "create", "update", "move", "done", "columns", "steps", "trash", "archive", "restore"}},
-				{Name: "files", Category: "core", Description: "Manage files, documents, and folders", Actions: []string{"list", "show", "download", "update", "trash", "archive", "restore"}},
-				{Name: "checkins", Category: "core", Description: "View automatic check-ins", Actions: []string{"questions", "question", "answers", "answer"}},
+				{Name: "chat", Category: "core", Description: "Chat in real-time", Actions: []string{"list", "messages", "post", "upload", "line", "update", "delete", "export", "bots"}},
+				{Name: "cards", Category: "core", Description: "Manage Kanban cards", Actions: []string{"list", "show", "create", "update", "move", "done", "columns", "metrics", "steps", "trash", "archive", "restore"}},
+				{Name: "files", Category: "core", Description: "Manage files, documents, and folders", Actions: []string{"list", "tree", "sync", "show", "download", "update", "trash", "archive", "restore"}},
+				{Name: "checkins", Category: "core", Description: "View automatic check-ins", Actions: []string{"questions", "create", "question", "answers", "answer"}},
 				{Name: "schedule", Category: "core", Description: "Manage schedule entries", Actions: []string{"show", "entries", "create", "update"}},
 			},
 		},
@@ -62,8 +62,8 @@ func CommandCategories() []CommandCategory {
 			Name: "Files & Docs",
 			Commands: []CommandInfo{
 				{Name: "uploads", Category: "files", Description: "List and manage uploads", Actions: []string{"list", "show", "download", "update", "trash", "archive", "restore"}},
-				{Name: "vaults", Category: "files", Description: "Manage folders (vaults)", Actions: []string{"list", "show", "download", "update", "trash", "archive", "restore"}},
-				{Name: "docs", Category: "files", Description: "Manage documents", Actions: []string{"list", "show", "download", "update", "trash", "archive", "restore"}},
+				{Name: "vaults", Category: "files", Description: "Manage folders (vaults)", Actions: []string{"list", "tree", "sync", "show", "download", "update", "trash", "archive", "restore"}},
+				{Name: "docs", Category: "files", Description: "Manage documents", Actions: []string{"list", "tree", "sync", "show", "download", "update", "trash", "archive", "restore"}},
 			},
 		},
 		{
@@ -71,14 +71,14 @@ func CommandCategories() []CommandCategory {
 			Commands: []CommandInfo{
 				{Name: "timesheet", Category: "scheduling", Description: "Manage time tracking", Actions: []string{"report", "project", "item"}},
 				{Name: "timeline", Category: "scheduling", Description: "View activity timelines", Actions: []string{}},
-				{Name: "reports", Category: "scheduling", Description: "View reports", Actions: []string{"assignable", "assigned", "overdue", "schedule"}},
+				{Name: "reports", Category: "scheduling", Description: "View reports", Actions: []string{"assignable", "assigned", "overdue", "schedule", "time"}},
 				{Name: "assignments", Category: "scheduling", Description: "View my assignments", Actions: []string{"list", "completed", "due"}},
 			},
 		},
 		{
 			Name: "Organization",
 			Commands: []CommandInfo{
-				{Name: "people", Category: "organization", Description: "Manage people and access", Actions: []string{"list", "show", "pingable", "add", "remove"}},
+				{Name: "people", Category: "organization", Description: "Manage people and access", Actions: []string{"list", "show", "pingable", "activity", "add", "remove", "invite", "invites", "sync", "export"}},
 				{Name: "templates", Category: "organization", Description: "Manage project templates", Actions: []string{"list", "show", "create", "update", "delete", "construct"}},
 				{Name: "webhooks", Category: "organization", Description: "Manage webhooks", Actions: []string{"list", "show", "create", "update", "delete"}},
 				{Name: "lineup", Category: "organization", Description: "Manage lineup markers", Actions: []string{"list", "create", "update", "delete"}},
@@ -91,6 +91,7 @@ func CommandCategories() []CommandCategory {
 				{Name: "messagetypes", Category: "communication", Description: "Manage message categories", Actions: []string{"list", "show", "create", "update", "delete"}},
 				{Name: "forwards", Category: "communication", Description: "Manage email forwards (inbox)", Actions: []string{"list", "show", "inbox", "replies", "reply"}},
 				{Name: "subscriptions", Category: "communication", Description: "Manage notification subscriptions", Actions: []string{"show", "subscribe", "unsubscribe", "add", "remove"}},
+				{Name: "visibility", Category: "communication", Description: "Manage client visibility", Actions: []string{"set"}},
 				{Name: "attachments", Category: "communication", Description: "List and download attachments", Actions: []string{"list", "download"}},
 				{Name: "comments", Category: "communication", Description: "Manage comments", Actions: []string{"create", "list", "show", "update", "trash", "archive", "restore"}},
 				{Name: "boost", Category: "communication", Description: "Manage boosts (reactions)", Actions: []string{"list", "show", "create", "delete"}},
@@ -101,7 +102,7 @@ func CommandCategories() []CommandCategory {
 			Name: "Search & Browse",
 			Commands: []CommandInfo{
 				{Name: "search", Category: "search", Description: "Search across projects"},
-				{Name: "recordings", Category: "search", Description: "Browse content by type across projects", Actions: []string{"list", "trash", "archive", "restore", "visibility"}},
+				{Name: "recordings", Category: "search", Description: "Browse content by type across projects", Actions: []string{"list", "show", "trash", "archive", "restore", "visibility"}},
 				{Name: "show", Category: "search", Description: "Show any item by ID"},
 				{Name: "events", Category: "search", Description: "View change history"},
 				{Name: "url", Category: "search", Description: "Parse Basecamp URLs"},
@@ -119,8 +120,10 @@ func CommandCategories() []CommandCategory {
 				{Name: "setup", Category: "auth", Description: "Interactive first-time setup"},
 				{Name: "quick-start", Category: "auth", Description: "Show getting started guide"},
 				{Name: "doctor", Category: "auth", Description: "Check CLI health and diagnose issues"},
+				{Name: "verify", Category: "auth", Description: "Check credentials and permissions against a project"},
+				{Name: "stats", Category: "auth", Description: "Show local command usage statistics"},
 				{Name: "upgrade", Category: "auth", Description: "Upgrade to the latest version"},
-				{Name: "migrate", Category: "auth", Description: "Migrate data from legacy bcq installation"},
+				{Name: "migrate", Category: "auth", Description: "Migrate data from legacy bcq installation", Actions: []string{"alias"}},
 				{Name: "profile", Category: "auth", Description: "Manage named profiles", Actions: []string{"list", "show", "create", "delete", "set-default"}},
 			},
 		},
@@ -134,6 +137,11 @@ func CommandCategories() []CommandCategory {
 				{Name: "tui", Category: "additional", Description: "Launch the Basecamp workspace", Experimental: true, DevOnly: true},
 				{Name: "bonfire", Category: "additional", Description: "Multi-chat orchestration", Actions: []string{"split", "layout"}, Experimental: true, DevOnly: true},
 				{Name: "api", Category: "additional", Description: "Raw API access"},
+				{Name: "run", Category: "additional", Description: "Run a YAML playbook of commands"},
+				{Name: "outbox", Category: "additional", Description: "Retry commands queued while offline", Actions: []string{"list", "flush", "drop"}},
+				{Name: "plugins", Category: "additional", Description: "List plugins on PATH"},
+				{Name: "access", Category: "additional", Description: "Check what you can do in a project", Actions: []string{"check"}},
+				{Name: "schema", Category: "additional", Description: "Show JSON schemas for CLI output", Actions: []string{"envelope"}},
 				{Name: "help", Category: "additional", Description: "Show help"},
 				{Name: "version", Category: "additional", Description: "Show version"},
 			},
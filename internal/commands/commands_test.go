@@ -62,6 +62,8 @@ func buildRootWithAllCommands() *cobra.Command {
 	root.AddCommand(commands.NewPeopleCmd())
 	root.AddCommand(commands.NewQuickStartCmd())
 	root.AddCommand(commands.NewAPICmd())
+	root.AddCommand(commands.NewRunCmd())
+	root.AddCommand(commands.NewAccessCmd())
 	root.AddCommand(commands.NewShowCmd())
 	root.AddCommand(commands.NewTodolistsCmd())
 	root.AddCommand(commands.NewCommentsCmd())
@@ -82,6 +84,7 @@ func buildRootWithAllCommands() *cobra.Command {
 	root.AddCommand(commands.NewWebhooksCmd())
 	root.AddCommand(commands.NewEventsCmd())
 	root.AddCommand(commands.NewSubscriptionsCmd())
+	root.AddCommand(commands.NewVisibilityCmd())
 	root.AddCommand(commands.NewForwardsCmd())
 	root.AddCommand(commands.NewMessageboardsCmd())
 	root.AddCommand(commands.NewMessagetypesCmd())
@@ -96,6 +99,7 @@ func buildRootWithAllCommands() *cobra.Command {
 	root.AddCommand(commands.NewTodolistgroupsCmd())
 	root.AddCommand(commands.NewCommandsCmd())
 	root.AddCommand(commands.NewVersionCmd())
+	root.AddCommand(commands.NewSchemaCmd())
 	root.AddCommand(commands.NewTimelineCmd())
 	root.AddCommand(commands.NewReportsCmd())
 	root.AddCommand(commands.NewCompletionCmd())
@@ -103,6 +107,8 @@ func buildRootWithAllCommands() *cobra.Command {
 	root.AddCommand(commands.NewLoginCmd())
 	root.AddCommand(commands.NewLogoutCmd())
 	root.AddCommand(commands.NewDoctorCmd())
+	root.AddCommand(commands.NewVerifyCmd())
+	root.AddCommand(commands.NewStatsCmd())
 	root.AddCommand(commands.NewUpgradeCmd())
 	root.AddCommand(commands.NewMigrateCmd())
 	root.AddCommand(commands.NewAttachmentsCmd())
@@ -115,6 +121,8 @@ func buildRootWithAllCommands() *cobra.Command {
 	root.AddCommand(commands.NewTUICmd())
 	root.AddCommand(commands.NewProfileCmd())
 	root.AddCommand(commands.NewBonfireCmd())
+	root.AddCommand(commands.NewPluginsCmd())
+	root.AddCommand(commands.NewOutboxCmd())
 	root.InitDefaultHelpCmd()
 	return root
 }
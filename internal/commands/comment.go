@@ -4,7 +4,6 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"os"
 	"strconv"
 	"strings"
 
@@ -13,7 +12,6 @@ import (
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 
 	"github.com/basecamp/basecamp-cli/internal/appctx"
-	"github.com/basecamp/basecamp-cli/internal/editor"
 	"github.com/basecamp/basecamp-cli/internal/output"
 	"github.com/basecamp/basecamp-cli/internal/richtext"
 )
@@ -29,8 +27,8 @@ func NewCommentsCmd() *cobra.Command {
 		Annotations: map[string]string{"agent_notes": "Comments are flat — reply to parent item, not to other comments\nURL fragments (#__recording_456) are comment IDs — comment on the parent recording_id, not the comment_id\nComments are on items (todos, messages, cards, etc.) — not on other comments\n@mentions: prefer [@Name](mention:SGID) for zero API calls, or [@Name](person:ID) for one lookup; @Name/@First.Last for fuzzy matching"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newCommentsListCmd(),
@@ -55,7 +53,14 @@ func newCommentsListCmd() *cobra.Command {
 		Long:  "List all comments on an item.",
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
-				return missingArg(cmd, "<id|url>")
+				id, ok, err := pickRecordingArg(cmd, appctx.FromContext(cmd.Context()), "Select item to list comments on")
+				if !ok {
+					return missingArg(cmd, "<id|url>")
+				}
+				if err != nil {
+					return err
+				}
+				args = []string{id}
 			}
 			return runCommentsList(cmd, args[0], limit, page, all)
 		},
@@ -129,8 +134,10 @@ func runCommentsList(cmd *cobra.Command, recordingID string, limit, page int, al
 		),
 	}
 
+	respOpts = append(respOpts, listPagination(commentsResult.Meta, page, len(comments)))
+
 	// Add truncation notice if results may be limited
-	if notice := output.TruncationNoticeWithTotal(len(comments), commentsResult.Meta.TotalCount); notice != "" {
+	if notice := listTruncationNotice(len(comments), commentsResult.Meta, page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
@@ -192,6 +199,8 @@ You can pass either a comment ID or a Basecamp URL:
 }
 
 func newCommentsUpdateCmd() *cobra.Command {
+	var edit bool
+
 	cmd := &cobra.Command{
 		Use:   "update <id|url> <content>",
 		Short: "Update a comment",
@@ -206,21 +215,35 @@ Use - as the content argument to read the updated content from stdin:
 
 For multiline or non-ASCII content, prefer stdin over bash ANSI-C quoting
 ($'...') — under a POSIX /bin/sh it posts a literal leading $ and keeps \n
-as backslash-n.`,
+as backslash-n.
+
+Use --edit to revise the current content as markdown in $EDITOR:
+  basecamp comments update 789 --edit`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return missingArg(cmd, "<id|url>")
 			}
-			if len(args) < 2 {
-				return missingArg(cmd, "<content>")
+			if edit && len(args) > 1 {
+				return output.ErrUsage("cannot combine --edit and positional content")
 			}
 
-			content, err := contentArgOrStdin(cmd, args[1:])
-			if err != nil {
-				return err
-			}
-			if strings.TrimSpace(content) == "" {
-				return missingArg(cmd, "<content>")
+			var content string
+			if edit {
+				if err := requireEditorTerminal(); err != nil {
+					return err
+				}
+			} else {
+				if len(args) < 2 {
+					return missingArg(cmd, "<content>")
+				}
+				var err error
+				content, err = contentArgOrStdin(cmd, args[1:])
+				if err != nil {
+					return err
+				}
+				if strings.TrimSpace(content) == "" {
+					return missingArg(cmd, "<content>")
+				}
 			}
 
 			app := appctx.FromContext(cmd.Context())
@@ -237,6 +260,16 @@ as backslash-n.`,
 				return output.ErrUsage("Invalid comment ID")
 			}
 
+			if edit {
+				current, err := app.Account().Comments().Get(cmd.Context(), commentID)
+				if err != nil {
+					return convertSDKError(err)
+				}
+				if content, err = editExistingContent(current.Content, "comment"); err != nil {
+					return err
+				}
+			}
+
 			// Convert Markdown content to HTML for Basecamp's rich text fields
 			html := richtext.MarkdownToHTML(content)
 
@@ -280,6 +313,9 @@ as backslash-n.`,
 		},
 	}
 
+	cmd.Flags().BoolVar(&edit, "edit", false, "Open $EDITOR with the current content")
+	addContentForceFlag(cmd)
+
 	return cmd
 }
 
@@ -301,12 +337,19 @@ Comma-separated IDs add the same comment to multiple items:
 Content can also be piped from stdin:
   printf 'Looks good!' | basecamp comments create 789
 
+On a terminal, run without arguments to pick a recent item (add --in to
+include the project's latest todos, messages, and documents) and write the
+comment in your editor.
+
 Content supports Markdown and @mentions (@Name or @First.Last):
   basecamp comments create 789 "Hey @Jane.Smith, **please review**"
 
 Use - as the content argument to read content from stdin:
   basecamp comments create 789 - < body.md
 
+The new comment (with its app_url) is returned; --quiet prints just its ID:
+  id=$(basecamp comments create 789 "Deployed" --quiet)
+
 For multiline or non-ASCII content, prefer stdin over bash ANSI-C quoting
 ($'...'). $'...' is a bash/zsh extension; under a POSIX /bin/sh (dash,
 busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
@@ -315,9 +358,18 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
 
-			// Show help when invoked with no args
+			// On a terminal, pick the item and write the comment in the
+			// editor; otherwise show help when invoked with no args.
 			if len(args) == 0 {
-				return missingArg(cmd, "<id|url>")
+				id, ok, err := pickRecordingArg(cmd, app, "Select item to comment on")
+				if !ok {
+					return missingArg(cmd, "<id|url>")
+				}
+				if err != nil {
+					return err
+				}
+				args = []string{id}
+				edit = true
 			}
 
 			// First arg is always the recording ID(s)
@@ -336,13 +388,10 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 				}
 			}
 			if edit {
-				fi, err := os.Stdin.Stat()
-				if err != nil || (fi.Mode()&os.ModeCharDevice) == 0 {
-					return output.ErrUsage("cannot use --edit when stdin is not a terminal")
-				}
-				content, err = editor.Open("")
+				var err error
+				content, err = editNewContent()
 				if err != nil {
-					return output.ErrUsage(err.Error())
+					return err
 				}
 			}
 
@@ -404,6 +453,7 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 
 			var commented []string
 			var commentIDs []string
+			var created []createdComment
 			var failed []string
 			var lastComment *basecamp.Comment
 			var firstAPIErr error // Capture first API error for better error reporting
@@ -427,6 +477,7 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 				lastComment = comment
 				commentIDs = append(commentIDs, fmt.Sprintf("%d", comment.ID))
 				commented = append(commented, recordingIDStr)
+				created = append(created, createdComment{ID: comment.ID, RecordingID: recordingIDStr, AppURL: comment.AppURL})
 			}
 
 			// If all operations failed, return an error for automation
@@ -451,8 +502,15 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 				return output.ErrUsage(fmt.Sprintf("Failed to comment on all items: %s", strings.Join(failed, ", ")))
 			}
 
+			// --quiet prints just the new comment's ID (a JSON number, or an
+			// array of IDs for a batch) so scripts can capture it directly.
+			quietIDs := app.Flags.Quiet && !app.Flags.Agent && app.Flags.JQFilter == ""
+
 			// Single comment: return the comment object directly
 			if len(commented) == 1 && len(failed) == 0 && lastComment != nil {
+				if quietIDs {
+					return app.OK(lastComment.ID)
+				}
 				respOpts := []output.ResponseOption{
 					output.WithEntity("comment"),
 					output.WithSummary(fmt.Sprintf("Commented on #%s", commented[0])),
@@ -467,6 +525,7 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 							Cmd:         fmt.Sprintf("basecamp comments update %d <text>", lastComment.ID),
 							Description: "Update comment",
 						},
+						commentThreadBreadcrumb(commented[0]),
 					),
 				}
 				if mentionNotice != "" {
@@ -479,8 +538,12 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 			result := map[string]any{
 				"commented_recordings": commented,
 				"comment_ids":          commentIDs,
+				"comments":             created,
 				"failed":               failed,
 			}
+			if quietIDs {
+				return app.OK(commentIDs)
+			}
 
 			var summary string
 			if len(failed) > 0 {
@@ -489,8 +552,13 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 				summary = fmt.Sprintf("Added %d comment(s) to: %s", len(commented), strings.Join(commented, ", "))
 			}
 
+			threads := make([]output.Breadcrumb, 0, len(commented))
+			for _, recordingID := range commented {
+				threads = append(threads, commentThreadBreadcrumb(recordingID))
+			}
 			batchOpts := []output.ResponseOption{
 				output.WithSummary(summary),
+				output.WithBreadcrumbs(threads...),
 			}
 			if mentionNotice != "" {
 				batchOpts = append(batchOpts, output.WithDiagnostic(mentionNotice))
@@ -501,10 +569,27 @@ busybox-ash) it posts a literal leading $ and keeps \n as backslash-n:
 
 	cmd.Flags().BoolVar(&edit, "edit", false, "Open $EDITOR to compose content")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
+	addContentForceFlag(cmd)
 
 	return cmd
 }
 
+// createdComment is one comment added by a batch comments create.
+type createdComment struct {
+	ID          int64  `json:"id"`
+	RecordingID string `json:"recording_id"`
+	AppURL      string `json:"app_url,omitempty"`
+}
+
+// commentThreadBreadcrumb points at the full thread a comment was added to.
+func commentThreadBreadcrumb(recordingID string) output.Breadcrumb {
+	return output.Breadcrumb{
+		Action:      "thread",
+		Cmd:         fmt.Sprintf("basecamp show %s --all-comments", recordingID),
+		Description: "View the thread",
+	}
+}
+
 func contentArgOrStdin(cmd *cobra.Command, args []string) (string, error) {
 	if len(args) == 1 && args[0] == "-" {
 		b, err := io.ReadAll(cmd.InOrStdin())
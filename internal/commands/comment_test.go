@@ -185,7 +185,63 @@ func (t *mockCommentWriteTransport) RoundTrip(req *http.Request) (*http.Response
 
 	return &http.Response{
 		StatusCode: status,
-		Body:       io.NopCloser(strings.NewReader(`{"id":1234,"content":"ok","status":"active"}`)),
+		Body:       io.NopCloser(strings.NewReader(`{"id":1234,"content":"ok","status":"active","app_url":"https://3.basecamp.com/99999/buckets/1/todos/789#__recording_1234"}`)),
 		Header:     header,
 	}, nil
 }
+
+func TestCommentsCreateReturnsAppURLAndThreadBreadcrumb(t *testing.T) {
+	app, buf := setupCommentsWriteTestApp(t, &mockCommentWriteTransport{})
+	app.Flags.Hints = true
+
+	err := executeCommand(newCommentsCreateCmd(), app, "789", "Looks good")
+	require.NoError(t, err)
+
+	var resp struct {
+		Data struct {
+			ID     int64  `json:"id"`
+			AppURL string `json:"app_url"`
+		} `json:"data"`
+		Breadcrumbs []output.Breadcrumb `json:"breadcrumbs"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, int64(1234), resp.Data.ID)
+	assert.Contains(t, resp.Data.AppURL, "#__recording_1234")
+
+	var cmds []string
+	for _, bc := range resp.Breadcrumbs {
+		cmds = append(cmds, bc.Cmd)
+	}
+	assert.Contains(t, cmds, "basecamp show 789 --all-comments")
+}
+
+func TestCommentsCreateBatchListsCreatedComments(t *testing.T) {
+	app, buf := setupCommentsWriteTestApp(t, &mockCommentWriteTransport{})
+	app.Flags.Hints = true
+
+	err := executeCommand(newCommentsCreateCmd(), app, "789,790", "Looks good")
+	require.NoError(t, err)
+
+	var resp struct {
+		Data struct {
+			Comments []createdComment `json:"comments"`
+		} `json:"data"`
+		Breadcrumbs []output.Breadcrumb `json:"breadcrumbs"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data.Comments, 2)
+	assert.Equal(t, "790", resp.Data.Comments[1].RecordingID)
+	assert.NotEmpty(t, resp.Data.Comments[1].AppURL)
+	require.Len(t, resp.Breadcrumbs, 2)
+	assert.Equal(t, "basecamp show 790 --all-comments", resp.Breadcrumbs[1].Cmd)
+}
+
+func TestCommentsCreateQuietPrintsID(t *testing.T) {
+	app, buf := setupCommentsWriteTestApp(t, &mockCommentWriteTransport{})
+	app.Flags.Quiet = true
+	app.Output = output.New(output.Options{Format: output.FormatQuiet, Writer: buf})
+
+	err := executeCommand(newCommentsCreateCmd(), app, "789", "Looks good")
+	require.NoError(t, err)
+	assert.Equal(t, "1234", strings.TrimSpace(buf.String()))
+}
@@ -15,12 +15,15 @@ import (
 	"github.com/basecamp/basecamp-cli/internal/output"
 )
 
-// commentFlags holds the parsed state of --comments / --no-comments / --all-comments.
+// commentFlags holds the parsed state of --comments / --no-comments /
+// --all-comments, plus --history, which rides along on every show command
+// that embeds comments.
 type commentFlags struct {
 	defaultOn   bool
 	comments    bool
 	noComments  bool
 	allComments bool
+	history     bool
 }
 
 // shouldFetch returns true when the caller should attempt comment fetching.
@@ -31,10 +34,11 @@ func (cf *commentFlags) shouldFetch() bool {
 	return cf.defaultOn || cf.comments || cf.allComments
 }
 
-// addCommentFlags registers --comments, --no-comments, and --all-comments on
-// cmd and returns the parsed flag holder. When defaultOn is true (e.g.
-// basecamp show), comments are fetched by default; when false (typed show
-// commands), --comments or --all-comments must be passed to opt in.
+// addCommentFlags registers --comments, --no-comments, --all-comments, and
+// --history on cmd and returns the parsed flag holder. When defaultOn is true
+// (e.g. basecamp show), comments are fetched by default; when false (typed
+// show commands), --comments or --all-comments must be passed to opt in.
+// History is always opt-in.
 func addCommentFlags(cmd *cobra.Command, defaultOn bool) *commentFlags {
 	cf := &commentFlags{defaultOn: defaultOn}
 	cmd.Flags().BoolVar(&cf.comments, "comments", false, "Include comments in output")
@@ -42,6 +46,7 @@ func addCommentFlags(cmd *cobra.Command, defaultOn bool) *commentFlags {
 	cmd.Flags().BoolVar(&cf.allComments, "all-comments", false,
 		fmt.Sprintf("Fetch all comments instead of the default %d", basecamp.DefaultCommentLimit))
 	cmd.MarkFlagsMutuallyExclusive("comments", "no-comments", "all-comments")
+	cmd.Flags().BoolVar(&cf.history, "history", false, "Include the change history (created, assigned, completed, ...)")
 	return cf
 }
 
@@ -63,6 +68,16 @@ type commentEnrichment struct {
 	// CountLabel is a parenthetical like "(3 comments)" for summary augmentation.
 	// Empty when the recording has no comments_count field.
 	CountLabel string
+
+	// History is the recording's event timeline (nil unless --history was
+	// passed and the fetch succeeded).
+	History []basecamp.Event
+
+	// HistoryNotice is a truncation notice for History.
+	HistoryNotice string
+
+	// HistoryFetchNotice is a diagnostic notice when fetching history failed.
+	HistoryFetchNotice string
 }
 
 // fetchCommentsForRecording fetches comments for a recording. Does not require
@@ -76,6 +91,10 @@ func fetchCommentsForRecording(
 ) *commentEnrichment {
 	result := &commentEnrichment{}
 
+	if cf.history {
+		result.fetchHistory(ctx, app, id)
+	}
+
 	if !cf.shouldFetch() {
 		return result
 	}
@@ -120,6 +139,38 @@ func fetchCommentsForRecording(
 	return result
 }
 
+// fetchHistory loads the recording's events into ce.History. A failed fetch
+// becomes a diagnostic rather than failing the show command; a truncated
+// timeline adds a notice pointing at basecamp events --all.
+func (ce *commentEnrichment) fetchHistory(ctx context.Context, app *appctx.App, id string) {
+	recordingID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return
+	}
+
+	eventsResult, err := app.Account().Events().List(ctx, recordingID, &basecamp.EventListOptions{})
+	if err != nil {
+		ce.HistoryFetchNotice = fmt.Sprintf("History fetching failed — view: basecamp events %s", id)
+		return
+	}
+
+	ce.History = eventsResult.Events
+	if ce.History == nil {
+		ce.History = []basecamp.Event{}
+	}
+
+	total := eventsResult.Meta.TotalCount
+	if total > len(ce.History) {
+		ce.HistoryNotice = fmt.Sprintf(
+			"Showing %d of %d events — view all: basecamp events --all %s", len(ce.History), total, id)
+	}
+	ce.Breadcrumbs = append(ce.Breadcrumbs, output.Breadcrumb{
+		Action:      "history",
+		Cmd:         fmt.Sprintf("basecamp events %s", id),
+		Description: "View full change history",
+	})
+}
+
 // fetchRecordingComments wraps fetchCommentsForRecording and additionally
 // reads comments_count from the data map. This provides a CountLabel even
 // when --no-comments skips the fetch (the parent object carries the count).
@@ -179,9 +230,22 @@ func withComments(data any, comments []basecamp.Comment) any {
 	if comments == nil {
 		return data
 	}
+	return withEmbedded(data, "comments", comments)
+}
+
+// withHistory injects the "history" key into data, like withComments.
+// Returns data unchanged when events is nil.
+func withHistory(data any, events []basecamp.Event) any {
+	if events == nil {
+		return data
+	}
+	return withEmbedded(data, "history", events)
+}
 
+// withEmbedded sets key on data, converting non-map data to a map first.
+func withEmbedded(data any, key string, value any) any {
 	if m, ok := data.(map[string]any); ok {
-		m["comments"] = comments
+		m[key] = value
 		return m
 	}
 
@@ -196,7 +260,7 @@ func withComments(data any, comments []basecamp.Comment) any {
 	if err := dec.Decode(&m); err != nil {
 		return data
 	}
-	m["comments"] = comments
+	m[key] = value
 	return m
 }
 
@@ -205,6 +269,7 @@ func withComments(data any, comments []basecamp.Comment) any {
 // of inlining the withComments / applyNotices / breadcrumbs sequence.
 func (ce *commentEnrichment) apply(data any, attachmentNotice string) (any, []output.ResponseOption) {
 	data = withComments(data, ce.Comments)
+	data = withHistory(data, ce.History)
 	opts := ce.applyNotices(attachmentNotice)
 	if len(ce.Breadcrumbs) > 0 {
 		opts = append(opts, output.WithBreadcrumbs(ce.Breadcrumbs...))
@@ -212,17 +277,17 @@ func (ce *commentEnrichment) apply(data any, attachmentNotice string) (any, []ou
 	return data, opts
 }
 
-// applyNotices merges comment and attachment notices into response options.
-// Routes fetch-failure diagnostics to WithDiagnostic; normal notices to
-// WithNotice. attachmentNotice is folded in so it is never lost.
+// applyNotices merges comment, history, and attachment notices into response
+// options. Routes fetch-failure diagnostics to WithDiagnostic; normal notices
+// to WithNotice. attachmentNotice is folded in so it is never lost.
 func (ce *commentEnrichment) applyNotices(attachmentNotice string) []output.ResponseOption {
 	var opts []output.ResponseOption
 
-	if ce.FetchNotice != "" {
-		diagnostic := joinShowNotices(ce.FetchNotice, attachmentNotice)
+	if ce.FetchNotice != "" || ce.HistoryFetchNotice != "" {
+		diagnostic := joinShowNotices(ce.FetchNotice, ce.HistoryFetchNotice, ce.Notice, ce.HistoryNotice, attachmentNotice)
 		opts = append(opts, output.WithDiagnostic(diagnostic))
 	} else {
-		notice := joinShowNotices(ce.Notice, attachmentNotice)
+		notice := joinShowNotices(ce.Notice, ce.HistoryNotice, attachmentNotice)
 		if notice != "" {
 			opts = append(opts, output.WithNotice(notice))
 		}
@@ -36,6 +36,19 @@ func TestWithCommentsNilIsNoOp(t *testing.T) {
 	assert.False(t, ok, "nil comments should not inject a key")
 }
 
+func TestWithHistoryInjectsIntoStruct(t *testing.T) {
+	todo := basecamp.Todo{ID: 42, Content: "Buy milk"}
+	events := []basecamp.Event{{ID: 1, Action: "created"}}
+
+	result := withHistory(todo, events)
+	m, ok := result.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "Buy milk", m["content"])
+	assert.Len(t, m["history"], 1)
+
+	assert.Equal(t, todo, withHistory(todo, nil), "nil history should leave data untouched")
+}
+
 func TestCommentFlagsShouldFetch(t *testing.T) {
 	t.Run("defaultOn true", func(t *testing.T) {
 		cf := &commentFlags{defaultOn: true}
@@ -87,6 +100,18 @@ func TestCommentEnrichmentApplyNotices(t *testing.T) {
 		assert.Empty(t, opts)
 	})
 
+	t.Run("history failure routes to diagnostic with other notices", func(t *testing.T) {
+		ce := &commentEnrichment{
+			Notice:             "Showing 10 of 50 comments",
+			HistoryFetchNotice: "History fetching failed",
+		}
+		resp := &output.Response{}
+		for _, opt := range ce.applyNotices("") {
+			opt(resp)
+		}
+		assert.Equal(t, "History fetching failed; Showing 10 of 50 comments", resp.Notice)
+	})
+
 	t.Run("attachment notice only", func(t *testing.T) {
 		ce := &commentEnrichment{}
 		opts := ce.applyNotices("1 attachment(s)")
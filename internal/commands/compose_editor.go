@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/basecamp/basecamp-cli/internal/editor"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// stdinIsTerminal reports whether stdin is a terminal. Extracted for testability.
+var stdinIsTerminal = func() bool {
+	fi, err := os.Stdin.Stat()
+	if err != nil {
+		return false
+	}
+	return (fi.Mode() & os.ModeCharDevice) != 0
+}
+
+// openEditor launches $EDITOR on a markdown buffer. Extracted for testability.
+var openEditor = editor.Open
+
+// requireEditorTerminal rejects --edit when there's no terminal for the
+// editor to run in.
+func requireEditorTerminal() error {
+	if !stdinIsTerminal() {
+		return output.ErrUsage("cannot use --edit when stdin is not a terminal")
+	}
+	return nil
+}
+
+// editNewContent opens $EDITOR on an empty buffer and returns the markdown
+// written there. Callers convert it like any other markdown content.
+func editNewContent() (string, error) {
+	if err := requireEditorTerminal(); err != nil {
+		return "", err
+	}
+	content, err := openEditor("")
+	if err != nil {
+		return "", output.ErrUsage(err.Error())
+	}
+	return content, nil
+}
+
+// editExistingContent opens $EDITOR pre-filled with existing rich text
+// converted to markdown. Like the TUI's body editors it fails closed on
+// content the markdown round trip would drop — tables and attached files —
+// rather than silently stripping them on save. noun names the item in the
+// refusal ("message", "comment", "document").
+func editExistingContent(html, noun string) (string, error) {
+	if err := requireEditorTerminal(); err != nil {
+		return "", err
+	}
+	if richtext.HasTableHTML(html) {
+		return "", output.ErrUsage(fmt.Sprintf("This %s contains a table — edit it on Basecamp web", noun))
+	}
+	if len(richtext.ParseAttachments(html)) > 0 {
+		return "", output.ErrUsage(fmt.Sprintf("This %s has attached files — edit it on Basecamp web", noun))
+	}
+
+	var initial string
+	if html != "" {
+		initial = richtext.HTMLToMarkdown(html) + "\n"
+	}
+	content, err := openEditor(initial)
+	if err != nil {
+		return "", output.ErrUsage(err.Error())
+	}
+	return content, nil
+}
@@ -0,0 +1,122 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// stubEditor stands in for a terminal and $EDITOR for the rest of the test.
+// edit receives the pre-filled buffer and returns the saved content.
+func stubEditor(t *testing.T, terminal bool, edit func(initial string) (string, error)) {
+	t.Helper()
+	origTerminal, origOpen := stdinIsTerminal, openEditor
+	t.Cleanup(func() { stdinIsTerminal, openEditor = origTerminal, origOpen })
+	stdinIsTerminal = func() bool { return terminal }
+	openEditor = edit
+}
+
+func TestEditExistingContentPrefillsMarkdown(t *testing.T) {
+	var initial string
+	stubEditor(t, true, func(s string) (string, error) {
+		initial = s
+		return "edited", nil
+	})
+
+	content, err := editExistingContent("<div>Ship it <strong>today</strong></div>", "message")
+	require.NoError(t, err)
+	assert.Equal(t, "edited", content)
+	assert.Equal(t, "Ship it **today**\n", initial)
+}
+
+func TestEditExistingContentRefusesLossyContent(t *testing.T) {
+	stubEditor(t, true, func(string) (string, error) {
+		t.Fatal("editor must not open")
+		return "", nil
+	})
+
+	_, err := editExistingContent("<table><tr><td>x</td></tr></table>", "document")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "This document contains a table")
+
+	_, err = editExistingContent(`<div>See <bc-attachment sgid="abc" filename="plan.pdf"></bc-attachment></div>`, "comment")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "This comment has attached files")
+}
+
+func TestMessagesUpdateEditRequiresTerminal(t *testing.T) {
+	stubEditor(t, false, func(string) (string, error) {
+		t.Fatal("editor must not open")
+		return "", nil
+	})
+	app, _ := setupTestApp(t)
+
+	cmd := newMessagesUpdateCmd()
+	err := executeCommand(cmd, app, "789", "--edit")
+	require.Error(t, err)
+	var outErr *output.Error
+	require.True(t, errors.As(err, &outErr))
+	assert.Equal(t, "cannot use --edit when stdin is not a terminal", outErr.Message)
+}
+
+func TestMessagesUpdateEditRejectsBody(t *testing.T) {
+	app, _ := setupTestApp(t)
+
+	cmd := newMessagesUpdateCmd()
+	err := executeCommand(cmd, app, "789", "--edit", "--body", "text")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cannot combine --edit and --body")
+}
+
+func TestCommentsUpdateEditSubmitsEditedContent(t *testing.T) {
+	var initial string
+	stubEditor(t, true, func(s string) (string, error) {
+		initial = s
+		return "Revised **comment**", nil
+	})
+	transport := &mockCommentWriteTransport{}
+	app, _ := setupCommentsWriteTestApp(t, transport)
+
+	cmd := newCommentsUpdateCmd()
+	require.NoError(t, executeCommand(cmd, app, "1234", "--edit"))
+	assert.Equal(t, "ok\n", initial, "buffer is pre-filled with the current comment")
+
+	require.Len(t, transport.capturedBodies, 1)
+	var body map[string]string
+	require.NoError(t, json.Unmarshal(transport.capturedBodies[0], &body))
+	assert.Equal(t, "<p>Revised <strong>comment</strong></p>", body["content"])
+}
+
+func TestFilesUpdateEditPrefillsDocument(t *testing.T) {
+	var initial string
+	stubEditor(t, true, func(s string) (string, error) {
+		initial = s
+		return "Edited **body**", nil
+	})
+	transport := &mockFilesUpdateTransport{}
+	app := showTestApp(t, transport)
+	app.Config.ProjectID = "456"
+
+	cmd := NewFilesCmd()
+	require.NoError(t, executeMessagesCommand(cmd, app, "update", "999", "--edit"))
+	assert.Equal(t, "Existing body\n", initial)
+
+	var body map[string]any
+	require.NoError(t, json.Unmarshal(transport.capturedBody, &body))
+	assert.Equal(t, "Existing title", body["title"])
+	assert.Equal(t, "<p>Edited <strong>body</strong></p>", body["content"])
+}
+
+func TestFilesUpdateEditRejectsNonDocuments(t *testing.T) {
+	app, _ := setupTestApp(t)
+
+	cmd := NewFilesCmd()
+	err := executeCommand(cmd, app, "update", "999", "--edit", "--type", "upload")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--edit can only be used with documents")
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/basecamp/basecamp-cli/internal/config"
 	"github.com/basecamp/basecamp-cli/internal/hostutil"
 	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/presenter"
 	"github.com/basecamp/basecamp-cli/internal/tui/resolve"
 )
 
@@ -51,17 +52,37 @@ Config locations:
 }
 
 func newConfigShowCmd() *cobra.Command {
-	return &cobra.Command{
+	var sources bool
+
+	cmd := &cobra.Command{
 		Use:   "show",
 		Short: "Show effective configuration",
-		Long:  "Display the current effective configuration with source information.",
+		Long: `Display the current effective configuration with source information.
+
+--sources also gives the file behind each value, lists the config files
+consulted in load order, and checks them for unknown keys and for values
+that are ignored: invalid ones, and trust-gated keys in an untrusted repo
+or local config.`,
+		Example: `  basecamp config show
+  basecamp config show --sources`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runConfigShow(cmd)
+			return runConfigShow(cmd, sources)
 		},
 	}
+
+	cmd.Flags().BoolVar(&sources, "sources", false, "Show the file behind each value and check config files for problems")
+
+	return cmd
+}
+
+// configSources is the config show --sources result.
+type configSources struct {
+	Values   map[string]any   `json:"values"`
+	Files    []config.File    `json:"files"`
+	Problems []config.Problem `json:"problems"`
 }
 
-func runConfigShow(cmd *cobra.Command) error {
+func runConfigShow(cmd *cobra.Command, sources bool) error {
 	app := appctx.FromContext(cmd.Context())
 
 	// Build config with sources
@@ -81,6 +102,13 @@ func runConfigShow(cmd *cobra.Command) error {
 		{"format", app.Config.Format, app.Config.Format != ""},
 		{"hints", fmt.Sprintf("%t", app.Config.Hints != nil && *app.Config.Hints), app.Config.Hints != nil},
 		{"stats", fmt.Sprintf("%t", app.Config.Stats != nil && *app.Config.Stats), app.Config.Stats != nil},
+		{"interactive", fmt.Sprintf("%t", app.Config.Interactive != nil && *app.Config.Interactive), app.Config.Interactive != nil},
+		{"no_breadcrumbs", fmt.Sprintf("%t", app.Config.NoBreadcrumbs != nil && *app.Config.NoBreadcrumbs), app.Config.NoBreadcrumbs != nil},
+		{"no_context", fmt.Sprintf("%t", app.Config.NoContext != nil && *app.Config.NoContext), app.Config.NoContext != nil},
+		{"minimal_output", fmt.Sprintf("%t", app.Config.MinimalOutput != nil && *app.Config.MinimalOutput), app.Config.MinimalOutput != nil},
+		{"emoji", fmt.Sprintf("%t", app.Config.Emoji != nil && *app.Config.Emoji), app.Config.Emoji != nil},
+		{"usage_stats", fmt.Sprintf("%t", app.Config.UsageStats != nil && *app.Config.UsageStats), app.Config.UsageStats != nil},
+		{"audit_log", fmt.Sprintf("%t", app.Config.AuditLog != nil && *app.Config.AuditLog), app.Config.AuditLog != nil},
 		{"verbose", fmt.Sprintf("%d", derefInt(app.Config.Verbose)), app.Config.Verbose != nil},
 		{"llm_provider", app.Config.LLMProvider, app.Config.LLMProvider != "" && app.Config.LLMProvider != "auto"},
 		{"llm_model", app.Config.LLMModel, app.Config.LLMModel != ""},
@@ -88,6 +116,11 @@ func runConfigShow(cmd *cobra.Command) error {
 		{"llm_endpoint", app.Config.LLMEndpoint, app.Config.LLMEndpoint != ""},
 		{"llm_max_concurrent", fmt.Sprintf("%d", app.Config.LLMMaxConcurrent), app.Config.Sources["llm_max_concurrent"] != ""},
 		{"llm_token_budget", fmt.Sprintf("%d", app.Config.LLMTokenBudget), app.Config.Sources["llm_token_budget"] != ""},
+		{"tui_mute", strings.Join(app.Config.TUIMute, ","), len(app.Config.TUIMute) > 0},
+		{"tui_theme", app.Config.TUITheme, app.Config.TUITheme != ""},
+		{"timezone", app.Config.Timezone, app.Config.Timezone != ""},
+		{"confirm", app.Config.Confirm, app.Config.Confirm != ""},
+		{"redact", strings.Join(app.Config.Redact, ","), len(app.Config.Redact) > 0},
 	}
 
 	for _, k := range keys {
@@ -115,21 +148,106 @@ func runConfigShow(cmd *cobra.Command) error {
 		}
 	}
 
-	return app.OK(configData,
-		output.WithSummary("Effective configuration"),
-		output.WithBreadcrumbs(
-			output.Breadcrumb{
-				Action:      "set",
-				Cmd:         "basecamp config set <key> <value>",
-				Description: "Set config value",
-			},
-			output.Breadcrumb{
-				Action:      "project",
-				Cmd:         "basecamp config project",
-				Description: "Select project",
-			},
-		),
+	// Show HTTP client tuning.
+	for _, name := range config.HTTPSettingNames() {
+		source := app.Config.Sources["http."+name]
+		if source == "" {
+			continue
+		}
+		configData["http."+name] = map[string]string{
+			"value":  app.Config.HTTP.Value(name),
+			"source": source,
+		}
+	}
+
+	// Show TUI refresh intervals.
+	for _, view := range config.RefreshViewNames() {
+		source := app.Config.Sources["refresh."+view]
+		if source == "" {
+			continue
+		}
+		configData["refresh."+view] = map[string]string{
+			"value":  app.Config.Refresh.Value(view),
+			"source": source,
+		}
+	}
+
+	// Show per-entity list column preferences.
+	for entity, columns := range app.Config.Columns {
+		source := app.Config.Sources["columns."+entity]
+		if source == "" {
+			source = "default"
+		}
+		configData["columns."+entity] = map[string]string{
+			"value":  strings.Join(columns, ","),
+			"source": source,
+		}
+	}
+
+	// Show saved cards list filters.
+	for _, name := range app.Config.CardFilterNames() {
+		configData["card_filters."+name] = map[string]string{
+			"value":  app.Config.CardFilters[name].String(),
+			"source": app.Config.Sources["card_filters."+name],
+		}
+	}
+
+	// Show custom TUI palette actions.
+	for _, name := range app.Config.PaletteActionNames() {
+		configData["palette_actions."+name] = map[string]string{
+			"value":  app.Config.PaletteActions[name].Command(),
+			"source": app.Config.Sources["palette_actions."+name],
+		}
+	}
+
+	breadcrumbs := output.WithBreadcrumbs(
+		output.Breadcrumb{
+			Action:      "set",
+			Cmd:         "basecamp config set <key> <value>",
+			Description: "Set config value",
+		},
+		output.Breadcrumb{
+			Action:      "project",
+			Cmd:         "basecamp config project",
+			Description: "Select project",
+		},
 	)
+	if !sources {
+		return app.OK(configData,
+			output.WithSummary("Effective configuration"),
+			breadcrumbs,
+		)
+	}
+
+	for key, v := range configData {
+		if path := app.Config.Origin(key); path != "" {
+			v.(map[string]string)["path"] = path
+		}
+	}
+	result := configSources{Values: configData, Problems: []config.Problem{}}
+	trust := config.LoadTrustStore(config.GlobalConfigDir())
+	loaded := 0
+	for _, f := range config.Files() {
+		result.Files = append(result.Files, f)
+		if f.Exists {
+			loaded++
+			result.Problems = append(result.Problems, config.CheckFile(f, trust)...)
+		}
+	}
+
+	summary := fmt.Sprintf("Effective configuration from %d config files", loaded)
+	if loaded == 1 {
+		summary = "Effective configuration from 1 config file"
+	}
+	opts := []output.ResponseOption{output.WithSummary(summary), breadcrumbs}
+	if n := len(result.Problems); n > 0 {
+		notice := fmt.Sprintf("%d problems in config files", n)
+		if n == 1 {
+			notice = "1 problem in config files"
+		}
+		opts = append(opts, output.WithDiagnostic(notice+"; see problems"))
+	}
+	return app.OK(result, opts...)
 }
 
 func newConfigInitCmd() *cobra.Command {
@@ -187,9 +305,31 @@ func newConfigSetCmd() *cobra.Command {
 		Long: `Set a configuration value in the local or global config file.
 
 Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_dir,
-            cache_enabled, format, scope, default_profile, hints, stats, verbose,
-            onboarded, llm_provider (or llm), llm_model, llm_api_key, llm_endpoint,
-            llm_max_concurrent, llm_token_budget, experimental.<feature>`,
+            cache_enabled, format, scope, default_profile, hints, stats, usage_stats,
+            interactive, no_breadcrumbs, no_context, verbose, onboarded, llm_provider (or llm), llm_model, llm_api_key, llm_endpoint,
+            minimal_output (styled and Markdown output without the summary
+            line and hints), emoji (✅/⚠️/❌ status markers in styled and
+            Markdown output, for pasting into chat),
+            llm_max_concurrent, llm_token_budget, experimental.<feature>,
+            columns.<entity> (comma-separated list columns, e.g. columns.todo title,due_on),
+            card_filters.<name> (saved "cards list --filter": column, assignee,
+            due_within, title regex; e.g. assignee=me,due_within=7d),
+            http.<setting> (API client tuning: timeout, max_retries, base_delay,
+            max_jitter, max_concurrent; durations like 30s or 500ms),
+            refresh.<view> (TUI polling: activity, bonfire, campfire, hey, timeline,
+            todos; a duration like 15s, or off),
+            tui_mute (TUI projects/tools without toasts or badges, comma-separated:
+            123 mutes a project, 123/chat one of its tools),
+            tui_theme (TUI palette: auto, dark, light, or none),
+            timezone (IANA zone dates are read and shown in, e.g. Europe/Berlin;
+            default the terminal's),
+            confirm (when to ask before changes: always, destructive, or never;
+            default destructive — trash, delete, and bulk operations),
+            redact (what --redact masks, comma-separated: emails, urls,
+            signed_urls, field:<json key>; default emails,signed_urls),
+            audit_log (append every mutating API call to
+            ~/.local/state/basecamp/audit.jsonl: time, command, method, path,
+            payload hash, result)`,
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
@@ -210,6 +350,13 @@ Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_di
 				"default_profile":    true,
 				"hints":              true,
 				"stats":              true,
+				"interactive":        true,
+				"no_breadcrumbs":     true,
+				"no_context":         true,
+				"minimal_output":     true,
+				"emoji":              true,
+				"usage_stats":        true,
+				"audit_log":          true,
 				"verbose":            true,
 				"onboarded":          true,
 				"llm_provider":       true,
@@ -218,15 +365,24 @@ Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_di
 				"llm_endpoint":       true,
 				"llm_max_concurrent": true,
 				"llm_token_budget":   true,
+				"tui_mute":           true,
+				"tui_theme":          true,
+				"timezone":           true,
+				"confirm":            true,
+				"redact":             true,
 			}
 			isExperimentalKey := strings.HasPrefix(key, "experimental.")
-			if !validKeys[key] && !isExperimentalKey {
+			isColumnsKey := strings.HasPrefix(key, "columns.")
+			isHTTPKey := strings.HasPrefix(key, "http.")
+			isRefreshKey := strings.HasPrefix(key, "refresh.")
+			isCardFilterKey := strings.HasPrefix(key, "card_filters.")
+			if !validKeys[key] && !isExperimentalKey && !isColumnsKey && !isHTTPKey && !isRefreshKey && !isCardFilterKey {
 				names := make([]string, 0, len(validKeys))
 				for k := range validKeys {
 					names = append(names, k)
 				}
 				sort.Strings(names)
-				return output.ErrUsage(fmt.Sprintf("Invalid config key %q. Valid keys: %s, experimental.<feature>", key, strings.Join(names, ", ")))
+				return output.ErrUsage(fmt.Sprintf("Invalid config key %q. Valid keys: %s, experimental.<feature>, columns.<entity>, http.<setting>, refresh.<view>, card_filters.<name>", key, strings.Join(names, ", ")))
 			}
 
 			var configPath string
@@ -275,7 +431,7 @@ Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_di
 			// Set value with type-specific validation
 			valueOut := value
 			switch key {
-			case "cache_enabled", "hints", "stats", "onboarded":
+			case "cache_enabled", "hints", "stats", "usage_stats", "audit_log", "onboarded", "interactive", "no_breadcrumbs", "no_context", "minimal_output", "emoji":
 				boolVal, ok := parseBoolFlag(value)
 				if !ok {
 					return output.ErrUsage(fmt.Sprintf("%s must be true/false (or 1/0)", key))
@@ -323,6 +479,35 @@ Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_di
 				}
 				configData[key] = level
 				valueOut = value
+			case "tui_mute":
+				entries, err := config.ParseMuteList(value)
+				if err != nil {
+					return output.ErrUsage(err.Error())
+				}
+				configData[key] = entries
+				valueOut = strings.Join(entries, ",")
+			case "redact":
+				rules, err := config.ParseRedactList(value)
+				if err != nil {
+					return output.ErrUsage(err.Error())
+				}
+				configData[key] = rules
+				valueOut = strings.Join(rules, ",")
+			case "tui_theme":
+				if !config.IsTUITheme(value) {
+					return output.ErrUsage(fmt.Sprintf("tui_theme must be one of: %s (got %q)", strings.Join(config.TUIThemes, ", "), value))
+				}
+				configData[key] = value
+			case "timezone":
+				if _, err := config.LoadTimezone(value); err != nil {
+					return output.ErrUsage(err.Error())
+				}
+				configData[key] = value
+			case "confirm":
+				if !config.IsConfirmPolicy(value) {
+					return output.ErrUsage(fmt.Sprintf("confirm must be one of: %s (got %q)", strings.Join(config.ConfirmPolicies, ", "), value))
+				}
+				configData[key] = value
 			default:
 				if isExperimentalKey {
 					feature := strings.TrimPrefix(key, "experimental.")
@@ -340,6 +525,60 @@ Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_di
 					expMap[feature] = boolVal
 					configData["experimental"] = expMap
 					valueOut = fmt.Sprintf("%t", boolVal)
+				} else if isColumnsKey {
+					entity := strings.TrimPrefix(key, "columns.")
+					columns, err := validateListColumns(entity, value)
+					if err != nil {
+						return err
+					}
+					colMap, _ := configData["columns"].(map[string]any)
+					if colMap == nil {
+						colMap = make(map[string]any)
+					}
+					colMap[entity] = columns
+					configData["columns"] = colMap
+					valueOut = strings.Join(columns, ",")
+				} else if isHTTPKey {
+					name := strings.TrimPrefix(key, "http.")
+					parsed, err := config.ParseHTTPSetting(name, value)
+					if err != nil {
+						return output.ErrUsage(err.Error())
+					}
+					httpMap, _ := configData["http"].(map[string]any)
+					if httpMap == nil {
+						httpMap = make(map[string]any)
+					}
+					httpMap[name] = parsed
+					configData["http"] = httpMap
+				} else if isRefreshKey {
+					view := strings.TrimPrefix(key, "refresh.")
+					parsed, err := config.ParseRefreshSetting(view, value)
+					if err != nil {
+						return output.ErrUsage(err.Error())
+					}
+					refreshMap, _ := configData["refresh"].(map[string]any)
+					if refreshMap == nil {
+						refreshMap = make(map[string]any)
+					}
+					refreshMap[view] = parsed
+					configData["refresh"] = refreshMap
+					valueOut = parsed
+				} else if isCardFilterKey {
+					name := strings.TrimPrefix(key, "card_filters.")
+					if name == "" {
+						return output.ErrUsage("card_filters key must name the filter: card_filters.<name>")
+					}
+					filter, err := config.ParseCardFilter(value)
+					if err != nil {
+						return output.ErrUsage(err.Error())
+					}
+					filterMap, _ := configData["card_filters"].(map[string]any)
+					if filterMap == nil {
+						filterMap = make(map[string]any)
+					}
+					filterMap[name] = filter.ToMap()
+					configData["card_filters"] = filterMap
+					valueOut = filter.String()
 				} else {
 					configData[key] = value
 				}
@@ -396,6 +635,33 @@ Valid keys: account_id, project_id (or project), todolist_id, base_url, cache_di
 	return cmd
 }
 
+// validateListColumns checks a columns.<entity> value against the entity's
+// presenter schema and returns the column names in order.
+func validateListColumns(entity, value string) ([]string, error) {
+	if entity == "" {
+		return nil, output.ErrUsage("columns key must name an entity: columns.<entity> (e.g. columns.todo)")
+	}
+	schema := presenter.LookupByName(entity)
+	if schema == nil {
+		return nil, output.ErrUsage(fmt.Sprintf("Unknown entity %q for columns.<entity>", entity))
+	}
+	columns := config.ParseColumns(value)
+	if len(columns) == 0 {
+		return nil, output.ErrUsage("columns value must be a comma-separated list of fields, e.g. title,due_on")
+	}
+	for _, col := range columns {
+		if _, ok := schema.ListField(col); !ok {
+			fields := make([]string, 0, len(schema.Fields))
+			for name := range schema.Fields {
+				fields = append(fields, name)
+			}
+			sort.Strings(fields)
+			return nil, output.ErrUsage(fmt.Sprintf("Unknown %s field %q (available: %s)", entity, col, strings.Join(fields, ", ")))
+		}
+	}
+	return columns, nil
+}
+
 // configKeyAliases maps short names to canonical config keys.
 var configKeyAliases = map[string]string{
 	"llm":     "llm_provider",
@@ -496,24 +762,23 @@ func newConfigUnsetCmd() *cobra.Command {
 			}
 
 			// Check if key exists and remove it
-			if strings.HasPrefix(key, "experimental.") {
-				feature := strings.TrimPrefix(key, "experimental.")
-				expMap, _ := configData["experimental"].(map[string]any)
-				if expMap == nil {
+			if section, name, nested := strings.Cut(key, "."); nested && (section == "experimental" || section == "columns" || section == "http" || section == "refresh" || section == "card_filters") {
+				subMap, _ := configData[section].(map[string]any)
+				if subMap == nil {
 					return app.OK(map[string]any{
 						"key": key, "status": "not_set",
 					}, output.WithSummary(fmt.Sprintf("Key not set: %s", key)))
 				}
-				if _, exists := expMap[feature]; !exists {
+				if _, exists := subMap[name]; !exists {
 					return app.OK(map[string]any{
 						"key": key, "status": "not_set",
 					}, output.WithSummary(fmt.Sprintf("Key not set: %s", key)))
 				}
-				delete(expMap, feature)
-				if len(expMap) == 0 {
-					delete(configData, "experimental")
+				delete(subMap, name)
+				if len(subMap) == 0 {
+					delete(configData, section)
 				} else {
-					configData["experimental"] = expMap
+					configData[section] = subMap
 				}
 			} else {
 				if _, exists := configData[key]; !exists {
@@ -462,8 +462,8 @@ func setupConfigProjectTestApp(t *testing.T) (*appctx.App, *bytes.Buffer, *httpt
 // registering the persistent --project flag that normally lives on the root command.
 func executeConfigProjectCmd(app *appctx.App, extraArgs ...string) error {
 	cmd := NewConfigCmd()
-	cmd.PersistentFlags().StringVarP(&app.Flags.Project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&app.Flags.Project, "in", "", "Project ID or name (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&app.Flags.Project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&app.Flags.Project, "in", "", "Project name, ID, or URL (alias for --project)")
 	args := append([]string{"project"}, extraArgs...)
 	cmd.SetArgs(args)
 	ctx := appctx.WithApp(context.Background(), app)
@@ -519,6 +519,209 @@ func TestConfigSet_LLMProviderValidation(t *testing.T) {
 	assert.Contains(t, err.Error(), "disabled")
 }
 
+func TestConfigSet_Columns(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	err := executeConfigCommand(app, "set", "columns.todo", "title, due_on,assignees")
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, map[string]any{"todo": []any{"title", "due_on", "assignees"}}, saved["columns"])
+
+	// Unknown entities and fields are rejected with the available fields.
+	err = executeConfigCommand(app, "set", "columns.widget", "title")
+	require.Error(t, err)
+	err = executeConfigCommand(app, "set", "columns.todo", "title,bogus")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "due_on")
+
+	err = executeConfigCommand(app, "unset", "columns.todo")
+	require.NoError(t, err)
+
+	data, err = os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	saved = nil
+	require.NoError(t, json.Unmarshal(data, &saved))
+	_, exists := saved["columns"]
+	assert.False(t, exists)
+}
+
+func TestConfigSet_HTTPSection(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "http.timeout", "90s"))
+	require.NoError(t, executeConfigCommand(app, "set", "http.max_retries", "5"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, map[string]any{"timeout": "90s", "max_retries": float64(5)}, saved["http"])
+
+	// Out-of-range values and unknown settings are rejected.
+	err = executeConfigCommand(app, "set", "http.timeout", "2h")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "1s to 10m")
+	err = executeConfigCommand(app, "set", "http.proxy", "x")
+	require.Error(t, err)
+
+	require.NoError(t, executeConfigCommand(app, "unset", "http.timeout"))
+	require.NoError(t, executeConfigCommand(app, "unset", "http.max_retries"))
+
+	data, err = os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	saved = nil
+	require.NoError(t, json.Unmarshal(data, &saved))
+	_, exists := saved["http"]
+	assert.False(t, exists)
+}
+
+func TestConfigSet_RefreshSection(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "refresh.campfire", "15s"))
+	require.NoError(t, executeConfigCommand(app, "set", "refresh.todos", "OFF"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, map[string]any{"campfire": "15s", "todos": "off"}, saved["refresh"])
+
+	// Out-of-range intervals and unknown views are rejected.
+	err = executeConfigCommand(app, "set", "refresh.campfire", "1s")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "5s to 1h")
+	err = executeConfigCommand(app, "set", "refresh.inbox", "30s")
+	require.Error(t, err)
+
+	require.NoError(t, executeConfigCommand(app, "unset", "refresh.campfire"))
+	require.NoError(t, executeConfigCommand(app, "unset", "refresh.todos"))
+
+	data, err = os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	saved = nil
+	require.NoError(t, json.Unmarshal(data, &saved))
+	_, exists := saved["refresh"]
+	assert.False(t, exists)
+}
+
+func TestConfigSet_TUIMute(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "tui_mute", "456/Chat,123"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, []any{"123", "456/chat"}, saved["tui_mute"])
+
+	err = executeConfigCommand(app, "set", "tui_mute", "123/pings")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown tool "pings"`)
+}
+
+func TestConfigSet_TUITheme(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "tui_theme", "dark"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, "dark", saved["tui_theme"])
+
+	err = executeConfigCommand(app, "set", "tui_theme", "solarized")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "auto, dark, light, none")
+}
+
+func TestConfigSet_Timezone(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "timezone", "Europe/Berlin"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, "Europe/Berlin", saved["timezone"])
+
+	err = executeConfigCommand(app, "set", "timezone", "Berlin")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `unknown timezone "Berlin"`)
+}
+
+func TestConfigSet_Confirm(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "confirm", "never"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, "never", saved["confirm"])
+
+	err = executeConfigCommand(app, "set", "confirm", "sometimes")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "always, destructive, never")
+}
+
 func TestConfigUnset_ProjectAlias(t *testing.T) {
 	app, _ := setupConfigTestApp(t)
 
@@ -612,3 +815,114 @@ func TestConfigProject_NoFlag_NonInteractive(t *testing.T) {
 	err := executeConfigProjectCmd(app)
 	assert.Error(t, err)
 }
+
+func TestConfigSet_CardFilter(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "card_filters.mine-due-soon", "assignee=me,due_within=7d"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, map[string]any{
+		"mine-due-soon": map[string]any{"assignee": "me", "due_within": "7d"},
+	}, saved["card_filters"])
+
+	err = executeConfigCommand(app, "set", "card_filters.bad", "title=(")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid title pattern")
+
+	require.NoError(t, executeConfigCommand(app, "unset", "card_filters.mine-due-soon"))
+	data, err = os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "card_filters")
+}
+
+func TestConfigSet_NoBreadcrumbs(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "no_breadcrumbs", "true"))
+	require.NoError(t, executeConfigCommand(app, "set", "no_context", "1"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, true, saved["no_breadcrumbs"])
+	assert.Equal(t, true, saved["no_context"])
+
+	err = executeConfigCommand(app, "set", "no_context", "sometimes")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "must be true/false")
+}
+
+func TestConfigSet_Redact(t *testing.T) {
+	app, _ := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	origDir, _ := os.Getwd()
+	require.NoError(t, os.Chdir(tmpDir))
+	defer os.Chdir(origDir)
+
+	require.NoError(t, os.MkdirAll(".basecamp", 0755))
+
+	require.NoError(t, executeConfigCommand(app, "set", "redact", "emails,field:attachable_sgid"))
+
+	data, err := os.ReadFile(filepath.Join(tmpDir, ".basecamp", "config.json"))
+	require.NoError(t, err)
+	var saved map[string]any
+	require.NoError(t, json.Unmarshal(data, &saved))
+	assert.Equal(t, []any{"emails", "field:attachable_sgid"}, saved["redact"])
+
+	err = executeConfigCommand(app, "set", "redact", "phones")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid redact rule")
+}
+
+func TestConfigShow_Sources(t *testing.T) {
+	app, buf := setupConfigTestApp(t)
+
+	tmpDir, _ := filepath.EvalSymlinks(t.TempDir())
+	localPath := filepath.Join(tmpDir, ".basecamp", "config.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0755))
+	require.NoError(t, os.WriteFile(localPath, []byte(`{"project_id":"42","todo_list_id":"7"}`), 0644))
+	t.Chdir(tmpDir)
+
+	cfg, err := config.Load(config.FlagOverrides{})
+	require.NoError(t, err)
+	app.Config = cfg
+
+	require.NoError(t, executeConfigCommand(app, "show", "--sources"))
+
+	var result struct {
+		Values   map[string]map[string]string `json:"values"`
+		Files    []config.File                `json:"files"`
+		Problems []config.Problem             `json:"problems"`
+	}
+	parseEnvelopeData(t, buf, &result)
+
+	assert.Equal(t, map[string]string{"value": "42", "source": "local", "path": localPath}, result.Values["project_id"])
+	require.NotEmpty(t, result.Files)
+	last := result.Files[len(result.Files)-1]
+	assert.Equal(t, config.SourceLocal, last.Source)
+	assert.Equal(t, localPath, last.Path)
+	assert.True(t, last.Exists)
+	require.Len(t, result.Problems, 1)
+	assert.Equal(t, "todo_list_id", result.Problems[0].Key)
+	assert.Equal(t, "unknown key (did you mean todolist_id?)", result.Problems[0].Message)
+}
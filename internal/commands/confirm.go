@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/tui"
+)
+
+// confirmKind classifies a change for the confirm policy.
+type confirmKind int
+
+const (
+	confirmChange      confirmKind = iota // reversible: archive, restore
+	confirmDestructive                    // trash and bulk operations
+	confirmPermanent                      // deletes that can't be undone
+)
+
+// confirmAction asks before a change when the confirm policy calls for it
+// and reports whether to go ahead. It never prompts under --yes, when the
+// command's own --force is set (force), or when the command isn't
+// interactive. A canceled prompt counts as no.
+func confirmAction(cmd *cobra.Command, kind confirmKind, prompt string, force bool) bool {
+	if force || isNonInteractiveCommand(cmd) || !isTerminal(cmd.InOrStdin()) || !isTerminal(cmd.OutOrStdout()) {
+		return true
+	}
+	policy, yes := config.ConfirmDestructive, false
+	if app := appctx.FromContext(cmd.Context()); app != nil {
+		yes = app.Flags.Yes
+		if app.Config != nil && app.Config.Confirm != "" {
+			policy = app.Config.Confirm
+		}
+	}
+	if !confirmRequired(policy, yes, kind) {
+		return true
+	}
+
+	var confirmed bool
+	var err error
+	if kind == confirmPermanent {
+		confirmed, err = tui.ConfirmDangerous(prompt)
+	} else {
+		confirmed, err = tui.Confirm(prompt, false)
+	}
+	return err == nil && confirmed
+}
+
+// isTerminal reports whether a command's stdin or stdout is a terminal; a
+// prompt can't be answered otherwise.
+func isTerminal(v any) bool {
+	f, ok := v.(*os.File)
+	if !ok {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
+
+// confirmRequired applies the confirm policy: "always" asks about every
+// confirmable change, "destructive" only about trash, deletes, and bulk
+// operations, and "never" asks about nothing. --yes skips them all.
+func confirmRequired(policy string, yes bool, kind confirmKind) bool {
+	if yes {
+		return false
+	}
+	switch policy {
+	case config.ConfirmAlways:
+		return true
+	case config.ConfirmNever:
+		return false
+	default:
+		return kind >= confirmDestructive
+	}
+}
@@ -0,0 +1,32 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/basecamp/basecamp-cli/internal/config"
+)
+
+func TestConfirmRequired(t *testing.T) {
+	tests := []struct {
+		policy string
+		yes    bool
+		kind   confirmKind
+		want   bool
+	}{
+		{"", false, confirmChange, false},
+		{"", false, confirmDestructive, true},
+		{"", false, confirmPermanent, true},
+		{config.ConfirmDestructive, false, confirmChange, false},
+		{config.ConfirmDestructive, false, confirmDestructive, true},
+		{config.ConfirmAlways, false, confirmChange, true},
+		{config.ConfirmAlways, true, confirmPermanent, false},
+		{config.ConfirmNever, false, confirmPermanent, false},
+		{config.ConfirmDestructive, true, confirmDestructive, false},
+	}
+	for _, tt := range tests {
+		got := confirmRequired(tt.policy, tt.yes, tt.kind)
+		assert.Equal(t, tt.want, got, "policy=%q yes=%v kind=%d", tt.policy, tt.yes, tt.kind)
+	}
+}
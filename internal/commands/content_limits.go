@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// addContentForceFlag registers --force on a command that sends rich text,
+// letting content over richtext.MaxContentBytes through.
+func addContentForceFlag(cmd *cobra.Command) {
+	cmd.Flags().Bool("force", false, "Send content over the 1 MB size limit anyway")
+}
+
+// guardContent drops inline data: images from outgoing HTML, which Basecamp
+// rejects, and refuses bodies over richtext.MaxContentBytes unless --force
+// is set. Both cases warn on stderr.
+func guardContent(cmd *cobra.Command, htmlStr string) (string, error) {
+	htmlStr, stripped := richtext.StripDataURIImages(htmlStr)
+	if stripped > 0 {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Warning: removed %d inline data: %s (Basecamp rejects them); attach files with ![alt](./path) instead\n",
+			stripped, pluralize(stripped, "image", "images"))
+	}
+
+	size := len(htmlStr)
+	if size <= richtext.MaxContentBytes {
+		return htmlStr, nil
+	}
+	msg := fmt.Sprintf("Content is %s, over the %s limit", richtext.FormatBytes(size), richtext.FormatBytes(richtext.MaxContentBytes))
+	if f := cmd.Flags().Lookup("force"); f == nil || f.Value.String() != "true" {
+		hint := "Trim it or move large pieces into attachments (--attach)"
+		if f != nil {
+			hint += ", or pass --force to send it anyway"
+		}
+		return "", output.ErrUsageHint(msg, hint)
+	}
+	fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s; sending anyway (--force)\n", msg)
+	return htmlStr, nil
+}
@@ -0,0 +1,75 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+func newGuardTestCmd(withForce bool) (*cobra.Command, *bytes.Buffer) {
+	cmd := &cobra.Command{Use: "test"}
+	if withForce {
+		addContentForceFlag(cmd)
+	}
+	stderr := &bytes.Buffer{}
+	cmd.SetErr(stderr)
+	return cmd, stderr
+}
+
+func TestGuardContentStripsDataURIImages(t *testing.T) {
+	cmd, stderr := newGuardTestCmd(true)
+
+	out, err := guardContent(cmd, `<p>hi</p><img src="data:image/png;base64,AAAA">`)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>hi</p>", out)
+	assert.Contains(t, stderr.String(), "removed 1 inline data: image")
+}
+
+func TestGuardContentRefusesOversizedContent(t *testing.T) {
+	cmd, _ := newGuardTestCmd(true)
+	big := "<p>" + strings.Repeat("x", richtext.MaxContentBytes) + "</p>"
+
+	_, err := guardContent(cmd, big)
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeUsage, e.Code)
+	assert.Contains(t, e.Message, "over the 1.0 MB limit")
+	assert.Contains(t, e.Hint, "--force")
+}
+
+func TestGuardContentForceSendsOversizedContent(t *testing.T) {
+	cmd, stderr := newGuardTestCmd(true)
+	require.NoError(t, cmd.Flags().Set("force", "true"))
+	big := "<p>" + strings.Repeat("x", richtext.MaxContentBytes) + "</p>"
+
+	out, err := guardContent(cmd, big)
+	require.NoError(t, err)
+	assert.Equal(t, big, out)
+	assert.Contains(t, stderr.String(), "sending anyway")
+}
+
+func TestGuardContentHintOmitsForceWhenUnavailable(t *testing.T) {
+	cmd, _ := newGuardTestCmd(false)
+
+	_, err := guardContent(cmd, strings.Repeat("x", richtext.MaxContentBytes+1))
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.NotContains(t, e.Hint, "--force")
+}
+
+func TestCommentsCreateRefusesOversizedContentBeforeSending(t *testing.T) {
+	transport := &mockCommentWriteTransport{}
+	app, _ := setupCommentsWriteTestApp(t, transport)
+
+	err := executeCommand(newCommentsCreateCmd(), app, "789", strings.Repeat("x", richtext.MaxContentBytes+1))
+	require.Error(t, err)
+	assert.Empty(t, transport.capturedBodies, "oversized content must not reach the API")
+}
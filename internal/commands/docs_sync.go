@@ -0,0 +1,531 @@
+package commands
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/ignore"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// docsSyncManifestName is the manifest written to the root of a synced
+// directory. It is meant to be committed alongside the docs so everyone
+// publishing from the repo updates the same Basecamp documents.
+const docsSyncManifestName = ".basecamp-docs.json"
+
+// Sync actions, as reported in the plan.
+const (
+	docsSyncCreate    = "create"
+	docsSyncUpdate    = "update"
+	docsSyncPull      = "pull"
+	docsSyncUnchanged = "unchanged"
+	docsSyncConflict  = "conflict"
+	docsSyncMissing   = "missing"
+	docsSyncOrphaned  = "orphaned"
+)
+
+type docsSyncManifest struct {
+	Version   int                       `json:"version"`
+	ProjectID string                    `json:"project_id"`
+	FolderID  int64                     `json:"folder_id"`
+	Documents map[string]*docsSyncEntry `json:"documents"`
+}
+
+// docsSyncEntry links a local file (the map key, slash-separated and
+// relative to the sync root) to a Basecamp document. Hash is the file's
+// content at the last sync; UpdatedAt is the document's updated_at at the
+// last sync. Comparing both against their current values tells which side
+// changed.
+type docsSyncEntry struct {
+	ID        int64     `json:"id"`
+	FolderID  int64     `json:"folder_id"`
+	Hash      string    `json:"sha256"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type docsSyncItem struct {
+	Path       string `json:"path"`
+	Action     string `json:"action"`
+	Title      string `json:"title,omitempty"`
+	DocumentID int64  `json:"document_id,omitempty"`
+
+	local *docsSyncLocal
+}
+
+type docsSyncLocal struct {
+	rel   string
+	path  string
+	title string
+	body  string
+	hash  string
+}
+
+type docsSyncResult struct {
+	Path      string         `json:"path"`
+	ProjectID string         `json:"project_id"`
+	FolderID  int64          `json:"folder_id"`
+	DryRun    bool           `json:"dry_run"`
+	Counts    map[string]int `json:"counts"`
+	Items     []docsSyncItem `json:"items"`
+	Skipped   []string       `json:"skipped"`
+}
+
+func newFilesSyncCmd(project, vaultID *string) *cobra.Command {
+	var dryRun bool
+	var force bool
+	var excludes []string
+
+	cmd := &cobra.Command{
+		Use:   "sync <dir>",
+		Short: "Sync a directory of Markdown files with documents",
+		Long: `Sync a local directory of Markdown files with Basecamp documents.
+
+Each .md or .markdown file maps to one document; subdirectories map to
+folders under the target folder (--vault, default: the project's root
+Docs & Files). A document's title is the file's leading "# Heading", or the
+file name when there is none.
+
+The mapping is kept in ` + docsSyncManifestName + ` at the root of the directory.
+Commit it with your docs so every sync updates the same documents. On each
+run:
+
+  create     new local files are published as new documents
+  update     local edits are pushed to Basecamp
+  pull       documents edited in Basecamp are written back to the local file
+  conflict   both sides changed since the last sync; skipped unless --force,
+             which overwrites Basecamp with the local file
+  missing    the document is no longer in its folder (trashed or moved)
+  orphaned   the local file was deleted; the document is left untouched
+
+Use --dry-run to see the plan without changing anything. Paths matching
+--exclude globs or the directory's .basecampignore file are skipped.`,
+		Example: `  basecamp docs sync ./docs --in "My Project" --dry-run
+  basecamp docs sync ./docs --in "My Project" --vault 12345
+  basecamp docs sync ./handbook --exclude drafts/ --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runDocsSync(cmd, *project, *vaultID, args[0], dryRun, force, excludes)
+		},
+	}
+
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the sync plan without making changes")
+	cmd.Flags().BoolVar(&force, "force", false, "Overwrite conflicting documents with local content")
+	cmd.Flags().StringArrayVar(&excludes, "exclude", nil, "Skip paths matching a gitignore-style glob (repeatable)")
+
+	return cmd
+}
+
+func runDocsSync(cmd *cobra.Command, project, vaultID, dir string, dryRun, force bool, excludes []string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	dir = richtext.NormalizeDragPath(dir)
+	info, err := os.Stat(dir)
+	if err != nil {
+		return fmt.Errorf("cannot access %s: %w", dir, err)
+	}
+	if !info.IsDir() {
+		return output.ErrUsage(fmt.Sprintf("%s is not a directory", dir))
+	}
+
+	manifest, err := loadDocsSyncManifest(dir)
+	if err != nil {
+		return err
+	}
+
+	matcher, err := ignore.Load(dir, excludes)
+	if err != nil {
+		return output.ErrUsage(err.Error())
+	}
+	locals, skipped, err := scanDocsSyncDir(dir, matcher)
+	if err != nil {
+		return err
+	}
+
+	// The manifest remembers where the docs were published, so later runs
+	// need no flags; explicit flags must agree with it.
+	if project == "" && app.Flags.Project == "" {
+		project = manifest.ProjectID
+	}
+	if vaultID == "" && manifest.FolderID != 0 {
+		vaultID = fmt.Sprintf("%d", manifest.FolderID)
+	}
+	projectID, folderID, err := resolveFilesFolder(cmd, app, project, vaultID)
+	if err != nil {
+		return err
+	}
+	if manifest.ProjectID != "" && manifest.ProjectID != projectID {
+		return output.ErrUsageHint(
+			fmt.Sprintf("%s was synced to project %s, not %s", docsSyncManifestName, manifest.ProjectID, projectID),
+			"Omit --in to use the manifest's project, or sync a fresh directory")
+	}
+	if manifest.FolderID != 0 && manifest.FolderID != folderID {
+		return output.ErrUsageHint(
+			fmt.Sprintf("%s was synced to folder %d, not %d", docsSyncManifestName, manifest.FolderID, folderID),
+			"Omit --vault to use the manifest's folder, or sync a fresh directory")
+	}
+	manifest.ProjectID, manifest.FolderID = projectID, folderID
+
+	remote, err := fetchDocsSyncRemote(cmd, app, manifest)
+	if err != nil {
+		return err
+	}
+	items := planDocsSync(manifest, locals, remote, force)
+
+	result := docsSyncResult{
+		Path:      dir,
+		ProjectID: projectID,
+		FolderID:  folderID,
+		DryRun:    dryRun,
+		Skipped:   skipped,
+	}
+	if result.Skipped == nil {
+		result.Skipped = []string{}
+	}
+
+	if !dryRun {
+		execErr := executeDocsSync(cmd, app, manifest, items)
+		// Save whatever succeeded, so a re-run after a failure does not
+		// create duplicate documents.
+		if err := saveDocsSyncManifest(dir, manifest); err != nil {
+			return err
+		}
+		if execErr != nil {
+			return execErr
+		}
+	}
+
+	result.Items = items
+	if result.Items == nil {
+		result.Items = []docsSyncItem{}
+	}
+	result.Counts = make(map[string]int)
+	for _, it := range items {
+		result.Counts[it.Action]++
+	}
+
+	opts := []output.ResponseOption{
+		output.WithSummary(docsSyncSummary(result)),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderDocsSyncStyled(w, r, result) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "tree",
+				Cmd:         fmt.Sprintf("basecamp files tree --vault %d --in %s", folderID, projectID),
+				Description: "Show folder hierarchy",
+			},
+		),
+	}
+	if n := result.Counts[docsSyncConflict]; n > 0 {
+		opts = append(opts, output.WithNotice(fmt.Sprintf(
+			"%d conflicting documents changed both locally and in Basecamp; re-run with --force to push local content", n)))
+	}
+	return app.OK(result, opts...)
+}
+
+func loadDocsSyncManifest(dir string) (*docsSyncManifest, error) {
+	manifest := &docsSyncManifest{Version: 1, Documents: map[string]*docsSyncEntry{}}
+
+	data, err := os.ReadFile(filepath.Join(dir, docsSyncManifestName)) //nolint:gosec // G304: path is the user's sync dir
+	if errors.Is(err, os.ErrNotExist) {
+		return manifest, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading %s: %w", docsSyncManifestName, err)
+	}
+	if err := json.Unmarshal(data, manifest); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", docsSyncManifestName, err)
+	}
+	if manifest.Documents == nil {
+		manifest.Documents = map[string]*docsSyncEntry{}
+	}
+	return manifest, nil
+}
+
+// saveDocsSyncManifest writes the manifest atomically, with sorted keys and
+// indentation so it diffs cleanly in version control.
+func saveDocsSyncManifest(dir string, manifest *docsSyncManifest) error {
+	data, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return err
+	}
+	data = append(data, '\n')
+
+	target := filepath.Join(dir, docsSyncManifestName)
+	tmp, err := os.CreateTemp(dir, docsSyncManifestName+".*")
+	if err != nil {
+		return fmt.Errorf("writing %s: %w", docsSyncManifestName, err)
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing %s: %w", docsSyncManifestName, err)
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing %s: %w", docsSyncManifestName, err)
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil { //nolint:gosec // G302: manifest is meant to be committed
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), target); err != nil {
+		os.Remove(tmp.Name())
+		return fmt.Errorf("writing %s: %w", docsSyncManifestName, err)
+	}
+	return nil
+}
+
+// scanDocsSyncDir collects the Markdown files under dir, sorted by path.
+func scanDocsSyncDir(dir string, m *ignore.Matcher) (locals []*docsSyncLocal, skipped []string, err error) {
+	err = filepath.WalkDir(dir, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		rel, err := filepath.Rel(dir, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if m.Match(rel, true) {
+				skipped = append(skipped, rel+"/")
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		ext := strings.ToLower(path.Ext(rel))
+		if !d.Type().IsRegular() || (ext != ".md" && ext != ".markdown") {
+			return nil
+		}
+		if m.Match(rel, false) {
+			skipped = append(skipped, rel)
+			return nil
+		}
+
+		local, err := readDocsSyncLocal(p, rel)
+		if err != nil {
+			return err
+		}
+		locals = append(locals, local)
+		return nil
+	})
+	sort.Slice(locals, func(i, j int) bool { return locals[i].rel < locals[j].rel })
+	return locals, skipped, err
+}
+
+func readDocsSyncLocal(p, rel string) (*docsSyncLocal, error) {
+	data, err := os.ReadFile(p) //nolint:gosec // G304: file under the user's sync dir
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", rel, err)
+	}
+	sum := sha256.Sum256(data)
+	title, body := splitDocTitle(string(data), path.Base(rel))
+	return &docsSyncLocal{rel: rel, path: p, title: title, body: body, hash: hex.EncodeToString(sum[:])}, nil
+}
+
+// splitDocTitle takes the title from a leading "# Heading" line, falling
+// back to the file name without its extension.
+func splitDocTitle(md, filename string) (title, body string) {
+	trimmed := strings.TrimLeft(md, " \t\r\n")
+	if strings.HasPrefix(trimmed, "# ") {
+		first, rest, _ := strings.Cut(trimmed, "\n")
+		return strings.TrimSpace(strings.TrimPrefix(first, "# ")), strings.TrimLeft(rest, "\r\n")
+	}
+	return strings.TrimSuffix(filename, path.Ext(filename)), md
+}
+
+// fetchDocsSyncRemote lists the documents in every folder the manifest
+// refers to, keyed by document ID.
+func fetchDocsSyncRemote(cmd *cobra.Command, app *appctx.App, manifest *docsSyncManifest) (map[int64]*basecamp.Document, error) {
+	folders := make(map[int64]bool)
+	for _, e := range manifest.Documents {
+		folders[e.FolderID] = true
+	}
+
+	remote := make(map[int64]*basecamp.Document)
+	for folderID := range folders {
+		result, err := app.Account().Documents().List(cmd.Context(), folderID, nil)
+		if err != nil {
+			var sdkErr *basecamp.Error
+			if errors.As(err, &sdkErr) && sdkErr.Code == basecamp.CodeNotFound {
+				continue // folder gone: its documents are reported missing
+			}
+			return nil, convertSDKError(err)
+		}
+		for i := range result.Documents {
+			doc := result.Documents[i]
+			remote[doc.ID] = &doc
+		}
+	}
+	return remote, nil
+}
+
+// planDocsSync decides what to do with each local file and manifest entry.
+func planDocsSync(manifest *docsSyncManifest, locals []*docsSyncLocal, remote map[int64]*basecamp.Document, force bool) []docsSyncItem {
+	var items []docsSyncItem
+	seen := make(map[string]bool, len(locals))
+
+	for _, local := range locals {
+		seen[local.rel] = true
+		item := docsSyncItem{Path: local.rel, Title: local.title, local: local}
+
+		entry, tracked := manifest.Documents[local.rel]
+		if !tracked {
+			item.Action = docsSyncCreate
+			items = append(items, item)
+			continue
+		}
+
+		item.DocumentID = entry.ID
+		doc, ok := remote[entry.ID]
+		if !ok {
+			item.Action = docsSyncMissing
+			items = append(items, item)
+			continue
+		}
+
+		localChanged := local.hash != entry.Hash
+		remoteChanged := doc.UpdatedAt.After(entry.UpdatedAt)
+		switch {
+		case localChanged && remoteChanged && !force:
+			item.Action = docsSyncConflict
+		case localChanged:
+			item.Action = docsSyncUpdate
+		case remoteChanged:
+			item.Action = docsSyncPull
+			item.Title = doc.Title
+		default:
+			item.Action = docsSyncUnchanged
+		}
+		items = append(items, item)
+	}
+
+	var orphaned []string
+	for rel := range manifest.Documents {
+		if !seen[rel] {
+			orphaned = append(orphaned, rel)
+		}
+	}
+	sort.Strings(orphaned)
+	for _, rel := range orphaned {
+		items = append(items, docsSyncItem{Path: rel, Action: docsSyncOrphaned, DocumentID: manifest.Documents[rel].ID})
+	}
+
+	return items
+}
+
+// executeDocsSync applies the plan, recording each success in the manifest
+// as it goes.
+func executeDocsSync(cmd *cobra.Command, app *appctx.App, manifest *docsSyncManifest, items []docsSyncItem) error {
+	ctx := cmd.Context()
+	folders := &folderMirror{app: app, cmd: cmd, ids: map[string]int64{".": manifest.FolderID}}
+
+	for i := range items {
+		it := &items[i]
+		switch it.Action {
+		case docsSyncCreate:
+			folderID, err := folders.ensure(path.Dir(it.Path))
+			if err != nil {
+				return err
+			}
+			doc, err := app.Account().Documents().Create(ctx, folderID, &basecamp.CreateDocumentRequest{
+				Title:   it.local.title,
+				Content: richtext.MarkdownToHTML(it.local.body),
+				Status:  "active",
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", it.Path, convertSDKError(err))
+			}
+			it.DocumentID = doc.ID
+			manifest.Documents[it.Path] = &docsSyncEntry{ID: doc.ID, FolderID: folderID, Hash: it.local.hash, UpdatedAt: doc.UpdatedAt}
+
+		case docsSyncUpdate:
+			doc, err := app.Account().Documents().Update(ctx, it.DocumentID, &basecamp.UpdateDocumentRequest{
+				Title:   it.local.title,
+				Content: richtext.MarkdownToHTML(it.local.body),
+			})
+			if err != nil {
+				return fmt.Errorf("%s: %w", it.Path, convertSDKError(err))
+			}
+			entry := manifest.Documents[it.Path]
+			entry.Hash, entry.UpdatedAt = it.local.hash, doc.UpdatedAt
+
+		case docsSyncPull:
+			// Lists may omit or truncate content; fetch the full document.
+			doc, err := app.Account().Documents().Get(ctx, it.DocumentID)
+			if err != nil {
+				return fmt.Errorf("%s: %w", it.Path, convertSDKError(err))
+			}
+			md := fmt.Sprintf("# %s\n\n%s\n", doc.Title, strings.TrimSpace(richtext.HTMLToMarkdown(doc.Content)))
+			if err := os.WriteFile(it.local.path, []byte(md), 0o644); err != nil { //nolint:gosec // G306: docs live in the user's repo
+				return fmt.Errorf("%s: %w", it.Path, err)
+			}
+			sum := sha256.Sum256([]byte(md))
+			entry := manifest.Documents[it.Path]
+			entry.Hash, entry.UpdatedAt = hex.EncodeToString(sum[:]), doc.UpdatedAt
+		}
+	}
+	return nil
+}
+
+func docsSyncSummary(r docsSyncResult) string {
+	var parts []string
+	for _, a := range []string{docsSyncCreate, docsSyncUpdate, docsSyncPull, docsSyncConflict, docsSyncMissing, docsSyncOrphaned, docsSyncUnchanged} {
+		if n := r.Counts[a]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, a))
+		}
+	}
+	summary := "Nothing to sync"
+	if len(parts) > 0 {
+		summary = strings.Join(parts, ", ")
+	}
+	if r.DryRun {
+		summary = "Dry run: " + summary
+	}
+	return summary
+}
+
+func renderDocsSyncStyled(w io.Writer, rr *output.Renderer, r docsSyncResult) {
+	var buf bytes.Buffer
+	for _, it := range r.Items {
+		if it.Action == docsSyncUnchanged {
+			continue
+		}
+		label := fmt.Sprintf("%-10s", it.Action)
+		line := it.Path
+		if it.DocumentID != 0 {
+			line += " " + rr.Muted.Render(fmt.Sprintf("#%d", it.DocumentID))
+		}
+		fmt.Fprintf(&buf, "  %s %s\n", label, line)
+	}
+
+	fmt.Fprintln(w, rr.Summary.Render(docsSyncSummary(r)))
+	if buf.Len() > 0 {
+		fmt.Fprint(w, buf.String())
+	}
+	if len(r.Skipped) > 0 {
+		fmt.Fprintln(w, rr.Muted.Render(fmt.Sprintf("%d paths excluded", len(r.Skipped))))
+	}
+}
@@ -0,0 +1,249 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// mockDocsSyncTransport serves project 123 whose root folder (10) holds
+// document 300 ("Guide"), and records every mutation.
+type mockDocsSyncTransport struct {
+	docUpdatedAt string
+	mutations    []string
+}
+
+func (m *mockDocsSyncTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	respond := func(status int, body string) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+	}
+
+	p := req.URL.Path
+	if req.Method != http.MethodGet {
+		var body struct {
+			Title string `json:"title"`
+		}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		m.mutations = append(m.mutations, fmt.Sprintf("%s %s %s", req.Method, p, body.Title))
+	}
+
+	switch {
+	case p == "/99999/projects.json":
+		return respond(200, `[{"id":123,"name":"Handbook"}]`)
+	case req.Method == http.MethodGet && p == "/99999/vaults/10/documents.json":
+		return respond(200, fmt.Sprintf(`[{"id":300,"title":"Guide","updated_at":%q}]`, m.docUpdatedAt))
+	case req.Method == http.MethodGet && strings.HasSuffix(p, "/vaults.json"):
+		return respond(200, `[]`)
+	case req.Method == http.MethodPost && strings.HasSuffix(p, "/vaults.json"):
+		return respond(201, `{"id":20,"title":"guides"}`)
+	case req.Method == http.MethodPost && strings.HasSuffix(p, "/documents.json"):
+		return respond(201, `{"id":301,"updated_at":"2026-03-01T00:00:00Z"}`)
+	case req.Method == http.MethodPut && strings.HasPrefix(p, "/99999/documents/300"):
+		return respond(200, `{"id":300,"updated_at":"2026-03-02T00:00:00Z"}`)
+	case req.Method == http.MethodGet && strings.HasPrefix(p, "/99999/documents/300"):
+		return respond(200, fmt.Sprintf(`{"id":300,"title":"Guide v2","content":"<p>Edited in Basecamp</p>","updated_at":%q}`, m.docUpdatedAt))
+	}
+	return nil, fmt.Errorf("unexpected request: %s %s", req.Method, p)
+}
+
+func writeDocsSyncFixture(t *testing.T, files map[string]string, manifest *docsSyncManifest) string {
+	t.Helper()
+	dir := writeTree(t, files)
+	if manifest != nil {
+		require.NoError(t, saveDocsSyncManifest(dir, manifest))
+	}
+	return dir
+}
+
+func docsSyncHash(t *testing.T, dir, rel string) string {
+	t.Helper()
+	local, err := readDocsSyncLocal(filepath.Join(dir, rel), rel)
+	require.NoError(t, err)
+	return local.hash
+}
+
+func runDocsSyncCommand(t *testing.T, transport http.RoundTripper, args ...string) (docsSyncResult, error) {
+	t.Helper()
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+
+	err := executeMessagesCommand(NewDocsCmd(), app, append([]string{"sync"}, args...)...)
+	var envelope struct {
+		Data docsSyncResult `json:"data"`
+	}
+	if err == nil {
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	}
+	return envelope.Data, err
+}
+
+func TestDocsSyncCreatesDocumentsAndWritesManifest(t *testing.T) {
+	dir := writeDocsSyncFixture(t, map[string]string{
+		"intro.md":         "# Welcome\n\nHello.\n",
+		"guides/setup.md":  "Install it.\n",
+		"notes.txt":        "not markdown",
+		"drafts/wip.md":    "# WIP\n",
+		".basecampignore":  "drafts/\n",
+		"guides/README.MD": "# Guides\n",
+	}, nil)
+	transport := &mockDocsSyncTransport{}
+
+	result, err := runDocsSyncCommand(t, transport, dir, "-p", "123", "--vault", "10")
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, result.Counts[docsSyncCreate])
+	assert.Equal(t, []string{"drafts/"}, result.Skipped)
+	assert.Equal(t, []string{
+		"POST /99999/vaults/10/vaults.json guides",
+		"POST /99999/vaults/20/documents.json Guides",
+		"POST /99999/vaults/20/documents.json setup",
+		"POST /99999/vaults/10/documents.json Welcome",
+	}, transport.mutations)
+
+	manifest, err := loadDocsSyncManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, "123", manifest.ProjectID)
+	assert.Equal(t, int64(10), manifest.FolderID)
+	require.Contains(t, manifest.Documents, "guides/setup.md")
+	assert.Equal(t, int64(20), manifest.Documents["guides/setup.md"].FolderID)
+	assert.Equal(t, docsSyncHash(t, dir, "intro.md"), manifest.Documents["intro.md"].Hash)
+}
+
+func TestDocsSyncDryRunChangesNothing(t *testing.T) {
+	dir := writeDocsSyncFixture(t, map[string]string{"intro.md": "# Welcome\n"}, nil)
+	transport := &mockDocsSyncTransport{}
+
+	result, err := runDocsSyncCommand(t, transport, dir, "-p", "123", "--vault", "10", "--dry-run")
+	require.NoError(t, err)
+
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.Counts[docsSyncCreate])
+	assert.Empty(t, transport.mutations)
+	_, err = os.Stat(filepath.Join(dir, docsSyncManifestName))
+	assert.True(t, os.IsNotExist(err), "dry run must not write the manifest")
+}
+
+func TestDocsSyncUsesManifestAndPushesLocalEdits(t *testing.T) {
+	synced := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	dir := writeDocsSyncFixture(t, map[string]string{"guide.md": "# Guide\n\nNew text.\n"}, &docsSyncManifest{
+		Version: 1, ProjectID: "123", FolderID: 10,
+		Documents: map[string]*docsSyncEntry{"guide.md": {ID: 300, FolderID: 10, Hash: "stale", UpdatedAt: synced}},
+	})
+	transport := &mockDocsSyncTransport{docUpdatedAt: synced.Format(time.RFC3339)}
+
+	// No --in or --vault: both come from the manifest.
+	result, err := runDocsSyncCommand(t, transport, dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Counts[docsSyncUpdate])
+	assert.Equal(t, []string{"PUT /99999/documents/300 Guide"}, transport.mutations)
+
+	manifest, err := loadDocsSyncManifest(dir)
+	require.NoError(t, err)
+	assert.Equal(t, docsSyncHash(t, dir, "guide.md"), manifest.Documents["guide.md"].Hash)
+	assert.Equal(t, "2026-03-02T00:00:00Z", manifest.Documents["guide.md"].UpdatedAt.Format(time.RFC3339))
+}
+
+func TestDocsSyncPullsRemoteEdits(t *testing.T) {
+	synced := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	dir := writeDocsSyncFixture(t, map[string]string{"guide.md": "# Guide\n"}, nil)
+	require.NoError(t, saveDocsSyncManifest(dir, &docsSyncManifest{
+		Version: 1, ProjectID: "123", FolderID: 10,
+		Documents: map[string]*docsSyncEntry{"guide.md": {ID: 300, FolderID: 10, Hash: docsSyncHash(t, dir, "guide.md"), UpdatedAt: synced}},
+	}))
+	transport := &mockDocsSyncTransport{docUpdatedAt: "2026-02-05T00:00:00Z"}
+
+	result, err := runDocsSyncCommand(t, transport, dir)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, result.Counts[docsSyncPull])
+	assert.Empty(t, transport.mutations)
+	data, err := os.ReadFile(filepath.Join(dir, "guide.md"))
+	require.NoError(t, err)
+	assert.Equal(t, "# Guide v2\n\nEdited in Basecamp\n", string(data))
+}
+
+func TestDocsSyncRejectsProjectMismatch(t *testing.T) {
+	dir := writeDocsSyncFixture(t, map[string]string{"a.md": "a"}, &docsSyncManifest{
+		Version: 1, ProjectID: "999", FolderID: 10, Documents: map[string]*docsSyncEntry{},
+	})
+
+	_, err := runDocsSyncCommand(t, &mockDocsSyncTransport{}, dir, "-p", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "project 999")
+}
+
+func TestPlanDocsSync(t *testing.T) {
+	synced := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	later := synced.Add(time.Hour)
+	manifest := &docsSyncManifest{Documents: map[string]*docsSyncEntry{
+		"same.md":     {ID: 1, Hash: "h1", UpdatedAt: synced},
+		"local.md":    {ID: 2, Hash: "old", UpdatedAt: synced},
+		"remote.md":   {ID: 3, Hash: "h3", UpdatedAt: synced},
+		"both.md":     {ID: 4, Hash: "old", UpdatedAt: synced},
+		"trashed.md":  {ID: 5, Hash: "h5", UpdatedAt: synced},
+		"deleted.md":  {ID: 6, Hash: "h6", UpdatedAt: synced},
+		"deleted2.md": {ID: 7, Hash: "h7", UpdatedAt: synced},
+	}}
+	locals := []*docsSyncLocal{
+		{rel: "both.md", hash: "new"},
+		{rel: "fresh.md", hash: "hf"},
+		{rel: "local.md", hash: "new"},
+		{rel: "remote.md", hash: "h3"},
+		{rel: "same.md", hash: "h1"},
+		{rel: "trashed.md", hash: "h5"},
+	}
+	remote := map[int64]*basecamp.Document{
+		1: {ID: 1, UpdatedAt: synced},
+		2: {ID: 2, UpdatedAt: synced},
+		3: {ID: 3, UpdatedAt: later},
+		4: {ID: 4, UpdatedAt: later},
+	}
+
+	actions := func(items []docsSyncItem) map[string]string {
+		out := make(map[string]string)
+		for _, it := range items {
+			out[it.Path] = it.Action
+		}
+		return out
+	}
+
+	assert.Equal(t, map[string]string{
+		"both.md":     docsSyncConflict,
+		"fresh.md":    docsSyncCreate,
+		"local.md":    docsSyncUpdate,
+		"remote.md":   docsSyncPull,
+		"same.md":     docsSyncUnchanged,
+		"trashed.md":  docsSyncMissing,
+		"deleted.md":  docsSyncOrphaned,
+		"deleted2.md": docsSyncOrphaned,
+	}, actions(planDocsSync(manifest, locals, remote, false)))
+
+	assert.Equal(t, docsSyncUpdate, actions(planDocsSync(manifest, locals, remote, true))["both.md"],
+		"--force resolves conflicts in favor of the local file")
+}
+
+func TestSplitDocTitle(t *testing.T) {
+	title, body := splitDocTitle("\n# Release Notes\n\nShipped.\n", "notes.md")
+	assert.Equal(t, "Release Notes", title)
+	assert.Equal(t, "Shipped.\n", body)
+
+	title, body = splitDocTitle("## Not a title\n", "setup-guide.md")
+	assert.Equal(t, "setup-guide", title)
+	assert.Equal(t, "## Not a title\n", body)
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/basecamp/basecamp-cli/internal/config"
 	"github.com/basecamp/basecamp-cli/internal/harness"
 	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/presenter"
 	"github.com/basecamp/basecamp-cli/internal/version"
 )
 
@@ -196,6 +197,11 @@ func runDoctorChecks(ctx context.Context, app *appctx.App, verbose bool) []Check
 	// 10. Shell completion
 	checks = append(checks, checkShellCompletion(verbose))
 
+	// 10b. User presenter schemas (only when any exist)
+	if schemaCheck := checkUserSchemas(verbose); schemaCheck != nil {
+		checks = append(checks, *schemaCheck)
+	}
+
 	// 11. Legacy bcq detection
 	if legacyCheck := checkLegacyInstall(); legacyCheck != nil {
 		checks = append(checks, *legacyCheck)
@@ -799,6 +805,31 @@ func checkCacheHealth(app *appctx.App, verbose bool) Check {
 	return check
 }
 
+// checkUserSchemas reports on user presenter schemas in
+// ~/.config/basecamp/schemas. Returns nil when none are present.
+func checkUserSchemas(verbose bool) *Check {
+	loaded, skipped := presenter.UserSchemas()
+	if len(loaded) == 0 && len(skipped) == 0 {
+		return nil
+	}
+
+	check := &Check{Name: "Presenter Schemas", Status: "pass"}
+	check.Message = fmt.Sprintf("%d user %s loaded", len(loaded), pluralize(len(loaded), "schema", "schemas"))
+	if verbose && len(loaded) > 0 {
+		check.Message += fmt.Sprintf(" (%s)", strings.Join(loaded, ", "))
+	}
+	if len(skipped) > 0 {
+		check.Status = "warn"
+		check.Message += fmt.Sprintf(", %d skipped", len(skipped))
+		reasons := make([]string, len(skipped))
+		for i, err := range skipped {
+			reasons[i] = err.Error()
+		}
+		check.Hint = strings.Join(reasons, "; ")
+	}
+	return check
+}
+
 // checkShellCompletion checks if shell completion is installed.
 func checkShellCompletion(verbose bool) Check {
 	check := Check{
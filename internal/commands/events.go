@@ -90,8 +90,10 @@ Events track all changes to an item. Common event actions:
 				),
 			}
 
+			respOpts = append(respOpts, listPagination(eventsResult.Meta, page, len(events)))
+
 			// Add truncation notice if results may be limited
-			if notice := output.TruncationNoticeWithTotal(len(events), eventsResult.Meta.TotalCount); notice != "" {
+			if notice := listTruncationNotice(len(events), eventsResult.Meta, page); notice != "" {
 				respOpts = append(respOpts, output.WithNotice(notice))
 			}
 
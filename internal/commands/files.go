@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 	"github.com/spf13/cobra"
@@ -32,22 +33,24 @@ Each project has a root folder containing documents, uploads, and subfolders.`,
 		Annotations: map[string]string{"agent_notes": "files is the unified view — use uploads, docs, folders for type-specific listing\n--vault <id> filters to contents of a specific folder\nDocuments support Markdown content\nCross-project: basecamp recordings documents --json or basecamp recordings uploads --json"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVar(&vaultID, "vault", "", "Folder ID (default: root)")
 	cmd.PersistentFlags().StringVar(&vaultID, "folder", "", "Folder ID (alias for --vault)")
 
 	cmd.AddCommand(
 		newFilesListCmd(&project, &vaultID),
+		newFilesTreeCmd(&project, &vaultID),
+		newFilesSyncCmd(&project, &vaultID),
 		newFoldersCmd(&project, &vaultID),
 		newUploadsCmd(&project, &vaultID),
 		newDocsCmd(&project, &vaultID),
 		newFilesShowCmd(&project),
 		newFilesUpdateCmd(&project),
 		newFilesDownloadCmd(&project),
-		newRecordableTrashCmd("file"),
+		newFilesTrashCmd(),
 		newRecordableArchiveCmd("file"),
-		newRecordableRestoreCmd("file"),
+		newFilesRestoreCmd(),
 	)
 
 	return cmd
@@ -82,8 +85,8 @@ func NewUploadsCmd() *cobra.Command {
 		Long:  "List, show, and upload files in a project's Docs & Files area.",
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVar(&vaultID, "vault", "", "Folder ID (default: root)")
 	cmd.PersistentFlags().StringVar(&vaultID, "folder", "", "Folder ID (alias for --vault)")
 
@@ -116,23 +119,7 @@ func runFilesList(cmd *cobra.Command, project, vaultID string) error {
 	}
 
 	// Resolve project from CLI flags and config, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-
-	// If no project specified, try interactive resolution
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -235,6 +222,167 @@ func runFilesList(cmd *cobra.Command, project, vaultID string) error {
 	return app.OK(items, respOpts...)
 }
 
+func newFilesTreeCmd(project, vaultID *string) *cobra.Command {
+	var depth int
+
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Show the folder hierarchy",
+		Long: `Show the folder hierarchy of a project's Docs & Files as an indented tree.
+
+Each folder lists its document and upload counts. Use --vault to start from
+a subfolder and --depth to limit how many levels are expanded (0 = all).`,
+		Example: `  basecamp files tree --in "My Project"
+  basecamp files tree --in "My Project" --depth 2
+  basecamp files tree --vault 12345 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if depth < 0 {
+				return output.ErrUsage("--depth must be 0 or greater")
+			}
+			return runFilesTree(cmd, *project, *vaultID, depth)
+		},
+	}
+
+	cmd.Flags().IntVar(&depth, "depth", 0, "Maximum folder depth to expand (0 = unlimited)")
+
+	return cmd
+}
+
+// filesTreeNode is one folder in the files tree output.
+type filesTreeNode struct {
+	ID        int64           `json:"id"`
+	Title     string          `json:"title"`
+	Documents int             `json:"documents_count"`
+	Uploads   int             `json:"uploads_count"`
+	Folders   int             `json:"folders_count"`
+	Children  []filesTreeNode `json:"children,omitempty"`
+}
+
+func runFilesTree(cmd *cobra.Command, project, vaultID string, depth int) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return err
+	}
+
+	resolvedVaultID := vaultID
+	if resolvedVaultID == "" {
+		resolvedVaultID, err = getVaultID(cmd, app, resolvedProjectID)
+		if err != nil {
+			return err
+		}
+	}
+
+	vaultIDNum, err := strconv.ParseInt(resolvedVaultID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid folder ID")
+	}
+
+	root, err := app.Account().Vaults().Get(cmd.Context(), vaultIDNum)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	tree, err := buildFilesTree(cmd, app, *root, depth, 1)
+	if err != nil {
+		return err
+	}
+
+	folders, documents, uploads := filesTreeTotals(tree)
+	summary := fmt.Sprintf("%d folders, %d documents, %d uploads", folders, documents, uploads)
+
+	return app.OK(tree,
+		output.WithSummary(summary),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderFilesTreeStyled(w, r, tree) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "list",
+				Cmd:         fmt.Sprintf("basecamp files list --vault <id> --in %s", resolvedProjectID),
+				Description: "List a folder's contents",
+			},
+			output.Breadcrumb{
+				Action:      "folder",
+				Cmd:         fmt.Sprintf("basecamp files folder create <name> --vault <id> --in %s", resolvedProjectID),
+				Description: "Create folder",
+			},
+		),
+	)
+}
+
+// buildFilesTree recursively expands the subfolders of v. Vaults().List with
+// nil options follows pagination, so large folders are listed in full.
+// Folders deeper than maxDepth (when > 0) keep their counts but are not expanded.
+func buildFilesTree(cmd *cobra.Command, app *appctx.App, v basecamp.Vault, maxDepth, level int) (filesTreeNode, error) {
+	node := filesTreeNode{
+		ID:        v.ID,
+		Title:     v.Title,
+		Documents: v.DocumentsCount,
+		Uploads:   v.UploadsCount,
+		Folders:   v.VaultsCount,
+	}
+
+	if v.VaultsCount == 0 || (maxDepth > 0 && level > maxDepth) {
+		return node, nil
+	}
+
+	result, err := app.Account().Vaults().List(cmd.Context(), v.ID, nil)
+	if err != nil {
+		return node, convertSDKError(err)
+	}
+
+	for _, child := range result.Vaults {
+		childNode, err := buildFilesTree(cmd, app, child, maxDepth, level+1)
+		if err != nil {
+			return node, err
+		}
+		node.Children = append(node.Children, childNode)
+	}
+
+	return node, nil
+}
+
+// filesTreeTotals sums folder, document, and upload counts across the
+// expanded portion of the tree, excluding the root folder itself.
+func filesTreeTotals(n filesTreeNode) (folders, documents, uploads int) {
+	documents, uploads = n.Documents, n.Uploads
+	for _, c := range n.Children {
+		f, d, u := filesTreeTotals(c)
+		folders += f + 1
+		documents += d
+		uploads += u
+	}
+	return folders, documents, uploads
+}
+
+func renderFilesTreeStyled(w io.Writer, r *output.Renderer, root filesTreeNode) {
+	counts := func(n filesTreeNode) string {
+		return r.Muted.Render(fmt.Sprintf("(%d docs, %d uploads) #%d", n.Documents, n.Uploads, n.ID))
+	}
+
+	var walk func(n filesTreeNode, prefix string)
+	walk = func(n filesTreeNode, prefix string) {
+		for i, c := range n.Children {
+			branch, next := "├── ", "│   "
+			if i == len(n.Children)-1 {
+				branch, next = "└── ", "    "
+			}
+			fmt.Fprintf(w, "%s%s%s %s\n", prefix, branch, c.Title, counts(c))
+			walk(c, prefix+next)
+		}
+		if len(n.Children) == 0 && n.Folders > 0 {
+			fmt.Fprintf(w, "%s└── %s\n", prefix, r.Muted.Render(fmt.Sprintf("… %d more folders", n.Folders)))
+		}
+	}
+
+	fmt.Fprintf(w, "%s %s\n", r.Summary.Render(root.Title), counts(root))
+	walk(root, "")
+}
+
 func newFoldersCmd(project, vaultID *string) *cobra.Command {
 	var limit int
 	var page int
@@ -300,21 +448,7 @@ func runFoldersList(cmd *cobra.Command, project, vaultID string, limit, page int
 	}
 
 	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -353,6 +487,7 @@ func runFoldersList(cmd *cobra.Command, project, vaultID string, limit, page int
 
 	return app.OK(folders,
 		output.WithSummary(fmt.Sprintf("%d folders", len(folders))),
+		listPagination(foldersResult.Meta, page, len(folders)),
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "create",
@@ -369,10 +504,26 @@ func runFoldersList(cmd *cobra.Command, project, vaultID string, limit, page int
 }
 
 func newFoldersCreateCmd(project, vaultID *string) *cobra.Command {
+	var folderPath string
+
 	cmd := &cobra.Command{
 		Use:   "create <name>",
 		Short: "Create a new folder",
+		Long: `Create a new folder.
+
+With --path, create a slash-separated chain of folders like mkdir -p:
+existing folders along the path are found by name and reused, missing ones
+are created, and every folder in the chain is returned.`,
+		Example: `  basecamp files folder create "Contracts" --in my-project
+  basecamp files folder create --path "Design/Assets/Logos" --in my-project`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if folderPath != "" {
+				if len(args) > 0 {
+					return output.ErrUsage("give either <name> or --path, not both")
+				}
+				return runFoldersCreatePath(cmd, *project, *vaultID, folderPath)
+			}
+
 			// Show help when invoked with no arguments
 			if len(args) == 0 {
 				return missingArg(cmd, "<name>")
@@ -387,21 +538,7 @@ func newFoldersCreateCmd(project, vaultID *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -443,9 +580,112 @@ func newFoldersCreateCmd(project, vaultID *string) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().StringVar(&folderPath, "path", "", "Slash-separated folder path to create, with any missing parents (e.g. Design/Assets/Logos)")
+
 	return cmd
 }
 
+// folderPathSegment is one folder along a created path.
+type folderPathSegment struct {
+	ID      int64  `json:"id"`
+	Title   string `json:"title"`
+	Created bool   `json:"created"`
+}
+
+// folderPathResult is the output of "files folder create --path".
+type folderPathResult struct {
+	Path      string              `json:"path"`
+	ID        int64               `json:"id"` // the last folder in the path
+	ParentID  int64               `json:"parent_id"`
+	ProjectID string              `json:"project_id"`
+	Folders   []folderPathSegment `json:"folders"`
+}
+
+// runFoldersCreatePath creates the folders along p under the target folder,
+// reusing any that already exist by name.
+func runFoldersCreatePath(cmd *cobra.Command, project, vaultID, p string) error {
+	segments, err := splitFolderPath(p)
+	if err != nil {
+		return err
+	}
+
+	app := appctx.FromContext(cmd.Context())
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProjectID, parentID, err := resolveFilesFolder(cmd, app, project, vaultID)
+	if err != nil {
+		return err
+	}
+
+	result := folderPathResult{
+		Path:      strings.Join(segments, "/"),
+		ParentID:  parentID,
+		ProjectID: resolvedProjectID,
+		Folders:   make([]folderPathSegment, 0, len(segments)),
+	}
+	folders := &folderMirror{app: app, cmd: cmd, ids: map[string]int64{".": parentID}}
+	for i, name := range segments {
+		before := folders.created
+		id, err := folders.ensure(strings.Join(segments[:i+1], "/"))
+		if err != nil {
+			if folders.created > 0 {
+				if outErr, ok := err.(*output.Error); ok {
+					outErr.Message = fmt.Sprintf("after creating %d folder(s): %s", folders.created, outErr.Message)
+					return outErr
+				}
+				return fmt.Errorf("after creating %d folder(s): %w", folders.created, err)
+			}
+			return err
+		}
+		result.Folders = append(result.Folders, folderPathSegment{ID: id, Title: name, Created: folders.created > before})
+		result.ID = id
+	}
+
+	summary := fmt.Sprintf("Folder #%d: %s already exists", result.ID, result.Path)
+	if folders.created > 0 {
+		summary = fmt.Sprintf("Created folder #%d: %s (%d new %s)", result.ID, result.Path,
+			folders.created, pluralize(folders.created, "folder", "folders"))
+	}
+
+	return app.OK(result,
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "list",
+				Cmd:         fmt.Sprintf("basecamp files --vault %d --in %s", result.ID, resolvedProjectID),
+				Description: "List folder contents",
+			},
+			output.Breadcrumb{
+				Action:      "upload",
+				Cmd:         fmt.Sprintf("basecamp files uploads create <file> --vault %d --in %s", result.ID, resolvedProjectID),
+				Description: "Upload a file here",
+			},
+		),
+	)
+}
+
+// splitFolderPath splits a slash-separated folder path into its names,
+// ignoring leading, trailing, and doubled slashes.
+func splitFolderPath(p string) ([]string, error) {
+	var segments []string
+	for _, name := range strings.Split(p, "/") {
+		name = strings.TrimSpace(name)
+		switch name {
+		case "":
+			continue
+		case ".", "..":
+			return nil, output.ErrUsage(fmt.Sprintf("--path can't contain %q segments", name))
+		}
+		segments = append(segments, name)
+	}
+	if len(segments) == 0 {
+		return nil, output.ErrUsage("--path must name at least one folder")
+	}
+	return segments, nil
+}
+
 func newUploadsCmd(project, vaultID *string) *cobra.Command {
 	var limit int
 	var page int
@@ -511,21 +751,7 @@ func runUploadsList(cmd *cobra.Command, project, vaultID string, limit, page int
 	}
 
 	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -564,6 +790,7 @@ func runUploadsList(cmd *cobra.Command, project, vaultID string, limit, page int
 
 	return app.OK(uploads,
 		output.WithSummary(fmt.Sprintf("%d files", len(uploads))),
+		listPagination(uploadsResult.Meta, page, len(uploads)),
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "show",
@@ -576,23 +803,33 @@ func runUploadsList(cmd *cobra.Command, project, vaultID string, limit, page int
 
 func newUploadsCreateCmd(project, vaultID *string) *cobra.Command {
 	var description string
+	var recursive bool
+	var excludes []string
 
 	cmd := &cobra.Command{
-		Use:   "create <file>",
+		Use:   "create <path>",
 		Short: "Upload a file to Docs & Files",
 		Long: `Upload a file to a project's Docs & Files area.
 
 Two-step process: the file is first uploaded as an attachment, then created
-as an upload in the target folder (vault).`,
+as an upload in the target folder (vault).
+
+With --recursive, <path> is a directory: every file in it is uploaded and
+subdirectories become folders (existing folders with the same name are
+reused). Paths matching --exclude globs or the directory's .basecampignore
+file (gitignore syntax) are skipped.`,
 		Example: `  basecamp uploads create ./report.pdf --in my-project
-  basecamp uploads create ./photo.png --folder 123 --description "Site photo"`,
+  basecamp uploads create ./photo.png --folder 123 --description "Site photo"
+  basecamp uploads create ./site --recursive --exclude node_modules --exclude "*.map"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runUploadFile(cmd, *project, *vaultID, args[0], description)
+			return runUploadPath(cmd, *project, *vaultID, args[0], description, recursive, excludes)
 		},
 	}
 
 	cmd.Flags().StringVar(&description, "description", "", "Upload description (Markdown)")
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Upload a directory and its subdirectories")
+	cmd.Flags().StringArrayVar(&excludes, "exclude", nil, "Skip paths matching a gitignore-style glob (repeatable; with --recursive)")
 
 	return cmd
 }
@@ -602,31 +839,53 @@ func NewUploadCmd() *cobra.Command {
 	var project string
 	var vaultID string
 	var description string
+	var recursive bool
+	var excludes []string
 
 	cmd := &cobra.Command{
-		Use:   "upload <file>",
+		Use:   "upload <path>",
 		Short: "Upload a file to Docs & Files",
 		Long: `Upload a file to a project's Docs & Files area.
 
 Shortcut for 'basecamp uploads create'. The file is uploaded as an
-attachment and then created as an upload in the target folder.`,
+attachment and then created as an upload in the target folder.
+
+With --recursive, <path> is a directory uploaded as a folder tree, skipping
+paths that match --exclude globs or its .basecampignore file.`,
 		Example: `  basecamp upload ./report.pdf --in my-project
-  basecamp upload ./photo.png --folder 123 --description "Site photo"`,
+  basecamp upload ./photo.png --folder 123 --description "Site photo"
+  basecamp upload ./site --recursive --exclude node_modules --in my-project`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runUploadFile(cmd, project, vaultID, args[0], description)
+			return runUploadPath(cmd, project, vaultID, args[0], description, recursive, excludes)
 		},
 	}
 
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.Flags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.Flags().StringVar(&vaultID, "vault", "", "Folder ID (default: root)")
 	cmd.Flags().StringVar(&vaultID, "folder", "", "Folder ID (alias for --vault)")
 	cmd.Flags().StringVar(&description, "description", "", "Upload description (Markdown)")
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Upload a directory and its subdirectories")
+	cmd.Flags().StringArrayVar(&excludes, "exclude", nil, "Skip paths matching a gitignore-style glob (repeatable; with --recursive)")
 
 	return cmd
 }
 
+// runUploadPath dispatches to a single-file or recursive directory upload.
+func runUploadPath(cmd *cobra.Command, project, vaultID, target, description string, recursive bool, excludes []string) error {
+	if !recursive {
+		if len(excludes) > 0 {
+			return output.ErrUsage("--exclude requires --recursive")
+		}
+		return runUploadFile(cmd, project, vaultID, target, description)
+	}
+	if description != "" {
+		return output.ErrUsage("--description cannot be combined with --recursive")
+	}
+	return runUploadDir(cmd, project, vaultID, target, excludes)
+}
+
 func runUploadFile(cmd *cobra.Command, project, vaultID, filePath, description string) error {
 	app := appctx.FromContext(cmd.Context())
 
@@ -641,21 +900,7 @@ func runUploadFile(cmd *cobra.Command, project, vaultID, filePath, description s
 	}
 
 	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -678,15 +923,9 @@ func runUploadFile(cmd *cobra.Command, project, vaultID, filePath, description s
 	contentType := richtext.DetectMIME(filePath)
 	filename := filepath.Base(filePath)
 
-	f, err := os.Open(filePath)
-	if err != nil {
-		return fmt.Errorf("%s: %w", filePath, err)
-	}
-	defer f.Close()
-
-	resp, err := app.Account().Attachments().Create(cmd.Context(), filename, contentType, f)
+	resp, err := uploadAttachmentFile(cmd.Context(), app, filePath, filename, contentType)
 	if err != nil {
-		return convertSDKError(err)
+		return err
 	}
 
 	// Step 2: Create upload in vault
@@ -738,19 +977,21 @@ func newDocsCmd(project, vaultID *string) *cobra.Command {
 	var limit int
 	var page int
 	var all bool
+	var filters recordingFilterFlags
 
 	cmd := &cobra.Command{
 		Use:     "documents",
 		Aliases: []string{"document", "doc"},
 		Short:   "Manage documents",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDocsList(cmd, *project, *vaultID, limit, page, all)
+			return runDocsList(cmd, *project, *vaultID, limit, page, all, filters)
 		},
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum number of documents to fetch (0 = all)")
 	cmd.Flags().BoolVar(&all, "all", false, "Fetch all documents (no limit)")
 	cmd.Flags().IntVar(&page, "page", 0, "Fetch a single page (use --all for everything)")
+	addRecordingFilterFlags(cmd, &filters)
 
 	cmd.AddCommand(
 		newDocsListCmd(project, vaultID),
@@ -764,23 +1005,29 @@ func newDocsListCmd(project, vaultID *string) *cobra.Command {
 	var limit int
 	var page int
 	var all bool
+	var filters recordingFilterFlags
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List documents in a folder",
+		Long: `List the documents in a folder.
+
+--created-by and --updated-since keep documents by who wrote them and when
+they last changed; with either, the whole folder is fetched before --limit.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runDocsList(cmd, *project, *vaultID, limit, page, all)
+			return runDocsList(cmd, *project, *vaultID, limit, page, all, filters)
 		},
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum number of documents to fetch (0 = all)")
 	cmd.Flags().BoolVar(&all, "all", false, "Fetch all documents (no limit)")
 	cmd.Flags().IntVar(&page, "page", 0, "Fetch a single page (use --all for everything)")
+	addRecordingFilterFlags(cmd, &filters)
 
 	return cmd
 }
 
-func runDocsList(cmd *cobra.Command, project, vaultID string, limit, page int, all bool) error {
+func runDocsList(cmd *cobra.Command, project, vaultID string, limit, page int, all bool, filters recordingFilterFlags) error {
 	app := appctx.FromContext(cmd.Context())
 
 	// Validate flag combinations
@@ -798,22 +1045,13 @@ func runDocsList(cmd *cobra.Command, project, vaultID string, limit, page int, a
 		return err
 	}
 
-	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+	filter, err := filters.resolve(cmd.Context(), app, time.Now())
+	if err != nil {
+		return err
 	}
 
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	// Resolve project, with interactive fallback
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -832,9 +1070,10 @@ func runDocsList(cmd *cobra.Command, project, vaultID string, limit, page int, a
 		return output.ErrUsage("Invalid folder ID")
 	}
 
-	// Build pagination options
+	// Build pagination options. A creator/update filter fetches the whole
+	// folder; --limit then caps the filtered set.
 	opts := &basecamp.DocumentListOptions{}
-	if all {
+	if all || (filter.active() && page == 0) {
 		opts.Limit = -1 // SDK treats -1 as "fetch all"
 	} else if limit > 0 {
 		opts.Limit = limit
@@ -849,9 +1088,18 @@ func runDocsList(cmd *cobra.Command, project, vaultID string, limit, page int, a
 		return convertSDKError(err)
 	}
 	documents := documentsResult.Documents
+	meta := documentsResult.Meta
+	if filter.active() {
+		documents = filterRecordings(filter, documents, documentRecordingFields)
+		meta = basecamp.ListMeta{TotalCount: len(documents)}
+		if limit > 0 && len(documents) > limit {
+			documents = documents[:limit]
+		}
+	}
 
 	return app.OK(documents,
 		output.WithSummary(fmt.Sprintf("%d documents", len(documents))),
+		listPagination(meta, page, len(documents)),
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "create",
@@ -868,6 +1116,7 @@ func runDocsList(cmd *cobra.Command, project, vaultID string, limit, page int, a
 }
 
 func newDocsCreateCmd(project, vaultID *string) *cobra.Command {
+	var edit bool
 	var draft bool
 	var subscribe string
 	var noSubscribe bool
@@ -884,15 +1133,25 @@ func newDocsCreateCmd(project, vaultID *string) *cobra.Command {
 
 			title := args[0]
 
+			content := ""
+			if len(args) > 1 {
+				content = args[1]
+			}
+			if edit && content != "" {
+				return output.ErrUsage("cannot combine --edit and content argument")
+			}
+			if edit {
+				var err error
+				if content, err = editNewContent(); err != nil {
+					return err
+				}
+			}
+
 			app := appctx.FromContext(cmd.Context())
 
 			if err := ensureAccount(cmd, app); err != nil {
 				return err
 			}
-			content := ""
-			if len(args) > 1 {
-				content = args[1]
-			}
 
 			// Resolve subscription flags before project (fail fast on bad input)
 			subs, err := applySubscribeFlags(cmd.Context(), app.Names, subscribe, cmd.Flags().Changed("subscribe"), noSubscribe)
@@ -901,21 +1160,7 @@ func newDocsCreateCmd(project, vaultID *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -991,6 +1236,8 @@ func newDocsCreateCmd(project, vaultID *string) *cobra.Command {
 	cmd.Flags().StringVar(&subscribe, "subscribe", "", "Subscribe specific people (comma-separated names, emails, IDs, or \"me\")")
 	cmd.Flags().BoolVar(&noSubscribe, "no-subscribe", false, "Don't subscribe anyone else (silent, no notifications)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
+	cmd.Flags().BoolVar(&edit, "edit", false, "Open $EDITOR to compose content")
+	addContentForceFlag(cmd)
 
 	return cmd
 }
@@ -1026,23 +1273,10 @@ You can pass either an item ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -1202,6 +1436,7 @@ func newFilesUpdateCmd(project *string) *cobra.Command {
 	var title string
 	var content string
 	var itemType string
+	var edit bool
 
 	cmd := &cobra.Command{
 		Use:   "update <id|url>",
@@ -1212,7 +1447,10 @@ For documents, updating only --title or only --content preserves the untouched f
 
 You can pass either an item ID or a Basecamp URL:
   basecamp files update 789 --title "new title" --in my-project
-  basecamp files update 789 --content "new content" --in my-project`,
+  basecamp files update 789 --content "new content" --in my-project
+
+Use --edit to revise a document's current content as markdown in $EDITOR:
+  basecamp files update 789 --edit --in my-project`,
 		Annotations: map[string]string{"agent_notes": "Document updates preserve untouched title/content by fetching current state first because BC3 rebuilds documents from permitted params on PUT; explicit clears via --title \"\"/--content \"\" work because the SDK strips empty strings to absent fields, which the controller then nulls. Upload/vault updates do not clear by omission, so empty-valued flags are rejected CLI-side."},
 		Args:        cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -1228,9 +1466,22 @@ You can pass either an item ID or a Basecamp URL:
 			nonDocTitleSet := titleChanged && titleTrimmed != ""
 			nonDocContentSet := contentChanged && contentTrimmed != ""
 			itemType = strings.ToLower(strings.TrimSpace(itemType))
+			if edit {
+				if contentChanged {
+					return output.ErrUsage("cannot combine --edit and --content")
+				}
+				switch itemType {
+				case "", "document", "doc":
+				default:
+					return output.ErrUsage("--edit can only be used with documents")
+				}
+				if err := requireEditorTerminal(); err != nil {
+					return err
+				}
+			}
 			switch itemType {
 			case "", "document", "doc":
-				if !docTitleSet && !docContentSet {
+				if !docTitleSet && !docContentSet && !edit {
 					return noChanges(cmd)
 				}
 			case "vault", "folder":
@@ -1267,25 +1518,27 @@ You can pass either an item ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
+				projectID = urlProjectID
 			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
+			if err != nil {
+				return err
 			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
+
+			// --edit only applies to documents, so fetch the current content
+			// up front and skip type detection.
+			var editedDoc *basecamp.Document
+			if edit {
+				editedDoc, err = app.Account().Documents().Get(cmd.Context(), itemID)
+				if err != nil {
+					return convertSDKError(err)
+				}
+				if content, err = editExistingContent(editedDoc.Content, "document"); err != nil {
 					return err
 				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
-			if err != nil {
-				return err
+				itemType = "document"
+				docContentSet = true
 			}
 
 			// Auto-detect type if not specified
@@ -1303,7 +1556,7 @@ You can pass either an item ID or a Basecamp URL:
 					result = vault
 					detectedType = "vault"
 				case "document", "doc":
-					req, err := buildDocumentUpdateRequest(cmd, app, itemID, nil, docTitleSet, docContentSet, title, content)
+					req, err := buildDocumentUpdateRequest(cmd, app, itemID, editedDoc, docTitleSet, docContentSet, title, content)
 					if err != nil {
 						return convertSDKError(err)
 					}
@@ -1421,6 +1674,8 @@ You can pass either an item ID or a Basecamp URL:
 	cmd.Flags().StringVarP(&title, "title", "t", "", "New title")
 	cmd.Flags().StringVarP(&content, "content", "c", "", "New content")
 	cmd.Flags().StringVar(&itemType, "type", "", "Item type (vault, document, upload)")
+	cmd.Flags().BoolVar(&edit, "edit", false, "Open $EDITOR with the document's current content")
+	addContentForceFlag(cmd)
 
 	return cmd
 }
@@ -1473,8 +1728,146 @@ func buildDocumentUpdateRequest(cmd *cobra.Command, app *appctx.App, itemID int6
 	return req, nil
 }
 
+func newFilesTrashCmd() *cobra.Command {
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "trash <id|url>",
+		Short: "Move a folder, document, or upload to trash",
+		Long: `Move a folder, document, or upload to the trash.
+
+The item type is detected first, so IDs that belong to anything other than
+Docs & Files (a to-do, a message) are refused rather than trashed. Trashing
+a folder also trashes everything in it. Restore with: basecamp files restore <id>
+
+You can pass either an item ID or a Basecamp URL:
+  basecamp files trash 789 --in my-project
+  basecamp files trash https://3.basecamp.com/123/buckets/456/documents/789 --force`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFilesStatus(cmd, args[0], "trashed", force)
+		},
+	}
+
+	cmd.Flags().BoolVarP(&force, "force", "f", false, "Skip confirmation prompt")
+
+	return cmd
+}
+
+func newFilesRestoreCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <id|url>",
+		Short: "Restore a folder, document, or upload",
+		Long: `Restore a folder, document, or upload from trash or archive to active status.
+
+You can pass either an item ID or a Basecamp URL:
+  basecamp files restore 789 --in my-project`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runFilesStatus(cmd, args[0], "active", false)
+		},
+	}
+}
+
+// runFilesStatus changes the status of a Docs & Files item. The generic
+// recording endpoint is consulted first so only vaults, documents, and
+// uploads are touched. The confirm policy decides whether to ask first;
+// force skips the prompt.
+func runFilesStatus(cmd *cobra.Command, arg, newStatus string, force bool) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	itemIDStr := extractID(arg)
+	itemID, err := strconv.ParseInt(itemIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid item ID")
+	}
+
+	recording, err := app.Account().Recordings().Get(cmd.Context(), itemID)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	itemType := filesItemType(recording.Type)
+	if itemType == "" {
+		action := "trash"
+		if newStatus == "active" {
+			action = "restore"
+		}
+		return output.ErrUsageHint(
+			fmt.Sprintf("#%s is a %s, not a folder, document, or upload", itemIDStr, recording.Type),
+			fmt.Sprintf("Use: basecamp recordings %s %s", action, itemIDStr),
+		)
+	}
+
+	prompt := fmt.Sprintf("Restore %s %q?", itemType, recording.Title)
+	kind := confirmChange
+	if newStatus == "trashed" {
+		prompt = fmt.Sprintf("Move %s %q to trash?", itemType, recording.Title)
+		if itemType == "vault" {
+			prompt = fmt.Sprintf("Move folder %q and everything in it to trash?", recording.Title)
+		}
+		kind = confirmDestructive
+	}
+	if !confirmAction(cmd, kind, prompt, force) {
+		return nil
+	}
+
+	var verb string
+	var breadcrumb output.Breadcrumb
+	switch newStatus {
+	case "trashed":
+		err = app.Account().Recordings().Trash(cmd.Context(), itemID)
+		verb = "Trashed"
+		breadcrumb = output.Breadcrumb{
+			Action:      "restore",
+			Cmd:         fmt.Sprintf("basecamp files restore %s", itemIDStr),
+			Description: "Restore from trash",
+		}
+	case "active":
+		// Unarchive() sets status to active, which also untrashes
+		err = app.Account().Recordings().Unarchive(cmd.Context(), itemID)
+		verb = "Restored"
+		breadcrumb = output.Breadcrumb{
+			Action:      "show",
+			Cmd:         fmt.Sprintf("basecamp files show %s", itemIDStr),
+			Description: "View item",
+		}
+	default:
+		return output.ErrUsage(fmt.Sprintf("Unknown status: %s", newStatus))
+	}
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	return app.OK(map[string]any{"id": itemID, "type": itemType, "title": recording.Title, "status": newStatus},
+		output.WithSummary(fmt.Sprintf("%s %s #%s: %s", verb, itemType, itemIDStr, recording.Title)),
+		output.WithBreadcrumbs(breadcrumb),
+	)
+}
+
+// filesItemType maps a recording type to the Docs & Files item type used by
+// files show, or "" when the recording lives outside Docs & Files.
+func filesItemType(recordingType string) string {
+	switch recordingType {
+	case "Vault":
+		return "vault"
+	case "Document", "Vault::Document":
+		return "document"
+	case "Upload", "Vault::Upload":
+		return "upload"
+	default:
+		return ""
+	}
+}
+
 func newFilesDownloadCmd(project *string) *cobra.Command {
 	var outDir string
+	var recursive bool
+	var excludes []string
 
 	cmd := &cobra.Command{
 		Use:   "download <upload-id|url>",
@@ -1491,7 +1884,14 @@ You can pass either an upload ID, a Basecamp URL, or a storage URL:
 Storage URLs (from attachments in rich text) are downloaded directly
 via the API. No --in flag is needed for storage URLs.
 
-Use --out - to stream the file to stdout (for piping to other commands).`,
+Use --out - to stream the file to stdout (for piping to other commands).
+
+With --recursive, the argument is a folder ID or URL: every upload in the
+folder and its subfolders is downloaded into --out (default: a directory
+named after the folder), mirroring the hierarchy. Paths matching --exclude
+globs or a .basecampignore file in the output directory (gitignore syntax)
+are skipped:
+  basecamp files download 456 --recursive --out ./assets --exclude "*.psd"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
@@ -1500,6 +1900,19 @@ Use --out - to stream the file to stdout (for piping to other commands).`,
 				return err
 			}
 
+			if recursive {
+				if outDir == "-" {
+					return output.ErrUsage("--out - cannot be combined with --recursive")
+				}
+				if isStorageURL(args[0]) {
+					return output.ErrUsage("--recursive requires a folder ID or URL, not a storage URL")
+				}
+				return runDownloadFolder(cmd, *project, args[0], outDir, excludes)
+			}
+			if len(excludes) > 0 {
+				return output.ErrUsage("--exclude requires --recursive")
+			}
+
 			// Stdout streaming: --out -
 			if outDir == "-" {
 				if isStorageURL(args[0]) {
@@ -1614,6 +2027,8 @@ Use --out - to stream the file to stdout (for piping to other commands).`,
 	}
 
 	cmd.Flags().StringVarP(&outDir, "out", "o", "", "Output directory (default: current directory)")
+	cmd.Flags().BoolVarP(&recursive, "recursive", "r", false, "Download a folder and its subfolders")
+	cmd.Flags().StringArrayVar(&excludes, "exclude", nil, "Skip paths matching a gitignore-style glob (repeatable; with --recursive)")
 
 	return cmd
 }
@@ -1688,23 +2103,7 @@ func resolveDownloadProject(cmd *cobra.Command, app *appctx.App, urlProjectID, f
 	if projectID == "" {
 		projectID = flagProject
 	}
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return "", err
-		}
-		projectID = app.Config.ProjectID
-	}
-	resolved, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
-	if err != nil {
-		return "", err
-	}
-	return resolved, nil
+	return resolveProjectID(cmd, app, projectID)
 }
 
 // isStorageURL returns true if the argument looks like a Basecamp storage blob URL.
@@ -0,0 +1,409 @@
+package commands
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/ignore"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// syncedFile is one file transferred by a recursive upload or download.
+type syncedFile struct {
+	Path     string `json:"path"`
+	UploadID int64  `json:"upload_id"`
+	FolderID int64  `json:"folder_id"`
+	ByteSize int64  `json:"byte_size"`
+}
+
+// recursiveSyncResult is the output of a recursive upload or download.
+// Skipped lists paths excluded by .basecampignore or --exclude, relative to
+// the local root; excluded directories end in "/" and are not descended.
+type recursiveSyncResult struct {
+	Path           string       `json:"path"`
+	FolderID       int64        `json:"folder_id"`
+	ProjectID      string       `json:"project_id"`
+	Files          []syncedFile `json:"files"`
+	FoldersCreated int          `json:"folders_created,omitempty"`
+	Skipped        []string     `json:"skipped"`
+}
+
+// resolveFilesFolder resolves the project and target folder for a files
+// command: --vault when given, otherwise the project's root Docs & Files.
+func resolveFilesFolder(cmd *cobra.Command, app *appctx.App, project, vaultID string) (string, int64, error) {
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return "", 0, err
+	}
+
+	resolvedVaultID := vaultID
+	if resolvedVaultID == "" {
+		resolvedVaultID, err = getVaultID(cmd, app, resolvedProjectID)
+		if err != nil {
+			return "", 0, err
+		}
+	}
+
+	vaultIDNum, err := strconv.ParseInt(resolvedVaultID, 10, 64)
+	if err != nil {
+		return "", 0, output.ErrUsage("Invalid folder ID")
+	}
+	return resolvedProjectID, vaultIDNum, nil
+}
+
+// localUpload is a file selected for a recursive upload.
+type localUpload struct {
+	rel  string // slash-separated, relative to the upload root
+	path string // on-disk path
+	size int64
+}
+
+// planDirUpload walks root and returns the files to upload, in walk order,
+// plus the paths excluded by m. Every selected file is validated up front so
+// a bad file fails the command before anything is sent. The ignore file
+// itself is never uploaded.
+func planDirUpload(root string, m *ignore.Matcher) (files []localUpload, skipped []string, err error) {
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		if rel == "." {
+			return nil
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if m.Match(rel, true) {
+				skipped = append(skipped, rel+"/")
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if rel == ignore.FileName || !d.Type().IsRegular() {
+			return nil
+		}
+		if m.Match(rel, false) {
+			skipped = append(skipped, rel)
+			return nil
+		}
+
+		if err := richtext.ValidateFile(p); err != nil {
+			return fmt.Errorf("%s: %w", rel, err)
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		files = append(files, localUpload{rel: rel, path: p, size: info.Size()})
+		return nil
+	})
+	return files, skipped, err
+}
+
+// runUploadDir uploads every file under dirPath into the target folder,
+// mirroring subdirectories as folders. Existing folders with a matching name
+// are reused; missing ones are created, but only when they will hold at
+// least one uploaded file.
+func runUploadDir(cmd *cobra.Command, project, vaultID, dirPath string, excludes []string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	dirPath = richtext.NormalizeDragPath(dirPath)
+	info, err := os.Stat(dirPath)
+	if err != nil {
+		return fmt.Errorf("cannot access %s: %w", dirPath, err)
+	}
+	if !info.IsDir() {
+		return output.ErrUsage(fmt.Sprintf("%s is not a directory; drop --recursive to upload a single file", dirPath))
+	}
+
+	matcher, err := ignore.Load(dirPath, excludes)
+	if err != nil {
+		return output.ErrUsage(err.Error())
+	}
+
+	files, skipped, err := planDirUpload(dirPath, matcher)
+	if err != nil {
+		return err
+	}
+
+	resolvedProjectID, rootVaultID, err := resolveFilesFolder(cmd, app, project, vaultID)
+	if err != nil {
+		return err
+	}
+
+	result := recursiveSyncResult{
+		Path:      dirPath,
+		FolderID:  rootVaultID,
+		ProjectID: resolvedProjectID,
+		Files:     []syncedFile{},
+		Skipped:   skipped,
+	}
+	if result.Skipped == nil {
+		result.Skipped = []string{}
+	}
+
+	folders := &folderMirror{app: app, cmd: cmd, ids: map[string]int64{".": rootVaultID}}
+	for _, f := range files {
+		folderID, err := folders.ensure(path.Dir(f.rel))
+		if err != nil {
+			return err
+		}
+
+		filename := path.Base(f.rel)
+		resp, err := uploadAttachmentFile(cmd.Context(), app, f.path, filename, richtext.DetectMIME(f.path))
+		if err != nil {
+			return err
+		}
+		upload, err := app.Account().Uploads().Create(cmd.Context(), folderID, &basecamp.CreateUploadRequest{
+			AttachableSGID: resp.AttachableSGID,
+			BaseName:       strings.TrimSuffix(filename, filepath.Ext(filename)),
+		})
+		if err != nil {
+			return convertSDKError(err)
+		}
+		result.Files = append(result.Files, syncedFile{Path: f.rel, UploadID: upload.ID, FolderID: folderID, ByteSize: f.size})
+	}
+	result.FoldersCreated = folders.created
+
+	summary := fmt.Sprintf("Uploaded %d files from %s", len(result.Files), dirPath)
+	if folders.created > 0 {
+		summary += fmt.Sprintf(", created %d folders", folders.created)
+	}
+	if len(result.Skipped) > 0 {
+		summary += fmt.Sprintf(" (%d excluded)", len(result.Skipped))
+	}
+
+	return app.OK(result,
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "tree",
+				Cmd:         fmt.Sprintf("basecamp files tree --vault %d --in %s", rootVaultID, resolvedProjectID),
+				Description: "Show folder hierarchy",
+			},
+		),
+	)
+}
+
+// folderMirror maps local directories (slash-separated, relative to the
+// upload root) to Basecamp folder IDs, finding or creating folders on demand.
+type folderMirror struct {
+	app      *appctx.App
+	cmd      *cobra.Command
+	ids      map[string]int64
+	children map[int64]map[string]int64 // parent ID -> child title -> ID
+	created  int
+}
+
+func (f *folderMirror) ensure(dir string) (int64, error) {
+	if id, ok := f.ids[dir]; ok {
+		return id, nil
+	}
+
+	parentID, err := f.ensure(path.Dir(dir))
+	if err != nil {
+		return 0, err
+	}
+
+	name := path.Base(dir)
+	existing, err := f.childFolders(parentID)
+	if err != nil {
+		return 0, err
+	}
+	id, ok := existing[name]
+	if !ok {
+		folder, err := f.app.Account().Vaults().Create(f.cmd.Context(), parentID, &basecamp.CreateVaultRequest{Title: name})
+		if err != nil {
+			return 0, convertSDKError(err)
+		}
+		id = folder.ID
+		existing[name] = id
+		f.created++
+	}
+
+	f.ids[dir] = id
+	return id, nil
+}
+
+func (f *folderMirror) childFolders(parentID int64) (map[string]int64, error) {
+	if f.children == nil {
+		f.children = make(map[int64]map[string]int64)
+	}
+	if c, ok := f.children[parentID]; ok {
+		return c, nil
+	}
+
+	result, err := f.app.Account().Vaults().List(f.cmd.Context(), parentID, nil)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	c := make(map[string]int64, len(result.Vaults))
+	for _, v := range result.Vaults {
+		if _, dup := c[v.Title]; !dup {
+			c[v.Title] = v.ID
+		}
+	}
+	f.children[parentID] = c
+	return c, nil
+}
+
+// runDownloadFolder downloads every upload in a folder and its subfolders
+// into outDir, mirroring the folder hierarchy. Documents are skipped: they
+// have no file to download.
+func runDownloadFolder(cmd *cobra.Command, project, arg, outDir string, excludes []string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	folderIDStr, urlProjectID := extractWithProject(arg)
+	folderID, err := strconv.ParseInt(folderIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid folder ID")
+	}
+
+	resolvedProjectID, err := resolveDownloadProject(cmd, app, urlProjectID, project)
+	if err != nil {
+		return err
+	}
+
+	root, err := app.Account().Vaults().Get(cmd.Context(), folderID)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	if outDir == "" {
+		outDir = syncLocalName(root.Title, fmt.Sprintf("folder-%d", root.ID))
+	}
+
+	matcher, err := ignore.Load(outDir, excludes)
+	if err != nil {
+		return output.ErrUsage(err.Error())
+	}
+
+	result := recursiveSyncResult{
+		Path:      outDir,
+		FolderID:  root.ID,
+		ProjectID: resolvedProjectID,
+		Files:     []syncedFile{},
+		Skipped:   []string{},
+	}
+
+	var walk func(v basecamp.Vault, rel string) error
+	walk = func(v basecamp.Vault, rel string) error {
+		if v.UploadsCount > 0 {
+			uploads, err := app.Account().Uploads().List(cmd.Context(), v.ID, nil)
+			if err != nil {
+				return convertSDKError(err)
+			}
+			seen := make(map[string]bool, len(uploads.Uploads))
+			for _, u := range uploads.Uploads {
+				name := syncLocalName(u.Filename, "")
+				if name == "" {
+					name = syncLocalName(u.Title, fmt.Sprintf("upload-%d", u.ID))
+				}
+				if seen[name] {
+					ext := filepath.Ext(name)
+					name = fmt.Sprintf("%s-%d%s", strings.TrimSuffix(name, ext), u.ID, ext)
+				}
+				seen[name] = true
+
+				relPath := path.Join(rel, name)
+				if matcher.Match(relPath, false) {
+					result.Skipped = append(result.Skipped, relPath)
+					continue
+				}
+
+				written, err := downloadUploadTo(cmd, app, u.ID, filepath.Join(outDir, filepath.FromSlash(rel)), name)
+				if err != nil {
+					return err
+				}
+				result.Files = append(result.Files, syncedFile{Path: relPath, UploadID: u.ID, FolderID: v.ID, ByteSize: written})
+			}
+		}
+
+		if v.VaultsCount == 0 {
+			return nil
+		}
+		children, err := app.Account().Vaults().List(cmd.Context(), v.ID, nil)
+		if err != nil {
+			return convertSDKError(err)
+		}
+		for _, child := range children.Vaults {
+			childRel := path.Join(rel, syncLocalName(child.Title, fmt.Sprintf("folder-%d", child.ID)))
+			if matcher.Match(childRel, true) {
+				result.Skipped = append(result.Skipped, childRel+"/")
+				continue
+			}
+			if err := walk(child, childRel); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if err := walk(*root, ""); err != nil {
+		return err
+	}
+
+	var total int64
+	for _, f := range result.Files {
+		total += f.ByteSize
+	}
+	summary := fmt.Sprintf("Downloaded %d files (%s) to %s", len(result.Files), humanSize(total), outDir)
+	if len(result.Skipped) > 0 {
+		summary += fmt.Sprintf(" (%d excluded)", len(result.Skipped))
+	}
+
+	return app.OK(result,
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "tree",
+				Cmd:         fmt.Sprintf("basecamp files tree --vault %d --in %s", root.ID, resolvedProjectID),
+				Description: "Show folder hierarchy",
+			},
+		),
+	)
+}
+
+// downloadUploadTo downloads one upload into dir under the given name.
+func downloadUploadTo(cmd *cobra.Command, app *appctx.App, uploadID int64, dir, name string) (int64, error) {
+	result, err := app.Account().Uploads().Download(cmd.Context(), uploadID)
+	if err != nil {
+		return 0, convertSDKError(err)
+	}
+	defer result.Body.Close()
+
+	// Name the file after the listing (which the ignore rules were matched
+	// against), not the download response.
+	result.Filename = name
+	_, _, written, err := writeDownloadToFile(result, dir, name)
+	return written, err
+}
+
+// syncLocalName turns a Basecamp title or filename into a single safe path
+// segment, or fallback when nothing usable remains.
+func syncLocalName(name, fallback string) string {
+	name = strings.TrimSpace(strings.NewReplacer("/", "-", `\`, "-").Replace(name))
+	if name == "" || name == "." || name == ".." {
+		return fallback
+	}
+	return name
+}
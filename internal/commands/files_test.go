@@ -8,6 +8,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -567,3 +569,398 @@ func TestFilesUpdateUploadWhitespaceTitleRealContentSendsOnlyDescription(t *test
 	_, hasBaseName := body["base_name"]
 	assert.False(t, hasBaseName, "base_name must not be sent for whitespace-only --title")
 }
+
+// mockFilesStatusTransport serves a single recording for type detection and
+// records status changes.
+type mockFilesStatusTransport struct {
+	recordingType string
+	statusPaths   []string
+}
+
+func (m *mockFilesStatusTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	switch {
+	case req.Method == http.MethodGet && req.URL.Path == "/99999/recordings/789":
+		body := fmt.Sprintf(`{"id":789,"status":"active","type":%q,"title":"Roadmap"}`, m.recordingType)
+		return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+	case req.Method == http.MethodPut && strings.HasPrefix(req.URL.Path, "/99999/recordings/789/status/"):
+		m.statusPaths = append(m.statusPaths, req.URL.Path)
+		return &http.Response{StatusCode: 204, Body: io.NopCloser(strings.NewReader("")), Header: header}, nil
+	default:
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+	}
+}
+
+func TestFilesTrashDetectsTypeAndTrashes(t *testing.T) {
+	transport := &mockFilesStatusTransport{recordingType: "Document"}
+	app := showTestApp(t, transport)
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "trash", "789", "--force")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/99999/recordings/789/status/trashed.json"}, transport.statusPaths)
+}
+
+func TestFilesTrashRefusesNonFilesRecording(t *testing.T) {
+	transport := &mockFilesStatusTransport{recordingType: "Todo"}
+	app := showTestApp(t, transport)
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "trash", "789", "--force")
+	require.Error(t, err)
+
+	var e *output.Error
+	require.True(t, errors.As(err, &e), "expected *output.Error, got %T: %v", err, err)
+	assert.Contains(t, e.Message, "not a folder, document, or upload")
+	assert.Empty(t, transport.statusPaths)
+}
+
+func TestFilesRestoreUsesActiveStatus(t *testing.T) {
+	transport := &mockFilesStatusTransport{recordingType: "Upload"}
+	app := showTestApp(t, transport)
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "restore", "789")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"/99999/recordings/789/status/active.json"}, transport.statusPaths)
+}
+
+// mockFilesTreeTransport serves a three-level folder hierarchy:
+// Root(10) → Specs(11) → Drafts(12), plus Assets(13) directly under Root.
+type mockFilesTreeTransport struct {
+	listed []string
+}
+
+func (m *mockFilesTreeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch req.URL.Path {
+	case "/99999/projects.json":
+		body = `[{"id":123,"name":"Launch"}]`
+	case "/99999/vaults/10":
+		body = `{"id":10,"title":"Docs & Files","documents_count":1,"uploads_count":2,"vaults_count":2}`
+	case "/99999/vaults/10/vaults.json":
+		m.listed = append(m.listed, req.URL.Path)
+		body = `[{"id":11,"title":"Specs","documents_count":3,"uploads_count":0,"vaults_count":1},` +
+			`{"id":13,"title":"Assets","documents_count":0,"uploads_count":5,"vaults_count":0}]`
+	case "/99999/vaults/11/vaults.json":
+		m.listed = append(m.listed, req.URL.Path)
+		body = `[{"id":12,"title":"Drafts","documents_count":4,"uploads_count":1,"vaults_count":0}]`
+	default:
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestFilesTreeBuildsHierarchyWithCounts(t *testing.T) {
+	transport := &mockFilesTreeTransport{}
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "tree", "-p", "123", "--vault", "10")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data    filesTreeNode `json:"data"`
+		Summary string        `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+
+	root := envelope.Data
+	assert.Equal(t, "Docs & Files", root.Title)
+	require.Len(t, root.Children, 2)
+	assert.Equal(t, "Specs", root.Children[0].Title)
+	require.Len(t, root.Children[0].Children, 1)
+	assert.Equal(t, 4, root.Children[0].Children[0].Documents)
+	assert.Equal(t, "3 folders, 8 documents, 8 uploads", envelope.Summary)
+}
+
+func TestFilesTreeStyledGoesThroughWriter(t *testing.T) {
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, &mockFilesTreeTransport{}, output.FormatStyled, &buf, &bytes.Buffer{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "tree", "-p", "123", "--vault", "10")
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "Docs & Files")
+	assert.Contains(t, out, "│   └── Drafts")
+	assert.Contains(t, out, "└── Assets")
+}
+
+func TestFilesTreeDepthLimitsExpansion(t *testing.T) {
+	transport := &mockFilesTreeTransport{}
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "tree", "-p", "123", "--vault", "10", "--depth", "1")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data filesTreeNode `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+
+	require.Len(t, envelope.Data.Children, 2)
+	assert.Empty(t, envelope.Data.Children[0].Children, "depth 1 must not expand Specs")
+	assert.Equal(t, 1, envelope.Data.Children[0].Folders, "unexpanded folders keep their counts")
+	assert.Equal(t, []string{"/99999/vaults/10/vaults.json"}, transport.listed)
+}
+
+func TestFilesTreeRejectsNegativeDepth(t *testing.T) {
+	app := showTestApp(t, &mockFilesTreeTransport{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "tree", "--depth", "-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--depth")
+}
+
+// mockRecursiveUploadTransport serves a root folder (10) with one existing
+// subfolder "docs" (11) and records folder creations and uploads.
+type mockRecursiveUploadTransport struct {
+	createdFolders []string
+	uploads        map[string][]string // folder path -> base names
+	nextID         int
+}
+
+func (m *mockRecursiveUploadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	respond := func(status int, body string) (*http.Response, error) {
+		return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+	}
+	m.nextID++
+
+	switch {
+	case req.URL.Path == "/99999/projects.json":
+		return respond(200, `[{"id":123,"name":"Launch"}]`)
+	case req.URL.Path == "/99999/attachments.json":
+		return respond(201, `{"attachable_sgid":"sgid"}`)
+	case req.Method == http.MethodGet && req.URL.Path == "/99999/vaults/10/vaults.json":
+		return respond(200, `[{"id":11,"title":"docs"}]`)
+	case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/vaults.json"):
+		return respond(200, `[]`)
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/vaults.json"):
+		var body struct {
+			Title string `json:"title"`
+		}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		m.createdFolders = append(m.createdFolders, req.URL.Path+" "+body.Title)
+		return respond(201, fmt.Sprintf(`{"id":%d,"title":%q}`, 100+m.nextID, body.Title))
+	case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/uploads.json"):
+		var body struct {
+			BaseName string `json:"base_name"`
+		}
+		_ = json.NewDecoder(req.Body).Decode(&body)
+		if m.uploads == nil {
+			m.uploads = make(map[string][]string)
+		}
+		m.uploads[req.URL.Path] = append(m.uploads[req.URL.Path], body.BaseName)
+		return respond(201, fmt.Sprintf(`{"id":%d}`, 500+m.nextID))
+	}
+	return nil, fmt.Errorf("unexpected request: %s %s", req.Method, req.URL.Path)
+}
+
+func writeTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+	root := t.TempDir()
+	for rel, content := range files {
+		p := filepath.Join(root, filepath.FromSlash(rel))
+		require.NoError(t, os.MkdirAll(filepath.Dir(p), 0o700))
+		require.NoError(t, os.WriteFile(p, []byte(content), 0o600))
+	}
+	return root
+}
+
+func TestUploadRecursiveHonorsIgnoreFileAndExcludes(t *testing.T) {
+	root := writeTree(t, map[string]string{
+		".basecampignore":            "node_modules/\n*.log\n",
+		"index.html":                 "<html>",
+		"debug.log":                  "noise",
+		"docs/guide.md":              "# Guide",
+		"docs/img/logo.png":          "png",
+		"docs/img/logo.png.map":      "map",
+		"node_modules/react/x.js":    "js",
+		"build/only-excluded/out.js": "js",
+	})
+
+	transport := &mockRecursiveUploadTransport{}
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+
+	err := executeMessagesCommand(NewUploadCmd(), app, root, "--recursive",
+		"-p", "123", "--vault", "10", "--exclude", "*.map", "--exclude", "build")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data    recursiveSyncResult `json:"data"`
+		Summary string              `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+
+	var uploaded []string
+	for _, f := range envelope.Data.Files {
+		uploaded = append(uploaded, f.Path)
+	}
+	assert.ElementsMatch(t, []string{"index.html", "docs/guide.md", "docs/img/logo.png"}, uploaded)
+	assert.ElementsMatch(t, []string{"build/", "debug.log", "docs/img/logo.png.map", "node_modules/"}, envelope.Data.Skipped)
+
+	// "docs" already exists and is reused; only "img" is created, inside it.
+	assert.Equal(t, []string{"/99999/vaults/11/vaults.json img"}, transport.createdFolders)
+	assert.Equal(t, []string{"index"}, transport.uploads["/99999/vaults/10/uploads.json"])
+	assert.Equal(t, []string{"guide"}, transport.uploads["/99999/vaults/11/uploads.json"])
+	assert.Equal(t, 1, envelope.Data.FoldersCreated)
+	assert.Contains(t, envelope.Summary, "Uploaded 3 files")
+}
+
+func TestFoldersCreatePathReusesExistingSegments(t *testing.T) {
+	transport := &mockRecursiveUploadTransport{}
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "folder", "create",
+		"--path", "/docs//Assets/Logos/", "-p", "123", "--vault", "10")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data    folderPathResult `json:"data"`
+		Summary string           `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+
+	// "docs" (11) exists; Assets is created in it, then Logos in Assets.
+	require.Len(t, transport.createdFolders, 2)
+	assert.Equal(t, "/99999/vaults/11/vaults.json Assets", transport.createdFolders[0])
+	assert.Contains(t, transport.createdFolders[1], " Logos")
+
+	folders := envelope.Data.Folders
+	require.Len(t, folders, 3)
+	assert.Equal(t, folderPathSegment{ID: 11, Title: "docs", Created: false}, folders[0])
+	assert.True(t, folders[1].Created)
+	assert.True(t, folders[2].Created)
+	assert.Equal(t, folders[2].ID, envelope.Data.ID)
+	assert.Equal(t, int64(10), envelope.Data.ParentID)
+	assert.Equal(t, "docs/Assets/Logos", envelope.Data.Path)
+	assert.Contains(t, envelope.Summary, "2 new folders")
+}
+
+func TestFoldersCreatePathValidation(t *testing.T) {
+	app := showTestApp(t, &mockRecursiveUploadTransport{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "folder", "create", "X", "--path", "a/b", "-p", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not both")
+
+	err = executeMessagesCommand(NewFilesCmd(), app, "folder", "create", "--path", "a/../b", "-p", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `".."`)
+
+	err = executeMessagesCommand(NewFilesCmd(), app, "folder", "create", "--path", " / ", "-p", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "at least one folder")
+}
+
+func TestUploadExcludeRequiresRecursive(t *testing.T) {
+	app := showTestApp(t, &mockRecursiveUploadTransport{})
+
+	err := executeMessagesCommand(NewUploadCmd(), app, "./file.txt", "--exclude", "*.log")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--exclude requires --recursive")
+}
+
+func TestUploadRecursiveRejectsFile(t *testing.T) {
+	root := writeTree(t, map[string]string{"a.txt": "a"})
+	app := showTestApp(t, &mockRecursiveUploadTransport{})
+
+	err := executeMessagesCommand(NewUploadCmd(), app, filepath.Join(root, "a.txt"), "--recursive", "-p", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not a directory")
+}
+
+// mockRecursiveDownloadTransport serves folder 10 ("Assets") holding
+// logo.png and a "raw" subfolder (11) holding huge.psd.
+type mockRecursiveDownloadTransport struct {
+	downloaded []string
+}
+
+func (m *mockRecursiveDownloadTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch p := req.URL.Path; {
+	case p == "/99999/projects.json":
+		body = `[{"id":123,"name":"Launch"}]`
+	case p == "/99999/vaults/10":
+		body = `{"id":10,"title":"Assets","uploads_count":1,"vaults_count":1}`
+	case p == "/99999/vaults/10/uploads.json":
+		body = `[{"id":1,"title":"logo","filename":"logo.png"}]`
+	case p == "/99999/vaults/10/vaults.json":
+		body = `[{"id":11,"title":"raw","uploads_count":1,"vaults_count":0}]`
+	case p == "/99999/vaults/11/uploads.json":
+		body = `[{"id":2,"title":"huge","filename":"huge.psd"}]`
+	case strings.HasPrefix(p, "/99999/uploads/"):
+		id := strings.TrimSuffix(strings.TrimPrefix(p, "/99999/uploads/"), ".json")
+		body = fmt.Sprintf(`{"id":%s,"download_url":"https://signed.example.com/blob-%s"}`, id, id)
+	case strings.HasPrefix(p, "/blob-"):
+		m.downloaded = append(m.downloaded, p)
+		header.Set("Content-Type", "application/octet-stream")
+		body = "bytes-" + strings.TrimPrefix(p, "/blob-")
+	default:
+		return nil, fmt.Errorf("unexpected request: %s %s", req.Method, p)
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestFilesDownloadRecursiveMirrorsFolders(t *testing.T) {
+	transport := &mockRecursiveDownloadTransport{}
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+	out := t.TempDir()
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "download", "10", "--recursive", "-p", "123", "--out", out)
+	require.NoError(t, err)
+
+	logo, err := os.ReadFile(filepath.Join(out, "logo.png"))
+	require.NoError(t, err)
+	assert.Equal(t, "bytes-1", string(logo))
+	psd, err := os.ReadFile(filepath.Join(out, "raw", "huge.psd"))
+	require.NoError(t, err)
+	assert.Equal(t, "bytes-2", string(psd))
+}
+
+func TestFilesDownloadRecursiveSkipsExcluded(t *testing.T) {
+	transport := &mockRecursiveDownloadTransport{}
+	var buf bytes.Buffer
+	app := showTestAppWithOutput(t, transport, output.FormatJSON, &buf, &bytes.Buffer{})
+	out := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(out, ".basecampignore"), []byte("raw/\n"), 0o600))
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "download", "10", "--recursive", "-p", "123",
+		"--out", out, "--exclude", "*.png")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data recursiveSyncResult `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Empty(t, envelope.Data.Files)
+	assert.Equal(t, []string{"logo.png", "raw/"}, envelope.Data.Skipped)
+	assert.Empty(t, transport.downloaded, "excluded files are never fetched")
+}
+
+func TestFilesDownloadRecursiveRejectsStdout(t *testing.T) {
+	app := showTestApp(t, &mockRecursiveDownloadTransport{})
+
+	err := executeMessagesCommand(NewFilesCmd(), app, "download", "10", "--recursive", "--out", "-")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--recursive")
+}
+
+func TestSyncLocalName(t *testing.T) {
+	assert.Equal(t, "a-b", syncLocalName("a/b", "x"))
+	assert.Equal(t, "x", syncLocalName("..", "x"))
+	assert.Equal(t, "x", syncLocalName("  ", "x"))
+	assert.Equal(t, "Q1 report.pdf", syncLocalName("Q1 report.pdf", "x"))
+}
@@ -27,8 +27,8 @@ that can receive forwarded emails.`,
 		Annotations: map[string]string{"agent_notes": "Forwards are emails sent into a Basecamp project's inbox\nEach project has one inbox (forward container)\nContent supports Markdown"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVar(&inboxID, "inbox", "", "Inbox ID (auto-detected from project)")
 
 	cmd.AddCommand(
@@ -87,21 +87,7 @@ func runForwardsList(cmd *cobra.Command, project, inboxID string, limit, page in
 	}
 
 	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -138,8 +124,10 @@ func runForwardsList(cmd *cobra.Command, project, inboxID string, limit, page in
 		output.WithSummary(fmt.Sprintf("%d forwards", len(forwards))),
 	}
 
+	respOpts = append(respOpts, listPagination(forwardsResult.Meta, page, len(forwards)))
+
 	// Add truncation notice if results may be limited
-	if notice := output.TruncationNoticeWithTotal(len(forwards), forwardsResult.Meta.TotalCount); notice != "" {
+	if notice := listTruncationNotice(len(forwards), forwardsResult.Meta, page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
@@ -190,23 +178,10 @@ You can pass either a forward ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
 			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -264,21 +239,7 @@ func newForwardsInboxCmd(project, inboxID *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -360,23 +321,10 @@ You can pass either a forward ID or a Basecamp URL:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
 			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
@@ -402,8 +350,10 @@ You can pass either a forward ID or a Basecamp URL:
 				output.WithSummary(fmt.Sprintf("%d replies to forward #%s", len(replies), forwardIDStr)),
 			}
 
+			respOpts = append(respOpts, listPagination(repliesResult.Meta, page, len(replies)))
+
 			// Add truncation notice if results may be limited
-			if notice := output.TruncationNoticeWithTotal(len(replies), repliesResult.Meta.TotalCount); notice != "" {
+			if notice := listTruncationNotice(len(replies), repliesResult.Meta, page); notice != "" {
 				respOpts = append(respOpts, output.WithNotice(notice))
 			}
 
@@ -466,23 +416,10 @@ You can pass either IDs or Basecamp URLs:
 
 			// Resolve project - use URL > flag > config, with interactive fallback
 			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
 			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
+				projectID = urlProjectID
 			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, projectID)
 			if err != nil {
 				return err
 			}
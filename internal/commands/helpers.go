@@ -103,9 +103,41 @@ func readPipedStdin(cmd *cobra.Command) (string, bool, error) {
 	if err != nil {
 		return "", false, fmt.Errorf("failed to read stdin: %w", err)
 	}
+	if app := appctx.FromContext(cmd.Context()); app != nil {
+		app.ReadStdin = true
+	}
 	return string(data), true, nil
 }
 
+// ContextCarryFlags are the global flags that decide which account,
+// profile, cache, and timezone a command works in. A child run of this
+// binary (a playbook step, an --interactive hint) is given them so it works
+// in the same place as its parent.
+var ContextCarryFlags = []string{"account", "profile", "cache-dir", "tz"}
+
+// CarryFlags returns --name=value for each of names set on root's
+// persistent flags, skipping any already given in args.
+func CarryFlags(root *cobra.Command, args, names []string) []string {
+	present := make(map[string]bool)
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "--") {
+			name, _, _ := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+			present[name] = true
+		}
+	}
+
+	var carried []string
+	pf := root.PersistentFlags()
+	for _, name := range names {
+		f := pf.Lookup(name)
+		if f == nil || !f.Changed || present[name] {
+			continue
+		}
+		carried = append(carried, "--"+name+"="+f.Value.String())
+	}
+	return carried
+}
+
 // DockTool represents a tool in a project's dock.
 type DockTool struct {
 	Name    string `json:"name"`
@@ -274,6 +306,35 @@ func ensureProject(cmd *cobra.Command, app *appctx.App) error {
 	return nil
 }
 
+// resolveProjectID resolves the project ID from a command's --project/--in
+// value, the global flag, config, or an interactive prompt, in that order.
+// Every form goes through the name resolver, so a project name, ID, or
+// pasted Basecamp URL is accepted and ambiguous names fail the same way.
+func resolveProjectID(cmd *cobra.Command, app *appctx.App, project string) (string, error) {
+	projectID, _, err := resolveProject(cmd, app, project)
+	return projectID, err
+}
+
+// resolveProject is resolveProjectID for callers that also show the
+// project's name.
+func resolveProject(cmd *cobra.Command, app *appctx.App, project string) (string, string, error) {
+	projectID := project
+	if projectID == "" {
+		projectID = app.Flags.Project
+	}
+	if projectID == "" {
+		projectID = app.Config.ProjectID
+	}
+	if projectID == "" {
+		if err := ensureProject(cmd, app); err != nil {
+			return "", "", err
+		}
+		projectID = app.Config.ProjectID
+	}
+
+	return app.Names.ResolveProject(cmd.Context(), projectID)
+}
+
 // ensureTodoset resolves the todoset ID from a project, with interactive fallback.
 // If explicitTodosetID is provided (e.g. from --todoset flag), it is used directly.
 // Otherwise, auto-selects when one todoset exists, or prompts when multiple exist.
@@ -3,6 +3,8 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"strconv"
 	"strings"
 
@@ -11,6 +13,7 @@ import (
 
 	"github.com/basecamp/basecamp-cli/internal/appctx"
 	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
 )
 
 // NewHillchartsCmd creates the hillcharts command group.
@@ -29,8 +32,8 @@ with each tracked todolist represented as a dot on the hill.`,
 		},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newHillchartsShowCmd(&project),
@@ -45,14 +48,29 @@ func newHillchartsShowCmd(project *string) *cobra.Command {
 	var todosetID string
 
 	cmd := &cobra.Command{
-		Use:   "show",
+		Use:   "show [project|todolist]",
 		Short: "Show hill chart for a todoset",
 		Long: `Show the hill chart state for a todoset, including all tracked todolists.
 
+The argument names the project, or, when --in or --todoset picks the
+project, a tracked todolist (by ID or name) to show on its own. In a
+terminal the chart is drawn as an ASCII hill; use --json for the data.
+
+  basecamp hillcharts show MyProject
   basecamp hillcharts show --in MyProject
+  basecamp hillcharts show "Launch" --in MyProject
   basecamp hillcharts show --in MyProject --todoset 12345`,
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runHillchartsShow(cmd, *project, todosetID)
+			projectArg, todolist := *project, ""
+			if len(args) > 0 {
+				if projectArg == "" && todosetID == "" {
+					projectArg = args[0]
+				} else {
+					todolist = args[0]
+				}
+			}
+			return runHillchartsShow(cmd, projectArg, todosetID, todolist)
 		},
 	}
 
@@ -61,7 +79,7 @@ func newHillchartsShowCmd(project *string) *cobra.Command {
 	return cmd
 }
 
-func runHillchartsShow(cmd *cobra.Command, project, todosetID string) error {
+func runHillchartsShow(cmd *cobra.Command, project, todosetID, todolist string) error {
 	app := appctx.FromContext(cmd.Context())
 
 	if err := ensureAccount(cmd, app); err != nil {
@@ -118,10 +136,28 @@ func runHillchartsShow(cmd *cobra.Command, project, todosetID string) error {
 	}
 
 	scope := hillchartScope(resolvedProjectID, todosetID)
-	summary := fmt.Sprintf("Hill chart: %d dot(s) tracked", len(hillChart.Dots))
 
-	return app.OK(hillChart,
+	if todolist != "" {
+		dot, ok := findHillChartDot(hillChart.Dots, todolist)
+		if !ok {
+			return output.ErrUsageHint(
+				fmt.Sprintf("Todolist %q is not tracked on the hill chart", todolist),
+				fmt.Sprintf("Track it with: basecamp hillcharts track %s %s", strconv.Quote(todolist), scope),
+			)
+		}
+		hillChart.Dots = []basecamp.HillChartDot{dot}
+	}
+
+	summary := fmt.Sprintf("Hill chart: %d dot(s) tracked", len(hillChart.Dots))
+	respOpts := []output.ResponseOption{
 		output.WithSummary(summary),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderHillChartStyled(w, r, hillChart) }),
+	}
+	if hillChart.Stale {
+		respOpts = append(respOpts, output.WithNotice("Hill chart is stale: tracked todolists have changed since it was last updated"))
+	}
+
+	return app.OK(hillChart, append(respOpts,
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "track",
@@ -134,7 +170,7 @@ func runHillchartsShow(cmd *cobra.Command, project, todosetID string) error {
 				Description: "Untrack todolists from hill chart",
 			},
 		),
-	)
+	)...)
 }
 
 func newHillchartsTrackCmd(project *string) *cobra.Command {
@@ -314,3 +350,109 @@ func emptyTodosetHint(resolvedProjectID, resolvedTodosetID, todosetFlag string)
 		"Create todolists in the project that owns this todoset, then track them:\n  basecamp hillcharts track <todolist-ids> --todoset %s",
 		resolvedTodosetID)
 }
+
+// findHillChartDot finds a tracked todolist by ID or, case-insensitively, by name.
+func findHillChartDot(dots []basecamp.HillChartDot, todolist string) (basecamp.HillChartDot, bool) {
+	for _, dot := range dots {
+		if strconv.FormatInt(dot.ID, 10) == todolist || strings.EqualFold(dot.Label, todolist) {
+			return dot, true
+		}
+	}
+	return basecamp.HillChartDot{}, false
+}
+
+const (
+	hillWidth  = 61 // odd, so the top of the hill falls on a column
+	hillHeight = 8
+	hillMarks  = "123456789abcdefghijklmnopqrstuvwxyz"
+)
+
+// hillMark labels the i'th dot on the plot and in the legend.
+func hillMark(i int) byte {
+	if i < len(hillMarks) {
+		return hillMarks[i]
+	}
+	return '*'
+}
+
+// hillPhase describes where a position (0-100) sits on the hill.
+func hillPhase(position int) string {
+	switch {
+	case position <= 0:
+		return "not started"
+	case position < 50:
+		return "figuring it out"
+	case position >= 100:
+		return "done"
+	default:
+		return "making it happen"
+	}
+}
+
+// renderHill draws the hill as ASCII lines with each dot's mark placed on
+// the curve at its position. Marks that land on the same spot stack upward.
+func renderHill(dots []basecamp.HillChartDot) []string {
+	// One spare row above the curve for stacked marks.
+	rows := hillHeight + 1
+	grid := make([][]byte, rows)
+	for i := range grid {
+		grid[i] = []byte(strings.Repeat(" ", hillWidth))
+	}
+	curveRow := func(col int) int {
+		x := float64(col) / float64(hillWidth-1)
+		return rows - 1 - int(math.Round(math.Sin(math.Pi*x)*float64(hillHeight-1)))
+	}
+	for col := range hillWidth {
+		grid[curveRow(col)][col] = '.'
+	}
+	for i, dot := range dots {
+		col := int(math.Round(float64(min(max(dot.Position, 0), 100)) / 100 * float64(hillWidth-1)))
+		row := curveRow(col)
+		for row > 0 && grid[row][col] != '.' {
+			row--
+		}
+		grid[row][col] = hillMark(i)
+	}
+
+	lines := make([]string, 0, rows+2)
+	for _, row := range grid {
+		if line := strings.TrimRight(string(row), " "); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	mid := hillWidth / 2
+	lines = append(lines,
+		strings.Repeat("-", mid)+"+"+strings.Repeat("-", hillWidth-mid-1),
+		fmt.Sprintf("%-*s%*s", mid, "Figuring it out", hillWidth-mid, "Making it happen"),
+	)
+	return lines
+}
+
+// renderHillChartStyled draws the chart; the writer adds the stale notice.
+func renderHillChartStyled(w io.Writer, r *output.Renderer, chart *basecamp.HillChart) {
+	summary := fmt.Sprintf("Hill chart: %d %s tracked", len(chart.Dots), pluralize(len(chart.Dots), "todolist", "todolists"))
+	if !chart.UpdatedAt.IsZero() {
+		summary += ", updated " + chart.UpdatedAt.Local().Format("Jan 2, 2006")
+	}
+	fmt.Fprintln(w, r.Summary.Render(summary))
+	fmt.Fprintln(w)
+
+	for _, line := range renderHill(chart.Dots) {
+		fmt.Fprintln(w, line)
+	}
+	if len(chart.Dots) == 0 {
+		return
+	}
+
+	fmt.Fprintln(w)
+	labels := make([]string, len(chart.Dots))
+	width := 0
+	for i, dot := range chart.Dots {
+		labels[i] = richtext.SanitizeSingleLine(dot.Label)
+		width = max(width, len(labels[i]))
+	}
+	for i, dot := range chart.Dots {
+		fmt.Fprintf(w, "  %c  %-*s  %3d%%  %s\n", hillMark(i), width, labels[i], dot.Position,
+			r.Muted.Render(fmt.Sprintf("%s  #%d", hillPhase(dot.Position), dot.ID)))
+	}
+}
@@ -3,6 +3,7 @@ package commands
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -266,6 +267,58 @@ func TestHillchartsShowSuccess(t *testing.T) {
 	assert.Contains(t, buf.String(), "Alpha")
 }
 
+func TestHillchartsShowTodolistArg(t *testing.T) {
+	transport := &hillchartsTransport{
+		hillChartBody: `{"enabled": true, "stale": true, "dots": [
+			{"id": 1, "label": "Alpha", "position": 20},
+			{"id": 2, "label": "Beta", "position": 80}]}`,
+	}
+	app, buf := setupHillchartsMockApp(t, transport)
+
+	err := executeHillchartsCommand(NewHillchartsCmd(), app, "show", "beta", "--todoset", "12345")
+	require.NoError(t, err)
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	dots := resp.Data.(map[string]any)["dots"].([]any)
+	require.Len(t, dots, 1)
+	assert.Equal(t, "Beta", dots[0].(map[string]any)["label"])
+	assert.Contains(t, resp.Notice, "stale")
+
+	err = executeHillchartsCommand(NewHillchartsCmd(), app, "show", "Gamma", "--todoset", "12345")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not tracked")
+}
+
+func TestRenderHill(t *testing.T) {
+	lines := renderHill([]basecamp.HillChartDot{
+		{ID: 1, Label: "Start", Position: 0},
+		{ID: 2, Label: "Top", Position: 50},
+		{ID: 3, Label: "Also top", Position: 50},
+		{ID: 4, Label: "Done", Position: 100},
+	})
+
+	require.Len(t, lines, hillHeight+3)
+	assert.Equal(t, "3", strings.TrimSpace(lines[0]), "a second dot at the top stacks above the first")
+	assert.Equal(t, byte('2'), lines[1][hillWidth/2])
+	assert.Equal(t, byte('1'), lines[hillHeight][0])
+	assert.Equal(t, byte('4'), lines[hillHeight][hillWidth-1])
+	assert.Equal(t, byte('+'), lines[hillHeight+1][hillWidth/2])
+	assert.True(t, strings.HasPrefix(lines[hillHeight+2], "Figuring it out"))
+	assert.True(t, strings.HasSuffix(lines[hillHeight+2], "Making it happen"))
+}
+
+func TestRenderHillChartStyled(t *testing.T) {
+	var buf bytes.Buffer
+	renderHillChartStyled(&buf, output.NewRenderer(&buf, false), &basecamp.HillChart{Dots: []basecamp.HillChartDot{
+		{ID: 7, Label: "Launch", Position: 30},
+	}})
+
+	out := buf.String()
+	assert.Contains(t, out, "Hill chart: 1 todolist tracked")
+	assert.Contains(t, out, "1  Launch   30%  figuring it out  #7")
+}
+
 func TestHillchartsShow404(t *testing.T) {
 	transport := &hillchartsTransport{
 		hillChartStatus: 404,
@@ -249,6 +249,10 @@ You can pass either a marker ID or a Basecamp URL:
 				return output.ErrUsage("Invalid marker ID")
 			}
 
+			if !confirmAction(cmd, confirmPermanent, fmt.Sprintf("Delete lineup marker #%d?", markerID), false) {
+				return nil
+			}
+
 			if err := app.Account().Lineup().DeleteMarker(cmd.Context(), markerID); err != nil {
 				return convertSDKError(err)
 			}
@@ -0,0 +1,96 @@
+package commands
+
+import (
+	"context"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+)
+
+// recordingFilterFlags are the --created-by and --updated-since flags shared
+// by the todos, cards, messages, and docs list commands.
+type recordingFilterFlags struct {
+	createdBy    string
+	updatedSince string
+}
+
+func addRecordingFilterFlags(cmd *cobra.Command, flags *recordingFilterFlags) {
+	cmd.Flags().StringVar(&flags.createdBy, "created-by", "", "Only items created by this person (ID, name, or \"me\")")
+	cmd.Flags().StringVar(&flags.updatedSince, "updated-since", "", "Only items updated since this window, date, or RFC 3339 time (24h, 3d, 2026-01-15)")
+}
+
+// recordingFilter narrows a fetched list by creator and last update. Zero
+// fields match everything.
+type recordingFilter struct {
+	creatorID    int64
+	updatedSince time.Time
+}
+
+// resolve turns the flags into a filter: the person through the names
+// resolver, the cutoff relative to now.
+func (flags recordingFilterFlags) resolve(ctx context.Context, app *appctx.App, now time.Time) (recordingFilter, error) {
+	var f recordingFilter
+	if flags.updatedSince != "" {
+		since, err := parseSinceFlag("--updated-since", flags.updatedSince, now)
+		if err != nil {
+			return recordingFilter{}, err
+		}
+		f.updatedSince = since
+	}
+	if flags.createdBy != "" {
+		id, err := resolvePersonRoleID(ctx, app, flags.createdBy, "Creator")
+		if err != nil {
+			return recordingFilter{}, err
+		}
+		f.creatorID = id
+	}
+	return f, nil
+}
+
+func (f recordingFilter) active() bool {
+	return f.creatorID != 0 || !f.updatedSince.IsZero()
+}
+
+func (f recordingFilter) match(creator *basecamp.Person, updatedAt time.Time) bool {
+	if f.creatorID != 0 && (creator == nil || creator.ID != f.creatorID) {
+		return false
+	}
+	if !f.updatedSince.IsZero() && updatedAt.Before(f.updatedSince) {
+		return false
+	}
+	return true
+}
+
+// filterRecordings returns the items f matches, in their original order.
+// fields extracts an item's creator and last update.
+func filterRecordings[T any](f recordingFilter, items []T, fields func(T) (*basecamp.Person, time.Time)) []T {
+	if !f.active() {
+		return items
+	}
+	kept := make([]T, 0, len(items))
+	for _, item := range items {
+		if f.match(fields(item)) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+func todoRecordingFields(t basecamp.Todo) (*basecamp.Person, time.Time) {
+	return t.Creator, t.UpdatedAt
+}
+
+func cardRecordingFields(c basecamp.Card) (*basecamp.Person, time.Time) {
+	return c.Creator, c.UpdatedAt
+}
+
+func messageRecordingFields(m basecamp.Message) (*basecamp.Person, time.Time) {
+	return m.Creator, m.UpdatedAt
+}
+
+func documentRecordingFields(d basecamp.Document) (*basecamp.Person, time.Time) {
+	return d.Creator, d.UpdatedAt
+}
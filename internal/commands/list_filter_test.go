@@ -0,0 +1,77 @@
+package commands
+
+import (
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordingFilterMatch(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	ann := &basecamp.Person{ID: 42, Name: "Ann"}
+	bo := &basecamp.Person{ID: 7, Name: "Bo"}
+	before := since.Add(-time.Hour)
+	after := since.Add(time.Hour)
+
+	assert.False(t, recordingFilter{}.active())
+	assert.True(t, recordingFilter{}.match(nil, before), "the zero filter matches everything")
+
+	byAnn := recordingFilter{creatorID: 42}
+	assert.True(t, byAnn.match(ann, before))
+	assert.False(t, byAnn.match(bo, before))
+	assert.False(t, byAnn.match(nil, before), "no creator doesn't match a creator filter")
+
+	recent := recordingFilter{updatedSince: since}
+	assert.True(t, recent.match(nil, after))
+	assert.True(t, recent.match(nil, since), "the cutoff itself is included")
+	assert.False(t, recent.match(nil, before))
+
+	both := recordingFilter{creatorID: 42, updatedSince: since}
+	assert.True(t, both.match(ann, after))
+	assert.False(t, both.match(ann, before))
+	assert.False(t, both.match(bo, after))
+}
+
+func TestFilterRecordings(t *testing.T) {
+	since := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+	todos := []basecamp.Todo{
+		{ID: 1, Creator: &basecamp.Person{ID: 42}, UpdatedAt: since.AddDate(0, 0, 1)},
+		{ID: 2, Creator: &basecamp.Person{ID: 7}, UpdatedAt: since.AddDate(0, 0, 2)},
+		{ID: 3, Creator: &basecamp.Person{ID: 42}, UpdatedAt: since.AddDate(0, 0, -1)},
+	}
+
+	assert.Len(t, filterRecordings(recordingFilter{}, todos, todoRecordingFields), 3)
+
+	kept := filterRecordings(recordingFilter{updatedSince: since}, todos, todoRecordingFields)
+	require.Len(t, kept, 2)
+	assert.Equal(t, int64(1), kept[0].ID)
+	assert.Equal(t, int64(2), kept[1].ID)
+
+	kept = filterRecordings(recordingFilter{creatorID: 42, updatedSince: since}, todos, todoRecordingFields)
+	require.Len(t, kept, 1)
+	assert.Equal(t, int64(1), kept[0].ID)
+}
+
+func TestParseSinceFlag(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	got, err := parseSinceFlag("--updated-since", "2026-03-14T09:30:00Z", now)
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2026, 3, 14, 9, 30, 0, 0, time.UTC), got)
+
+	got, err = parseSinceFlag("--updated-since", "3d", now)
+	require.NoError(t, err)
+	assert.Equal(t, now.AddDate(0, 0, -3), got)
+
+	_, err = parseSinceFlag("--updated-since", "soon", now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --updated-since value")
+
+	_, err = parseSinceFlag("--updated-since", "2026-04-01T00:00:00Z", now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--updated-since")
+	assert.Contains(t, err.Error(), "in the future")
+}
@@ -24,8 +24,8 @@ A message board is the container that holds all messages in a project.
 Each project has exactly one message board in its dock.`,
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVarP(&boardID, "board", "b", "", "Message board ID (auto-detected from project)")
 
 	cmd.AddCommand(newMessageboardShowCmd(&project, &boardID))
@@ -62,21 +62,7 @@ func runMessageboardShow(cmd *cobra.Command, project, boardIDStr string) error {
 	}
 
 	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
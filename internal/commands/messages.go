@@ -1,17 +1,19 @@
 package commands
 
 import (
+	"context"
 	"fmt"
-	"os"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 
 	"github.com/basecamp/basecamp-cli/internal/appctx"
-	"github.com/basecamp/basecamp-cli/internal/editor"
 	"github.com/basecamp/basecamp-cli/internal/output"
 	"github.com/basecamp/basecamp-cli/internal/richtext"
 )
@@ -32,8 +34,8 @@ use --message-board <id> to specify which one.`,
 		Annotations: map[string]string{"agent_notes": "Rich text content accepts Markdown — the CLI converts to HTML\nCross-project messages: basecamp recordings messages --json\nPinned messages appear at the top of the message board\n@mentions: prefer [@Name](mention:SGID) for zero API calls, or [@Name](person:ID) for one lookup; @Name/@First.Last for fuzzy matching"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVar(&messageBoard, "message-board", "", "Message board ID (required if project has multiple)")
 
 	cmd.AddCommand(
@@ -57,26 +59,34 @@ func newMessagesListCmd(project *string, messageBoard *string) *cobra.Command {
 	var all bool
 	var sortField string
 	var reverse bool
+	var filters recordingFilterFlags
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List messages",
-		Long:  "List all messages in a project's message board.",
+		Long: `List all messages in a project's message board.
+
+Each message includes its comments_count and last_comment_at, so stale
+threads can be found without fetching each message. --sort activity puts
+the most recently active threads (last comment, or posting if none) first.
+--created-by and --updated-since keep messages by who posted them and when
+they last changed; with either, the whole board is fetched before --limit.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runMessagesList(cmd, *project, *messageBoard, limit, page, all, sortField, reverse)
+			return runMessagesList(cmd, *project, *messageBoard, limit, page, all, sortField, reverse, filters)
 		},
 	}
 
 	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum number of messages to fetch (0 = default 100)")
 	cmd.Flags().BoolVar(&all, "all", false, "Fetch all messages (no limit)")
 	cmd.Flags().IntVar(&page, "page", 0, "Fetch a single page (use --all for everything)")
-	cmd.Flags().StringVar(&sortField, "sort", "", "Sort by field (title, created, updated)")
+	cmd.Flags().StringVar(&sortField, "sort", "", "Sort by field (title, created, updated, activity)")
 	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse sort order")
+	addRecordingFilterFlags(cmd, &filters)
 
 	return cmd
 }
 
-func runMessagesList(cmd *cobra.Command, project string, messageBoard string, limit, page int, all bool, sortField string, reverse bool) error {
+func runMessagesList(cmd *cobra.Command, project string, messageBoard string, limit, page int, all bool, sortField string, reverse bool, filters recordingFilterFlags) error {
 	app := appctx.FromContext(cmd.Context())
 
 	// Validate flag combinations
@@ -90,7 +100,7 @@ func runMessagesList(cmd *cobra.Command, project string, messageBoard string, li
 		return output.ErrUsage("only --page 1 is supported; use --all to fetch everything")
 	}
 	if sortField != "" {
-		if err := validateSortField(sortField, []string{"title", "created", "updated"}); err != nil {
+		if err := validateSortField(sortField, []string{"title", "created", "updated", "activity"}); err != nil {
 			return err
 		}
 	}
@@ -100,24 +110,13 @@ func runMessagesList(cmd *cobra.Command, project string, messageBoard string, li
 		return err
 	}
 
-	// Resolve project from CLI flags and config, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-
-	// If no project specified, try interactive resolution
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
+	filter, err := filters.resolve(cmd.Context(), app, time.Now())
+	if err != nil {
+		return err
 	}
 
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	// Resolve project from CLI flags and config, with interactive fallback
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -133,9 +132,11 @@ func runMessagesList(cmd *cobra.Command, project string, messageBoard string, li
 		return output.ErrUsage("Invalid message board ID")
 	}
 
-	// Build pagination options
+	// Build pagination options. A creator/update filter fetches the whole
+	// board so matches past the first page aren't missed; --limit then caps
+	// the filtered set.
 	opts := &basecamp.MessageListOptions{}
-	if all {
+	if all || (filter.active() && page == 0) {
 		opts.Limit = -1 // SDK treats -1 as unlimited
 	} else if limit > 0 {
 		opts.Limit = limit
@@ -150,8 +151,27 @@ func runMessagesList(cmd *cobra.Command, project string, messageBoard string, li
 		return convertSDKError(err)
 	}
 	messages := messagesResult.Messages
+	meta := messagesResult.Meta
+	if filter.active() {
+		messages = filterRecordings(filter, messages, messageRecordingFields)
+		meta = basecamp.ListMeta{TotalCount: len(messages)}
+		if limit > 0 && len(messages) > limit {
+			messages = messages[:limit]
+		}
+	}
 
-	if sortField != "" {
+	bucketID, err := strconv.ParseInt(resolvedProjectID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+	lastComments, err := messageLastComments(cmd.Context(), app, bucketID, messages)
+	if err != nil {
+		return err
+	}
+
+	if sortField == "activity" {
+		sortMessagesByActivity(messages, lastComments, reverse)
+	} else if sortField != "" {
 		sortMessages(messages, sortField, reverse)
 	}
 
@@ -161,14 +181,110 @@ func runMessagesList(cmd *cobra.Command, project string, messageBoard string, li
 		output.WithBreadcrumbs(messagesListBreadcrumbs(resolvedProjectID)...),
 	}
 
+	respOpts = append(respOpts, listPagination(meta, page, len(messages)))
+
 	// Add truncation notice if results may be limited
-	if notice := output.TruncationNoticeWithTotal(len(messages), messagesResult.Meta.TotalCount); notice != "" {
+	if notice := listTruncationNotice(len(messages), meta, page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
 	respOpts = append(respOpts, output.WithEntity("message"))
 
-	return app.OK(messages, respOpts...)
+	return app.OK(messageListItems(messages, lastComments), respOpts...)
+}
+
+// messageCommentScanLimit bounds the scan of a project's recent comments
+// that dates most threads' last comment in one paginated request.
+const messageCommentScanLimit = 500
+
+// messageListItem is a message in list output, with its comment activity.
+// CommentsCount shadows the embedded field so zero counts are shown too.
+type messageListItem struct {
+	basecamp.Message
+	CommentsCount int        `json:"comments_count"`
+	LastCommentAt *time.Time `json:"last_comment_at,omitempty"`
+}
+
+func messageListItems(messages []basecamp.Message, lastComments map[int64]time.Time) []messageListItem {
+	items := make([]messageListItem, len(messages))
+	for i, msg := range messages {
+		items[i] = messageListItem{Message: msg, CommentsCount: msg.CommentsCount}
+		if t, ok := lastComments[msg.ID]; ok {
+			items[i].LastCommentAt = &t
+		}
+	}
+	return items
+}
+
+// messageLastComments finds when each commented message was last commented
+// on, keyed by message ID. The project's most recent comments are scanned
+// first; messages whose latest comment is older than the scan reaches have
+// their own comments listed.
+func messageLastComments(ctx context.Context, app *appctx.App, bucketID int64, messages []basecamp.Message) (map[int64]time.Time, error) {
+	pending := make(map[int64]bool)
+	for _, msg := range messages {
+		if msg.CommentsCount > 0 {
+			pending[msg.ID] = true
+		}
+	}
+	last := make(map[int64]time.Time, len(pending))
+	if len(pending) == 0 {
+		return last, nil
+	}
+
+	recent, err := app.Account().Recordings().List(ctx, basecamp.RecordingTypeComment, &basecamp.RecordingsListOptions{
+		Bucket:    []int64{bucketID},
+		Sort:      "created_at",
+		Direction: "desc",
+		Limit:     messageCommentScanLimit,
+	})
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	for _, c := range recent.Recordings {
+		if c.Parent == nil || !pending[c.Parent.ID] {
+			continue
+		}
+		if c.CreatedAt.After(last[c.Parent.ID]) {
+			last[c.Parent.ID] = c.CreatedAt
+		}
+	}
+
+	for _, msg := range messages {
+		if !pending[msg.ID] {
+			continue
+		}
+		if _, ok := last[msg.ID]; ok {
+			continue
+		}
+		comments, err := app.Account().Comments().List(ctx, msg.ID, &basecamp.CommentListOptions{Limit: -1})
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		for _, c := range comments.Comments {
+			if c.CreatedAt.After(last[msg.ID]) {
+				last[msg.ID] = c.CreatedAt
+			}
+		}
+	}
+	return last, nil
+}
+
+// sortMessagesByActivity sorts messages most recently active first: by last
+// comment, or by posting time for messages without comments.
+func sortMessagesByActivity(messages []basecamp.Message, lastComments map[int64]time.Time, reverse bool) {
+	activity := func(m basecamp.Message) time.Time {
+		if t, ok := lastComments[m.ID]; ok && t.After(m.CreatedAt) {
+			return t
+		}
+		return m.CreatedAt
+	}
+	sort.SliceStable(messages, func(i, j int) bool {
+		return activity(messages[i]).After(activity(messages[j]))
+	})
+	if reverse {
+		slices.Reverse(messages)
+	}
 }
 
 func messagesListBreadcrumbs(resolvedProjectID string) []output.Breadcrumb {
@@ -290,14 +406,10 @@ func newMessagesCreateCmd(project *string, messageBoard *string) *cobra.Command
 				return output.ErrUsage("cannot combine --edit and body argument")
 			}
 			if edit {
-				fi, err := os.Stdin.Stat()
-				if err != nil || (fi.Mode()&os.ModeCharDevice) == 0 {
-					return output.ErrUsage("cannot use --edit when stdin is not a terminal")
-				}
-				var editorErr error
-				body, editorErr = editor.Open("")
-				if editorErr != nil {
-					return output.ErrUsage(editorErr.Error())
+				var err error
+				body, err = editNewContent()
+				if err != nil {
+					return err
 				}
 			}
 
@@ -314,21 +426,7 @@ func newMessagesCreateCmd(project *string, messageBoard *string) *cobra.Command
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -417,6 +515,7 @@ func newMessagesCreateCmd(project *string, messageBoard *string) *cobra.Command
 	cmd.Flags().StringVar(&subscribe, "subscribe", "", "Subscribe specific people (comma-separated names, emails, IDs, or \"me\")")
 	cmd.Flags().BoolVar(&noSubscribe, "no-subscribe", false, "Don't subscribe anyone else (silent, no notifications)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
+	addContentForceFlag(cmd)
 
 	return cmd
 }
@@ -424,6 +523,7 @@ func newMessagesCreateCmd(project *string, messageBoard *string) *cobra.Command
 func newMessagesUpdateCmd() *cobra.Command {
 	var title string
 	var body string
+	var edit bool
 
 	cmd := &cobra.Command{
 		Use:   "update <id|url>",
@@ -432,12 +532,23 @@ func newMessagesUpdateCmd() *cobra.Command {
 
 You can pass either a message ID or a Basecamp URL:
   basecamp messages update 789 --title "new title"
-  basecamp messages update 789 --body "new body"`,
+  basecamp messages update 789 --body "new body"
+
+Use --edit to revise the current body as markdown in $EDITOR:
+  basecamp messages update 789 --edit`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			if strings.TrimSpace(title) == "" && strings.TrimSpace(body) == "" {
+			if edit && body != "" {
+				return output.ErrUsage("cannot combine --edit and --body")
+			}
+			if strings.TrimSpace(title) == "" && strings.TrimSpace(body) == "" && !edit {
 				return noChanges(cmd)
 			}
+			if edit {
+				if err := requireEditorTerminal(); err != nil {
+					return err
+				}
+			}
 
 			app := appctx.FromContext(cmd.Context())
 
@@ -453,6 +564,16 @@ You can pass either a message ID or a Basecamp URL:
 				return output.ErrUsage("Invalid message ID")
 			}
 
+			if edit {
+				current, err := app.Account().Messages().Get(cmd.Context(), messageID)
+				if err != nil {
+					return convertSDKError(err)
+				}
+				if body, err = editExistingContent(current.Content, "message"); err != nil {
+					return err
+				}
+			}
+
 			// Build SDK request
 			// Convert Markdown content to HTML for Basecamp's rich text fields
 			html := richtext.MarkdownToHTML(body)
@@ -500,6 +621,8 @@ You can pass either a message ID or a Basecamp URL:
 
 	cmd.Flags().StringVarP(&title, "title", "t", "", "New title")
 	cmd.Flags().StringVarP(&body, "body", "b", "", "New body content")
+	cmd.Flags().BoolVar(&edit, "edit", false, "Open $EDITOR with the current body")
+	addContentForceFlag(cmd)
 
 	return cmd
 }
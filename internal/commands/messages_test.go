@@ -515,3 +515,133 @@ func TestMessagesListAgentModeTruncationSilent(t *testing.T) {
 	assert.Empty(t, stderr.String(),
 		"truncation notices should not appear on stderr in quiet mode")
 }
+
+// mockMessageActivityTransport serves three messages: one whose latest
+// comment is in the project's recent comments, one only reachable through
+// its own comments, and one without comments.
+type mockMessageActivityTransport struct {
+	commentLists []string
+}
+
+func (t *mockMessageActivityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch {
+	case strings.Contains(req.URL.Path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test Project"}]`
+	case strings.Contains(req.URL.Path, "/projects/recordings.json"):
+		body = `[
+			{"id": 91, "type": "Comment", "created_at": "2026-03-01T10:00:00Z", "parent": {"id": 1, "type": "Message"}},
+			{"id": 92, "type": "Comment", "created_at": "2026-02-01T10:00:00Z", "parent": {"id": 1, "type": "Message"}},
+			{"id": 93, "type": "Comment", "created_at": "2026-02-15T10:00:00Z", "parent": {"id": 55, "type": "Todo"}}
+		]`
+	case strings.Contains(req.URL.Path, "/projects/"):
+		body = `{"id": 123, "dock": [{"name": "message_board", "id": 777, "enabled": true}]}`
+	case strings.Contains(req.URL.Path, "/messages.json"):
+		body = `[
+			{"id": 1, "subject": "Busy", "created_at": "2026-01-01T10:00:00Z", "comments_count": 2},
+			{"id": 2, "subject": "Stale", "created_at": "2026-01-02T10:00:00Z", "comments_count": 1},
+			{"id": 3, "subject": "Quiet", "created_at": "2026-01-20T10:00:00Z"}
+		]`
+	case strings.Contains(req.URL.Path, "/recordings/2/comments.json"):
+		t.commentLists = append(t.commentLists, req.URL.Path)
+		body = `[{"id": 81, "created_at": "2026-01-05T10:00:00Z", "content": "old"}]`
+	default:
+		body = `[]`
+	}
+
+	return &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     header,
+	}, nil
+}
+
+func TestMessagesListCommentActivity(t *testing.T) {
+	transport := &mockMessageActivityTransport{}
+	app, buf := setupMessagesMockApp(t, transport)
+
+	err := executeMessagesCommand(NewMessagesCmd(), app, "list", "--in", "123", "--sort", "activity")
+	require.NoError(t, err)
+
+	var resp struct {
+		Data []map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 3)
+
+	// Busy (comment Mar 1), Quiet (posted Jan 20), Stale (comment Jan 5)
+	assert.Equal(t, "Busy", resp.Data[0]["subject"])
+	assert.Equal(t, "Quiet", resp.Data[1]["subject"])
+	assert.Equal(t, "Stale", resp.Data[2]["subject"])
+
+	assert.Equal(t, float64(2), resp.Data[0]["comments_count"])
+	assert.Equal(t, "2026-03-01T10:00:00Z", resp.Data[0]["last_comment_at"])
+	assert.Equal(t, float64(0), resp.Data[1]["comments_count"])
+	assert.NotContains(t, resp.Data[1], "last_comment_at")
+	assert.Equal(t, "2026-01-05T10:00:00Z", resp.Data[2]["last_comment_at"])
+
+	assert.Len(t, transport.commentLists, 1, "only the message missed by the scan lists its comments")
+}
+
+// mockMessageAuthorsTransport serves three messages from two authors,
+// updated at different times.
+type mockMessageAuthorsTransport struct{}
+
+func (mockMessageAuthorsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch path := req.URL.Path; {
+	case strings.Contains(path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test Project"}]`
+	case strings.Contains(path, "/projects/"):
+		body = `{"id": 123, "dock": [{"name": "message_board", "id": 777, "enabled": true}]}`
+	case strings.Contains(path, "/messages.json"):
+		body = `[{"id": 1, "subject": "Kickoff", "creator": {"id": 42}, "updated_at": "2025-09-01T10:00:00Z"},` +
+			`{"id": 2, "subject": "Retro", "creator": {"id": 7}, "updated_at": "2025-09-02T10:00:00Z"},` +
+			`{"id": 3, "subject": "Old news", "creator": {"id": 42}, "updated_at": "2025-01-01T10:00:00Z"}]`
+	default:
+		body = `[]`
+	}
+
+	return &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     header,
+	}, nil
+}
+
+func TestMessagesListCreatedByAndUpdatedSince(t *testing.T) {
+	tests := []struct {
+		args []string
+		ids  []int64
+	}{
+		{[]string{"--created-by", "42"}, []int64{1, 3}},
+		{[]string{"--updated-since", "2025-06-01"}, []int64{1, 2}},
+		{[]string{"--updated-since", "2025-06-01", "--limit", "1"}, []int64{1}},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			app, buf := setupMessagesMockApp(t, mockMessageAuthorsTransport{})
+
+			err := executeMessagesCommand(NewMessagesCmd(), app, append([]string{"list", "--in", "123"}, tt.args...)...)
+			require.NoError(t, err)
+
+			var resp struct {
+				Data []struct {
+					ID int64 `json:"id"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+			var ids []int64
+			for _, msg := range resp.Data {
+				ids = append(ids, msg.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+}
@@ -261,6 +261,10 @@ func newMessagetypesDeleteCmd() *cobra.Command {
 				return output.ErrUsage("Invalid message type ID")
 			}
 
+			if !confirmAction(cmd, confirmPermanent, fmt.Sprintf("Delete message type #%d?", typeID), false) {
+				return nil
+			}
+
 			err = app.Account().MessageTypes().Delete(cmd.Context(), typeID)
 			if err != nil {
 				return convertSDKError(err)
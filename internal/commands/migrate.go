@@ -55,6 +55,7 @@ Safe to run multiple times — skips already-migrated data.`,
 	}
 
 	cmd.Flags().BoolVar(&force, "force", false, "Re-run migration even if already completed")
+	cmd.AddCommand(newMigrateAliasCmd())
 
 	return cmd
 }
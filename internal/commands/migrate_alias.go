@@ -0,0 +1,136 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// legacyBinaryName is the command name scripts written for bcq still call.
+const legacyBinaryName = "bcq"
+
+// MigrateAliasResult holds the outcome of installing the bcq alias.
+type MigrateAliasResult struct {
+	Path   string `json:"path"`
+	Target string `json:"target"`
+	Status string `json:"status"` // "installed", "replaced", or "already_installed"
+	OnPath bool   `json:"on_path"`
+}
+
+func newMigrateAliasCmd() *cobra.Command {
+	var dir string
+	var force bool
+
+	cmd := &cobra.Command{
+		Use:   "alias",
+		Short: "Install a bcq command that runs basecamp",
+		Long: `Install "bcq" as a symlink to this basecamp binary, so scripts written
+for bcq keep working.
+
+When run as bcq, suggested commands in output (breadcrumbs, hints, usage)
+are printed with bcq, so they run as printed either way.
+
+The link goes next to this binary unless --dir says otherwise.`,
+		Example: "  basecamp migrate alias\n  basecamp migrate alias --dir ~/.local/bin",
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runMigrateAlias(cmd, dir, force)
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", "", "Directory to install bcq into (default: next to this binary)")
+	cmd.Flags().BoolVar(&force, "force", false, "Replace an existing bcq (e.g. a legacy install)")
+
+	return cmd
+}
+
+func runMigrateAlias(cmd *cobra.Command, dir string, force bool) error {
+	target, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("could not locate the basecamp binary: %w", err)
+	}
+	if resolved, err := filepath.EvalSymlinks(target); err == nil {
+		target = resolved
+	}
+	if dir == "" {
+		dir = filepath.Dir(target)
+	}
+
+	name := legacyBinaryName
+	if runtime.GOOS == "windows" {
+		name += ".exe"
+	}
+	link := filepath.Join(dir, name)
+
+	result := &MigrateAliasResult{Path: link, Target: target, Status: "installed", OnPath: dirOnPath(dir)}
+
+	if existing, err := os.Readlink(link); err == nil && sameFile(existing, target) {
+		result.Status = "already_installed"
+	} else if _, err := os.Lstat(link); err == nil {
+		if !force {
+			return output.ErrUsageHint(
+				fmt.Sprintf("%s already exists", link),
+				"Replace it: basecamp migrate alias --force",
+			)
+		}
+		if err := os.Remove(link); err != nil {
+			return fmt.Errorf("could not replace %s: %w", link, err)
+		}
+		result.Status = "replaced"
+	}
+
+	if result.Status != "already_installed" {
+		if err := os.Symlink(target, link); err != nil {
+			return output.ErrUsageHint(
+				fmt.Sprintf("could not create %s: %v", link, err),
+				fmt.Sprintf("Create it by hand: ln -s %s %s", target, link),
+			)
+		}
+	}
+
+	summary := fmt.Sprintf("Installed %s → %s", link, target)
+	switch result.Status {
+	case "replaced":
+		summary = fmt.Sprintf("Replaced %s with a link to %s", link, target)
+	case "already_installed":
+		summary = fmt.Sprintf("%s already links to %s", link, target)
+	}
+
+	opts := []output.ResponseOption{
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(output.Breadcrumb{
+			Action: "verify", Cmd: "bcq --version", Description: "Check the alias runs",
+		}),
+	}
+	if !result.OnPath {
+		opts = append(opts, output.WithNotice(fmt.Sprintf("%s is not on your PATH; add it to run bcq by name", dir)))
+	}
+	if app := getApp(cmd); app != nil {
+		return app.OK(result, opts...)
+	}
+	fmt.Fprintln(cmd.OutOrStdout(), summary)
+	return nil
+}
+
+// sameFile reports whether a symlink target names the given file.
+func sameFile(linkTarget, file string) bool {
+	if resolved, err := filepath.EvalSymlinks(linkTarget); err == nil {
+		linkTarget = resolved
+	}
+	return filepath.Clean(linkTarget) == filepath.Clean(file)
+}
+
+func dirOnPath(dir string) bool {
+	dir = filepath.Clean(dir)
+	for _, p := range filepath.SplitList(os.Getenv("PATH")) {
+		if p != "" && filepath.Clean(p) == dir {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,69 @@
+package commands
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+func runMigrateAliasCmd(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	cmd := newMigrateAliasCmd()
+	var buf bytes.Buffer
+	cmd.SetOut(&buf)
+	cmd.SetErr(&buf)
+	cmd.SetArgs(args)
+	err := cmd.Execute()
+	return buf.String(), err
+}
+
+func TestMigrateAliasInstallsSymlink(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks need extra privileges on Windows")
+	}
+	dir := t.TempDir()
+	exe, err := os.Executable()
+	require.NoError(t, err)
+	exe, _ = filepath.EvalSymlinks(exe)
+
+	out, err := runMigrateAliasCmd(t, "--dir", dir)
+	require.NoError(t, err)
+	assert.Contains(t, out, "Installed")
+
+	target, err := os.Readlink(filepath.Join(dir, "bcq"))
+	require.NoError(t, err)
+	assert.Equal(t, exe, target)
+
+	out, err = runMigrateAliasCmd(t, "--dir", dir)
+	require.NoError(t, err)
+	assert.Contains(t, out, "already links to")
+}
+
+func TestMigrateAliasKeepsExistingFileWithoutForce(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("symlinks need extra privileges on Windows")
+	}
+	dir := t.TempDir()
+	legacy := filepath.Join(dir, "bcq")
+	require.NoError(t, os.WriteFile(legacy, []byte("#!/bin/sh\n"), 0o755))
+
+	_, err := runMigrateAliasCmd(t, "--dir", dir)
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Contains(t, e.Hint, "--force")
+
+	out, err := runMigrateAliasCmd(t, "--dir", dir, "--force")
+	require.NoError(t, err)
+	assert.Contains(t, out, "Replaced")
+	info, err := os.Lstat(legacy)
+	require.NoError(t, err)
+	assert.NotZero(t, info.Mode()&os.ModeSymlink)
+}
@@ -0,0 +1,331 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/outbox"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// outboxResult is the outcome of replaying one queued command.
+type outboxResult struct {
+	ID      int64  `json:"id"`
+	Command string `json:"command"`
+	Sent    bool   `json:"sent"`
+	Message string `json:"message,omitempty"`
+}
+
+// runOutboxEntry replays a queued command and returns its exit code and
+// what it reported (the summary, or the error). Tests replace it.
+var runOutboxEntry = replayOutboxEntry
+
+// NewOutboxCmd creates the outbox command for retrying queued commands.
+func NewOutboxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "outbox",
+		Short: "Retry commands queued while offline",
+		Long: `Commands run with --queue-on-failure that fail on a network error before
+sending any change are saved to a local outbox instead of lost.
+flush runs them again, oldest first, under the account and profile they
+were queued with.
+
+  basecamp todos create "Draft agenda" --in Launch --queue-on-failure
+  basecamp outbox list
+  basecamp outbox flush
+
+Flush stops at the first command that fails so later ones keep their order;
+drop a command that can't succeed with 'basecamp outbox drop <id>'. Only one
+flush runs at a time, and drop waits its turn: both fail while another
+process is flushing.
+
+Nothing is queued once Basecamp has answered a change the command sent, so
+a replay doesn't repeat it; a change whose answer was lost in transit may
+still have been made. Only commands that change data are queued, and not
+those that read input from stdin (a "-" argument or flag value, or piped
+content) or an editor, as the input can't be replayed.`,
+		Annotations: map[string]string{"agent_notes": "Queue with the global --queue-on-failure flag; the error hint names the outbox ID\nflush exits 0 even when it stops early — check stopped and remaining in the result"},
+	}
+
+	cmd.AddCommand(
+		newOutboxListCmd(),
+		newOutboxFlushCmd(),
+		newOutboxDropCmd(),
+	)
+
+	return cmd
+}
+
+func newOutboxListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "List queued commands",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			store, err := outboxStore(app)
+			if err != nil {
+				return err
+			}
+
+			entries := store.List()
+			if entries == nil {
+				entries = []outbox.Entry{}
+			}
+			respOpts := []output.ResponseOption{
+				output.WithSummary(fmt.Sprintf("%d queued %s", len(entries), pluralize(len(entries), "command", "commands"))),
+			}
+			if len(entries) > 0 {
+				respOpts = append(respOpts, output.WithBreadcrumbs(output.Breadcrumb{
+					Action:      "flush",
+					Cmd:         "basecamp outbox flush",
+					Description: "Retry queued commands",
+				}))
+			}
+			return app.OK(entries, respOpts...)
+		},
+	}
+}
+
+func newOutboxFlushCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "flush",
+		Short: "Retry queued commands, oldest first",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			store, err := outboxStore(app)
+			if err != nil {
+				return err
+			}
+			return runOutboxFlush(cmd, app, store)
+		},
+	}
+}
+
+func runOutboxFlush(cmd *cobra.Command, app *appctx.App, store *outbox.Store) error {
+	// The flush lock is held until every replay is sent and removed, so a
+	// second flush can't pick up an entry this one is still running.
+	unlock, err := lockOutboxFlush(store)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	entries := store.List()
+	results := make([]outboxResult, 0, len(entries))
+	sent, skipped := 0, 0
+	var stopped *outbox.Entry
+
+	for i := range entries {
+		entry, ok := store.Get(entries[i].ID)
+		if !ok || !entry.QueuedAt.Equal(entries[i].QueuedAt) {
+			skipped++
+			continue
+		}
+		code, message, err := runOutboxEntry(cmd.Context(), entry)
+		result := outboxResult{ID: entry.ID, Command: outboxCommandLine(entry), Message: message}
+
+		if err == nil && code == 0 {
+			result.Sent = true
+			results = append(results, result)
+			sent++
+			if _, err := store.Remove(entry.ID); err != nil {
+				return fmt.Errorf("command #%d was sent but could not be removed from the outbox: %w", entry.ID, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			result.Message = err.Error()
+		}
+		entry.Attempts++
+		entry.Error = result.Message
+		if _, err := store.Update(entry); err != nil {
+			return fmt.Errorf("failed to update outbox: %w", err)
+		}
+		results = append(results, result)
+		stopped = &entry
+		break
+	}
+
+	remaining := len(entries) - sent - skipped
+	summary := fmt.Sprintf("Sent %d queued %s", sent, pluralize(sent, "command", "commands"))
+	if remaining > 0 {
+		summary += fmt.Sprintf("; %d still queued", remaining)
+	}
+	respOpts := []output.ResponseOption{output.WithSummary(summary)}
+	if stopped != nil {
+		respOpts = append(respOpts,
+			output.WithNotice(fmt.Sprintf("Stopped at #%d: %s", stopped.ID, stopped.Error)),
+			output.WithBreadcrumbs(
+				output.Breadcrumb{
+					Action:      "retry",
+					Cmd:         "basecamp outbox flush",
+					Description: "Retry again",
+				},
+				output.Breadcrumb{
+					Action:      "drop",
+					Cmd:         fmt.Sprintf("basecamp outbox drop %d", stopped.ID),
+					Description: "Give up on the failing command",
+				},
+			),
+		)
+	}
+
+	return app.OK(map[string]any{
+		"sent":      sent,
+		"remaining": remaining,
+		"stopped":   stopped != nil,
+		"results":   results,
+	}, respOpts...)
+}
+
+func newOutboxDropCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "drop <id>",
+		Short: "Remove a queued command without running it",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			id, err := strconv.ParseInt(args[0], 10, 64)
+			if err != nil {
+				return output.ErrUsage("Invalid outbox ID: " + args[0])
+			}
+			store, err := outboxStore(app)
+			if err != nil {
+				return err
+			}
+			unlock, err := lockOutboxFlush(store)
+			if err != nil {
+				return err
+			}
+			defer unlock()
+			found, err := store.Remove(id)
+			if err != nil {
+				return fmt.Errorf("failed to update outbox: %w", err)
+			}
+			if !found {
+				return output.ErrNotFoundHint("Queued command", args[0], "See queued commands with: basecamp outbox list")
+			}
+			return app.OK(map[string]any{"id": id, "dropped": true},
+				output.WithSummary(fmt.Sprintf("Dropped queued command #%d", id)),
+			)
+		},
+	}
+}
+
+// outboxStore returns the outbox for the current profile.
+func outboxStore(app *appctx.App) (*outbox.Store, error) {
+	if app == nil {
+		return nil, fmt.Errorf("app not initialized")
+	}
+	if app.Config.CacheDir == "" {
+		return nil, output.ErrUsage("No cache directory configured for the outbox (set --cache-dir or BASECAMP_CACHE_DIR)")
+	}
+	return outbox.NewStore(filepath.Join(app.Config.CacheDir, outbox.DirName)), nil
+}
+
+// lockOutboxFlush takes the outbox's flush lock, turning a held lock into
+// an error that says what to do.
+func lockOutboxFlush(store *outbox.Store) (func(), error) {
+	unlock, err := store.LockFlush()
+	if errors.Is(err, outbox.ErrFlushing) {
+		return nil, output.ErrUsageHint("Another basecamp process is flushing the outbox",
+			"Wait for it to finish, then check with: basecamp outbox list")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock outbox: %w", err)
+	}
+	return unlock, nil
+}
+
+// outboxCommandLine renders an entry as the command it replays.
+func outboxCommandLine(e outbox.Entry) string {
+	return strings.Join(append([]string{"basecamp"}, e.Args...), " ")
+}
+
+// outboxReplayArgs is the entry's command line, pinned to the account it
+// was queued under unless it names one itself.
+func outboxReplayArgs(e outbox.Entry) []string {
+	args := slices.Clone(e.Args)
+	end := slices.Index(args, "--")
+	if end < 0 {
+		end = len(args)
+	}
+	if e.Account == "" || slices.ContainsFunc(args[:end], isAccountFlag) {
+		return args
+	}
+	return slices.Insert(args, end, "--account", e.Account)
+}
+
+func isAccountFlag(arg string) bool {
+	return arg == "--account" || arg == "-a" || strings.HasPrefix(arg, "--account=")
+}
+
+// replayOutboxEntry runs the entry's command line with this binary, from the
+// directory it was queued in, without prompts.
+func replayOutboxEntry(ctx context.Context, e outbox.Entry) (int, string, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return 0, "", err
+	}
+	child := exec.CommandContext(ctx, self, outboxReplayArgs(e)...) //nolint:gosec // G204: replays a command the user queued
+	if e.Dir != "" {
+		if info, err := os.Stat(e.Dir); err == nil && info.IsDir() {
+			child.Dir = e.Dir
+		}
+	}
+	child.Env = append(os.Environ(), "BASECAMP_NONINTERACTIVE=1")
+	if e.Profile != "" {
+		child.Env = append(child.Env, "BASECAMP_PROFILE="+e.Profile)
+	}
+	var out bytes.Buffer
+	child.Stdout = &out
+	child.Stderr = &out
+
+	err = child.Run()
+	message := replayMessage(out.Bytes())
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		if message == "" {
+			message = fmt.Sprintf("exited with status %d", exitErr.ExitCode())
+		}
+		return exitErr.ExitCode(), message, nil
+	}
+	return 0, message, err
+}
+
+// replayMessage pulls the summary or error out of a replayed command's
+// output: the JSON envelope when it printed one, else its last line.
+func replayMessage(out []byte) string {
+	var envelope struct {
+		Summary string `json:"summary"`
+		Error   string `json:"error"`
+		Hint    string `json:"hint"`
+	}
+	if json.Unmarshal(out, &envelope) == nil {
+		switch {
+		case envelope.Error != "" && envelope.Hint != "":
+			return envelope.Error + ": " + envelope.Hint
+		case envelope.Error != "":
+			return envelope.Error
+		case envelope.Summary != "":
+			return envelope.Summary
+		}
+	}
+	lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+	return strings.TrimSpace(lines[len(lines)-1])
+}
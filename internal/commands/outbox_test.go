@@ -0,0 +1,175 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/outbox"
+)
+
+// stubOutboxRunner replaces the replay of queued commands with fixed exit
+// codes by outbox ID, recording the order they ran in.
+func stubOutboxRunner(t *testing.T, codes map[int64]int) *[]int64 {
+	t.Helper()
+	var ran []int64
+	orig := runOutboxEntry
+	runOutboxEntry = func(_ context.Context, e outbox.Entry) (int, string, error) {
+		ran = append(ran, e.ID)
+		if code := codes[e.ID]; code != 0 {
+			return code, "Network error: dial tcp: connection refused", nil
+		}
+		return 0, "Created todo", nil
+	}
+	t.Cleanup(func() { runOutboxEntry = orig })
+	return &ran
+}
+
+func queueOutboxEntries(t *testing.T, dir string, n int) *outbox.Store {
+	t.Helper()
+	store := outbox.NewStore(filepath.Join(dir, outbox.DirName))
+	for range n {
+		_, err := store.Add(outbox.Entry{Args: []string{"todos", "create", "Draft"}, Account: "99999", Attempts: 1})
+		require.NoError(t, err)
+	}
+	return store
+}
+
+func TestOutboxFlushStopsAtFirstFailure(t *testing.T) {
+	app, buf := setupTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+	store := queueOutboxEntries(t, app.Config.CacheDir, 3)
+	ran := stubOutboxRunner(t, map[int64]int{2: 6})
+
+	require.NoError(t, executeCommand(NewOutboxCmd(), app, "flush"))
+
+	assert.Equal(t, []int64{1, 2}, *ran, "flush stops so later commands keep their order")
+	var resp struct {
+		Summary string `json:"summary"`
+		Notice  string `json:"notice"`
+		Data    struct {
+			Sent      int            `json:"sent"`
+			Remaining int            `json:"remaining"`
+			Stopped   bool           `json:"stopped"`
+			Results   []outboxResult `json:"results"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "Sent 1 queued command; 2 still queued", resp.Summary)
+	assert.Contains(t, resp.Notice, "Stopped at #2: Network error")
+	assert.True(t, resp.Data.Stopped)
+	require.Len(t, resp.Data.Results, 2)
+	assert.True(t, resp.Data.Results[0].Sent)
+	assert.Equal(t, "basecamp todos create Draft", resp.Data.Results[0].Command)
+	assert.False(t, resp.Data.Results[1].Sent)
+
+	entries := store.List()
+	require.Len(t, entries, 2)
+	assert.Equal(t, int64(2), entries[0].ID)
+	assert.Equal(t, 2, entries[0].Attempts)
+	assert.Contains(t, entries[0].Error, "Network error")
+	assert.Equal(t, int64(3), entries[1].ID)
+}
+
+func TestOutboxFlushSendsAll(t *testing.T) {
+	app, buf := setupTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+	store := queueOutboxEntries(t, app.Config.CacheDir, 2)
+	stubOutboxRunner(t, nil)
+
+	require.NoError(t, executeCommand(NewOutboxCmd(), app, "flush"))
+
+	assert.Empty(t, store.List())
+	var resp struct {
+		Summary string `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "Sent 2 queued commands", resp.Summary)
+}
+
+func TestOutboxFlushRefusesWhileAnotherFlushRuns(t *testing.T) {
+	app, _ := setupTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+	store := queueOutboxEntries(t, app.Config.CacheDir, 1)
+	ran := stubOutboxRunner(t, nil)
+
+	unlock, err := outbox.NewStore(filepath.Join(app.Config.CacheDir, outbox.DirName)).LockFlush()
+	require.NoError(t, err)
+	defer unlock()
+
+	err = executeCommand(NewOutboxCmd(), app, "flush")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Another basecamp process is flushing the outbox")
+	err = executeCommand(NewOutboxCmd(), app, "drop", "1")
+	require.Error(t, err)
+	assert.Empty(t, *ran)
+	assert.Len(t, store.List(), 1)
+}
+
+func TestOutboxFlushSkipsEntriesGoneSinceItStarted(t *testing.T) {
+	app, buf := setupTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+	store := queueOutboxEntries(t, app.Config.CacheDir, 2)
+	var ran []int64
+	orig := runOutboxEntry
+	runOutboxEntry = func(_ context.Context, e outbox.Entry) (int, string, error) {
+		ran = append(ran, e.ID)
+		// Entry 2 leaves the queue while entry 1 replays.
+		_, err := store.Remove(2)
+		require.NoError(t, err)
+		return 0, "Created todo", nil
+	}
+	t.Cleanup(func() { runOutboxEntry = orig })
+
+	require.NoError(t, executeCommand(NewOutboxCmd(), app, "flush"))
+
+	assert.Equal(t, []int64{1}, ran)
+	var resp struct {
+		Summary string `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "Sent 1 queued command", resp.Summary)
+}
+
+func TestOutboxListAndDrop(t *testing.T) {
+	app, buf := setupTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+	store := queueOutboxEntries(t, app.Config.CacheDir, 2)
+
+	require.NoError(t, executeCommand(NewOutboxCmd(), app, "list"))
+	var resp struct {
+		Summary string         `json:"summary"`
+		Data    []outbox.Entry `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "2 queued commands", resp.Summary)
+	require.Len(t, resp.Data, 2)
+
+	require.NoError(t, executeCommand(NewOutboxCmd(), app, "drop", "1"))
+	require.Len(t, store.List(), 1)
+
+	err := executeCommand(NewOutboxCmd(), app, "drop", "1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "not found")
+}
+
+func TestOutboxReplayArgsPinsAccount(t *testing.T) {
+	e := outbox.Entry{Args: []string{"chat", "post", "hi", "--in", "Launch"}, Account: "99999"}
+	assert.Equal(t, []string{"chat", "post", "hi", "--in", "Launch", "--account", "99999"}, outboxReplayArgs(e))
+
+	e.Args = []string{"api", "post", "/x.json", "--", "-a"}
+	assert.Equal(t, []string{"api", "post", "/x.json", "--account", "99999", "--", "-a"}, outboxReplayArgs(e))
+
+	e.Args = []string{"todos", "create", "A", "-a", "123"}
+	assert.Equal(t, e.Args, outboxReplayArgs(e), "an explicit account wins")
+}
+
+func TestReplayMessage(t *testing.T) {
+	assert.Equal(t, "Created todo #5", replayMessage([]byte(`{"ok":true,"summary":"Created todo #5"}`)))
+	assert.Equal(t, "Network error: dial tcp", replayMessage([]byte(`{"ok":false,"error":"Network error","hint":"dial tcp"}`)))
+	assert.Equal(t, "last line", replayMessage([]byte("first line\nlast line\n")))
+}
@@ -0,0 +1,37 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// listPagination reports how complete a paginated list is in the response
+// meta (see output.WithPagination). page is the --page value and fetched
+// counts what the API returned, before any client-side filtering.
+func listPagination(meta basecamp.ListMeta, page, fetched int) output.ResponseOption {
+	return output.WithPagination(output.Pagination{
+		TotalCount: meta.TotalCount,
+		Page:       page,
+		Fetched:    fetched,
+		Truncated:  meta.Truncated,
+	})
+}
+
+// listTruncationNotice tells a reader the list is incomplete: with the
+// total when the API sent one, otherwise whenever the SDK saw more pages.
+// A page past the first is partial by request, not truncated.
+func listTruncationNotice(count int, meta basecamp.ListMeta, page int) string {
+	if page > 1 {
+		return ""
+	}
+	if notice := output.TruncationNoticeWithTotal(count, meta.TotalCount); notice != "" {
+		return notice
+	}
+	if meta.Truncated && meta.TotalCount == 0 {
+		return fmt.Sprintf("Showing %d results (use --all for complete list)", count)
+	}
+	return ""
+}
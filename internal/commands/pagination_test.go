@@ -0,0 +1,30 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+)
+
+func TestListTruncationNotice(t *testing.T) {
+	tests := []struct {
+		name  string
+		count int
+		meta  basecamp.ListMeta
+		page  int
+		want  string
+	}{
+		{"complete", 5, basecamp.ListMeta{TotalCount: 5}, 0, ""},
+		{"short of total", 5, basecamp.ListMeta{TotalCount: 12}, 0, "Showing 5 of 12 results (use --all for complete list)"},
+		{"more pages without total", 5, basecamp.ListMeta{Truncated: true}, 0, "Showing 5 results (use --all for complete list)"},
+		{"explicit page", 5, basecamp.ListMeta{TotalCount: 12, Truncated: true}, 2, ""},
+		{"nothing known", 5, basecamp.ListMeta{}, 0, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, listTruncationNotice(tt.count, tt.meta, tt.page))
+		})
+	}
+}
@@ -152,6 +152,11 @@ func NewPeopleCmd() *cobra.Command {
 	cmd.AddCommand(newPeoplePingableCmd())
 	cmd.AddCommand(newPeopleAddCmd())
 	cmd.AddCommand(newPeopleRemoveCmd())
+	cmd.AddCommand(newPeopleInviteCmd())
+	cmd.AddCommand(newPeopleInvitesCmd())
+	cmd.AddCommand(newPeopleSyncCmd())
+	cmd.AddCommand(newPeopleExportCmd())
+	cmd.AddCommand(newPeopleActivityCmd())
 
 	return cmd
 }
@@ -292,10 +297,15 @@ func runPeopleList(cmd *cobra.Command, projectID string, limit, page int, all bo
 	respOpts := []output.ResponseOption{
 		output.WithSummary(summary),
 		output.WithBreadcrumbs(breadcrumbs...),
+		listPagination(peopleResult.Meta, page, len(people)),
 	}
 
 	// Add truncation notice if results may be limited
-	if notice := output.TruncationNotice(len(items), 0, all, limit); notice != "" {
+	notice := listTruncationNotice(len(items), peopleResult.Meta, page)
+	if notice == "" {
+		notice = output.TruncationNotice(len(items), 0, all, limit)
+	}
+	if notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
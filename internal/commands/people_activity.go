@@ -0,0 +1,452 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/dateparse"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// peopleActivityConcurrency bounds parallel project timeline fetches.
+const peopleActivityConcurrency = 5
+
+// Activity categories reported by people activity.
+const (
+	activityCompletion = "completion"
+	activityComment    = "comment"
+	activityMessage    = "message"
+)
+
+// peopleActivityEvent is one event in the activity readout.
+type peopleActivityEvent struct {
+	ID          int64     `json:"id"`
+	Category    string    `json:"category"`
+	Kind        string    `json:"kind"`
+	Title       string    `json:"title"`
+	CreatedAt   time.Time `json:"created_at"`
+	ProjectID   int64     `json:"project_id"`
+	ProjectName string    `json:"project_name"`
+	AppURL      string    `json:"app_url,omitempty"`
+}
+
+// peopleActivityProject is a per-project rollup.
+type peopleActivityProject struct {
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Completions int    `json:"completions"`
+	Comments    int    `json:"comments"`
+	Messages    int    `json:"messages"`
+}
+
+// peopleActivityResult is the full activity readout for one person.
+type peopleActivityResult struct {
+	Person      peopleActivityPerson    `json:"person"`
+	Since       time.Time               `json:"since"`
+	Completions int                     `json:"completions"`
+	Comments    int                     `json:"comments"`
+	Messages    int                     `json:"messages"`
+	Projects    []peopleActivityProject `json:"projects"`
+	Events      []peopleActivityEvent   `json:"events"`
+}
+
+type peopleActivityPerson struct {
+	ID   int64  `json:"id"`
+	Name string `json:"name"`
+}
+
+func newPeopleActivityCmd() *cobra.Command {
+	var since string
+	var project string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "activity <id|name|me>",
+		Short: "Summarize a person's recent activity across projects",
+		Long: `Summarize a person's recent completions, comments, and messages across
+projects — handy for check-in prep.
+
+Project timelines are fetched in parallel and filtered to the person's own
+activity since the given cutoff. Each timeline is read newest first, up to
+--limit events (100 by default); when that cap is hit before the cutoff, the
+project is named in a notice and meta.truncated is set, since older activity
+in the window isn't counted. Ctrl-C returns the projects read so far, marked
+interrupted.
+
+--since accepts a relative window (24h, 3d, 1w, 2m) or a date
+(2026-01-15, yesterday).`,
+		Example: `  basecamp people activity me
+  basecamp people activity "Jane Doe" --since 2w
+  basecamp people activity 12345 --since 2026-01-15 --in "Marketing"`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPeopleActivity(cmd, args[0], since, project, limit)
+		},
+	}
+
+	cmd.Flags().StringVar(&since, "since", "1w", "Only include activity since this window or date")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Limit to a single project (name, ID, or URL)")
+	cmd.Flags().StringVar(&project, "in", "", "Limit to a single project (alias for --project)")
+	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum timeline events to scan per project (0 = default 100)")
+
+	completer := completion.NewCompleter(nil)
+	_ = cmd.RegisterFlagCompletionFunc("project", completer.ProjectNameCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
+
+	return cmd
+}
+
+func runPeopleActivity(cmd *cobra.Command, personArg, sinceArg, project string, limit int) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if limit < 0 {
+		return output.ErrUsage("--limit must be 0 or greater")
+	}
+	since, err := parseActivitySince(sinceArg, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	personIDStr, personName, err := app.Names.ResolvePerson(cmd.Context(), personArg)
+	if err != nil {
+		return err
+	}
+	personID, err := strconv.ParseInt(personIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid person ID")
+	}
+
+	if project == "" {
+		project = app.Flags.Project
+	}
+
+	var projects []basecamp.Project
+	if project != "" {
+		resolvedID, projectName, resolveErr := app.Names.ResolveProject(cmd.Context(), project)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		projectID, parseErr := strconv.ParseInt(resolvedID, 10, 64)
+		if parseErr != nil {
+			return output.ErrUsage("Invalid project ID")
+		}
+		projects = []basecamp.Project{{ID: projectID, Name: projectName}}
+	} else {
+		listed, listErr := app.Account().Projects().List(cmd.Context(), nil)
+		if listErr != nil {
+			return convertSDKError(listErr)
+		}
+		projects = listed.Projects
+	}
+
+	opts := &basecamp.TimelineListOptions{Limit: limit}
+	scan := fetchPeopleActivity(cmd.Context(), app, projects, personID, since, opts)
+
+	result := buildPeopleActivity(peopleActivityPerson{ID: personID, Name: personName}, since, scan.events)
+
+	displayName := personName
+	if displayName == "" {
+		displayName = "person #" + personIDStr
+	}
+	respOpts := []output.ResponseOption{
+		output.WithSummary(fmt.Sprintf("%s since %s: %d completions, %d comments, %d messages across %d projects",
+			displayName, since.Local().Format("Jan 2"), result.Completions, result.Comments, result.Messages, len(result.Projects))),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderPeopleActivityStyled(w, r, result) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "timeline",
+				Cmd:         fmt.Sprintf("basecamp timeline --person %s", personIDStr),
+				Description: "View full activity timeline",
+			},
+			output.Breadcrumb{
+				Action:      "assigned",
+				Cmd:         fmt.Sprintf("basecamp reports assigned %s", personIDStr),
+				Description: "View assigned todos",
+			},
+		),
+	}
+	if notice := scan.notice(); notice != "" {
+		respOpts = append(respOpts, output.WithNotice(notice))
+	}
+	if len(scan.capped) > 0 {
+		respOpts = append(respOpts, output.WithMeta("truncated", true))
+	}
+	if scan.interrupted {
+		respOpts = append(respOpts, output.WithInterrupted())
+	}
+
+	return app.OK(result, respOpts...)
+}
+
+// peopleActivityScan is what fetchPeopleActivity read: the person's events
+// plus the projects it couldn't read in full.
+type peopleActivityScan struct {
+	events      []peopleActivityEvent
+	failed      []string // projects whose timeline failed to load
+	capped      []string // projects whose timeline hit the limit before since
+	interrupted bool     // Ctrl-C stopped the fan-out
+}
+
+// notice describes the projects skipped or cut short, or is "".
+func (s peopleActivityScan) notice() string {
+	var parts []string
+	if len(s.failed) > 0 {
+		parts = append(parts, fmt.Sprintf("Skipped %d projects that could not be read: %s", len(s.failed), strings.Join(s.failed, ", ")))
+	}
+	if len(s.capped) > 0 {
+		parts = append(parts, fmt.Sprintf("Older activity not scanned in %d projects (timeline limit reached; raise --limit): %s", len(s.capped), strings.Join(s.capped, ", ")))
+	}
+	return strings.Join(parts, "; ")
+}
+
+// fetchPeopleActivity fans out over project timelines and keeps the person's
+// own categorized events at or after since. Projects that fail to load are
+// returned by name rather than failing the whole readout, as are projects
+// whose timeline hit the limit while still inside the window. Ctrl-C stops
+// the fan-out: the timelines read so far are returned with interrupted set.
+func fetchPeopleActivity(ctx context.Context, app *appctx.App, projects []basecamp.Project, personID int64, since time.Time, opts *basecamp.TimelineListOptions) peopleActivityScan {
+	limit := opts.Limit
+	if limit == 0 {
+		limit = basecamp.DefaultTimelineLimit
+	}
+	perProject := make([][]peopleActivityEvent, len(projects))
+	capped := make([]bool, len(projects))
+	errs := make([]error, len(projects))
+	sem := make(chan struct{}, peopleActivityConcurrency)
+	var wg sync.WaitGroup
+
+	for i, p := range projects {
+		wg.Add(1)
+		go func(i int, p basecamp.Project) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result, err := app.Account().Timeline().ProjectTimeline(ctx, p.ID, opts)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			// Timelines come newest first, so a full page whose oldest event
+			// is still in the window may have more activity behind it.
+			if n := len(result.Events); limit > 0 && n >= limit && !result.Events[n-1].CreatedAt.Before(since) {
+				capped[i] = true
+			}
+			for _, e := range result.Events {
+				if e.Creator == nil || e.Creator.ID != personID || e.CreatedAt.Before(since) {
+					continue
+				}
+				category := activityCategory(e.Kind)
+				if category == "" {
+					continue
+				}
+				projectName := p.Name
+				if e.Bucket != nil && e.Bucket.Name != "" {
+					projectName = e.Bucket.Name
+				}
+				perProject[i] = append(perProject[i], peopleActivityEvent{
+					ID:          e.ID,
+					Category:    category,
+					Kind:        e.Kind,
+					Title:       activityTitle(e),
+					CreatedAt:   e.CreatedAt,
+					ProjectID:   p.ID,
+					ProjectName: projectName,
+					AppURL:      e.AppURL,
+				})
+			}
+		}(i, p)
+	}
+	wg.Wait()
+
+	var scan peopleActivityScan
+	for i, p := range projects {
+		name := p.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", p.ID)
+		}
+		if errs[i] != nil && ctx.Err() != nil {
+			scan.interrupted = true
+			continue
+		}
+		if errs[i] != nil {
+			scan.failed = append(scan.failed, name)
+			continue
+		}
+		if capped[i] {
+			scan.capped = append(scan.capped, name)
+		}
+		scan.events = append(scan.events, perProject[i]...)
+	}
+	sort.SliceStable(scan.events, func(i, j int) bool {
+		return scan.events[i].CreatedAt.After(scan.events[j].CreatedAt)
+	})
+	return scan
+}
+
+// buildPeopleActivity rolls events up into totals and per-project counts.
+// Projects are ordered by total activity, busiest first.
+func buildPeopleActivity(person peopleActivityPerson, since time.Time, events []peopleActivityEvent) peopleActivityResult {
+	result := peopleActivityResult{
+		Person:   person,
+		Since:    since,
+		Projects: []peopleActivityProject{},
+		Events:   events,
+	}
+	if result.Events == nil {
+		result.Events = []peopleActivityEvent{}
+	}
+
+	byProject := make(map[int64]*peopleActivityProject)
+	var order []int64
+	for _, e := range events {
+		p := byProject[e.ProjectID]
+		if p == nil {
+			p = &peopleActivityProject{ID: e.ProjectID, Name: e.ProjectName}
+			byProject[e.ProjectID] = p
+			order = append(order, e.ProjectID)
+		}
+		switch e.Category {
+		case activityCompletion:
+			p.Completions++
+			result.Completions++
+		case activityComment:
+			p.Comments++
+			result.Comments++
+		case activityMessage:
+			p.Messages++
+			result.Messages++
+		}
+	}
+
+	for _, id := range order {
+		result.Projects = append(result.Projects, *byProject[id])
+	}
+	total := func(p peopleActivityProject) int { return p.Completions + p.Comments + p.Messages }
+	sort.SliceStable(result.Projects, func(i, j int) bool {
+		return total(result.Projects[i]) > total(result.Projects[j])
+	})
+
+	return result
+}
+
+// activityCategory maps a timeline event kind to a readout category.
+// Kinds look like "todo_completed", "comment_created", "message_created".
+// Returns "" for kinds outside the readout.
+func activityCategory(kind string) string {
+	switch {
+	case strings.HasSuffix(kind, "_completed"):
+		return activityCompletion
+	case strings.HasPrefix(kind, "comment_"), strings.HasSuffix(kind, "_comment_created"):
+		return activityComment
+	case kind == "message_created":
+		return activityMessage
+	default:
+		return ""
+	}
+}
+
+func activityTitle(e basecamp.TimelineEvent) string {
+	if e.Title != "" {
+		return e.Title
+	}
+	return e.SummaryExcerpt
+}
+
+var activityWindowPattern = regexp.MustCompile(`^(\d+)\s*([hdwm])$`)
+
+// parseActivitySince turns --since into a cutoff time. It accepts relative
+// windows (24h, 3d, 1w, 2m) and anything dateparse understands as a date.
+func parseActivitySince(input string, now time.Time) (time.Time, error) {
+	return parseSinceFlag("--since", input, now)
+}
+
+// parseSinceFlag is parseActivitySince for any flag name, which its errors
+// carry. An RFC 3339 timestamp is taken as is, so scripts can pass the time
+// of their last run.
+func parseSinceFlag(flag, input string, now time.Time) (time.Time, error) {
+	s := strings.ToLower(strings.TrimSpace(input))
+	if m := activityWindowPattern.FindStringSubmatch(s); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		switch m[2] {
+		case "h":
+			return now.Add(-time.Duration(n) * time.Hour), nil
+		case "d":
+			return now.AddDate(0, 0, -n), nil
+		case "w":
+			return now.AddDate(0, 0, -7*n), nil
+		case "m":
+			return now.AddDate(0, -n, 0), nil
+		}
+	}
+
+	if t, err := time.Parse(time.RFC3339, strings.TrimSpace(input)); err == nil {
+		if t.After(now) {
+			return time.Time{}, output.ErrUsage(fmt.Sprintf("%s %q is in the future", flag, input))
+		}
+		return t, nil
+	}
+
+	if parsed := dateparse.ParseFrom(s, now); parsed != "" {
+		if t, err := time.ParseInLocation("2006-01-02", parsed, now.Location()); err == nil {
+			if t.After(now) {
+				return time.Time{}, output.ErrUsage(fmt.Sprintf("%s %q is in the future", flag, input))
+			}
+			return t, nil
+		}
+	}
+
+	return time.Time{}, output.ErrUsageHint(
+		fmt.Sprintf("invalid %s value %q", flag, input),
+		"Use a window like 24h, 3d, 1w, 2m or a date like 2026-01-15",
+	)
+}
+
+// renderPeopleActivityStyled draws the readout; the writer adds the notice
+// for skipped, capped, or interrupted projects.
+func renderPeopleActivityStyled(w io.Writer, r *output.Renderer, result peopleActivityResult) {
+	name := richtext.SanitizeSingleLine(result.Person.Name)
+	if name == "" {
+		name = fmt.Sprintf("Person #%d", result.Person.ID)
+	}
+	fmt.Fprintf(w, "%s %s\n", r.Summary.Render(name), r.Muted.Render("since "+result.Since.Local().Format("Mon Jan 2")))
+	fmt.Fprintf(w, "%d completions · %d comments · %d messages\n", result.Completions, result.Comments, result.Messages)
+
+	icons := map[string]string{
+		activityCompletion: "✓",
+		activityComment:    "💬",
+		activityMessage:    "✉",
+	}
+	for _, p := range result.Projects {
+		fmt.Fprintf(w, "\n%s %s\n", r.Summary.Render(richtext.SanitizeSingleLine(p.Name)),
+			r.Muted.Render(fmt.Sprintf("(%d completions, %d comments, %d messages)", p.Completions, p.Comments, p.Messages)))
+		for _, e := range result.Events {
+			if e.ProjectID != p.ID {
+				continue
+			}
+			fmt.Fprintf(w, "  %s %s %s\n", icons[e.Category], richtext.SanitizeSingleLine(e.Title),
+				r.Muted.Render(e.CreatedAt.Local().Format("Jan 2 15:04")))
+		}
+	}
+
+	if len(result.Projects) == 0 {
+		fmt.Fprintln(w, r.Muted.Render("No activity in this window."))
+	}
+}
@@ -0,0 +1,134 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// peopleExportHeader is the CSV header of a people export.
+var peopleExportHeader = []string{"id", "name", "email", "title", "company", "admin", "owner", "employee", "client"}
+
+func newPeopleExportCmd() *cobra.Command {
+	var format string
+	var out string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the account's people directory",
+		Long: `Export everyone in the account — name, email, title, company, and the
+admin, owner, employee, and client flags — for reconciling against other
+directories. Every page of the directory is fetched.
+
+Rows are sorted by name and written as CSV with a header line. Without --out
+the CSV goes to stdout. The directory is read in a single SDK call, so Ctrl-C
+fails the export with nothing written rather than leaving a partial file.`,
+		Example: `  basecamp people export --format csv > people.csv
+  basecamp people export --out people.csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runPeopleExport(cmd, format, out)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "csv", "Export format (csv)")
+	cmd.Flags().StringVarP(&out, "out", "o", "", "Write the export to this file (default: stdout)")
+
+	return cmd
+}
+
+func runPeopleExport(cmd *cobra.Command, format, out string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if !strings.EqualFold(format, "csv") {
+		return output.ErrUsageHint(
+			fmt.Sprintf("Unsupported format: %q", format),
+			"Supported formats: csv",
+		)
+	}
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	result, err := app.Account().People().List(cmd.Context(), &basecamp.PeopleListOptions{})
+	if err != nil {
+		return convertSDKError(err)
+	}
+	people := result.People
+	updatePeopleCache(people, app.Config.CacheDir)
+
+	sort.SliceStable(people, func(i, j int) bool {
+		return strings.ToLower(people[i].Name) < strings.ToLower(people[j].Name)
+	})
+
+	data, err := peopleExportCSV(people)
+	if err != nil {
+		return err
+	}
+
+	if out == "" || out == "-" {
+		_, err := cmd.OutOrStdout().Write(data)
+		return err
+	}
+
+	if err := os.WriteFile(out, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write export: %w", err)
+	}
+
+	count := len(people)
+	return app.OK(map[string]any{
+		"path":   out,
+		"format": "csv",
+		"people": count,
+	},
+		output.WithSummary(fmt.Sprintf("Exported %d %s to %s", count, pluralize(count, "person", "people"), out)),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "list",
+				Cmd:         "basecamp people list",
+				Description: "List people",
+			},
+		),
+	)
+}
+
+// peopleExportCSV renders people as CSV under peopleExportHeader.
+func peopleExportCSV(people []basecamp.Person) ([]byte, error) {
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	if err := w.Write(peopleExportHeader); err != nil {
+		return nil, err
+	}
+	for _, p := range people {
+		company := ""
+		if p.Company != nil {
+			company = p.Company.Name
+		}
+		if err := w.Write([]string{
+			strconv.FormatInt(p.ID, 10),
+			p.Name,
+			p.EmailAddress,
+			p.Title,
+			company,
+			strconv.FormatBool(p.Admin),
+			strconv.FormatBool(p.Owner),
+			strconv.FormatBool(p.Employee),
+			strconv.FormatBool(p.Client),
+		}); err != nil {
+			return nil, err
+		}
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
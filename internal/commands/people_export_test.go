@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+func setupPeopleExportServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/99999/people.json" {
+			http.NotFound(w, r)
+			return
+		}
+		json.NewEncoder(w).Encode([]map[string]any{
+			{"id": 2, "name": "zed Quinn", "email_address": "zed@example.com", "client": true,
+				"company": map[string]any{"id": 9, "name": "Acme, Inc."}},
+			{"id": 1, "name": "Alice Test", "email_address": "alice@example.com", "title": "Ops",
+				"admin": true, "owner": true, "employee": true},
+		})
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+const peopleExportWant = "id,name,email,title,company,admin,owner,employee,client\n" +
+	"1,Alice Test,alice@example.com,Ops,,true,true,true,false\n" +
+	"2,zed Quinn,zed@example.com,,\"Acme, Inc.\",false,false,false,true\n"
+
+func TestPeopleExportWritesCSVToStdout(t *testing.T) {
+	app, _ := setupPeopleMockApp(t, setupPeopleExportServer(t))
+
+	var out bytes.Buffer
+	cmd := NewPeopleCmd()
+	cmd.SetArgs([]string{"export", "--format", "csv"})
+	cmd.SetContext(appctx.WithApp(context.Background(), app))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, peopleExportWant, out.String())
+}
+
+func TestPeopleExportWritesFile(t *testing.T) {
+	app, buf := setupPeopleMockApp(t, setupPeopleExportServer(t))
+
+	path := filepath.Join(t.TempDir(), "people.csv")
+	require.NoError(t, executePeopleCommand(NewPeopleCmd(), app, "export", "--out", path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, peopleExportWant, string(data))
+	assert.Contains(t, buf.String(), `"summary": "Exported 2 people to `+path+`"`)
+}
+
+func TestPeopleExportRejectsUnknownFormat(t *testing.T) {
+	app, _ := setupPeopleMockApp(t, setupPeopleExportServer(t))
+
+	err := executePeopleCommand(NewPeopleCmd(), app, "export", "--format", "xlsx")
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeUsage, e.Code)
+	assert.Contains(t, e.Hint, "csv")
+}
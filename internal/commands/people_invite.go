@@ -0,0 +1,304 @@
+package commands
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// peopleInvitesFile is the invite log within the cache dir.
+const peopleInvitesFile = "invites.jsonl"
+
+// peopleInvite is one logged invite.
+type peopleInvite struct {
+	ID        int64     `json:"id"`
+	Name      string    `json:"name"`
+	Email     string    `json:"email"`
+	Title     string    `json:"title,omitempty"`
+	Company   string    `json:"company,omitempty"`
+	ProjectID int64     `json:"project_id"`
+	AccountID string    `json:"account_id"`
+	InvitedAt time.Time `json:"invited_at"`
+}
+
+func newPeopleInviteCmd() *cobra.Command {
+	var email, name, title, company, projectID string
+
+	cmd := &cobra.Command{
+		Use:   "invite",
+		Short: "Invite someone new to a project",
+		Long: `Invite a person by email address and give them access to a project.
+Basecamp emails them an invitation to join.
+
+When the address already belongs to someone in the account, they are added
+to the project instead of invited again. Invites are logged locally; list
+them with 'basecamp people invites'.`,
+		Example: `  basecamp people invite --email dana@example.com --to Launch --title "Designer"
+  basecamp people invite --email dana@example.com --name "Dana Scully" --company "Acme" --in 12345`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if email == "" {
+				return output.ErrUsage("--email is required")
+			}
+			if projectID == "" {
+				projectID = appctx.FromContext(cmd.Context()).Flags.Project
+			}
+			if projectID == "" {
+				return output.ErrUsage("--to (or --in) is required")
+			}
+			return runPeopleInvite(cmd, projectID, email, name, title, company)
+		},
+	}
+
+	cmd.Flags().StringVar(&email, "email", "", "Email address to invite (required)")
+	cmd.Flags().StringVar(&name, "name", "", "Full name (default: the part of the email before @)")
+	cmd.Flags().StringVar(&title, "title", "", "Job title")
+	cmd.Flags().StringVar(&company, "company", "", "Company name")
+	cmd.Flags().StringVar(&projectID, "to", "", "Project to invite them to (required)")
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Project to invite them to (alias for --to)")
+	cmd.Flags().StringVar(&projectID, "in", "", "Project to invite them to (alias for --to)")
+
+	completer := completion.NewCompleter(nil)
+	for _, flag := range []string{"to", "project", "in"} {
+		_ = cmd.RegisterFlagCompletionFunc(flag, completer.ProjectNameCompletion())
+	}
+
+	return cmd
+}
+
+func runPeopleInvite(cmd *cobra.Command, projectID, email, name, title, company string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	addr, err := mail.ParseAddress(email)
+	if err != nil {
+		return output.ErrUsage(fmt.Sprintf("Invalid email address: %q", email))
+	}
+	email = addr.Address
+	if name == "" {
+		name = addr.Name
+	}
+	if name == "" {
+		name, _, _ = strings.Cut(email, "@")
+	}
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	if err != nil {
+		return err
+	}
+	bucketID, err := strconv.ParseInt(resolvedProjectID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+
+	// Someone already in the account only needs project access.
+	req := &basecamp.UpdateProjectAccessRequest{}
+	existingID, existingName, err := app.Names.ResolvePerson(cmd.Context(), email)
+	var outErr *output.Error
+	switch {
+	case err == nil:
+		id, parseErr := strconv.ParseInt(existingID, 10, 64)
+		if parseErr != nil {
+			return output.ErrUsage("Invalid person ID")
+		}
+		req.Grant = []int64{id}
+	case errors.As(err, &outErr) && outErr.Code == output.CodeNotFound:
+		req.Create = []basecamp.CreatePersonRequest{{
+			Name:         name,
+			EmailAddress: email,
+			Title:        title,
+			CompanyName:  company,
+		}}
+	default:
+		return err
+	}
+
+	result, err := app.Account().People().UpdateProjectAccess(cmd.Context(), bucketID, req)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	var person basecamp.Person
+	if i := slices.IndexFunc(result.Granted, func(p basecamp.Person) bool {
+		return strings.EqualFold(p.EmailAddress, email)
+	}); i >= 0 {
+		person = result.Granted[i]
+	} else if len(result.Granted) > 0 {
+		person = result.Granted[0]
+	}
+
+	respOpts := []output.ResponseOption{
+		output.WithBreadcrumbs(
+			output.Breadcrumb{Action: "list", Cmd: fmt.Sprintf("basecamp people list --in %s", resolvedProjectID), Description: "List project members"},
+			output.Breadcrumb{Action: "invites", Cmd: fmt.Sprintf("basecamp people invites --in %s", resolvedProjectID), Description: "List invites sent to this project"},
+		),
+	}
+	if len(req.Grant) > 0 {
+		if existingName == "" {
+			existingName = email
+		}
+		respOpts = append(respOpts,
+			output.WithSummary(fmt.Sprintf("Added %s to project #%s", existingName, resolvedProjectID)),
+			output.WithNotice(fmt.Sprintf("%s is already in the account, so no invite was sent", email)),
+		)
+		return app.OK(person, respOpts...)
+	}
+
+	invite := peopleInvite{
+		ID:        person.ID,
+		Name:      name,
+		Email:     email,
+		Title:     title,
+		Company:   company,
+		ProjectID: bucketID,
+		AccountID: app.Config.AccountID,
+		InvitedAt: time.Now().UTC(),
+	}
+	if err := appendPeopleInvite(app, invite); err != nil {
+		respOpts = append(respOpts, output.WithNotice(fmt.Sprintf("Invite sent, but not logged locally: %v", err)))
+	}
+	respOpts = append(respOpts, output.WithSummary(fmt.Sprintf("Invited %s to project #%s", email, resolvedProjectID)))
+	return app.OK(person, respOpts...)
+}
+
+func newPeopleInvitesCmd() *cobra.Command {
+	var projectID string
+
+	cmd := &cobra.Command{
+		Use:   "invites",
+		Short: "List invites sent with people invite",
+		Long: `List the invites sent from this machine with 'basecamp people invite',
+newest first, for the current account.
+
+Basecamp's API doesn't report whether an invitation has been accepted, so
+this is a record of what was sent rather than a live pending list.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if projectID == "" {
+				projectID = appctx.FromContext(cmd.Context()).Flags.Project
+			}
+			return runPeopleInvites(cmd, projectID)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Only invites to this project")
+	cmd.Flags().StringVar(&projectID, "in", "", "Only invites to this project (alias for --project)")
+
+	completer := completion.NewCompleter(nil)
+	_ = cmd.RegisterFlagCompletionFunc("project", completer.ProjectNameCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
+
+	return cmd
+}
+
+func runPeopleInvites(cmd *cobra.Command, projectID string) error {
+	app := appctx.FromContext(cmd.Context())
+	if app.Config.CacheDir == "" {
+		return output.ErrUsage("No cache directory configured for the invite log (set --cache-dir or BASECAMP_CACHE_DIR)")
+	}
+
+	var bucketID int64
+	if projectID != "" {
+		if err := ensureAccount(cmd, app); err != nil {
+			return err
+		}
+		resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+		if err != nil {
+			return err
+		}
+		if bucketID, err = strconv.ParseInt(resolvedProjectID, 10, 64); err != nil {
+			return output.ErrUsage("Invalid project ID")
+		}
+	}
+
+	logged, err := readPeopleInvites(app)
+	if err != nil {
+		return fmt.Errorf("failed to read invite log: %w", err)
+	}
+	invites := make([]peopleInvite, 0, len(logged))
+	for _, inv := range logged {
+		if inv.AccountID != app.Config.AccountID || (bucketID != 0 && inv.ProjectID != bucketID) {
+			continue
+		}
+		invites = append(invites, inv)
+	}
+	slices.Reverse(invites)
+
+	return app.OK(invites,
+		output.WithSummary(fmt.Sprintf("%d %s sent", len(invites), pluralize(len(invites), "invite", "invites"))),
+		output.WithBreadcrumbs(output.Breadcrumb{
+			Action:      "invite",
+			Cmd:         "basecamp people invite --email <email> --to <project>",
+			Description: "Invite someone",
+		}),
+	)
+}
+
+func peopleInvitesPath(app *appctx.App) string {
+	return filepath.Join(app.Config.CacheDir, peopleInvitesFile)
+}
+
+// appendPeopleInvite adds one line to the invite log. Like the audit log,
+// it opens the file in append mode so concurrent runs interleave whole lines.
+func appendPeopleInvite(app *appctx.App, invite peopleInvite) error {
+	if app.Config.CacheDir == "" {
+		return errors.New("no cache directory configured")
+	}
+	data, err := json.Marshal(invite)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(app.Config.CacheDir, 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(peopleInvitesPath(app), os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// readPeopleInvites returns the logged invites, oldest first. Unreadable
+// lines are skipped.
+func readPeopleInvites(app *appctx.App) ([]peopleInvite, error) {
+	f, err := os.Open(peopleInvitesPath(app))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var invites []peopleInvite
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var inv peopleInvite
+		if json.Unmarshal(scanner.Bytes(), &inv) == nil {
+			invites = append(invites, inv)
+		}
+	}
+	return invites, scanner.Err()
+}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+func TestPeopleInviteCreatesAndLogs(t *testing.T) {
+	var accessReqs []map[string]any
+	app, buf := setupPeopleMockApp(t, setupPeopleSyncServer(t, &accessReqs))
+
+	err := executePeopleCommand(NewPeopleCmd(), app, "invite", "--email", "dana@example.com", "--to", "55555", "--title", "Designer")
+	require.NoError(t, err, buf.String())
+
+	require.Len(t, accessReqs, 1)
+	assert.Equal(t, []any{map[string]any{"name": "dana", "email_address": "dana@example.com", "title": "Designer"}}, accessReqs[0]["create"])
+	assert.Nil(t, accessReqs[0]["grant"])
+
+	var envelope struct {
+		Data struct {
+			ID int64 `json:"id"`
+		} `json:"data"`
+		Summary string `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Equal(t, int64(3000), envelope.Data.ID)
+	assert.Equal(t, "Invited dana@example.com to project #55555", envelope.Summary)
+
+	buf.Reset()
+	require.NoError(t, executePeopleCommand(NewPeopleCmd(), app, "invites", "--in", "55555"))
+	var list struct {
+		Data    []peopleInvite `json:"data"`
+		Summary string         `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &list))
+	require.Len(t, list.Data, 1)
+	assert.Equal(t, "dana@example.com", list.Data[0].Email)
+	assert.Equal(t, int64(55555), list.Data[0].ProjectID)
+	assert.Equal(t, "99999", list.Data[0].AccountID)
+	assert.Equal(t, "1 invite sent", list.Summary)
+}
+
+func TestPeopleInviteGrantsExistingPerson(t *testing.T) {
+	var accessReqs []map[string]any
+	app, buf := setupPeopleMockApp(t, setupPeopleSyncServer(t, &accessReqs))
+
+	err := executePeopleCommand(NewPeopleCmd(), app, "invite", "--email", "Bob@example.com", "--in", "55555")
+	require.NoError(t, err, buf.String())
+
+	require.Len(t, accessReqs, 1)
+	assert.Equal(t, []any{float64(2001)}, accessReqs[0]["grant"])
+	assert.Nil(t, accessReqs[0]["create"])
+
+	var envelope struct {
+		Notice string `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Contains(t, envelope.Notice, "already in the account")
+
+	invites, err := readPeopleInvites(app)
+	require.NoError(t, err)
+	assert.Empty(t, invites, "granting access isn't an invite")
+}
+
+func TestPeopleInviteValidation(t *testing.T) {
+	app, _ := setupPeopleTestApp(t)
+
+	err := executePeopleCommand(NewPeopleCmd(), app, "invite", "--to", "55555")
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, "--email is required", e.Message)
+
+	err = executePeopleCommand(NewPeopleCmd(), app, "invite", "--email", "dana@example.com")
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, "--to")
+
+	err = executePeopleCommand(NewPeopleCmd(), app, "invite", "--email", "not-an-address", "--to", "55555")
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, "Invalid email address")
+}
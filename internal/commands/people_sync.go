@@ -0,0 +1,465 @@
+package commands
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// Membership sync actions, as reported in the plan.
+const (
+	peopleSyncAdd    = "add"
+	peopleSyncInvite = "invite"
+	peopleSyncRemove = "remove"
+	peopleSyncKeep   = "keep"
+)
+
+type peopleSyncItem struct {
+	Action string `json:"action"`
+	ID     int64  `json:"id,omitempty"`
+	Name   string `json:"name"`
+	Email  string `json:"email_address,omitempty"`
+
+	title   string
+	company string
+}
+
+type peopleSyncResult struct {
+	ProjectID string           `json:"project_id"`
+	DryRun    bool             `json:"dry_run"`
+	Counts    map[string]int   `json:"counts"`
+	Items     []peopleSyncItem `json:"items"`
+}
+
+// peopleSyncRow is one person listed in the membership file.
+type peopleSyncRow struct {
+	line    int
+	id      string
+	email   string
+	name    string
+	title   string
+	company string
+}
+
+// ident is what the row is resolved by: ID first, then email, then name.
+func (r peopleSyncRow) ident() string {
+	switch {
+	case r.id != "":
+		return r.id
+	case r.email != "":
+		return r.email
+	default:
+		return r.name
+	}
+}
+
+func newPeopleSyncCmd() *cobra.Command {
+	var projectID string
+	var from string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "sync",
+		Short: "Sync project membership with a CSV file",
+		Long: `Make a project's members match a CSV file.
+
+People in the file but not in the project are added; project members
+missing from the file are removed. Rows that match nobody in the account
+but carry both a name and an email address are invited as new people.
+You are never removed from a project you sync.
+
+The file either has a header naming its columns — id, email, name, and
+optionally title and company — or no header, in which case the first field
+of each line is a person ID, email address, or name. Lines starting with #
+are comments. Use --from - to read stdin.
+
+Use --dry-run to see the plan without changing anything.`,
+		Example: `  basecamp people sync --in "Launch" --from people.csv --dry-run
+  basecamp people sync --in "Launch" --from people.csv
+  cut -d, -f2 roster.csv | basecamp people sync --in 12345 --from -`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if projectID == "" {
+				projectID = appctx.FromContext(cmd.Context()).Flags.Project
+			}
+			if projectID == "" {
+				return output.ErrUsage("--project (or --in) is required")
+			}
+			if from == "" {
+				return output.ErrUsage("--from is required")
+			}
+			return runPeopleSync(cmd, projectID, from, dryRun)
+		},
+	}
+
+	cmd.Flags().StringVarP(&projectID, "project", "p", "", "Project to sync (required)")
+	cmd.Flags().StringVar(&projectID, "in", "", "Project to sync (alias for --project)")
+	cmd.Flags().StringVar(&from, "from", "", "CSV file listing the desired members (- for stdin)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the sync plan without making changes")
+
+	completer := completion.NewCompleter(nil)
+	_ = cmd.RegisterFlagCompletionFunc("project", completer.ProjectNameCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
+
+	return cmd
+}
+
+func runPeopleSync(cmd *cobra.Command, projectID, from string, dryRun bool) error {
+	app := appctx.FromContext(cmd.Context())
+
+	rows, err := readPeopleSyncFile(cmd, from)
+	if err != nil {
+		return err
+	}
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	if err != nil {
+		return err
+	}
+	bucketID, err := strconv.ParseInt(resolvedProjectID, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+
+	desired, err := resolvePeopleSyncRows(cmd, app, rows)
+	if err != nil {
+		return err
+	}
+
+	membersResult, err := app.Account().People().ListProjectPeople(cmd.Context(), bucketID, &basecamp.PeopleListOptions{})
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	meID, _, err := app.Names.ResolvePerson(cmd.Context(), "me")
+	if err != nil {
+		return err
+	}
+	self, _ := strconv.ParseInt(meID, 10, 64)
+
+	items, keptSelf := planPeopleSync(desired, membersResult.People, self)
+
+	result := peopleSyncResult{
+		ProjectID: resolvedProjectID,
+		DryRun:    dryRun,
+		Items:     items,
+		Counts:    make(map[string]int),
+	}
+	for _, it := range items {
+		result.Counts[it.Action]++
+	}
+
+	if !dryRun {
+		if err := applyPeopleSync(cmd, app, bucketID, items); err != nil {
+			return err
+		}
+	}
+
+	opts := []output.ResponseOption{
+		output.WithSummary(peopleSyncSummary(result)),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderPeopleSyncStyled(w, r, result) }),
+		output.WithBreadcrumbs(output.Breadcrumb{
+			Action:      "list",
+			Cmd:         fmt.Sprintf("basecamp people list --project %s", resolvedProjectID),
+			Description: "List project members",
+		}),
+	}
+	if keptSelf {
+		opts = append(opts, output.WithNotice("You are not in the file but stay in the project; remove yourself with basecamp people remove me"))
+	}
+	return app.OK(result, opts...)
+}
+
+func readPeopleSyncFile(cmd *cobra.Command, from string) ([]peopleSyncRow, error) {
+	var r io.Reader
+	if from == "-" {
+		r = cmd.InOrStdin()
+	} else {
+		data, err := os.ReadFile(richtext.NormalizeDragPath(from)) //nolint:gosec // G304: user-supplied membership file
+		if err != nil {
+			return nil, fmt.Errorf("cannot read %s: %w", from, err)
+		}
+		r = bytes.NewReader(data)
+	}
+
+	rows, err := parsePeopleSyncCSV(r)
+	if err != nil {
+		return nil, output.ErrUsage(fmt.Sprintf("%s: %v", from, err))
+	}
+	return rows, nil
+}
+
+// peopleSyncColumns maps accepted header names to row fields.
+var peopleSyncColumns = map[string]string{
+	"id":            "id",
+	"person_id":     "id",
+	"email":         "email",
+	"email_address": "email",
+	"name":          "name",
+	"title":         "title",
+	"company":       "company",
+	"company_name":  "company",
+}
+
+// parsePeopleSyncCSV reads the membership file. A first line made only of
+// known column names is a header; otherwise each line's first field is an
+// ID, email address, or name.
+func parsePeopleSyncCSV(r io.Reader) ([]peopleSyncRow, error) {
+	reader := csv.NewReader(r)
+	reader.Comment = '#'
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var header []string
+	var rows []peopleSyncRow
+	for first := true; ; first = false {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		line, _ := reader.FieldPos(0)
+
+		if first && isPeopleSyncHeader(record) {
+			for _, col := range record {
+				header = append(header, peopleSyncColumns[strings.ToLower(strings.TrimSpace(col))])
+			}
+			continue
+		}
+
+		row := peopleSyncRow{line: line}
+		if header != nil {
+			for j, value := range record {
+				if j >= len(header) {
+					break
+				}
+				value = strings.TrimSpace(value)
+				switch header[j] {
+				case "id":
+					row.id = value
+				case "email":
+					row.email = value
+				case "name":
+					row.name = value
+				case "title":
+					row.title = value
+				case "company":
+					row.company = value
+				}
+			}
+		} else if len(record) > 0 {
+			value := strings.TrimSpace(record[0])
+			if _, err := strconv.ParseInt(value, 10, 64); err == nil {
+				row.id = value
+			} else if strings.Contains(value, "@") {
+				row.email = value
+			} else {
+				row.name = value
+			}
+		}
+		if row.ident() == "" {
+			continue
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func isPeopleSyncHeader(record []string) bool {
+	for _, col := range record {
+		if _, ok := peopleSyncColumns[strings.ToLower(strings.TrimSpace(col))]; !ok {
+			return false
+		}
+	}
+	return len(record) > 0
+}
+
+// resolvePeopleSyncRows resolves each row to an account person, or to an
+// invite when nobody matches and the row has a name and email address.
+// Nothing is applied unless every row resolves.
+func resolvePeopleSyncRows(cmd *cobra.Command, app *appctx.App, rows []peopleSyncRow) ([]peopleSyncItem, error) {
+	var items []peopleSyncItem
+	for _, row := range rows {
+		resolvedID, name, err := app.Names.ResolvePerson(cmd.Context(), row.ident())
+		if err != nil {
+			var outErr *output.Error
+			if errors.As(err, &outErr) && outErr.Code == output.CodeNotFound && row.email != "" && row.name != "" {
+				items = append(items, peopleSyncItem{
+					Action:  peopleSyncInvite,
+					Name:    row.name,
+					Email:   row.email,
+					title:   row.title,
+					company: row.company,
+				})
+				continue
+			}
+			if errors.As(err, &outErr) {
+				outErr.Message = fmt.Sprintf("line %d: %s", row.line, outErr.Message)
+				return nil, outErr
+			}
+			return nil, fmt.Errorf("line %d: %w", row.line, err)
+		}
+
+		id, err := strconv.ParseInt(resolvedID, 10, 64)
+		if err != nil {
+			return nil, output.ErrUsage(fmt.Sprintf("line %d: invalid person ID %q", row.line, resolvedID))
+		}
+		if name == "" {
+			name = row.name
+		}
+		items = append(items, peopleSyncItem{ID: id, Name: name, Email: row.email})
+	}
+	return items, nil
+}
+
+// planPeopleSync diffs the desired people against the project's members.
+// Desired people keep their file order; removals follow, sorted by name.
+// The current user (self) is never removed; keptSelf reports when that
+// rule kept them.
+func planPeopleSync(desired []peopleSyncItem, members []basecamp.Person, self int64) (items []peopleSyncItem, keptSelf bool) {
+	isMember := make(map[int64]bool, len(members))
+	for _, m := range members {
+		isMember[m.ID] = true
+	}
+
+	wanted := make(map[int64]bool, len(desired))
+	invited := make(map[string]bool)
+	for _, d := range desired {
+		switch {
+		case d.Action == peopleSyncInvite:
+			key := strings.ToLower(d.Email)
+			if !invited[key] {
+				invited[key] = true
+				items = append(items, d)
+			}
+			continue
+		case wanted[d.ID]:
+			continue
+		case isMember[d.ID]:
+			d.Action = peopleSyncKeep
+		default:
+			d.Action = peopleSyncAdd
+		}
+		wanted[d.ID] = true
+		items = append(items, d)
+	}
+
+	var removals []peopleSyncItem
+	for _, m := range members {
+		if wanted[m.ID] {
+			continue
+		}
+		item := peopleSyncItem{Action: peopleSyncRemove, ID: m.ID, Name: m.Name, Email: m.EmailAddress}
+		if m.ID == self {
+			item.Action = peopleSyncKeep
+			keptSelf = true
+		}
+		removals = append(removals, item)
+	}
+	sort.SliceStable(removals, func(i, j int) bool {
+		return strings.ToLower(removals[i].Name) < strings.ToLower(removals[j].Name)
+	})
+
+	return append(items, removals...), keptSelf
+}
+
+// applyPeopleSync sends the whole plan as one access update and fills in
+// the IDs of invited people from the response.
+func applyPeopleSync(cmd *cobra.Command, app *appctx.App, bucketID int64, items []peopleSyncItem) error {
+	req := &basecamp.UpdateProjectAccessRequest{}
+	for _, it := range items {
+		switch it.Action {
+		case peopleSyncAdd:
+			req.Grant = append(req.Grant, it.ID)
+		case peopleSyncRemove:
+			req.Revoke = append(req.Revoke, it.ID)
+		case peopleSyncInvite:
+			req.Create = append(req.Create, basecamp.CreatePersonRequest{
+				Name:         it.Name,
+				EmailAddress: it.Email,
+				Title:        it.title,
+				CompanyName:  it.company,
+			})
+		}
+	}
+	if len(req.Grant) == 0 && len(req.Revoke) == 0 && len(req.Create) == 0 {
+		return nil
+	}
+
+	resp, err := app.Account().People().UpdateProjectAccess(cmd.Context(), bucketID, req)
+	if err != nil {
+		return convertSDKError(err)
+	}
+	for i := range items {
+		if items[i].Action != peopleSyncInvite {
+			continue
+		}
+		for _, p := range resp.Granted {
+			if strings.EqualFold(p.EmailAddress, items[i].Email) {
+				items[i].ID = p.ID
+				break
+			}
+		}
+	}
+	return nil
+}
+
+func peopleSyncSummary(r peopleSyncResult) string {
+	var parts []string
+	for _, a := range []string{peopleSyncAdd, peopleSyncInvite, peopleSyncRemove, peopleSyncKeep} {
+		if n := r.Counts[a]; n > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", n, a))
+		}
+	}
+	summary := "Nothing to sync"
+	if len(parts) > 0 {
+		summary = strings.Join(parts, ", ")
+	}
+	summary += fmt.Sprintf(" (project #%s)", r.ProjectID)
+	if r.DryRun {
+		summary = "Dry run: " + summary
+	}
+	return summary
+}
+
+func renderPeopleSyncStyled(w io.Writer, rr *output.Renderer, r peopleSyncResult) {
+	var buf bytes.Buffer
+	for _, it := range r.Items {
+		if it.Action == peopleSyncKeep {
+			continue
+		}
+		line := it.Name
+		if it.Email != "" {
+			line += " <" + it.Email + ">"
+		}
+		if it.ID != 0 {
+			line += " " + rr.Muted.Render(fmt.Sprintf("#%d", it.ID))
+		}
+		fmt.Fprintf(&buf, "  %-8s %s\n", it.Action, line)
+	}
+
+	fmt.Fprintln(w, rr.Summary.Render(peopleSyncSummary(r)))
+	if buf.Len() > 0 {
+		fmt.Fprint(w, buf.String())
+	}
+}
@@ -0,0 +1,170 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// setupPeopleSyncServer serves an account with Alice (the current user),
+// Bob, and Carol; the project has Alice and Carol. accessReqs collects the
+// bodies of project access updates.
+func setupPeopleSyncServer(t *testing.T, accessReqs *[]map[string]any) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.URL.Path == "/99999/projects.json":
+			json.NewEncoder(w).Encode([]map[string]any{{"id": 55555, "name": "Launch"}})
+		case r.URL.Path == "/99999/people.json":
+			json.NewEncoder(w).Encode([]map[string]any{
+				{"id": 1001, "name": "Alice Test", "email_address": "alice@example.com"},
+				{"id": 2001, "name": "Bob Builder", "email_address": "bob@example.com"},
+				{"id": 2002, "name": "Carol Danvers", "email_address": "carol@example.com"},
+			})
+		case r.URL.Path == "/99999/people/pingable.json":
+			json.NewEncoder(w).Encode([]map[string]any{})
+		case r.URL.Path == "/99999/my/profile.json":
+			json.NewEncoder(w).Encode(map[string]any{"id": 1001, "name": "Alice Test"})
+		case r.URL.Path == "/99999/projects/55555/people.json":
+			json.NewEncoder(w).Encode([]map[string]any{
+				{"id": 1001, "name": "Alice Test", "email_address": "alice@example.com"},
+				{"id": 2002, "name": "Carol Danvers", "email_address": "carol@example.com"},
+			})
+		case r.URL.Path == "/99999/projects/55555/people/users.json" && r.Method == http.MethodPut:
+			var req map[string]any
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+			*accessReqs = append(*accessReqs, req)
+			granted := []any{}
+			if creates, ok := req["create"].([]any); ok {
+				for i, c := range creates {
+					granted = append(granted, map[string]any{
+						"id": 3000 + i, "name": c.(map[string]any)["name"], "email_address": c.(map[string]any)["email_address"],
+					})
+				}
+			}
+			json.NewEncoder(w).Encode(map[string]any{"granted": granted, "revoked": []any{}})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func writePeopleSyncFile(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "people.csv")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o644))
+	return path
+}
+
+func TestPeopleSyncAppliesDiff(t *testing.T) {
+	var accessReqs []map[string]any
+	app, buf := setupPeopleMockApp(t, setupPeopleSyncServer(t, &accessReqs))
+
+	file := writePeopleSyncFile(t, "email,name,title\n"+
+		"# onboarding\n"+
+		"bob@example.com,Bob Builder,\n"+
+		"dana@example.com,Dana Scully,Agent\n")
+
+	err := executePeopleCommand(NewPeopleCmd(), app, "sync", "--in", "55555", "--from", file)
+	require.NoError(t, err, buf.String())
+
+	require.Len(t, accessReqs, 1)
+	assert.Equal(t, []any{float64(2001)}, accessReqs[0]["grant"])
+	assert.Equal(t, []any{float64(2002)}, accessReqs[0]["revoke"], "Carol is not in the file")
+	assert.Equal(t, []any{map[string]any{"name": "Dana Scully", "email_address": "dana@example.com", "title": "Agent"}}, accessReqs[0]["create"])
+
+	var envelope struct {
+		Data    peopleSyncResult `json:"data"`
+		Summary string           `json:"summary"`
+		Notice  string           `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Equal(t, "1 add, 1 invite, 1 remove, 1 keep (project #55555)", envelope.Summary)
+	assert.Contains(t, envelope.Notice, "You are not in the file but stay in the project")
+	require.Len(t, envelope.Data.Items, 4)
+	assert.Equal(t, peopleSyncItem{Action: peopleSyncInvite, ID: 3000, Name: "Dana Scully", Email: "dana@example.com"}, envelope.Data.Items[1])
+}
+
+func TestPeopleSyncDryRunChangesNothing(t *testing.T) {
+	var accessReqs []map[string]any
+	app, buf := setupPeopleMockApp(t, setupPeopleSyncServer(t, &accessReqs))
+
+	cmd := NewPeopleCmd()
+	cmd.SetIn(strings.NewReader("1001\nCarol Danvers\nbob@example.com\n"))
+	err := executePeopleCommand(cmd, app, "sync", "--in", "55555", "--from", "-", "--dry-run")
+	require.NoError(t, err, buf.String())
+
+	assert.Empty(t, accessReqs)
+	assert.Contains(t, buf.String(), `"summary": "Dry run: 1 add, 2 keep (project #55555)"`)
+}
+
+func TestPeopleSyncStyledHonorsRedact(t *testing.T) {
+	var accessReqs []map[string]any
+	app, buf := setupPeopleMockApp(t, setupPeopleSyncServer(t, &accessReqs))
+	app.Output = output.New(output.Options{Format: output.FormatStyled, Writer: buf, Redact: output.NewRedactor([]string{"emails"})})
+
+	file := writePeopleSyncFile(t, "bob@example.com\n")
+	err := executePeopleCommand(NewPeopleCmd(), app, "sync", "--in", "55555", "--from", file, "--dry-run")
+	require.NoError(t, err, buf.String())
+
+	assert.Contains(t, buf.String(), "Bob Builder <"+output.Redacted+">")
+	assert.NotContains(t, buf.String(), "@example.com")
+}
+
+func TestPeopleSyncUnknownPersonFailsBeforeChanges(t *testing.T) {
+	var accessReqs []map[string]any
+	app, _ := setupPeopleMockApp(t, setupPeopleSyncServer(t, &accessReqs))
+
+	file := writePeopleSyncFile(t, "bob@example.com\nnobody@example.com\n")
+	err := executePeopleCommand(NewPeopleCmd(), app, "sync", "--in", "55555", "--from", file)
+	require.Error(t, err)
+
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeNotFound, e.Code)
+	assert.Contains(t, e.Message, "line 2:")
+	assert.Empty(t, accessReqs)
+}
+
+func TestParsePeopleSyncCSV(t *testing.T) {
+	rows, err := parsePeopleSyncCSV(strings.NewReader("# team\nID, Email\n42,\n,x@example.com\n\n"))
+	require.NoError(t, err)
+	require.Len(t, rows, 2)
+	assert.Equal(t, peopleSyncRow{line: 3, id: "42"}, rows[0])
+	assert.Equal(t, peopleSyncRow{line: 4, email: "x@example.com"}, rows[1])
+
+	_, err = parsePeopleSyncCSV(strings.NewReader("name\n\"unterminated\n"))
+	assert.Error(t, err)
+}
+
+func TestPlanPeopleSyncDedupesAndKeepsSelf(t *testing.T) {
+	desired := []peopleSyncItem{{ID: 1, Name: "A"}, {ID: 1, Name: "A"}, {ID: 2, Name: "B"}}
+	members := []basecamp.Person{{ID: 2, Name: "B"}, {ID: 9, Name: "Me"}, {ID: 3, Name: "C"}}
+
+	items, keptSelf := planPeopleSync(desired, members, 9)
+	assert.True(t, keptSelf)
+
+	var got []string
+	for _, it := range items {
+		got = append(got, fmt.Sprintf("%s %d", it.Action, it.ID))
+	}
+	assert.Equal(t, []string{"add 1", "keep 2", "remove 3", "keep 9"}, got)
+}
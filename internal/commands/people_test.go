@@ -6,12 +6,14 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -719,3 +721,183 @@ func TestPeopleRemoveNoProject(t *testing.T) {
 	assert.Equal(t, output.CodeUsage, e.Code)
 	assert.Contains(t, e.Message, "--project (or --in) is required")
 }
+
+// mockPeopleActivityTransport serves a person, three projects, and their
+// timelines. Project 3 is forbidden to exercise partial failure.
+type mockPeopleActivityTransport struct {
+	now time.Time
+}
+
+func (m mockPeopleActivityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	ts := func(ago time.Duration) string { return m.now.Add(-ago).UTC().Format(time.RFC3339) }
+	event := func(id int64, kind, title string, creator int64, ago time.Duration) string {
+		return fmt.Sprintf(`{"id": %d, "kind": %q, "title": %q, "created_at": %q, "creator": {"id": %d, "name": "x"}}`,
+			id, kind, title, ts(ago), creator)
+	}
+
+	var body string
+	status := http.StatusOK
+	switch {
+	case strings.HasSuffix(req.URL.Path, "/people.json"):
+		body = `[{"id": 42, "name": "Jane Doe"}, {"id": 7, "name": "Someone Else"}]`
+	case strings.HasSuffix(req.URL.Path, "/projects/1/timeline.json"):
+		body = "[" + strings.Join([]string{
+			event(101, "todo_completed", "Ship it", 42, time.Hour),
+			event(102, "comment_created", "Looks good", 42, 2*time.Hour),
+			event(103, "todo_completed", "Not hers", 7, time.Hour),
+			event(104, "document_created", "Ignored kind", 42, time.Hour),
+			event(105, "todo_completed", "Too old", 42, 30*24*time.Hour),
+		}, ",") + "]"
+	case strings.HasSuffix(req.URL.Path, "/projects/2/timeline.json"):
+		body = "[" + event(201, "message_created", "Kickoff", 42, 3*time.Hour) + "]"
+	case strings.HasSuffix(req.URL.Path, "/projects/3/timeline.json"):
+		status = http.StatusForbidden
+		body = `{"error": "forbidden"}`
+	case strings.HasSuffix(req.URL.Path, "/projects.json"):
+		body = `[{"id": 1, "name": "Alpha"}, {"id": 2, "name": "Beta"}, {"id": 3, "name": "Locked"}]`
+	default:
+		return nil, fmt.Errorf("unexpected path: %s", req.URL.Path)
+	}
+
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestPeopleActivityAggregatesAcrossProjects(t *testing.T) {
+	buf := &bytes.Buffer{}
+	app := showTestAppWithOutput(t, mockPeopleActivityTransport{now: time.Now()}, output.FormatJSON, buf, &bytes.Buffer{})
+
+	err := executePeopleCommand(newPeopleActivityCmd(), app, "42", "--since", "1w")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Data   peopleActivityResult `json:"data"`
+		Notice string               `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+
+	result := envelope.Data
+	assert.Equal(t, "Jane Doe", result.Person.Name)
+	assert.Equal(t, 1, result.Completions)
+	assert.Equal(t, 1, result.Comments)
+	assert.Equal(t, 1, result.Messages)
+
+	require.Len(t, result.Events, 3)
+	assert.Equal(t, []int64{101, 102, 201}, []int64{result.Events[0].ID, result.Events[1].ID, result.Events[2].ID}, "events newest first")
+
+	require.Len(t, result.Projects, 2)
+	assert.Equal(t, "Alpha", result.Projects[0].Name, "busiest project first")
+	assert.Equal(t, "Beta", result.Projects[1].Name)
+
+	assert.Contains(t, envelope.Notice, "Locked")
+}
+
+// stallingTransport holds requests for the stall paths until their context
+// is canceled, as a slow project would when Ctrl-C arrives.
+type stallingTransport struct {
+	inner http.RoundTripper
+	stall []string
+}
+
+func (t stallingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for _, suffix := range t.stall {
+		if strings.HasSuffix(req.URL.Path, suffix) {
+			<-req.Context().Done()
+			return nil, req.Context().Err()
+		}
+	}
+	return t.inner.RoundTrip(req)
+}
+
+func TestPeopleActivityInterruptedKeepsProjectsRead(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	buf := &bytes.Buffer{}
+	app := showTestAppWithOutput(t, stallingTransport{
+		inner: interruptingTransport{
+			inner:  mockPeopleActivityTransport{now: time.Now()},
+			after:  "/projects/1/timeline.json",
+			cancel: cancel,
+		},
+		stall: []string{"/projects/2/timeline.json", "/projects/3/timeline.json"},
+	}, output.FormatJSON, buf, &bytes.Buffer{})
+
+	cmd := newPeopleActivityCmd()
+	cmd.SetContext(appctx.WithApp(ctx, app))
+	cmd.SetArgs([]string{"42", "--since", "1w"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	var envelope struct {
+		Data   peopleActivityResult `json:"data"`
+		Meta   map[string]any       `json:"meta"`
+		Notice string               `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Equal(t, true, envelope.Meta["interrupted"])
+	assert.Equal(t, true, envelope.Meta["truncated"])
+	assert.Equal(t, 1, envelope.Data.Completions, "the timeline read before Ctrl-C is counted")
+	assert.NotContains(t, envelope.Notice, "could not be read", "an interrupted project is not reported as unreadable")
+}
+
+func TestPeopleActivityReportsTimelineLimit(t *testing.T) {
+	buf := &bytes.Buffer{}
+	app := showTestAppWithOutput(t, mockPeopleActivityTransport{now: time.Now()}, output.FormatJSON, buf, &bytes.Buffer{})
+
+	err := executePeopleCommand(newPeopleActivityCmd(), app, "42", "--since", "1w", "--limit", "2")
+	require.NoError(t, err)
+
+	var envelope struct {
+		Meta   map[string]any `json:"meta"`
+		Notice string         `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Equal(t, true, envelope.Meta["truncated"], "Alpha's newest 2 events are both in the window")
+	assert.Contains(t, envelope.Notice, "raise --limit): Alpha")
+	assert.NotContains(t, envelope.Notice, "Beta", "Beta's timeline is shorter than the limit")
+}
+
+func TestPeopleActivityInvalidSince(t *testing.T) {
+	app, _ := setupPeopleTestApp(t)
+
+	err := executePeopleCommand(newPeopleActivityCmd(), app, "42", "--since", "soon")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --since value")
+}
+
+func TestParseActivitySince(t *testing.T) {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"24h", now.Add(-24 * time.Hour)},
+		{"3d", now.AddDate(0, 0, -3)},
+		{"1w", now.AddDate(0, 0, -7)},
+		{"2m", now.AddDate(0, -2, 0)},
+		{"2026-03-01", time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"yesterday", time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := parseActivitySince(tt.input, now)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+
+	_, err := parseActivitySince("2027-01-01", now)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "in the future")
+}
+
+func TestActivityCategory(t *testing.T) {
+	assert.Equal(t, activityCompletion, activityCategory("todo_completed"))
+	assert.Equal(t, activityComment, activityCategory("comment_created"))
+	assert.Equal(t, activityMessage, activityCategory("message_created"))
+	assert.Empty(t, activityCategory("document_created"))
+}
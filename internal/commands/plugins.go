@@ -0,0 +1,253 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// Plugins are executables named basecamp-<name> on PATH, run as
+// "basecamp <name> [args]" the way git runs git-<name>. Built-in commands
+// always win. A plugin gets the resolved account, project, and access token
+// in its environment, and BASECAMP_CLI to call back into this binary
+// ("$BASECAMP_CLI auth token" hands over a fresh token at any time). The
+// token goes in BASECAMP_PLUGIN_TOKEN, not BASECAMP_TOKEN: the auth layer
+// returns BASECAMP_TOKEN as-is, so exporting it would pin every callback to
+// the token the plugin started with.
+
+// pluginPrefix names plugin executables.
+const pluginPrefix = "basecamp-"
+
+// pluginAPIVersion tells plugins which environment contract they get.
+const pluginAPIVersion = "1"
+
+var pluginNameRe = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]*$`)
+
+// PluginExitError carries a plugin's non-zero exit status. The plugin has
+// already reported its failure, so the caller exits with Code and prints
+// nothing more.
+type PluginExitError struct {
+	Code int
+}
+
+func (e *PluginExitError) Error() string {
+	return fmt.Sprintf("plugin exited with status %d", e.Code)
+}
+
+// pluginInfo is one plugin found on PATH.
+type pluginInfo struct {
+	Name     string `json:"name"`
+	Path     string `json:"path"`
+	Shadowed bool   `json:"shadowed"`
+}
+
+// NewPluginCmd returns a command that runs the plugin named by args[0], or
+// nil when args[0] is a built-in command or no basecamp-<name> is on PATH.
+func NewPluginCmd(root *cobra.Command, args []string) *cobra.Command {
+	if len(args) == 0 || !pluginNameRe.MatchString(args[0]) || args[0] == "help" {
+		return nil
+	}
+	if isBuiltinCommand(root, args[0]) {
+		return nil
+	}
+	path, err := exec.LookPath(pluginPrefix + args[0])
+	if err != nil {
+		return nil
+	}
+
+	return &cobra.Command{
+		Use:                args[0],
+		Short:              "Plugin " + filepath.Base(path),
+		DisableFlagParsing: true, // everything after the name belongs to the plugin
+		Annotations:        map[string]string{"plugin": path},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if app == nil {
+				return fmt.Errorf("app not initialized")
+			}
+			return runPlugin(cmd, app, path, args)
+		},
+	}
+}
+
+// runPlugin runs a plugin with this process's stdio and the plugin
+// environment.
+func runPlugin(cmd *cobra.Command, app *appctx.App, path string, args []string) error {
+	child := exec.CommandContext(cmd.Context(), path, args...) //nolint:gosec // G204: runs the basecamp-<name> plugin the user invoked
+	child.Stdin, child.Stdout, child.Stderr = cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr()
+	child.Env = append(os.Environ(), pluginEnv(cmd.Context(), app)...)
+	if err := child.Run(); err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			return &PluginExitError{Code: exitErr.ExitCode()}
+		}
+		return fmt.Errorf("running plugin %s: %w", path, err)
+	}
+	return nil
+}
+
+// pluginEnv is what a plugin learns from the CLI: the resolved context and,
+// when logged in, an access token. Unset values are left out so the
+// plugin's own environment still applies.
+func pluginEnv(ctx context.Context, app *appctx.App) []string {
+	env := []string{"BASECAMP_PLUGIN_API=" + pluginAPIVersion}
+	add := func(key, value string) {
+		if value != "" {
+			env = append(env, key+"="+value)
+		}
+	}
+	if self, err := os.Executable(); err == nil {
+		add("BASECAMP_CLI", self)
+	}
+	add("BASECAMP_BASE_URL", app.Config.BaseURL)
+	add("BASECAMP_ACCOUNT_ID", app.Config.AccountID)
+	add("BASECAMP_PROJECT_ID", app.Config.ProjectID)
+	add("BASECAMP_TODOLIST_ID", app.Config.TodolistID)
+	add("BASECAMP_PROFILE", app.Config.ActiveProfile)
+	// Not being logged in isn't fatal: the plugin may not need the API, and
+	// "$BASECAMP_CLI auth token" reports the problem if it does.
+	if app.Auth != nil {
+		if token, err := app.Auth.AccessToken(ctx); err == nil {
+			add("BASECAMP_PLUGIN_TOKEN", token)
+		}
+	}
+	return env
+}
+
+// isBuiltinCommand reports whether name is a root command or alias.
+func isBuiltinCommand(root *cobra.Command, name string) bool {
+	for _, c := range root.Commands() {
+		if c.Name() == name || c.HasAlias(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// findPlugins lists the basecamp-<name> executables in the PATH
+// directories, first one wins per name as with exec.LookPath.
+func findPlugins(root *cobra.Command, pathEnv string) []pluginInfo {
+	seen := make(map[string]bool)
+	var plugins []pluginInfo
+	for _, dir := range filepath.SplitList(pathEnv) {
+		if dir == "" {
+			continue
+		}
+		entries, err := os.ReadDir(dir)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name, ok := pluginName(e.Name())
+			if !ok || seen[name] {
+				continue
+			}
+			path := filepath.Join(dir, e.Name())
+			if !isExecutableFile(path) {
+				continue
+			}
+			seen[name] = true
+			plugins = append(plugins, pluginInfo{
+				Name:     name,
+				Path:     path,
+				Shadowed: name == "help" || isBuiltinCommand(root, name),
+			})
+		}
+	}
+	sort.Slice(plugins, func(i, j int) bool { return plugins[i].Name < plugins[j].Name })
+	return plugins
+}
+
+// pluginName returns the command name for a plugin file name.
+func pluginName(file string) (string, bool) {
+	if runtime.GOOS == "windows" {
+		file = strings.TrimSuffix(file, filepath.Ext(file))
+	}
+	name, ok := strings.CutPrefix(file, pluginPrefix)
+	return name, ok && pluginNameRe.MatchString(name)
+}
+
+func isExecutableFile(path string) bool {
+	fi, err := os.Stat(path)
+	if err != nil || fi.IsDir() {
+		return false
+	}
+	if runtime.GOOS == "windows" {
+		_, err := exec.LookPath(path)
+		return err == nil
+	}
+	return fi.Mode()&0o111 != 0
+}
+
+// NewPluginsCmd creates the plugins command.
+func NewPluginsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "plugins",
+		Short: "List plugins on PATH",
+		Long: `List plugins: executables named basecamp-<name> on PATH, which run as
+"basecamp <name> [args]". Built-in commands take precedence, so a plugin
+with a built-in's name is listed as shadowed and never runs.
+
+A plugin receives all of its arguments untouched, the CLI's stdin, stdout,
+and stderr, and these environment variables:
+
+  BASECAMP_PLUGIN_API   Version of this contract (1)
+  BASECAMP_CLI          Path to this basecamp binary, for calling back
+  BASECAMP_PLUGIN_TOKEN Access token, when logged in
+  BASECAMP_BASE_URL     API base URL
+  BASECAMP_ACCOUNT_ID   Resolved account (from profile, environment, or config)
+  BASECAMP_PROJECT_ID   Resolved default project, if any
+  BASECAMP_TODOLIST_ID  Resolved default todolist, if any
+  BASECAMP_PROFILE      Active profile, if any
+
+The plugin name must come first; every flag after it goes to the plugin.
+A long-running plugin should fetch a fresh token with
+"$BASECAMP_CLI auth token" rather than hold on to BASECAMP_PLUGIN_TOKEN.`,
+		Example: `  basecamp plugins
+  basecamp standup --since yesterday   # runs basecamp-standup from PATH`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if app == nil {
+				return fmt.Errorf("app not initialized")
+			}
+
+			plugins := findPlugins(cmd.Root(), os.Getenv("PATH"))
+			if plugins == nil {
+				plugins = []pluginInfo{}
+			}
+			summary := fmt.Sprintf("%d plugins on PATH", len(plugins))
+			if len(plugins) == 1 {
+				summary = "1 plugin on PATH"
+			}
+
+			var crumbs []output.Breadcrumb
+			for _, p := range plugins {
+				if !p.Shadowed {
+					crumbs = append(crumbs, output.Breadcrumb{
+						Action:      "run",
+						Cmd:         "basecamp " + p.Name,
+						Description: "Run the " + p.Name + " plugin",
+					})
+					break
+				}
+			}
+			return app.OK(plugins,
+				output.WithSummary(summary),
+				output.WithBreadcrumbs(crumbs...),
+			)
+		},
+	}
+}
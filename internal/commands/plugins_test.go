@@ -0,0 +1,182 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/auth"
+	"github.com/basecamp/basecamp-cli/internal/config"
+)
+
+// writePlugin puts an executable shell script named file in dir.
+func writePlugin(t *testing.T, dir, file, script string) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, file), []byte("#!/bin/sh\n"+script), 0o755)) //nolint:gosec // G306: test plugin must be executable
+}
+
+func pluginTestRoot() *cobra.Command {
+	root := &cobra.Command{Use: "basecamp"}
+	root.AddCommand(&cobra.Command{Use: "todos", Aliases: []string{"todo"}})
+	return root
+}
+
+func TestPluginRunsWithContextEnv(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script plugins")
+	}
+	dir := t.TempDir()
+	writePlugin(t, dir, "basecamp-hello", `echo "$BASECAMP_PLUGIN_API $BASECAMP_ACCOUNT_ID $BASECAMP_PROJECT_ID $BASECAMP_PLUGIN_TOKEN $*"`+"\n")
+	writePlugin(t, dir, "basecamp-fail", "echo nope >&2\nexit 3\n")
+	t.Setenv("PATH", dir)
+	t.Setenv("BASECAMP_TOKEN", "tok")
+
+	app, _ := setupTestApp(t)
+	app.Config.ProjectID = "456"
+	root := pluginTestRoot()
+
+	plugin := NewPluginCmd(root, []string{"hello", "--in", "x"})
+	require.NotNil(t, plugin)
+	root.AddCommand(plugin)
+
+	var out bytes.Buffer
+	root.SetOut(&out)
+	root.SetContext(appctx.WithApp(context.Background(), app))
+	root.SetArgs([]string{"hello", "--in", "x"})
+	require.NoError(t, root.Execute())
+	assert.Equal(t, "1 99999 456 tok --in x\n", out.String(), "flags after the name go to the plugin")
+
+	fail := NewPluginCmd(root, []string{"fail"})
+	require.NotNil(t, fail)
+	root.AddCommand(fail)
+	root.SetArgs([]string{"fail"})
+	root.SetErr(&bytes.Buffer{})
+	err := root.Execute()
+	var exitErr *PluginExitError
+	require.ErrorAs(t, err, &exitErr)
+	assert.Equal(t, 3, exitErr.Code)
+}
+
+func TestPluginTokenCallbackRefreshes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script plugins")
+	}
+	// Each refresh hands out the next token, still inside the expiry window,
+	// so every AccessToken call refreshes.
+	refreshes := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		refreshes++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"access_token": "fresh-%d", "refresh_token": "r", "expires_in": 60}`, refreshes)
+	}))
+	defer srv.Close()
+
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	t.Setenv("BASECAMP_TOKEN", "")
+	app, _ := setupTestApp(t)
+	app.Config.BaseURL = "https://3.basecampapi.com"
+	app.Auth = auth.NewManager(app.Config, srv.Client())
+	require.NoError(t, auth.NewStore(config.GlobalConfigDir()).Save("https://3.basecampapi.com", &auth.Credentials{
+		AccessToken:   "stale",
+		RefreshToken:  "r",
+		ExpiresAt:     time.Now().Add(time.Minute).Unix(),
+		OAuthType:     "launchpad",
+		TokenEndpoint: srv.URL + "/authorization/token",
+	}))
+
+	// The plugin records its environment; the test then plays the plugin's
+	// "$BASECAMP_CLI auth token" call with that environment.
+	dir := t.TempDir()
+	envFile := filepath.Join(dir, "env")
+	writePlugin(t, dir, "basecamp-envdump", `env > "`+envFile+`"`+"\n")
+	t.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	root := pluginTestRoot()
+	plugin := NewPluginCmd(root, []string{"envdump"})
+	require.NotNil(t, plugin)
+	root.AddCommand(plugin)
+	root.SetContext(appctx.WithApp(context.Background(), app))
+	root.SetArgs([]string{"envdump"})
+	require.NoError(t, root.Execute())
+
+	data, err := os.ReadFile(envFile)
+	require.NoError(t, err)
+	env := map[string]string{}
+	for _, line := range strings.Split(string(data), "\n") {
+		if key, value, ok := strings.Cut(line, "="); ok && strings.HasPrefix(key, "BASECAMP_") {
+			env[key] = value
+		}
+	}
+	assert.Equal(t, "fresh-1", env["BASECAMP_PLUGIN_TOKEN"])
+	assert.Empty(t, env["BASECAMP_TOKEN"], "a plugin's callbacks must not be pinned to the token it started with")
+	for key, value := range env {
+		t.Setenv(key, value)
+	}
+
+	token, err := auth.NewManager(app.Config, srv.Client()).AccessToken(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, "fresh-2", token)
+}
+
+func TestPluginNeverShadowsBuiltins(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script plugins")
+	}
+	dir := t.TempDir()
+	writePlugin(t, dir, "basecamp-todos", "exit 0\n")
+	writePlugin(t, dir, "basecamp-todo", "exit 0\n")
+	t.Setenv("PATH", dir)
+	root := pluginTestRoot()
+
+	assert.Nil(t, NewPluginCmd(root, []string{"todos"}))
+	assert.Nil(t, NewPluginCmd(root, []string{"todo"}), "aliases count as built in")
+	assert.Nil(t, NewPluginCmd(root, []string{"missing"}))
+	assert.Nil(t, NewPluginCmd(root, []string{"--json", "todos"}))
+	assert.Nil(t, NewPluginCmd(root, nil))
+}
+
+func TestPluginsList(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("shell script plugins")
+	}
+	first, second := t.TempDir(), t.TempDir()
+	writePlugin(t, first, "basecamp-standup", "exit 0\n")
+	writePlugin(t, first, "basecamp-todos", "exit 0\n")
+	writePlugin(t, second, "basecamp-standup", "exit 0\n")
+	writePlugin(t, second, "basecamp-zeta", "exit 0\n")
+	require.NoError(t, os.WriteFile(filepath.Join(second, "basecamp-notes"), []byte("not executable"), 0o644))
+	t.Setenv("PATH", first+string(os.PathListSeparator)+second)
+
+	app, buf := setupTestApp(t)
+	root := pluginTestRoot()
+	root.AddCommand(NewPluginsCmd())
+	root.SetContext(appctx.WithApp(context.Background(), app))
+	root.SetArgs([]string{"plugins"})
+	require.NoError(t, root.Execute())
+
+	var resp struct {
+		Data    []pluginInfo `json:"data"`
+		Summary string       `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "3 plugins on PATH", resp.Summary)
+	assert.Equal(t, []pluginInfo{
+		{Name: "standup", Path: filepath.Join(first, "basecamp-standup")},
+		{Name: "todos", Path: filepath.Join(first, "basecamp-todos"), Shadowed: true},
+		{Name: "zeta", Path: filepath.Join(second, "basecamp-zeta")},
+	}, resp.Data)
+}
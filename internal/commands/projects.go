@@ -1,12 +1,14 @@
 package commands
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"slices"
 	"sort"
 	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/spf13/cobra"
 
@@ -42,19 +44,30 @@ func NewProjectsCmd() *cobra.Command {
 	return cmd
 }
 
+// projectStatuses are the values the projects API accepts for status.
+var projectStatuses = []string{"active", "archived", "trashed"}
+
+// projectsMineConcurrency bounds parallel project people fetches for --mine.
+const projectsMineConcurrency = 5
+
 func newProjectsListCmd() *cobra.Command {
 	var status string
 	var limit, page int
 	var all bool
 	var sortField string
 	var reverse bool
+	var mine bool
 
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List projects",
-		Long:  "List all accessible projects in the account.",
+		Long: `List all accessible projects in the account.
+
+--mine keeps only projects you are a member of; it checks each project's
+people, so it is slower on large accounts. --page 1 fetches a single page of
+results; the total project count is reported in meta.total_count.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runProjectsList(cmd, status, limit, page, all, sortField, reverse)
+			return runProjectsList(cmd, status, limit, page, all, sortField, reverse, mine)
 		},
 	}
 
@@ -62,13 +75,14 @@ func newProjectsListCmd() *cobra.Command {
 	cmd.Flags().IntVarP(&limit, "limit", "n", 0, "Maximum number of projects to fetch (0 = all)")
 	cmd.Flags().BoolVar(&all, "all", false, "Fetch all projects (no limit)")
 	cmd.Flags().IntVar(&page, "page", 0, "Fetch a single page (use --all for everything)")
-	cmd.Flags().StringVar(&sortField, "sort", "", "Sort by field (title, created, updated)")
+	cmd.Flags().StringVar(&sortField, "sort", "", "Sort by field (name, created, updated)")
 	cmd.Flags().BoolVar(&reverse, "reverse", false, "Reverse sort order")
+	cmd.Flags().BoolVar(&mine, "mine", false, "Only projects you are a member of")
 
 	return cmd
 }
 
-func runProjectsList(cmd *cobra.Command, status string, limit, page int, all bool, sortField string, reverse bool) error {
+func runProjectsList(cmd *cobra.Command, status string, limit, page int, all bool, sortField string, reverse, mine bool) error {
 	app := appctx.FromContext(cmd.Context())
 	if app == nil {
 		return fmt.Errorf("app not initialized")
@@ -81,11 +95,22 @@ func runProjectsList(cmd *cobra.Command, status string, limit, page int, all boo
 	if page > 0 && (all || limit > 0) {
 		return output.ErrUsage("--page cannot be combined with --all or --limit")
 	}
+	if page < 0 {
+		return output.ErrUsage("--page must be a positive number")
+	}
 	if page > 1 {
-		return output.ErrUsage("only --page 1 is supported; use --all to fetch everything")
+		// The SDK doesn't honor the page number yet (see API-COVERAGE.md).
+		return output.ErrUsageHint("only --page 1 is supported; use --all to fetch everything",
+			"meta.total_count on page 1 has the account's project count")
+	}
+	if status != "" && !slices.Contains(projectStatuses, status) {
+		return output.ErrUsage(fmt.Sprintf("invalid status %q; valid values: %s", status, strings.Join(projectStatuses, ", ")))
+	}
+	if sortField == "title" { // accepted for compatibility with earlier releases
+		sortField = "name"
 	}
 	if sortField != "" {
-		if err := validateSortField(sortField, []string{"title", "created", "updated"}); err != nil {
+		if err := validateSortField(sortField, []string{"name", "created", "updated"}); err != nil {
 			return err
 		}
 	}
@@ -114,8 +139,17 @@ func runProjectsList(cmd *cobra.Command, status string, limit, page int, all boo
 	if err != nil {
 		return convertSDKError(err)
 	}
-
-	projects := result.Projects
+	projects, meta := result.Projects, result.Meta
+	fetched := len(projects)
+
+	var failed []string
+	if mine {
+		me, err := app.Account().People().Me(cmd.Context())
+		if err != nil {
+			return convertSDKError(err)
+		}
+		projects, failed = filterMyProjects(cmd.Context(), app, projects, me.ID)
+	}
 
 	if sortField != "" {
 		sortProjects(projects, sortField, reverse)
@@ -135,14 +169,17 @@ func runProjectsList(cmd *cobra.Command, status string, limit, page int, all boo
 	// Only cache when listing all active projects (no filter/pagination), as filtered
 	// results wouldn't be suitable for general-purpose completion.
 	// Done synchronously to ensure write completes before process exits.
-	if status == "" && page == 0 && (limit == 0 || all) {
+	if status == "" && !mine && page == 0 && (limit == 0 || all) {
 		updateProjectsCache(projects, app.Config.CacheDir)
 	}
 
 	// Build summary with total count if available
 	summary := fmt.Sprintf("%d projects", len(projects))
-	if result.Meta.TotalCount > 0 && result.Meta.TotalCount != len(projects) {
-		summary = fmt.Sprintf("%d of %d projects", len(projects), result.Meta.TotalCount)
+	switch {
+	case mine:
+		summary = fmt.Sprintf("%d of %d projects you're on", len(projects), fetched)
+	case meta.TotalCount > 0 && meta.TotalCount != len(projects):
+		summary = fmt.Sprintf("%d of %d projects", len(projects), meta.TotalCount)
 	}
 
 	respOpts := []output.ResponseOption{
@@ -161,15 +198,69 @@ func runProjectsList(cmd *cobra.Command, status string, limit, page int, all boo
 			},
 		),
 	}
+	respOpts = append(respOpts, listPagination(meta, page, fetched))
 
-	// Add truncation notice if results were truncated (using API's total count)
-	if notice := output.TruncationNoticeWithTotal(len(projects), result.Meta.TotalCount); notice != "" {
+	// Add truncation notice if results were truncated.
+	var notice string
+	if !mine {
+		notice = listTruncationNotice(len(projects), meta, page)
+	}
+	if len(failed) > 0 {
+		notice = fmt.Sprintf("Skipped %d projects whose people could not be read: %s", len(failed), strings.Join(failed, ", "))
+	}
+	if notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
 	return app.OK(projects, respOpts...)
 }
 
+// filterMyProjects keeps the projects whose people include personID, in
+// their original order. Projects whose people fail to load are dropped and
+// returned by name rather than failing the whole listing.
+func filterMyProjects(ctx context.Context, app *appctx.App, projects []basecamp.Project, personID int64) ([]basecamp.Project, []string) {
+	member := make([]bool, len(projects))
+	errs := make([]error, len(projects))
+	sem := make(chan struct{}, projectsMineConcurrency)
+	var wg sync.WaitGroup
+
+	for i, p := range projects {
+		wg.Add(1)
+		go func(i int, p basecamp.Project) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result, err := app.Account().People().ListProjectPeople(ctx, p.ID, nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			member[i] = slices.ContainsFunc(result.People, func(person basecamp.Person) bool {
+				return person.ID == personID
+			})
+		}(i, p)
+	}
+	wg.Wait()
+
+	kept := make([]basecamp.Project, 0, len(projects))
+	var failed []string
+	for i, p := range projects {
+		if errs[i] != nil {
+			name := p.Name
+			if name == "" {
+				name = fmt.Sprintf("#%d", p.ID)
+			}
+			failed = append(failed, name)
+			continue
+		}
+		if member[i] {
+			kept = append(kept, p)
+		}
+	}
+	return kept, failed
+}
+
 // updateProjectsCache updates the completion cache with fresh project data.
 // Runs synchronously; errors are ignored (best-effort).
 func updateProjectsCache(projects []basecamp.Project, cacheDir string) {
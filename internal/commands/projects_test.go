@@ -150,3 +150,134 @@ type projectUpdateEnvelope struct {
 		UpdatedAt   string `json:"updated_at"`
 	} `json:"data"`
 }
+
+// mockProjectsListTransport serves /projects.json pages, the current user's
+// profile, and per-project people for the --mine filter.
+type mockProjectsListTransport struct {
+	queries []string
+	members map[int64][]int64 // project ID -> person IDs
+	failing map[int64]bool
+}
+
+func (t *mockProjectsListTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	path := req.URL.Path
+	switch {
+	case strings.HasSuffix(path, "/projects.json"):
+		t.queries = append(t.queries, req.URL.RawQuery)
+		header.Set("X-Total-Count", "3")
+		return jsonResponse(200, `[{"id":1,"name":"beta","status":"active"},{"id":2,"name":"Alpha","status":"active"}]`, header), nil
+	case strings.HasSuffix(path, "/my/profile.json"):
+		return jsonResponse(200, `{"id":7,"name":"Me"}`, header), nil
+	case strings.HasSuffix(path, "/people.json"):
+		var id int64
+		if _, err := fmt.Sscanf(path[strings.Index(path, "/projects/"):], "/projects/%d/people.json", &id); err != nil {
+			return nil, fmt.Errorf("unexpected people path: %s", path)
+		}
+		if t.failing[id] {
+			return jsonResponse(500, `{"error":"boom"}`, header), nil
+		}
+		people := make([]string, 0, len(t.members[id]))
+		for _, pid := range t.members[id] {
+			people = append(people, fmt.Sprintf(`{"id":%d,"name":"P%d"}`, pid, pid))
+		}
+		return jsonResponse(200, "["+strings.Join(people, ",")+"]", header), nil
+	default:
+		return nil, fmt.Errorf("unexpected request path: %s", path)
+	}
+}
+
+type projectsListEnvelope struct {
+	Data []struct {
+		ID   int64  `json:"id"`
+		Name string `json:"name"`
+	} `json:"data"`
+	Summary string         `json:"summary"`
+	Notice  string         `json:"notice"`
+	Meta    map[string]any `json:"meta"`
+}
+
+func runProjectsListTest(t *testing.T, transport *mockProjectsListTransport, args ...string) (projectsListEnvelope, error) {
+	t.Helper()
+	app, out := setupProjectsMockApp(t, transport)
+	app.Config.CacheDir = t.TempDir()
+
+	var envelope projectsListEnvelope
+	err := executeCommand(NewProjectsCmd(), app, append([]string{"list"}, args...)...)
+	if err == nil {
+		require.NoError(t, json.Unmarshal(out.Bytes(), &envelope))
+	}
+	return envelope, err
+}
+
+func TestProjectsListReportsTotalCountInMeta(t *testing.T) {
+	envelope, err := runProjectsListTest(t, &mockProjectsListTransport{}, "--page", "1")
+	require.NoError(t, err)
+
+	assert.Len(t, envelope.Data, 2)
+	assert.EqualValues(t, 3, envelope.Meta["total_count"])
+	assert.EqualValues(t, 1, envelope.Meta["page"])
+	assert.Equal(t, "2 of 3 projects", envelope.Summary)
+}
+
+func TestProjectsListRefusesLaterPages(t *testing.T) {
+	transport := &mockProjectsListTransport{}
+	_, err := runProjectsListTest(t, transport, "--page", "2", "--status", "archived")
+
+	var outErr *output.Error
+	require.ErrorAs(t, err, &outErr)
+	assert.Equal(t, output.CodeUsage, outErr.Code)
+	assert.Contains(t, outErr.Message, "only --page 1 is supported")
+	assert.Empty(t, transport.queries, "no request is made for a page the SDK can't fetch")
+}
+
+func TestProjectsListSortByName(t *testing.T) {
+	for _, field := range []string{"name", "title"} {
+		envelope, err := runProjectsListTest(t, &mockProjectsListTransport{}, "--sort", field, "--reverse")
+		require.NoError(t, err, field)
+		require.Len(t, envelope.Data, 2)
+		assert.Equal(t, "beta", envelope.Data[0].Name, field)
+		assert.Equal(t, "Alpha", envelope.Data[1].Name, field)
+	}
+}
+
+func TestProjectsListMine(t *testing.T) {
+	transport := &mockProjectsListTransport{members: map[int64][]int64{1: {5, 7}, 2: {5}}}
+	envelope, err := runProjectsListTest(t, transport, "--mine")
+	require.NoError(t, err)
+
+	require.Len(t, envelope.Data, 1)
+	assert.Equal(t, int64(1), envelope.Data[0].ID)
+	assert.Equal(t, "1 of 2 projects you're on", envelope.Summary)
+}
+
+func TestProjectsListMineSkipsUnreadableProjects(t *testing.T) {
+	transport := &mockProjectsListTransport{
+		members: map[int64][]int64{2: {7}},
+		failing: map[int64]bool{1: true},
+	}
+	envelope, err := runProjectsListTest(t, transport, "--mine")
+	require.NoError(t, err)
+
+	require.Len(t, envelope.Data, 1)
+	assert.Equal(t, "Alpha", envelope.Data[0].Name)
+	assert.Contains(t, envelope.Notice, "beta")
+}
+
+func TestProjectsListRejectsInvalidFlags(t *testing.T) {
+	tests := map[string][]string{
+		"status":   {"--status", "deleted"},
+		"sort":     {"--sort", "size"},
+		"negative": {"--page", "-1"},
+	}
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := runProjectsListTest(t, &mockProjectsListTransport{}, args...)
+			var outErr *output.Error
+			require.ErrorAs(t, err, &outErr)
+			assert.Equal(t, output.CodeUsage, outErr.Code)
+		})
+	}
+}
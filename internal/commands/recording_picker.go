@@ -0,0 +1,42 @@
+package commands
+
+import (
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+)
+
+// pickRecordingArg stands in for a missing <id|url> argument on a terminal:
+// it offers the recording picker (items recently opened in the TUI, then the
+// project's latest) and returns the picked ID. ok is false when there's no
+// one to ask, and the caller reports the missing argument as before.
+func pickRecordingArg(cmd *cobra.Command, app *appctx.App, title string) (id string, ok bool, err error) {
+	if app == nil || !app.IsInteractive() || !stdinIsTerminal() {
+		return "", false, nil
+	}
+	if err := ensureAccount(cmd, app); err != nil {
+		return "", true, err
+	}
+
+	// --in is optional here: without a project only recent items are offered.
+	project, _ := cmd.Flags().GetString("project")
+	if project == "" {
+		project = app.Flags.Project
+	}
+	if project == "" {
+		project = app.Config.ProjectID
+	}
+	if project != "" {
+		if project, _, err = app.Names.ResolveProject(cmd.Context(), project); err != nil {
+			return "", true, err
+		}
+	}
+
+	target, err := app.Resolve().Recording(cmd.Context(), title, project)
+	if err != nil {
+		return "", true, err
+	}
+	return strconv.FormatInt(target.RecordingID, 10), true, nil
+}
@@ -1,7 +1,10 @@
 package commands
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
 
@@ -25,8 +28,9 @@ func NewRecordingsCmd() *cobra.Command {
 	var assignee string
 
 	cmd := &cobra.Command{
-		Use:   "recordings [type]",
-		Short: "Browse content across projects",
+		Use:     "recordings [type]",
+		Aliases: []string{"recording"},
+		Short:   "Browse content across projects",
 		Long: `Browse content across projects by type.
 
 Provides filtered view of content across all projects.
@@ -69,8 +73,8 @@ Type is required: todos, messages, documents, comments, cards, uploads.`,
 		},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Filter by project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Filter by project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.Flags().StringVarP(&recordingType, "type", "t", "", "Content type (todo, message, document, comment, card, upload)")
 	cmd.Flags().StringVarP(&status, "status", "s", "active", "Status filter (active, trashed, archived)")
@@ -84,6 +88,7 @@ Type is required: todos, messages, documents, comments, cards, uploads.`,
 
 	cmd.AddCommand(
 		newRecordingsListCmd(&project),
+		newRecordingsShowCmd(&project),
 		newRecordingsTrashCmd(),
 		newRecordingsArchiveCmd(),
 		newRecordingsRestoreCmd(),
@@ -253,14 +258,147 @@ func runRecordingsList(cmd *cobra.Command, app *appctx.App, recordingType, proje
 		),
 	}
 
+	respOpts = append(respOpts, listPagination(recordingsResult.Meta, page, len(recordings)))
+
 	// Add truncation notice if results may be limited
-	if notice := output.TruncationNoticeWithTotal(len(recordings), recordingsResult.Meta.TotalCount); notice != "" {
+	if notice := listTruncationNotice(len(recordings), recordingsResult.Meta, page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
 	return app.OK(recordings, respOpts...)
 }
 
+func newRecordingsShowCmd(project *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show <id|url>",
+		Short: "Show any item through the generic recordings endpoint",
+		Long: `Show any item by ID without knowing its type.
+
+The item is fetched from the generic recordings endpoint, its type is read
+from the response, and it is re-fetched from the endpoint for that type so
+it displays like the type's own show command. With --in the lookup is
+limited to that project.
+
+  basecamp recording show 789
+  basecamp recording show 789 --in my-project
+  basecamp recording show https://3.basecamp.com/123/buckets/456/recordings/789`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			return runRecordingsShow(cmd, app, args[0], *project)
+		},
+	}
+	return cmd
+}
+
+func runRecordingsShow(cmd *cobra.Command, app *appctx.App, arg, project string) error {
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	recordingIDStr, urlProjectID := extractWithProject(arg)
+	if _, err := strconv.ParseInt(recordingIDStr, 10, 64); err != nil {
+		return output.ErrUsage("Invalid ID")
+	}
+	if project == "" {
+		project = urlProjectID
+	}
+
+	endpoint := fmt.Sprintf("/recordings/%s.json", recordingIDStr)
+	if project != "" {
+		projectID, _, err := app.Names.ResolveProject(cmd.Context(), project)
+		if err != nil {
+			return err
+		}
+		endpoint = fmt.Sprintf("/buckets/%s/recordings/%s.json", projectID, recordingIDStr)
+	}
+
+	data, err := getRecordingData(cmd, app, endpoint)
+	if err != nil {
+		return err
+	}
+	if data == nil {
+		return output.ErrNotFound("recording", recordingIDStr)
+	}
+
+	// The generic endpoint returns sparse data; the type's own endpoint has
+	// everything its presenter schema shows.
+	if typed := recordingTypeEndpoint(data, recordingIDStr); typed != "" {
+		if richer, err := getRecordingData(cmd, app, typed); err == nil && richer != nil {
+			data = richer
+		}
+	}
+
+	recordingType, _ := data["type"].(string)
+	itemType := recordingType
+	if itemType == "" {
+		itemType = "Item"
+	}
+	title := ""
+	for _, key := range []string{"title", "name", "subject", "content"} {
+		if v, ok := data[key].(string); ok && v != "" {
+			title = v
+			break
+		}
+	}
+	if len(title) > 60 {
+		title = title[:57] + "..."
+	}
+
+	return app.OK(data,
+		output.WithEntity(recordingSchemaEntity(recordingType)),
+		output.WithSummary(fmt.Sprintf("%s #%s: %s", itemType, recordingIDStr, title)),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "comment",
+				Cmd:         fmt.Sprintf("basecamp comments create %s <text>", recordingIDStr),
+				Description: "Add comment",
+			},
+			output.Breadcrumb{
+				Action:      "trash",
+				Cmd:         fmt.Sprintf("basecamp recordings trash %s", recordingIDStr),
+				Description: "Move to trash",
+			},
+		),
+	)
+}
+
+// getRecordingData fetches a recording as a generic map. Returns nil data
+// when the API answers 204 No Content, which it does for recordings the
+// endpoint doesn't know.
+func getRecordingData(cmd *cobra.Command, app *appctx.App, endpoint string) (map[string]any, error) {
+	resp, err := app.Account().Get(cmd.Context(), endpoint)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return nil, nil
+	}
+
+	// UseNumber keeps IDs exact through the map round-trip.
+	var data map[string]any
+	dec := json.NewDecoder(bytes.NewReader(resp.Data))
+	dec.UseNumber()
+	if err := dec.Decode(&data); err != nil {
+		return nil, err
+	}
+	return data, nil
+}
+
+// recordingSchemaEntity names the presenter schema for an API recording
+// type. Types whose schema type_key matches exactly need no hint; this
+// covers the namespaced variants of the same entities.
+func recordingSchemaEntity(apiType string) string {
+	switch {
+	case apiType == "Todolist::Todo":
+		return "todo"
+	case strings.HasPrefix(apiType, "Chat::Lines::"):
+		return "chat_line"
+	default:
+		return ""
+	}
+}
+
 func newRecordingsTrashCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "trash <id|url>",
@@ -332,6 +470,17 @@ func runRecordingsStatus(cmd *cobra.Command, app *appctx.App, recordingIDStr, ne
 		return output.ErrUsage("Invalid ID")
 	}
 
+	prompt, kind := fmt.Sprintf("Restore #%d?", recordingID), confirmChange
+	switch newStatus {
+	case "trashed":
+		prompt, kind = fmt.Sprintf("Move #%d to trash?", recordingID), confirmDestructive
+	case "archived":
+		prompt = fmt.Sprintf("Archive #%d?", recordingID)
+	}
+	if !confirmAction(cmd, kind, prompt, false) {
+		return nil
+	}
+
 	// Call appropriate SDK method based on status
 	switch newStatus {
 	case "trashed":
@@ -391,52 +540,13 @@ You can pass either an ID or a Basecamp URL:
   basecamp recordings visibility https://3.basecamp.com/123/buckets/456/recordings/789 --visible`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			app := appctx.FromContext(cmd.Context())
-
-			if err := ensureAccount(cmd, app); err != nil {
-				return err
-			}
-
-			// Extract ID from URL if provided
-			recordingIDStr := extractID(args[0])
-
-			recordingID, err := strconv.ParseInt(recordingIDStr, 10, 64)
-			if err != nil {
-				return output.ErrUsage("Invalid ID")
-			}
-
-			// Determine visibility
-			var isVisible bool
 			if visible && hidden {
 				return output.ErrUsage("Cannot specify both --visible and --hidden")
 			}
 			if !visible && !hidden {
 				return output.ErrUsage("Must specify --visible or --hidden")
 			}
-			isVisible = visible
-
-			recording, err := app.Account().Recordings().SetClientVisibility(cmd.Context(), recordingID, isVisible)
-			if err != nil {
-				return convertSDKError(err)
-			}
-
-			var summary string
-			if isVisible {
-				summary = fmt.Sprintf("#%s now visible to clients", recordingIDStr)
-			} else {
-				summary = fmt.Sprintf("#%s now hidden from clients", recordingIDStr)
-			}
-
-			return app.OK(recording,
-				output.WithSummary(summary),
-				output.WithBreadcrumbs(
-					output.Breadcrumb{
-						Action:      "show",
-						Cmd:         fmt.Sprintf("basecamp show %s", recordingIDStr),
-						Description: "View item",
-					},
-				),
-			)
+			return runSetClientVisibility(cmd, args[0], visible)
 		},
 	}
 
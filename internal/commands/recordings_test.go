@@ -3,7 +3,11 @@ package commands
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
@@ -207,3 +211,65 @@ func TestRecordingsListAssigneeFlagIsHidden(t *testing.T) {
 	require.NotNil(t, flag, "expected --assignee flag to exist on list subcommand")
 	assert.True(t, flag.Hidden, "expected --assignee flag to be hidden on list subcommand")
 }
+
+// recordingShowTransport serves recording 789 in project 456 as a sparse
+// generic record, plus the richer Kanban::Card endpoint for it.
+type recordingShowTransport struct {
+	paths []string
+}
+
+func (t *recordingShowTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.paths = append(t.paths, req.URL.Path)
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	var body string
+	switch path := req.URL.Path; {
+	case strings.HasSuffix(path, "/projects.json"):
+		body = `[{"id": 456, "name": "Launch"}]`
+	case strings.HasSuffix(path, "/recordings/789.json"):
+		body = `{"id": 789, "type": "Kanban::Card", "title": "Sparse"}`
+	case strings.HasSuffix(path, "/card_tables/cards/789.json"):
+		body = `{"id": 789, "type": "Kanban::Card", "title": "Ship the launch post", "content": "<p>Draft</p>"}`
+	default:
+		return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader("")), Header: header}, nil
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestRecordingsShowRefetchesByDetectedType(t *testing.T) {
+	transport := &recordingShowTransport{}
+	app, buf := setupCommentsWriteTestApp(t, transport)
+
+	err := executeRecordingsCommand(NewRecordingsCmd(), app, "show", "789", "--in", "456")
+	require.NoError(t, err)
+
+	require.GreaterOrEqual(t, len(transport.paths), 2)
+	assert.Contains(t, transport.paths, "/99999/buckets/456/recordings/789.json", "--in scopes the generic lookup")
+	assert.Equal(t, "/99999/card_tables/cards/789.json", transport.paths[len(transport.paths)-1])
+
+	var resp struct {
+		Summary string         `json:"summary"`
+		Data    map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "Kanban::Card #789: Ship the launch post", resp.Summary)
+	assert.Equal(t, "<p>Draft</p>", resp.Data["content"])
+}
+
+func TestRecordingsShowNotFound(t *testing.T) {
+	transport := &recordingShowTransport{}
+	app, _ := setupCommentsWriteTestApp(t, transport)
+
+	err := executeRecordingsCommand(NewRecordingsCmd(), app, "show", "111")
+	var e *output.Error
+	require.True(t, errors.As(err, &e), "got %v", err)
+	assert.Equal(t, output.CodeNotFound, e.Code)
+}
+
+func TestRecordingSchemaEntity(t *testing.T) {
+	assert.Equal(t, "todo", recordingSchemaEntity("Todolist::Todo"))
+	assert.Equal(t, "chat_line", recordingSchemaEntity("Chat::Lines::RichText"))
+	assert.Empty(t, recordingSchemaEntity("Todo"), "exact type keys are detected without a hint")
+}
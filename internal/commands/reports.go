@@ -17,12 +17,13 @@ import (
 // NewReportsCmd creates the reports command for viewing various reports.
 func NewReportsCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "reports",
-		Short: "View reports",
-		Long: `View various reports including assignable people, assigned todos, overdue todos, and upcoming schedule.
+		Use:     "reports",
+		Aliases: []string{"report"},
+		Short:   "View reports",
+		Long: `View various reports including assignable people, assigned todos, overdue todos, upcoming schedule, and time logged on todos.
 
 Reports provide cross-project views of assignments and schedules.`,
-		Annotations: map[string]string{"agent_notes": "Reports are account-wide — no --in <project> needed\nreports assigned is the best way to see what's on my plate across projects\nreports overdue surfaces todos past their due date"},
+		Annotations: map[string]string{"agent_notes": "Reports are account-wide — no --in <project> needed\nreports assigned is the best way to see what's on my plate across projects\nreports overdue surfaces todos past their due date\nreports time totals time logged with todos log-time, per person and per list"},
 	}
 
 	cmd.AddCommand(
@@ -30,6 +31,7 @@ Reports provide cross-project views of assignments and schedules.`,
 		newReportsAssignedCmd(),
 		newReportsOverdueCmd(),
 		newReportsScheduleCmd(),
+		newReportsTimeCmd(),
 	)
 
 	return cmd
@@ -0,0 +1,395 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// playbookStepIDRe keeps step IDs usable as template keys (.steps.<id>.id).
+var playbookStepIDRe = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// playbook is a YAML list of CLI operations run in order by `basecamp run`.
+type playbook struct {
+	Name  string            `yaml:"name"`
+	Vars  map[string]string `yaml:"vars"`
+	Steps []playbookStep    `yaml:"steps"`
+}
+
+// playbookStep is one CLI invocation. Run is a command line ("projects
+// create Launch", optionally prefixed with "basecamp"); Args is the same as a
+// list, for values that are awkward to quote. Each argument is a Go template
+// over {vars, steps}, where steps holds the JSON data of earlier steps by ID.
+type playbookStep struct {
+	ID   string   `yaml:"id"`
+	Name string   `yaml:"name"`
+	Run  string   `yaml:"run"`
+	Args []string `yaml:"args"`
+
+	templates []*template.Template
+}
+
+// playbookResult records a step that ran.
+type playbookResult struct {
+	Step    int    `json:"step"`
+	ID      string `json:"id,omitempty"`
+	Name    string `json:"name,omitempty"`
+	Command string `json:"command"`
+	Data    any    `json:"data,omitempty"`
+}
+
+// playbookEnvelope is the JSON envelope a step prints with --json.
+type playbookEnvelope struct {
+	OK    bool   `json:"ok"`
+	Data  any    `json:"data"`
+	Error string `json:"error"`
+	Code  string `json:"code"`
+	Hint  string `json:"hint"`
+}
+
+// runPlaybookStep runs one step's arguments and returns its stdout.
+// Swappable in tests.
+var runPlaybookStep = func(ctx context.Context, args []string) ([]byte, error) {
+	self, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	cmd := exec.CommandContext(ctx, self, args...) //nolint:gosec // G204: re-invokes this binary with playbook arguments
+	cmd.Env = append(os.Environ(), "BASECAMP_NONINTERACTIVE=1")
+	cmd.Stderr = os.Stderr
+	return cmd.Output()
+}
+
+// NewRunCmd creates the run command for executing playbooks.
+func NewRunCmd() *cobra.Command {
+	var vars []string
+	var dryRun bool
+
+	cmd := &cobra.Command{
+		Use:   "run <playbook.yaml>",
+		Short: "Run a YAML playbook of commands",
+		Long: `Run a playbook: a YAML list of basecamp commands executed in order, for
+repeatable project provisioning.
+
+Each step's arguments are Go templates. {{.vars.<name>}} reads playbook vars
+(override with --var name=value) and {{.steps.<id>}} reads the JSON data of
+an earlier step with that id. The run stops at the first failing step.
+Steps run with this run's --account, --profile, --cache-dir, and --tz.
+
+  name: Provision a launch project
+  vars:
+    project: Launch
+  steps:
+    - id: project
+      run: projects create "{{.vars.project}}"
+    - id: list
+      run: todolists create "Kickoff" --in {{.steps.project.id}}
+    - run: todos create "Book the room" --in {{.steps.project.id}} --list {{.steps.list.id}}
+    - args: [messages, create, "Welcome to {{.vars.project}}", --in, "{{.steps.project.id}}"]
+    - run: people add 123 456 --project {{.steps.project.id}}`,
+		Example: `  basecamp run provision.yaml
+  basecamp run provision.yaml --var project="Q3 Launch"
+  basecamp run provision.yaml --dry-run`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if len(args) == 0 {
+				return missingArg(cmd, "<playbook.yaml>")
+			}
+
+			pb, err := loadPlaybook(args[0])
+			if err != nil {
+				return err
+			}
+			for _, kv := range vars {
+				name, value, ok := strings.Cut(kv, "=")
+				if !ok || name == "" {
+					return output.ErrUsage(fmt.Sprintf("--var must be name=value, got %q", kv))
+				}
+				if pb.Vars == nil {
+					pb.Vars = make(map[string]string)
+				}
+				pb.Vars[name] = value
+			}
+
+			title := pb.Name
+			if title == "" {
+				title = args[0]
+			}
+
+			if dryRun {
+				plan := make([]playbookResult, len(pb.Steps))
+				for i, step := range pb.Steps {
+					plan[i] = playbookResult{Step: i + 1, ID: step.ID, Name: step.Name, Command: step.source()}
+				}
+				return app.OK(plan,
+					output.WithSummary(fmt.Sprintf("%s: %d %s (dry run)", title, len(plan), pluralize(len(plan), "step", "steps"))),
+					output.WithBreadcrumbs(output.Breadcrumb{
+						Action:      "run",
+						Cmd:         "basecamp run " + args[0],
+						Description: "Run the playbook",
+					}),
+				)
+			}
+
+			// Steps run in the same account, profile, and cache as this
+			// run; an account picked from config or a prompt is pinned too.
+			carry := CarryFlags(cmd.Root(), nil, ContextCarryFlags)
+			if !cmd.Root().PersistentFlags().Changed("account") && app.Config.AccountID != "" {
+				carry = append(carry, "--account="+app.Config.AccountID)
+			}
+			results, err := runPlaybook(cmd.Context(), pb, carry)
+			if err != nil {
+				return err
+			}
+
+			return app.OK(results,
+				output.WithSummary(fmt.Sprintf("%s: ran %d %s", title, len(results), pluralize(len(results), "step", "steps"))),
+			)
+		},
+	}
+
+	cmd.Flags().StringArrayVar(&vars, "var", nil, "Set a playbook var (name=value, repeatable)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Validate the playbook and list its steps without running them")
+
+	return cmd
+}
+
+// loadPlaybook reads and validates a playbook, parsing every step template
+// up front so a typo fails before anything runs.
+func loadPlaybook(path string) (*playbook, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: Path is the user's playbook argument
+	if err != nil {
+		return nil, output.ErrUsage(fmt.Sprintf("Can't read playbook: %v", err))
+	}
+	return parsePlaybook(data)
+}
+
+func parsePlaybook(data []byte) (*playbook, error) {
+	var pb playbook
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&pb); err != nil {
+		return nil, output.ErrUsage(fmt.Sprintf("Invalid playbook: %v", err))
+	}
+	if len(pb.Steps) == 0 {
+		return nil, output.ErrUsage("Playbook has no steps")
+	}
+
+	seen := make(map[string]bool)
+	for i := range pb.Steps {
+		step := &pb.Steps[i]
+		label := fmt.Sprintf("step %d", i+1)
+
+		if step.ID != "" {
+			if !playbookStepIDRe.MatchString(step.ID) {
+				return nil, output.ErrUsage(fmt.Sprintf("%s: id %q must be letters, digits, and underscores", label, step.ID))
+			}
+			if seen[step.ID] {
+				return nil, output.ErrUsage(fmt.Sprintf("%s: duplicate id %q", label, step.ID))
+			}
+			seen[step.ID] = true
+		}
+
+		args := step.Args
+		switch {
+		case step.Run != "" && len(step.Args) > 0:
+			return nil, output.ErrUsage(fmt.Sprintf("%s: use run or args, not both", label))
+		case step.Run != "":
+			split, err := splitPlaybookLine(step.Run)
+			if err != nil {
+				return nil, output.ErrUsage(fmt.Sprintf("%s: %v", label, err))
+			}
+			args = split
+		case len(step.Args) == 0:
+			return nil, output.ErrUsage(fmt.Sprintf("%s: needs run or args", label))
+		}
+		if len(args) > 0 && args[0] == "basecamp" {
+			args = args[1:]
+		}
+		if len(args) == 0 {
+			return nil, output.ErrUsage(fmt.Sprintf("%s: empty command", label))
+		}
+		if args[0] == "run" {
+			return nil, output.ErrUsage(fmt.Sprintf("%s: playbooks can't run other playbooks", label))
+		}
+
+		for j, arg := range args {
+			tmpl, err := template.New(fmt.Sprintf("%s arg %d", label, j+1)).Option("missingkey=error").Parse(arg)
+			if err != nil {
+				return nil, output.ErrUsage(fmt.Sprintf("%s: %v", label, err))
+			}
+			step.templates = append(step.templates, tmpl)
+		}
+	}
+	return &pb, nil
+}
+
+// source returns the step as written, for dry runs.
+func (s playbookStep) source() string {
+	if s.Run != "" {
+		return s.Run
+	}
+	return strings.Join(s.Args, " ")
+}
+
+// render expands the step's argument templates. Each template yields exactly
+// one argument, so interpolated values with spaces are never re-split.
+func (s playbookStep) render(data map[string]any) ([]string, error) {
+	args := make([]string, len(s.templates))
+	for i, tmpl := range s.templates {
+		var b strings.Builder
+		if err := tmpl.Execute(&b, data); err != nil {
+			return nil, err
+		}
+		args[i] = b.String()
+	}
+	return args, nil
+}
+
+// runPlaybook runs each step with --json plus the carried global flags,
+// feeding each step's data to later templates. It stops at the first
+// failure and reports how far it got.
+func runPlaybook(ctx context.Context, pb *playbook, carry []string) ([]playbookResult, error) {
+	vars := make(map[string]any, len(pb.Vars))
+	for k, v := range pb.Vars {
+		vars[k] = v
+	}
+	steps := make(map[string]any)
+	data := map[string]any{"vars": vars, "steps": steps}
+
+	var results []playbookResult
+	for i, step := range pb.Steps {
+		fail := func(code, msg, hint string) error {
+			if len(results) > 0 {
+				done := make([]string, len(results))
+				for j, r := range results {
+					done[j] = fmt.Sprintf("%d", r.Step)
+					if r.ID != "" {
+						done[j] += " (" + r.ID + ")"
+					}
+				}
+				msg += fmt.Sprintf("; steps %s already ran", strings.Join(done, ", "))
+			}
+			return &output.Error{Code: code, Message: fmt.Sprintf("Playbook stopped at step %d: %s", i+1, msg), Hint: hint}
+		}
+
+		args, err := step.render(data)
+		if err != nil {
+			return results, fail(output.CodeUsage, err.Error(), "Templates can only read vars and earlier steps' ids")
+		}
+
+		stdout, runErr := runPlaybookStep(ctx, append(append(args, "--json"), carry...))
+		var env playbookEnvelope
+		dec := json.NewDecoder(bytes.NewReader(stdout))
+		dec.UseNumber() // keep IDs as written, not 1.2345e+06
+		decodeErr := dec.Decode(&env)
+
+		command := "basecamp " + strings.Join(args, " ")
+		switch {
+		case decodeErr == nil && !env.OK:
+			code := env.Code
+			if code == "" {
+				code = output.CodeAPI
+			}
+			return results, fail(code, fmt.Sprintf("%s: %s", command, env.Error), env.Hint)
+		case runErr != nil:
+			return results, fail(output.CodeAPI, fmt.Sprintf("%s: %v", command, runErr), "")
+		case decodeErr != nil:
+			return results, fail(output.CodeAPI, fmt.Sprintf("%s: unreadable output: %v", command, decodeErr), "")
+		}
+
+		if step.ID != "" {
+			steps[step.ID] = env.Data
+		}
+		results = append(results, playbookResult{Step: i + 1, ID: step.ID, Name: step.Name, Command: command, Data: env.Data})
+	}
+	return results, nil
+}
+
+// playbookActionRe matches a template action, which may contain spaces and
+// quotes ({{ index .steps.lists 0 }}) but is always one argument's worth.
+var playbookActionRe = regexp.MustCompile(`\{\{.*?\}\}`)
+
+// splitPlaybookLine splits a run line like SplitCommandLine, keeping each
+// {{ template action }} intact within its argument.
+func splitPlaybookLine(line string) ([]string, error) {
+	var actions []string
+	masked := playbookActionRe.ReplaceAllStringFunc(line, func(action string) string {
+		actions = append(actions, action)
+		return fmt.Sprintf("\x00%d\x00", len(actions)-1)
+	})
+	args, err := SplitCommandLine(masked)
+	if err != nil {
+		return nil, err
+	}
+	for i, arg := range args {
+		for j, action := range actions {
+			arg = strings.ReplaceAll(arg, fmt.Sprintf("\x00%d\x00", j), action)
+		}
+		args[i] = arg
+	}
+	return args, nil
+}
+
+// SplitCommandLine splits a command line into arguments, honoring single and
+// double quotes and backslash escapes. Pipelines and command chaining are
+// rejected: the line must be one invocation.
+func SplitCommandLine(line string) ([]string, error) {
+	var (
+		args    []string
+		cur     strings.Builder
+		inToken bool
+		quote   rune
+		escaped bool
+	)
+	for _, r := range line {
+		switch {
+		case escaped:
+			cur.WriteRune(r)
+			escaped = false
+		case r == '\\' && quote != '\'':
+			escaped = true
+			inToken = true
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '\'' || r == '"':
+			quote = r
+			inToken = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inToken {
+				args = append(args, cur.String())
+				cur.Reset()
+				inToken = false
+			}
+		case strings.ContainsRune("|&;`", r):
+			return nil, fmt.Errorf("%q is a shell pipeline, not a single command", line)
+		default:
+			cur.WriteRune(r)
+			inToken = true
+		}
+	}
+	if quote != 0 || escaped {
+		return nil, fmt.Errorf("unterminated quote in %q", line)
+	}
+	if inToken {
+		args = append(args, cur.String())
+	}
+	return args, nil
+}
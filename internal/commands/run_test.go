@@ -0,0 +1,179 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+const testPlaybook = `
+name: Launch
+vars:
+  project: Launch Day
+steps:
+  - id: project
+    run: basecamp projects create "{{ .vars.project }}"
+  - id: list
+    run: todolists create Kickoff --in {{.steps.project.id}}
+  - args: [todos, create, "Book {{ .vars.project }}", --list, "{{.steps.list.id}}"]
+`
+
+// fakePlaybookSteps replaces runPlaybookStep with a function that records
+// each invocation and answers from respond.
+func fakePlaybookSteps(t *testing.T, respond func(args []string) (any, error)) *[][]string {
+	t.Helper()
+	var calls [][]string
+	orig := runPlaybookStep
+	runPlaybookStep = func(_ context.Context, args []string) ([]byte, error) {
+		calls = append(calls, args)
+		data, err := respond(args)
+		if err != nil {
+			out, _ := json.Marshal(map[string]any{"ok": false, "error": err.Error(), "code": output.CodeNotFound})
+			return out, errors.New("exit status 2")
+		}
+		return json.Marshal(map[string]any{"ok": true, "data": data})
+	}
+	t.Cleanup(func() { runPlaybookStep = orig })
+	return &calls
+}
+
+func TestParsePlaybook(t *testing.T) {
+	pb, err := parsePlaybook([]byte(testPlaybook))
+	require.NoError(t, err)
+	require.Len(t, pb.Steps, 3)
+	assert.Equal(t, "Launch Day", pb.Vars["project"])
+	assert.Len(t, pb.Steps[0].templates, 3, "basecamp prefix dropped; template action kept whole")
+	assert.Len(t, pb.Steps[2].templates, 5)
+}
+
+func TestParsePlaybookRejectsInvalid(t *testing.T) {
+	tests := map[string]string{
+		"no steps":      `name: empty`,
+		"unknown key":   "steps:\n  - runn: projects list",
+		"run and args":  "steps:\n  - run: projects list\n    args: [projects, list]",
+		"no command":    "steps:\n  - id: x",
+		"bad id":        "steps:\n  - id: my-project\n    run: projects list",
+		"duplicate id":  "steps:\n  - id: a\n    run: projects list\n  - id: a\n    run: projects list",
+		"pipeline":      "steps:\n  - run: projects list | head",
+		"nested run":    "steps:\n  - run: run other.yaml",
+		"bad template":  "steps:\n  - run: projects show {{.steps.x",
+		"just basecamp": "steps:\n  - run: basecamp",
+	}
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := parsePlaybook([]byte(doc))
+			var e *output.Error
+			require.ErrorAs(t, err, &e)
+			assert.Equal(t, output.CodeUsage, e.Code)
+		})
+	}
+}
+
+func TestRunPlaybookInterpolatesEarlierSteps(t *testing.T) {
+	calls := fakePlaybookSteps(t, func(args []string) (any, error) {
+		switch args[0] {
+		case "projects":
+			return map[string]any{"id": 1234567, "name": args[2]}, nil
+		case "todolists":
+			return map[string]any{"id": 89}, nil
+		}
+		return map[string]any{"id": 1}, nil
+	})
+
+	pb, err := parsePlaybook([]byte(testPlaybook))
+	require.NoError(t, err)
+	results, err := runPlaybook(context.Background(), pb, []string{"--account", "99"})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.Equal(t, []string{"projects", "create", "Launch Day", "--json", "--account", "99"}, (*calls)[0])
+	assert.Equal(t, []string{"todolists", "create", "Kickoff", "--in", "1234567", "--json", "--account", "99"}, (*calls)[1],
+		"IDs interpolate as written, not in float notation")
+	assert.Equal(t, []string{"todos", "create", "Book Launch Day", "--list", "89", "--json", "--account", "99"}, (*calls)[2])
+	assert.Equal(t, "project", results[0].ID)
+	assert.Equal(t, "basecamp todolists create Kickoff --in 1234567", results[1].Command)
+}
+
+func TestRunCarriesGlobalContextFlagsToSteps(t *testing.T) {
+	calls := fakePlaybookSteps(t, func(args []string) (any, error) {
+		return map[string]any{"id": 1}, nil
+	})
+
+	path := filepath.Join(t.TempDir(), "play.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("steps:\n  - run: projects list\n"), 0o600))
+
+	root := &cobra.Command{Use: "basecamp"}
+	for _, name := range []string{"account", "profile", "cache-dir", "tz", "columns"} {
+		root.PersistentFlags().String(name, "", "")
+	}
+	root.AddCommand(NewRunCmd())
+
+	app, _ := setupTestApp(t)
+	app.Config.AccountID = "99"
+	root.SetArgs([]string{"run", path, "--profile", "staging", "--cache-dir", "/tmp/bc", "--columns", "title"})
+	root.SetContext(appctx.WithApp(context.Background(), app))
+	root.SetOut(&bytes.Buffer{})
+	root.SetErr(&bytes.Buffer{})
+	require.NoError(t, root.Execute())
+
+	require.Len(t, *calls, 1)
+	assert.Equal(t, []string{"projects", "list", "--json", "--profile=staging", "--cache-dir=/tmp/bc", "--account=99"}, (*calls)[0])
+}
+
+func TestRunPlaybookStopsOnError(t *testing.T) {
+	calls := fakePlaybookSteps(t, func(args []string) (any, error) {
+		if args[0] == "todolists" {
+			return nil, errors.New("Project not found")
+		}
+		return map[string]any{"id": 5}, nil
+	})
+
+	pb, err := parsePlaybook([]byte(testPlaybook))
+	require.NoError(t, err)
+	results, err := runPlaybook(context.Background(), pb, nil)
+
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, output.CodeNotFound, e.Code)
+	assert.Contains(t, e.Message, "step 2")
+	assert.Contains(t, e.Message, "Project not found")
+	assert.Contains(t, e.Message, "steps 1 (project) already ran")
+	assert.Len(t, results, 1)
+	assert.Len(t, *calls, 2, "later steps don't run")
+}
+
+func TestRunPlaybookUnknownReference(t *testing.T) {
+	fakePlaybookSteps(t, func([]string) (any, error) { return map[string]any{"id": 5}, nil })
+
+	pb, err := parsePlaybook([]byte("steps:\n  - run: projects show {{.steps.later.id}}\n  - id: later\n    run: projects list"))
+	require.NoError(t, err)
+	_, err = runPlaybook(context.Background(), pb, nil)
+
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, output.CodeUsage, e.Code)
+	assert.True(t, strings.HasPrefix(e.Message, "Playbook stopped at step 1"), e.Message)
+}
+
+func TestSplitCommandLine(t *testing.T) {
+	got, err := SplitCommandLine(`basecamp todo "Ship it" --in 42 it\'s ''`)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"basecamp", "todo", "Ship it", "--in", "42", "it's", ""}, got)
+
+	_, err = SplitCommandLine(`basecamp todo "unterminated`)
+	assert.Error(t, err)
+	_, err = SplitCommandLine(`basecamp todos list; rm -rf /`)
+	assert.Error(t, err)
+}
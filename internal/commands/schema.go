@@ -0,0 +1,43 @@
+package commands
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// NewSchemaCmd creates the schema command for publishing output contracts.
+func NewSchemaCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schema",
+		Short: "Show JSON schemas for CLI output",
+		Long:  "Show the JSON Schemas that describe the CLI's machine-readable output.",
+	}
+
+	cmd.AddCommand(newSchemaEnvelopeCmd())
+
+	return cmd
+}
+
+func newSchemaEnvelopeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "envelope",
+		Short: "Show the JSON Schema for the --json envelope",
+		Long: `Print the JSON Schema (draft 2020-12) for the envelope every --json
+response is wrapped in: the success shape (ok, data, summary, notice,
+breadcrumbs, context, meta) and the error shape (ok, error, code, hint, meta).
+
+The schema is printed as-is, not wrapped in an envelope, so it can be saved
+and used to validate output:
+
+  basecamp schema envelope > basecamp-envelope.schema.json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if jq, _ := cmd.Root().PersistentFlags().GetString("jq"); jq != "" {
+				return output.ErrJQNotSupported("the schema command")
+			}
+			_, err := cmd.OutOrStdout().Write(output.EnvelopeSchema())
+			return err
+		},
+	}
+}
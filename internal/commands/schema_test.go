@@ -0,0 +1,109 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/auth"
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/names"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+func TestSchemaEnvelopePrintsSchema(t *testing.T) {
+	app, _ := setupTestApp(t)
+
+	cmd := NewSchemaCmd()
+	var out bytes.Buffer
+	cmd.SetArgs([]string{"envelope"})
+	cmd.SetContext(appctx.WithApp(context.Background(), app))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	require.NoError(t, cmd.Execute())
+
+	assert.Equal(t, string(output.EnvelopeSchema()), out.String())
+	assert.Contains(t, out.String(), `"$schema": "https://json-schema.org/draft/2020-12/schema"`)
+}
+
+// newEnvelopeTestApp serves one project and one todo; everything else 404s.
+func newEnvelopeTestApp(t *testing.T) (*appctx.App, *bytes.Buffer) {
+	t.Helper()
+	t.Setenv("BASECAMP_NO_KEYRING", "1")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.HasSuffix(r.URL.Path, "/projects.json"):
+			fmt.Fprint(w, `[{"id": 123, "name": "Launch", "status": "active"}]`)
+		case strings.Contains(r.URL.Path, "/todos/456"):
+			fmt.Fprint(w, `{"id": 456, "title": "Ship it", "content": "Ship it", "type": "Todo",
+				"bucket": {"id": 123, "name": "Launch"}, "assignees": [{"id": 7, "name": "Ada"}]}`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	buf := &bytes.Buffer{}
+	cfg := &config.Config{AccountID: "99999", ProjectID: "123"}
+	sdkClient := basecamp.NewClient(&basecamp.Config{BaseURL: server.URL}, &chatTestTokenProvider{},
+		basecamp.WithMaxRetries(1),
+	)
+	authMgr := auth.NewManager(cfg, nil)
+	app := &appctx.App{
+		Config: cfg,
+		Auth:   authMgr,
+		SDK:    sdkClient,
+		Names:  names.NewResolver(sdkClient, authMgr, cfg.AccountID),
+		Output: output.New(output.Options{Format: output.FormatJSON, Writer: buf}),
+	}
+	return app, buf
+}
+
+// TestCommandOutputsMatchEnvelopeSchema runs commands across the success and
+// error paths and validates what they print against the published schema.
+func TestCommandOutputsMatchEnvelopeSchema(t *testing.T) {
+	tests := []struct {
+		name    string
+		cmd     func() *cobra.Command
+		args    []string
+		wantErr bool
+	}{
+		{"catalog", NewCommandsCmd, nil, false},
+		{"url parse", NewURLCmd, []string{"parse", "https://3.basecamp.com/99999/buckets/123/todos/456"}, false},
+		{"projects list", NewProjectsCmd, []string{"list"}, false},
+		{"todos show", NewTodosCmd, []string{"show", "456"}, false},
+		{"not found", NewTodosCmd, []string{"show", "789"}, true},
+		{"usage error", NewTodosCmd, []string{"show", "not-an-id"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			app, buf := newEnvelopeTestApp(t)
+
+			err := executeCommand(tt.cmd(), app, tt.args...)
+			if tt.wantErr {
+				require.Error(t, err)
+				require.Empty(t, buf.String(), "a failed command must not have printed an envelope yet")
+				// The root command renders failures through App.Err.
+				require.NoError(t, app.Err(err))
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotEmpty(t, buf.String())
+			assert.NoError(t, output.ValidateEnvelope(buf.Bytes()), buf.String())
+		})
+	}
+}
@@ -90,7 +90,9 @@ Use 'basecamp search metadata' to see available search scopes.`,
 				),
 			}
 
-			if notice := output.TruncationNoticeWithTotal(len(results), searchResult.Meta.TotalCount); notice != "" {
+			respOpts = append(respOpts, listPagination(searchResult.Meta, 0, len(results)))
+
+			if notice := listTruncationNotice(len(results), searchResult.Meta, 0); notice != "" {
 				respOpts = append(respOpts, output.WithNotice(notice))
 			}
 
@@ -255,6 +255,9 @@ You can also pass a Basecamp URL directly:
 				if enrichment.Comments != nil {
 					data["comments"] = enrichment.Comments
 				}
+				if enrichment.History != nil {
+					data["history"] = enrichment.History
+				}
 			}
 
 			// Extract title from various fields
@@ -278,6 +281,9 @@ You can also pass a Basecamp URL directly:
 			if enrichment.CountLabel != "" {
 				summary += fmt.Sprintf(" (%s)", enrichment.CountLabel)
 			}
+			if visible, _ := data["visible_to_clients"].(bool); visible {
+				summary += " [visible to clients]"
+			}
 			breadcrumbs := make([]output.Breadcrumb, 0, 1+len(enrichment.Breadcrumbs))
 			breadcrumbs = append(breadcrumbs, output.Breadcrumb{
 				Action:      "comment",
@@ -285,6 +291,9 @@ You can also pass a Basecamp URL directly:
 				Description: "Add comment",
 			})
 			breadcrumbs = append(breadcrumbs, enrichment.Breadcrumbs...)
+			if bc, ok := clientVisibilityBreadcrumb(id, data); ok {
+				breadcrumbs = append(breadcrumbs, bc)
+			}
 
 			opts := []output.ResponseOption{
 				output.WithSummary(summary),
@@ -421,6 +421,23 @@ func TestShowIncludesCommentsWhenPresent(t *testing.T) {
 	assert.Equal(t, []int{9001, 9002}, []int{decodedComments[0].ID, decodedComments[1].ID})
 }
 
+func TestShowReportsClientVisibility(t *testing.T) {
+	transport := &showTrackingTransport{
+		responder: func(path string) (int, string) {
+			if strings.Contains(path, "/todos/42.json") {
+				return 200, `{"id": 42, "type": "Todo", "title": "Buy milk", "visible_to_clients": true}`
+			}
+			return 200, `[]`
+		},
+	}
+
+	_, stdout, _, err := runShowCmdCapture(t, transport, output.FormatJSON, "todo", "42")
+	require.NoError(t, err)
+
+	envelope := decodeShowJSONEnvelope(t, stdout)
+	assert.Equal(t, "Todo #42: Buy milk [visible to clients]", envelope.Summary)
+}
+
 func TestShowFetchesCommentsEvenWhenCountZero(t *testing.T) {
 	transport := &showTrackingTransport{
 		responder: func(path string) (int, string) {
@@ -597,6 +614,58 @@ func TestShowCommentsDiagnosticPreservesAttachmentNotice(t *testing.T) {
 	assert.Contains(t, stderr, "1 attachment(s) — download: basecamp attachments download 42")
 }
 
+func TestShowHistoryFlagIncludesEvents(t *testing.T) {
+	transport := &showTrackingTransport{
+		responder: func(path string) (int, string) {
+			switch {
+			case strings.Contains(path, "/todos/42.json"):
+				return 200, `{"id": 42, "type": "Todo", "title": "Buy milk"}`
+			case strings.Contains(path, "/recordings/42/events.json"):
+				return 200, `[
+					{"id": 1, "recording_id": 42, "action": "created", "created_at": "2026-03-26T10:00:00Z", "creator": {"name": "Annie Bryan"}},
+					{"id": 2, "recording_id": 42, "action": "completed", "created_at": "2026-03-27T10:00:00Z", "creator": {"name": "Jason Fried"}}
+				]`
+			default:
+				return 200, `{}`
+			}
+		},
+	}
+
+	reqs, stdout, _, err := runShowCmdCapture(t, transport, output.FormatJSON, "todo", "42", "--no-comments", "--history")
+	require.NoError(t, err)
+	require.Len(t, reqs, 2)
+	assert.Contains(t, reqs[1], "/recordings/42/events.json")
+
+	envelope := decodeShowJSONEnvelope(t, stdout)
+	data := decodeShowJSONDataMap(t, envelope.Data)
+	var history []struct {
+		Action string `json:"action"`
+	}
+	require.NoError(t, json.Unmarshal(data["history"], &history))
+	require.Len(t, history, 2)
+	assert.Equal(t, "completed", history[1].Action)
+}
+
+func TestShowHistoryGracefulDegradation(t *testing.T) {
+	transport := &showTrackingTransport{
+		responder: func(path string) (int, string) {
+			switch {
+			case strings.Contains(path, "/todos/42.json"):
+				return 200, `{"id": 42, "type": "Todo", "title": "Buy milk"}`
+			case strings.Contains(path, "/recordings/42/events.json"):
+				return 500, `{"error":"boom"}`
+			default:
+				return 200, `[]`
+			}
+		},
+	}
+
+	_, stdout, stderr, err := runShowCmdCapture(t, transport, output.FormatQuiet, "todo", "42", "--history")
+	require.NoError(t, err)
+	assert.Contains(t, stderr, "History fetching failed — view: basecamp events 42")
+	assert.NotContains(t, stdout, `"history"`)
+}
+
 func TestShowSkipsCommentsForNonCommentableTypes(t *testing.T) {
 	transport := &showTrackingTransport{
 		responder: func(path string) (int, string) {
@@ -122,11 +122,11 @@ func sortTodolists(todolists []basecamp.Todolist, field string, reverse bool) {
 }
 
 // sortProjects sorts a slice of projects by field with default direction, then reverses if requested.
-// "title" maps to the Name field on projects.
+// "name" (or its older alias "title") sorts by the project name.
 func sortProjects(projects []basecamp.Project, field string, reverse bool) {
 	sort.SliceStable(projects, func(i, j int) bool {
 		switch field {
-		case "title":
+		case "name", "title":
 			return strings.ToLower(projects[i].Name) < strings.ToLower(projects[j].Name)
 		case "created":
 			return projects[i].CreatedAt.After(projects[j].CreatedAt)
@@ -0,0 +1,113 @@
+package commands
+
+import (
+	"fmt"
+	"math"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/usage"
+)
+
+// statsRow is one command's line in the stats output.
+type statsRow struct {
+	Command   string    `json:"command"`
+	Runs      int       `json:"runs"`
+	AvgMs     int64     `json:"avg_ms"`
+	MaxMs     int64     `json:"max_ms"`
+	ErrorRate float64   `json:"error_rate"`
+	LastUsed  time.Time `json:"last_used"`
+}
+
+// NewStatsCmd creates the stats command.
+func NewStatsCmd() *cobra.Command {
+	var limit int
+	var reset bool
+
+	cmd := &cobra.Command{
+		Use:   "stats",
+		Short: "Show local command usage statistics",
+		Long: `Show which commands you run most, how long they take, and how often they fail.
+
+Stats are recorded on this machine only and are never uploaded. Recording is
+off by default; opt in with:
+
+  basecamp config set usage_stats true --global
+
+Examples:
+  basecamp stats              # Top 10 commands by run count
+  basecamp stats --limit 0    # All recorded commands
+  basecamp stats --reset      # Clear recorded stats`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if app == nil {
+				return fmt.Errorf("app not initialized")
+			}
+			if limit < 0 {
+				return output.ErrUsage("--limit must be 0 or greater")
+			}
+
+			store := usage.NewStore(filepath.Join(app.Config.CacheDir, usage.DirName))
+
+			if reset {
+				if err := store.Reset(); err != nil {
+					return fmt.Errorf("failed to reset usage stats: %w", err)
+				}
+				return app.OK(map[string]any{"status": "reset"},
+					output.WithSummary("Usage stats cleared"),
+				)
+			}
+
+			stats, err := store.Load()
+			if err != nil {
+				return fmt.Errorf("failed to read usage stats: %w", err)
+			}
+			return renderStats(app, stats, limit)
+		},
+	}
+
+	cmd.Flags().IntVarP(&limit, "limit", "n", 10, "Maximum number of commands to show (0 = all)")
+	cmd.Flags().BoolVar(&reset, "reset", false, "Clear recorded stats")
+
+	return cmd
+}
+
+func renderStats(app *appctx.App, stats *usage.Stats, limit int) error {
+	rows := make([]statsRow, 0, len(stats.Commands))
+	for _, e := range stats.Top(limit) {
+		rows = append(rows, statsRow{
+			Command:   e.Command,
+			Runs:      e.Count,
+			AvgMs:     e.AvgMs(),
+			MaxMs:     e.MaxMs,
+			ErrorRate: math.Round(e.ErrorRate()*1000) / 1000,
+			LastUsed:  e.LastUsed,
+		})
+	}
+
+	runs, errs := stats.Totals()
+	summary := "No usage recorded yet"
+	if runs > 0 {
+		summary = fmt.Sprintf("%d runs across %d commands since %s, %d failed",
+			runs, len(stats.Commands), stats.Since.Local().Format("Jan 2, 2006"), errs)
+	}
+
+	opts := []output.ResponseOption{output.WithSummary(summary)}
+	if app.Config.UsageStats == nil || !*app.Config.UsageStats {
+		opts = append(opts,
+			output.WithNotice("Usage stats are off. Enable with: basecamp config set usage_stats true --global"),
+			output.WithBreadcrumbs(output.Breadcrumb{
+				Action:      "enable",
+				Cmd:         "basecamp config set usage_stats true --global",
+				Description: "Start recording local usage stats",
+			}),
+		)
+	}
+
+	return app.OK(rows, opts...)
+}
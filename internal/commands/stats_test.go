@@ -0,0 +1,70 @@
+package commands
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/usage"
+)
+
+func TestStatsShowsTopCommands(t *testing.T) {
+	app, buf := setupPeopleTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+	enabled := true
+	app.Config.UsageStats = &enabled
+
+	store := usage.NewStore(filepath.Join(app.Config.CacheDir, usage.DirName))
+	require.NoError(t, store.Record(usage.Event{Command: "todos list", Duration: 100 * time.Millisecond}))
+	require.NoError(t, store.Record(usage.Event{Command: "todos list", Duration: 300 * time.Millisecond, ErrorCode: "network"}))
+	require.NoError(t, store.Record(usage.Event{Command: "projects list", Duration: 50 * time.Millisecond}))
+
+	err := executePeopleCommand(NewStatsCmd(), app, "--limit", "1")
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, `"command": "todos list"`)
+	assert.Contains(t, out, `"avg_ms": 200`)
+	assert.Contains(t, out, `"error_rate": 0.5`)
+	assert.NotContains(t, out, "projects list", "--limit 1 should keep only the top command")
+	assert.Contains(t, out, "3 runs across 2 commands")
+	assert.NotContains(t, out, "Usage stats are off")
+}
+
+func TestStatsNoticeWhenDisabled(t *testing.T) {
+	app, buf := setupPeopleTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+
+	err := executePeopleCommand(NewStatsCmd(), app)
+	require.NoError(t, err)
+
+	out := buf.String()
+	assert.Contains(t, out, "No usage recorded yet")
+	assert.Contains(t, out, "Usage stats are off")
+	assert.Contains(t, out, "basecamp config set usage_stats true --global")
+}
+
+func TestStatsReset(t *testing.T) {
+	app, buf := setupPeopleTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+
+	store := usage.NewStore(filepath.Join(app.Config.CacheDir, usage.DirName))
+	require.NoError(t, store.Record(usage.Event{Command: "me", Duration: time.Millisecond}))
+
+	err := executePeopleCommand(NewStatsCmd(), app, "--reset")
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), `"status": "reset"`)
+	assert.NoFileExists(t, store.Path())
+}
+
+func TestStatsRejectsNegativeLimit(t *testing.T) {
+	app, _ := setupPeopleTestApp(t)
+	app.Config.CacheDir = t.TempDir()
+
+	err := executePeopleCommand(NewStatsCmd(), app, "--limit", "-1")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--limit must be 0 or greater")
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
 	"github.com/basecamp/basecamp-cli/internal/output"
 )
 
@@ -36,22 +37,65 @@ commented on, or otherwise changed.`,
 }
 
 func newSubscriptionsShowCmd() *cobra.Command {
-	return &cobra.Command{
+	var format string
+
+	cmd := &cobra.Command{
 		Use:   "show <id|url>",
 		Short: "Show current subscribers",
-		Long: `Display all current subscribers for an item.
+		Long: `Display all current subscribers for an item, with their names and emails.
 
 You can pass either an ID or a Basecamp URL:
   basecamp subscriptions show 789
-  basecamp subscriptions show https://3.basecamp.com/123/buckets/456/recordings/789`,
+  basecamp subscriptions show https://3.basecamp.com/123/buckets/456/recordings/789
+
+Use --format ids to print the subscriber IDs comma-separated, ready for
+subscriptions add or remove:
+  basecamp subscriptions remove 789 --people "$(basecamp subscriptions show 789 --format ids)"`,
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			return runSubscriptionsShow(cmd, args[0])
+			if format != "" && format != "ids" {
+				return output.ErrUsage(fmt.Sprintf("Unknown --format %q (supported: ids)", format))
+			}
+			return runSubscriptionsShow(cmd, args[0], format)
 		},
 	}
+
+	cmd.Flags().StringVar(&format, "format", "", "Output format: ids (comma-separated subscriber IDs)")
+
+	return cmd
+}
+
+// subscriber is one row of the subscribers table.
+type subscriber struct {
+	ID           int64  `json:"id"`
+	Name         string `json:"name"`
+	EmailAddress string `json:"email_address,omitempty"`
 }
 
-func runSubscriptionsShow(cmd *cobra.Command, recordingIDStr string) error {
+// subscriberRows lists the subscribers by name and email. Details the API
+// left out are filled from the people cache.
+func subscriberRows(people []basecamp.Person, cached []completion.CachedPerson) []subscriber {
+	byID := make(map[int64]completion.CachedPerson, len(cached))
+	for _, p := range cached {
+		byID[p.ID] = p
+	}
+	rows := make([]subscriber, 0, len(people))
+	for _, p := range people {
+		row := subscriber{ID: p.ID, Name: p.Name, EmailAddress: p.EmailAddress}
+		if c, ok := byID[p.ID]; ok {
+			if row.Name == "" {
+				row.Name = c.Name
+			}
+			if row.EmailAddress == "" {
+				row.EmailAddress = c.EmailAddress
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+func runSubscriptionsShow(cmd *cobra.Command, recordingIDStr, format string) error {
 	app := appctx.FromContext(cmd.Context())
 
 	if err := ensureAccount(cmd, app); err != nil {
@@ -71,6 +115,21 @@ func runSubscriptionsShow(cmd *cobra.Command, recordingIDStr string) error {
 		return convertSDKError(err)
 	}
 
+	if format == "ids" {
+		ids := make([]string, len(subscription.Subscribers))
+		for i, p := range subscription.Subscribers {
+			ids[i] = strconv.FormatInt(p.ID, 10)
+		}
+		_, err := fmt.Fprintln(cmd.OutOrStdout(), strings.Join(ids, ","))
+		return err
+	}
+
+	rows := subscriberRows(subscription.Subscribers, completion.NewStore(app.Config.CacheDir).People())
+	for i := range subscription.Subscribers {
+		subscription.Subscribers[i].Name = rows[i].Name
+		subscription.Subscribers[i].EmailAddress = rows[i].EmailAddress
+	}
+
 	subscribedStr := "no"
 	if subscription.Subscribed {
 		subscribedStr = "yes"
@@ -78,6 +137,7 @@ func runSubscriptionsShow(cmd *cobra.Command, recordingIDStr string) error {
 
 	return app.OK(subscription,
 		output.WithSummary(fmt.Sprintf("%d subscribers (you: %s)", subscription.Count, subscribedStr)),
+		output.WithDisplayData(rows),
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "subscribe",
@@ -89,6 +149,11 @@ func runSubscriptionsShow(cmd *cobra.Command, recordingIDStr string) error {
 				Cmd:         fmt.Sprintf("basecamp subscriptions unsubscribe %s", recordingIDStr),
 				Description: "Unsubscribe yourself",
 			},
+			output.Breadcrumb{
+				Action:      "remove",
+				Cmd:         fmt.Sprintf("basecamp subscriptions remove %s --people \"$(basecamp subscriptions show %s --format ids)\"", recordingIDStr, recordingIDStr),
+				Description: "Remove all subscribers",
+			},
 		),
 	)
 }
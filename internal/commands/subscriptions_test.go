@@ -0,0 +1,78 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// mockSubscriptionTransport serves a recording with two subscribers, one of
+// whom the API returns without an email address.
+type mockSubscriptionTransport struct{}
+
+func (mockSubscriptionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	body := `{}`
+	if req.Method == "GET" && strings.HasSuffix(req.URL.Path, "/recordings/789/subscription.json") {
+		body = `{"subscribed": true, "count": 2, "subscribers": [
+			{"id": 1, "name": "Jane Doe", "email_address": "jane@example.com"},
+			{"id": 2, "name": "Sam Roe"}]}`
+	}
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func executeSubscriptionsShow(t *testing.T, app *appctx.App, args ...string) (string, error) {
+	t.Helper()
+	var out bytes.Buffer
+	cmd := NewSubscriptionsCmd()
+	cmd.SetArgs(append([]string{"show"}, args...))
+	cmd.SetContext(appctx.WithApp(context.Background(), app))
+	cmd.SetOut(&out)
+	cmd.SetErr(&bytes.Buffer{})
+	err := cmd.Execute()
+	return out.String(), err
+}
+
+func TestSubscriptionsShowFillsFromPeopleCache(t *testing.T) {
+	app, buf := setupMessagesMockApp(t, mockSubscriptionTransport{})
+	app.Config.CacheDir = t.TempDir()
+	require.NoError(t, completion.NewStore(app.Config.CacheDir).UpdatePeople([]completion.CachedPerson{
+		{ID: 2, Name: "Sam Roe", EmailAddress: "sam@example.com"},
+	}))
+
+	_, err := executeSubscriptionsShow(t, app, "789")
+	require.NoError(t, err)
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "2 subscribers (you: yes)", resp.Summary)
+	subscribers := resp.Data.(map[string]any)["subscribers"].([]any)
+	require.Len(t, subscribers, 2)
+	assert.Equal(t, "jane@example.com", subscribers[0].(map[string]any)["email_address"])
+	assert.Equal(t, "sam@example.com", subscribers[1].(map[string]any)["email_address"])
+}
+
+func TestSubscriptionsShowFormatIDs(t *testing.T) {
+	app, buf := setupMessagesMockApp(t, mockSubscriptionTransport{})
+
+	out, err := executeSubscriptionsShow(t, app, "789", "--format", "ids")
+	require.NoError(t, err)
+	assert.Equal(t, "1,2\n", out)
+	assert.Empty(t, buf.String(), "ids output bypasses the envelope")
+
+	_, err = executeSubscriptionsShow(t, app, "789", "--format", "csv")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "supported: ids")
+}
@@ -323,6 +323,10 @@ func newTemplatesDeleteCmd() *cobra.Command {
 				return output.ErrUsage("Invalid template ID")
 			}
 
+			if !confirmAction(cmd, confirmDestructive, fmt.Sprintf("Delete template #%d?", templateID), false) {
+				return nil
+			}
+
 			err = app.Account().Templates().Delete(cmd.Context(), templateID)
 			if err != nil {
 				return convertSDKError(err)
@@ -0,0 +1,477 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/dateparse"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// Time logs are a CLI convention layered on comments: `todos log-time`
+// posts a comment whose first line reads
+//
+//	time-log: 90m on 2026-01-15 — code review
+//
+// and `reports time` finds those lines again. The comment's author is the
+// person who logged the time.
+
+var timeLogLineRe = regexp.MustCompile(`(?im)^\s*time-log:\s*(\d+)m\s+on\s+(\d{4}-\d{2}-\d{2})(?:\s+[—–-]+\s+(.*?))?\s*$`)
+
+// timeLogMaxMinutes bounds a single entry to one day.
+const timeLogMaxMinutes = 24 * 60
+
+// timeLogReportDefaultLimit is how many comments `reports time` scans by default.
+const timeLogReportDefaultLimit = 500
+
+// timeLogEntry is one logged block of time.
+type timeLogEntry struct {
+	TodoID    int64  `json:"todo_id"`
+	Todo      string `json:"todo,omitempty"`
+	CommentID int64  `json:"comment_id,omitempty"`
+	Minutes   int    `json:"minutes"`
+	Date      string `json:"date"`
+	Note      string `json:"note,omitempty"`
+	PersonID  int64  `json:"person_id,omitempty"`
+	Person    string `json:"person,omitempty"`
+	ListID    int64  `json:"list_id,omitempty"`
+	List      string `json:"list,omitempty"`
+	ProjectID int64  `json:"project_id,omitempty"`
+	Project   string `json:"project,omitempty"`
+	AppURL    string `json:"app_url,omitempty"`
+}
+
+// formatTimeLogHTML renders the comment body for a time log entry.
+func formatTimeLogHTML(minutes int, date, note string) string {
+	line := fmt.Sprintf("time-log: %dm on %s", minutes, date)
+	if note != "" {
+		line += " — " + note
+	}
+	return "<div>" + html.EscapeString(line) + "</div>"
+}
+
+// parseTimeLog extracts the time log line from a comment body (HTML or
+// Markdown). Only the first time-log line counts.
+func parseTimeLog(content string) (minutes int, date, note string, ok bool) {
+	text := content
+	if richtext.IsHTML(text) {
+		text = richtext.HTMLToMarkdown(text)
+	}
+	m := timeLogLineRe.FindStringSubmatch(text)
+	if m == nil {
+		return 0, "", "", false
+	}
+	minutes, err := strconv.Atoi(m[1])
+	if err != nil || minutes <= 0 {
+		return 0, "", "", false
+	}
+	return minutes, m[2], strings.TrimSpace(m[3]), true
+}
+
+// formatMinutes renders a duration in minutes as "1h 30m".
+func formatMinutes(minutes int) string {
+	h, m := minutes/60, minutes%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dm", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh %dm", h, m)
+	}
+}
+
+func newTodosLogTimeCmd() *cobra.Command {
+	var minutes int
+	var note string
+	var date string
+
+	cmd := &cobra.Command{
+		Use:   "log-time <id|url>",
+		Short: "Log time spent on a todo",
+		Long: `Log time spent on a todo by posting a comment in a fixed format:
+
+  time-log: 90m on 2026-01-15 — code review
+
+The comment is attributed to you, so 'basecamp reports time' can total time
+per person and per list. Edit or trash the comment to correct an entry.`,
+		Example: `  basecamp todos log-time 789 --minutes 90 --note "review"
+  basecamp todos log-time 789 --minutes 30 --date yesterday`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return missingArg(cmd, "<id|url>")
+			}
+			return runTodosLogTime(cmd, args[0], minutes, date, note)
+		},
+	}
+
+	cmd.Flags().IntVar(&minutes, "minutes", 0, "Minutes spent (required)")
+	cmd.Flags().StringVar(&note, "note", "", "Short description of the work")
+	cmd.Flags().StringVar(&date, "date", "today", "Day the work happened (YYYY-MM-DD, today, yesterday)")
+
+	return cmd
+}
+
+func runTodosLogTime(cmd *cobra.Command, arg string, minutes int, dateArg, note string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if minutes <= 0 {
+		return output.ErrUsage("--minutes is required and must be greater than 0")
+	}
+	if minutes > timeLogMaxMinutes {
+		return output.ErrUsageHint(
+			fmt.Sprintf("--minutes %d is more than a day", minutes),
+			"Log each day separately with --date",
+		)
+	}
+	if !dateparse.IsValid(dateArg) {
+		return output.ErrUsage(fmt.Sprintf("invalid --date value %q", dateArg))
+	}
+	date := dateparse.Parse(dateArg)
+	note = strings.Join(strings.Fields(note), " ")
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	todoID, err := strconv.ParseInt(extractID(arg), 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid todo ID")
+	}
+
+	// Fetch first so time is never logged against something that isn't a todo.
+	todo, err := app.Account().Todos().Get(cmd.Context(), todoID)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	comment, err := app.Account().Comments().Create(cmd.Context(), todoID, &basecamp.CreateCommentRequest{
+		Content: formatTimeLogHTML(minutes, date, note),
+	})
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	entry := timeLogEntry{
+		TodoID:    todo.ID,
+		Todo:      todo.Content,
+		CommentID: comment.ID,
+		Minutes:   minutes,
+		Date:      date,
+		Note:      note,
+		AppURL:    comment.AppURL,
+	}
+	if todo.Parent != nil {
+		entry.ListID, entry.List = todo.Parent.ID, todo.Parent.Title
+	}
+	if todo.Bucket != nil {
+		entry.ProjectID, entry.Project = todo.Bucket.ID, todo.Bucket.Name
+	}
+
+	reportCmd := "basecamp reports time"
+	if todo.Bucket != nil {
+		reportCmd = fmt.Sprintf("basecamp reports time --in %d", todo.Bucket.ID)
+	}
+
+	return app.OK(entry,
+		output.WithSummary(fmt.Sprintf("Logged %s on #%d for %s", formatMinutes(minutes), todo.ID, date)),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "report",
+				Cmd:         reportCmd,
+				Description: "View logged time",
+			},
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         fmt.Sprintf("basecamp todos show %d", todo.ID),
+				Description: "Show todo details",
+			},
+		),
+	)
+}
+
+// timeReportList is the time logged against one todolist.
+type timeReportList struct {
+	ID      int64  `json:"id"`
+	Name    string `json:"name"`
+	Project string `json:"project,omitempty"`
+	Minutes int    `json:"minutes"`
+}
+
+// timeReportPerson is one person's logged time, broken down by list.
+type timeReportPerson struct {
+	ID      int64            `json:"id"`
+	Name    string           `json:"name"`
+	Minutes int              `json:"minutes"`
+	Lists   []timeReportList `json:"lists"`
+}
+
+type timeReport struct {
+	Since        string             `json:"since"`
+	TotalMinutes int                `json:"total_minutes"`
+	People       []timeReportPerson `json:"people"`
+	Lists        []timeReportList   `json:"lists"`
+	Entries      []timeLogEntry     `json:"entries"`
+}
+
+func newReportsTimeCmd() *cobra.Command {
+	var project string
+	var since string
+	var limit int
+
+	cmd := &cobra.Command{
+		Use:   "time",
+		Short: "Total time logged on todos per person and list",
+		Long: `Total the time logged with 'basecamp todos log-time', per person and per
+todolist.
+
+Entries are found by scanning the newest comments (in one project with --in,
+otherwise across all active projects) and counted by the day the work
+happened.
+
+--since accepts a relative window (24h, 3d, 1w, 2m) or a date
+(2026-01-15, yesterday).`,
+		Example: `  basecamp reports time --in "Launch" --since 1w
+  basecamp reports time --since 2026-01-01 --limit 0 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runReportsTime(cmd, project, since, limit)
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "in", "", "Limit to a single project (name, ID, or URL)")
+	cmd.Flags().StringVar(&since, "since", "1w", "Only include time logged for days since this window or date")
+	cmd.Flags().IntVarP(&limit, "limit", "n", timeLogReportDefaultLimit, "Maximum comments to scan, newest first (0 = all)")
+
+	completer := completion.NewCompleter(nil)
+	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
+
+	return cmd
+}
+
+func runReportsTime(cmd *cobra.Command, project, sinceArg string, limit int) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if limit < 0 {
+		return output.ErrUsage("--limit must be 0 or greater")
+	}
+	since, err := parseActivitySince(sinceArg, time.Now())
+	if err != nil {
+		return err
+	}
+	sinceDate := since.Format("2006-01-02")
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	if project == "" {
+		project = app.Flags.Project
+	}
+	opts := &basecamp.RecordingsListOptions{Sort: "created_at", Direction: "desc", Limit: limit}
+	if limit == 0 {
+		opts.Limit = -1
+	}
+	if project != "" {
+		resolvedID, _, resolveErr := app.Names.ResolveProject(cmd.Context(), project)
+		if resolveErr != nil {
+			return resolveErr
+		}
+		projectID, parseErr := strconv.ParseInt(resolvedID, 10, 64)
+		if parseErr != nil {
+			return output.ErrUsage("Invalid project ID")
+		}
+		opts.Bucket = []int64{projectID}
+	}
+
+	result, err := app.Account().Recordings().List(cmd.Context(), basecamp.RecordingTypeComment, opts)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	entries := collectTimeLogs(cmd.Context(), app, result.Recordings, sinceDate)
+	report := buildTimeReport(sinceDate, entries)
+
+	// Comments are newest first; if the scan stopped while still inside the
+	// window, older entries may have been missed.
+	var notice string
+	if n := len(result.Recordings); limit > 0 && n >= limit && !result.Recordings[n-1].CreatedAt.Before(since) {
+		notice = fmt.Sprintf("Scanned the newest %d comments; older time logs may be missing (raise --limit or use --limit 0)", n)
+	}
+
+	respOpts := []output.ResponseOption{
+		output.WithSummary(fmt.Sprintf("%s logged since %s by %d people across %d lists",
+			formatMinutes(report.TotalMinutes), sinceDate, len(report.People), len(report.Lists))),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderTimeReportStyled(w, r, report) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "log",
+				Cmd:         "basecamp todos log-time <id> --minutes <n>",
+				Description: "Log time on a todo",
+			},
+		),
+	}
+	if notice != "" {
+		respOpts = append(respOpts, output.WithNotice(notice))
+	}
+
+	return app.OK(report, respOpts...)
+}
+
+// collectTimeLogs parses time log comments on todos dated on or after
+// sinceDate. Each todo is fetched once to find its list; a todo that can't be
+// fetched still counts, just without a list.
+func collectTimeLogs(ctx context.Context, app *appctx.App, comments []basecamp.Recording, sinceDate string) []timeLogEntry {
+	todos := make(map[int64]*basecamp.Todo)
+	entries := []timeLogEntry{}
+
+	for _, c := range comments {
+		if c.Parent == nil || c.Parent.Type != "Todo" {
+			continue
+		}
+		minutes, date, note, ok := parseTimeLog(c.Content)
+		if !ok || date < sinceDate {
+			continue
+		}
+
+		entry := timeLogEntry{
+			TodoID:    c.Parent.ID,
+			Todo:      c.Parent.Title,
+			CommentID: c.ID,
+			Minutes:   minutes,
+			Date:      date,
+			Note:      note,
+			AppURL:    c.AppURL,
+		}
+		if c.Creator != nil {
+			entry.PersonID, entry.Person = c.Creator.ID, c.Creator.Name
+		}
+		if c.Bucket != nil {
+			entry.ProjectID, entry.Project = c.Bucket.ID, c.Bucket.Name
+		}
+
+		todo, seen := todos[c.Parent.ID]
+		if !seen {
+			todo, _ = app.Account().Todos().Get(ctx, c.Parent.ID)
+			todos[c.Parent.ID] = todo
+		}
+		if todo != nil && todo.Parent != nil {
+			entry.ListID, entry.List = todo.Parent.ID, todo.Parent.Title
+		}
+
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// buildTimeReport totals entries per person (with a per-list breakdown) and
+// per list. Groups are ordered by time logged, most first.
+func buildTimeReport(sinceDate string, entries []timeLogEntry) timeReport {
+	report := timeReport{Since: sinceDate, People: []timeReportPerson{}, Lists: []timeReportList{}, Entries: entries}
+
+	people := make(map[int64]*timeReportPerson)
+	personLists := make(map[int64]map[int64]*timeReportList)
+	lists := make(map[int64]*timeReportList)
+
+	addList := func(m map[int64]*timeReportList, e timeLogEntry) {
+		l, ok := m[e.ListID]
+		if !ok {
+			name := e.List
+			if name == "" {
+				name = "(no list)"
+			}
+			l = &timeReportList{ID: e.ListID, Name: name, Project: e.Project}
+			m[e.ListID] = l
+		}
+		l.Minutes += e.Minutes
+	}
+
+	for _, e := range entries {
+		report.TotalMinutes += e.Minutes
+
+		p, ok := people[e.PersonID]
+		if !ok {
+			name := e.Person
+			if name == "" {
+				name = "(unknown)"
+			}
+			p = &timeReportPerson{ID: e.PersonID, Name: name}
+			people[e.PersonID] = p
+			personLists[e.PersonID] = make(map[int64]*timeReportList)
+		}
+		p.Minutes += e.Minutes
+		addList(personLists[e.PersonID], e)
+		addList(lists, e)
+	}
+
+	for id, p := range people {
+		p.Lists = sortedTimeReportLists(personLists[id])
+		report.People = append(report.People, *p)
+	}
+	sort.Slice(report.People, func(i, j int) bool {
+		a, b := report.People[i], report.People[j]
+		if a.Minutes != b.Minutes {
+			return a.Minutes > b.Minutes
+		}
+		return a.Name < b.Name
+	})
+	report.Lists = sortedTimeReportLists(lists)
+
+	return report
+}
+
+func sortedTimeReportLists(m map[int64]*timeReportList) []timeReportList {
+	out := make([]timeReportList, 0, len(m))
+	for _, l := range m {
+		out = append(out, *l)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Minutes != out[j].Minutes {
+			return out[i].Minutes > out[j].Minutes
+		}
+		if out[i].Name != out[j].Name {
+			return out[i].Name < out[j].Name
+		}
+		return out[i].ID < out[j].ID
+	})
+	return out
+}
+
+// renderTimeReportStyled draws the report; the writer adds the scan notice.
+func renderTimeReportStyled(w io.Writer, r *output.Renderer, report timeReport) {
+	fmt.Fprintf(w, "%s %s\n", r.Summary.Render(formatMinutes(report.TotalMinutes)+" logged"), r.Muted.Render("since "+report.Since))
+
+	if len(report.People) == 0 {
+		fmt.Fprintln(w, r.Muted.Render("No time logged in this window."))
+	}
+	for _, p := range report.People {
+		fmt.Fprintf(w, "\n%s %s\n", r.Summary.Render(richtext.SanitizeSingleLine(p.Name)), r.Muted.Render(formatMinutes(p.Minutes)))
+		for _, l := range p.Lists {
+			fmt.Fprintf(w, "  %-8s %s\n", formatMinutes(l.Minutes), richtext.SanitizeSingleLine(l.Name))
+		}
+	}
+
+	if len(report.Lists) > 0 {
+		fmt.Fprintf(w, "\n%s\n", r.Summary.Render("By list"))
+		for _, l := range report.Lists {
+			name := richtext.SanitizeSingleLine(l.Name)
+			if l.Project != "" {
+				name += " " + r.Muted.Render("("+richtext.SanitizeSingleLine(l.Project)+")")
+			}
+			fmt.Fprintf(w, "  %-8s %s\n", formatMinutes(l.Minutes), name)
+		}
+	}
+}
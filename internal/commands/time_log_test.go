@@ -0,0 +1,87 @@
+package commands
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimeLogRoundTrip(t *testing.T) {
+	minutes, date, note, ok := parseTimeLog(formatTimeLogHTML(90, "2026-01-15", "review & fixes"))
+	require.True(t, ok)
+	assert.Equal(t, 90, minutes)
+	assert.Equal(t, "2026-01-15", date)
+	assert.Equal(t, "review & fixes", note)
+
+	minutes, date, note, ok = parseTimeLog(formatTimeLogHTML(30, "2026-01-16", ""))
+	require.True(t, ok)
+	assert.Equal(t, 30, minutes)
+	assert.Equal(t, "2026-01-16", date)
+	assert.Empty(t, note)
+}
+
+func TestParseTimeLogIgnoresOrdinaryComments(t *testing.T) {
+	for _, content := range []string{
+		"<div>Looks good to me</div>",
+		"spent about 90m on this yesterday",
+		"time-log: 0m on 2026-01-15",
+		"time-log: 90 on 2026-01-15",
+		"",
+	} {
+		_, _, _, ok := parseTimeLog(content)
+		assert.False(t, ok, "content %q", content)
+	}
+}
+
+func TestFormatMinutes(t *testing.T) {
+	assert.Equal(t, "45m", formatMinutes(45))
+	assert.Equal(t, "2h", formatMinutes(120))
+	assert.Equal(t, "1h 30m", formatMinutes(90))
+}
+
+func TestBuildTimeReport(t *testing.T) {
+	entries := []timeLogEntry{
+		{PersonID: 1, Person: "Ann", ListID: 10, List: "Sprint", Minutes: 60},
+		{PersonID: 2, Person: "Bo", ListID: 10, List: "Sprint", Minutes: 30},
+		{PersonID: 1, Person: "Ann", ListID: 11, List: "Bugs", Minutes: 80},
+		{PersonID: 2, Person: "Bo", Minutes: 15},
+	}
+
+	report := buildTimeReport("2026-01-01", entries)
+
+	assert.Equal(t, 185, report.TotalMinutes)
+	require.Len(t, report.People, 2)
+	assert.Equal(t, "Ann", report.People[0].Name)
+	assert.Equal(t, 140, report.People[0].Minutes)
+	require.Len(t, report.People[0].Lists, 2)
+	assert.Equal(t, "Bugs", report.People[0].Lists[0].Name, "lists are ordered by time logged")
+	assert.Equal(t, 45, report.People[1].Minutes)
+
+	require.Len(t, report.Lists, 3)
+	assert.Equal(t, timeReportList{ID: 10, Name: "Sprint", Minutes: 90}, report.Lists[0])
+	assert.Equal(t, "(no list)", report.Lists[2].Name)
+}
+
+func TestBuildTimeReportEmpty(t *testing.T) {
+	report := buildTimeReport("2026-01-01", []timeLogEntry{})
+	assert.Zero(t, report.TotalMinutes)
+	assert.NotNil(t, report.People)
+	assert.NotNil(t, report.Lists)
+}
+
+func TestTodosLogTimeValidatesFlags(t *testing.T) {
+	app, _ := setupTodosTestApp(t)
+
+	err := executeTodosCommand(NewTodosCmd(), app, "log-time", "123")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--minutes is required")
+
+	err = executeTodosCommand(NewTodosCmd(), app, "log-time", "123", "--minutes", "2000")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "more than a day")
+
+	err = executeTodosCommand(NewTodosCmd(), app, "log-time", "123", "--minutes", "30", "--date", "someday")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "invalid --date")
+}
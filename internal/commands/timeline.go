@@ -51,8 +51,8 @@ Use --watch to continuously poll for new activity.`,
 		},
 	}
 
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.Flags().StringVar(&project, "in", "", "Project ID or name (alias for --project)")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.Flags().StringVar(&person, "person", "", "Person ID or name")
 	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Watch for new activity (poll continuously)")
 	cmd.Flags().IntVar(&interval, "interval", 30, "Poll interval in seconds (default: 30)")
@@ -155,7 +155,9 @@ func runTimeline(cmd *cobra.Command, args []string, project, person string, limi
 		),
 	}
 
-	if notice := output.TruncationNoticeWithTotal(len(result.Events), result.Meta.TotalCount); notice != "" {
+	respOpts = append(respOpts, listPagination(result.Meta, page, len(result.Events)))
+
+	if notice := listTruncationNotice(len(result.Events), result.Meta, page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
@@ -202,7 +204,9 @@ func runProjectTimeline(cmd *cobra.Command, project string, opts *basecamp.Timel
 		),
 	}
 
-	if notice := output.TruncationNoticeWithTotal(len(timelineResult.Events), timelineResult.Meta.TotalCount); notice != "" {
+	respOpts = append(respOpts, listPagination(timelineResult.Meta, opts.Page, len(timelineResult.Events)))
+
+	if notice := listTruncationNotice(len(timelineResult.Events), timelineResult.Meta, opts.Page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
@@ -255,7 +259,9 @@ func runPersonTimeline(cmd *cobra.Command, personArg string, opts *basecamp.Time
 		),
 	}
 
-	if notice := output.TruncationNoticeWithTotal(len(result.Events), result.Meta.TotalCount); notice != "" {
+	respOpts = append(respOpts, listPagination(result.Meta, opts.Page, len(result.Events)))
+
+	if notice := listTruncationNotice(len(result.Events), result.Meta, opts.Page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
@@ -37,8 +37,8 @@ date range is specified.`,
 	cmd.PersistentFlags().StringVar(&endDate, "end", "", "End date (ISO 8601)")
 	cmd.PersistentFlags().StringVar(&endDate, "to", "", "End date (alias for --end)")
 	cmd.PersistentFlags().StringVar(&personID, "person", "", "Filter by person ID")
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, URL, or ID")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, URL, or ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newTimesheetReportCmd(&startDate, &endDate, &personID),
@@ -135,21 +135,7 @@ func newTimesheetProjectCmd(project *string) *cobra.Command {
 			}
 
 			// Resolve project — required for project-scoped timesheet
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -26,8 +26,8 @@ func NewTodolistgroupsCmd() *cobra.Command {
 Todolist groups allow you to organize todolists into collapsible sections.`,
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.PersistentFlags().StringVarP(&todolist, "list", "l", "", "Todolist ID")
 
 	cmd.AddCommand(
@@ -63,20 +63,7 @@ func runTodolistgroupsList(cmd *cobra.Command, project, todolist string) error {
 	}
 
 	// Resolve project, with interactive fallback
-	if project == "" {
-		project = app.Flags.Project
-	}
-	if project == "" {
-		project = app.Config.ProjectID
-	}
-	if project == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		project = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), project)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -146,21 +133,7 @@ func newTodolistgroupsShowCmd(project *string) *cobra.Command {
 			groupIDStr := args[0]
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -214,21 +187,6 @@ func newTodolistgroupsCreateCmd(project, todolist *string) *cobra.Command {
 				return err
 			}
 
-			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
 			// Resolve todolist - fall back to config
 			todolistIDStr := *todolist
 			if todolistIDStr == "" {
@@ -241,7 +199,7 @@ func newTodolistgroupsCreateCmd(project, todolist *string) *cobra.Command {
 				return output.ErrUsage("--list is required")
 			}
 
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
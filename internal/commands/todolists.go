@@ -28,14 +28,14 @@ Most projects have one todoset, but some may have multiple. Use --todoset
 to disambiguate when needed.`,
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newTodolistsListCmd(&project, &todosetID),
-		newTodolistsShowCmd(&project),
+		newTodolistsShowCmd(),
 		newTodolistsCreateCmd(&project, &todosetID),
-		newTodolistsUpdateCmd(&project),
+		newTodolistsUpdateCmd(),
 		newRecordableTrashCmd("todolist"),
 		newRecordableArchiveCmd("todolist"),
 		newRecordableRestoreCmd("todolist"),
@@ -97,21 +97,7 @@ func runTodolistsList(cmd *cobra.Command, project, todosetFlag string, limit, pa
 	}
 
 	// Resolve project, with interactive fallback
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return err
 	}
@@ -170,15 +156,17 @@ func runTodolistsList(cmd *cobra.Command, project, todosetFlag string, limit, pa
 		),
 	}
 
+	respOpts = append(respOpts, listPagination(todolistsResult.Meta, page, len(todolists)))
+
 	// Add truncation notice if results may be limited
-	if notice := output.TruncationNoticeWithTotal(len(todolists), todolistsResult.Meta.TotalCount); notice != "" {
+	if notice := listTruncationNotice(len(todolists), todolistsResult.Meta, page); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
 	return app.OK(todolists, respOpts...)
 }
 
-func newTodolistsShowCmd(project *string) *cobra.Command {
+func newTodolistsShowCmd() *cobra.Command {
 	var cf *commentFlags
 
 	cmd := &cobra.Command{
@@ -200,25 +188,8 @@ You can pass either a todolist ID or a Basecamp URL:
 				return err
 			}
 
-			// Extract ID and project from URL if provided
-			todolistIDStr, urlProjectID := extractWithProject(args[0])
-
-			// Resolve project - use URL > flag > config, with interactive fallback
-			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-			}
+			// The todolist is fetched by ID alone; its project isn't needed.
+			todolistIDStr := extractID(args[0])
 
 			// Parse todolist ID as int64
 			todolistID, err := strconv.ParseInt(todolistIDStr, 10, 64)
@@ -288,21 +259,7 @@ func newTodolistsCreateCmd(project, todosetID *string) *cobra.Command {
 			}
 
 			// Resolve project, with interactive fallback
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -358,7 +315,7 @@ func newTodolistsCreateCmd(project, todosetID *string) *cobra.Command {
 	return cmd
 }
 
-func newTodolistsUpdateCmd(project *string) *cobra.Command {
+func newTodolistsUpdateCmd() *cobra.Command {
 	var name string
 	var description string
 
@@ -385,25 +342,8 @@ You can pass either a todolist ID or a Basecamp URL:
 				return err
 			}
 
-			// Extract ID and project from URL if provided
-			todolistIDStr, urlProjectID := extractWithProject(args[0])
-
-			// Resolve project - use URL > flag > config, with interactive fallback
-			projectID := *project
-			if projectID == "" && urlProjectID != "" {
-				projectID = urlProjectID
-			}
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-			}
+			// The todolist is fetched by ID alone; its project isn't needed.
+			todolistIDStr := extractID(args[0])
 
 			// Parse todolist ID as int64
 			todolistID, err := strconv.ParseInt(todolistIDStr, 10, 64)
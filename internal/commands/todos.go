@@ -30,11 +30,14 @@ type todosListFlags struct {
 	status    string
 	completed bool
 	overdue   bool
+	due       string
+	dueBefore string
 	limit     int
 	page      int
 	all       bool
 	sortField string
 	reverse   bool
+	filters   recordingFilterFlags
 }
 
 // NewTodosCmd creates the todos command group.
@@ -43,7 +46,7 @@ func NewTodosCmd() *cobra.Command {
 		Use:         "todos",
 		Short:       "Manage todos",
 		Long:        "List, show, create, and manage Basecamp todos.",
-		Annotations: map[string]string{"agent_notes": "--assignee only works on todos, not cards or other content types\nbasecamp todos complete accepts multiple IDs: basecamp todos complete 1 2 3\n--assignee and --overdue require a project (--in, global flag, or config default); for cross-project use basecamp reports assigned/overdue"},
+		Annotations: map[string]string{"agent_notes": "--assignee only works on todos, not cards or other content types\nbasecamp todos complete accepts multiple IDs: basecamp todos complete 1 2 3\n--assignee, --overdue, and --due require a project (--in, global flag, or config default); for cross-project use basecamp reports assigned/overdue"},
 	}
 
 	cmd.AddCommand(
@@ -55,6 +58,12 @@ func NewTodosCmd() *cobra.Command {
 		newTodosUncompleteCmd(),
 		newTodosSweepCmd(),
 		newTodosPositionCmd(),
+		newTodosCopyCmd(),
+		newTodosMoveCmd(),
+		newTodosBlockedCmd(),
+		newTodosDepsCmd(),
+		newTodosTreeCmd(),
+		newTodosLogTimeCmd(),
 		newRecordableTrashCmd("todo"),
 		newRecordableArchiveCmd("todo"),
 		newRecordableRestoreCmd("todo"),
@@ -69,30 +78,50 @@ func newTodosListCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list",
 		Short: "List todos",
-		Long:  "List todos in a project or todolist.",
+		Long: `List todos in a project or todolist.
+
+--due narrows the list to incomplete todos due today, this week (today
+through Sunday), or overdue; --overdue is shorthand for --due overdue.
+--due-before keeps incomplete todos due before a date (YYYY-MM-DD or a
+phrase like friday or in 2 weeks).
+--created-by and --updated-since keep todos by who created them and when
+they last changed, for incremental syncs.
+--watch polls every --interval seconds and, after the first list, prints
+only todos that are new or changed.`,
+		Example: `  basecamp todos list --in <project>
+  basecamp todos list --due today --in <project>
+  basecamp todos list --due this-week --assignee me --in <project>
+  basecamp todos list --due-before friday --list "Launch" --in <project>
+  basecamp todos list --updated-since 24h --created-by me --in <project>
+  basecamp todos list --assignee me --watch --in <project>`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return runTodosList(cmd, flags)
 		},
 	}
 
 	// Note: can't use -a for assignee since it conflicts with global -a for account
-	cmd.Flags().StringVar(&flags.project, "in", "", "Project ID or name")
-	cmd.Flags().StringVarP(&flags.todolist, "list", "l", "", "Todolist ID")
+	cmd.Flags().StringVar(&flags.project, "in", "", "Project name, ID, or URL")
+	cmd.Flags().StringVarP(&flags.todolist, "list", "l", "", "Todolist ID, name, or URL")
 	cmd.Flags().StringVarP(&flags.todoset, "todoset", "t", "", "Todoset ID (for projects with multiple todosets)")
 	cmd.Flags().StringVar(&flags.assignee, "assignee", "", "Filter by assignee")
 	cmd.Flags().StringVarP(&flags.status, "status", "s", "", "Filter by status (completed, incomplete, archived, trashed)")
 	cmd.Flags().BoolVar(&flags.completed, "completed", false, "Show completed todos (shorthand for --status completed)")
 	cmd.Flags().BoolVar(&flags.overdue, "overdue", false, "Filter overdue todos")
+	cmd.Flags().StringVar(&flags.due, "due", "", "Filter by due date (today, this-week, overdue)")
+	cmd.Flags().StringVar(&flags.dueBefore, "due-before", "", "Only todos due before this date (YYYY-MM-DD or natural date)")
 	cmd.Flags().IntVarP(&flags.limit, "limit", "n", 0, "Maximum number of todos to fetch (0 = default 100)")
 	cmd.Flags().BoolVar(&flags.all, "all", false, "Fetch all todos (no limit)")
 	cmd.Flags().IntVar(&flags.page, "page", 0, "Fetch a single page (use --all for everything)")
 	cmd.Flags().StringVar(&flags.sortField, "sort", "", "Sort by field (title, created, updated, position, due)")
 	cmd.Flags().BoolVar(&flags.reverse, "reverse", false, "Reverse sort order")
+	addRecordingFilterFlags(cmd, &flags.filters)
+	addWatchFlags(cmd)
 
 	// Register tab completion for flags
 	completer := completion.NewCompleter(nil)
 	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
 	_ = cmd.RegisterFlagCompletionFunc("assignee", completer.PeopleNameCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("created-by", completer.PeopleNameCompletion())
 
 	return cmd
 }
@@ -129,13 +158,36 @@ func runTodosList(cmd *cobra.Command, flags todosListFlags) error {
 	if err != nil {
 		return err
 	}
+	if flags.overdue {
+		if flags.due != "" && flags.due != "overdue" {
+			return output.ErrUsage("--overdue and --due are mutually exclusive")
+		}
+		flags.due = "overdue"
+	}
+	due, err := resolveDueFilter(flags.due, time.Now())
+	if err != nil {
+		return err
+	}
+	if flags.dueBefore != "" {
+		if due != nil {
+			return output.ErrUsage("--due-before cannot be combined with --due or --overdue")
+		}
+		if due, err = resolveDueBefore(flags.dueBefore); err != nil {
+			return err
+		}
+	}
 
 	// Resolve account (enables interactive prompt if needed)
 	if err := ensureAccount(cmd, app); err != nil {
 		return err
 	}
 
-	// --assignee and --overdue filter within a single project. When no
+	filter, err := flags.filters.resolve(cmd.Context(), app, time.Now())
+	if err != nil {
+		return err
+	}
+
+	// --assignee and --due/--overdue filter within a single project. When no
 	// project is set anywhere (flag, global flag, config), the interactive
 	// picker would silently scope results to one arbitrary project. Error
 	// early and point to the Reports API for cross-project queries.
@@ -151,31 +203,21 @@ func runTodosList(cmd *cobra.Command, flags todosListFlags) error {
 				"--overdue requires a project (--in or default config)",
 				"For cross-project overdue todos: basecamp reports overdue")
 		}
-	}
-
-	// Use project from flag or config, with interactive fallback
-	project := flags.project
-	if project == "" {
-		project = app.Flags.Project
-	}
-	if project == "" {
-		project = app.Config.ProjectID
-	}
-
-	// If no project specified, try interactive resolution
-	if project == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
+		if due != nil {
+			flag := "--due"
+			if flags.dueBefore != "" {
+				flag = "--due-before"
+			}
+			return output.ErrUsageHint(
+				flag+" requires a project (--in or default config)",
+				"For cross-project due dates: basecamp reports schedule")
 		}
-		project = app.Config.ProjectID
 	}
 
-	// Resolve project name to ID
-	resolvedProject, _, err := app.Names.ResolveProject(cmd.Context(), project)
+	project, projectName, err := resolveProject(cmd, app, flags.project)
 	if err != nil {
 		return err
 	}
-	project = resolvedProject
 
 	// Use todolist from flag or config
 	todolist := flags.todolist
@@ -188,7 +230,7 @@ func runTodosList(cmd *cobra.Command, flags todosListFlags) error {
 
 	// If todolist is specified, list todos in that list
 	if todolist != "" {
-		return listTodosInList(cmd, app, project, todolist, flags.assignee, sdkStatus, sdkCompleted, flags.limit, flags.all, flags.sortField, flags.reverse)
+		return listTodosInList(cmd, app, project, projectName, todolist, flags.assignee, sdkStatus, sdkCompleted, due, filter, flags.limit, flags.all, flags.sortField, flags.reverse)
 	}
 
 	// --page is not meaningful when aggregating across todolists
@@ -198,7 +240,7 @@ func runTodosList(cmd *cobra.Command, flags todosListFlags) error {
 	}
 
 	// Otherwise, get all todos from project's todoset
-	return listAllTodos(cmd, app, project, flags.todoset, flags.assignee, sdkStatus, sdkCompleted, flags.overdue, flags.limit, flags.all, flags.sortField, flags.reverse)
+	return listAllTodos(cmd, app, project, projectName, flags.todoset, flags.assignee, sdkStatus, sdkCompleted, due, filter, flags.limit, flags.all, flags.sortField, flags.reverse)
 }
 
 // resolveStatusFilter maps the user-facing --status value to the SDK's
@@ -220,6 +262,71 @@ func resolveStatusFilter(status string) (sdkStatus string, completed bool, err e
 	return sdkStatus, completed, nil
 }
 
+// dueWindow is a --due filter: incomplete todos due between from and to,
+// inclusive. Dates are YYYY-MM-DD so they compare as strings (timezone-safe);
+// an empty from leaves the window open into the past.
+type dueWindow struct {
+	from  string
+	to    string
+	label string // summary suffix, e.g. "due this week"
+}
+
+// resolveDueFilter maps the user-facing --due value to a window relative to
+// now. The week runs through Sunday. An empty value means no filter (nil).
+func resolveDueFilter(due string, now time.Time) (*dueWindow, error) {
+	const layout = "2006-01-02"
+	today := now.Format(layout)
+	switch due {
+	case "":
+		return nil, nil
+	case "today":
+		return &dueWindow{from: today, to: today, label: "due today"}, nil
+	case "this-week":
+		sunday := now.AddDate(0, 0, (7-int(now.Weekday()))%7)
+		return &dueWindow{from: today, to: sunday.Format(layout), label: "due this week"}, nil
+	case "overdue":
+		return &dueWindow{to: now.AddDate(0, 0, -1).Format(layout), label: "overdue"}, nil
+	default:
+		return nil, output.ErrUsage(
+			fmt.Sprintf("unknown --due value %q (expected today, this-week, or overdue)", due))
+	}
+}
+
+// resolveDueBefore maps --due-before to an open window ending the day
+// before date, matching cards list --due-before (an exclusive bound).
+func resolveDueBefore(input string) (*dueWindow, error) {
+	date, err := parseDueFlag(input)
+	if err != nil {
+		return nil, err
+	}
+	before, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, output.ErrUsage(fmt.Sprintf("Invalid due date: %q", input))
+	}
+	return &dueWindow{to: before.AddDate(0, 0, -1).Format("2006-01-02"), label: "due before " + date}, nil
+}
+
+// matches reports whether todo falls in the window. A nil window matches
+// everything.
+func (w *dueWindow) matches(todo basecamp.Todo) bool {
+	if w == nil {
+		return true
+	}
+	if todo.DueOn == "" || todo.Completed {
+		return false
+	}
+	return (w.from == "" || todo.DueOn >= w.from) && todo.DueOn <= w.to
+}
+
+// todosSummary is the summary line of a todo listing: "12 todos", or with a
+// due filter "8 todos due this week".
+func todosSummary(n int, due *dueWindow) string {
+	if due == nil {
+		return fmt.Sprintf("%d todos", n)
+	}
+	return fmt.Sprintf("%d %s %s", n, pluralize(n, "todo", "todos"), due.label)
+}
+
 // fetchTodosIncludingGroups fetches all todos from a todolist, including
 // those nested inside todolist groups. Groups and direct todos share the
 // same position space; this function merges them by position so the output
@@ -334,8 +441,8 @@ func fetchTodosIncludingGroups(ctx context.Context, app *appctx.App, todolistID
 	return result, totalCount, nil
 }
 
-func listTodosInList(cmd *cobra.Command, app *appctx.App, project, todolist, assignee, sdkStatus string, sdkCompleted bool, limit int, all bool, sortField string, reverse bool) error {
-	resolvedTodolist, _, err := app.Names.ResolveTodolist(cmd.Context(), todolist, project)
+func listTodosInList(cmd *cobra.Command, app *appctx.App, project, projectName, todolist, assignee, sdkStatus string, sdkCompleted bool, due *dueWindow, filter recordingFilter, limit int, all bool, sortField string, reverse bool) error {
+	resolvedTodolist, todolistName, err := app.Names.ResolveTodolist(cmd.Context(), todolist, project)
 	if err != nil {
 		return err
 	}
@@ -350,10 +457,10 @@ func listTodosInList(cmd *cobra.Command, app *appctx.App, project, todolist, ass
 
 	// Determine the SDK limit to pass through. fetchTodosIncludingGroups
 	// uses this for the no-groups fast path and for cross-list aggregation.
-	// When assignee filtering is active, fetch all so client-side filtering
-	// doesn't miss matches beyond the default cap.
+	// When assignee, due, or creator/update filtering is active, fetch all so
+	// client-side filtering doesn't miss matches beyond the default cap.
 	sdkLimit := 0 // SDK default
-	if all || assignee != "" {
+	if all || assignee != "" || due != nil || filter.active() {
 		sdkLimit = -1
 	} else if limit > 0 {
 		sdkLimit = limit
@@ -363,6 +470,7 @@ func listTodosInList(cmd *cobra.Command, app *appctx.App, project, todolist, ass
 	if err != nil {
 		return convertSDKError(err)
 	}
+	pagination := listPagination(basecamp.ListMeta{TotalCount: totalCount}, 1, len(todos))
 
 	// Filter by assignee client-side (API has no server-side assignee filter)
 	if assignee != "" {
@@ -386,9 +494,25 @@ func listTodosInList(cmd *cobra.Command, app *appctx.App, project, todolist, ass
 		}
 	}
 
+	if due != nil {
+		filtered := todos[:0]
+		for _, todo := range todos {
+			if due.matches(todo) {
+				filtered = append(filtered, todo)
+			}
+		}
+		todos = filtered
+		totalCount = len(todos)
+	}
+
+	if filter.active() {
+		todos = filterRecordings(filter, todos, todoRecordingFields)
+		totalCount = len(todos)
+	}
+
 	// Apply --limit after client-side filtering so the cap reflects
 	// the filtered set, not the pre-filter fetch.
-	if assignee != "" && !all && limit > 0 && len(todos) > limit {
+	if (assignee != "" || due != nil || filter.active()) && !all && limit > 0 && len(todos) > limit {
 		todos = todos[:limit]
 	}
 
@@ -399,7 +523,7 @@ func listTodosInList(cmd *cobra.Command, app *appctx.App, project, todolist, ass
 
 	respOpts := []output.ResponseOption{
 		output.WithEntity("todo"),
-		output.WithSummary(fmt.Sprintf("%d todos", len(todos))),
+		output.WithSummary(todosSummary(len(todos), due)),
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "create",
@@ -412,16 +536,22 @@ func listTodosInList(cmd *cobra.Command, app *appctx.App, project, todolist, ass
 				Description: "Complete a todo",
 			},
 		),
+		pagination,
 	}
 
 	if notice := output.TruncationNoticeWithTotal(len(todos), totalCount); notice != "" {
 		respOpts = append(respOpts, output.WithNotice(notice))
 	}
 
-	return app.OK(todos, respOpts...)
+	ref := todolistRef{ID: todolistID, Title: todolistName}
+	lists := make(map[int64]todolistRef, len(todos))
+	for _, todo := range todos {
+		lists[todo.ID] = ref
+	}
+	return app.OK(todoListItems(todos, lists, projectBucket(project, projectName)), respOpts...)
 }
 
-func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, assignee, sdkStatus string, sdkCompleted bool, overdue bool, limit int, all bool, sortField string, reverse bool) error {
+func listAllTodos(cmd *cobra.Command, app *appctx.App, project, projectName, todosetFlag, assignee, sdkStatus string, sdkCompleted bool, due *dueWindow, filter recordingFilter, limit int, all bool, sortField string, reverse bool) error {
 	// Position is only meaningful within a single todolist — reject before
 	// the --all check so users get the right error message.
 	if sortField == "position" {
@@ -429,11 +559,11 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 	}
 	// Sorting the aggregate path is only meaningful when the full set is
 	// fetched. That happens with --all, or when a client-side filter
-	// (assignee/overdue) forces an unlimited per-list fetch below. Otherwise
-	// results are sampled per-todolist using default SDK paging and a sort
-	// would be misleading.
-	if sortField != "" && !all && assignee == "" && !overdue {
-		return output.ErrUsage("--sort requires --all (or --assignee/--overdue) when listing across todolists (results are otherwise sampled per list)")
+	// (assignee/due/creator/update) forces an unlimited per-list fetch below.
+	// Otherwise results are sampled per-todolist using default SDK paging and
+	// a sort would be misleading.
+	if sortField != "" && !all && assignee == "" && due == nil && !filter.active() {
+		return output.ErrUsage("--sort requires --all (or --assignee/--due/--created-by/--updated-since) when listing across todolists (results are otherwise sampled per list)")
 	}
 	// Resolve assignee name to ID if provided
 	var assigneeID int64
@@ -463,11 +593,12 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 
 	// Determine per-list limit to pass through to each fetch (todolists and the
 	// listless-todo recordings scan alike). When a client-side filter
-	// (assignee/overdue) is active, fetch everything so the post-fetch filter
-	// doesn't miss matches beyond the default cap — mirroring the single-list
-	// path. Any explicit --limit is then applied after filtering, below.
+	// (assignee/due/creator/update) is active, fetch everything so the
+	// post-fetch filter doesn't miss matches beyond the default cap — mirroring
+	// the single-list path. Any explicit --limit is then applied after
+	// filtering, below.
 	sdkLimit := 0 // SDK default
-	if all || assignee != "" || overdue {
+	if all || assignee != "" || due != nil || filter.active() {
 		sdkLimit = -1
 	} else if limit > 0 {
 		sdkLimit = limit
@@ -476,27 +607,44 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 	// Aggregate todos from all todolists, including group-nested todos.
 	// The server applies the status/completed filter directly — no client-side
 	// status filter is needed (the API is the single source of truth).
+	// Ctrl-C stops the fan-out; the todolists read so far are still listed.
 	var allTodos []basecamp.Todo
+	lists := make(map[int64]todolistRef)
+	interrupted := false
 	for _, tl := range todolistsResult.Todolists {
 		todos, _, err := fetchTodosIncludingGroups(cmd.Context(), app, tl.ID, sdkStatus, sdkCompleted, sdkLimit, false)
 		if err != nil {
+			if cmd.Context().Err() != nil {
+				interrupted = true
+				break
+			}
 			continue // Skip failed todolists
 		}
+		ref := todolistRef{ID: tl.ID, Title: tl.Name}
+		if ref.Title == "" {
+			ref.Title = tl.Title
+		}
+		for _, todo := range todos {
+			lists[todo.ID] = ref
+		}
 		allTodos = append(allTodos, todos...)
 	}
 
 	// Basecamp 5 lets todos live directly under the Todoset without a
 	// Todolist. Those "listless" todos are invisible to the per-todolist
 	// enumeration above, so fetch them via the Recordings API and merge them
-	// in. Assignee/overdue filters below apply to them too. project is already
+	// in. Assignee/due filters below apply to them too. project is already
 	// resolved to a numeric ID by this point, so a parse failure signals a bug
 	// rather than user input — error out instead of silently dropping them.
 	projectID, err := strconv.ParseInt(project, 10, 64)
 	if err != nil {
 		return output.ErrUsage("Invalid project ID")
 	}
-	allTodos = append(allTodos,
-		fetchTodosetLevelTodos(cmd.Context(), app, projectID, todosetID, sdkStatus, sdkCompleted, sdkLimit)...)
+	if !interrupted {
+		allTodos = append(allTodos,
+			fetchTodosetLevelTodos(cmd.Context(), app, projectID, todosetID, sdkStatus, sdkCompleted, sdkLimit)...)
+		interrupted = cmd.Context().Err() != nil
+	}
 
 	// Apply filters
 	var result []basecamp.Todo
@@ -515,16 +663,14 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 			}
 		}
 
-		// Filter overdue - check if due date is in the past and not completed
-		if overdue {
-			if todo.DueOn == "" || todo.Completed {
-				continue
-			}
-			// Compare date strings directly (timezone-safe)
-			today := time.Now().Format("2006-01-02")
-			if todo.DueOn >= today {
-				continue // Not overdue
-			}
+		// Filter by due date window (--due/--overdue)
+		if !due.matches(todo) {
+			continue
+		}
+
+		// Filter by creator and last update (--created-by/--updated-since)
+		if !filter.match(todo.Creator, todo.UpdatedAt) {
+			continue
 		}
 
 		result = append(result, todo)
@@ -533,7 +679,7 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 	// When a client-side filter forced an unlimited fetch above, apply the
 	// explicit --limit after filtering so the cap reflects the filtered set
 	// rather than the pre-filter fetch (mirrors the single-list path).
-	if (assignee != "" || overdue) && !all && limit > 0 && len(result) > limit {
+	if (assignee != "" || due != nil || filter.active()) && !all && limit > 0 && len(result) > limit {
 		result = result[:limit]
 	}
 
@@ -546,7 +692,7 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 	// Build response options
 	respOpts := []output.ResponseOption{
 		output.WithEntity("todo"),
-		output.WithSummary(fmt.Sprintf("%d todos", len(result))),
+		output.WithSummary(todosSummary(len(result), due)),
 		output.WithBreadcrumbs(
 			output.Breadcrumb{
 				Action:      "create",
@@ -568,8 +714,54 @@ func listAllTodos(cmd *cobra.Command, app *appctx.App, project, todosetFlag, ass
 
 	// Note: truncation notice is not shown when aggregating across todolists
 	// because limit is applied per-list, not globally. Use --list for accurate notices.
+	if interrupted {
+		respOpts = append(respOpts, output.WithInterrupted())
+	}
+
+	return app.OK(todoListItems(result, lists, projectBucket(project, projectName)), respOpts...)
+}
+
+// todolistRef names the todolist a listed todo belongs to.
+type todolistRef struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
 
-	return app.OK(result, respOpts...)
+// todoListItem is a todo in list output. A todo in a todolist group has the
+// group as its parent, so the todolist is given separately; todos directly
+// under the todoset have none.
+type todoListItem struct {
+	basecamp.Todo
+	Todolist *todolistRef `json:"todolist,omitempty"`
+}
+
+// todoListItems pairs todos with their todolists (keyed by todo ID) and
+// fills in the project for any todo the API returned without one, so each
+// item can be acted on without fetching it again.
+func todoListItems(todos []basecamp.Todo, lists map[int64]todolistRef, bucket *basecamp.Bucket) []todoListItem {
+	if todos == nil {
+		return nil
+	}
+	items := make([]todoListItem, len(todos))
+	for i, todo := range todos {
+		if todo.Bucket == nil {
+			todo.Bucket = bucket
+		}
+		items[i] = todoListItem{Todo: todo}
+		if ref, ok := lists[todo.ID]; ok {
+			items[i].Todolist = &ref
+		}
+	}
+	return items
+}
+
+// projectBucket is the bucket reference for a resolved project ID.
+func projectBucket(projectID, name string) *basecamp.Bucket {
+	id, err := strconv.ParseInt(projectID, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return &basecamp.Bucket{ID: id, Name: name, Type: "Project"}
 }
 
 // fetchTodosetLevelTodos returns todos that live directly under the project's
@@ -777,17 +969,73 @@ func newTodosCreateCmd() *cobra.Command {
 	var description string
 	var attachFiles []string
 	var notifyOnCompletion string
+	var fromTemplate string
+	var templateVars []string
+	var start string
+	var dryRun bool
 
 	cmd := &cobra.Command{
 		Use:   "create <content>",
 		Short: "Create a new todo",
-		Long:  "Create a new todo in a project.",
+		Long: `Create a new todo in a project.
+
+With --from-template, create a whole todolist from a YAML or Markdown file
+instead, for processes you repeat (releases, onboarding). Template text can
+use {{.vars.<name>}} (set with --var name=value) and {{.start}}. Due dates
+like "+3d" or "+1w from start" count from --start (default: today).
+
+  name: Release {{.vars.version}}
+  todos:
+    - content: Cut the release branch
+      assignees: [me]
+      due: +0d
+    - content: Publish release notes
+      assignees: [Jane Smith]
+      due: +3d from start
+
+In a Markdown template (.md), the "# " heading names the list, each list
+item is a todo, indented lines are its description, and @Name (or
+@First.Last) and due:+3d words set assignees and due dates:
+
+  # Release {{.vars.version}}
+  - [ ] Cut the release branch @me due:+0d
+  - [ ] Publish release notes @Jane.Smith due:+3d
+
+Pass --list to add the todos to an existing todolist instead.`,
+		Example: `  basecamp todos create "Book the room" --in Launch --list Kickoff --due friday
+  basecamp todos create --from-template release-checklist.yaml --in Launch --var version=1.4
+  basecamp todos create --from-template onboarding.md --in HR --start 2026-11-02 --dry-run`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			app := appctx.FromContext(cmd.Context())
 			if app == nil {
 				return fmt.Errorf("app not initialized")
 			}
 
+			if fromTemplate != "" {
+				if len(args) > 0 {
+					return output.ErrUsage("<content> can't be combined with --from-template; the template lists the todos")
+				}
+				for _, name := range []string{"assignee", "to", "due", "description", "attach", "notify-on-completion"} {
+					if cmd.Flags().Changed(name) {
+						return output.ErrUsage(fmt.Sprintf("--%s can't be combined with --from-template; set it in the template", name))
+					}
+				}
+				return runTodosFromTemplate(cmd, app, todoTemplateOpts{
+					path:     fromTemplate,
+					vars:     templateVars,
+					start:    start,
+					project:  project,
+					todoset:  todoset,
+					todolist: todolist,
+					dryRun:   dryRun,
+				})
+			}
+			for _, name := range []string{"var", "start", "dry-run"} {
+				if cmd.Flags().Changed(name) {
+					return output.ErrUsage(fmt.Sprintf("--%s requires --from-template", name))
+				}
+			}
+
 			// Show help when invoked with no content
 			if len(args) == 0 {
 				return missingArg(cmd, "<content>")
@@ -801,22 +1049,7 @@ func newTodosCreateCmd() *cobra.Command {
 				return err
 			}
 
-			// Use project from flag or config, with interactive fallback
-			if project == "" {
-				project = app.Flags.Project
-			}
-			if project == "" {
-				project = app.Config.ProjectID
-			}
-			if project == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				project = app.Config.ProjectID
-			}
-
-			// Resolve project name to ID
-			resolvedProject, _, err := app.Names.ResolveProject(cmd.Context(), project)
+			resolvedProject, err := resolveProjectID(cmd, app, project)
 			if err != nil {
 				return err
 			}
@@ -934,9 +1167,9 @@ func newTodosCreateCmd() *cobra.Command {
 		},
 	}
 
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.Flags().StringVar(&project, "in", "", "Project ID (alias for --project)")
-	cmd.Flags().StringVarP(&todolist, "list", "l", "", "Todolist ID")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
+	cmd.Flags().StringVarP(&todolist, "list", "l", "", "Todolist ID, name, or URL")
 	cmd.Flags().StringVarP(&todoset, "todoset", "t", "", "Todoset ID (for projects with multiple todosets)")
 	cmd.Flags().StringVar(&assignee, "assignee", "", "Assignee ID")
 	cmd.Flags().StringVar(&assignee, "to", "", "Assignee ID (alias for --assignee)")
@@ -944,6 +1177,11 @@ func newTodosCreateCmd() *cobra.Command {
 	cmd.Flags().StringVar(&description, "description", "", "Extended description (Markdown)")
 	cmd.Flags().StringArrayVar(&attachFiles, "attach", nil, "Attach file (repeatable)")
 	cmd.Flags().StringVar(&notifyOnCompletion, "notify-on-completion", "", "People to notify when done (names or IDs, comma-separated)")
+	cmd.Flags().StringVar(&fromTemplate, "from-template", "", "Create a todolist of todos from a YAML or Markdown template file")
+	cmd.Flags().StringArrayVar(&templateVars, "var", nil, "Set a template var (name=value, repeatable; with --from-template)")
+	cmd.Flags().StringVar(&start, "start", "", "Date relative due dates count from (default: today; with --from-template)")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "Show the expanded template without creating anything (with --from-template)")
+	addContentForceFlag(cmd)
 
 	// Register tab completion for flags
 	completer := completion.NewCompleter(nil)
@@ -952,6 +1190,9 @@ func newTodosCreateCmd() *cobra.Command {
 	_ = cmd.RegisterFlagCompletionFunc("assignee", completer.PeopleNameCompletion())
 	_ = cmd.RegisterFlagCompletionFunc("to", completer.PeopleNameCompletion())
 	_ = cmd.RegisterFlagCompletionFunc("notify-on-completion", completer.PeopleNameCompletion())
+	_ = cmd.RegisterFlagCompletionFunc("from-template", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return []string{"yaml", "yml", "md"}, cobra.ShellCompDirectiveFilterFileExt
+	})
 
 	return cmd
 }
@@ -987,7 +1228,10 @@ Clear a field by passing its --no- flag or an empty value:
 
 Set or clear the people notified when the todo is completed:
   basecamp todos update 789 --notify-on-completion "Jane Smith,Bob"
-  basecamp todos update 789 --no-notify-on-completion`,
+  basecamp todos update 789 --no-notify-on-completion
+
+When --assignee changes who is assigned, styled output (or --verbose) shows
+who is added, removed, and notified before the todo is saved.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return missingArg(cmd, "<id|url>")
@@ -1129,6 +1373,7 @@ Set or clear the people notified when the todo is completed:
 					f.StartsOn = parsedStarts
 				}
 				if assigneeChanged {
+					previewAssigneeChange(cmd, app, f.AssigneeIDs, assigneeIDs, cmd.Flags().Changed("notify") && notify)
 					f.AssigneeIDs = assigneeIDs
 				}
 				if subscribersChanged {
@@ -1176,6 +1421,7 @@ Set or clear the people notified when the todo is completed:
 	cmd.Flags().BoolVar(&noDescription, "no-description", false, "Clear the description")
 	cmd.Flags().StringVar(&notifyOnCompletion, "notify-on-completion", "", "People to notify when done (names or IDs, comma-separated)")
 	cmd.Flags().BoolVar(&noNotifyOnCompletion, "no-notify-on-completion", false, "Clear the people notified when done")
+	addContentForceFlag(cmd)
 
 	// Register tab completion for people flags
 	completer := completion.NewCompleter(nil)
@@ -1193,6 +1439,8 @@ func resolveCompletionSubscriberIDs(ctx context.Context, app *appctx.App, input
 }
 
 func newTodosCompleteCmd() *cobra.Command {
+	var checkDeps bool
+
 	cmd := &cobra.Command{
 		Use:   "complete <id|url>...",
 		Short: "Complete todo(s)",
@@ -1202,19 +1450,25 @@ You can pass todo IDs, Basecamp URLs, or comma-separated IDs:
   basecamp todos complete 789
   basecamp todos complete 789 012 345
   basecamp todos complete 789,012,345
-  basecamp todos complete https://3.basecamp.com/123/buckets/456/todos/789`,
+  basecamp todos complete https://3.basecamp.com/123/buckets/456/todos/789
+
+With --check-deps, the output notes which todos waiting on the completed
+ones ("blocked-by: #id" in their notes) are now unblocked, and warns if a
+completed todo was itself still blocked.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if len(args) == 0 {
 				return missingArg(cmd, "<id|url>...")
 			}
-			return completeTodos(cmd, args)
+			return completeTodos(cmd, args, checkDeps)
 		},
 	}
 
+	cmd.Flags().BoolVar(&checkDeps, "check-deps", false, "Report todos unblocked by completing these (see 'todos blocked')")
+
 	return cmd
 }
 
-func completeTodos(cmd *cobra.Command, todoIDs []string) error {
+func completeTodos(cmd *cobra.Command, todoIDs []string, checkDeps bool) error {
 	app := appctx.FromContext(cmd.Context())
 	if app == nil {
 		return fmt.Errorf("app not initialized")
@@ -1288,20 +1542,23 @@ func completeTodos(cmd *cobra.Command, todoIDs []string) error {
 		},
 	}
 
+	respOpts := []output.ResponseOption{
+		output.WithEntity("todo"),
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(breadcrumbs...),
+	}
+	if checkDeps {
+		if notice := completionDepsNotice(cmd.Context(), app, completedTodos); notice != "" {
+			respOpts = append(respOpts, output.WithNotice(notice))
+		}
+	}
+
 	// Return single todo directly (like basecamp todos create does), list for multiple
 	if len(completedTodos) == 1 {
-		return app.OK(completedTodos[0],
-			output.WithEntity("todo"),
-			output.WithSummary(summary),
-			output.WithBreadcrumbs(breadcrumbs...),
-		)
+		return app.OK(completedTodos[0], respOpts...)
 	}
 
-	return app.OK(completedTodos,
-		output.WithEntity("todo"),
-		output.WithSummary(summary),
-		output.WithBreadcrumbs(breadcrumbs...),
-	)
+	return app.OK(completedTodos, respOpts...)
 }
 
 func newTodosUncompleteCmd() *cobra.Command {
@@ -1441,6 +1698,14 @@ Examples:
 				)
 			}
 
+			prompt := fmt.Sprintf("Comment on %d todo(s)?", len(todoIDs))
+			if complete {
+				prompt = fmt.Sprintf("Complete %d todo(s)?", len(todoIDs))
+			}
+			if !confirmAction(cmd, confirmDestructive, prompt, false) {
+				return nil
+			}
+
 			// Convert comment through rich text pipeline
 			commentHTML := comment
 			var mentionNotice string
@@ -1466,7 +1731,14 @@ Examples:
 				CompleteAction: complete,
 			}
 
+			// Ctrl-C stops before the next todo; the result covers the
+			// todos already swept.
+			interrupted := false
 			for _, todoID := range todoIDs {
+				if cmd.Context().Err() != nil {
+					interrupted = true
+					break
+				}
 				result.Swept = append(result.Swept, todoID)
 
 				// Add comment if specified
@@ -1512,12 +1784,15 @@ Examples:
 			if mentionNotice != "" {
 				respOpts = append(respOpts, output.WithDiagnostic(mentionNotice))
 			}
+			if interrupted {
+				respOpts = append(respOpts, output.WithInterrupted())
+			}
 			return app.OK(result, respOpts...)
 		},
 	}
 
-	cmd.Flags().StringVarP(&project, "project", "p", "", "Project ID or name")
-	cmd.Flags().StringVar(&project, "in", "", "Project ID (alias for --project)")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 	cmd.Flags().StringVarP(&todoset, "todoset", "t", "", "Todoset ID (for projects with multiple todosets)")
 	cmd.Flags().StringVar(&assignee, "assignee", "", "Filter by assignee")
 	cmd.Flags().BoolVar(&overdueOnly, "overdue", false, "Filter overdue todos")
@@ -1709,7 +1984,7 @@ func newTodosPositionCmd() *cobra.Command {
 
 	cmd := &cobra.Command{
 		Use:     "position <id|url>",
-		Aliases: []string{"move", "reorder"},
+		Aliases: []string{"reorder"},
 		Short:   "Change todo position or move between lists",
 		Long: `Reorder a todo within its todolist, or move it to a different list in the
 same project. Position is 1-based (1 = top).
@@ -1736,115 +2011,122 @@ Move to a different todolist in the same project:
 				return err
 			}
 
-			if position == 0 {
-				return output.ErrUsage("--to is required (1 = top)")
-			}
+			return runTodosPosition(cmd, app, args[0], position, list)
+		},
+	}
 
-			// Extract todo ID and project from URL if provided
-			todoIDStr, todoProjectID := extractWithProject(args[0])
+	cmd.Flags().IntVar(&position, "to", 0, "Target position, 1-based (1 = top)")
+	cmd.Flags().IntVar(&position, "position", 0, "Target position (alias for --to)")
+	cmd.Flags().StringVarP(&list, "list", "l", "", "Destination todolist ID, name, or URL (move to a different list)")
 
-			todoID, err := strconv.ParseInt(todoIDStr, 10, 64)
-			if err != nil {
-				return output.ErrUsage("Invalid todo ID")
-			}
+	return cmd
+}
 
-			// Resolve destination todolist when --list is provided
-			var parentID *int64
-			if list != "" {
-				listIDStr, listProjectID := extractWithProject(list)
+// runTodosPosition reorders a todo within its list or moves it to another
+// list in the same project (todos position, and todos move without
+// --to-project).
+func runTodosPosition(cmd *cobra.Command, app *appctx.App, arg string, position int, list string) error {
+	if position == 0 {
+		return output.ErrUsage("--to is required (1 = top)")
+	}
 
-				// When --list is a URL, validate it's a todolist URL — not a
-				// todo, project, or collection URL that would silently extract
-				// the wrong ID.
-				if parsed := urlarg.Parse(list); parsed != nil {
-					if parsed.RecordingID == "" || parsed.Type != "todolists" || parsed.IsCollection {
-						return output.ErrUsage("Expected a todolist URL (.../todolists/<id>), " +
-							"or pass a todolist ID or name.")
-					}
-				}
+	// Extract todo ID and project from URL if provided
+	todoIDStr, todoProjectID := extractWithProject(arg)
 
-				// Build project context: todo URL > --in flag > config
-				project := todoProjectID
-				if project == "" {
-					project = app.Flags.Project
-				}
-				if project == "" {
-					project = app.Config.ProjectID
-				}
+	todoID, err := strconv.ParseInt(todoIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid todo ID")
+	}
 
-				// Resolve project name to numeric ID only when needed:
-				// cross-project URL validation or todolist name resolution.
-				resolvedProject := project
-				needsResolve := (todoProjectID != "" && listProjectID != "") || !isNumeric(listIDStr)
-				if needsResolve && project != "" && !isNumeric(project) {
-					rp, _, resolveErr := app.Names.ResolveProject(cmd.Context(), project)
-					if resolveErr != nil {
-						return resolveErr
-					}
-					resolvedProject = rp
-				}
+	// Resolve destination todolist when --list is provided
+	var parentID *int64
+	if list != "" {
+		listIDStr, listProjectID := extractWithProject(list)
 
-				// Cross-project moves are not supported by the reposition endpoint.
-				// Only enforce when the todo's project comes from its URL (high
-				// confidence). Config/flag project is a default context — it may
-				// not match where a bare-ID todo actually lives.
-				if todoProjectID != "" && listProjectID != "" && resolvedProject != listProjectID {
-					return output.ErrUsageHint(
-						"Cannot move a todo to a list in a different project.",
-						"Pass a todolist from the same project; cross-project moves are not supported.",
-					)
-				}
+		// When --list is a URL, validate it's a todolist URL — not a
+		// todo, project, or collection URL that would silently extract
+		// the wrong ID.
+		if parsed := urlarg.Parse(list); parsed != nil {
+			if parsed.RecordingID == "" || parsed.Type != "todolists" || parsed.IsCollection {
+				return output.ErrUsage("Expected a todolist URL (.../todolists/<id>), " +
+					"or pass a todolist ID or name.")
+			}
+		}
 
-				// Resolve todolist name to ID when not already numeric
-				if !isNumeric(listIDStr) {
-					if resolvedProject == "" {
-						return output.ErrUsage("--in is required to resolve todolist names")
-					}
-					resolved, resolveErr := resolveTodolistInTodoset(cmd, app, listIDStr, resolvedProject, "")
-					if resolveErr != nil {
-						return resolveErr
-					}
-					listIDStr = resolved
-				}
+		// Build project context: todo URL > --in flag > config
+		project := todoProjectID
+		if project == "" {
+			project = app.Flags.Project
+		}
+		if project == "" {
+			project = app.Config.ProjectID
+		}
 
-				listID, parseErr := strconv.ParseInt(listIDStr, 10, 64)
-				if parseErr != nil {
-					return output.ErrUsage("Invalid todolist ID")
-				}
-				parentID = &listID
+		// Resolve project name to numeric ID only when needed:
+		// cross-project URL validation or todolist name resolution.
+		resolvedProject := project
+		needsResolve := (todoProjectID != "" && listProjectID != "") || !isNumeric(listIDStr)
+		if needsResolve && project != "" && !isNumeric(project) {
+			rp, _, resolveErr := app.Names.ResolveProject(cmd.Context(), project)
+			if resolveErr != nil {
+				return resolveErr
 			}
+			resolvedProject = rp
+		}
 
-			err = app.Account().Todos().Reposition(cmd.Context(), todoID, position, parentID)
-			if err != nil {
-				return convertSDKError(err)
-			}
+		// Cross-project moves are not supported by the reposition endpoint.
+		// Only enforce when the todo's project comes from its URL (high
+		// confidence). Config/flag project is a default context — it may
+		// not match where a bare-ID todo actually lives.
+		if todoProjectID != "" && listProjectID != "" && resolvedProject != listProjectID {
+			return output.ErrUsageHint(
+				"Cannot move a todo to a list in a different project.",
+				"Pass a todolist from the same project; cross-project moves are not supported.",
+			)
+		}
 
-			summary := fmt.Sprintf("Moved todo #%d to position %d", todoID, position)
-			if parentID != nil {
-				summary = fmt.Sprintf("Moved todo #%d to list #%d at position %d", todoID, *parentID, position)
+		// Resolve todolist name to ID when not already numeric
+		if !isNumeric(listIDStr) {
+			if resolvedProject == "" {
+				return output.ErrUsage("--in is required to resolve todolist names")
 			}
-
-			response := map[string]any{"repositioned": true, "position": position}
-			if parentID != nil {
-				response["todolist_id"] = *parentID
+			resolved, resolveErr := resolveTodolistInTodoset(cmd, app, listIDStr, resolvedProject, "")
+			if resolveErr != nil {
+				return resolveErr
 			}
+			listIDStr = resolved
+		}
 
-			return app.OK(response,
-				output.WithSummary(summary),
-				output.WithBreadcrumbs(
-					output.Breadcrumb{
-						Action:      "show",
-						Cmd:         fmt.Sprintf("basecamp todos show %d", todoID),
-						Description: "View todo",
-					},
-				),
-			)
-		},
+		listID, parseErr := strconv.ParseInt(listIDStr, 10, 64)
+		if parseErr != nil {
+			return output.ErrUsage("Invalid todolist ID")
+		}
+		parentID = &listID
 	}
 
-	cmd.Flags().IntVar(&position, "to", 0, "Target position, 1-based (1 = top)")
-	cmd.Flags().IntVar(&position, "position", 0, "Target position (alias for --to)")
-	cmd.Flags().StringVarP(&list, "list", "l", "", "Destination todolist ID, name, or URL (move to a different list)")
+	err = app.Account().Todos().Reposition(cmd.Context(), todoID, position, parentID)
+	if err != nil {
+		return convertSDKError(err)
+	}
 
-	return cmd
+	summary := fmt.Sprintf("Moved todo #%d to position %d", todoID, position)
+	if parentID != nil {
+		summary = fmt.Sprintf("Moved todo #%d to list #%d at position %d", todoID, *parentID, position)
+	}
+
+	response := map[string]any{"repositioned": true, "position": position}
+	if parentID != nil {
+		response["todolist_id"] = *parentID
+	}
+
+	return app.OK(response,
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         fmt.Sprintf("basecamp todos show %d", todoID),
+				Description: "View todo",
+			},
+		),
+	)
 }
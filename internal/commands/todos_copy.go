@@ -0,0 +1,336 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// Basecamp has no API for copying or moving a todo to another project. Copy
+// is built from public endpoints and says so: it creates a new todo in the
+// destination list with the original's content, description, dates, and
+// whichever assignees are also on the destination project, then leaves a
+// comment on the new todo pointing back at the original. The original is
+// untouched. Moving across projects waits on an SDK endpoint (see
+// API-COVERAGE.md); re-creating and trashing would lose the todo's ID,
+// comments, attachments, and history.
+
+// todoCopyDest is a todos copy destination.
+type todoCopyDest struct {
+	project string // --to-project: ID, name, or URL
+	list    string // --to-list: ID, name, or URL
+}
+
+// newTodosCopyCmd creates the cross-project copy subcommand.
+func newTodosCopyCmd() *cobra.Command {
+	var dest todoCopyDest
+
+	cmd := &cobra.Command{
+		Use:   "copy <id|url> --to-project <project> --to-list <list>",
+		Short: "Copy a todo as a new todo in any project's list",
+		Long: `Copy a todo as a new todo in a todolist in any project.
+
+This is not a Basecamp recording copy (the API has none): it creates a new
+todo with its own ID. The copy keeps the content, description, start and due
+dates, and completion state. Assignees and completion subscribers carry over
+when they are also on the destination project; the rest are listed in the
+output. A comment on the copy links back to the original. Comments,
+attachments, steps, and history stay with the original, which is unchanged.`,
+		Example: `  basecamp todos copy 789 --to-project "Launch" --to-list "Follow-ups"
+  basecamp todos copy https://3.basecamp.com/123/buckets/456/todos/789 --to-project 999 --to-list 321`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return missingArg(cmd, "<id|url>")
+			}
+			return runTodosCopy(cmd, args[0], dest)
+		},
+	}
+
+	cmd.Flags().StringVar(&dest.project, "to-project", "", "Destination project ID, name, or URL")
+	cmd.Flags().StringVar(&dest.list, "to-list", "", "Destination todolist ID, name, or URL")
+
+	return cmd
+}
+
+// newTodosMoveCmd creates the move subcommand: reorder a todo or move it to
+// another list in its project, like todos position, which it used to alias.
+func newTodosMoveCmd() *cobra.Command {
+	var (
+		project  string
+		toList   string
+		position int
+		list     string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "move <id|url>",
+		Short: "Move a todo within its project",
+		Long: `Move a todo to another position or todolist in the same project, keeping
+its ID, comments, and history. --to defaults to 1 (the top) when only a list
+is given.
+
+Moving a todo to another project isn't supported: Basecamp has no API for
+it. "basecamp todos copy" creates a new todo there instead. --to-project is
+accepted to name the todo's own project, for resolving --to-list by name.`,
+		Example: `  basecamp todos move 789 --to 1 --to-list "Sprint 1"
+  basecamp todos move 789 --to-project "Launch" --to-list "Follow-ups" --to 3`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return missingArg(cmd, "<id|url>")
+			}
+			if list == "" {
+				list = toList
+			}
+			if position == 0 && list == "" {
+				return output.ErrUsageHint("--to or --to-list is required",
+					"To copy a todo to another project: basecamp todos copy <id> --to-project <project> --to-list <list>")
+			}
+			if position == 0 {
+				position = 1
+			}
+
+			app := appctx.FromContext(cmd.Context())
+			if app == nil {
+				return fmt.Errorf("app not initialized")
+			}
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+			if project != "" {
+				resolvedList, err := sameProjectMoveList(cmd, app, args[0], project, list)
+				if err != nil {
+					return err
+				}
+				list = resolvedList
+			}
+			return runTodosPosition(cmd, app, args[0], position, list)
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "to-project", "", "The todo's own project (ID, name, or URL); other projects are refused")
+	cmd.Flags().StringVar(&toList, "to-list", "", "Destination todolist ID, name, or URL")
+	cmd.Flags().IntVar(&position, "to", 0, "Target position, 1-based (default 1 with a list)")
+	cmd.Flags().IntVar(&position, "position", 0, "Target position (alias for --to)")
+	cmd.Flags().StringVarP(&list, "list", "l", "", "Destination todolist (alias for --to-list)")
+
+	return cmd
+}
+
+// sameProjectMoveList checks that --to-project is the todo's own project
+// and resolves list (if any) to a todolist ID in it. Moving to another
+// project is refused with a pointer to todos copy.
+func sameProjectMoveList(cmd *cobra.Command, app *appctx.App, arg, project, list string) (string, error) {
+	todoID, err := strconv.ParseInt(extractID(arg), 10, 64)
+	if err != nil {
+		return "", output.ErrUsage("Invalid todo ID")
+	}
+	projectID, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return "", err
+	}
+
+	src, err := app.Account().Todos().Get(cmd.Context(), todoID)
+	if err != nil {
+		return "", convertSDKError(err)
+	}
+	if src.Bucket == nil || strconv.FormatInt(src.Bucket.ID, 10) != projectID {
+		hint := fmt.Sprintf("Copy it instead (a new todo; comments, attachments, and steps stay behind): basecamp todos copy %d --to-project %s --to-list <list>", todoID, projectID)
+		return "", output.ErrUsageHint(
+			fmt.Sprintf("Can't move todo #%d to another project: Basecamp has no API for it", todoID), hint)
+	}
+
+	if list == "" {
+		return "", nil
+	}
+	return resolveTodolistInTodoset(cmd, app, extractID(list), projectID, "")
+}
+
+func runTodosCopy(cmd *cobra.Command, arg string, dest todoCopyDest) error {
+	app := appctx.FromContext(cmd.Context())
+	if app == nil {
+		return fmt.Errorf("app not initialized")
+	}
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	if dest.project == "" {
+		return output.ErrUsage("--to-project is required")
+	}
+	if dest.list == "" {
+		return output.ErrUsageHint("--to-list is required",
+			fmt.Sprintf("See the lists: basecamp todolists list --in %s", dest.project))
+	}
+
+	todoID, err := strconv.ParseInt(extractID(arg), 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid todo ID")
+	}
+
+	ctx := cmd.Context()
+	projectID, projectName, err := resolveProject(cmd, app, dest.project)
+	if err != nil {
+		return err
+	}
+	listIDStr, err := resolveTodolistInTodoset(cmd, app, extractID(dest.list), projectID, "")
+	if err != nil {
+		return err
+	}
+	listID, err := strconv.ParseInt(listIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid todolist ID")
+	}
+
+	src, err := app.Account().Todos().Get(ctx, todoID)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	members, err := projectMemberIDs(ctx, app, projectID)
+	if err != nil {
+		return err
+	}
+	assignees, dropped := keepMembers(src.Assignees, members)
+	subscribers, droppedSubs := keepMembers(src.CompletionSubscribers, members)
+	for _, name := range droppedSubs {
+		if !slices.Contains(dropped, name) {
+			dropped = append(dropped, name)
+		}
+	}
+
+	copied, err := app.Account().Todos().Create(ctx, listID, &basecamp.CreateTodoRequest{
+		Content:                 src.Content,
+		Description:             src.Description,
+		AssigneeIDs:             assignees,
+		CompletionSubscriberIDs: subscribers,
+		DueOn:                   src.DueOn,
+		StartsOn:                src.StartsOn,
+	})
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	// The copy exists from here on; later failures are reported as notices
+	// so a retry doesn't create a second copy.
+	var problems []string
+	if src.Completed {
+		if err := app.Account().Todos().Complete(ctx, copied.ID); err != nil {
+			problems = append(problems, "could not mark the copy done: "+convertSDKError(err).Error())
+		} else {
+			copied.Completed = true
+		}
+	}
+	if _, err := app.Account().Comments().Create(ctx, copied.ID, &basecamp.CreateCommentRequest{
+		Content: formatCopyProvenanceHTML(src),
+	}); err != nil {
+		problems = append(problems, "could not add the provenance comment: "+convertSDKError(err).Error())
+	}
+
+	listName := ""
+	if copied.Parent != nil {
+		listName = copied.Parent.Title
+	}
+	summary := fmt.Sprintf("Copied todo #%d to %s as new todo #%d", todoID, copyDestination(projectName, listName, listID), copied.ID)
+
+	opts := []output.ResponseOption{
+		output.WithSummary(summary),
+		output.WithEntity("todo"),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         fmt.Sprintf("basecamp todos show %d", copied.ID),
+				Description: "View the new todo",
+			},
+		),
+	}
+	if notice := copyNotice(projectName, dropped, problems); notice != "" {
+		opts = append(opts, output.WithDiagnostic(notice))
+	}
+	return app.OK(copied, opts...)
+}
+
+// projectMemberIDs returns the IDs of the people on a project.
+func projectMemberIDs(ctx context.Context, app *appctx.App, projectID string) (map[int64]bool, error) {
+	bucketID, err := strconv.ParseInt(projectID, 10, 64)
+	if err != nil {
+		return nil, output.ErrUsage("Invalid project ID")
+	}
+	result, err := app.Account().People().ListProjectPeople(ctx, bucketID, nil)
+	if err != nil {
+		return nil, convertSDKError(err)
+	}
+	members := make(map[int64]bool, len(result.People))
+	for _, p := range result.People {
+		members[p.ID] = true
+	}
+	return members, nil
+}
+
+// keepMembers splits people into the IDs on the project and the names of
+// those who aren't. Basecamp would silently drop the latter.
+func keepMembers(people []basecamp.Person, members map[int64]bool) (ids []int64, dropped []string) {
+	for _, p := range people {
+		if members[p.ID] {
+			ids = append(ids, p.ID)
+		} else {
+			dropped = append(dropped, p.Name)
+		}
+	}
+	return ids, dropped
+}
+
+// formatCopyProvenanceHTML is the comment left on a copied todo.
+func formatCopyProvenanceHTML(src *basecamp.Todo) string {
+	from := html.EscapeString(src.Content)
+	if src.AppURL != "" {
+		from = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(src.AppURL), from)
+	}
+	line := "Copied from " + from
+	var where []string
+	if src.Parent != nil && src.Parent.Title != "" {
+		where = append(where, html.EscapeString(src.Parent.Title))
+	}
+	if src.Bucket != nil && src.Bucket.Name != "" {
+		where = append(where, html.EscapeString(src.Bucket.Name))
+	}
+	if len(where) > 0 {
+		line += " (" + strings.Join(where, ", ") + ")"
+	}
+	return "<div>" + line + "</div>"
+}
+
+func copyDestination(projectName, listName string, listID int64) string {
+	list := listName
+	if list == "" {
+		list = fmt.Sprintf("list #%d", listID)
+	}
+	if projectName == "" {
+		return list
+	}
+	return projectName + " / " + list
+}
+
+// copyNotice reports people left off the copy and follow-up steps that
+// failed after it was created.
+func copyNotice(projectName string, dropped, problems []string) string {
+	var parts []string
+	if len(dropped) > 0 {
+		where := "the destination project"
+		if projectName != "" {
+			where = projectName
+		}
+		parts = append(parts, fmt.Sprintf("Not on %s, so left off the copy: %s", where, strings.Join(dropped, ", ")))
+	}
+	parts = append(parts, problems...)
+	return strings.Join(parts, "; ")
+}
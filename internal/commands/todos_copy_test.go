@@ -0,0 +1,161 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// mockTodoTransferTransport serves a source todo #789 in project 456 and a
+// destination project 999 with todolist 321, recording every request.
+type mockTodoTransferTransport struct {
+	completed bool
+	requests  []string
+	created   map[string]any
+	comment   string
+	trashed   bool
+	positions []any
+}
+
+func (m *mockTodoTransferTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	path := req.URL.Path
+	m.requests = append(m.requests, req.Method+" "+path)
+
+	var body map[string]any
+	if req.Body != nil {
+		raw, _ := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		_ = json.Unmarshal(raw, &body)
+	}
+
+	switch {
+	case req.Method == "GET" && strings.HasSuffix(path, "/projects.json"):
+		return jsonResponse(200, `[{"id": 456, "name": "Origin"}, {"id": 999, "name": "Launch"}]`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/projects/999.json"):
+		return jsonResponse(200, `{"id": 999, "name": "Launch", "dock": [{"id": 55, "name": "todoset"}]}`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/projects/456.json"):
+		return jsonResponse(200, `{"id": 456, "name": "Origin", "dock": [{"id": 66, "name": "todoset"}]}`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/todosets/55/todolists.json"):
+		return jsonResponse(200, `[{"id": 321, "name": "Follow-ups"}]`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/todosets/66/todolists.json"):
+		return jsonResponse(200, `[{"id": 11, "name": "Inbox"}, {"id": 12, "name": "Later"}]`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/todos/789"):
+		return jsonResponse(200, fmt.Sprintf(`{"id": 789, "content": "Ship <it>", "description": "<div>Notes</div>",
+			"due_on": "2026-05-01", "completed": %t, "app_url": "https://3.basecamp.com/99999/buckets/456/todos/789",
+			"parent": {"id": 11, "title": "Inbox"}, "bucket": {"id": 456, "name": "Origin"},
+			"assignees": [{"id": 1, "name": "Ana"}, {"id": 2, "name": "Bo"}],
+			"completion_subscribers": [{"id": 2, "name": "Bo"}, {"id": 3, "name": "Cy"}]}`, m.completed), header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/projects/999/people.json"):
+		return jsonResponse(200, `[{"id": 1, "name": "Ana"}, {"id": 3, "name": "Cy"}]`, header), nil
+	case req.Method == "POST" && strings.HasSuffix(path, "/todolists/321/todos.json"):
+		m.created = body
+		return jsonResponse(201, `{"id": 1001, "content": "Ship <it>", "parent": {"id": 321, "title": "Follow-ups"}, "bucket": {"id": 999, "name": "Launch"}}`, header), nil
+	case req.Method == "POST" && strings.HasSuffix(path, "/todos/1001/completion.json"):
+		return jsonResponse(204, ``, header), nil
+	case req.Method == "POST" && strings.HasSuffix(path, "/recordings/1001/comments.json"):
+		m.comment, _ = body["content"].(string)
+		return jsonResponse(201, `{"id": 5000}`, header), nil
+	case req.Method == "PUT" && strings.HasSuffix(path, "/recordings/789/status/trashed.json"):
+		m.trashed = true
+		return jsonResponse(204, ``, header), nil
+	case req.Method == "PUT" && strings.HasSuffix(path, "/todos/789/position.json"):
+		m.positions = append(m.positions, body["position"])
+		return jsonResponse(204, ``, header), nil
+	}
+	return nil, fmt.Errorf("unexpected request: %s %s", req.Method, path)
+}
+
+func TestTodosCopyAcrossProjects(t *testing.T) {
+	transport := &mockTodoTransferTransport{}
+	app, out := setupProjectsMockApp(t, transport)
+
+	err := executeCommand(NewTodosCmd(), app, "copy", "789", "--to-project", "Launch", "--to-list", "Follow-ups")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Ship <it>", transport.created["content"])
+	assert.Equal(t, "<div>Notes</div>", transport.created["description"])
+	assert.Equal(t, "2026-05-01", transport.created["due_on"])
+	assert.Equal(t, []any{float64(1)}, transport.created["assignee_ids"], "Bo is not on the destination project")
+	assert.Equal(t, []any{float64(3)}, transport.created["completion_subscriber_ids"])
+
+	assert.Contains(t, transport.comment, "Copied from")
+	assert.Contains(t, transport.comment, `href="https://3.basecamp.com/99999/buckets/456/todos/789"`)
+	assert.Contains(t, transport.comment, "Ship &lt;it&gt;")
+	assert.Contains(t, transport.comment, "(Inbox, Origin)")
+	assert.False(t, transport.trashed, "copy keeps the original")
+
+	var resp struct {
+		Data    basecamp.Todo `json:"data"`
+		Summary string        `json:"summary"`
+		Notice  string        `json:"notice"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &resp))
+	assert.Equal(t, int64(1001), resp.Data.ID)
+	assert.Equal(t, "Copied todo #789 to Launch / Follow-ups as new todo #1001", resp.Summary)
+	assert.Equal(t, "Not on Launch, so left off the copy: Bo", resp.Notice)
+}
+
+func TestTodosMoveAcrossProjectsIsRefused(t *testing.T) {
+	transport := &mockTodoTransferTransport{}
+	app, _ := setupProjectsMockApp(t, transport)
+
+	err := executeCommand(NewTodosCmd(), app, "move", "789", "--to-project", "999", "--to-list", "321")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Can't move todo #789 to another project")
+
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Hint, "basecamp todos copy 789 --to-project 999")
+
+	assert.Nil(t, transport.created, "no copy is created")
+	assert.False(t, transport.trashed)
+	assert.Empty(t, transport.positions)
+}
+
+func TestTodosMoveWithinProjectRepositions(t *testing.T) {
+	transport := &mockTodoTransferTransport{}
+	app, _ := setupProjectsMockApp(t, transport)
+
+	err := executeCommand(NewTodosCmd(), app, "move", "789", "--to-project", "Origin", "--to-list", "Later", "--to", "3")
+	require.NoError(t, err)
+	assert.Equal(t, []any{float64(3)}, transport.positions, "same-project moves keep the todo and the requested position")
+	assert.Nil(t, transport.created)
+	assert.False(t, transport.trashed)
+
+	err = executeCommand(NewTodosCmd(), app, "move", "789", "--to-project", "Origin", "--to-list", "Later")
+	require.NoError(t, err)
+	assert.Equal(t, []any{float64(3), float64(1)}, transport.positions, "position defaults to the top")
+}
+
+func TestTodosMoveWithoutProjectActsAsPosition(t *testing.T) {
+	transport := &mockTodoTransferTransport{}
+	app, _ := setupProjectsMockApp(t, transport)
+
+	err := executeCommand(NewTodosCmd(), app, "move", "789", "--to", "2")
+	require.NoError(t, err)
+	assert.Equal(t, []any{float64(2)}, transport.positions)
+
+	err = executeCommand(NewTodosCmd(), app, "move", "789")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--to or --to-list is required")
+}
+
+func TestTodosCopyRequiresList(t *testing.T) {
+	app, _ := setupProjectsMockApp(t, &mockTodoTransferTransport{})
+
+	err := executeCommand(NewTodosCmd(), app, "copy", "789", "--to-project", "Launch")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--to-list is required")
+}
@@ -0,0 +1,542 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// Todo dependencies are a CLI convention rather than a Basecamp feature: a
+// line in a todo's notes such as
+//
+//	blocked-by: #123, #456
+//
+// marks it as waiting on todos 123 and 456. Blockers may also be written as
+// Basecamp todo URLs. The CLI parses these lines on demand; nothing is
+// stored anywhere else.
+
+var (
+	blockedByLineRe = regexp.MustCompile(`(?im)^[\s>*-]*blocked[ -]by\s*:(.*)$`)
+	blockedByRefRe  = regexp.MustCompile(`(?:/todos/|#)(\d+)`)
+	htmlLineBreakRe = regexp.MustCompile(`(?i)<br\s*/?>|</(?:div|p|li|h[1-6]|blockquote)>`)
+)
+
+// todoDepsMaxDepth bounds how far `todos deps` follows a blocker chain.
+const todoDepsMaxDepth = 20
+
+// parseBlockedBy returns the todo IDs named on blocked-by lines of a todo
+// description (HTML or Markdown), in order and without duplicates.
+func parseBlockedBy(description string) []int64 {
+	if description == "" {
+		return nil
+	}
+	text := description
+	if richtext.IsHTML(text) {
+		// Convert block by block: the converter runs adjacent <div>s
+		// together, which would hide a blocked-by line mid-paragraph.
+		blocks := strings.Split(htmlLineBreakRe.ReplaceAllString(text, "$0\n"), "\n")
+		for i, block := range blocks {
+			blocks[i] = richtext.HTMLToMarkdown(block)
+		}
+		text = strings.Join(blocks, "\n")
+	}
+
+	var ids []int64
+	seen := make(map[int64]bool)
+	for _, line := range blockedByLineRe.FindAllStringSubmatch(text, -1) {
+		for _, ref := range blockedByRefRe.FindAllStringSubmatch(line[1], -1) {
+			id, err := strconv.ParseInt(ref[1], 10, 64)
+			if err != nil || seen[id] {
+				continue
+			}
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// todoDepRef identifies one side of a dependency.
+type todoDepRef struct {
+	ID        int64  `json:"id"`
+	Content   string `json:"content"`
+	Completed bool   `json:"completed"`
+	AppURL    string `json:"app_url,omitempty"`
+	Missing   bool   `json:"missing,omitempty"`
+}
+
+func (r todoDepRef) open() bool { return !r.Completed && !r.Missing }
+
+// todoDepIndex caches todos by ID, fetching ones it hasn't seen. Todos that
+// can't be fetched (trashed, inaccessible) are remembered as missing and
+// never block anything.
+type todoDepIndex struct {
+	app   *appctx.App
+	todos map[int64]*basecamp.Todo
+}
+
+func newTodoDepIndex(app *appctx.App, known []basecamp.Todo) *todoDepIndex {
+	idx := &todoDepIndex{app: app, todos: make(map[int64]*basecamp.Todo, len(known))}
+	for i := range known {
+		idx.todos[known[i].ID] = &known[i]
+	}
+	return idx
+}
+
+func (idx *todoDepIndex) get(ctx context.Context, id int64) *basecamp.Todo {
+	if todo, ok := idx.todos[id]; ok {
+		return todo
+	}
+	todo, err := idx.app.Account().Todos().Get(ctx, id)
+	if err != nil {
+		todo = nil
+	}
+	idx.todos[id] = todo
+	return todo
+}
+
+func (idx *todoDepIndex) ref(ctx context.Context, id int64) todoDepRef {
+	todo := idx.get(ctx, id)
+	if todo == nil {
+		return todoDepRef{ID: id, Missing: true}
+	}
+	return todoDepRefFor(todo)
+}
+
+func todoDepRefFor(todo *basecamp.Todo) todoDepRef {
+	return todoDepRef{ID: todo.ID, Content: todo.Content, Completed: todo.Completed, AppURL: todo.AppURL}
+}
+
+// openBlockers returns the still-open todos that todo is waiting on.
+func (idx *todoDepIndex) openBlockers(ctx context.Context, todo *basecamp.Todo) []todoDepRef {
+	var open []todoDepRef
+	for _, id := range parseBlockedBy(todo.Description) {
+		if id == todo.ID {
+			continue
+		}
+		if ref := idx.ref(ctx, id); ref.open() {
+			open = append(open, ref)
+		}
+	}
+	return open
+}
+
+// fetchOpenProjectTodos returns the incomplete todos in every todoset of a
+// project, including todos in groups and todos outside any list. Lists that
+// fail to load are skipped.
+func fetchOpenProjectTodos(ctx context.Context, app *appctx.App, projectID int64, todosetIDs []int64) ([]basecamp.Todo, error) {
+	var todos []basecamp.Todo
+	for _, todosetID := range todosetIDs {
+		lists, err := app.Account().Todolists().List(ctx, todosetID, nil)
+		if err != nil {
+			return nil, convertSDKError(err)
+		}
+		for _, tl := range lists.Todolists {
+			listTodos, _, err := fetchTodosIncludingGroups(ctx, app, tl.ID, "", false, -1, false)
+			if err != nil {
+				continue
+			}
+			todos = append(todos, listTodos...)
+		}
+		todos = append(todos, fetchTodosetLevelTodos(ctx, app, projectID, todosetID, "", false, -1)...)
+	}
+	return todos, nil
+}
+
+// projectTodosetIDs returns the IDs of a project's enabled todosets.
+func projectTodosetIDs(ctx context.Context, app *appctx.App, projectID int64) ([]int64, error) {
+	enabled, _, err := getDockTools(ctx, app, strconv.FormatInt(projectID, 10), "todoset")
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]int64, 0, len(enabled))
+	for _, tool := range enabled {
+		ids = append(ids, tool.ID)
+	}
+	return ids, nil
+}
+
+// dependentsOf returns the open todos in todos whose blocked-by lines name id.
+func dependentsOf(todos []basecamp.Todo, id int64) []basecamp.Todo {
+	var out []basecamp.Todo
+	for _, t := range todos {
+		if t.Completed || t.ID == id {
+			continue
+		}
+		for _, b := range parseBlockedBy(t.Description) {
+			if b == id {
+				out = append(out, t)
+				break
+			}
+		}
+	}
+	return out
+}
+
+// blockedTodo is one row of `todos blocked`.
+type blockedTodo struct {
+	ID        int64        `json:"id"`
+	Content   string       `json:"content"`
+	DueOn     string       `json:"due_on,omitempty"`
+	Assignees []string     `json:"assignees,omitempty"`
+	AppURL    string       `json:"app_url,omitempty"`
+	BlockedBy []todoDepRef `json:"blocked_by"`
+}
+
+func newTodosBlockedCmd() *cobra.Command {
+	var project string
+	var todoset string
+
+	cmd := &cobra.Command{
+		Use:   "blocked",
+		Short: "List todos waiting on other todos",
+		Long: `List open todos that are blocked by other open todos.
+
+Dependencies are declared in a todo's notes with a line like:
+
+  blocked-by: #123, #456
+
+Blockers may be todo IDs or Basecamp todo URLs. A todo stops being blocked
+once all of its blockers are completed.`,
+		Example: `  basecamp todos blocked --in "Launch"
+  basecamp todos blocked --in "Launch" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTodosBlocked(cmd, project, todoset)
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL")
+	cmd.Flags().StringVarP(&todoset, "todoset", "t", "", "Todoset ID (for projects with multiple todosets)")
+
+	completer := completion.NewCompleter(nil)
+	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
+
+	return cmd
+}
+
+func runTodosBlocked(cmd *cobra.Command, project, todosetFlag string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProject, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return err
+	}
+	projectID, err := strconv.ParseInt(resolvedProject, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+
+	todosetIDStr, err := ensureTodoset(cmd, app, resolvedProject, todosetFlag)
+	if err != nil {
+		return err
+	}
+	todosetID, err := strconv.ParseInt(todosetIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid todoset ID")
+	}
+
+	todos, err := fetchOpenProjectTodos(cmd.Context(), app, projectID, []int64{todosetID})
+	if err != nil {
+		return err
+	}
+
+	idx := newTodoDepIndex(app, todos)
+	blocked := []blockedTodo{}
+	for i := range todos {
+		open := idx.openBlockers(cmd.Context(), &todos[i])
+		if len(open) == 0 {
+			continue
+		}
+		row := blockedTodo{
+			ID:        todos[i].ID,
+			Content:   todos[i].Content,
+			DueOn:     todos[i].DueOn,
+			AppURL:    todos[i].AppURL,
+			BlockedBy: open,
+		}
+		for _, a := range todos[i].Assignees {
+			row.Assignees = append(row.Assignees, a.Name)
+		}
+		blocked = append(blocked, row)
+	}
+
+	return app.OK(blocked,
+		output.WithSummary(fmt.Sprintf("%d blocked todos", len(blocked))),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderTodosBlockedStyled(w, r, blocked) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "deps",
+				Cmd:         "basecamp todos deps <id>",
+				Description: "Show a todo's dependency chain",
+			},
+			output.Breadcrumb{
+				Action:      "complete",
+				Cmd:         "basecamp todos complete <id> --check-deps",
+				Description: "Complete a blocker and report what it unblocks",
+			},
+		),
+	)
+}
+
+func renderTodosBlockedStyled(w io.Writer, r *output.Renderer, rows []blockedTodo) {
+	fmt.Fprintln(w, r.Summary.Render(fmt.Sprintf("%d blocked todos", len(rows))))
+	for _, row := range rows {
+		fmt.Fprintf(w, "\n%s %s\n", row.Content, r.Muted.Render(fmt.Sprintf("#%d", row.ID)))
+		for _, b := range row.BlockedBy {
+			fmt.Fprintf(w, "  waiting on %s %s\n", b.Content, r.Muted.Render(fmt.Sprintf("#%d", b.ID)))
+		}
+	}
+}
+
+// todoDepNode is a todo and, recursively, the todos it is blocked by.
+type todoDepNode struct {
+	todoDepRef
+	BlockedBy []todoDepNode `json:"blocked_by,omitempty"`
+	Cycle     bool          `json:"cycle,omitempty"`
+}
+
+type todoDepsResult struct {
+	Todo    todoDepNode  `json:"todo"`
+	Blocked bool         `json:"blocked"`
+	Blocks  []todoDepRef `json:"blocks"`
+}
+
+func newTodosDepsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deps <id|url>",
+		Short: "Show a todo's dependency chain",
+		Long: `Show the todos a todo is waiting on, recursively, and the open todos
+waiting on it.
+
+Dependencies come from "blocked-by: #123" lines in todo notes. See
+'basecamp todos blocked' for the convention.`,
+		Example: `  basecamp todos deps 789
+  basecamp todos deps https://3.basecamp.com/123/buckets/456/todos/789 --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) == 0 {
+				return missingArg(cmd, "<id|url>")
+			}
+			return runTodosDeps(cmd, args[0])
+		},
+	}
+
+	return cmd
+}
+
+func runTodosDeps(cmd *cobra.Command, arg string) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	todoID, err := strconv.ParseInt(extractID(arg), 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid todo ID")
+	}
+
+	todo, err := app.Account().Todos().Get(cmd.Context(), todoID)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	idx := newTodoDepIndex(app, []basecamp.Todo{*todo})
+	root := buildTodoDepTree(cmd.Context(), idx, todo, map[int64]bool{}, 0)
+
+	result := todoDepsResult{Todo: root, Blocks: []todoDepRef{}}
+	for _, b := range root.BlockedBy {
+		if b.open() {
+			result.Blocked = true
+		}
+	}
+
+	// Dependents can only be found by scanning the project's todos.
+	var notice string
+	if todo.Bucket != nil {
+		todosetIDs, err := projectTodosetIDs(cmd.Context(), app, todo.Bucket.ID)
+		var projectTodos []basecamp.Todo
+		if err == nil {
+			projectTodos, err = fetchOpenProjectTodos(cmd.Context(), app, todo.Bucket.ID, todosetIDs)
+		}
+		if err != nil {
+			notice = "Could not scan the project for todos blocked by this one"
+		}
+		for _, d := range dependentsOf(projectTodos, todo.ID) {
+			result.Blocks = append(result.Blocks, todoDepRefFor(&d))
+		}
+	}
+
+	summary := fmt.Sprintf("#%d: blocked by %d, blocks %d", todo.ID, len(root.BlockedBy), len(result.Blocks))
+	opts := []output.ResponseOption{
+		output.WithSummary(summary),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderTodosDepsStyled(w, r, result) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         fmt.Sprintf("basecamp todos show %d", todo.ID),
+				Description: "Show todo details",
+			},
+			output.Breadcrumb{
+				Action:      "complete",
+				Cmd:         fmt.Sprintf("basecamp todos complete %d --check-deps", todo.ID),
+				Description: "Complete and report what it unblocks",
+			},
+		),
+	}
+	if notice != "" {
+		opts = append(opts, output.WithDiagnostic(notice))
+	}
+	return app.OK(result, opts...)
+}
+
+// buildTodoDepTree expands a todo's blockers depth-first. A todo already on
+// the current path is marked as a cycle instead of being expanded again.
+func buildTodoDepTree(ctx context.Context, idx *todoDepIndex, todo *basecamp.Todo, path map[int64]bool, depth int) todoDepNode {
+	node := todoDepNode{todoDepRef: todoDepRefFor(todo)}
+	if depth >= todoDepsMaxDepth {
+		return node
+	}
+
+	path[todo.ID] = true
+	defer delete(path, todo.ID)
+
+	for _, id := range parseBlockedBy(todo.Description) {
+		if path[id] {
+			child := todoDepNode{todoDepRef: idx.ref(ctx, id), Cycle: true}
+			node.BlockedBy = append(node.BlockedBy, child)
+			continue
+		}
+		blocker := idx.get(ctx, id)
+		if blocker == nil {
+			node.BlockedBy = append(node.BlockedBy, todoDepNode{todoDepRef: todoDepRef{ID: id, Missing: true}})
+			continue
+		}
+		node.BlockedBy = append(node.BlockedBy, buildTodoDepTree(ctx, idx, blocker, path, depth+1))
+	}
+	return node
+}
+
+func renderTodosDepsStyled(w io.Writer, r *output.Renderer, result todoDepsResult) {
+	label := func(ref todoDepRef) string {
+		state := "open"
+		switch {
+		case ref.Missing:
+			return r.Muted.Render(fmt.Sprintf("#%d (not found)", ref.ID))
+		case ref.Completed:
+			state = "done"
+		}
+		return fmt.Sprintf("%s %s", ref.Content, r.Muted.Render(fmt.Sprintf("#%d %s", ref.ID, state)))
+	}
+
+	var walk func(n todoDepNode, prefix string)
+	walk = func(n todoDepNode, prefix string) {
+		for i, c := range n.BlockedBy {
+			branch, next := "├── ", "│   "
+			if i == len(n.BlockedBy)-1 {
+				branch, next = "└── ", "    "
+			}
+			suffix := ""
+			if c.Cycle {
+				suffix = " " + r.Muted.Render("(cycle)")
+			}
+			fmt.Fprintf(w, "%s%s%s%s\n", prefix, branch, label(c.todoDepRef), suffix)
+			walk(c, prefix+next)
+		}
+	}
+
+	fmt.Fprintln(w, r.Summary.Render(label(result.Todo.todoDepRef)))
+	if len(result.Todo.BlockedBy) == 0 {
+		fmt.Fprintln(w, r.Muted.Render("Not blocked by anything"))
+	} else {
+		walk(result.Todo, "")
+	}
+
+	if len(result.Blocks) > 0 {
+		fmt.Fprintf(w, "\nBlocks:\n")
+		for _, b := range result.Blocks {
+			fmt.Fprintf(w, "  %s\n", label(b))
+		}
+	}
+}
+
+// completionDepsNotice describes how completing todos affects dependencies:
+// which dependents are now unblocked or still waiting, and whether a
+// completed todo was itself still blocked. Returns "" when there is nothing
+// to report. Lookup failures are ignored; this is advisory only.
+func completionDepsNotice(ctx context.Context, app *appctx.App, todos []basecamp.Todo) string {
+	// A todo that couldn't be re-fetched after completing is a bare stub;
+	// it is still completed as far as its dependents are concerned.
+	completed := make([]basecamp.Todo, len(todos))
+	copy(completed, todos)
+	for i := range completed {
+		completed[i].Completed = true
+	}
+	idx := newTodoDepIndex(app, completed)
+	projects := make(map[int64][]basecamp.Todo)
+
+	var notes []string
+	for i := range completed {
+		todo := &completed[i]
+
+		if open := idx.openBlockers(ctx, todo); len(open) > 0 {
+			notes = append(notes, fmt.Sprintf("#%d was still blocked by %s", todo.ID, formatDepRefs(open)))
+		}
+
+		if todo.Bucket == nil {
+			continue
+		}
+		projectTodos, ok := projects[todo.Bucket.ID]
+		if !ok {
+			todosetIDs, err := projectTodosetIDs(ctx, app, todo.Bucket.ID)
+			if err == nil {
+				projectTodos, _ = fetchOpenProjectTodos(ctx, app, todo.Bucket.ID, todosetIDs)
+			}
+			for j := range projectTodos {
+				if _, known := idx.todos[projectTodos[j].ID]; !known {
+					idx.todos[projectTodos[j].ID] = &projectTodos[j]
+				}
+			}
+			projects[todo.Bucket.ID] = projectTodos
+		}
+
+		var unblocked, waiting []string
+		for _, d := range dependentsOf(projectTodos, todo.ID) {
+			if open := idx.openBlockers(ctx, &d); len(open) > 0 {
+				waiting = append(waiting, fmt.Sprintf("#%d (still waiting on %s)", d.ID, formatDepRefs(open)))
+			} else {
+				unblocked = append(unblocked, fmt.Sprintf("#%d", d.ID))
+			}
+		}
+		if len(unblocked) > 0 {
+			notes = append(notes, fmt.Sprintf("#%d unblocked %s", todo.ID, strings.Join(unblocked, ", ")))
+		}
+		if len(waiting) > 0 {
+			notes = append(notes, fmt.Sprintf("#%d was blocking %s", todo.ID, strings.Join(waiting, ", ")))
+		}
+	}
+	return strings.Join(notes, "; ")
+}
+
+func formatDepRefs(refs []todoDepRef) string {
+	parts := make([]string, len(refs))
+	for i, ref := range refs {
+		parts[i] = fmt.Sprintf("#%d", ref.ID)
+	}
+	return strings.Join(parts, ", ")
+}
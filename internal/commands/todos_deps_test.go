@@ -0,0 +1,133 @@
+package commands
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBlockedBy(t *testing.T) {
+	tests := []struct {
+		name string
+		desc string
+		want []int64
+	}{
+		{"plain", "blocked-by: #12", []int64{12}},
+		{"html", "<div>Ship it</div><div>Blocked by: #12, #34</div>", []int64{12, 34}},
+		{"urls", "blocked-by: https://3.basecamp.com/1/buckets/2/todos/56", []int64{56}},
+		{"bullet and dedupe", "- blocked-by: #7\n* blocked-by: #7 #8", []int64{7, 8}},
+		{"mentions elsewhere ignored", "see #99\nnot blocked by anything", nil},
+		{"empty", "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, parseBlockedBy(tt.desc))
+		})
+	}
+}
+
+func TestDependentsOf(t *testing.T) {
+	todos := []basecamp.Todo{
+		{ID: 1, Description: "blocked-by: #10"},
+		{ID: 2, Description: "blocked-by: #10", Completed: true},
+		{ID: 3, Description: "blocked-by: #11"},
+		{ID: 10, Description: "blocked-by: #10"},
+	}
+
+	deps := dependentsOf(todos, 10)
+	require.Len(t, deps, 1, "completed todos and self-references are skipped")
+	assert.Equal(t, int64(1), deps[0].ID)
+}
+
+// newSeededTodoDepIndex builds an index that never fetches: every ID in
+// missing resolves as not found.
+func newSeededTodoDepIndex(known []basecamp.Todo, missing ...int64) *todoDepIndex {
+	idx := newTodoDepIndex(nil, known)
+	for _, id := range missing {
+		idx.todos[id] = nil
+	}
+	return idx
+}
+
+func TestBuildTodoDepTreeMarksCyclesAndMissing(t *testing.T) {
+	todos := []basecamp.Todo{
+		{ID: 1, Content: "Launch", Description: "blocked-by: #2, #9"},
+		{ID: 2, Content: "QA", Description: "blocked-by: #3"},
+		{ID: 3, Content: "Fix bugs", Description: "blocked-by: #1"},
+	}
+	idx := newSeededTodoDepIndex(todos, 9)
+
+	tree := buildTodoDepTree(context.Background(), idx, &todos[0], map[int64]bool{}, 0)
+
+	require.Len(t, tree.BlockedBy, 2)
+	qa := tree.BlockedBy[0]
+	assert.Equal(t, int64(2), qa.ID)
+	require.Len(t, qa.BlockedBy, 1)
+	fix := qa.BlockedBy[0]
+	assert.Equal(t, int64(3), fix.ID)
+	require.Len(t, fix.BlockedBy, 1)
+	assert.True(t, fix.BlockedBy[0].Cycle)
+	assert.Equal(t, int64(1), fix.BlockedBy[0].ID)
+	assert.Empty(t, fix.BlockedBy[0].BlockedBy, "a cycle is not expanded again")
+
+	assert.True(t, tree.BlockedBy[1].Missing)
+}
+
+func TestOpenBlockersIgnoresCompletedAndMissing(t *testing.T) {
+	todos := []basecamp.Todo{
+		{ID: 1, Description: "blocked-by: #1 #2 #3 #4"},
+		{ID: 2, Completed: true},
+		{ID: 3},
+	}
+	idx := newSeededTodoDepIndex(todos, 4)
+
+	open := idx.openBlockers(context.Background(), &todos[0])
+	require.Len(t, open, 1)
+	assert.Equal(t, int64(3), open[0].ID)
+}
+
+// todoDepsGetTransport serves GET /todos/{id} for an open todo 2 and a
+// completed todo 3; anything else is not found.
+type todoDepsGetTransport struct{}
+
+func (todoDepsGetTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	status, body := 404, `{"error":"not found"}`
+	switch req.URL.Path {
+	case "/99999/todos/2":
+		status, body = 200, `{"id":2,"content":"Write spec","completed":false}`
+	case "/99999/todos/3":
+		status, body = 200, `{"id":3,"content":"Kickoff","completed":true}`
+	}
+	return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body)), Header: header}, nil
+}
+
+func TestCompletionDepsNoticeReportsOwnOpenBlockers(t *testing.T) {
+	app := showTestApp(t, todoDepsGetTransport{})
+
+	// Without a bucket no project scan happens, so only the todo's own
+	// blockers are considered.
+	notice := completionDepsNotice(context.Background(), app, []basecamp.Todo{
+		{ID: 1, Description: "blocked-by: #2, #3, #4"},
+	})
+	assert.Equal(t, "#1 was still blocked by #2", notice)
+
+	assert.Empty(t, completionDepsNotice(context.Background(), app, []basecamp.Todo{{ID: 5}}))
+}
+
+func TestCompletionDepsNoticeTreatsBatchAsCompleted(t *testing.T) {
+	// Completing a blocker together with the todo it blocks is not a warning,
+	// even when the blocker came back as a bare stub.
+	notice := completionDepsNotice(context.Background(), nil, []basecamp.Todo{
+		{ID: 1, Description: "blocked-by: #2"},
+		{ID: 2},
+	})
+	assert.Empty(t, notice)
+}
@@ -0,0 +1,426 @@
+package commands
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/dateparse"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+)
+
+// todoTemplateDueRe matches a due date relative to the template's start
+// date: "+3d", "+2w", "-1d", "+5" (days), optionally followed by
+// "from start".
+var todoTemplateDueRe = regexp.MustCompile(`^([+-])(\d+)\s*([dw]?)(?:\s+from\s+start)?$`)
+
+// todoTemplate is a todolist and its todos, read from a YAML or Markdown
+// file for todos create --from-template.
+type todoTemplate struct {
+	Name        string             `yaml:"name" json:"name"`
+	Description string             `yaml:"description" json:"description,omitempty"`
+	Vars        map[string]string  `yaml:"vars" json:"vars,omitempty"`
+	Todos       []todoTemplateItem `yaml:"todos" json:"todos"`
+}
+
+// todoTemplateItem is one todo in a template. Due is a date relative to
+// the start date ("+3d") or anything --due accepts.
+type todoTemplateItem struct {
+	Content     string   `yaml:"content" json:"content"`
+	Description string   `yaml:"description" json:"description,omitempty"`
+	Assignees   []string `yaml:"assignees" json:"assignees,omitempty"`
+	Due         string   `yaml:"due" json:"due_on,omitempty"`
+}
+
+// todoTemplateOpts are the todos create flags that apply to a template.
+type todoTemplateOpts struct {
+	path     string
+	vars     []string
+	start    string
+	project  string
+	todoset  string
+	todolist string
+	dryRun   bool
+}
+
+func runTodosFromTemplate(cmd *cobra.Command, app *appctx.App, opts todoTemplateOpts) error {
+	data, err := os.ReadFile(opts.path) //nolint:gosec // G304: Path is the user's --from-template argument
+	if err != nil {
+		return output.ErrUsage(fmt.Sprintf("Can't read template: %v", err))
+	}
+	tmpl, err := parseTodoTemplate(opts.path, data)
+	if err != nil {
+		return err
+	}
+	for _, kv := range opts.vars {
+		name, value, ok := strings.Cut(kv, "=")
+		if !ok || name == "" {
+			return output.ErrUsage(fmt.Sprintf("--var must be name=value, got %q", kv))
+		}
+		if tmpl.Vars == nil {
+			tmpl.Vars = make(map[string]string)
+		}
+		tmpl.Vars[name] = value
+	}
+
+	start := time.Now()
+	if opts.start != "" {
+		parsed := dateparse.Parse(opts.start)
+		if start, err = time.ParseInLocation("2006-01-02", parsed, time.Local); err != nil {
+			return output.ErrUsage(fmt.Sprintf("Invalid --start date: %q", opts.start))
+		}
+	}
+	if err := tmpl.expand(start); err != nil {
+		return err
+	}
+	if opts.todolist == "" && tmpl.Name == "" {
+		return output.ErrUsageHint("Template has no todolist name",
+			"Add name: (YAML) or a # heading (Markdown), or pass --list to fill an existing todolist")
+	}
+
+	if opts.dryRun {
+		return app.OK(tmpl,
+			output.WithSummary(fmt.Sprintf("%s: %d %s (dry run)", tmpl.title(opts.path), len(tmpl.Todos), pluralize(len(tmpl.Todos), "todo", "todos"))),
+		)
+	}
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+	projectID, err := resolveProjectID(cmd, app, opts.project)
+	if err != nil {
+		return err
+	}
+
+	// Resolve every assignee before creating anything, so a typo doesn't
+	// leave a half-built list behind.
+	people := make(map[string]int64)
+	for _, item := range tmpl.Todos {
+		for _, name := range item.Assignees {
+			if _, ok := people[name]; ok {
+				continue
+			}
+			idStr, _, err := app.Names.ResolvePerson(cmd.Context(), name)
+			if err != nil {
+				return fmt.Errorf("failed to resolve assignee '%s': %w", name, err)
+			}
+			id, err := strconv.ParseInt(idStr, 10, 64)
+			if err != nil {
+				return output.ErrUsage("Invalid assignee ID: " + idStr)
+			}
+			people[name] = id
+		}
+	}
+
+	var list *basecamp.Todolist
+	if opts.todolist != "" {
+		listIDStr, err := resolveTodolistInTodoset(cmd, app, opts.todolist, projectID, opts.todoset)
+		if err != nil {
+			return err
+		}
+		listID, err := strconv.ParseInt(listIDStr, 10, 64)
+		if err != nil {
+			return output.ErrUsage("Invalid todolist ID")
+		}
+		if list, err = app.Account().Todolists().Get(cmd.Context(), listID); err != nil {
+			return convertSDKError(err)
+		}
+	} else {
+		todosetIDStr, err := ensureTodoset(cmd, app, projectID, opts.todoset)
+		if err != nil {
+			return err
+		}
+		todosetID, err := strconv.ParseInt(todosetIDStr, 10, 64)
+		if err != nil {
+			return output.ErrUsage("Invalid todoset ID")
+		}
+		req := &basecamp.CreateTodolistRequest{Name: tmpl.Name}
+		if tmpl.Description != "" {
+			req.Description = richtext.MarkdownToHTML(tmpl.Description)
+		}
+		if list, err = app.Account().Todolists().Create(cmd.Context(), todosetID, req); err != nil {
+			return convertSDKError(err)
+		}
+	}
+
+	todos := make([]basecamp.Todo, 0, len(tmpl.Todos))
+	for _, item := range tmpl.Todos {
+		req := &basecamp.CreateTodoRequest{Content: item.Content, DueOn: item.Due}
+		if item.Description != "" {
+			req.Description = richtext.MarkdownToHTML(item.Description)
+		}
+		for _, name := range item.Assignees {
+			req.AssigneeIDs = append(req.AssigneeIDs, people[name])
+		}
+		todo, err := app.Account().Todos().Create(cmd.Context(), list.ID, req)
+		if err != nil {
+			e := output.AsError(convertSDKError(err))
+			e.Hint = fmt.Sprintf("Todolist #%d has %d of %d todos; add the rest with: basecamp todos create <content> --list %d --in %s",
+				list.ID, len(todos), len(tmpl.Todos), list.ID, projectID)
+			return e
+		}
+		todos = append(todos, *todo)
+	}
+
+	return app.OK(map[string]any{
+		"todolist": list,
+		"todos":    todos,
+	},
+		output.WithSummary(fmt.Sprintf("Created %d %s in todolist #%d: %s", len(todos), pluralize(len(todos), "todo", "todos"), list.ID, list.Name)),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "list",
+				Cmd:         fmt.Sprintf("basecamp todos --list %d --in %s", list.ID, projectID),
+				Description: "List the todos",
+			},
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         fmt.Sprintf("basecamp todolists show %d --in %s", list.ID, projectID),
+				Description: "View the todolist",
+			},
+		),
+	)
+}
+
+// parseTodoTemplate reads a template as Markdown (.md, .markdown) or YAML.
+func parseTodoTemplate(path string, data []byte) (*todoTemplate, error) {
+	var tmpl *todoTemplate
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		tmpl, err = parseTodoTemplateMarkdown(data)
+	default:
+		tmpl, err = parseTodoTemplateYAML(data)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(tmpl.Todos) == 0 {
+		return nil, output.ErrUsage("Template has no todos")
+	}
+	for i, item := range tmpl.Todos {
+		if strings.TrimSpace(item.Content) == "" {
+			return nil, output.ErrUsage(fmt.Sprintf("Template todo %d has no content", i+1))
+		}
+	}
+	return tmpl, nil
+}
+
+func parseTodoTemplateYAML(data []byte) (*todoTemplate, error) {
+	var tmpl todoTemplate
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&tmpl); err != nil {
+		return nil, output.ErrUsage(fmt.Sprintf("Invalid template: %v", err))
+	}
+	return &tmpl, nil
+}
+
+// parseTodoTemplateMarkdown reads a Markdown template: optional YAML front
+// matter (for vars), a "# " heading naming the todolist, paragraphs before
+// the first item as its description, and one todo per list item. Lines
+// indented under an item are its description; @Name (or @First.Last) and
+// due:<date> words in an item set its assignees and due date.
+//
+//	# Release {{.vars.version}}
+//	- [ ] Cut the release branch @me due:+0d
+//	- [ ] Write the changelog @Jane.Smith due:+2d
+//	  Cover every merged PR since the last tag.
+func parseTodoTemplateMarkdown(data []byte) (*todoTemplate, error) {
+	var tmpl todoTemplate
+	text := strings.ReplaceAll(string(data), "\r\n", "\n")
+
+	if rest, ok := strings.CutPrefix(text, "---\n"); ok {
+		front, body, found := strings.Cut(rest, "\n---\n")
+		if !found {
+			return nil, output.ErrUsage("Invalid template: front matter has no closing ---")
+		}
+		dec := yaml.NewDecoder(strings.NewReader(front))
+		dec.KnownFields(true)
+		var meta struct {
+			Vars map[string]string `yaml:"vars"`
+		}
+		if err := dec.Decode(&meta); err != nil {
+			return nil, output.ErrUsage(fmt.Sprintf("Invalid template front matter: %v", err))
+		}
+		tmpl.Vars = meta.Vars
+		text = body
+	}
+
+	var description []string
+	var current *todoTemplateItem
+	var itemLines []string
+	flush := func() {
+		if current != nil {
+			current.Description = strings.TrimSpace(strings.Join(itemLines, "\n"))
+			tmpl.Todos = append(tmpl.Todos, *current)
+		}
+		current, itemLines = nil, nil
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := scanner.Text()
+		trimmed := strings.TrimSpace(line)
+
+		if item, ok := todoTemplateListItem(line); ok {
+			flush()
+			current = parseTodoTemplateItem(item)
+			continue
+		}
+		if current != nil && (trimmed == "" || line != strings.TrimLeft(line, " \t")) {
+			itemLines = append(itemLines, trimmed)
+			continue
+		}
+		if name, ok := strings.CutPrefix(trimmed, "# "); ok && tmpl.Name == "" && current == nil {
+			tmpl.Name = strings.TrimSpace(name)
+			continue
+		}
+		if current != nil || len(tmpl.Todos) > 0 {
+			// Text between items (a "## Phase" subheading, say) only
+			// ends the item above it.
+			flush()
+			continue
+		}
+		description = append(description, line)
+	}
+	flush()
+	tmpl.Description = strings.TrimSpace(strings.Join(description, "\n"))
+	return &tmpl, scanner.Err()
+}
+
+// todoTemplateListItem returns the text of a top-level list item ("- ",
+// "* ", or a task-list "- [ ] "), or false if line isn't one.
+func todoTemplateListItem(line string) (string, bool) {
+	for _, marker := range []string{"- ", "* "} {
+		if item, ok := strings.CutPrefix(line, marker); ok {
+			for _, box := range []string{"[ ] ", "[x] ", "[X] "} {
+				item = strings.TrimPrefix(item, box)
+			}
+			return item, true
+		}
+	}
+	return "", false
+}
+
+// parseTodoTemplateItem splits a Markdown item into content, @assignees,
+// and a due:<date> word.
+func parseTodoTemplateItem(text string) *todoTemplateItem {
+	item := &todoTemplateItem{}
+	var words []string
+	for _, word := range strings.Fields(text) {
+		switch {
+		case len(word) > 1 && strings.HasPrefix(word, "@"):
+			item.Assignees = append(item.Assignees, strings.ReplaceAll(word[1:], ".", " "))
+		case strings.HasPrefix(word, "due:") && len(word) > len("due:"):
+			item.Due = strings.TrimPrefix(word, "due:")
+		default:
+			words = append(words, word)
+		}
+	}
+	item.Content = strings.Join(words, " ")
+	return item
+}
+
+// expand fills in template vars ({{.vars.name}}, {{.start}}) in every
+// field and resolves due dates against start.
+func (t *todoTemplate) expand(start time.Time) error {
+	data := map[string]any{
+		"vars":  t.Vars,
+		"start": start.Format("2006-01-02"),
+	}
+	render := func(field, text string) (string, error) {
+		if !strings.Contains(text, "{{") {
+			return text, nil
+		}
+		tpl, err := template.New(field).Option("missingkey=error").Parse(text)
+		if err != nil {
+			return "", output.ErrUsage(fmt.Sprintf("Invalid template in %s: %v", field, err))
+		}
+		var b strings.Builder
+		if err := tpl.Execute(&b, data); err != nil {
+			return "", output.ErrUsageHint(fmt.Sprintf("Can't fill in %s: %v", field, err), "Set template vars with --var name=value")
+		}
+		return b.String(), nil
+	}
+
+	var err error
+	if t.Name, err = render("name", t.Name); err != nil {
+		return err
+	}
+	if t.Description, err = render("description", t.Description); err != nil {
+		return err
+	}
+	for i := range t.Todos {
+		item := &t.Todos[i]
+		label := fmt.Sprintf("todo %d", i+1)
+		if item.Content, err = render(label, item.Content); err != nil {
+			return err
+		}
+		if item.Description, err = render(label+" description", item.Description); err != nil {
+			return err
+		}
+		for j := range item.Assignees {
+			if item.Assignees[j], err = render(label+" assignees", item.Assignees[j]); err != nil {
+				return err
+			}
+		}
+		due, err := render(label+" due", item.Due)
+		if err != nil {
+			return err
+		}
+		if item.Due, err = todoTemplateDue(due, start); err != nil {
+			return output.ErrUsage(fmt.Sprintf("%s: %v", label, err))
+		}
+	}
+	return nil
+}
+
+// todoTemplateDue resolves a template due date to YYYY-MM-DD: "+3d" or
+// "+1w from start" count from start; anything else is read as --due reads
+// it, relative to start ("next friday").
+func todoTemplateDue(due string, start time.Time) (string, error) {
+	due = strings.TrimSpace(due)
+	if due == "" {
+		return "", nil
+	}
+	if m := todoTemplateDueRe.FindStringSubmatch(strings.ToLower(due)); m != nil {
+		n, err := strconv.Atoi(m[2])
+		if err != nil {
+			return "", fmt.Errorf("invalid due date %q", due)
+		}
+		if m[3] == "w" {
+			n *= 7
+		}
+		if m[1] == "-" {
+			n = -n
+		}
+		return start.AddDate(0, 0, n).Format("2006-01-02"), nil
+	}
+	parsed := dateparse.ParseFrom(due, start)
+	if _, err := time.Parse("2006-01-02", parsed); err != nil {
+		return "", fmt.Errorf("invalid due date %q (use +3d, +1w, or a date)", due)
+	}
+	return parsed, nil
+}
+
+// title names the template in summaries.
+func (t *todoTemplate) title(path string) string {
+	if t.Name != "" {
+		return t.Name
+	}
+	return filepath.Base(path)
+}
@@ -0,0 +1,188 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// mockTodoTemplateTransport serves project 999 (todoset 55) and two people,
+// recording the todolist and todos created.
+type mockTodoTemplateTransport struct {
+	list  map[string]any
+	todos []map[string]any
+}
+
+func (m *mockTodoTemplateTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	path := req.URL.Path
+
+	var body map[string]any
+	if req.Body != nil {
+		raw, _ := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		_ = json.Unmarshal(raw, &body)
+	}
+
+	switch {
+	case req.Method == "GET" && strings.HasSuffix(path, "/projects.json"):
+		return jsonResponse(200, `[{"id": 999, "name": "Launch"}]`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/projects/999.json"):
+		return jsonResponse(200, `{"id": 999, "name": "Launch", "dock": [{"id": 55, "name": "todoset", "enabled": true}]}`, header), nil
+	case req.Method == "GET" && strings.HasSuffix(path, "/people.json"):
+		return jsonResponse(200, `[{"id": 1, "name": "Ana Lima"}, {"id": 2, "name": "Bo Park"}]`, header), nil
+	case req.Method == "POST" && strings.HasSuffix(path, "/todosets/55/todolists.json"):
+		m.list = body
+		return jsonResponse(201, fmt.Sprintf(`{"id": 321, "name": %q}`, body["name"]), header), nil
+	case req.Method == "POST" && strings.HasSuffix(path, "/todolists/321/todos.json"):
+		m.todos = append(m.todos, body)
+		return jsonResponse(201, fmt.Sprintf(`{"id": %d, "content": %q}`, 1000+len(m.todos), body["content"]), header), nil
+	}
+	return nil, fmt.Errorf("unexpected request: %s %s", req.Method, path)
+}
+
+func writeTodoTemplate(t *testing.T, name, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestTodosCreateFromTemplateYAML(t *testing.T) {
+	transport := &mockTodoTemplateTransport{}
+	app, out := setupProjectsMockApp(t, transport)
+	path := writeTodoTemplate(t, "release.yaml", `name: Release {{.vars.version}}
+description: Ship **{{.vars.version}}**
+todos:
+  - content: Cut the v{{.vars.version}} branch
+    assignees: [Ana Lima]
+    due: +0d
+  - content: Publish release notes
+    assignees: [Ana Lima, Bo Park]
+    due: +3d from start
+  - content: Retro
+`)
+
+	err := executeCommand(NewTodosCmd(), app, "create", "--from-template", path,
+		"--in", "Launch", "--var", "version=1.4", "--start", "2026-03-02")
+	require.NoError(t, err)
+
+	assert.Equal(t, "Release 1.4", transport.list["name"])
+	assert.Contains(t, transport.list["description"], "<strong>1.4</strong>")
+	require.Len(t, transport.todos, 3)
+	assert.Equal(t, "Cut the v1.4 branch", transport.todos[0]["content"])
+	assert.Equal(t, "2026-03-02", transport.todos[0]["due_on"])
+	assert.Equal(t, []any{float64(1)}, transport.todos[0]["assignee_ids"])
+	assert.Equal(t, "2026-03-05", transport.todos[1]["due_on"])
+	assert.Equal(t, []any{float64(1), float64(2)}, transport.todos[1]["assignee_ids"])
+	assert.Nil(t, transport.todos[2]["due_on"])
+
+	var resp struct {
+		Summary string `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &resp))
+	assert.Equal(t, "Created 3 todos in todolist #321: Release 1.4", resp.Summary)
+}
+
+func TestTodosCreateFromTemplateUnknownAssigneeCreatesNothing(t *testing.T) {
+	transport := &mockTodoTemplateTransport{}
+	app, _ := setupProjectsMockApp(t, transport)
+	path := writeTodoTemplate(t, "release.md", "# Release\n- [ ] Tag it @Nobody.Here\n")
+
+	err := executeCommand(NewTodosCmd(), app, "create", "--from-template", path, "--in", "Launch")
+	require.Error(t, err)
+	assert.Nil(t, transport.list, "no todolist is created when an assignee can't be resolved")
+}
+
+func TestTodosCreateFromTemplateRejectsContent(t *testing.T) {
+	app, _ := setupTodosTestApp(t)
+	path := writeTodoTemplate(t, "release.yaml", "name: Release\ntodos:\n  - content: Tag\n")
+
+	err := executeTodosCommand(NewTodosCmd(), app, "create", "Tag", "--from-template", path)
+	var e *output.Error
+	require.True(t, errors.As(err, &e), "expected *output.Error, got %T: %v", err, err)
+	assert.Equal(t, output.CodeUsage, e.Code)
+}
+
+func TestParseTodoTemplateMarkdown(t *testing.T) {
+	tmpl, err := parseTodoTemplate("onboarding.md", []byte(`---
+vars:
+  who: Dana
+---
+# Onboarding {{.vars.who}}
+
+Everything {{.vars.who}} needs in week one.
+
+- [ ] Set up a laptop @me due:+1d
+  Order it from the IT portal.
+
+  Include a dock.
+- [ ] Meet the team @Ana.Lima @Bo due:+1w
+
+## Later
+
+* Write a first post
+`))
+	require.NoError(t, err)
+	require.NoError(t, tmpl.expand(time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local)))
+
+	assert.Equal(t, "Onboarding Dana", tmpl.Name)
+	assert.Equal(t, "Everything Dana needs in week one.", tmpl.Description)
+	require.Len(t, tmpl.Todos, 3)
+	assert.Equal(t, todoTemplateItem{
+		Content:     "Set up a laptop",
+		Description: "Order it from the IT portal.\n\nInclude a dock.",
+		Assignees:   []string{"me"},
+		Due:         "2026-03-03",
+	}, tmpl.Todos[0])
+	assert.Equal(t, []string{"Ana Lima", "Bo"}, tmpl.Todos[1].Assignees)
+	assert.Equal(t, "2026-03-09", tmpl.Todos[1].Due)
+	assert.Equal(t, "Write a first post", tmpl.Todos[2].Content)
+}
+
+func TestTodoTemplateMissingVar(t *testing.T) {
+	tmpl, err := parseTodoTemplate("release.yaml", []byte("name: Release {{.vars.version}}\ntodos:\n  - content: Tag\n"))
+	require.NoError(t, err)
+
+	err = tmpl.expand(time.Now())
+	var e *output.Error
+	require.True(t, errors.As(err, &e), "expected *output.Error, got %T: %v", err, err)
+	assert.Contains(t, e.Hint, "--var")
+}
+
+func TestTodoTemplateDue(t *testing.T) {
+	start := time.Date(2026, 3, 2, 0, 0, 0, 0, time.Local) // a Monday
+	tests := map[string]string{
+		"":                "",
+		"+0d":             "2026-03-02",
+		"+3":              "2026-03-05",
+		"+2w":             "2026-03-16",
+		"-1d":             "2026-03-01",
+		"+3d from start":  "2026-03-05",
+		"friday":          "2026-03-06",
+		"2026-04-01":      "2026-04-01",
+		"in 2 weeks":      "2026-03-16",
+		"+1W FROM START ": "2026-03-09",
+	}
+	for input, want := range tests {
+		got, err := todoTemplateDue(input, start)
+		require.NoError(t, err, input)
+		assert.Equal(t, want, got, input)
+	}
+
+	_, err := todoTemplateDue("someday", start)
+	assert.Error(t, err)
+}
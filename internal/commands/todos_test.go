@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/spf13/cobra"
 	"github.com/stretchr/testify/assert"
@@ -275,7 +276,7 @@ func TestTodosPositionRejectsNonTodolistURL(t *testing.T) {
 func TestTodosSubcommands(t *testing.T) {
 	cmd := NewTodosCmd()
 
-	expected := []string{"list", "show", "create", "update", "complete", "uncomplete", "position"}
+	expected := []string{"list", "show", "create", "update", "complete", "uncomplete", "position", "blocked", "deps", "log-time"}
 	for _, name := range expected {
 		sub, _, err := cmd.Find([]string{name})
 		require.NoError(t, err, "expected subcommand %q to exist", name)
@@ -2922,3 +2923,247 @@ func TestTodosListAggregateSortGuard(t *testing.T) {
 	errA := executeTodosCommand(NewTodosCmd(), appA, "list", "--in", "123", "--assignee", "Alice", "--sort", "title")
 	require.NoError(t, errA)
 }
+
+func TestTodosListAllIncludesTodolistAndBucket(t *testing.T) {
+	app, buf := setupGroupTodoApp(t, groupTodoTransport{})
+
+	cmd := NewTodosCmd()
+	err := executeTodosCommand(cmd, app, "list")
+	require.NoError(t, err)
+
+	var resp struct {
+		Data []struct {
+			ID       int64 `json:"id"`
+			Todolist *struct {
+				ID    int64  `json:"id"`
+				Title string `json:"title"`
+			} `json:"todolist"`
+			Bucket *struct {
+				ID   int64  `json:"id"`
+				Name string `json:"name"`
+			} `json:"bucket"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 3)
+	for _, todo := range resp.Data {
+		require.NotNil(t, todo.Todolist, "todo %d should name its todolist", todo.ID)
+		assert.Equal(t, int64(500), todo.Todolist.ID, "group todo %d belongs to the todolist, not the group", todo.ID)
+		assert.Equal(t, "Sprint", todo.Todolist.Title)
+		require.NotNil(t, todo.Bucket, "todo %d should name its project", todo.ID)
+		assert.Equal(t, int64(123), todo.Bucket.ID)
+		assert.Equal(t, "Test", todo.Bucket.Name)
+	}
+}
+
+func TestTodosListInListIncludesTodolist(t *testing.T) {
+	app, buf := setupGroupTodoApp(t, groupTodoTransport{})
+
+	cmd := NewTodosCmd()
+	err := executeTodosCommand(cmd, app, "list", "--list", "500")
+	require.NoError(t, err)
+
+	var resp struct {
+		Data []struct {
+			Todolist struct {
+				ID int64 `json:"id"`
+			} `json:"todolist"`
+		} `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 3)
+	for _, todo := range resp.Data {
+		assert.Equal(t, int64(500), todo.Todolist.ID)
+	}
+}
+
+func TestResolveDueFilter(t *testing.T) {
+	wednesday := time.Date(2026, 10, 14, 9, 0, 0, 0, time.Local)
+	sunday := time.Date(2026, 10, 18, 9, 0, 0, 0, time.Local)
+
+	w, err := resolveDueFilter("", wednesday)
+	require.NoError(t, err)
+	assert.Nil(t, w)
+
+	w, err = resolveDueFilter("today", wednesday)
+	require.NoError(t, err)
+	assert.Equal(t, dueWindow{from: "2026-10-14", to: "2026-10-14", label: "due today"}, *w)
+
+	w, err = resolveDueFilter("this-week", wednesday)
+	require.NoError(t, err)
+	assert.Equal(t, dueWindow{from: "2026-10-14", to: "2026-10-18", label: "due this week"}, *w)
+
+	w, err = resolveDueFilter("this-week", sunday)
+	require.NoError(t, err)
+	assert.Equal(t, "2026-10-18", w.to, "on Sunday the week ends today")
+
+	w, err = resolveDueFilter("overdue", wednesday)
+	require.NoError(t, err)
+	assert.Equal(t, dueWindow{to: "2026-10-13", label: "overdue"}, *w)
+
+	_, err = resolveDueFilter("tomorrow", wednesday)
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeUsage, e.Code)
+}
+
+func TestDueWindowMatches(t *testing.T) {
+	w := &dueWindow{from: "2026-10-14", to: "2026-10-18"}
+
+	assert.True(t, w.matches(basecamp.Todo{DueOn: "2026-10-14"}))
+	assert.True(t, w.matches(basecamp.Todo{DueOn: "2026-10-18"}))
+	assert.False(t, w.matches(basecamp.Todo{DueOn: "2026-10-13"}))
+	assert.False(t, w.matches(basecamp.Todo{DueOn: "2026-10-19"}))
+	assert.False(t, w.matches(basecamp.Todo{}), "todos without a due date never match")
+	assert.False(t, w.matches(basecamp.Todo{DueOn: "2026-10-15", Completed: true}))
+
+	var none *dueWindow
+	assert.True(t, none.matches(basecamp.Todo{}))
+}
+
+func TestTodosSummary(t *testing.T) {
+	assert.Equal(t, "4 todos", todosSummary(4, nil))
+	assert.Equal(t, "8 todos due this week", todosSummary(8, &dueWindow{label: "due this week"}))
+	assert.Equal(t, "1 todo due today", todosSummary(1, &dueWindow{label: "due today"}))
+}
+
+// dueTodosTransport serves one todolist whose todos are due yesterday,
+// today, and in a month (plus one with no due date), dated relative to now.
+type dueTodosTransport struct{}
+
+func (dueTodosTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	day := func(offset int) string { return time.Now().AddDate(0, 0, offset).Format("2006-01-02") }
+
+	var body string
+	switch path := req.URL.Path; {
+	case strings.Contains(path, "/projects.json"):
+		body = `[{"id": 123, "name": "Test"}]`
+	case strings.Contains(path, "/projects/"):
+		body = `{"id": 123, "dock": [{"name": "todoset", "id": 900, "enabled": true}]}`
+	case strings.Contains(path, "/todosets/900/todolists"):
+		body = `[{"id": 500, "name": "Sprint"}]`
+	case strings.Contains(path, "/todolists/500/groups.json"):
+		body = `[]`
+	case strings.Contains(path, "/todolists/500/todos.json"):
+		body = fmt.Sprintf(`[{"id": 1, "title": "Late", "due_on": %q, "creator": {"id": 42}, "updated_at": "2025-09-01T10:00:00Z"},`+
+			`{"id": 2, "title": "Now", "due_on": %q, "creator": {"id": 7}, "updated_at": "2025-09-01T10:00:00Z"},`+
+			`{"id": 3, "title": "Later", "due_on": %q, "creator": {"id": 42}, "updated_at": "2025-01-01T10:00:00Z"},`+
+			`{"id": 4, "title": "Someday", "updated_at": "2025-09-01T10:00:00Z"}]`, day(-1), day(0), day(30))
+	case strings.Contains(path, "/recordings.json"):
+		body = `[]`
+	default:
+		body = `{}`
+	}
+
+	return &http.Response{
+		StatusCode: 200,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     header,
+	}, nil
+}
+
+func TestTodosListDueFilters(t *testing.T) {
+	tomorrow := time.Now().AddDate(0, 0, 1).Format("2006-01-02")
+	tests := []struct {
+		args    []string
+		ids     []int64
+		summary string
+	}{
+		{[]string{"--due", "today"}, []int64{2}, "1 todo due today"},
+		{[]string{"--due", "overdue"}, []int64{1}, "1 todo overdue"},
+		{[]string{"--overdue"}, []int64{1}, "1 todo overdue"},
+		{[]string{"--due", "today", "--list", "500"}, []int64{2}, "1 todo due today"},
+		{[]string{"--due", "today", "--list", "Sprint"}, []int64{2}, "1 todo due today"},
+		{[]string{"--due-before", tomorrow}, []int64{1, 2}, "2 todos due before " + tomorrow},
+		{[]string{"--due-before", "+1", "--list", "Sprint"}, []int64{1, 2}, "2 todos due before " + tomorrow},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			app, buf := setupGroupTodoApp(t, dueTodosTransport{})
+
+			err := executeTodosCommand(NewTodosCmd(), app, append([]string{"list"}, tt.args...)...)
+			require.NoError(t, err)
+
+			var resp struct {
+				Summary string `json:"summary"`
+				Data    []struct {
+					ID int64 `json:"id"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+			var ids []int64
+			for _, todo := range resp.Data {
+				ids = append(ids, todo.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+			assert.Equal(t, tt.summary, resp.Summary)
+		})
+	}
+}
+
+func TestTodosListCreatedByAndUpdatedSince(t *testing.T) {
+	tests := []struct {
+		args []string
+		ids  []int64
+	}{
+		{[]string{"--created-by", "42"}, []int64{1, 3}},
+		{[]string{"--updated-since", "2025-06-01"}, []int64{1, 2, 4}},
+		{[]string{"--created-by", "42", "--updated-since", "2025-06-01"}, []int64{1}},
+		{[]string{"--created-by", "42", "--list", "500"}, []int64{1, 3}},
+		{[]string{"--updated-since", "2025-06-01", "--limit", "2"}, []int64{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(strings.Join(tt.args, " "), func(t *testing.T) {
+			app, buf := setupGroupTodoApp(t, dueTodosTransport{})
+
+			err := executeTodosCommand(NewTodosCmd(), app, append([]string{"list"}, tt.args...)...)
+			require.NoError(t, err)
+
+			var resp struct {
+				Data []struct {
+					ID int64 `json:"id"`
+				} `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+			var ids []int64
+			for _, todo := range resp.Data {
+				ids = append(ids, todo.ID)
+			}
+			assert.Equal(t, tt.ids, ids)
+		})
+	}
+}
+
+func TestTodosListDueConflictsWithOverdue(t *testing.T) {
+	app, _ := setupGroupTodoApp(t, todosNoNetworkTransport{})
+
+	err := executeTodosCommand(NewTodosCmd(), app, "list", "--overdue", "--due", "today")
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Contains(t, e.Message, "mutually exclusive")
+}
+
+func TestTodosListDueBeforeConflictsWithDue(t *testing.T) {
+	app, _ := setupGroupTodoApp(t, todosNoNetworkTransport{})
+
+	err := executeTodosCommand(NewTodosCmd(), app, "list", "--due", "today", "--due-before", "friday")
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Contains(t, e.Message, "--due-before cannot be combined")
+
+	err = executeTodosCommand(NewTodosCmd(), app, "list", "--due-before", "someday")
+	require.True(t, errors.As(err, &e))
+	assert.Contains(t, e.Message, "Invalid due date")
+}
+
+func TestTodosListDueWithoutProjectErrors(t *testing.T) {
+	app, _ := setupTodosTestApp(t)
+
+	err := executeTodosCommand(NewTodosCmd(), app, "list", "--due", "this-week")
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Contains(t, e.Message, "--due requires a project")
+}
@@ -0,0 +1,335 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/completion"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// todosTreeConcurrency bounds the list and group fetches `todos tree` runs
+// at once.
+const todosTreeConcurrency = 5
+
+// todoTreeTodoset is one todoset of `todos tree`. Todos holds the todos
+// that live directly under the todoset rather than in a list.
+type todoTreeTodoset struct {
+	ID        int64          `json:"id"`
+	Title     string         `json:"title"`
+	Todolists []todoTreeList `json:"todolists"`
+	Todos     []todoTreeTodo `json:"todos"`
+}
+
+type todoTreeList struct {
+	ID             int64           `json:"id"`
+	Name           string          `json:"name"`
+	Completed      bool            `json:"completed"`
+	CompletedRatio string          `json:"completed_ratio,omitempty"`
+	AppURL         string          `json:"app_url,omitempty"`
+	Groups         []todoTreeGroup `json:"groups"`
+	Todos          []todoTreeTodo  `json:"todos"`
+}
+
+type todoTreeGroup struct {
+	ID     int64          `json:"id"`
+	Name   string         `json:"name"`
+	AppURL string         `json:"app_url,omitempty"`
+	Todos  []todoTreeTodo `json:"todos"`
+}
+
+type todoTreeTodo struct {
+	ID        int64    `json:"id"`
+	Content   string   `json:"content"`
+	Completed bool     `json:"completed"`
+	DueOn     string   `json:"due_on,omitempty"`
+	Assignees []string `json:"assignees,omitempty"`
+	AppURL    string   `json:"app_url,omitempty"`
+}
+
+func newTodosTreeCmd() *cobra.Command {
+	var project string
+	var todoset string
+	var completed bool
+
+	cmd := &cobra.Command{
+		Use:   "tree",
+		Short: "Show a project's todosets, lists, groups, and todos",
+		Long: `Show the full todo hierarchy of a project in one call: each todoset, its
+todolists, the groups within each list, and the todos at every level.
+
+Every todoset in the project is included unless --todoset picks one. Open
+todos are shown by default; --completed shows completed todos instead.`,
+		Example: `  basecamp todos tree --in "Launch"
+  basecamp todos tree --in "Launch" --json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runTodosTree(cmd, project, todoset, completed)
+		},
+	}
+
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL")
+	cmd.Flags().StringVarP(&todoset, "todoset", "t", "", "Todoset ID (default: every todoset in the project)")
+	cmd.Flags().BoolVar(&completed, "completed", false, "Show completed todos instead of open ones")
+
+	completer := completion.NewCompleter(nil)
+	_ = cmd.RegisterFlagCompletionFunc("in", completer.ProjectNameCompletion())
+
+	return cmd
+}
+
+func runTodosTree(cmd *cobra.Command, project, todosetFlag string, completed bool) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	resolvedProject, err := resolveProjectID(cmd, app, project)
+	if err != nil {
+		return err
+	}
+	projectID, err := strconv.ParseInt(resolvedProject, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid project ID")
+	}
+
+	enabled, _, err := getDockTools(cmd.Context(), app, resolvedProject, "todoset")
+	if err != nil {
+		return err
+	}
+	if todosetFlag != "" {
+		todosetID, err := strconv.ParseInt(todosetFlag, 10, 64)
+		if err != nil {
+			return output.ErrUsage("Invalid todoset ID")
+		}
+		var picked []DockTool
+		for _, tool := range enabled {
+			if tool.ID == todosetID {
+				picked = append(picked, tool)
+			}
+		}
+		if len(picked) == 0 {
+			return output.ErrNotFound("todoset", todosetFlag)
+		}
+		enabled = picked
+	}
+
+	todosets, err := fetchTodoTree(cmd.Context(), app, projectID, enabled, completed)
+	if err != nil {
+		return err
+	}
+
+	return app.OK(todosets,
+		output.WithSummary(todoTreeSummary(todosets)),
+		output.WithStyledView(func(w io.Writer, r *output.Renderer) { renderTodoTreeStyled(w, r, todosets) }),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         "basecamp todos show <id>",
+				Description: "Show todo details",
+			},
+			output.Breadcrumb{
+				Action:      "create",
+				Cmd:         fmt.Sprintf("basecamp todos create <content> --list <list> --in %s", resolvedProject),
+				Description: "Create a todo",
+			},
+		),
+	)
+}
+
+// fetchTodoTree builds the hierarchy for the given todosets. Lists are
+// fetched per todoset, then every list's groups and todos, and finally every
+// group's todos, each stage running concurrently. Any failed fetch fails the
+// whole tree: a partial breakdown would read as a complete one.
+func fetchTodoTree(ctx context.Context, app *appctx.App, projectID int64, tools []DockTool, completed bool) ([]todoTreeTodoset, error) {
+	todoOpts := &basecamp.TodoListOptions{Limit: -1, Completed: completed}
+
+	todosets := make([]todoTreeTodoset, len(tools))
+	listless := make([][]basecamp.Todo, len(tools))
+	if err := forEachConcurrently(len(tools), func(i int) error {
+		todosets[i] = todoTreeTodoset{ID: tools[i].ID, Title: tools[i].Title, Todolists: []todoTreeList{}}
+		result, err := app.Account().Todolists().List(ctx, tools[i].ID, nil)
+		if err != nil {
+			return err
+		}
+		for _, tl := range result.Todolists {
+			todosets[i].Todolists = append(todosets[i].Todolists, todoTreeList{
+				ID:             tl.ID,
+				Name:           tl.Name,
+				Completed:      tl.Completed,
+				CompletedRatio: tl.CompletedRatio,
+				AppURL:         tl.AppURL,
+			})
+		}
+		listless[i] = fetchTodosetLevelTodos(ctx, app, projectID, tools[i].ID, "", completed, -1)
+		return nil
+	}); err != nil {
+		return nil, convertSDKError(err)
+	}
+
+	var lists []*todoTreeList
+	for i := range todosets {
+		todosets[i].Todos = todoTreeTodos(listless[i])
+		for j := range todosets[i].Todolists {
+			lists = append(lists, &todosets[i].Todolists[j])
+		}
+	}
+
+	groupsByList := make([][]basecamp.TodolistGroup, len(lists))
+	if err := forEachConcurrently(len(lists), func(i int) error {
+		groups, err := app.Account().TodolistGroups().List(ctx, lists[i].ID, nil)
+		if err != nil {
+			return err
+		}
+		todos, err := app.Account().Todos().List(ctx, lists[i].ID, todoOpts)
+		if err != nil {
+			return err
+		}
+		groupsByList[i] = groups.Groups
+		lists[i].Todos = todoTreeTodos(todos.Todos)
+		return nil
+	}); err != nil {
+		return nil, convertSDKError(err)
+	}
+
+	var groups []*todoTreeGroup
+	for i, list := range lists {
+		sort.SliceStable(groupsByList[i], func(a, b int) bool {
+			return groupsByList[i][a].Position < groupsByList[i][b].Position
+		})
+		list.Groups = make([]todoTreeGroup, 0, len(groupsByList[i]))
+		for _, g := range groupsByList[i] {
+			list.Groups = append(list.Groups, todoTreeGroup{ID: g.ID, Name: g.Name, AppURL: g.AppURL})
+		}
+		for j := range list.Groups {
+			groups = append(groups, &list.Groups[j])
+		}
+	}
+
+	if err := forEachConcurrently(len(groups), func(i int) error {
+		todos, err := app.Account().Todos().List(ctx, groups[i].ID, todoOpts)
+		if err != nil {
+			return err
+		}
+		groups[i].Todos = todoTreeTodos(todos.Todos)
+		return nil
+	}); err != nil {
+		return nil, convertSDKError(err)
+	}
+
+	return todosets, nil
+}
+
+// forEachConcurrently calls fn for 0..n-1, at most todosTreeConcurrency at
+// a time, and returns the error of the lowest index that failed.
+func forEachConcurrently(n int, fn func(i int) error) error {
+	errs := make([]error, n)
+	sem := make(chan struct{}, todosTreeConcurrency)
+	var wg sync.WaitGroup
+
+	for i := range n {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			errs[i] = fn(i)
+		}(i)
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func todoTreeTodos(todos []basecamp.Todo) []todoTreeTodo {
+	out := make([]todoTreeTodo, 0, len(todos))
+	for _, t := range todos {
+		content := t.Content
+		if content == "" {
+			content = t.Title
+		}
+		node := todoTreeTodo{
+			ID:        t.ID,
+			Content:   content,
+			Completed: t.Completed,
+			DueOn:     t.DueOn,
+			AppURL:    t.AppURL,
+		}
+		for _, a := range t.Assignees {
+			node.Assignees = append(node.Assignees, a.Name)
+		}
+		out = append(out, node)
+	}
+	return out
+}
+
+func todoTreeSummary(todosets []todoTreeTodoset) string {
+	var lists, todos int
+	for _, ts := range todosets {
+		todos += len(ts.Todos)
+		for _, tl := range ts.Todolists {
+			lists++
+			todos += len(tl.Todos)
+			for _, g := range tl.Groups {
+				todos += len(g.Todos)
+			}
+		}
+	}
+	return fmt.Sprintf("%d %s, %d %s, %d %s",
+		len(todosets), pluralize(len(todosets), "todoset", "todosets"),
+		lists, pluralize(lists, "list", "lists"),
+		todos, pluralize(todos, "todo", "todos"))
+}
+
+func renderTodoTreeStyled(w io.Writer, r *output.Renderer, todosets []todoTreeTodoset) {
+	node := func(depth int, label string, id int64) {
+		fmt.Fprintf(w, "%s%s %s\n", strings.Repeat("  ", depth), label, r.Muted.Render(fmt.Sprintf("#%d", id)))
+	}
+	todos := func(depth int, todos []todoTreeTodo) {
+		for _, t := range todos {
+			box := "[ ]"
+			if t.Completed {
+				box = "[x]"
+			}
+			label := box + " " + t.Content
+			if t.DueOn != "" {
+				label += " " + r.Muted.Render("due "+t.DueOn)
+			}
+			node(depth, label, t.ID)
+		}
+	}
+
+	fmt.Fprintln(w, r.Summary.Render(todoTreeSummary(todosets)))
+	for _, ts := range todosets {
+		title := ts.Title
+		if title == "" {
+			title = "To-dos"
+		}
+		fmt.Fprintln(w)
+		node(0, r.Header.Render(title), ts.ID)
+		todos(1, ts.Todos)
+		for _, tl := range ts.Todolists {
+			node(1, tl.Name, tl.ID)
+			todos(2, tl.Todos)
+			for _, g := range tl.Groups {
+				node(2, g.Name, g.ID)
+				todos(3, g.Todos)
+			}
+		}
+	}
+}
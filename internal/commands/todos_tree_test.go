@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTodosTreeNestsListsGroupsAndTodos(t *testing.T) {
+	app, buf := setupGroupTodoApp(t, groupTodoTransport{})
+
+	cmd := NewTodosCmd()
+	require.NoError(t, executeTodosCommand(cmd, app, "tree"))
+
+	var resp struct {
+		Summary string            `json:"summary"`
+		Data    []todoTreeTodoset `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	require.Len(t, resp.Data, 1)
+	assert.Equal(t, int64(900), resp.Data[0].ID)
+
+	require.Len(t, resp.Data[0].Todolists, 1)
+	list := resp.Data[0].Todolists[0]
+	assert.Equal(t, "Sprint", list.Name)
+	require.Len(t, list.Todos, 2)
+	assert.Equal(t, "First", list.Todos[0].Content)
+	assert.Equal(t, int64(3), list.Todos[1].ID)
+
+	require.Len(t, list.Groups, 1)
+	assert.Equal(t, "Group A", list.Groups[0].Name)
+	require.Len(t, list.Groups[0].Todos, 1)
+	assert.Equal(t, int64(2), list.Groups[0].Todos[0].ID)
+
+	assert.Equal(t, "1 todoset, 1 list, 3 todos", resp.Summary)
+}
+
+func TestTodosTreeGroupErrorFails(t *testing.T) {
+	app, _ := setupGroupTodoApp(t, groupErrorTransport{})
+
+	cmd := NewTodosCmd()
+	assert.Error(t, executeTodosCommand(cmd, app, "tree"))
+}
+
+func TestTodosTreeUnknownTodoset(t *testing.T) {
+	app, _ := setupGroupTodoApp(t, groupTodoTransport{})
+
+	cmd := NewTodosCmd()
+	err := executeTodosCommand(cmd, app, "tree", "--todoset", "12345")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "12345")
+}
@@ -36,7 +36,7 @@ Disabling a tool hides it from the dock but preserves its content.`,
 	}
 
 	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project ID or name (for breadcrumbs)")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project ID or name (alias for --project)")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newToolsShowCmd(&project),
@@ -229,21 +229,7 @@ func toolTypeFriendlyNames() []string {
 // flag > config default > interactive. Returns the bucket ID and the resolved
 // project ID string (for breadcrumbs).
 func resolveToolBucketID(cmd *cobra.Command, app *appctx.App, project string) (int64, string, error) {
-	projectID := project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return 0, "", err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, project)
 	if err != nil {
 		return 0, "", err
 	}
@@ -441,6 +427,10 @@ WARNING: This permanently removes the tool and all its content.`,
 				return err
 			}
 
+			if !confirmAction(cmd, confirmDestructive, fmt.Sprintf("Delete tool #%d?", toolID), false) {
+				return nil
+			}
+
 			err = app.Account().Tools().Delete(cmd.Context(), toolID)
 			if err != nil {
 				return convertSDKError(err)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/cobra"
@@ -27,6 +28,17 @@ func NewTUICmd() *cobra.Command {
 		Short: "Launch the Basecamp workspace [dev]",
 		Long: "Launch a persistent, full-screen terminal workspace for Basecamp.\n" +
 			"Optionally pass a Basecamp URL to jump directly to a project or recording.\n\n" +
+			"Custom command palette actions come from palette_actions in the config\n" +
+			"file (global, or a trusted local one), keyed by name, each with a\n" +
+			"description and either run (a basecamp command) or shell (an sh -c\n" +
+			"command line). Both interpolate\n" +
+			"{{account_id}}, {{project_id}}, {{project_name}}, {{tool_type}},\n" +
+			"{{tool_id}}, {{recording_id}}, and {{recording_type}} from where you are,\n" +
+			"and run in the background with the result in the status bar:\n\n" +
+			"  \"palette_actions\": {\n" +
+			"    \"standup\": {\"description\": \"Post standup\", \"run\": \"chat post Standup --in {{project_id}}\"},\n" +
+			"    \"copy-id\": {\"shell\": \"printf %s {{recording_id}} | pbcopy\"}\n" +
+			"  }\n\n" +
 			"This feature is under active development and may change between releases.",
 		Annotations: map[string]string{"dev_only": "true"},
 		Args:        cobra.MaximumNArgs(1),
@@ -40,6 +52,9 @@ func NewTUICmd() *cobra.Command {
 					`experimental feature "tui" is not enabled; run: basecamp config set experimental.tui true --global`)
 			}
 			printDevNotice(app.Config.CacheDir)
+			if workspace.NeedsOnboarding(app) {
+				return nil // the workspace opens with setup instead of failing here
+			}
 			return ensureAccount(cmd, app)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -96,6 +111,13 @@ func NewTUICmd() *cobra.Command {
 			}
 			defer session.Shutdown()
 
+			if workspace.NeedsOnboarding(app) {
+				done, err := runOnboarding(cmd, app, session)
+				if err != nil || !done {
+					return err
+				}
+			}
+
 			// Deep-link: parse URL argument and set initial navigation target.
 			if len(args) > 0 {
 				target, scope, err := parseBasecampURL(args[0])
@@ -110,6 +132,9 @@ func NewTUICmd() *cobra.Command {
 			if app.Tracer != nil {
 				wsOpts = append(wsOpts, workspace.WithTracer(app.Tracer))
 			}
+			if actions := paletteActions(app); len(actions) > 0 {
+				wsOpts = append(wsOpts, workspace.WithCustomActions(actions))
+			}
 			model := workspace.New(session, viewFactory, poolMonitorFactory(session), wsOpts...)
 
 			p := tea.NewProgram(model)
@@ -122,9 +147,68 @@ func NewTUICmd() *cobra.Command {
 
 	cmd.Flags().Bool("trace", false, "Enable trace logging to file")
 
+	cmd.AddCommand(newTUIKeysCmd())
+
 	return cmd
 }
 
+// paletteActions converts config palette_actions into workspace actions.
+// A run command is split into arguments before interpolation, so scope
+// values never change how it parses; an unsplittable one is skipped with a
+// warning.
+func paletteActions(app *appctx.App) []workspace.CustomAction {
+	var actions []workspace.CustomAction
+	for _, name := range app.Config.PaletteActionNames() {
+		pa := app.Config.PaletteActions[name]
+		a := workspace.CustomAction{Name: name, Description: pa.Description, Shell: pa.Shell}
+		if pa.Run != "" {
+			args, err := SplitCommandLine(pa.Run)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "warning: ignoring palette_actions.%s: %v\n", name, err)
+				continue
+			}
+			if len(args) > 0 && args[0] == output.CanonicalBinary {
+				args = args[1:]
+			}
+			a.Args = args
+		}
+		actions = append(actions, a)
+	}
+	return actions
+}
+
+// runOnboarding runs the first-run setup flow and applies its choices to the
+// app and session. Returns false when the user quit before finishing.
+func runOnboarding(cmd *cobra.Command, app *appctx.App, session *workspace.Session) (bool, error) {
+	onboarding := workspace.NewOnboarding(session)
+	if _, err := tea.NewProgram(onboarding).Run(); err != nil {
+		return false, err
+	}
+	result, ok := onboarding.Result()
+	if !ok {
+		return false, nil
+	}
+
+	app.Config.AccountID = result.AccountID
+	if result.ProjectID != 0 {
+		app.Config.ProjectID = strconv.FormatInt(result.ProjectID, 10)
+	}
+	app.Config.TUITheme = result.Theme
+	if err := ensureAccount(cmd, app); err != nil {
+		return false, err
+	}
+	session.SetScope(workspace.Scope{AccountID: result.AccountID})
+
+	// Land in the chosen project rather than on Home.
+	if result.ProjectID != 0 {
+		session.SetInitialView(workspace.ViewDock, workspace.Scope{
+			AccountID: result.AccountID,
+			ProjectID: result.ProjectID,
+		})
+	}
+	return true, nil
+}
+
 // poolMonitorFactory returns a factory that creates pool monitor views.
 func poolMonitorFactory(session *workspace.Session) func() workspace.View {
 	return func() workspace.View {
@@ -153,7 +237,7 @@ func viewFactory(target workspace.ViewTarget, session *workspace.Session, scope
 	case workspace.ViewMessages:
 		return views.NewMessages(session)
 	case workspace.ViewSearch:
-		return views.NewSearch(session)
+		return views.NewSearchWithQuery(session, scope.SearchQuery)
 	case workspace.ViewMyStuff:
 		return views.NewMyStuff(session)
 	case workspace.ViewPeople:
@@ -0,0 +1,128 @@
+//go:build dev
+
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+
+	"charm.land/bubbles/v2/key"
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+)
+
+// cheatsheetViews are the views listed in the keymap cheatsheet, in the
+// order a user typically meets them.
+var cheatsheetViews = []workspace.ViewTarget{
+	workspace.ViewHome,
+	workspace.ViewProjects,
+	workspace.ViewDock,
+	workspace.ViewTodos,
+	workspace.ViewCards,
+	workspace.ViewMessages,
+	workspace.ViewChat,
+	workspace.ViewSchedule,
+	workspace.ViewDocsFiles,
+	workspace.ViewCheckins,
+	workspace.ViewForwards,
+	workspace.ViewDetail,
+	workspace.ViewCompose,
+	workspace.ViewHey,
+	workspace.ViewMyStuff,
+	workspace.ViewAssignments,
+	workspace.ViewPings,
+	workspace.ViewActivity,
+	workspace.ViewPulse,
+	workspace.ViewTimeline,
+	workspace.ViewPeople,
+	workspace.ViewSearch,
+	workspace.ViewBonfire,
+	workspace.ViewFrontPage,
+	workspace.ViewBonfireSidebar,
+}
+
+func newTUIKeysCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Work with the workspace key map",
+	}
+	cmd.AddCommand(newTUIKeysExportCmd())
+	return cmd
+}
+
+func newTUIKeysExportCmd() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export the effective key map as a cheatsheet",
+		Long: `Export the effective workspace key map as a printable cheatsheet.
+
+The global section reflects your keybindings.json overrides; each view
+section lists the keys shown in that view's help overlay.
+
+  basecamp tui keys export --format markdown > basecamp-keys.md`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if format != "markdown" {
+				return output.ErrUsageHint(
+					fmt.Sprintf("Unsupported format: %q", format),
+					"Supported formats: markdown",
+				)
+			}
+
+			app := appctx.FromContext(cmd.Context())
+			if app == nil {
+				return fmt.Errorf("app not initialized")
+			}
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+
+			session, err := workspace.NewSession(app)
+			if err != nil {
+				return err
+			}
+			defer session.Shutdown()
+
+			return writeKeysCheatsheet(cmd.OutOrStdout(), session)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", "markdown", "Output format (markdown)")
+
+	return cmd
+}
+
+// writeKeysCheatsheet renders the global keys and every view's full help
+// as markdown. Views are only constructed, never initialized, so nothing
+// is fetched.
+func writeKeysCheatsheet(w io.Writer, session *workspace.Session) error {
+	global, err := workspace.EffectiveGlobalKeyMap()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: keybindings: %v\n", err)
+	}
+
+	sections := []workspace.KeyMapSection{
+		{Title: "Global", Groups: global.FullHelp()},
+		{Title: "Lists", Groups: [][]key.Binding{listKeyBindings(workspace.DefaultListKeyMap())}},
+	}
+	if hub := session.Hub(); hub != nil {
+		hub.EnsureAccount(session.Scope().AccountID)
+	}
+	for _, target := range cheatsheetViews {
+		v := viewFactory(target, session, workspace.Scope{})
+		sections = append(sections, workspace.KeyMapSection{Title: v.Title(), Groups: v.FullHelp()})
+	}
+
+	_, err = io.WriteString(w, workspace.RenderKeyMapMarkdown("Basecamp workspace keys", sections))
+	return err
+}
+
+func listKeyBindings(k workspace.ListKeyMap) []key.Binding {
+	return []key.Binding{k.Up, k.Down, k.Top, k.Bottom, k.PageDown, k.PageUp, k.Open, k.Filter}
+}
@@ -14,15 +14,31 @@ func NewTUICmd() *cobra.Command {
 		Use:   "tui [url]",
 		Short: "Launch the Basecamp workspace [dev]",
 		Args:  cobra.MaximumNArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			return output.ErrUsageHint(
-				"the tui workspace is only available in development builds",
-				"build with: make build (or go build -tags dev ./cmd/basecamp)",
-			)
-		},
+		RunE:  runTUIStub,
 	}
 
 	cmd.Flags().Bool("trace", false, "Enable trace logging to file")
 
+	keys := &cobra.Command{
+		Use:   "keys",
+		Short: "Work with the workspace key map",
+	}
+	export := &cobra.Command{
+		Use:   "export",
+		Short: "Export the effective key map as a cheatsheet",
+		Args:  cobra.NoArgs,
+		RunE:  runTUIStub,
+	}
+	export.Flags().String("format", "markdown", "Output format (markdown)")
+	keys.AddCommand(export)
+	cmd.AddCommand(keys)
+
 	return cmd
 }
+
+func runTUIStub(cmd *cobra.Command, args []string) error {
+	return output.ErrUsageHint(
+		"the tui workspace is only available in development builds",
+		"build with: make build (or go build -tags dev ./cmd/basecamp)",
+	)
+}
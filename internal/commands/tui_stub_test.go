@@ -28,3 +28,11 @@ func TestStubTUICmd_AcceptsURLArg(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "only available in development builds")
 }
+
+func TestStubTUICmd_KeysExport(t *testing.T) {
+	cmd := NewTUICmd()
+	cmd.SetArgs([]string{"keys", "export", "--format", "markdown"})
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "only available in development builds")
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -119,3 +120,36 @@ func TestTUIExperimentalGate(t *testing.T) {
 		assert.NotEmpty(t, matches, "sentinel file must be created when experimental gate passes")
 	})
 }
+
+func TestWriteKeysCheatsheet(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	cfg := config.Default()
+	cfg.CacheDir = t.TempDir()
+	cfg.AccountID = "99999"
+	session, err := workspace.NewSession(appctx.NewApp(cfg))
+	require.NoError(t, err)
+	defer session.Shutdown()
+
+	var buf bytes.Buffer
+	require.NoError(t, writeKeysCheatsheet(&buf, session))
+	out := buf.String()
+
+	assert.True(t, strings.HasPrefix(out, "# Basecamp workspace keys\n"))
+	assert.Contains(t, out, "\n## Global\n")
+	assert.Contains(t, out, "| `ctrl+p` | command palette |")
+	assert.Contains(t, out, "| `` ` `` | pool monitor |")
+	assert.Contains(t, out, "\n## Home\n")
+	assert.Contains(t, out, "| `t` | trash |", "per-view FullHelp keys must be included")
+}
+
+func TestTUIKeysExport_RejectsUnknownFormat(t *testing.T) {
+	cmd := NewTUICmd()
+	cmd.SetArgs([]string{"keys", "export", "--format", "pdf"})
+	cmd.SetOut(&bytes.Buffer{})
+	cmd.SetErr(&bytes.Buffer{})
+
+	err := cmd.Execute()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `Unsupported format: "pdf"`)
+}
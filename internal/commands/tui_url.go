@@ -5,7 +5,6 @@ package commands
 import (
 	"fmt"
 	"net/url"
-	"regexp"
 	"strconv"
 	"strings"
 
@@ -30,13 +29,6 @@ var urlPathToRecordingType = map[string]string{
 	"lines":            "Campfire::Line",
 }
 
-// bucketOnlyPattern matches project-level bucket URLs without a recording path.
-// urlarg.Parse doesn't match these since the SDK router expects a resource type.
-// Only accepts /buckets/{id} with optional trailing slash, query string, or fragment.
-var bucketOnlyPattern = regexp.MustCompile(
-	`^https?://(?:3\.)?basecamp\.com/(\d+)/buckets/(\d+)/?(?:\?[^/]*)?(?:#.*)?$`,
-)
-
 // parseBasecampURL extracts a ViewTarget and Scope from a Basecamp URL.
 // Recording URLs use the shared urlarg.Parse router for consistent matching;
 // project-only bucket URLs fall back to regex.
@@ -89,10 +81,10 @@ func parseBasecampURL(raw string) (workspace.ViewTarget, workspace.Scope, error)
 	}
 
 	// Fall back to bucket-only pattern (/buckets/{id} without recording)
-	if matches := bucketOnlyPattern.FindStringSubmatch(raw); matches != nil {
-		projectID, _ := strconv.ParseInt(matches[2], 10, 64)
+	if accountID, bucketID, ok := urlarg.ParseBucket(raw); ok {
+		projectID, _ := strconv.ParseInt(bucketID, 10, 64)
 		return workspace.ViewDock, workspace.Scope{
-			AccountID: matches[1],
+			AccountID: accountID,
 			ProjectID: projectID,
 		}, nil
 	}
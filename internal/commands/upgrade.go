@@ -46,15 +46,37 @@ var (
 
 // NewUpgradeCmd creates the upgrade command.
 func NewUpgradeCmd() *cobra.Command {
-	return &cobra.Command{
+	var check bool
+	var requireSignature bool
+
+	cmd := &cobra.Command{
 		Use:   "upgrade",
 		Short: "Upgrade to the latest version",
-		Long:  "Check for updates and upgrade the Basecamp CLI to the latest version.",
-		RunE:  runUpgrade,
+		Long: `Check for updates and upgrade the Basecamp CLI to the latest version.
+
+Homebrew and Scoop installs upgrade through their package manager. Other
+installs download the release archive for this platform, verify its SHA-256
+against the release checksums, and replace the binary in place. When cosign
+is on PATH, the checksums' signature is verified too. Without cosign the
+upgrade goes ahead on the checksum alone and warns that the signature wasn't
+checked; --require-signature refuses instead.
+
+Use --check to report whether an update is available without installing it.`,
+		Example: `  basecamp upgrade
+  basecamp upgrade --check --json
+  basecamp upgrade --require-signature`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return runUpgrade(cmd, check, requireSignature)
+		},
 	}
+
+	cmd.Flags().BoolVar(&check, "check", false, "Only check for an update; don't install it")
+	cmd.Flags().BoolVar(&requireSignature, "require-signature", false, "Fail unless the release signature can be verified with cosign")
+
+	return cmd
 }
 
-func runUpgrade(cmd *cobra.Command, args []string) error {
+func runUpgrade(cmd *cobra.Command, check, requireSignature bool) error {
 	app := appctx.FromContext(cmd.Context())
 
 	w := cmd.OutOrStdout()
@@ -89,6 +111,19 @@ func runUpgrade(cmd *cobra.Command, args []string) error {
 
 	fmt.Fprintf(w, "update available: %s\n", latest)
 
+	releaseURL := fmt.Sprintf("https://github.com/basecamp/basecamp-cli/releases/tag/v%s", latest)
+	if check {
+		return app.OK(
+			map[string]string{"status": "update_available", "from": current, "to": latest, "download_url": releaseURL},
+			output.WithSummary(fmt.Sprintf("Update available: %s → %s", current, latest)),
+			output.WithBreadcrumbs(output.Breadcrumb{
+				Action:      "upgrade",
+				Cmd:         "basecamp upgrade",
+				Description: "Install the update",
+			}),
+		)
+	}
+
 	ctx := cmd.Context()
 	if homebrewChecker(ctx) {
 		fmt.Fprintln(w, "Upgrading via Homebrew…")
@@ -148,12 +183,33 @@ func runUpgrade(cmd *cobra.Command, args []string) error {
 		)
 	}
 
-	downloadURL := fmt.Sprintf("https://github.com/basecamp/basecamp-cli/releases/tag/v%s", latest)
+	if exe, ok := selfUpdateTarget(); ok {
+		result, err := selfUpdater(ctx, latest, exe, requireSignature, w)
+		if err != nil {
+			return fmt.Errorf("upgrade failed: %w", err)
+		}
+		opts := []output.ResponseOption{output.WithSummary(fmt.Sprintf("Upgraded %s → %s", current, latest))}
+		if result.Signature != "verified" {
+			opts = append(opts, output.WithDiagnostic("Warning: "+unverifiedSignatureWarning))
+		}
+		return app.OK(
+			map[string]string{
+				"status":      "upgraded",
+				"from":        current,
+				"to":          latest,
+				"path":        result.Path,
+				"signature":   result.Signature,
+				"release_url": releaseURL,
+			},
+			opts...,
+		)
+	}
+
 	fmt.Fprintln(w)
 	fmt.Fprintf(w, "Download the latest release from:\n")
-	fmt.Fprintf(w, "  %s\n", downloadURL)
+	fmt.Fprintf(w, "  %s\n", releaseURL)
 	return app.OK(
-		map[string]string{"status": "update_available", "from": current, "to": latest, "download_url": downloadURL},
+		map[string]string{"status": "update_available", "from": current, "to": latest, "download_url": releaseURL},
 		output.WithSummary(fmt.Sprintf("Update available: %s → %s", current, latest)),
 	)
 }
@@ -0,0 +1,320 @@
+package commands
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+)
+
+const (
+	releaseDownloadURL  = "https://github.com/basecamp/basecamp-cli/releases/download"
+	releaseChecksums    = "checksums.txt"
+	releaseBundleSuffix = ".bundle"
+	releaseSignerFormat = "https://github.com/basecamp/basecamp-cli/.github/workflows/release.yml@refs/tags/v%s"
+	releaseOIDCIssuer   = "https://token.actions.githubusercontent.com"
+
+	// maxReleaseDownload bounds archive and binary sizes read during self-update.
+	maxReleaseDownload = 256 << 20
+)
+
+// Self-update seams, overridden in tests.
+var (
+	releaseBaseURL     = releaseDownloadURL
+	selfUpdateTarget   = resolveSelfUpdateTarget
+	selfUpdater        = upgradeInPlace
+	cosignLookup       = exec.LookPath
+	cosignBlobVerifier = runCosignVerifyBlob
+)
+
+// selfUpdateResult describes a completed in-place upgrade.
+type selfUpdateResult struct {
+	Path      string
+	Signature string // "verified" or "skipped"
+}
+
+// resolveSelfUpdateTarget returns the real path of the running binary when it
+// is safe to replace in place. Package-managed locations (Nix store, system
+// bin directories) are left to their package manager.
+func resolveSelfUpdateTarget() (string, bool) {
+	exe, err := os.Executable()
+	if err != nil {
+		return "", false
+	}
+	if resolved, resolveErr := filepath.EvalSymlinks(exe); resolveErr == nil {
+		exe = resolved
+	}
+	if isPackageManagedPath(exe) {
+		return "", false
+	}
+	return exe, true
+}
+
+func isPackageManagedPath(exe string) bool {
+	p := strings.ToLower(filepath.ToSlash(exe))
+	if strings.Contains(p, "/nix/store/") {
+		return true
+	}
+	for _, dir := range []string{"/usr/bin/", "/usr/sbin/", "/bin/", "/sbin/"} {
+		if strings.HasPrefix(p, dir) {
+			return true
+		}
+	}
+	return false
+}
+
+// releaseArchiveName mirrors the goreleaser archive name_template.
+func releaseArchiveName(version, goos, goarch string) string {
+	ext := "tar.gz"
+	if goos == "windows" {
+		ext = "zip"
+	}
+	return fmt.Sprintf("basecamp_%s_%s_%s.%s", version, goos, goarch, ext)
+}
+
+// unverifiedSignatureWarning is shown when an upgrade installs without a
+// signature check, so that a checksum-only install is never silent.
+const unverifiedSignatureWarning = "release signature NOT verified (cosign not found on PATH); only the checksum was checked. " +
+	"Install cosign, or pass --require-signature to refuse unverified upgrades."
+
+// upgradeInPlace downloads the release archive for this platform, verifies it
+// against the release checksums (and the checksums' cosign signature when
+// cosign is installed), and atomically replaces the binary at exe.
+func upgradeInPlace(ctx context.Context, latest, exe string, requireSignature bool, w io.Writer) (*selfUpdateResult, error) {
+	tmpDir, err := os.MkdirTemp("", "basecamp-upgrade-")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+
+	archiveName := releaseArchiveName(latest, runtime.GOOS, runtime.GOARCH)
+	base := fmt.Sprintf("%s/v%s", strings.TrimSuffix(releaseBaseURL, "/"), latest)
+	archivePath := filepath.Join(tmpDir, archiveName)
+	checksumsPath := filepath.Join(tmpDir, releaseChecksums)
+
+	fmt.Fprintf(w, "Downloading %s…\n", archiveName)
+	if err := downloadReleaseAsset(ctx, base+"/"+archiveName, archivePath); err != nil {
+		return nil, err
+	}
+	if err := downloadReleaseAsset(ctx, base+"/"+releaseChecksums, checksumsPath); err != nil {
+		return nil, err
+	}
+
+	signature := "skipped"
+	if cosign, lookErr := cosignLookup("cosign"); lookErr == nil {
+		bundlePath := checksumsPath + releaseBundleSuffix
+		if err := downloadReleaseAsset(ctx, base+"/"+releaseChecksums+releaseBundleSuffix, bundlePath); err != nil {
+			return nil, err
+		}
+		if err := cosignBlobVerifier(ctx, cosign, latest, checksumsPath, bundlePath); err != nil {
+			return nil, fmt.Errorf("signature verification failed for %s: %w", releaseChecksums, err)
+		}
+		signature = "verified"
+		fmt.Fprintln(w, "Signature verified")
+	} else if requireSignature {
+		return nil, errors.New("cosign not found on PATH; install cosign or drop --require-signature")
+	} else {
+		fmt.Fprintln(w, "WARNING: "+unverifiedSignatureWarning)
+	}
+
+	if err := verifyReleaseChecksum(archivePath, checksumsPath, archiveName); err != nil {
+		return nil, err
+	}
+	fmt.Fprintln(w, "Checksum verified")
+
+	binaryName := "basecamp"
+	if runtime.GOOS == "windows" {
+		binaryName = "basecamp.exe"
+	}
+
+	// Stage next to the target so the final rename stays on one filesystem.
+	staged := exe + ".new"
+	if err := extractReleaseBinary(archivePath, binaryName, staged); err != nil {
+		_ = os.Remove(staged)
+		return nil, err
+	}
+	if err := replaceExecutable(staged, exe); err != nil {
+		_ = os.Remove(staged)
+		return nil, err
+	}
+
+	return &selfUpdateResult{Path: exe, Signature: signature}, nil
+}
+
+func downloadReleaseAsset(ctx context.Context, url, dest string) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Minute)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("download %s: %w", url, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("download %s: unexpected status %d", url, resp.StatusCode)
+	}
+
+	f, err := os.Create(dest) //nolint:gosec // G304: dest is inside our temp dir
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(f, io.LimitReader(resp.Body, maxReleaseDownload)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// verifyReleaseChecksum compares the archive's SHA-256 with its entry in checksums.txt.
+func verifyReleaseChecksum(archivePath, checksumsPath, archiveName string) error {
+	expected, err := releaseChecksumFor(checksumsPath, archiveName)
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(archivePath) //nolint:gosec // G304: path is inside our temp dir
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return err
+	}
+	if actual := hex.EncodeToString(h.Sum(nil)); !strings.EqualFold(actual, expected) {
+		return fmt.Errorf("checksum mismatch for %s: expected %s, got %s", archiveName, expected, actual)
+	}
+	return nil
+}
+
+func releaseChecksumFor(checksumsPath, archiveName string) (string, error) {
+	f, err := os.Open(checksumsPath) //nolint:gosec // G304: path is inside our temp dir
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 2 && strings.TrimPrefix(fields[1], "*") == archiveName {
+			return fields[0], nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", err
+	}
+	return "", fmt.Errorf("%s not listed in %s", archiveName, releaseChecksums)
+}
+
+func runCosignVerifyBlob(ctx context.Context, cosign, version, checksumsPath, bundlePath string) error {
+	verify := exec.CommandContext(ctx, cosign, "verify-blob", //nolint:gosec // G204: fixed arguments, cosign resolved via LookPath
+		"--bundle", bundlePath,
+		"--certificate-identity", fmt.Sprintf(releaseSignerFormat, version),
+		"--certificate-oidc-issuer", releaseOIDCIssuer,
+		checksumsPath,
+	)
+	out, err := verify.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
+
+// extractReleaseBinary copies binaryName out of a .tar.gz or .zip archive to dest.
+func extractReleaseBinary(archivePath, binaryName, dest string) error {
+	if strings.HasSuffix(archivePath, ".zip") {
+		zr, err := zip.OpenReader(archivePath)
+		if err != nil {
+			return err
+		}
+		defer zr.Close()
+		for _, f := range zr.File {
+			if filepath.Base(f.Name) != binaryName || f.FileInfo().IsDir() {
+				continue
+			}
+			rc, err := f.Open()
+			if err != nil {
+				return err
+			}
+			defer rc.Close()
+			return writeExecutable(rc, dest)
+		}
+		return fmt.Errorf("%s not found in release archive", binaryName)
+	}
+
+	f, err := os.Open(archivePath) //nolint:gosec // G304: path is inside our temp dir
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+	defer gz.Close()
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			return fmt.Errorf("%s not found in release archive", binaryName)
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag == tar.TypeReg && filepath.Base(hdr.Name) == binaryName {
+			return writeExecutable(tr, dest)
+		}
+	}
+}
+
+func writeExecutable(r io.Reader, dest string) error {
+	f, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o755) //nolint:gosec // G302,G304: executable staged next to the running binary
+	if err != nil {
+		return fmt.Errorf("cannot write to %s: %w", filepath.Dir(dest), err)
+	}
+	if _, err := io.Copy(f, io.LimitReader(r, maxReleaseDownload)); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// replaceExecutable moves staged over exe. Windows cannot overwrite a running
+// binary, but it can rename it, so the old binary is moved aside first.
+func replaceExecutable(staged, exe string) error {
+	if runtime.GOOS == "windows" {
+		old := exe + ".old"
+		_ = os.Remove(old)
+		if err := os.Rename(exe, old); err != nil {
+			return err
+		}
+		if err := os.Rename(staged, exe); err != nil {
+			_ = os.Rename(old, exe)
+			return err
+		}
+		return nil
+	}
+	return os.Rename(staged, exe)
+}
@@ -0,0 +1,239 @@
+package commands
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/version"
+)
+
+// buildReleaseArchive returns a goreleaser-shaped archive for this platform
+// containing a single basecamp binary with the given contents.
+func buildReleaseArchive(t *testing.T, contents string) []byte {
+	t.Helper()
+
+	binaryName := "basecamp"
+	var buf bytes.Buffer
+	if runtime.GOOS == "windows" {
+		binaryName = "basecamp.exe"
+		zw := zip.NewWriter(&buf)
+		f, err := zw.Create(binaryName)
+		require.NoError(t, err)
+		_, err = f.Write([]byte(contents))
+		require.NoError(t, err)
+		require.NoError(t, zw.Close())
+		return buf.Bytes()
+	}
+
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: "README.md", Mode: 0o644, Size: 2, Typeflag: tar.TypeReg}))
+	_, err := tw.Write([]byte("hi"))
+	require.NoError(t, err)
+	require.NoError(t, tw.WriteHeader(&tar.Header{Name: binaryName, Mode: 0o755, Size: int64(len(contents)), Typeflag: tar.TypeReg}))
+	_, err = tw.Write([]byte(contents))
+	require.NoError(t, err)
+	require.NoError(t, tw.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+// serveRelease stubs the release download base with an httptest server that
+// serves the archive and a checksums.txt listing checksum for it.
+func serveRelease(t *testing.T, latest string, archive []byte, checksum string) {
+	t.Helper()
+
+	archiveName := releaseArchiveName(latest, runtime.GOOS, runtime.GOARCH)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v" + latest + "/" + archiveName:
+			_, _ = w.Write(archive)
+		case "/v" + latest + "/checksums.txt":
+			fmt.Fprintf(w, "0000  basecamp_%s_plan9_amd64.tar.gz\n%s  %s\n", latest, checksum, archiveName)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	origBase := releaseBaseURL
+	releaseBaseURL = srv.URL
+	t.Cleanup(func() { releaseBaseURL = origBase })
+
+	origLookup := cosignLookup
+	cosignLookup = func(string) (string, error) { return "", errors.New("not found") }
+	t.Cleanup(func() { cosignLookup = origLookup })
+}
+
+func sha256Hex(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+func TestReleaseArchiveName(t *testing.T) {
+	assert.Equal(t, "basecamp_1.2.3_linux_amd64.tar.gz", releaseArchiveName("1.2.3", "linux", "amd64"))
+	assert.Equal(t, "basecamp_1.2.3_windows_arm64.zip", releaseArchiveName("1.2.3", "windows", "arm64"))
+}
+
+func TestIsPackageManagedPath(t *testing.T) {
+	assert.True(t, isPackageManagedPath("/nix/store/abc-basecamp/bin/basecamp"))
+	assert.True(t, isPackageManagedPath("/usr/bin/basecamp"))
+	assert.False(t, isPackageManagedPath("/home/me/.local/bin/basecamp"))
+	assert.False(t, isPackageManagedPath("/usr/local/bin/basecamp"))
+}
+
+func TestUpgradeInPlaceReplacesBinary(t *testing.T) {
+	archive := buildReleaseArchive(t, "new binary")
+	serveRelease(t, "1.3.0", archive, sha256Hex(archive))
+
+	exe := filepath.Join(t.TempDir(), "basecamp")
+	require.NoError(t, os.WriteFile(exe, []byte("old binary"), 0o755))
+
+	var out bytes.Buffer
+	result, err := upgradeInPlace(context.Background(), "1.3.0", exe, false, &out)
+	require.NoError(t, err)
+
+	assert.Equal(t, exe, result.Path)
+	assert.Equal(t, "skipped", result.Signature)
+	assert.Contains(t, out.String(), "Checksum verified")
+	assert.Contains(t, out.String(), "WARNING: release signature NOT verified")
+
+	got, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "new binary", string(got))
+	assert.NoFileExists(t, exe+".new")
+}
+
+func TestUpgradeInPlaceRejectsChecksumMismatch(t *testing.T) {
+	archive := buildReleaseArchive(t, "tampered binary")
+	serveRelease(t, "1.3.0", archive, sha256Hex([]byte("something else")))
+
+	exe := filepath.Join(t.TempDir(), "basecamp")
+	require.NoError(t, os.WriteFile(exe, []byte("old binary"), 0o755))
+
+	_, err := upgradeInPlace(context.Background(), "1.3.0", exe, false, io.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksum mismatch")
+
+	got, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "old binary", string(got), "binary must be untouched on verification failure")
+}
+
+func TestUpgradeInPlaceRequireSignatureWithoutCosign(t *testing.T) {
+	archive := buildReleaseArchive(t, "new binary")
+	serveRelease(t, "1.3.0", archive, sha256Hex(archive))
+
+	exe := filepath.Join(t.TempDir(), "basecamp")
+	require.NoError(t, os.WriteFile(exe, []byte("old binary"), 0o755))
+
+	_, err := upgradeInPlace(context.Background(), "1.3.0", exe, true, io.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "cosign not found")
+}
+
+func TestUpgradeInPlaceVerifiesSignatureWithCosign(t *testing.T) {
+	archive := buildReleaseArchive(t, "new binary")
+	serveRelease(t, "1.3.0", archive, sha256Hex(archive))
+	cosignLookup = func(string) (string, error) { return "/usr/local/bin/cosign", nil }
+
+	// The stub release server has no signature bundle, so the signature
+	// step must fail before the binary is touched.
+	exe := filepath.Join(t.TempDir(), "basecamp")
+	require.NoError(t, os.WriteFile(exe, []byte("old binary"), 0o755))
+
+	_, err := upgradeInPlace(context.Background(), "1.3.0", exe, false, io.Discard)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "checksums.txt.bundle")
+
+	got, err := os.ReadFile(exe)
+	require.NoError(t, err)
+	assert.Equal(t, "old binary", string(got))
+}
+
+func TestUpgradeCheckDoesNotInstall(t *testing.T) {
+	app, appBuf := setupPeopleTestApp(t)
+
+	orig := version.Version
+	version.Version = "1.2.3"
+	t.Cleanup(func() { version.Version = orig })
+
+	stubUpgradeCheckers(t, upgradeCheckersStub{latestVersion: "1.3.0", isBrew: true, selfUpdatePath: "/tmp/basecamp"})
+	homebrewUpgrader = func(context.Context, io.Writer, io.Writer) error {
+		t.Fatal("--check must not upgrade")
+		return nil
+	}
+	origUpdater := selfUpdater
+	selfUpdater = func(context.Context, string, string, bool, io.Writer) (*selfUpdateResult, error) {
+		t.Fatal("--check must not self-update")
+		return nil, nil
+	}
+	t.Cleanup(func() { selfUpdater = origUpdater })
+
+	_, err := executeUpgradeCommand(t, app, "--check")
+	require.NoError(t, err)
+	assert.Contains(t, appBuf.String(), "update_available")
+}
+
+func TestUpgradeSelfUpdatesDirectInstall(t *testing.T) {
+	app, appBuf := setupPeopleTestApp(t)
+
+	orig := version.Version
+	version.Version = "1.2.3"
+	t.Cleanup(func() { version.Version = orig })
+
+	stubUpgradeCheckers(t, upgradeCheckersStub{latestVersion: "1.3.0", selfUpdatePath: "/home/me/.local/bin/basecamp"})
+
+	var gotPath, gotVersion string
+	origUpdater := selfUpdater
+	selfUpdater = func(_ context.Context, latest, exe string, _ bool, _ io.Writer) (*selfUpdateResult, error) {
+		gotPath, gotVersion = exe, latest
+		return &selfUpdateResult{Path: exe, Signature: "verified"}, nil
+	}
+	t.Cleanup(func() { selfUpdater = origUpdater })
+
+	_, err := executeUpgradeCommand(t, app)
+	require.NoError(t, err)
+	assert.Equal(t, "/home/me/.local/bin/basecamp", gotPath)
+	assert.Equal(t, "1.3.0", gotVersion)
+	assert.Contains(t, appBuf.String(), `"status": "upgraded"`)
+	assert.Contains(t, appBuf.String(), `"signature": "verified"`)
+}
+
+func TestUpgradeWarnsWhenSignatureSkipped(t *testing.T) {
+	app, appBuf := setupPeopleTestApp(t)
+
+	orig := version.Version
+	version.Version = "1.2.3"
+	t.Cleanup(func() { version.Version = orig })
+
+	stubUpgradeCheckers(t, upgradeCheckersStub{latestVersion: "1.3.0", selfUpdatePath: "/home/me/.local/bin/basecamp"})
+
+	origUpdater := selfUpdater
+	selfUpdater = func(_ context.Context, _, exe string, _ bool, _ io.Writer) (*selfUpdateResult, error) {
+		return &selfUpdateResult{Path: exe, Signature: "skipped"}, nil
+	}
+	t.Cleanup(func() { selfUpdater = origUpdater })
+
+	_, err := executeUpgradeCommand(t, app)
+	require.NoError(t, err)
+	assert.Contains(t, appBuf.String(), `"signature": "skipped"`)
+	assert.Contains(t, appBuf.String(), "release signature NOT verified")
+}
@@ -39,6 +39,7 @@ type upgradeCheckersStub struct {
 	isGlobalScoop   bool
 	homebrewUpgrade func(context.Context, io.Writer, io.Writer) error
 	scoopUpgrade    func(context.Context, bool, io.Writer, io.Writer) error
+	selfUpdatePath  string // empty disables in-place self-update
 }
 
 // stubUpgradeCheckers overrides version and package manager helpers for tests.
@@ -82,14 +83,18 @@ func stubUpgradeCheckers(t *testing.T, stub upgradeCheckersStub) {
 		scoopUpgrader = func(context.Context, bool, io.Writer, io.Writer) error { return nil }
 	}
 	t.Cleanup(func() { scoopUpgrader = origSU })
+
+	origTarget := selfUpdateTarget
+	selfUpdateTarget = func() (string, bool) { return stub.selfUpdatePath, stub.selfUpdatePath != "" }
+	t.Cleanup(func() { selfUpdateTarget = origTarget })
 }
 
 // executeUpgradeCommand runs the upgrade command and returns the combined
 // output captured from cmd.OutOrStdout().
-func executeUpgradeCommand(t *testing.T, app *appctx.App) (cmdOut string, err error) {
+func executeUpgradeCommand(t *testing.T, app *appctx.App, args ...string) (cmdOut string, err error) {
 	t.Helper()
 	cmd := NewUpgradeCmd()
-	cmd.SetArgs(nil)
+	cmd.SetArgs(args)
 	ctx := appctx.WithApp(context.Background(), app)
 	cmd.SetContext(ctx)
 
@@ -0,0 +1,212 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// verifyScratchListName names the throwaway todolist verify creates when no
+// sandbox list is given. It's trashed again before the command returns.
+const verifyScratchListName = "basecamp verify (scratch, safe to delete)"
+
+// VerifyResult records what basecamp verify exercised.
+type VerifyResult struct {
+	ProjectID   int64   `json:"project_id"`
+	ProjectName string  `json:"project_name"`
+	TodolistID  int64   `json:"todolist_id"`
+	Scratch     bool    `json:"scratch_list"`
+	TodoID      int64   `json:"todo_id"`
+	Steps       []Check `json:"steps"` // all "pass"; a failed step is returned as an error
+}
+
+// NewVerifyCmd creates the verify command.
+func NewVerifyCmd() *cobra.Command {
+	var project string
+	var todolist string
+
+	cmd := &cobra.Command{
+		Use:   "verify",
+		Short: "Check credentials and permissions against a project",
+		Long: `Run a minimal end-to-end check against a project before a big automation
+run: read the project, create a scratch todo, and trash it again.
+
+Without --list, the todo goes in a scratch todolist that verify creates and
+trashes afterwards. Pass --list to use a designated sandbox list instead, so
+nothing but the todo is created.
+
+The first step that fails stops the run and exits non-zero with the API
+error, so scripts can gate on it. Anything created before the failure is
+still trashed.`,
+		Example: `  basecamp verify --in Launch
+  basecamp verify --in Launch --list Sandbox`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			app := appctx.FromContext(cmd.Context())
+			if app == nil {
+				return fmt.Errorf("app not initialized")
+			}
+
+			if err := ensureAccount(cmd, app); err != nil {
+				return err
+			}
+
+			projectID, err := resolveProjectID(cmd, app, project)
+			if err != nil {
+				return err
+			}
+
+			if todolist != "" {
+				todolist, err = resolveTodolistInTodoset(cmd, app, todolist, projectID, "")
+				if err != nil {
+					return err
+				}
+			}
+
+			result, err := runVerify(cmd, app, projectID, todolist)
+			if err != nil {
+				return err
+			}
+
+			return app.OK(result,
+				output.WithSummary(fmt.Sprintf("Verified read, create, and trash in %s", result.ProjectName)),
+				output.WithBreadcrumbs(
+					output.Breadcrumb{
+						Action:      "todos",
+						Cmd:         fmt.Sprintf("basecamp todos --in %d", result.ProjectID),
+						Description: "List todos",
+					},
+				),
+			)
+		},
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.Flags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
+	cmd.Flags().StringVarP(&todolist, "list", "l", "", "Sandbox todolist ID, name, or URL (default: a scratch list)")
+
+	return cmd
+}
+
+// runVerify performs the verify steps in order, stopping at the first
+// failure. Whatever was created is trashed on the way out, even on failure.
+func runVerify(cmd *cobra.Command, app *appctx.App, projectID, todolist string) (_ *VerifyResult, err error) {
+	ctx := cmd.Context()
+	result := &VerifyResult{}
+
+	step := func(name string, fn func() (string, error)) error {
+		msg, err := fn()
+		if err != nil {
+			return verifyStepError(name, err)
+		}
+		result.Steps = append(result.Steps, Check{Name: name, Status: "pass", Message: msg})
+		return nil
+	}
+
+	// Trash in reverse order of creation.
+	var cleanup []func() error
+	defer func() {
+		for i := len(cleanup) - 1; i >= 0; i-- {
+			if cerr := cleanup[i](); cerr != nil && err == nil {
+				err = cerr
+			}
+		}
+	}()
+
+	pid, err := strconv.ParseInt(projectID, 10, 64)
+	if err != nil {
+		return nil, output.ErrUsage("Invalid project ID")
+	}
+
+	if err := step("Read project", func() (string, error) {
+		p, err := app.Account().Projects().Get(ctx, pid)
+		if err != nil {
+			return "", err
+		}
+		result.ProjectID, result.ProjectName = p.ID, p.Name
+		return p.Name, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if todolist == "" {
+		todosetID, err := ensureTodoset(cmd, app, projectID, "")
+		if err != nil {
+			return nil, err
+		}
+		tsid, err := strconv.ParseInt(todosetID, 10, 64)
+		if err != nil {
+			return nil, output.ErrUsage("Invalid todoset ID")
+		}
+		if err := step("Create scratch todolist", func() (string, error) {
+			list, err := app.Account().Todolists().Create(ctx, tsid, &basecamp.CreateTodolistRequest{
+				Name: verifyScratchListName,
+			})
+			if err != nil {
+				return "", err
+			}
+			result.TodolistID, result.Scratch = list.ID, true
+			return fmt.Sprintf("#%d", list.ID), nil
+		}); err != nil {
+			return nil, err
+		}
+		listID := result.TodolistID
+		cleanup = append(cleanup, func() error {
+			return verifyTrash(result, "Trash scratch todolist", app, listID)
+		})
+	} else {
+		lid, err := strconv.ParseInt(todolist, 10, 64)
+		if err != nil {
+			return nil, output.ErrUsage("Invalid todolist ID")
+		}
+		result.TodolistID = lid
+	}
+
+	if err := step("Create todo", func() (string, error) {
+		todo, err := app.Account().Todos().Create(ctx, result.TodolistID, &basecamp.CreateTodoRequest{
+			Content: fmt.Sprintf("basecamp verify %s", time.Now().Format(time.RFC3339)),
+		})
+		if err != nil {
+			return "", err
+		}
+		result.TodoID = todo.ID
+		return fmt.Sprintf("#%d", todo.ID), nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := verifyTrash(result, "Trash todo", app, result.TodoID); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// verifyTrash trashes a recording made by verify and records the step. It
+// runs on its own context so an interrupted run still removes what it made.
+func verifyTrash(result *VerifyResult, name string, app *appctx.App, id int64) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	if err := app.Account().Recordings().Trash(ctx, id); err != nil {
+		return verifyStepError(name, err)
+	}
+	result.Steps = append(result.Steps, Check{Name: name, Status: "pass", Message: fmt.Sprintf("#%d", id)})
+	return nil
+}
+
+// verifyStepError prefixes the converted API error with the step that failed,
+// keeping its code so the exit status still reflects the cause.
+func verifyStepError(name string, err error) error {
+	e := *output.AsError(convertSDKError(err))
+	e.Message = fmt.Sprintf("verify failed at %q: %s", name, e.Message)
+	return &e
+}
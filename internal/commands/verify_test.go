@@ -0,0 +1,111 @@
+package commands
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// setupVerifyServer serves a project with one todoset and records every
+// mutating request as "METHOD path". When failTodo is set, creating the todo
+// is refused with a 403.
+func setupVerifyServer(t *testing.T, calls *[]string, failTodo bool) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			*calls = append(*calls, r.Method+" "+r.URL.Path)
+		}
+
+		switch {
+		case r.URL.Path == "/99999/projects.json":
+			json.NewEncoder(w).Encode([]map[string]any{{"id": 55555, "name": "Launch"}})
+		case r.URL.Path == "/99999/projects/55555", r.URL.Path == "/99999/projects/55555.json":
+			json.NewEncoder(w).Encode(map[string]any{
+				"id": 55555, "name": "Launch",
+				"dock": []map[string]any{{"id": 700, "name": "todoset", "title": "To-dos", "enabled": true}},
+			})
+		case r.URL.Path == "/99999/todosets/700/todolists.json" && r.Method == http.MethodGet:
+			json.NewEncoder(w).Encode([]map[string]any{{"id": 800, "name": "Sandbox", "title": "Sandbox"}})
+		case r.URL.Path == "/99999/todosets/700/todolists.json":
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]any{"id": 801, "name": verifyScratchListName})
+		case r.URL.Path == "/99999/todolists/801/todos.json", r.URL.Path == "/99999/todolists/800/todos.json":
+			if failTodo {
+				w.WriteHeader(http.StatusForbidden)
+				json.NewEncoder(w).Encode(map[string]any{"error": "You don't have access"})
+				return
+			}
+			w.WriteHeader(http.StatusCreated)
+			json.NewEncoder(w).Encode(map[string]any{"id": 900, "content": "basecamp verify"})
+		case r.Method == http.MethodPut && (r.URL.Path == "/99999/recordings/900/status/trashed.json" ||
+			r.URL.Path == "/99999/recordings/801/status/trashed.json"):
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestVerifyScratchList(t *testing.T) {
+	var calls []string
+	app, buf := setupPeopleMockApp(t, setupVerifyServer(t, &calls, false))
+
+	err := executePeopleCommand(NewVerifyCmd(), app, "--in", "55555")
+	require.NoError(t, err, buf.String())
+
+	assert.Equal(t, []string{
+		"POST /99999/todosets/700/todolists.json",
+		"POST /99999/todolists/801/todos.json",
+		"PUT /99999/recordings/900/status/trashed.json",
+		"PUT /99999/recordings/801/status/trashed.json",
+	}, calls)
+
+	var envelope struct {
+		Data    VerifyResult `json:"data"`
+		Summary string       `json:"summary"`
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &envelope))
+	assert.Equal(t, "Verified read, create, and trash in Launch", envelope.Summary)
+	assert.True(t, envelope.Data.Scratch)
+	assert.Equal(t, int64(900), envelope.Data.TodoID)
+	require.Len(t, envelope.Data.Steps, 5)
+	assert.Equal(t, "Trash scratch todolist", envelope.Data.Steps[4].Name)
+}
+
+func TestVerifySandboxList(t *testing.T) {
+	var calls []string
+	app, buf := setupPeopleMockApp(t, setupVerifyServer(t, &calls, false))
+
+	err := executePeopleCommand(NewVerifyCmd(), app, "--in", "55555", "--list", "Sandbox")
+	require.NoError(t, err, buf.String())
+
+	assert.Equal(t, []string{
+		"POST /99999/todolists/800/todos.json",
+		"PUT /99999/recordings/900/status/trashed.json",
+	}, calls)
+}
+
+func TestVerifyFailureStillCleansUp(t *testing.T) {
+	var calls []string
+	app, _ := setupPeopleMockApp(t, setupVerifyServer(t, &calls, true))
+
+	err := executePeopleCommand(NewVerifyCmd(), app, "--in", "55555")
+	var e *output.Error
+	require.ErrorAs(t, err, &e)
+	assert.Contains(t, e.Message, `verify failed at "Create todo"`)
+	assert.Equal(t, output.CodeForbidden, e.Code)
+
+	require.NotEmpty(t, calls)
+	assert.Equal(t, "PUT /99999/recordings/801/status/trashed.json", calls[len(calls)-1],
+		"the scratch list is trashed even though the todo step failed")
+}
@@ -0,0 +1,123 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// NewVisibilityCmd creates the visibility command for managing what clients can see.
+func NewVisibilityCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "visibility",
+		Short: "Manage client visibility",
+		Long: `Manage whether items are visible to clients.
+
+In projects with client access, each item is either shared with the
+client or kept to your team. basecamp show reports an item's current state.`,
+		Annotations: map[string]string{"agent_notes": "Client visibility only matters in projects with clients invited\nbasecamp show <id> reports visible_to_clients"},
+	}
+
+	cmd.AddCommand(newVisibilitySetCmd())
+
+	return cmd
+}
+
+func newVisibilitySetCmd() *cobra.Command {
+	var clients string
+
+	cmd := &cobra.Command{
+		Use:   "set <id|url>",
+		Short: "Show or hide an item from clients",
+		Long: `Set whether an item is visible to clients.
+
+You can pass either an ID or a Basecamp URL:
+  basecamp visibility set 789 --clients on
+  basecamp visibility set https://3.basecamp.com/123/buckets/456/recordings/789 --clients off`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var visible bool
+			switch clients {
+			case "on":
+				visible = true
+			case "off":
+				visible = false
+			case "":
+				return output.ErrUsage("Must specify --clients on or --clients off")
+			default:
+				return output.ErrUsage(fmt.Sprintf("Invalid --clients value %q (use on or off)", clients))
+			}
+			return runSetClientVisibility(cmd, args[0], visible)
+		},
+	}
+
+	cmd.Flags().StringVar(&clients, "clients", "", "Client visibility: on or off")
+
+	return cmd
+}
+
+// runSetClientVisibility shares an item with clients or hides it from them.
+func runSetClientVisibility(cmd *cobra.Command, arg string, visible bool) error {
+	app := appctx.FromContext(cmd.Context())
+
+	if err := ensureAccount(cmd, app); err != nil {
+		return err
+	}
+
+	// Extract ID from URL if provided
+	recordingIDStr := extractID(arg)
+
+	recordingID, err := strconv.ParseInt(recordingIDStr, 10, 64)
+	if err != nil {
+		return output.ErrUsage("Invalid ID")
+	}
+
+	recording, err := app.Account().Recordings().SetClientVisibility(cmd.Context(), recordingID, visible)
+	if err != nil {
+		return convertSDKError(err)
+	}
+
+	var summary string
+	if visible {
+		summary = fmt.Sprintf("#%s now visible to clients", recordingIDStr)
+	} else {
+		summary = fmt.Sprintf("#%s now hidden from clients", recordingIDStr)
+	}
+
+	return app.OK(recording,
+		output.WithSummary(summary),
+		output.WithBreadcrumbs(
+			output.Breadcrumb{
+				Action:      "show",
+				Cmd:         fmt.Sprintf("basecamp show %s", recordingIDStr),
+				Description: "View item",
+			},
+		),
+	)
+}
+
+// clientVisibilityBreadcrumb offers to flip an item's client visibility,
+// given its visible_to_clients value. Items without the field (people,
+// projects) get none.
+func clientVisibilityBreadcrumb(id string, data map[string]any) (output.Breadcrumb, bool) {
+	visible, ok := data["visible_to_clients"].(bool)
+	if !ok {
+		return output.Breadcrumb{}, false
+	}
+	if visible {
+		return output.Breadcrumb{
+			Action:      "hide",
+			Cmd:         fmt.Sprintf("basecamp visibility set %s --clients off", id),
+			Description: "Hide from clients",
+		}, true
+	}
+	return output.Breadcrumb{
+		Action:      "share",
+		Cmd:         fmt.Sprintf("basecamp visibility set %s --clients on", id),
+		Description: "Make visible to clients",
+	}, true
+}
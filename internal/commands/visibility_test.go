@@ -0,0 +1,67 @@
+package commands
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// mockVisibilityTransport records client visibility updates.
+type mockVisibilityTransport struct {
+	paths  []string
+	bodies []map[string]any
+}
+
+func (t *mockVisibilityTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	if req.Method == "PUT" {
+		var body map[string]any
+		data, _ := io.ReadAll(req.Body)
+		req.Body.Close()
+		_ = json.Unmarshal(data, &body)
+		t.paths = append(t.paths, req.URL.Path)
+		t.bodies = append(t.bodies, body)
+	}
+	resp := `{"id": 789, "type": "Message", "visible_to_clients": true}`
+	return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(resp)), Header: header}, nil
+}
+
+func TestVisibilitySetClients(t *testing.T) {
+	transport := &mockVisibilityTransport{}
+	app, buf := setupMessagesMockApp(t, transport)
+
+	require.NoError(t, executeMessagesCommand(NewVisibilityCmd(), app, "set", "789", "--clients", "on"))
+	require.Len(t, transport.paths, 1)
+	assert.Equal(t, "/99999/recordings/789/client_visibility.json", transport.paths[0])
+	assert.Equal(t, true, transport.bodies[0]["visible_to_clients"])
+
+	var resp output.Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "#789 now visible to clients", resp.Summary)
+
+	require.NoError(t, executeMessagesCommand(NewVisibilityCmd(), app, "set", "789", "--clients", "off"))
+	require.Len(t, transport.bodies, 2)
+	assert.Equal(t, false, transport.bodies[1]["visible_to_clients"])
+}
+
+func TestVisibilitySetRequiresOnOrOff(t *testing.T) {
+	transport := &mockVisibilityTransport{}
+	app, _ := setupMessagesMockApp(t, transport)
+
+	err := executeMessagesCommand(NewVisibilityCmd(), app, "set", "789")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "--clients")
+
+	err = executeMessagesCommand(NewVisibilityCmd(), app, "set", "789", "--clients", "maybe")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "use on or off")
+	assert.Empty(t, transport.paths)
+}
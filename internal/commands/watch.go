@@ -0,0 +1,187 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// addWatchFlags adds --watch and --interval to a list command. With --watch
+// the command reruns every interval until interrupted: the first run prints
+// the whole list, later runs only the items that are new or changed since
+// the run before, matched by ID. Add it after the command's RunE is set.
+func addWatchFlags(cmd *cobra.Command) {
+	var watch bool
+	var interval int
+
+	run := cmd.RunE
+	cmd.RunE = func(cmd *cobra.Command, args []string) error {
+		if !watch {
+			return run(cmd, args)
+		}
+		if interval < 1 {
+			return output.ErrUsage("--interval must be at least 1 second")
+		}
+		if f := cmd.Flags().Lookup("page"); f != nil && f.Changed {
+			return output.ErrUsage("--page cannot be used with --watch")
+		}
+		if app := appctx.FromContext(cmd.Context()); app != nil && app.Flags.OutputFile != "" {
+			return output.ErrUsage("--output-file cannot be used with --watch")
+		}
+		return runListWatch(cmd, args, run, time.Duration(interval)*time.Second)
+	}
+
+	cmd.Flags().BoolVarP(&watch, "watch", "w", false, "Poll and print new or changed items until interrupted")
+	cmd.Flags().IntVar(&interval, "interval", 30, "Poll interval in seconds for --watch")
+}
+
+// runListWatch polls run, printing the first result in full and then only
+// what changed. A failed first run is returned; later failures are reported
+// on stderr and polling carries on. Ctrl-C ends the watch without error.
+func runListWatch(cmd *cobra.Command, args []string, run func(*cobra.Command, []string) error, interval time.Duration) error {
+	ctx := cmd.Context()
+	app := appctx.FromContext(ctx)
+	out := app.Output
+	defer func() { app.Output = out }()
+
+	var seen map[string]string // item key → its JSON at the last run
+	for {
+		var resp *output.Response
+		app.Output = output.New(output.Options{Capture: func(r *output.Response) { resp = r }})
+		err := run(cmd, args)
+		app.Output = out
+
+		switch {
+		case ctx.Err() != nil:
+			return nil
+		case err != nil && seen == nil:
+			return err
+		case err != nil:
+			fmt.Fprintf(cmd.ErrOrStderr(), "Warning: %s: %v\n", time.Now().Format("15:04:05"), err)
+		case resp != nil:
+			first := seen == nil
+			var changed []int
+			var added, updated int
+			keys, encoded := watchItems(resp.Data)
+			next := make(map[string]string, len(keys))
+			for i, key := range keys {
+				next[key] = encoded[i]
+				prev, ok := seen[key]
+				switch {
+				case first:
+					changed = append(changed, i)
+				case !ok:
+					changed = append(changed, i)
+					added++
+				case prev != encoded[i]:
+					changed = append(changed, i)
+					updated++
+				}
+			}
+			seen = next
+
+			if first || len(changed) > 0 {
+				if err := app.OK(pickItems(resp.Data, changed), watchResponseOptions(resp, changed, first, added, updated, interval)...); err != nil {
+					return err
+				}
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// watchResponseOptions carries the captured response's presentation over to
+// the items being printed. The first run keeps its summary, notice, and
+// breadcrumbs; later runs say what changed and when.
+func watchResponseOptions(resp *output.Response, changed []int, first bool, added, updated int, interval time.Duration) []output.ResponseOption {
+	var opts []output.ResponseOption
+	if resp.Entity != "" {
+		opts = append(opts, output.WithEntity(resp.Entity))
+	}
+	if resp.DisplayData != nil && sliceLen(resp.DisplayData) == sliceLen(resp.Data) {
+		opts = append(opts, output.WithDisplayData(pickItems(resp.DisplayData, changed)))
+	}
+
+	if first {
+		summary := fmt.Sprintf("watching every %s, Ctrl-C to stop", interval)
+		if resp.Summary != "" {
+			summary = resp.Summary + " (" + summary + ")"
+		}
+		opts = append(opts, output.WithSummary(summary), output.WithBreadcrumbs(resp.Breadcrumbs...))
+		if resp.Notice != "" {
+			opts = append(opts, output.WithNotice(resp.Notice))
+		}
+		return opts
+	}
+
+	var parts []string
+	if added > 0 {
+		parts = append(parts, fmt.Sprintf("%d new", added))
+	}
+	if updated > 0 {
+		parts = append(parts, fmt.Sprintf("%d changed", updated))
+	}
+	summary := time.Now().Format("15:04:05") + ": " + strings.Join(parts, ", ")
+	return append(opts, output.WithSummary(summary), output.WithoutBreadcrumbs())
+}
+
+// watchItems returns a key and the JSON encoding of each item of a list
+// (a single object counts as a one-item list). Items are keyed by their
+// "id"; one without an ID is keyed by its encoding, so a change to it reads
+// as a new item.
+func watchItems(data any) (keys, encoded []string) {
+	raw, err := json.Marshal(output.NormalizeData(data))
+	if err != nil {
+		return nil, nil
+	}
+	var items []json.RawMessage
+	if json.Unmarshal(raw, &items) != nil {
+		items = []json.RawMessage{raw}
+	}
+	for _, item := range items {
+		var ref struct {
+			ID json.Number `json:"id"`
+		}
+		key := string(item)
+		if json.Unmarshal(item, &ref) == nil && ref.ID != "" {
+			key = ref.ID.String()
+		}
+		keys = append(keys, key)
+		encoded = append(encoded, string(item))
+	}
+	return keys, encoded
+}
+
+// pickItems returns the elements of a slice at the given indexes, keeping
+// the slice's type. A value that isn't a slice is its own only element.
+func pickItems(data any, indexes []int) any {
+	v := reflect.ValueOf(data)
+	if v.Kind() != reflect.Slice {
+		return data
+	}
+	picked := reflect.MakeSlice(v.Type(), 0, len(indexes))
+	for _, i := range indexes {
+		picked = reflect.Append(picked, v.Index(i))
+	}
+	return picked.Interface()
+}
+
+// sliceLen returns the length of a slice, or 1 for any other value.
+func sliceLen(data any) int {
+	if v := reflect.ValueOf(data); v.Kind() == reflect.Slice {
+		return v.Len()
+	}
+	return 1
+}
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+type watchTestItem struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+// watchResponses decodes the JSON envelopes written by successive polls.
+func watchResponses(t *testing.T, buf *bytes.Buffer) []output.Response {
+	t.Helper()
+	var responses []output.Response
+	dec := json.NewDecoder(buf)
+	for {
+		var resp output.Response
+		err := dec.Decode(&resp)
+		if errors.Is(err, io.EOF) {
+			return responses
+		}
+		require.NoError(t, err)
+		responses = append(responses, resp)
+	}
+}
+
+// runWatchPolls runs runListWatch over the given poll results and stops it
+// on the poll after the last one.
+func runWatchPolls(t *testing.T, polls [][]watchTestItem, failAt int) ([]output.Response, string, error) {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	app := &appctx.App{Output: output.New(output.Options{Format: output.FormatJSON, Writer: buf})}
+	ctx, cancel := context.WithCancel(appctx.WithApp(context.Background(), app))
+	defer cancel()
+
+	cmd := &cobra.Command{Use: "list"}
+	cmd.SetContext(ctx)
+	stderr := &bytes.Buffer{}
+	cmd.SetErr(stderr)
+
+	n := 0
+	run := func(cmd *cobra.Command, args []string) error {
+		defer func() { n++ }()
+		if n == len(polls) {
+			cancel()
+			return cmd.Context().Err()
+		}
+		if n == failAt {
+			return output.ErrAPI(500, "boom")
+		}
+		return app.OK(polls[n], output.WithSummary("cards"))
+	}
+
+	err := runListWatch(cmd, nil, run, time.Millisecond)
+	return watchResponses(t, buf), stderr.String(), err
+}
+
+func TestListWatchPrintsOnlyNewAndChangedItems(t *testing.T) {
+	polls := [][]watchTestItem{
+		{{ID: 1, Title: "One"}, {ID: 2, Title: "Two"}},
+		{{ID: 1, Title: "One"}, {ID: 2, Title: "Two"}},
+		{{ID: 3, Title: "Three"}, {ID: 1, Title: "One"}, {ID: 2, Title: "Two, edited"}},
+		{{ID: 3, Title: "Three"}, {ID: 2, Title: "Two, edited"}},
+	}
+	responses, _, err := runWatchPolls(t, polls, -1)
+	require.NoError(t, err)
+
+	// The unchanged second poll and the removal-only last poll print nothing.
+	require.Len(t, responses, 2)
+	assert.Len(t, responses[0].Data, 2)
+	assert.Contains(t, responses[0].Summary, "cards (watching every 1ms")
+
+	changed := responses[1].Data.([]any)
+	require.Len(t, changed, 2)
+	assert.Equal(t, "Three", changed[0].(map[string]any)["title"])
+	assert.Equal(t, "Two, edited", changed[1].(map[string]any)["title"])
+	assert.Contains(t, responses[1].Summary, "1 new, 1 changed")
+}
+
+func TestListWatchKeepsPollingAfterLaterErrors(t *testing.T) {
+	polls := [][]watchTestItem{
+		{{ID: 1, Title: "One"}},
+		nil,
+		{{ID: 1, Title: "One"}, {ID: 2, Title: "Two"}},
+	}
+	responses, stderr, err := runWatchPolls(t, polls, 1)
+	require.NoError(t, err)
+	assert.Contains(t, stderr, "boom")
+	require.Len(t, responses, 2)
+	assert.Contains(t, responses[1].Summary, "1 new")
+}
+
+func TestListWatchReturnsFirstError(t *testing.T) {
+	_, _, err := runWatchPolls(t, [][]watchTestItem{nil, nil}, 0)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "boom")
+}
+
+func TestWatchFlagsRejectBadCombinations(t *testing.T) {
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--watch", "--interval", "0"}, "--interval must be at least 1 second"},
+		{[]string{"--watch", "--page", "1"}, "--page cannot be used with --watch"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.want, func(t *testing.T) {
+			ran := false
+			cmd := &cobra.Command{Use: "list", RunE: func(*cobra.Command, []string) error {
+				ran = true
+				return nil
+			}}
+			cmd.Flags().Int("page", 0, "")
+			addWatchFlags(cmd)
+
+			app := &appctx.App{Output: output.New(output.Options{Format: output.FormatJSON, Writer: &bytes.Buffer{}})}
+			err := executeChatCommand(cmd, app, tt.args...)
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+			assert.False(t, ran)
+		})
+	}
+}
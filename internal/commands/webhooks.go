@@ -28,8 +28,8 @@ Vault, Schedule::Entry, Kanban::Card, Question, Question::Answer`,
 		Annotations: map[string]string{"agent_notes": "Event types: Todo, Todolist, Message, Comment, Document, Upload, Vault, Schedule::Entry, Kanban::Card, Question, Question::Answer"},
 	}
 
-	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, URL, or ID")
-	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, URL, or ID (alias for --project)")
+	cmd.PersistentFlags().StringVarP(&project, "project", "p", "", "Project name, ID, or URL")
+	cmd.PersistentFlags().StringVar(&project, "in", "", "Project name, ID, or URL (alias for --project)")
 
 	cmd.AddCommand(
 		newWebhooksListCmd(&project),
@@ -61,21 +61,7 @@ func runWebhooksList(cmd *cobra.Command, project *string) error {
 	}
 
 	// Resolve project — required for project-scoped webhook listing
-	projectID := *project
-	if projectID == "" {
-		projectID = app.Flags.Project
-	}
-	if projectID == "" {
-		projectID = app.Config.ProjectID
-	}
-	if projectID == "" {
-		if err := ensureProject(cmd, app); err != nil {
-			return err
-		}
-		projectID = app.Config.ProjectID
-	}
-
-	resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+	resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 	if err != nil {
 		return err
 	}
@@ -180,21 +166,7 @@ Vault, Schedule::Entry, Kanban::Card, Question, Question::Answer`,
 			}
 
 			// Resolve project — required for project-scoped webhook creation
-			projectID := *project
-			if projectID == "" {
-				projectID = app.Flags.Project
-			}
-			if projectID == "" {
-				projectID = app.Config.ProjectID
-			}
-			if projectID == "" {
-				if err := ensureProject(cmd, app); err != nil {
-					return err
-				}
-				projectID = app.Config.ProjectID
-			}
-
-			resolvedProjectID, _, err := app.Names.ResolveProject(cmd.Context(), projectID)
+			resolvedProjectID, err := resolveProjectID(cmd, app, *project)
 			if err != nil {
 				return err
 			}
@@ -360,6 +332,10 @@ func newWebhooksDeleteCmd() *cobra.Command {
 				return output.ErrUsage("Invalid webhook ID")
 			}
 
+			if !confirmAction(cmd, confirmPermanent, fmt.Sprintf("Delete webhook #%d?", webhookID), false) {
+				return nil
+			}
+
 			err = app.Account().Webhooks().Delete(cmd.Context(), webhookID)
 			if err != nil {
 				return convertSDKError(err)
@@ -0,0 +1,180 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// CardFilter is a named cards list filter saved under card_filters.<name>.
+// Every set field must match for a card to be kept.
+type CardFilter struct {
+	// Column matches the card's column by ID or name (case-insensitive).
+	Column string `json:"column,omitempty"`
+	// Assignee is a person ID, name, email, or "me".
+	Assignee string `json:"assignee,omitempty"`
+	// DueWithin keeps cards due within a window from today, overdue
+	// included: "7d", "2w", or a bare number of days.
+	DueWithin string `json:"due_within,omitempty"`
+	// Title is a case-insensitive regular expression for the card title.
+	Title string `json:"title,omitempty"`
+}
+
+// cardFilterKeys are the fields of a card_filters.<name> value, in the order
+// they are displayed.
+var cardFilterKeys = []string{"column", "assignee", "due_within", "title"}
+
+// ParseCardFilter parses a command-line card_filters.<name> value such as
+// "column=Doing,assignee=me,due_within=7d,title=^Bug". A comma only starts a
+// new field when followed by a known key, so title patterns may use commas.
+func ParseCardFilter(value string) (CardFilter, error) {
+	var f CardFilter
+	var parts []string
+	for _, seg := range strings.Split(value, ",") {
+		key, _, _ := strings.Cut(seg, "=")
+		if len(parts) > 0 && !isCardFilterKey(strings.TrimSpace(key)) {
+			parts[len(parts)-1] += "," + seg
+			continue
+		}
+		parts = append(parts, seg)
+	}
+	for _, part := range parts {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		key, val, ok := strings.Cut(part, "=")
+		key = strings.TrimSpace(key)
+		if !ok || !isCardFilterKey(key) {
+			return CardFilter{}, fmt.Errorf("card filter fields are key=value pairs with keys %s (got %q)", strings.Join(cardFilterKeys, ", "), part)
+		}
+		f.setField(key, strings.TrimSpace(val))
+	}
+	if f == (CardFilter{}) {
+		return CardFilter{}, errors.New("card filter needs at least one of: " + strings.Join(cardFilterKeys, ", "))
+	}
+	return f, f.Validate()
+}
+
+// Validate checks the due window and title pattern.
+func (f CardFilter) Validate() error {
+	if f.DueWithin != "" {
+		if _, err := ParseDueWindow(f.DueWithin); err != nil {
+			return err
+		}
+	}
+	if f.Title != "" {
+		if _, err := regexp.Compile("(?i)" + f.Title); err != nil {
+			return fmt.Errorf("invalid title pattern %q: %w", f.Title, err)
+		}
+	}
+	return nil
+}
+
+// String renders the filter in the form ParseCardFilter accepts.
+func (f CardFilter) String() string {
+	var parts []string
+	for _, key := range cardFilterKeys {
+		if v := f.field(key); v != "" {
+			parts = append(parts, key+"="+v)
+		}
+	}
+	return strings.Join(parts, ",")
+}
+
+// ParseDueWindow parses a due_within window into days: "7d", "2w", or "10".
+func ParseDueWindow(window string) (int, error) {
+	s := strings.ToLower(strings.TrimSpace(window))
+	mult := 1
+	switch {
+	case strings.HasSuffix(s, "w"):
+		mult, s = 7, strings.TrimSuffix(s, "w")
+	case strings.HasSuffix(s, "d"):
+		s = strings.TrimSuffix(s, "d")
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("due_within must be a number of days or weeks, like 7d or 2w (got %q)", window)
+	}
+	return n * mult, nil
+}
+
+// CardFilterNames returns the names of the saved card filters, sorted.
+func (c *Config) CardFilterNames() []string {
+	names := make([]string, 0, len(c.CardFilters))
+	for name := range c.CardFilters {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// cardFilterFromFile decodes one card_filters.<name> value from a config
+// file: either an object of fields or the command-line string form.
+func cardFilterFromFile(raw any) (CardFilter, error) {
+	switch v := raw.(type) {
+	case string:
+		return ParseCardFilter(v)
+	case map[string]any:
+		var f CardFilter
+		for key, val := range v {
+			s, ok := val.(string)
+			if !isCardFilterKey(key) || !ok {
+				return CardFilter{}, fmt.Errorf("unknown or non-string field %q", key)
+			}
+			f.setField(key, strings.TrimSpace(s))
+		}
+		return f, f.Validate()
+	default:
+		return CardFilter{}, errors.New("must be an object or a key=value string")
+	}
+}
+
+func isCardFilterKey(key string) bool {
+	for _, k := range cardFilterKeys {
+		if k == key {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *CardFilter) setField(key, val string) {
+	switch key {
+	case "column":
+		f.Column = val
+	case "assignee":
+		f.Assignee = val
+	case "due_within":
+		f.DueWithin = val
+	case "title":
+		f.Title = val
+	}
+}
+
+func (f CardFilter) field(key string) string {
+	switch key {
+	case "column":
+		return f.Column
+	case "assignee":
+		return f.Assignee
+	case "due_within":
+		return f.DueWithin
+	case "title":
+		return f.Title
+	}
+	return ""
+}
+
+// ToMap returns the filter as stored in a config file.
+func (f CardFilter) ToMap() map[string]any {
+	m := make(map[string]any)
+	for _, key := range cardFilterKeys {
+		if v := f.field(key); v != "" {
+			m[key] = v
+		}
+	}
+	return m
+}
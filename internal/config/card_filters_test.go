@@ -0,0 +1,50 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCardFilter(t *testing.T) {
+	f, err := ParseCardFilter("column=Doing, assignee=me,due_within=2w,title=^(bug|fix){1,2}")
+	require.NoError(t, err)
+	assert.Equal(t, CardFilter{Column: "Doing", Assignee: "me", DueWithin: "2w", Title: "^(bug|fix){1,2}"}, f,
+		"a comma inside the title pattern stays part of it")
+	assert.Equal(t, "column=Doing,assignee=me,due_within=2w,title=^(bug|fix){1,2}", f.String())
+
+	for _, bad := range []string{"", "owner=me", "due_within=soon", "title=("} {
+		_, err := ParseCardFilter(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestParseDueWindow(t *testing.T) {
+	for in, want := range map[string]int{"7d": 7, "2w": 14, "10": 10, "0d": 0} {
+		got, err := ParseDueWindow(in)
+		require.NoError(t, err, in)
+		assert.Equal(t, want, got, in)
+	}
+	_, err := ParseDueWindow("-1d")
+	assert.Error(t, err)
+}
+
+func TestLoadFromFile_CardFilters(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"card_filters": {
+		"mine": {"assignee": "me", "due_within": "7d"},
+		"bugs": "title=^bug",
+		"broken": {"title": "("}
+	}}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+
+	assert.Equal(t, []string{"bugs", "mine"}, cfg.CardFilterNames(), "invalid filters are skipped")
+	assert.Equal(t, CardFilter{Assignee: "me", DueWithin: "7d"}, cfg.CardFilters["mine"])
+	assert.Equal(t, "global", cfg.Sources["card_filters.bugs"])
+}
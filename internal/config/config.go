@@ -7,6 +7,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 )
 
@@ -33,12 +34,62 @@ type Config struct {
 	// Output settings
 	Format string `json:"format"`
 
+	// HTTP tunes retries, backoff, timeouts, and concurrency for API requests
+	// (via the "http" section, e.g. "config set http.timeout 60s").
+	HTTP HTTPConfig `json:"http,omitzero"`
+
+	// Refresh overrides the TUI's polling interval per view (via the
+	// "refresh" section, e.g. "config set refresh.campfire 15s").
+	Refresh RefreshConfig `json:"refresh,omitempty"`
+
+	// TUIMute silences TUI toasts and unread badges for whole projects
+	// ("123") or single dock tools ("123/chat").
+	TUIMute []string `json:"tui_mute,omitempty"`
+
+	// TUITheme picks the TUI palette (see TUIThemes); empty means "auto".
+	TUITheme string `json:"tui_theme,omitempty"`
+
+	// Timezone is the IANA zone dates are read and shown in (see
+	// ApplyTimezone); empty means the terminal's local zone.
+	Timezone string `json:"timezone,omitempty"`
+
+	// Confirm is when commands ask before changing things (see
+	// ConfirmPolicies); empty means "destructive".
+	Confirm string `json:"confirm,omitempty"`
+
+	// Redact lists what --redact masks in output (see RedactKinds);
+	// empty means DefaultRedact.
+	Redact []string `json:"redact,omitempty"`
+
 	// Behavior preferences (persisted via config set, overridable by flags)
 	Hints     *bool `json:"hints,omitempty"`
 	Stats     *bool `json:"stats,omitempty"`
 	Verbose   *int  `json:"verbose,omitempty"`
 	Onboarded *bool `json:"onboarded,omitempty"`
 
+	// Interactive numbers hints on a terminal and offers to run one
+	// (default for --interactive).
+	Interactive *bool `json:"interactive,omitempty"`
+
+	// NoBreadcrumbs and NoContext drop those sections from the output
+	// envelope (defaults for --no-breadcrumbs and --no-context).
+	NoBreadcrumbs *bool `json:"no_breadcrumbs,omitempty"`
+	NoContext     *bool `json:"no_context,omitempty"`
+
+	// MinimalOutput drops the summary line and hints from styled and
+	// Markdown output; Emoji marks success, notices, and errors with
+	// ✅/⚠️/❌ there. JSON output is unaffected by either.
+	MinimalOutput *bool `json:"minimal_output,omitempty"`
+	Emoji         *bool `json:"emoji,omitempty"`
+
+	// UsageStats opts in to local per-command usage statistics
+	// (see "basecamp stats"). Recorded on this machine only, never uploaded.
+	UsageStats *bool `json:"usage_stats,omitempty"`
+
+	// AuditLog opts in to an append-only log of every mutating API call
+	// (see the audit package).
+	AuditLog *bool `json:"audit_log,omitempty"`
+
 	// LLM settings (for TUI smart zoom summarization)
 	LLMProvider      string `json:"llm_provider,omitempty"`
 	LLMModel         string `json:"llm_model,omitempty"`
@@ -50,8 +101,40 @@ type Config struct {
 	// Experimental feature flags (opt-in via "config set experimental.X true --global").
 	Experimental map[string]bool `json:"experimental,omitempty"`
 
+	// Columns holds per-entity list column preferences
+	// (via "config set columns.todo title,due_on,assignees").
+	Columns map[string][]string `json:"columns,omitempty"`
+
+	// CardFilters holds saved cards list filters by name
+	// (via "config set card_filters.mine-due-soon assignee=me,due_within=7d").
+	CardFilters map[string]CardFilter `json:"card_filters,omitempty"`
+
+	// PaletteActions holds custom TUI command palette actions by name,
+	// edited in the config file under "palette_actions".
+	PaletteActions map[string]PaletteAction `json:"palette_actions,omitempty"`
+
 	// Sources tracks where each value came from (for debugging).
 	Sources map[string]string `json:"-"`
+
+	// Origins records the config file behind each file-sourced value
+	// (see Origin).
+	Origins map[string]string `json:"-"`
+
+	// ContextTrail records every layer that set a context key, including
+	// the ones that lost (for --explain-context).
+	ContextTrail []ContextSetting `json:"-"`
+}
+
+// ParseColumns splits a comma-separated column list ("title, due_on"),
+// dropping blanks.
+func ParseColumns(s string) []string {
+	var columns []string
+	for _, c := range strings.Split(s, ",") {
+		if c = strings.TrimSpace(c); c != "" {
+			columns = append(columns, c)
+		}
+	}
+	return columns
 }
 
 // IsExperimental returns true if the named experimental feature is enabled.
@@ -94,6 +177,7 @@ type FlagOverrides struct {
 	Profile  string
 	CacheDir string
 	Format   string
+	Timezone string
 }
 
 // Default returns the default configuration.
@@ -129,19 +213,8 @@ func Load(overrides FlagOverrides) (*Config, error) {
 	trust := LoadTrustStore(GlobalConfigDir())
 
 	// Load from file layers (system -> global -> repo -> local)
-	loadFromFile(cfg, systemConfigPath(), SourceSystem, trust)
-	loadFromFile(cfg, globalConfigPath(), SourceGlobal, trust)
-
-	repoPath := RepoConfigPath()
-	if repoPath != "" {
-		loadFromFile(cfg, repoPath, SourceRepo, trust)
-	}
-
-	// Load all local configs from root to current (closer overrides)
-	// This allows nested directories to override parent directories
-	localPaths := localConfigPaths(repoPath)
-	for _, path := range localPaths {
-		loadFromFile(cfg, path, SourceLocal, trust)
+	for _, f := range Files() {
+		loadFromFile(cfg, f.Path, f.Source, trust)
 	}
 
 	// Load from environment
@@ -155,7 +228,17 @@ func Load(overrides FlagOverrides) (*Config, error) {
 	return cfg, nil
 }
 
+// loadFromFile loads one config file layer, warning on stderr about values
+// it ignores.
 func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
+	loadFile(cfg, path, source, trust, func(format string, args ...any) {
+		fmt.Fprintf(os.Stderr, "warning: "+format, args...)
+	})
+}
+
+// loadFile loads one config file layer into cfg, reporting each value it
+// ignores to warn.
+func loadFile(cfg *Config, path string, source Source, trust *TrustStore, warn func(format string, args ...any)) {
 	data, err := os.ReadFile(path) //nolint:gosec // G304: Path is from trusted config locations
 	if err != nil {
 		return // File doesn't exist, skip
@@ -163,7 +246,7 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 
 	var fileCfg map[string]any
 	if err := json.Unmarshal(data, &fileCfg); err != nil {
-		fmt.Fprintf(os.Stderr, "warning: skipping malformed config at %s: %v\n", path, err)
+		warn("skipping malformed config at %s: %v\n", path, err)
 		return
 	}
 
@@ -176,27 +259,31 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 
 	if v, ok := fileCfg["base_url"].(string); ok && v != "" {
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring base_url %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
+			warn("ignoring base_url %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
 		} else {
 			cfg.BaseURL = v
-			cfg.Sources["base_url"] = string(source)
+			cfg.setFileSource("base_url", source, path)
+			cfg.TraceContext("base_url", v, string(source), path)
 		}
 	}
 	if v := getStringOrNumber(fileCfg, "account_id"); v != "" {
 		cfg.AccountID = v
-		cfg.Sources["account_id"] = string(source)
+		cfg.setFileSource("account_id", source, path)
+		cfg.TraceContext("account_id", v, string(source), path)
 	}
 	if v := getStringOrNumber(fileCfg, "project_id"); v != "" {
 		cfg.ProjectID = v
-		cfg.Sources["project_id"] = string(source)
+		cfg.setFileSource("project_id", source, path)
+		cfg.TraceContext("project_id", v, string(source), path)
 	}
 	if v := getStringOrNumber(fileCfg, "todolist_id"); v != "" {
 		cfg.TodolistID = v
-		cfg.Sources["todolist_id"] = string(source)
+		cfg.setFileSource("todolist_id", source, path)
+		cfg.TraceContext("todolist_id", v, string(source), path)
 	}
 	if v, ok := fileCfg["scope"].(string); ok && v != "" {
 		cfg.Scope = v
-		cfg.Sources["scope"] = string(source)
+		cfg.setFileSource("scope", source, path)
 	}
 	if v, ok := fileCfg["cache_dir"].(string); ok && v != "" {
 		// cache_dir redirects every cache write (completion, resilience, TUI
@@ -204,75 +291,193 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 		// point it at any user-writable path, so gate it like other authority
 		// keys. filepath.Clean normalizes the accepted value.
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring cache_dir %q from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
+			warn("ignoring cache_dir %q from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
 		} else {
 			cfg.CacheDir = filepath.Clean(v)
-			cfg.Sources["cache_dir"] = string(source)
+			cfg.setFileSource("cache_dir", source, path)
 		}
 	}
 	if v, ok := fileCfg["cache_enabled"].(bool); ok {
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring cache_enabled from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
+			warn("ignoring cache_enabled from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
 		} else {
 			cfg.CacheEnabled = v
-			cfg.Sources["cache_enabled"] = string(source)
+			cfg.setFileSource("cache_enabled", source, path)
 		}
 	}
 	if v, ok := fileCfg["format"].(string); ok && v != "" {
 		cfg.Format = v
-		cfg.Sources["format"] = string(source)
+		cfg.setFileSource("format", source, path)
+	}
+	if v, ok := fileCfg["http"].(map[string]any); ok {
+		for name, val := range v {
+			if err := cfg.HTTP.set(name, val); err != nil {
+				warn("ignoring http.%s from %s config at %s: %v\n", name, source, path, err)
+				continue
+			}
+			cfg.setFileSource("http."+name, source, path)
+		}
+	}
+	if v, ok := fileCfg["refresh"].(map[string]any); ok {
+		for view, val := range v {
+			if err := cfg.Refresh.set(view, val); err != nil {
+				warn("ignoring refresh.%s from %s config at %s: %v\n", view, source, path, err)
+				continue
+			}
+			cfg.setFileSource("refresh."+view, source, path)
+		}
+	}
+	if v, ok := fileCfg["tui_mute"]; ok {
+		var raw []string
+		switch mv := v.(type) {
+		case string:
+			raw = strings.Split(mv, ",")
+		case []any:
+			for _, e := range mv {
+				if entry, ok := e.(string); ok {
+					raw = append(raw, entry)
+				}
+			}
+		}
+		var entries []string
+		for _, entry := range raw {
+			if strings.TrimSpace(entry) == "" {
+				continue
+			}
+			parsed, err := parseMuteEntry(entry)
+			if err != nil {
+				warn("ignoring %s from %s config at %s\n", err, source, path)
+				continue
+			}
+			entries = append(entries, parsed)
+		}
+		cfg.TUIMute, _ = normalizeMuteList(entries)
+		cfg.setFileSource("tui_mute", source, path)
+	}
+	if v, ok := fileCfg["redact"]; ok {
+		var raw []string
+		switch rv := v.(type) {
+		case string:
+			raw = strings.Split(rv, ",")
+		case []any:
+			for _, e := range rv {
+				if rule, ok := e.(string); ok {
+					raw = append(raw, rule)
+				}
+			}
+		}
+		if rules, err := normalizeRedactList(raw); err != nil {
+			warn("ignoring redact from %s config at %s: %v\n", source, path, err)
+		} else {
+			cfg.Redact = rules
+			cfg.setFileSource("redact", source, path)
+		}
+	}
+	if v, ok := fileCfg["tui_theme"].(string); ok && v != "" {
+		if IsTUITheme(v) {
+			cfg.TUITheme = v
+			cfg.setFileSource("tui_theme", source, path)
+		} else {
+			warn("ignoring tui_theme %q from %s config at %s\n", v, source, path)
+		}
+	}
+	if v, ok := fileCfg["timezone"].(string); ok && v != "" {
+		if _, err := LoadTimezone(v); err == nil {
+			cfg.Timezone = v
+			cfg.setFileSource("timezone", source, path)
+		} else {
+			warn("ignoring timezone %q from %s config at %s\n", v, source, path)
+		}
+	}
+	if v, ok := fileCfg["confirm"].(string); ok && v != "" {
+		if IsConfirmPolicy(v) {
+			cfg.Confirm = v
+			cfg.setFileSource("confirm", source, path)
+		} else {
+			warn("ignoring confirm %q from %s config at %s\n", v, source, path)
+		}
 	}
 	if v, ok := fileCfg["hints"].(bool); ok {
 		cfg.Hints = &v
-		cfg.Sources["hints"] = string(source)
+		cfg.setFileSource("hints", source, path)
+	}
+	if v, ok := fileCfg["interactive"].(bool); ok {
+		cfg.Interactive = &v
+		cfg.setFileSource("interactive", source, path)
+	}
+	if v, ok := fileCfg["no_breadcrumbs"].(bool); ok {
+		cfg.NoBreadcrumbs = &v
+		cfg.setFileSource("no_breadcrumbs", source, path)
+	}
+	if v, ok := fileCfg["no_context"].(bool); ok {
+		cfg.NoContext = &v
+		cfg.setFileSource("no_context", source, path)
+	}
+	if v, ok := fileCfg["minimal_output"].(bool); ok {
+		cfg.MinimalOutput = &v
+		cfg.setFileSource("minimal_output", source, path)
+	}
+	if v, ok := fileCfg["emoji"].(bool); ok {
+		cfg.Emoji = &v
+		cfg.setFileSource("emoji", source, path)
 	}
 	if v, ok := fileCfg["stats"].(bool); ok {
 		cfg.Stats = &v
-		cfg.Sources["stats"] = string(source)
+		cfg.setFileSource("stats", source, path)
+	}
+	if v, ok := fileCfg["usage_stats"].(bool); ok {
+		cfg.UsageStats = &v
+		cfg.setFileSource("usage_stats", source, path)
+	}
+	if v, ok := fileCfg["audit_log"].(bool); ok {
+		cfg.AuditLog = &v
+		cfg.setFileSource("audit_log", source, path)
 	}
 	if v, ok := fileCfg["onboarded"].(bool); ok {
 		cfg.Onboarded = &v
-		cfg.Sources["onboarded"] = string(source)
+		cfg.setFileSource("onboarded", source, path)
 	}
 	if v, ok := fileCfg["verbose"]; ok {
 		if fv, ok := v.(float64); ok {
 			iv := int(fv)
 			if iv >= 0 && iv <= 2 && fv == float64(iv) {
 				cfg.Verbose = &iv
-				cfg.Sources["verbose"] = string(source)
+				cfg.setFileSource("verbose", source, path)
+			} else {
+				warn("ignoring verbose from %s config at %s: must be 0, 1, or 2\n", source, path)
 			}
 		}
 	}
 	if v, ok := fileCfg["llm_provider"].(string); ok && v != "" {
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring llm_provider %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
+			warn("ignoring llm_provider %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
 		} else {
 			cfg.LLMProvider = v
-			cfg.Sources["llm_provider"] = string(source)
+			cfg.setFileSource("llm_provider", source, path)
 		}
 	}
 	if v, ok := fileCfg["llm_model"].(string); ok && v != "" {
 		// Gate like other LLM authority keys: an untrusted config could
 		// silently substitute a costlier paid model.
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring llm_model %q from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
+			warn("ignoring llm_model %q from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
 		} else {
 			cfg.LLMModel = v
-			cfg.Sources["llm_model"] = string(source)
+			cfg.setFileSource("llm_model", source, path)
 		}
 	}
 	if v, ok := fileCfg["llm_api_key"].(string); ok && v != "" {
 		// Secret: only from global/system config, never local/repo
 		if source != SourceLocal && source != SourceRepo {
 			cfg.LLMAPIKey = v
-			cfg.Sources["llm_api_key"] = string(source)
+			cfg.setFileSource("llm_api_key", source, path)
 		} else {
-			fmt.Fprintf(os.Stderr, "warning: ignoring llm_api_key from %s config at %s (use --global or BASECAMP_LLM_API_KEY env var)\n", source, path)
+			warn("ignoring llm_api_key from %s config at %s (use --global or BASECAMP_LLM_API_KEY env var)\n", source, path)
 		}
 	}
 	if v, ok := fileCfg["llm_endpoint"].(string); ok && v != "" {
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring llm_endpoint %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
+			warn("ignoring llm_endpoint %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
 		} else {
 			// Keep the value even if malformed (non-http(s)/hostless):
 			// summarize.ValidateEndpoint rejects it at the point of
@@ -282,7 +487,7 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 			// Other commands never consume it, so `config unset llm_endpoint`
 			// can always repair.
 			cfg.LLMEndpoint = v
-			cfg.Sources["llm_endpoint"] = string(source)
+			cfg.setFileSource("llm_endpoint", source, path)
 		}
 	}
 	if v, ok := fileCfg["llm_max_concurrent"]; ok {
@@ -291,10 +496,12 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 			// Gate like other LLM authority keys: block a malicious repo from
 			// inflating paid-LLM concurrency (cost amplification).
 			if untrusted {
-				fmt.Fprintf(os.Stderr, "warning: ignoring llm_max_concurrent from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
+				warn("ignoring llm_max_concurrent from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
 			} else if iv >= 1 && iv <= 10 && fv == float64(iv) {
 				cfg.LLMMaxConcurrent = iv
-				cfg.Sources["llm_max_concurrent"] = string(source)
+				cfg.setFileSource("llm_max_concurrent", source, path)
+			} else {
+				warn("ignoring llm_max_concurrent from %s config at %s: must be a whole number from 1 to 10\n", source, path)
 			}
 		}
 	}
@@ -303,10 +510,12 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 			iv := int(fv)
 			// Gate like other LLM authority keys (cost amplification).
 			if untrusted {
-				fmt.Fprintf(os.Stderr, "warning: ignoring llm_token_budget from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
+				warn("ignoring llm_token_budget from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
 			} else if iv >= 100 && iv <= 100000 && fv == float64(iv) {
 				cfg.LLMTokenBudget = iv
-				cfg.Sources["llm_token_budget"] = string(source)
+				cfg.setFileSource("llm_token_budget", source, path)
+			} else {
+				warn("ignoring llm_token_budget from %s config at %s: must be a whole number from 100 to 100000\n", source, path)
 			}
 		}
 	}
@@ -317,21 +526,77 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 		for feature, val := range v {
 			if enabled, ok := val.(bool); ok {
 				cfg.Experimental[feature] = enabled
-				cfg.Sources["experimental."+feature] = string(source)
+				cfg.setFileSource("experimental."+feature, source, path)
+			}
+		}
+	}
+	if v, ok := fileCfg["columns"].(map[string]any); ok {
+		if cfg.Columns == nil {
+			cfg.Columns = make(map[string][]string)
+		}
+		for entity, val := range v {
+			var columns []string
+			switch cv := val.(type) {
+			case string:
+				columns = ParseColumns(cv)
+			case []any:
+				for _, c := range cv {
+					if name, ok := c.(string); ok && strings.TrimSpace(name) != "" {
+						columns = append(columns, strings.TrimSpace(name))
+					}
+				}
+			}
+			if len(columns) > 0 {
+				cfg.Columns[entity] = columns
+				cfg.setFileSource("columns."+entity, source, path)
+			}
+		}
+	}
+	if v, ok := fileCfg["card_filters"].(map[string]any); ok {
+		for name, raw := range v {
+			f, err := cardFilterFromFile(raw)
+			if err != nil {
+				warn("ignoring card_filters.%s from %s config at %s: %v\n", name, source, path, err)
+				continue
+			}
+			if cfg.CardFilters == nil {
+				cfg.CardFilters = make(map[string]CardFilter)
+			}
+			cfg.CardFilters[name] = f
+			cfg.setFileSource("card_filters."+name, source, path)
+		}
+	}
+	if v, ok := fileCfg["palette_actions"].(map[string]any); ok {
+		// Palette actions run commands, so a cloned repo's config must not
+		// be able to plant them.
+		if untrusted {
+			warn("ignoring palette_actions from %s config at %s\n  (trust-gated key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
+		} else {
+			for name, raw := range v {
+				a, err := paletteActionFromFile(name, raw)
+				if err != nil {
+					warn("ignoring palette_actions.%s from %s config at %s: %v\n", name, source, path, err)
+					continue
+				}
+				if cfg.PaletteActions == nil {
+					cfg.PaletteActions = make(map[string]PaletteAction)
+				}
+				cfg.PaletteActions[name] = a
+				cfg.setFileSource("palette_actions."+name, source, path)
 			}
 		}
 	}
 	if v, ok := fileCfg["default_profile"].(string); ok && v != "" {
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring default_profile %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
+			warn("ignoring default_profile %q from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", v, source, path, ShellQuote(path))
 		} else {
 			cfg.DefaultProfile = v
-			cfg.Sources["default_profile"] = string(source)
+			cfg.setFileSource("default_profile", source, path)
 		}
 	}
 	if v, ok := fileCfg["profiles"].(map[string]any); ok {
 		if untrusted {
-			fmt.Fprintf(os.Stderr, "warning: ignoring profiles from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
+			warn("ignoring profiles from %s config at %s\n  (authority key from local/repo config; run `basecamp config trust %s` to allow)\n", source, path, ShellQuote(path))
 		} else {
 			if cfg.Profiles == nil {
 				cfg.Profiles = make(map[string]*ProfileConfig)
@@ -363,7 +628,7 @@ func loadFromFile(cfg *Config, path string, source Source, trust *TrustStore) {
 					cfg.Profiles[name] = profileCfg
 				}
 			}
-			cfg.Sources["profiles"] = string(source)
+			cfg.setFileSource("profiles", source, path)
 		}
 	}
 }
@@ -387,18 +652,22 @@ func LoadFromEnv(cfg *Config) error {
 	if v := os.Getenv("BASECAMP_BASE_URL"); v != "" {
 		cfg.BaseURL = v
 		cfg.Sources["base_url"] = string(SourceEnv)
+		cfg.TraceContext("base_url", v, string(SourceEnv), "BASECAMP_BASE_URL")
 	}
 	if v := os.Getenv("BASECAMP_ACCOUNT_ID"); v != "" {
 		cfg.AccountID = v
 		cfg.Sources["account_id"] = string(SourceEnv)
+		cfg.TraceContext("account_id", v, string(SourceEnv), "BASECAMP_ACCOUNT_ID")
 	}
 	if v := os.Getenv("BASECAMP_PROJECT_ID"); v != "" {
 		cfg.ProjectID = v
 		cfg.Sources["project_id"] = string(SourceEnv)
+		cfg.TraceContext("project_id", v, string(SourceEnv), "BASECAMP_PROJECT_ID")
 	}
 	if v := os.Getenv("BASECAMP_TODOLIST_ID"); v != "" {
 		cfg.TodolistID = v
 		cfg.Sources["todolist_id"] = string(SourceEnv)
+		cfg.TraceContext("todolist_id", v, string(SourceEnv), "BASECAMP_TODOLIST_ID")
 	}
 	if v := os.Getenv("BASECAMP_CACHE_DIR"); v != "" {
 		cfg.CacheDir = v
@@ -408,6 +677,11 @@ func LoadFromEnv(cfg *Config) error {
 		cfg.CacheEnabled = strings.ToLower(v) == "true" || v == "1"
 		cfg.Sources["cache_enabled"] = string(SourceEnv)
 	}
+	if v := os.Getenv("BASECAMP_MAX_RETRIES"); v != "" {
+		if iv, err := strconv.Atoi(v); err == nil && cfg.HTTP.set("max_retries", float64(iv)) == nil {
+			cfg.Sources["http.max_retries"] = string(SourceEnv)
+		}
+	}
 	if v := os.Getenv("BASECAMP_HINTS"); v != "" {
 		if b, ok := parseEnvBool(v); ok {
 			cfg.Hints = &b
@@ -420,6 +694,18 @@ func LoadFromEnv(cfg *Config) error {
 			cfg.Sources["stats"] = string(SourceEnv)
 		}
 	}
+	if v := os.Getenv("BASECAMP_USAGE_STATS"); v != "" {
+		if b, ok := parseEnvBool(v); ok {
+			cfg.UsageStats = &b
+			cfg.Sources["usage_stats"] = string(SourceEnv)
+		}
+	}
+	if v := os.Getenv("BASECAMP_AUDIT_LOG"); v != "" {
+		if b, ok := parseEnvBool(v); ok {
+			cfg.AuditLog = &b
+			cfg.Sources["audit_log"] = string(SourceEnv)
+		}
+	}
 	if v := os.Getenv("BASECAMP_LLM_PROVIDER"); v != "" {
 		cfg.LLMProvider = v
 		cfg.Sources["llm_provider"] = string(SourceEnv)
@@ -443,6 +729,10 @@ func LoadFromEnv(cfg *Config) error {
 		cfg.LLMEndpoint = v
 		cfg.Sources["llm_endpoint"] = string(SourceEnv)
 	}
+	if v := os.Getenv("BASECAMP_TIMEZONE"); v != "" {
+		cfg.Timezone = v
+		cfg.Sources["timezone"] = string(SourceEnv)
+	}
 	return nil
 }
 
@@ -505,14 +795,17 @@ func ApplyOverrides(cfg *Config, o FlagOverrides) {
 	if o.Account != "" {
 		cfg.AccountID = o.Account
 		cfg.Sources["account_id"] = string(SourceFlag)
+		cfg.TraceContext("account_id", o.Account, string(SourceFlag), "--account")
 	}
 	if o.Project != "" {
 		cfg.ProjectID = o.Project
 		cfg.Sources["project_id"] = string(SourceFlag)
+		cfg.TraceContext("project_id", o.Project, string(SourceFlag), "--project")
 	}
 	if o.Todolist != "" {
 		cfg.TodolistID = o.Todolist
 		cfg.Sources["todolist_id"] = string(SourceFlag)
+		cfg.TraceContext("todolist_id", o.Todolist, string(SourceFlag), "--todolist")
 	}
 	if o.CacheDir != "" {
 		cfg.CacheDir = o.CacheDir
@@ -522,6 +815,10 @@ func ApplyOverrides(cfg *Config, o FlagOverrides) {
 		cfg.Format = o.Format
 		cfg.Sources["format"] = string(SourceFlag)
 	}
+	if o.Timezone != "" {
+		cfg.Timezone = o.Timezone
+		cfg.Sources["timezone"] = string(SourceFlag)
+	}
 }
 
 // ApplyProfile overlays profile values onto the config.
@@ -550,18 +847,22 @@ func (c *Config) ApplyProfile(name string) error {
 	if p.BaseURL != "" {
 		c.BaseURL = p.BaseURL
 		c.Sources["base_url"] = "profile"
+		c.TraceContext("base_url", p.BaseURL, "profile", name)
 	}
 	if p.AccountID != "" {
 		c.AccountID = p.AccountID
 		c.Sources["account_id"] = "profile"
+		c.TraceContext("account_id", p.AccountID, "profile", name)
 	}
 	if p.ProjectID != "" {
 		c.ProjectID = p.ProjectID
 		c.Sources["project_id"] = "profile"
+		c.TraceContext("project_id", p.ProjectID, "profile", name)
 	}
 	if p.TodolistID != "" {
 		c.TodolistID = p.TodolistID
 		c.Sources["todolist_id"] = "profile"
+		c.TraceContext("todolist_id", p.TodolistID, "profile", name)
 	}
 	if p.Scope != "" {
 		c.Scope = p.Scope
@@ -958,6 +958,30 @@ func TestLoadFromEnv_LLMEndpointEmpty(t *testing.T) {
 	assert.Empty(t, cfg.Sources["llm_endpoint"])
 }
 
+func TestLoadFromEnv_MaxRetries(t *testing.T) {
+	t.Setenv("BASECAMP_MAX_RETRIES", "5")
+
+	cfg := Default()
+	require.NoError(t, LoadFromEnv(cfg))
+
+	assert.Equal(t, 5, cfg.HTTP.MaxRetries)
+	assert.Equal(t, "env", cfg.Sources["http.max_retries"])
+}
+
+func TestLoadFromEnv_MaxRetriesOutOfRangeIgnored(t *testing.T) {
+	for _, bad := range []string{"0", "11", "three"} {
+		t.Run(bad, func(t *testing.T) {
+			t.Setenv("BASECAMP_MAX_RETRIES", bad)
+
+			cfg := Default()
+			require.NoError(t, LoadFromEnv(cfg))
+
+			assert.Zero(t, cfg.HTTP.MaxRetries)
+			assert.Empty(t, cfg.Sources["http.max_retries"])
+		})
+	}
+}
+
 // TestIsHTTPURL covers the scheme+host validation reused at llm_endpoint's
 // call sites: config-set acceptance (internal/commands/config.go) and
 // consumption-time validation (summarize.ValidateEndpoint).
@@ -1032,9 +1056,15 @@ func TestLoadPreferencesFromFile(t *testing.T) {
 	configPath := filepath.Join(tmpDir, "config.json")
 
 	testConfig := map[string]any{
-		"hints":   true,
-		"stats":   false,
-		"verbose": 2,
+		"hints":          true,
+		"stats":          false,
+		"usage_stats":    true,
+		"audit_log":      true,
+		"verbose":        2,
+		"no_breadcrumbs": true,
+		"no_context":     false,
+		"minimal_output": true,
+		"emoji":          true,
 	}
 	data, err := json.Marshal(testConfig)
 	require.NoError(t, err)
@@ -1051,9 +1081,32 @@ func TestLoadPreferencesFromFile(t *testing.T) {
 	assert.False(t, *cfg.Stats)
 	assert.Equal(t, "global", cfg.Sources["stats"])
 
+	require.NotNil(t, cfg.UsageStats)
+	assert.True(t, *cfg.UsageStats)
+	assert.Equal(t, "global", cfg.Sources["usage_stats"])
+
+	require.NotNil(t, cfg.AuditLog)
+	assert.True(t, *cfg.AuditLog)
+	assert.Equal(t, "global", cfg.Sources["audit_log"])
+
 	require.NotNil(t, cfg.Verbose)
 	assert.Equal(t, 2, *cfg.Verbose)
 	assert.Equal(t, "global", cfg.Sources["verbose"])
+
+	require.NotNil(t, cfg.NoBreadcrumbs)
+	assert.True(t, *cfg.NoBreadcrumbs)
+	assert.Equal(t, "global", cfg.Sources["no_breadcrumbs"])
+
+	require.NotNil(t, cfg.NoContext)
+	assert.False(t, *cfg.NoContext)
+
+	require.NotNil(t, cfg.MinimalOutput)
+	assert.True(t, *cfg.MinimalOutput)
+	assert.Equal(t, "global", cfg.Sources["minimal_output"])
+
+	require.NotNil(t, cfg.Emoji)
+	assert.True(t, *cfg.Emoji)
+	assert.Equal(t, "global", cfg.Sources["emoji"])
 }
 
 func TestPreferenceLayering(t *testing.T) {
@@ -1130,8 +1183,52 @@ func TestLoadExperimentalFlagsWithProvenance(t *testing.T) {
 	assert.False(t, cfg.IsExperimental("nonexistent"))
 }
 
+func TestLoadColumnPreferencesWithProvenance(t *testing.T) {
+	tmpDir := t.TempDir()
+	globalPath := filepath.Join(tmpDir, "global.json")
+	localPath := filepath.Join(tmpDir, "local.json")
+
+	globalConfig := map[string]any{
+		"columns": map[string]any{
+			"todo":    []any{"content", "due_on"},
+			"project": "id, name",
+		},
+	}
+	data, _ := json.Marshal(globalConfig)
+	os.WriteFile(globalPath, data, 0644)
+
+	// Local overrides todo; an empty list is ignored
+	localConfig := map[string]any{
+		"columns": map[string]any{
+			"todo":    "title,assignees",
+			"message": "",
+		},
+	}
+	data, _ = json.Marshal(localConfig)
+	os.WriteFile(localPath, data, 0644)
+
+	cfg := Default()
+	loadFromFile(cfg, globalPath, SourceGlobal, nil)
+	loadFromFile(cfg, localPath, SourceLocal, nil)
+
+	assert.Equal(t, []string{"title", "assignees"}, cfg.Columns["todo"])
+	assert.Equal(t, "local", cfg.Sources["columns.todo"])
+
+	assert.Equal(t, []string{"id", "name"}, cfg.Columns["project"])
+	assert.Equal(t, "global", cfg.Sources["columns.project"])
+
+	_, ok := cfg.Columns["message"]
+	assert.False(t, ok)
+}
+
+func TestParseColumns(t *testing.T) {
+	assert.Equal(t, []string{"title", "due_on"}, ParseColumns(" title , due_on ,"))
+	assert.Nil(t, ParseColumns(""))
+	assert.Nil(t, ParseColumns(" , "))
+}
+
 func TestPreferencesFromEnv(t *testing.T) {
-	envVars := []string{"BASECAMP_HINTS", "BASECAMP_STATS"}
+	envVars := []string{"BASECAMP_HINTS", "BASECAMP_STATS", "BASECAMP_USAGE_STATS", "BASECAMP_AUDIT_LOG"}
 	originals := make(map[string]string)
 	for _, k := range envVars {
 		originals[k] = os.Getenv(k)
@@ -1148,6 +1245,8 @@ func TestPreferencesFromEnv(t *testing.T) {
 
 	os.Setenv("BASECAMP_HINTS", "true")
 	os.Setenv("BASECAMP_STATS", "0")
+	os.Setenv("BASECAMP_USAGE_STATS", "1")
+	os.Setenv("BASECAMP_AUDIT_LOG", "true")
 
 	cfg := Default()
 	require.NoError(t, LoadFromEnv(cfg))
@@ -1159,6 +1258,14 @@ func TestPreferencesFromEnv(t *testing.T) {
 	require.NotNil(t, cfg.Stats)
 	assert.False(t, *cfg.Stats)
 	assert.Equal(t, "env", cfg.Sources["stats"])
+
+	require.NotNil(t, cfg.UsageStats)
+	assert.True(t, *cfg.UsageStats)
+	assert.Equal(t, "env", cfg.Sources["usage_stats"])
+
+	require.NotNil(t, cfg.AuditLog)
+	assert.True(t, *cfg.AuditLog)
+	assert.Equal(t, "env", cfg.Sources["audit_log"])
 }
 
 func TestPreferencesEnvOverridesFile(t *testing.T) {
@@ -0,0 +1,24 @@
+package config
+
+// Confirm policies for the confirm key: "always" prompts before every
+// confirmable change, "destructive" (the default) only before trash, delete,
+// and bulk operations, and "never" doesn't prompt. Prompts only ever appear
+// on an interactive terminal.
+const (
+	ConfirmAlways      = "always"
+	ConfirmDestructive = "destructive"
+	ConfirmNever       = "never"
+)
+
+// ConfirmPolicies are the accepted confirm values.
+var ConfirmPolicies = []string{ConfirmAlways, ConfirmDestructive, ConfirmNever}
+
+// IsConfirmPolicy reports whether v is a valid confirm value.
+func IsConfirmPolicy(v string) bool {
+	for _, p := range ConfirmPolicies {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromFile_Confirm(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"confirm": "never"}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+	assert.Equal(t, ConfirmNever, cfg.Confirm)
+	assert.Equal(t, "global", cfg.Sources["confirm"])
+
+	// Unknown values are ignored rather than half-applied.
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"confirm": "sometimes"}`), 0644))
+	loadFromFile(cfg, configPath, SourceLocal, nil)
+	assert.Equal(t, ConfirmNever, cfg.Confirm)
+}
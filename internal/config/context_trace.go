@@ -0,0 +1,97 @@
+package config
+
+import "sort"
+
+// ContextKeys are the settings that decide which Basecamp a command reads
+// from and writes to, in the order they are explained.
+var ContextKeys = []string{"profile", "base_url", "account_id", "project_id", "todolist_id"}
+
+// ContextSetting is one layer's value for a context key.
+type ContextSetting struct {
+	Key    string
+	Value  string
+	Source string // a Source, or "profile"
+	Origin string // file path, env var, flag, or profile name
+}
+
+// ContextExplanation says which layer set a context key and which layers
+// it overrode.
+type ContextExplanation struct {
+	Key   string
+	Value string
+
+	// Winner is the setting in effect, nil when the value is a default.
+	Winner *ContextSetting
+
+	// Overridden lists lower-precedence layers that set a different value,
+	// highest precedence first.
+	Overridden []ContextSetting
+}
+
+// contextRank orders sources by precedence:
+// flag > env > profile > local > repo > global > system.
+var contextRank = map[string]int{
+	string(SourceSystem): 1,
+	string(SourceGlobal): 2,
+	string(SourceRepo):   3,
+	string(SourceLocal):  4,
+	"profile":            5,
+	string(SourceEnv):    6,
+	string(SourceFlag):   7,
+}
+
+// TraceContext records that a layer set a context key. Loading re-applies
+// env and flags after a profile overlay, so repeats are dropped.
+func (c *Config) TraceContext(key, value, source, origin string) {
+	s := ContextSetting{Key: key, Value: value, Source: source, Origin: origin}
+	for _, existing := range c.ContextTrail {
+		if existing == s {
+			return
+		}
+	}
+	c.ContextTrail = append(c.ContextTrail, s)
+}
+
+// ExplainContext reports, for each context key, which layer won and which
+// conflicting values it overrode. Within a source, later settings win
+// (nested local configs closer to the working directory load last).
+func (c *Config) ExplainContext() []ContextExplanation {
+	current := map[string]string{
+		"profile":     c.ActiveProfile,
+		"base_url":    c.BaseURL,
+		"account_id":  c.AccountID,
+		"project_id":  c.ProjectID,
+		"todolist_id": c.TodolistID,
+	}
+
+	explanations := make([]ContextExplanation, 0, len(ContextKeys))
+	for _, key := range ContextKeys {
+		var settings []ContextSetting
+		for _, s := range c.ContextTrail {
+			if s.Key == key {
+				settings = append(settings, s)
+			}
+		}
+		// Highest precedence first; reversing before the stable sort keeps
+		// later settings ahead of earlier ones from the same source.
+		for i, j := 0, len(settings)-1; i < j; i, j = i+1, j-1 {
+			settings[i], settings[j] = settings[j], settings[i]
+		}
+		sort.SliceStable(settings, func(i, j int) bool {
+			return contextRank[settings[i].Source] > contextRank[settings[j].Source]
+		})
+
+		e := ContextExplanation{Key: key, Value: current[key]}
+		for i := range settings {
+			s := settings[i]
+			switch {
+			case e.Winner == nil && s.Value == e.Value:
+				e.Winner = &s
+			case e.Winner != nil && s.Value != e.Value:
+				e.Overridden = append(e.Overridden, s)
+			}
+		}
+		explanations = append(explanations, e)
+	}
+	return explanations
+}
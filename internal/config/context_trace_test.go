@@ -0,0 +1,81 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func explanationFor(t *testing.T, cfg *Config, key string) ContextExplanation {
+	t.Helper()
+	for _, e := range cfg.ExplainContext() {
+		if e.Key == key {
+			return e
+		}
+	}
+	t.Fatalf("no explanation for %s", key)
+	return ContextExplanation{}
+}
+
+func TestExplainContextFlagOverridesEnvAndFile(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"project_id": "111", "account_id": "9"}`), 0644))
+	t.Setenv("BASECAMP_PROJECT_ID", "222")
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+	require.NoError(t, LoadFromEnv(cfg))
+	ApplyOverrides(cfg, FlagOverrides{Project: "333"})
+
+	project := explanationFor(t, cfg, "project_id")
+	assert.Equal(t, "333", project.Value)
+	require.NotNil(t, project.Winner)
+	assert.Equal(t, "--project", project.Winner.Origin)
+	require.Len(t, project.Overridden, 2)
+	assert.Equal(t, "BASECAMP_PROJECT_ID", project.Overridden[0].Origin)
+	assert.Equal(t, configPath, project.Overridden[1].Origin)
+
+	account := explanationFor(t, cfg, "account_id")
+	require.NotNil(t, account.Winner)
+	assert.Equal(t, string(SourceGlobal), account.Winner.Source)
+	assert.Empty(t, account.Overridden)
+
+	baseURL := explanationFor(t, cfg, "base_url")
+	assert.Nil(t, baseURL.Winner, "default base_url has no layer")
+}
+
+func TestExplainContextEnvBeatsProfileAfterReapply(t *testing.T) {
+	t.Setenv("BASECAMP_ACCOUNT_ID", "42")
+
+	cfg := Default()
+	cfg.Profiles = map[string]*ProfileConfig{
+		"work": {BaseURL: "https://3.basecampapi.com", AccountID: "7"},
+	}
+	require.NoError(t, LoadFromEnv(cfg))
+	require.NoError(t, cfg.ApplyProfile("work"))
+	require.NoError(t, LoadFromEnv(cfg))
+
+	account := explanationFor(t, cfg, "account_id")
+	assert.Equal(t, "42", account.Value)
+	require.NotNil(t, account.Winner)
+	assert.Equal(t, string(SourceEnv), account.Winner.Source)
+	require.Len(t, account.Overridden, 1)
+	assert.Equal(t, ContextSetting{Key: "account_id", Value: "7", Source: "profile", Origin: "work"}, account.Overridden[0])
+}
+
+func TestExplainContextCloserLocalConfigWins(t *testing.T) {
+	cfg := Default()
+	cfg.ProjectID = "2"
+	cfg.TraceContext("project_id", "1", string(SourceLocal), "/repo/.basecamp/config.json")
+	cfg.TraceContext("project_id", "2", string(SourceLocal), "/repo/sub/.basecamp/config.json")
+
+	project := explanationFor(t, cfg, "project_id")
+	require.NotNil(t, project.Winner)
+	assert.Equal(t, "/repo/sub/.basecamp/config.json", project.Winner.Origin)
+	require.Len(t, project.Overridden, 1)
+	assert.Equal(t, "1", project.Overridden[0].Value)
+}
@@ -0,0 +1,154 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// HTTPConfig tunes the API client for constrained or high-throughput
+// environments. Zero values leave the client defaults in place.
+type HTTPConfig struct {
+	// Timeout bounds a single API request (SDK default: 30s).
+	Timeout time.Duration `json:"timeout,omitempty"`
+
+	// MaxRetries is the total number of attempts for idempotent GETs that
+	// fail with a transient error (SDK default: 3). Mutations never retry.
+	MaxRetries int `json:"max_retries,omitempty"`
+
+	// BaseDelay is the first retry delay; it doubles on each attempt.
+	BaseDelay time.Duration `json:"base_delay,omitempty"`
+
+	// MaxJitter is the upper bound of the random delay added to each retry.
+	MaxJitter time.Duration `json:"max_jitter,omitempty"`
+
+	// MaxConcurrent caps in-flight requests across all basecamp processes.
+	MaxConcurrent int `json:"max_concurrent,omitempty"`
+}
+
+// httpSetting describes the accepted range of one http.<name> setting.
+type httpSetting struct {
+	duration bool
+	min, max int64 // a count, or a duration in nanoseconds
+	rangeMsg string
+}
+
+var httpSettings = map[string]httpSetting{
+	"timeout":        {duration: true, min: int64(time.Second), max: int64(10 * time.Minute), rangeMsg: "a duration from 1s to 10m"},
+	"max_retries":    {min: 1, max: 10, rangeMsg: "a whole number from 1 to 10"},
+	"base_delay":     {duration: true, min: int64(time.Millisecond), max: int64(time.Minute), rangeMsg: "a duration from 1ms to 1m"},
+	"max_jitter":     {duration: true, min: int64(time.Millisecond), max: int64(time.Minute), rangeMsg: "a duration from 1ms to 1m"},
+	"max_concurrent": {min: 1, max: 50, rangeMsg: "a whole number from 1 to 50"},
+}
+
+// HTTPSettingNames returns the settings accepted under the "http" section,
+// sorted.
+func HTTPSettingNames() []string {
+	names := make([]string, 0, len(httpSettings))
+	for name := range httpSettings {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseHTTPSetting validates a command-line value for http.<name> and
+// returns it in the form stored in the config file: a duration string or
+// an integer.
+func ParseHTTPSetting(name, value string) (any, error) {
+	setting, ok := httpSettings[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown http setting %q (valid: %v)", name, HTTPSettingNames())
+	}
+	if setting.duration {
+		d, err := time.ParseDuration(value)
+		if err != nil || int64(d) < setting.min || int64(d) > setting.max {
+			return nil, fmt.Errorf("http.%s must be %s (e.g. %s)", name, setting.rangeMsg, time.Duration(setting.min))
+		}
+		return value, nil
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || int64(n) < setting.min || int64(n) > setting.max {
+		return nil, fmt.Errorf("http.%s must be %s", name, setting.rangeMsg)
+	}
+	return n, nil
+}
+
+// set validates one http.<name> value decoded from a config file and
+// applies it. Durations are strings ("30s"); counts are JSON numbers.
+func (h *HTTPConfig) set(name string, raw any) error {
+	setting, ok := httpSettings[name]
+	if !ok {
+		return fmt.Errorf("unknown setting (valid: %v)", HTTPSettingNames())
+	}
+
+	var n int64
+	switch v := raw.(type) {
+	case string:
+		if !setting.duration {
+			return fmt.Errorf("must be %s", setting.rangeMsg)
+		}
+		d, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("must be %s", setting.rangeMsg)
+		}
+		n = int64(d)
+	case float64:
+		if setting.duration || v != float64(int64(v)) {
+			return fmt.Errorf("must be %s", setting.rangeMsg)
+		}
+		n = int64(v)
+	default:
+		return fmt.Errorf("must be %s", setting.rangeMsg)
+	}
+	if n < setting.min || n > setting.max {
+		return fmt.Errorf("must be %s", setting.rangeMsg)
+	}
+
+	switch name {
+	case "timeout":
+		h.Timeout = time.Duration(n)
+	case "max_retries":
+		h.MaxRetries = int(n)
+	case "base_delay":
+		h.BaseDelay = time.Duration(n)
+	case "max_jitter":
+		h.MaxJitter = time.Duration(n)
+	case "max_concurrent":
+		h.MaxConcurrent = int(n)
+	}
+	return nil
+}
+
+// Value returns the effective value of http.<name> for display, or "" if
+// it isn't set.
+func (h HTTPConfig) Value(name string) string {
+	switch name {
+	case "timeout":
+		return durationValue(h.Timeout)
+	case "max_retries":
+		return countValue(h.MaxRetries)
+	case "base_delay":
+		return durationValue(h.BaseDelay)
+	case "max_jitter":
+		return durationValue(h.MaxJitter)
+	case "max_concurrent":
+		return countValue(h.MaxConcurrent)
+	}
+	return ""
+}
+
+func durationValue(d time.Duration) string {
+	if d == 0 {
+		return ""
+	}
+	return d.String()
+}
+
+func countValue(n int) string {
+	if n == 0 {
+		return ""
+	}
+	return strconv.Itoa(n)
+}
@@ -0,0 +1,76 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromFile_HTTPSection(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"http": {
+		"timeout": "90s",
+		"max_retries": 5,
+		"base_delay": "250ms",
+		"max_jitter": "50ms",
+		"max_concurrent": 4
+	}}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceLocal, nil)
+
+	assert.Equal(t, HTTPConfig{
+		Timeout:       90 * time.Second,
+		MaxRetries:    5,
+		BaseDelay:     250 * time.Millisecond,
+		MaxJitter:     50 * time.Millisecond,
+		MaxConcurrent: 4,
+	}, cfg.HTTP)
+	assert.Equal(t, "local", cfg.Sources["http.timeout"])
+	assert.Equal(t, "local", cfg.Sources["http.max_concurrent"])
+}
+
+func TestLoadFromFile_HTTPSectionRejectsInvalidValues(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"http": {
+		"timeout": 30,
+		"max_retries": 0,
+		"base_delay": "soon",
+		"max_jitter": "2h",
+		"max_concurrent": 2.5,
+		"proxy": "http://example.com"
+	}}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+
+	assert.Equal(t, HTTPConfig{}, cfg.HTTP)
+	for _, name := range []string{"timeout", "max_retries", "base_delay", "max_jitter", "max_concurrent", "proxy"} {
+		assert.Empty(t, cfg.Sources["http."+name], name)
+	}
+}
+
+func TestParseHTTPSetting(t *testing.T) {
+	v, err := ParseHTTPSetting("timeout", "45s")
+	require.NoError(t, err)
+	assert.Equal(t, "45s", v)
+
+	v, err = ParseHTTPSetting("max_concurrent", "20")
+	require.NoError(t, err)
+	assert.Equal(t, 20, v)
+
+	_, err = ParseHTTPSetting("timeout", "500ms")
+	assert.ErrorContains(t, err, "http.timeout must be a duration from 1s to 10m")
+
+	_, err = ParseHTTPSetting("max_retries", "11")
+	assert.ErrorContains(t, err, "http.max_retries must be a whole number from 1 to 10")
+
+	_, err = ParseHTTPSetting("proxy", "x")
+	assert.ErrorContains(t, err, `unknown http setting "proxy"`)
+}
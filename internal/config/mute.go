@@ -0,0 +1,76 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// muteTools names the dock tools a tui_mute entry can target.
+var muteTools = map[string]bool{
+	"chat":          true,
+	"inbox":         true,
+	"kanban_board":  true,
+	"message_board": true,
+	"questionnaire": true,
+	"schedule":      true,
+	"todoset":       true,
+	"vault":         true,
+}
+
+// MuteToolNames returns the dock tools accepted in tui_mute entries, sorted.
+func MuteToolNames() []string {
+	names := make([]string, 0, len(muteTools))
+	for name := range muteTools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseMuteList validates a comma-separated tui_mute value and returns its
+// entries sorted and de-duplicated. Each entry is a project ID ("123") or a
+// project ID and dock tool ("123/chat").
+func ParseMuteList(value string) ([]string, error) {
+	var raw []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			raw = append(raw, entry)
+		}
+	}
+	return normalizeMuteList(raw)
+}
+
+func normalizeMuteList(raw []string) ([]string, error) {
+	seen := make(map[string]bool, len(raw))
+	entries := make([]string, 0, len(raw))
+	for _, entry := range raw {
+		parsed, err := parseMuteEntry(entry)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[parsed] {
+			seen[parsed] = true
+			entries = append(entries, parsed)
+		}
+	}
+	sort.Strings(entries)
+	return entries, nil
+}
+
+func parseMuteEntry(entry string) (string, error) {
+	project, tool, hasTool := strings.Cut(strings.TrimSpace(entry), "/")
+	id, err := strconv.ParseInt(project, 10, 64)
+	if err != nil || id <= 0 {
+		return "", fmt.Errorf("invalid tui_mute entry %q: want <project-id> or <project-id>/<tool>", entry)
+	}
+	if !hasTool {
+		return strconv.FormatInt(id, 10), nil
+	}
+	tool = strings.ToLower(tool)
+	if !muteTools[tool] {
+		return "", fmt.Errorf("invalid tui_mute entry %q: unknown tool %q (valid: %s)", entry, tool, strings.Join(MuteToolNames(), ", "))
+	}
+	return fmt.Sprintf("%d/%s", id, tool), nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromFile_TUIMute(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"tui_mute": ["456/Chat", "123", "nope", "123", "9/pings"]}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+
+	assert.Equal(t, []string{"123", "456/chat"}, cfg.TUIMute)
+	assert.Equal(t, "global", cfg.Sources["tui_mute"])
+}
+
+func TestLoadFromFile_TUIMuteString(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"tui_mute": "123/todoset, 77"}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceLocal, nil)
+
+	assert.Equal(t, []string{"123/todoset", "77"}, cfg.TUIMute)
+}
+
+func TestParseMuteList(t *testing.T) {
+	entries, err := ParseMuteList(" 123/chat ,456,123/CHAT,")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"123/chat", "456"}, entries)
+
+	entries, err = ParseMuteList("")
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+
+	_, err = ParseMuteList("launch")
+	assert.ErrorContains(t, err, `invalid tui_mute entry "launch"`)
+
+	_, err = ParseMuteList("123/pings")
+	assert.ErrorContains(t, err, `unknown tool "pings"`)
+}
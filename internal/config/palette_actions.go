@@ -0,0 +1,129 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// PaletteAction is a custom TUI command palette action saved under
+// palette_actions.<name>. Exactly one of Run and Shell is set.
+type PaletteAction struct {
+	Description string `json:"description,omitempty"`
+	// Run is a basecamp command line without the binary name, e.g.
+	// "todos list --in {{project_id}}".
+	Run string `json:"run,omitempty"`
+	// Shell is a command line for sh -c. Interpolated values are quoted.
+	Shell string `json:"shell,omitempty"`
+}
+
+// PaletteVariables are the TUI scope values a palette action can
+// interpolate as {{name}}.
+var PaletteVariables = []string{
+	"account_id", "project_id", "project_name",
+	"tool_type", "tool_id", "recording_id", "recording_type",
+}
+
+var paletteVariableRe = regexp.MustCompile(`\{\{\s*([a-z_]+)\s*\}\}`)
+
+// Command returns the action's command line, whichever kind it is.
+func (a PaletteAction) Command() string {
+	if a.Shell != "" {
+		return a.Shell
+	}
+	return a.Run
+}
+
+// Validate checks that exactly one command is set and that it only uses
+// known variables.
+func (a PaletteAction) Validate() error {
+	switch {
+	case a.Run == "" && a.Shell == "":
+		return errors.New("needs a run or shell command")
+	case a.Run != "" && a.Shell != "":
+		return errors.New("set run or shell, not both")
+	}
+	_, err := PaletteTemplateVars(a.Command())
+	return err
+}
+
+// Vars returns the variables the action's command uses.
+func (a PaletteAction) Vars() []string {
+	vars, _ := PaletteTemplateVars(a.Command())
+	return vars
+}
+
+// PaletteTemplateVars returns the distinct {{name}} variables in s, in
+// order of first use. Unknown names are an error.
+func PaletteTemplateVars(s string) ([]string, error) {
+	var vars []string
+	for _, m := range paletteVariableRe.FindAllStringSubmatch(s, -1) {
+		name := m[1]
+		if !isPaletteVariable(name) {
+			return nil, fmt.Errorf("unknown variable {{%s}} (valid: %s)", name, strings.Join(PaletteVariables, ", "))
+		}
+		if !slices.Contains(vars, name) {
+			vars = append(vars, name)
+		}
+	}
+	return vars, nil
+}
+
+// ExpandPaletteTemplate replaces each {{name}} in s with quote(values[name]).
+// A nil quote inserts values verbatim.
+func ExpandPaletteTemplate(s string, values map[string]string, quote func(string) string) string {
+	return paletteVariableRe.ReplaceAllStringFunc(s, func(m string) string {
+		v := values[paletteVariableRe.FindStringSubmatch(m)[1]]
+		if quote != nil {
+			return quote(v)
+		}
+		return v
+	})
+}
+
+// PaletteActionNames returns the names of the custom palette actions, sorted.
+func (c *Config) PaletteActionNames() []string {
+	names := make([]string, 0, len(c.PaletteActions))
+	for name := range c.PaletteActions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// paletteActionFromFile decodes one palette_actions.<name> value from a
+// config file.
+func paletteActionFromFile(name string, raw any) (PaletteAction, error) {
+	if name == "" || strings.ContainsAny(name, " \t\n") {
+		return PaletteAction{}, errors.New("name must be non-empty without spaces")
+	}
+	v, ok := raw.(map[string]any)
+	if !ok {
+		return PaletteAction{}, errors.New("must be an object with description and run or shell")
+	}
+	var a PaletteAction
+	for key, val := range v {
+		s, ok := val.(string)
+		if !ok {
+			return PaletteAction{}, fmt.Errorf("%s must be a string", key)
+		}
+		switch key {
+		case "description":
+			a.Description = strings.TrimSpace(s)
+		case "run":
+			a.Run = strings.TrimSpace(s)
+		case "shell":
+			a.Shell = strings.TrimSpace(s)
+		default:
+			return PaletteAction{}, fmt.Errorf("unknown field %q", key)
+		}
+	}
+	return a, a.Validate()
+}
+
+func isPaletteVariable(name string) bool {
+	return slices.Contains(PaletteVariables, name)
+}
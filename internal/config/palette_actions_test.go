@@ -0,0 +1,52 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaletteActionValidate(t *testing.T) {
+	assert.NoError(t, PaletteAction{Run: "todos list --in {{project_id}}"}.Validate())
+	assert.NoError(t, PaletteAction{Shell: "echo {{ recording_id }}"}.Validate())
+	assert.ErrorContains(t, PaletteAction{Description: "nothing"}.Validate(), "run or shell")
+	assert.ErrorContains(t, PaletteAction{Run: "x", Shell: "y"}.Validate(), "not both")
+	assert.ErrorContains(t, PaletteAction{Run: "show {{todo_id}}"}.Validate(), "unknown variable {{todo_id}}")
+}
+
+func TestExpandPaletteTemplate(t *testing.T) {
+	values := map[string]string{"project_id": "42", "project_name": "Bob's"}
+	tmpl := "open {{project_id}} {{ project_name }} {{project_id}}"
+
+	assert.Equal(t, "open 42 Bob's 42", ExpandPaletteTemplate(tmpl, values, nil))
+	assert.Equal(t, `open '42' 'Bob'\''s' '42'`, ExpandPaletteTemplate(tmpl, values, ShellQuote))
+
+	vars, err := PaletteTemplateVars(tmpl)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"project_id", "project_name"}, vars)
+}
+
+func TestLoadFromFile_PaletteActions(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"palette_actions": {
+		"standup": {"description": "Post standup", "run": "chat post \"Standup\" --in {{project_id}}"},
+		"notes": {"shell": "open notes/{{project_id}}.md"},
+		"broken": {"run": "show {{nope}}"},
+		"both": {"run": "x", "shell": "y"}
+	}}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+
+	assert.Equal(t, []string{"notes", "standup"}, cfg.PaletteActionNames(), "invalid actions are skipped")
+	assert.Equal(t, PaletteAction{Description: "Post standup", Run: `chat post "Standup" --in {{project_id}}`}, cfg.PaletteActions["standup"])
+	assert.Equal(t, "global", cfg.Sources["palette_actions.notes"])
+
+	local := Default()
+	loadFromFile(local, configPath, SourceLocal, nil)
+	assert.Empty(t, local.PaletteActions, "untrusted local config can't add commands")
+}
@@ -0,0 +1,56 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RedactKinds are the built-in redact rules; a rule may also be
+// "field:<name>" to mask every value under that JSON key.
+var RedactKinds = []string{"emails", "urls", "signed_urls"}
+
+// DefaultRedact is what --redact masks when the redact key isn't set.
+var DefaultRedact = []string{"emails", "signed_urls"}
+
+// ParseRedactList validates a comma-separated redact value and returns its
+// rules de-duplicated, in the order given.
+func ParseRedactList(value string) ([]string, error) {
+	return normalizeRedactList(strings.Split(value, ","))
+}
+
+func normalizeRedactList(raw []string) ([]string, error) {
+	seen := make(map[string]bool, len(raw))
+	var rules []string
+	for _, rule := range raw {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		if err := checkRedactRule(rule); err != nil {
+			return nil, err
+		}
+		if !seen[rule] {
+			seen[rule] = true
+			rules = append(rules, rule)
+		}
+	}
+	if len(rules) == 0 {
+		return nil, fmt.Errorf("redact needs at least one rule: %s, or field:<name>", strings.Join(RedactKinds, ", "))
+	}
+	return rules, nil
+}
+
+func checkRedactRule(rule string) error {
+	if name, ok := strings.CutPrefix(rule, "field:"); ok {
+		if name == "" || strings.ContainsAny(name, " \t") {
+			return fmt.Errorf("invalid redact rule %q: field:<name> needs a JSON key, e.g. field:email_address", rule)
+		}
+		return nil
+	}
+	for _, kind := range RedactKinds {
+		if rule == kind {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid redact rule %q (valid: %s, or field:<name>)", rule, strings.Join(RedactKinds, ", "))
+}
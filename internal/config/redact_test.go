@@ -0,0 +1,37 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRedactList(t *testing.T) {
+	rules, err := ParseRedactList("emails, field:email_address,emails,signed_urls")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"emails", "field:email_address", "signed_urls"}, rules)
+
+	for _, bad := range []string{"", "phone_numbers", "field:", "field:a b"} {
+		_, err := ParseRedactList(bad)
+		assert.Error(t, err, bad)
+	}
+}
+
+func TestLoadFromFile_Redact(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"redact": ["urls", "field:sgid"]}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+	assert.Equal(t, []string{"urls", "field:sgid"}, cfg.Redact)
+	assert.Equal(t, "global", cfg.Sources["redact"])
+
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"redact": "emails,bogus"}`), 0644))
+	cfg = Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+	assert.Empty(t, cfg.Redact, "an invalid list is ignored as a whole")
+}
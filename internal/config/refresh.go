@@ -0,0 +1,105 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// RefreshOff is the RefreshConfig interval that turns a view's polling off.
+const RefreshOff time.Duration = -1
+
+// Bounds for refresh.<view> intervals. Anything faster than minRefresh
+// hammers the API; anything slower than maxRefresh is better left off.
+const (
+	minRefresh = 5 * time.Second
+	maxRefresh = time.Hour
+)
+
+const refreshRangeMsg = "must be a duration from 5s to 1h, or off"
+
+// RefreshConfig overrides how often the workspace TUI polls each kind of
+// view, keyed by view name (see RefreshViewNames). An interval of RefreshOff
+// disables polling; views missing from the map keep their built-in cadence.
+type RefreshConfig map[string]time.Duration
+
+// refreshViews names the views whose polling can be tuned.
+var refreshViews = map[string]bool{
+	"activity": true,
+	"bonfire":  true,
+	"campfire": true,
+	"hey":      true,
+	"timeline": true,
+	"todos":    true,
+}
+
+// RefreshViewNames returns the views accepted under the "refresh" section,
+// sorted.
+func RefreshViewNames() []string {
+	names := make([]string, 0, len(refreshViews))
+	for name := range refreshViews {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// ParseRefreshSetting validates a command-line value for refresh.<view> and
+// returns it in the form stored in the config file: a duration string or
+// "off".
+func ParseRefreshSetting(view, value string) (string, error) {
+	if !refreshViews[view] {
+		return "", fmt.Errorf("unknown refresh view %q (valid: %v)", view, RefreshViewNames())
+	}
+	if _, err := parseRefreshInterval(value); err != nil {
+		return "", fmt.Errorf("refresh.%s %s", view, err)
+	}
+	return strings.ToLower(value), nil
+}
+
+// set validates one refresh.<view> value decoded from a config file and
+// applies it.
+func (r *RefreshConfig) set(view string, raw any) error {
+	if !refreshViews[view] {
+		return fmt.Errorf("unknown view (valid: %v)", RefreshViewNames())
+	}
+	s, ok := raw.(string)
+	if !ok {
+		return errors.New(refreshRangeMsg)
+	}
+	d, err := parseRefreshInterval(s)
+	if err != nil {
+		return err
+	}
+	if *r == nil {
+		*r = make(RefreshConfig)
+	}
+	(*r)[view] = d
+	return nil
+}
+
+// Value returns the effective value of refresh.<view> for display, or "" if
+// it isn't set.
+func (r RefreshConfig) Value(view string) string {
+	d, ok := r[view]
+	if !ok {
+		return ""
+	}
+	if d == RefreshOff {
+		return "off"
+	}
+	return d.String()
+}
+
+func parseRefreshInterval(value string) (time.Duration, error) {
+	if strings.EqualFold(value, "off") {
+		return RefreshOff, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < minRefresh || d > maxRefresh {
+		return 0, errors.New(refreshRangeMsg)
+	}
+	return d, nil
+}
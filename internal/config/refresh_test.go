@@ -0,0 +1,69 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromFile_RefreshSection(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"refresh": {
+		"campfire": "15s",
+		"todos": "2m",
+		"hey": "OFF"
+	}}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+
+	assert.Equal(t, RefreshConfig{
+		"campfire": 15 * time.Second,
+		"todos":    2 * time.Minute,
+		"hey":      RefreshOff,
+	}, cfg.Refresh)
+	assert.Equal(t, "global", cfg.Sources["refresh.campfire"])
+	assert.Equal(t, "off", cfg.Refresh.Value("hey"))
+	assert.Equal(t, "2m0s", cfg.Refresh.Value("todos"))
+	assert.Empty(t, cfg.Refresh.Value("pulse"))
+}
+
+func TestLoadFromFile_RefreshSectionRejectsInvalidValues(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"refresh": {
+		"campfire": "1s",
+		"todos": 120,
+		"hey": "never",
+		"inbox": "30s"
+	}}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceLocal, nil)
+
+	assert.Empty(t, cfg.Refresh)
+	for _, view := range []string{"campfire", "todos", "hey", "inbox"} {
+		assert.Empty(t, cfg.Sources["refresh."+view], view)
+	}
+}
+
+func TestParseRefreshSetting(t *testing.T) {
+	v, err := ParseRefreshSetting("campfire", "15s")
+	require.NoError(t, err)
+	assert.Equal(t, "15s", v)
+
+	v, err = ParseRefreshSetting("todos", "Off")
+	require.NoError(t, err)
+	assert.Equal(t, "off", v)
+
+	_, err = ParseRefreshSetting("campfire", "2h")
+	assert.ErrorContains(t, err, "refresh.campfire must be a duration from 5s to 1h, or off")
+
+	_, err = ParseRefreshSetting("inbox", "30s")
+	assert.ErrorContains(t, err, `unknown refresh view "inbox"`)
+}
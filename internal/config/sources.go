@@ -0,0 +1,156 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+)
+
+// File is one config file layer.
+type File struct {
+	Source Source `json:"source"`
+	Path   string `json:"path"`
+	Exists bool   `json:"exists"`
+}
+
+// Files returns the config file layers in load order, later ones winning:
+// system, global, the repo config, then local configs from the repo root
+// (or the current directory) inward. System and global are listed whether
+// or not they exist; repo and local configs only when found.
+func Files() []File {
+	files := []File{
+		{Source: SourceSystem, Path: systemConfigPath()},
+		{Source: SourceGlobal, Path: globalConfigPath()},
+	}
+	repoPath := RepoConfigPath()
+	if repoPath != "" {
+		files = append(files, File{Source: SourceRepo, Path: repoPath})
+	}
+	// Nested directories override their parents.
+	for _, path := range localConfigPaths(repoPath) {
+		files = append(files, File{Source: SourceLocal, Path: path})
+	}
+	for i := range files {
+		_, err := os.Stat(files[i].Path)
+		files[i].Exists = err == nil
+	}
+	return files
+}
+
+// setFileSource records that key was set by the config file at path.
+func (c *Config) setFileSource(key string, source Source, path string) {
+	c.Sources[key] = string(source)
+	if c.Origins == nil {
+		c.Origins = make(map[string]string)
+	}
+	c.Origins[key] = path
+}
+
+// Origin returns the config file that set key, or "" when its value came
+// from somewhere else (a default, the environment, a flag, or a profile).
+func (c *Config) Origin(key string) string {
+	switch Source(c.Sources[key]) {
+	case SourceSystem, SourceGlobal, SourceRepo, SourceLocal:
+		return c.Origins[key]
+	}
+	return ""
+}
+
+// fileKeys are the top-level keys a config file may set.
+var fileKeys = []string{
+	"account_id", "project_id", "todolist_id", "base_url", "scope",
+	"cache_dir", "cache_enabled", "format", "hints", "stats", "usage_stats", "audit_log",
+	"interactive", "no_breadcrumbs", "no_context", "minimal_output", "emoji",
+	"verbose", "onboarded",
+	"llm_provider", "llm_model", "llm_api_key", "llm_endpoint",
+	"llm_max_concurrent", "llm_token_budget",
+	"tui_mute", "tui_theme", "timezone", "confirm", "redact",
+	"http", "refresh", "experimental", "columns", "card_filters",
+	"palette_actions", "default_profile", "profiles",
+}
+
+// Problem is something wrong in a config file: a key the CLI doesn't know
+// or a value it ignores.
+type Problem struct {
+	Path    string `json:"path"`
+	Key     string `json:"key,omitempty"`
+	Message string `json:"message"`
+}
+
+// CheckFile reports the problems in one config file layer: malformed JSON,
+// unknown keys, and every value loading would ignore, including
+// trust-gated keys in an untrusted local or repo config. A missing file
+// has no problems.
+func CheckFile(f File, trust *TrustStore) []Problem {
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		return nil
+	}
+	var fileCfg map[string]any
+	if err := json.Unmarshal(data, &fileCfg); err != nil {
+		return []Problem{{Path: f.Path, Message: fmt.Sprintf("malformed JSON: %v", err)}}
+	}
+
+	var problems []Problem
+	for _, key := range sortedKeys(fileCfg) {
+		if slices.Contains(fileKeys, key) {
+			continue
+		}
+		msg := "unknown key"
+		if s := closestFileKey(key); s != "" {
+			msg += fmt.Sprintf(" (did you mean %s?)", s)
+		}
+		problems = append(problems, Problem{Path: f.Path, Key: key, Message: msg})
+	}
+
+	// Load into a scratch config to collect what loading would warn about.
+	loadFile(Default(), f.Path, f.Source, trust, func(format string, args ...any) {
+		msg := strings.TrimSpace(fmt.Sprintf(format, args...))
+		msg = strings.Join(strings.Fields(msg), " ")
+		problems = append(problems, Problem{Path: f.Path, Message: msg})
+	})
+	return problems
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// closestFileKey suggests the known key a typo was probably meant to be.
+func closestFileKey(key string) string {
+	best, bestDist := "", 3 // suggest only within two edits
+	for _, k := range fileKeys {
+		if d := editDistance(key, k); d < bestDist {
+			best, bestDist = k, d
+		}
+	}
+	return best
+}
+
+// editDistance is the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
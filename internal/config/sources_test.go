@@ -0,0 +1,78 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOrigin(t *testing.T) {
+	dir := t.TempDir()
+	globalPath := filepath.Join(dir, "global.json")
+	localPath := filepath.Join(dir, "local.json")
+	require.NoError(t, os.WriteFile(globalPath, []byte(`{"account_id":"1","project_id":"2","format":"json"}`), 0644))
+	require.NoError(t, os.WriteFile(localPath, []byte(`{"project_id":"3"}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, globalPath, SourceGlobal, nil)
+	loadFromFile(cfg, localPath, SourceLocal, nil)
+	ApplyOverrides(cfg, FlagOverrides{Format: "md"})
+
+	assert.Equal(t, globalPath, cfg.Origin("account_id"))
+	assert.Equal(t, localPath, cfg.Origin("project_id"), "the closer file wins")
+	assert.Empty(t, cfg.Origin("format"), "a flag has no file")
+	assert.Empty(t, cfg.Origin("base_url"), "defaults have no file")
+}
+
+func TestCheckFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{
+		"project_id": "1",
+		"projct_id": "2",
+		"colour": "blue",
+		"tui_theme": "neon",
+		"verbose": 7,
+		"cache_dir": "/tmp/elsewhere"
+	}`), 0644))
+
+	problems := CheckFile(File{Source: SourceLocal, Path: path, Exists: true}, nil)
+
+	var messages []string
+	for _, p := range problems {
+		assert.Equal(t, path, p.Path)
+		messages = append(messages, p.Key+": "+p.Message)
+	}
+	assert.Contains(t, messages, "colour: unknown key")
+	assert.Contains(t, messages, "projct_id: unknown key (did you mean project_id?)")
+	assert.Contains(t, messages, `: ignoring tui_theme "neon" from local config at `+path)
+	assert.Contains(t, messages, ": ignoring verbose from local config at "+path+": must be 0, 1, or 2")
+	assert.Len(t, problems, 5, "cache_dir is trust-gated in an untrusted local config: %v", messages)
+}
+
+func TestCheckFileTrusted(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"cache_dir":"/tmp/elsewhere"}`), 0644))
+
+	trust := NewTrustStore(t.TempDir())
+	require.NoError(t, trust.Trust(path))
+
+	assert.Empty(t, CheckFile(File{Source: SourceLocal, Path: path, Exists: true}, trust))
+}
+
+func TestCheckFileMalformed(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"project_id":`), 0644))
+
+	problems := CheckFile(File{Source: SourceGlobal, Path: path, Exists: true}, nil)
+	require.Len(t, problems, 1)
+	assert.Contains(t, problems[0].Message, "malformed JSON")
+}
+
+func TestCheckFileMissing(t *testing.T) {
+	assert.Empty(t, CheckFile(File{Source: SourceGlobal, Path: filepath.Join(t.TempDir(), "none.json")}, nil))
+}
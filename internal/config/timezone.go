@@ -0,0 +1,40 @@
+package config
+
+import (
+	"fmt"
+	"time"
+
+	// Embed the zone database so timezone works where the system has none
+	// (Windows, minimal containers).
+	_ "time/tzdata"
+)
+
+// LoadTimezone resolves a timezone value: an IANA name such as
+// "Europe/Berlin", "UTC", or "Local" for the terminal's own zone.
+func LoadTimezone(name string) (*time.Location, error) {
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("unknown timezone %q (use an IANA name like Europe/Berlin or America/New_York)", name)
+	}
+	return loc, nil
+}
+
+// ApplyTimezone makes c.Timezone the process's local time zone, so relative
+// dates ("tomorrow", "friday") are reckoned and timestamps shown in the
+// account's zone rather than the terminal's. An empty Timezone keeps the
+// terminal's zone.
+func (c *Config) ApplyTimezone() error {
+	if c.Timezone == "" {
+		return nil
+	}
+	loc, err := LoadTimezone(c.Timezone)
+	if err != nil {
+		source := c.Sources["timezone"]
+		if source == "" {
+			source = "unknown"
+		}
+		return fmt.Errorf("timezone (%s): %w", source, err)
+	}
+	time.Local = loc
+	return nil
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromFile_Timezone(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"timezone": "Europe/Berlin"}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+	assert.Equal(t, "Europe/Berlin", cfg.Timezone)
+	assert.Equal(t, "global", cfg.Sources["timezone"])
+
+	// Unknown zones are ignored rather than half-applied.
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"timezone": "Mars/Olympus"}`), 0644))
+	loadFromFile(cfg, configPath, SourceLocal, nil)
+	assert.Equal(t, "Europe/Berlin", cfg.Timezone)
+}
+
+func TestTimezoneFlagOverridesEnv(t *testing.T) {
+	t.Setenv("BASECAMP_TIMEZONE", "America/New_York")
+
+	cfg := Default()
+	require.NoError(t, LoadFromEnv(cfg))
+	assert.Equal(t, "America/New_York", cfg.Timezone)
+
+	ApplyOverrides(cfg, FlagOverrides{Timezone: "Asia/Tokyo"})
+	assert.Equal(t, "Asia/Tokyo", cfg.Timezone)
+	assert.Equal(t, "flag", cfg.Sources["timezone"])
+}
+
+func TestApplyTimezone(t *testing.T) {
+	orig := time.Local
+	t.Cleanup(func() { time.Local = orig })
+
+	cfg := Default()
+	require.NoError(t, cfg.ApplyTimezone())
+	assert.Same(t, orig, time.Local, "empty timezone keeps the terminal's")
+
+	cfg.Timezone = "Europe/Berlin"
+	require.NoError(t, cfg.ApplyTimezone())
+	assert.Equal(t, "Europe/Berlin", time.Local.String())
+
+	cfg.Timezone = "Nowhere/Special"
+	cfg.Sources["timezone"] = "flag"
+	err := cfg.ApplyTimezone()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), `timezone (flag): unknown timezone "Nowhere/Special"`)
+}
@@ -0,0 +1,15 @@
+package config
+
+// TUIThemes are the accepted tui_theme values: "auto" follows the terminal
+// background, "dark" and "light" pin one palette, "none" drops color.
+var TUIThemes = []string{"auto", "dark", "light", "none"}
+
+// IsTUITheme reports whether v is a valid tui_theme value.
+func IsTUITheme(v string) bool {
+	for _, t := range TUIThemes {
+		if v == t {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,26 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadFromFile_TUITheme(t *testing.T) {
+	tmpDir := t.TempDir()
+	configPath := filepath.Join(tmpDir, "config.json")
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"tui_theme": "light"}`), 0644))
+
+	cfg := Default()
+	loadFromFile(cfg, configPath, SourceGlobal, nil)
+	assert.Equal(t, "light", cfg.TUITheme)
+	assert.Equal(t, "global", cfg.Sources["tui_theme"])
+
+	// Unknown values are ignored rather than half-applied.
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"tui_theme": "solarized"}`), 0644))
+	loadFromFile(cfg, configPath, SourceLocal, nil)
+	assert.Equal(t, "light", cfg.TUITheme)
+}
@@ -14,10 +14,12 @@ import (
 //   - monday, tuesday, ... (next occurrence, same day = next week)
 //   - next monday, next tuesday, ... (at least 7 days from now)
 //   - next week, next month
+//   - last monday, last week, last month
 //   - eow (end of week - Friday)
 //   - eom (end of month)
 //   - +N (N days from now)
 //   - in N days, in N weeks
+//   - N days ago, N weeks ago
 //   - YYYY-MM-DD (passthrough)
 func Parse(input string) string {
 	return ParseFrom(input, time.Now())
@@ -39,12 +41,23 @@ func ParseFrom(input string, now time.Time) string {
 		return formatDate(now.AddDate(0, 0, 7))
 	case "next month", "nextmonth":
 		return formatDate(now.AddDate(0, 1, 0))
+	case "last week", "lastweek":
+		return formatDate(now.AddDate(0, 0, -7))
+	case "last month", "lastmonth":
+		return formatDate(now.AddDate(0, -1, 0))
 	case "end of week", "eow":
 		return formatDate(nextWeekday(now, time.Friday, false))
 	case "end of month", "eom":
 		return formatDate(endOfMonth(now))
 	}
 
+	// "last monday" = most recent past occurrence
+	if rest, ok := strings.CutPrefix(input, "last "); ok {
+		if day, ok := parseWeekday(rest); ok {
+			return formatDate(lastWeekday(now, day))
+		}
+	}
+
 	// Weekday names
 	if day, ok := parseWeekday(input); ok {
 		next := strings.HasPrefix(input, "next ")
@@ -72,6 +85,16 @@ func ParseFrom(input string, now time.Time) string {
 		}
 	}
 
+	// "N days ago" / "N weeks ago" format
+	if match := agoPattern.FindStringSubmatch(input); match != nil {
+		if n, err := strconv.Atoi(match[1]); err == nil {
+			if strings.HasPrefix(match[2], "week") {
+				n *= 7
+			}
+			return formatDate(now.AddDate(0, 0, -n))
+		}
+	}
+
 	// YYYY-MM-DD passthrough
 	if datePattern.MatchString(input) {
 		return input
@@ -85,6 +108,7 @@ var (
 	datePattern    = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
 	inDaysPattern  = regexp.MustCompile(`^in (\d+) days?$`)
 	inWeeksPattern = regexp.MustCompile(`^in (\d+) weeks?$`)
+	agoPattern     = regexp.MustCompile(`^(\d+) (days?|weeks?) ago$`)
 )
 
 func formatDate(t time.Time) string {
@@ -137,6 +161,16 @@ func nextWeekday(now time.Time, target time.Weekday, forceNext bool) time.Time {
 	return now.AddDate(0, 0, daysUntil)
 }
 
+// lastWeekday returns the most recent past occurrence of the given weekday.
+// If today IS the target weekday, it returns the same day last week.
+func lastWeekday(now time.Time, target time.Weekday) time.Time {
+	daysSince := int(now.Weekday() - target)
+	if daysSince <= 0 {
+		daysSince += 7
+	}
+	return now.AddDate(0, 0, -daysSince)
+}
+
 // endOfMonth returns the last day of the current month.
 func endOfMonth(now time.Time) time.Time {
 	// Go to first day of next month, then subtract one day
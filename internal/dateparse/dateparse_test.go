@@ -61,6 +61,16 @@ func TestParse(t *testing.T) {
 		{"in 1 week", "2024-01-24"},
 		{"in 2 weeks", "2024-01-31"},
 
+		// Past dates (from Wednesday Jan 17)
+		{"last monday", "2024-01-15"},    // This week's Monday (2 days ago)
+		{"last wednesday", "2024-01-10"}, // Same day = last week (7 days)
+		{"last fri", "2024-01-12"},
+		{"last week", "2024-01-10"},
+		{"last month", "2023-12-17"},
+		{"3 days ago", "2024-01-14"},
+		{"1 day ago", "2024-01-16"},
+		{"2 weeks ago", "2024-01-03"},
+
 		// YYYY-MM-DD passthrough
 		{"2024-06-15", "2024-06-15"},
 		{"2025-12-25", "2025-12-25"},
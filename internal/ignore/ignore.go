@@ -0,0 +1,200 @@
+// Package ignore implements gitignore-style path filters for recursive
+// uploads and downloads.
+//
+// Patterns come from a .basecampignore file at the root of the local
+// directory and from --exclude flags. The supported syntax is the common
+// subset of .gitignore:
+//
+//   - blank lines and lines starting with # are ignored
+//   - * and ? match within a path segment, [abc] matches a character class
+//   - ** matches any number of segments ("**/dist", "logs/**", "a/**/b")
+//   - a trailing / matches directories only ("build/")
+//   - a pattern containing / is anchored to the root ("/vendor", "docs/*.tmp");
+//     otherwise it matches a name at any depth ("node_modules", "*.log")
+//   - a leading ! re-includes a path excluded by an earlier pattern
+//
+// As with git, the last matching pattern wins, and nothing inside an
+// excluded directory can be re-included.
+package ignore
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// FileName is the ignore file read from the root of a local directory.
+const FileName = ".basecampignore"
+
+type pattern struct {
+	segments []string
+	negate   bool
+	dirOnly  bool
+}
+
+// Matcher decides whether a path relative to the sync root is excluded.
+// The zero value excludes nothing.
+type Matcher struct {
+	patterns []pattern
+}
+
+// New builds a Matcher from patterns in .gitignore syntax. Later patterns
+// take precedence over earlier ones.
+func New(patterns []string) (*Matcher, error) {
+	m := &Matcher{}
+	for _, line := range patterns {
+		if err := m.add(line); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Load builds a Matcher from root's .basecampignore file, if there is one,
+// followed by extra (typically --exclude flags), so flags can override the
+// file with ! patterns.
+func Load(root string, extra []string) (*Matcher, error) {
+	m := &Matcher{}
+
+	file := filepath.Join(root, FileName)
+	f, err := os.Open(file) //nolint:gosec // G304: path is the user's sync root
+	switch {
+	case errors.Is(err, os.ErrNotExist):
+	case err != nil:
+		return nil, fmt.Errorf("reading %s: %w", file, err)
+	default:
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		for n := 1; scanner.Scan(); n++ {
+			if err := m.add(scanner.Text()); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", file, n, err)
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("reading %s: %w", file, err)
+		}
+	}
+
+	for _, p := range extra {
+		if err := m.add(p); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Len returns the number of active patterns.
+func (m *Matcher) Len() int {
+	if m == nil {
+		return 0
+	}
+	return len(m.patterns)
+}
+
+func (m *Matcher) add(line string) error {
+	line = strings.TrimSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return nil
+	}
+
+	var p pattern
+	switch {
+	case strings.HasPrefix(line, "!"):
+		p.negate = true
+		line = line[1:]
+	case strings.HasPrefix(line, `\!`), strings.HasPrefix(line, `\#`):
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		p.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if line == "" {
+		return nil
+	}
+
+	p.segments = strings.Split(line, "/")
+	for _, seg := range p.segments {
+		if _, err := path.Match(seg, ""); err != nil {
+			return fmt.Errorf("invalid pattern %q: %w", line, err)
+		}
+	}
+	if !anchored {
+		p.segments = append([]string{"**"}, p.segments...)
+	}
+
+	m.patterns = append(m.patterns, p)
+	return nil
+}
+
+// Match reports whether rel, a slash- or OS-separated path relative to the
+// sync root, is excluded. isDir says whether rel names a directory. A path
+// is also excluded when any of its parent directories is.
+func (m *Matcher) Match(rel string, isDir bool) bool {
+	if m.Len() == 0 {
+		return false
+	}
+
+	rel = path.Clean(filepath.ToSlash(rel))
+	if rel == "." || rel == "" {
+		return false
+	}
+	parts := strings.Split(strings.TrimPrefix(rel, "/"), "/")
+
+	for i := 1; i < len(parts); i++ {
+		if m.matchParts(parts[:i], true) {
+			return true
+		}
+	}
+	return m.matchParts(parts, isDir)
+}
+
+func (m *Matcher) matchParts(parts []string, isDir bool) bool {
+	excluded := false
+	for _, p := range m.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if matchSegments(p.segments, parts) {
+			excluded = !p.negate
+		}
+	}
+	return excluded
+}
+
+// matchSegments matches path segments against pattern segments, where a
+// "**" segment matches zero or more path segments. A trailing "**" must
+// match at least one segment, so "logs/**" matches the contents of logs but
+// not logs itself.
+func matchSegments(pat, parts []string) bool {
+	for len(pat) > 0 {
+		if pat[0] == "**" {
+			rest := pat[1:]
+			if len(rest) == 0 {
+				return len(parts) > 0
+			}
+			for i := 0; i <= len(parts); i++ {
+				if matchSegments(rest, parts[i:]) {
+					return true
+				}
+			}
+			return false
+		}
+		if len(parts) == 0 {
+			return false
+		}
+		if ok, _ := path.Match(pat[0], parts[0]); !ok {
+			return false
+		}
+		pat, parts = pat[1:], parts[1:]
+	}
+	return len(parts) == 0
+}
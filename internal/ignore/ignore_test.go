@@ -0,0 +1,125 @@
+package ignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func mustNew(t *testing.T, patterns ...string) *Matcher {
+	t.Helper()
+	m, err := New(patterns)
+	require.NoError(t, err)
+	return m
+}
+
+func TestMatchUnanchoredNameAtAnyDepth(t *testing.T) {
+	m := mustNew(t, "node_modules", "*.log")
+
+	assert.True(t, m.Match("node_modules", true))
+	assert.True(t, m.Match("web/node_modules", true))
+	assert.True(t, m.Match("web/node_modules/react/index.js", false), "contents of an excluded dir")
+	assert.True(t, m.Match("debug.log", false))
+	assert.True(t, m.Match("logs/2024/app.log", false))
+	assert.False(t, m.Match("src/main.go", false))
+	assert.False(t, m.Match("catalog", false))
+}
+
+func TestMatchAnchoredPatterns(t *testing.T) {
+	m := mustNew(t, "/dist", "docs/*.tmp")
+
+	assert.True(t, m.Match("dist", true))
+	assert.False(t, m.Match("web/dist", true), "leading slash anchors to the root")
+	assert.True(t, m.Match("docs/draft.tmp", false))
+	assert.False(t, m.Match("docs/nested/draft.tmp", false), "* does not cross /")
+}
+
+func TestMatchDirOnly(t *testing.T) {
+	m := mustNew(t, "build/")
+
+	assert.True(t, m.Match("build", true))
+	assert.True(t, m.Match("build/out.bin", false))
+	assert.False(t, m.Match("build", false), "a file named build is kept")
+}
+
+func TestMatchDoubleStar(t *testing.T) {
+	m := mustNew(t, "a/**/b", "logs/**")
+
+	assert.True(t, m.Match("a/b", false))
+	assert.True(t, m.Match("a/x/y/b", false))
+	assert.False(t, m.Match("a/x/c", false))
+	assert.True(t, m.Match("logs/today.txt", false))
+	assert.False(t, m.Match("logs", true), "trailing ** matches contents only")
+}
+
+func TestMatchNegationLastPatternWins(t *testing.T) {
+	m := mustNew(t, "*.pdf", "!keep.pdf")
+
+	assert.True(t, m.Match("report.pdf", false))
+	assert.False(t, m.Match("keep.pdf", false))
+	assert.False(t, m.Match("sub/keep.pdf", false))
+}
+
+func TestMatchCannotReincludeInsideExcludedDir(t *testing.T) {
+	m := mustNew(t, "vendor/", "!vendor/keep.txt")
+
+	assert.True(t, m.Match("vendor/keep.txt", false))
+}
+
+func TestMatchCommentsEscapesAndBlankLines(t *testing.T) {
+	m := mustNew(t, "# comment", "", "   ", `\#notes.md`)
+
+	assert.Equal(t, 1, m.Len())
+	assert.True(t, m.Match("#notes.md", false))
+}
+
+func TestMatchCleansPath(t *testing.T) {
+	m := mustNew(t, "web/tmp")
+
+	assert.True(t, m.Match("./web/tmp", true))
+	assert.True(t, m.Match("web//tmp/", true))
+	assert.False(t, m.Match(".", true))
+}
+
+func TestNilMatcherExcludesNothing(t *testing.T) {
+	var m *Matcher
+	assert.False(t, m.Match("anything", false))
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestNewRejectsBadPattern(t *testing.T) {
+	_, err := New([]string{"[unclosed"})
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "[unclosed")
+}
+
+func TestLoadReadsIgnoreFileThenExtra(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, FileName), []byte("# build output\ndist/\n*.map\n"), 0o600))
+
+	m, err := Load(dir, []string{"!app.js.map"})
+	require.NoError(t, err)
+
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m.Match("dist", true))
+	assert.True(t, m.Match("vendor.js.map", false))
+	assert.False(t, m.Match("app.js.map", false), "--exclude patterns override the file")
+}
+
+func TestLoadWithoutIgnoreFile(t *testing.T) {
+	m, err := Load(t.TempDir(), []string{"*.tmp"})
+	require.NoError(t, err)
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestLoadReportsLineOfBadPattern(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, FileName), []byte("ok\n[bad\n"), 0o600))
+
+	_, err := Load(dir, nil)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), FileName+":2")
+}
@@ -1,6 +1,6 @@
 // Package names provides name resolution for projects, people, and todolists.
 // It implements fuzzy matching with the following priority:
-// 1. Numeric ID passthrough
+// 1. Numeric ID passthrough (projects also accept a pasted Basecamp URL)
 // 2. Exact match (case-sensitive)
 // 3. Case-insensitive match
 // 4. Partial match (contains)
@@ -19,6 +19,7 @@ import (
 
 	"github.com/basecamp/basecamp-cli/internal/auth"
 	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/urlarg"
 )
 
 // Resolver resolves names to IDs for projects, people, and todolists.
@@ -38,6 +39,10 @@ type Resolver struct {
 	pingable  []Person              // cached /people/pingable.json
 	todolists map[string][]Todolist // keyed by project ID
 	me        *Person               // cached /my/profile.json result
+
+	// projectsWarm is closed when the background fetch started by
+	// WarmProjects finishes; nil when none is in flight.
+	projectsWarm chan struct{}
 }
 
 // Project represents a Basecamp project for name resolution.
@@ -85,6 +90,7 @@ func (r *Resolver) SetAccountID(accountID string) {
 		r.pingable = nil
 		r.me = nil
 		r.todolists = make(map[string][]Todolist)
+		r.projectsWarm = nil
 	}
 }
 
@@ -94,9 +100,25 @@ func (r *Resolver) forAccount() *basecamp.AccountClient {
 	return r.sdk.ForAccount(r.accountID)
 }
 
-// ResolveProject resolves a project name or ID to an ID.
+// ResolveProject resolves a project name, ID, or URL to an ID. A URL may
+// be the project's or that of anything in it.
 // Returns the ID and the project name for display.
 func (r *Resolver) ResolveProject(ctx context.Context, input string) (string, string, error) {
+	if urlAccountID, projectID, ok := urlarg.ParseProject(input); ok {
+		if r.accountID != "" && urlAccountID != "" && urlAccountID != r.accountID {
+			return "", "", output.ErrUsageHint(
+				fmt.Sprintf("Project URL is in account %s, not the current account %s", urlAccountID, r.accountID),
+				"Pass --account "+urlAccountID+" to use it",
+			)
+		}
+		input = projectID
+	} else if strings.Contains(input, "://") {
+		return "", "", output.ErrUsageHint(
+			"Not a Basecamp project URL: "+input,
+			"Pass a project name, ID, or a URL like https://3.basecamp.com/<account>/projects/<id>",
+		)
+	}
+
 	// Numeric ID passthrough
 	if id, err := strconv.ParseInt(input, 10, 64); err == nil {
 		// Validate the ID exists by fetching projects
@@ -402,14 +424,55 @@ func (r *Resolver) getMe(ctx context.Context) (*Person, error) {
 	return r.me, nil
 }
 
+// WarmProjects fetches the project list in the background so a later
+// ResolveProject finds it cached instead of waiting on the round trip. It
+// returns immediately; a failed warm-up is dropped and the next lookup
+// fetches (and reports errors) as usual.
+func (r *Resolver) WarmProjects(ctx context.Context) {
+	r.mu.Lock()
+	if r.projects != nil || r.projectsWarm != nil {
+		r.mu.Unlock()
+		return
+	}
+	done := make(chan struct{})
+	r.projectsWarm = done
+	accountID := r.accountID
+	r.mu.Unlock()
+
+	go func() {
+		defer close(done)
+		projects, err := r.fetchProjects(ctx, accountID)
+
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		if r.projectsWarm == done {
+			r.projectsWarm = nil
+		}
+		// Drop the result if the account changed while it was in flight.
+		if err == nil && r.accountID == accountID && r.projects == nil {
+			r.projects = projects
+		}
+	}()
+}
+
 func (r *Resolver) getProjects(ctx context.Context) ([]Project, error) {
 	r.mu.RLock()
 	if r.projects != nil {
 		defer r.mu.RUnlock()
 		return r.projects, nil
 	}
+	warm := r.projectsWarm
 	r.mu.RUnlock()
 
+	// Join an in-flight warm-up rather than fetching the same list twice.
+	if warm != nil {
+		select {
+		case <-warm:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -418,8 +481,17 @@ func (r *Resolver) getProjects(ctx context.Context) ([]Project, error) {
 		return r.projects, nil
 	}
 
-	// Fetch all pages from API
-	pages, err := r.forAccount().GetAll(ctx, "/projects.json")
+	projects, err := r.fetchProjects(ctx, r.accountID)
+	if err != nil {
+		return nil, err
+	}
+	r.projects = projects
+	return projects, nil
+}
+
+// fetchProjects fetches all pages of the account's projects.
+func (r *Resolver) fetchProjects(ctx context.Context, accountID string) ([]Project, error) {
+	pages, err := r.sdk.ForAccount(accountID).GetAll(ctx, "/projects.json")
 	if err != nil {
 		return nil, convertSDKError(err)
 	}
@@ -432,8 +504,6 @@ func (r *Resolver) getProjects(ctx context.Context) ([]Project, error) {
 		}
 		projects = append(projects, p)
 	}
-
-	r.projects = projects
 	return projects, nil
 }
 
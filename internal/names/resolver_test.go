@@ -474,6 +474,49 @@ func TestResolverResolveProjectNotFound(t *testing.T) {
 	assert.Equal(t, output.CodeNotFound, outErr.Code)
 }
 
+func TestResolverResolveProjectURL(t *testing.T) {
+	r := newMockResolver()
+	r.accountID = "99"
+	r.setProjects([]Project{
+		{ID: 111, Name: "Project Alpha"},
+		{ID: 222, Name: "Project Beta"},
+	})
+
+	ctx := context.Background()
+	for _, url := range []string{
+		"https://3.basecamp.com/99/projects/222",
+		"https://3.basecamp.com/99/buckets/222",
+		"https://3.basecamp.com/99/buckets/222/todos/555",
+	} {
+		id, name, err := r.ResolveProject(ctx, url)
+		require.NoError(t, err, url)
+		assert.Equal(t, "222", id, url)
+		assert.Equal(t, "Project Beta", name, url)
+	}
+}
+
+func TestResolverResolveProjectURLOtherAccount(t *testing.T) {
+	r := newMockResolver()
+	r.accountID = "99"
+	r.setProjects([]Project{{ID: 222, Name: "Project Beta"}})
+
+	_, _, err := r.ResolveProject(context.Background(), "https://3.basecamp.com/42/projects/222")
+	var outErr *output.Error
+	require.True(t, errors.As(err, &outErr), "expected *output.Error, got %T", err)
+	assert.Equal(t, output.CodeUsage, outErr.Code)
+	assert.Contains(t, outErr.Hint, "--account 42")
+}
+
+func TestResolverResolveProjectNonBasecampURL(t *testing.T) {
+	r := newMockResolver()
+	r.setProjects([]Project{{ID: 222, Name: "Project Beta"}})
+
+	_, _, err := r.ResolveProject(context.Background(), "https://example.com/projects/222")
+	var outErr *output.Error
+	require.True(t, errors.As(err, &outErr), "expected *output.Error, got %T", err)
+	assert.Equal(t, output.CodeUsage, outErr.Code)
+}
+
 func TestResolverResolvePersonNumericID(t *testing.T) {
 	r := newMockResolver()
 	r.setPeople([]Person{
@@ -1100,3 +1143,75 @@ func TestResolvePersonByID(t *testing.T) {
 		assert.Equal(t, output.CodeNotFound, outErr.Code)
 	})
 }
+
+// TestResolverWarmProjects checks that a lookup during an in-flight warm-up
+// waits for it instead of fetching the project list a second time.
+func TestResolverWarmProjects(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		assert.Equal(t, "/99999/projects.json", r.URL.Path)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]map[string]any{{"id": 7, "name": "Launch"}})
+	}))
+	t.Cleanup(server.Close)
+
+	sdkClient := basecamp.NewClient(
+		&basecamp.Config{BaseURL: server.URL},
+		testTokenProvider{},
+		basecamp.WithMaxRetries(1),
+	)
+	r := NewResolver(sdkClient, nil, "99999")
+	ctx := context.Background()
+
+	r.WarmProjects(ctx)
+	r.WarmProjects(ctx) // already in flight: no second fetch
+
+	type result struct {
+		id  string
+		err error
+	}
+	got := make(chan result, 1)
+	go func() {
+		id, _, err := r.ResolveProject(ctx, "launch")
+		got <- result{id, err}
+	}()
+
+	close(release)
+	res := <-got
+	require.NoError(t, res.err)
+	assert.Equal(t, "7", res.id)
+	assert.Equal(t, int32(1), calls.Load(), "the lookup should reuse the warm-up fetch")
+
+	r.WarmProjects(ctx) // already cached: no fetch
+	assert.Equal(t, int32(1), calls.Load())
+}
+
+func TestResolverWarmProjectsFailureFallsBackToFetch(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]map[string]any{{"id": 7, "name": "Launch"}})
+	}))
+	t.Cleanup(server.Close)
+
+	sdkClient := basecamp.NewClient(
+		&basecamp.Config{BaseURL: server.URL},
+		testTokenProvider{},
+		basecamp.WithMaxRetries(1),
+	)
+	r := NewResolver(sdkClient, nil, "99999")
+	ctx := context.Background()
+
+	r.WarmProjects(ctx)
+	id, _, err := r.ResolveProject(ctx, "Launch")
+	require.NoError(t, err, "a failed warm-up is retried by the lookup")
+	assert.Equal(t, "7", id)
+	assert.Equal(t, int32(2), calls.Load())
+}
@@ -59,6 +59,43 @@ func (h *CLIHooks) SetTracer(t *Tracer) {
 	h.tracer = t
 }
 
+// OnCommandStart is called when a CLI command begins running.
+func (h *CLIHooks) OnCommandStart(inv *Invocation) {
+	h.mu.Lock()
+	level := h.level
+	writer := h.writer
+	tracer := h.tracer
+	h.mu.Unlock()
+
+	if level >= 1 && writer != nil {
+		writer.WriteCommandStart(inv.Command(), inv.ID)
+	}
+
+	tracer.Log(TraceHTTP, "command.start", "command", inv.Command(), "correlation_id", inv.ID)
+}
+
+// OnCommandEnd is called when a CLI command finishes, with its error if it failed.
+func (h *CLIHooks) OnCommandEnd(inv *Invocation, err error) {
+	h.mu.Lock()
+	level := h.level
+	writer := h.writer
+	tracer := h.tracer
+	h.mu.Unlock()
+
+	duration := inv.Elapsed()
+	if level >= 1 && writer != nil {
+		writer.WriteCommandEnd(inv.Command(), inv.ID, err, duration)
+	}
+
+	var errStr string
+	if err != nil {
+		errStr = err.Error()
+	}
+	tracer.Log(TraceHTTP, "command.end",
+		"command", inv.Command(), "correlation_id", inv.ID,
+		"duration_ms", duration.Milliseconds(), "error", errStr)
+}
+
 // OnOperationStart is called when a semantic SDK operation begins.
 func (h *CLIHooks) OnOperationStart(ctx context.Context, op basecamp.OperationInfo) context.Context {
 	h.mu.Lock()
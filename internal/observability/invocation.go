@@ -0,0 +1,73 @@
+package observability
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// CorrelationHeader carries an invocation's correlation ID on each of its
+// API requests, so server- and proxy-side logs can be tied back to the
+// command that made them.
+const CorrelationHeader = "X-Correlation-Id"
+
+// Invocation is one run of a CLI command: what ran, when it started, and
+// the correlation ID shared by every request it makes.
+type Invocation struct {
+	ID      string
+	Started time.Time
+
+	mu      sync.Mutex
+	command string
+}
+
+// NewInvocation starts timing an invocation under a fresh correlation ID.
+func NewInvocation() *Invocation {
+	return &Invocation{ID: newCorrelationID(), Started: time.Now()}
+}
+
+func newCorrelationID() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/rand doesn't fail on supported platforms; fall back to the
+		// clock rather than leave requests uncorrelated.
+		return time.Now().UTC().Format("20060102T150405.000000000")
+	}
+	return hex.EncodeToString(b)
+}
+
+// SetCommand names the command being run, e.g. "todos list".
+func (i *Invocation) SetCommand(command string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.command = command
+}
+
+// Command returns the command set by SetCommand.
+func (i *Invocation) Command() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.command
+}
+
+// Elapsed returns the time since the invocation started.
+func (i *Invocation) Elapsed() time.Duration {
+	return time.Since(i.Started)
+}
+
+// CorrelationTransport sets CorrelationHeader on every request that passes
+// through it.
+type CorrelationTransport struct {
+	Inner http.RoundTripper
+	ID    string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *CorrelationTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// RoundTrippers must not mutate the caller's request.
+	clone := req.Clone(req.Context())
+	clone.Header.Set(CorrelationHeader, t.ID)
+	return t.Inner.RoundTrip(clone)
+}
@@ -0,0 +1,59 @@
+package observability
+
+import (
+	"bytes"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type headerRecorder struct {
+	got http.Header
+}
+
+func (r *headerRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	r.got = req.Header
+	return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody}, nil
+}
+
+func TestNewInvocationIDs(t *testing.T) {
+	a, b := NewInvocation(), NewInvocation()
+	assert.Len(t, a.ID, 16)
+	assert.NotEqual(t, a.ID, b.ID)
+
+	a.SetCommand("todos list")
+	assert.Equal(t, "todos list", a.Command())
+}
+
+func TestCorrelationTransport(t *testing.T) {
+	inner := &headerRecorder{}
+	transport := &CorrelationTransport{Inner: inner, ID: "abc123"}
+
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/x.json", nil)
+	require.NoError(t, err)
+	resp, err := transport.RoundTrip(req)
+	require.NoError(t, err)
+	resp.Body.Close()
+
+	assert.Equal(t, "abc123", inner.got.Get(CorrelationHeader))
+	assert.Empty(t, req.Header.Get(CorrelationHeader), "caller's request is left untouched")
+}
+
+func TestCLIHooks_CommandTrace(t *testing.T) {
+	var buf bytes.Buffer
+	inv := NewInvocation()
+	inv.SetCommand("todos list")
+
+	h := NewCLIHooks(0, nil, NewTraceWriterTo(&buf))
+	h.OnCommandStart(inv)
+	h.OnCommandEnd(inv, nil)
+	assert.Empty(t, buf.String(), "expected no output at level 0")
+
+	h.SetLevel(1)
+	h.OnCommandStart(inv)
+	h.OnCommandEnd(inv, nil)
+	assert.Contains(t, buf.String(), "Running todos list (correlation id "+inv.ID+")")
+	assert.Contains(t, buf.String(), "Finished todos list in ")
+}
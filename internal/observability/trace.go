@@ -118,6 +118,30 @@ func (t *TraceWriter) WriteRetry(info basecamp.RequestInfo, attempt int, err err
 	fmt.Fprintf(t.writer, "[%.3fs]   RETRY #%d: %v\n", elapsed, attempt, err)
 }
 
+// WriteCommandStart writes a command start trace line.
+// Format: [0.001s] Running todos list (correlation id 3f9a0c2e41b7d865)
+func (t *TraceWriter) WriteCommandStart(command, id string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elapsed := time.Since(t.startTime).Seconds()
+	fmt.Fprintf(t.writer, "[%.3fs] Running %s (correlation id %s)\n", elapsed, command, id)
+}
+
+// WriteCommandEnd writes a command completion trace line.
+// Format: [0.412s] Finished todos list in 411ms (correlation id 3f9a0c2e41b7d865)
+func (t *TraceWriter) WriteCommandEnd(command, id string, err error, duration time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	elapsed := time.Since(t.startTime).Seconds()
+	if err != nil {
+		fmt.Fprintf(t.writer, "[%.3fs] Failed %s after %dms (correlation id %s): %v\n", elapsed, command, duration.Milliseconds(), id, err)
+	} else {
+		fmt.Fprintf(t.writer, "[%.3fs] Finished %s in %dms (correlation id %s)\n", elapsed, command, duration.Milliseconds(), id)
+	}
+}
+
 // Reset resets the start time for relative timestamps.
 func (t *TraceWriter) Reset() {
 	t.mu.Lock()
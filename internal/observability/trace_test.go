@@ -109,6 +109,21 @@ func TestTraceWriter_WriteRetry(t *testing.T) {
 	assert.Contains(t, output, "timeout", "expected error message")
 }
 
+func TestTraceWriter_WriteCommand(t *testing.T) {
+	var buf bytes.Buffer
+	w := NewTraceWriterTo(&buf)
+
+	w.WriteCommandStart("todos list", "abc123")
+	w.WriteCommandEnd("todos list", "abc123", nil, 411*time.Millisecond)
+	w.WriteCommandEnd("todos list", "abc123", errors.New("boom"), 12*time.Millisecond)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Contains(t, lines[0], "Running todos list (correlation id abc123)")
+	assert.Contains(t, lines[1], "Finished todos list in 411ms (correlation id abc123)")
+	assert.Contains(t, lines[2], "Failed todos list after 12ms (correlation id abc123): boom")
+}
+
 func TestTraceWriter_Timestamps(t *testing.T) {
 	var buf bytes.Buffer
 	w := NewTraceWriterTo(&buf)
@@ -0,0 +1,275 @@
+// Package outbox queues commands that failed for lack of a network so they
+// can be retried later.
+//
+// A command run with --queue-on-failure that fails on a network error before
+// any of its writes could reach Basecamp is saved here: its arguments,
+// the account and profile it ran against, and the directory it ran in.
+// "basecamp outbox flush" replays the queue oldest first. Entries live in the
+// cache dir, so each profile has its own outbox.
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/gofrs/flock"
+
+	"github.com/basecamp/basecamp-cli/internal/audit"
+)
+
+const (
+	// FileName is the queue file name within the store directory.
+	FileName = "outbox.json"
+
+	// DirName is the subdirectory within the cache dir.
+	DirName = "outbox"
+
+	// LockTimeout bounds how long a write waits for the file lock before
+	// giving up with ErrBusy.
+	LockTimeout = 2 * time.Second
+)
+
+// ErrBusy is returned when another basecamp process holds the outbox lock.
+var ErrBusy = errors.New("outbox is busy: another basecamp process is using it")
+
+// ErrFlushing is returned by LockFlush when another process is flushing.
+var ErrFlushing = errors.New("another basecamp process is flushing the outbox")
+
+// Entry is one queued command.
+type Entry struct {
+	ID       int64     `json:"id"`
+	QueuedAt time.Time `json:"queued_at"`
+	Args     []string  `json:"args"`              // command line, without the binary name
+	Account  string    `json:"account,omitempty"` // account the command ran against
+	Profile  string    `json:"profile,omitempty"` // profile the command ran under
+	Dir      string    `json:"dir,omitempty"`     // working directory, for relative paths
+	Error    string    `json:"error,omitempty"`   // why the last attempt failed
+	Attempts int       `json:"attempts"`          // including the original run
+}
+
+// Store reads and writes the queue with file locking.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store rooted at dir (typically <cache_dir>/outbox).
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Path returns the full path to the queue file.
+func (s *Store) Path() string {
+	return filepath.Join(s.dir, FileName)
+}
+
+// List returns the queued entries, oldest first.
+func (s *Store) List() []Entry {
+	return s.load()
+}
+
+// Get returns the entry with id, reading the queue afresh.
+func (s *Store) Get(id int64) (Entry, bool) {
+	for _, e := range s.load() {
+		if e.ID == id {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// Add queues e under the next free ID and returns it as stored.
+func (s *Store) Add(e Entry) (Entry, error) {
+	err := s.withLock(func() error {
+		entries := s.load()
+		e.ID = 1
+		for _, existing := range entries {
+			e.ID = max(e.ID, existing.ID+1)
+		}
+		if e.QueuedAt.IsZero() {
+			e.QueuedAt = time.Now()
+		}
+		return s.save(append(entries, e))
+	})
+	return e, err
+}
+
+// Update replaces the entry with e's ID. It reports false when no such
+// entry is queued.
+func (s *Store) Update(e Entry) (bool, error) {
+	found := false
+	err := s.withLock(func() error {
+		entries := s.load()
+		for i := range entries {
+			if entries[i].ID == e.ID {
+				entries[i] = e
+				found = true
+				return s.save(entries)
+			}
+		}
+		return nil
+	})
+	return found, err
+}
+
+// Remove drops the entry with id. It reports false when no such entry is
+// queued.
+func (s *Store) Remove(id int64) (bool, error) {
+	found := false
+	err := s.withLock(func() error {
+		entries := s.load()
+		kept := entries[:0]
+		for _, e := range entries {
+			if e.ID == id {
+				found = true
+				continue
+			}
+			kept = append(kept, e)
+		}
+		if !found {
+			return nil
+		}
+		return s.save(kept)
+	})
+	return found, err
+}
+
+// LockFlush takes the flush lock, which a flush holds from its first
+// replay to its last removal so no entry is replayed twice, and returns the
+// function that releases it. It doesn't wait: if another process holds the
+// lock, it returns ErrFlushing.
+func (s *Store) LockFlush() (func(), error) {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return nil, err
+	}
+	fl := flock.New(filepath.Join(s.dir, ".flush.lock"))
+	locked, err := fl.TryLock()
+	if err != nil {
+		return nil, err
+	}
+	if !locked {
+		return nil, ErrFlushing
+	}
+	return func() { _ = fl.Unlock() }, nil
+}
+
+// withLock runs fn while holding the store lock. Unlike the chat read
+// store, it fails closed: a lost or doubled entry is a lost or doubled
+// write, so if the lock isn't acquired within LockTimeout it returns
+// ErrBusy without running fn.
+func (s *Store) withLock(fn func() error) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+	fl := flock.New(filepath.Join(s.dir, ".lock"))
+	ctx, cancel := context.WithTimeout(context.Background(), LockTimeout)
+	defer cancel()
+	locked, err := fl.TryLockContext(ctx, 10*time.Millisecond)
+	if err != nil && !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if !locked {
+		return ErrBusy
+	}
+	defer func() { _ = fl.Unlock() }()
+	return fn()
+}
+
+// load reads the queue file. A missing or corrupt file reads as empty.
+func (s *Store) load() []Entry {
+	var entries []Entry
+	if data, err := os.ReadFile(s.Path()); err == nil {
+		if json.Unmarshal(data, &entries) != nil {
+			return nil
+		}
+	}
+	return entries
+}
+
+// save writes the queue file atomically.
+func (s *Store) save(entries []Entry) error {
+	if entries == nil {
+		entries = []Entry{}
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmpPath := fmt.Sprintf("%s.%d.%d.tmp", s.Path(), os.Getpid(), time.Now().UnixNano())
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+	// On Windows, os.Rename fails if the destination exists.
+	if runtime.GOOS == "windows" {
+		_ = os.Remove(s.Path())
+	}
+	if err := os.Rename(tmpPath, s.Path()); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
+
+// Tracker watches the requests a command makes to tell whether it failed
+// for lack of a network before any write could reach Basecamp — the
+// failures that are safe to retry by running the command again. A write
+// that got an answer, or that failed after its connection was made (a reset
+// or timeout while waiting for the reply), may already have been applied.
+type Tracker struct {
+	Inner http.RoundTripper
+
+	mu         sync.Mutex
+	netFailure bool
+	wrote      bool
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *Tracker) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.Inner.RoundTrip(req)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	mutating := audit.IsMutating(req.Method)
+	switch {
+	case err == nil:
+		// Any answer to a write may mean it was applied.
+		if mutating {
+			t.wrote = true
+		}
+	case mutating && !failedBeforeSending(err):
+		t.wrote = true
+	case req.Context().Err() == nil && !errors.Is(err, context.Canceled):
+		t.netFailure = true
+	}
+	return resp, err
+}
+
+// failedBeforeSending reports whether err means the request never left
+// this machine: the name didn't resolve or no connection could be opened.
+func failedBeforeSending(err error) bool {
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return true
+	}
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && (opErr.Op == "dial" || opErr.Op == "proxyconnect")
+}
+
+// Retryable reports whether a request failed on the network and no write
+// could have been applied.
+func (t *Tracker) Retryable() bool {
+	if t == nil {
+		return false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.netFailure && !t.wrote
+}
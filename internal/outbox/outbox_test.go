@@ -0,0 +1,230 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gofrs/flock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStoreAddUpdateRemove(t *testing.T) {
+	s := NewStore(t.TempDir())
+	assert.Empty(t, s.List())
+
+	first, err := s.Add(Entry{Args: []string{"todos", "create", "A"}, Account: "1", Attempts: 1})
+	require.NoError(t, err)
+	second, err := s.Add(Entry{Args: []string{"todos", "create", "B"}, Account: "1", Attempts: 1})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), first.ID)
+	assert.Equal(t, int64(2), second.ID)
+	assert.False(t, first.QueuedAt.IsZero())
+
+	second.Attempts = 2
+	second.Error = "Network error"
+	found, err := s.Update(second)
+	require.NoError(t, err)
+	assert.True(t, found)
+
+	found, err = s.Remove(first.ID)
+	require.NoError(t, err)
+	assert.True(t, found)
+	found, err = s.Remove(first.ID)
+	require.NoError(t, err)
+	assert.False(t, found)
+
+	// A fresh store over the same directory sees the persisted queue, and
+	// IDs are never reused while later entries remain.
+	s = NewStore(s.dir)
+	entries := s.List()
+	require.Len(t, entries, 1)
+	assert.Equal(t, []string{"todos", "create", "B"}, entries[0].Args)
+	assert.Equal(t, 2, entries[0].Attempts)
+	third, err := s.Add(Entry{Args: []string{"done", "3"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), third.ID)
+}
+
+func TestStoreFailsClosedWhenLocked(t *testing.T) {
+	s := NewStore(t.TempDir())
+	held := flock.New(filepath.Join(s.dir, ".lock"))
+	locked, err := held.TryLock()
+	require.NoError(t, err)
+	require.True(t, locked)
+	defer func() { _ = held.Unlock() }()
+
+	_, err = s.Add(Entry{Args: []string{"done", "1"}})
+	require.ErrorIs(t, err, ErrBusy)
+	assert.NoFileExists(t, s.Path(), "nothing is written without the lock")
+}
+
+func TestStoreLockFlushIsExclusive(t *testing.T) {
+	s := NewStore(t.TempDir())
+	unlock, err := s.LockFlush()
+	require.NoError(t, err)
+
+	_, err = NewStore(s.dir).LockFlush()
+	require.ErrorIs(t, err, ErrFlushing)
+
+	unlock()
+	unlock, err = NewStore(s.dir).LockFlush()
+	require.NoError(t, err)
+	unlock()
+}
+
+func TestStoreToleratesCorruptFile(t *testing.T) {
+	s := NewStore(t.TempDir())
+	require.NoError(t, os.WriteFile(s.Path(), []byte("{not json"), 0600))
+	assert.Empty(t, s.List())
+
+	e, err := s.Add(Entry{Args: []string{"done", "1"}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), e.ID)
+	assert.Len(t, s.List(), 1)
+}
+
+// stubTransport answers GETs and fails writes as configured. failGet and
+// failWrite fail to connect; failAfterSend loses the connection once the
+// request has been sent.
+type stubTransport struct {
+	failGet, failWrite, failAfterSend bool
+}
+
+func (s stubTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	fail := s.failGet
+	if req.Method != http.MethodGet {
+		fail = s.failWrite
+	}
+	if fail {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	}
+	if s.failAfterSend {
+		return nil, &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+	}
+	return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody, Request: req}, nil
+}
+
+// dnsFailTransport fails every request the way a lookup with no network does.
+type dnsFailTransport struct{}
+
+func (dnsFailTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, &net.OpError{Op: "dial", Net: "tcp", Err: &net.DNSError{Err: "no such host", Name: "3.basecampapi.com"}}
+}
+
+func roundTrip(ctx context.Context, t *testing.T, tr *Tracker, method string) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, method, "https://3.basecampapi.com/1/projects.json", nil)
+	require.NoError(t, err)
+	if resp, err := tr.RoundTrip(req); err == nil {
+		resp.Body.Close()
+	}
+}
+
+func TestTrackerRetryable(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("read fails before any write", func(t *testing.T) {
+		tr := &Tracker{Inner: stubTransport{failGet: true}}
+		roundTrip(ctx, t, tr, http.MethodGet)
+		assert.True(t, tr.Retryable())
+	})
+
+	t.Run("write fails without an answer", func(t *testing.T) {
+		tr := &Tracker{Inner: stubTransport{failWrite: true}}
+		roundTrip(ctx, t, tr, http.MethodGet)
+		roundTrip(ctx, t, tr, http.MethodPost)
+		assert.True(t, tr.Retryable())
+	})
+
+	t.Run("a write fails after it was sent", func(t *testing.T) {
+		tr := &Tracker{Inner: stubTransport{}}
+		roundTrip(ctx, t, tr, http.MethodGet)
+		tr.Inner = stubTransport{failAfterSend: true}
+		roundTrip(ctx, t, tr, http.MethodPost)
+		assert.False(t, tr.Retryable(), "the server may have applied the write before the reply was lost")
+	})
+
+	t.Run("a read fails after it was sent", func(t *testing.T) {
+		tr := &Tracker{Inner: stubTransport{failAfterSend: true}}
+		roundTrip(ctx, t, tr, http.MethodGet)
+		assert.True(t, tr.Retryable())
+	})
+
+	t.Run("a write fails to resolve the host", func(t *testing.T) {
+		tr := &Tracker{Inner: dnsFailTransport{}}
+		roundTrip(ctx, t, tr, http.MethodPost)
+		assert.True(t, tr.Retryable())
+	})
+
+	t.Run("a write got an answer", func(t *testing.T) {
+		tr := &Tracker{Inner: stubTransport{}}
+		roundTrip(ctx, t, tr, http.MethodPost)
+		tr.Inner = stubTransport{failWrite: true}
+		roundTrip(ctx, t, tr, http.MethodPut)
+		assert.False(t, tr.Retryable(), "the first write may have been applied")
+	})
+
+	t.Run("no failure", func(t *testing.T) {
+		tr := &Tracker{Inner: stubTransport{}}
+		roundTrip(ctx, t, tr, http.MethodGet)
+		assert.False(t, tr.Retryable())
+	})
+
+	t.Run("canceled request", func(t *testing.T) {
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+		tr := &Tracker{Inner: stubTransport{failGet: true}}
+		roundTrip(canceled, t, tr, http.MethodGet)
+		assert.False(t, tr.Retryable(), "an interrupted command is not a network failure")
+	})
+
+	t.Run("nil tracker", func(t *testing.T) {
+		var tr *Tracker
+		assert.False(t, tr.Retryable())
+	})
+}
+
+func TestTrackerWithRealConnections(t *testing.T) {
+	// The server reads the whole POST, then hangs up without answering.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.Copy(io.Discard, r.Body)
+		conn, _, err := w.(http.Hijacker).Hijack()
+		require.NoError(t, err)
+		conn.Close()
+	}))
+	defer srv.Close()
+
+	post := func(tr *Tracker, url string) {
+		req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"content":"x"}`))
+		require.NoError(t, err)
+		resp, err := tr.RoundTrip(req)
+		require.Error(t, err)
+		assert.Nil(t, resp)
+	}
+
+	t.Run("connection dropped after the POST was sent", func(t *testing.T) {
+		tr := &Tracker{Inner: &http.Transport{}}
+		post(tr, srv.URL)
+		assert.False(t, tr.Retryable())
+	})
+
+	t.Run("nothing listening", func(t *testing.T) {
+		ln, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		addr := ln.Addr().String()
+		ln.Close()
+
+		tr := &Tracker{Inner: &http.Transport{}}
+		post(tr, "http://"+addr)
+		assert.True(t, tr.Retryable())
+	})
+}
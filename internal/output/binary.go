@@ -0,0 +1,75 @@
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// CanonicalBinary is the command name suggested commands are written with
+// throughout the CLI.
+const CanonicalBinary = "basecamp"
+
+// knownBinaries are the names the CLI is installed under. bcq is the legacy
+// name, kept as an alias (see "basecamp migrate alias").
+var knownBinaries = map[string]bool{
+	CanonicalBinary: true,
+	"bcq":           true,
+}
+
+// InvokedBinary returns the name this process was started as, when it is one
+// of the names the CLI is installed under. Anything else (a dev build, go
+// run, a test binary) falls back to CanonicalBinary.
+func InvokedBinary() string {
+	return binaryName(os.Args[0])
+}
+
+func binaryName(arg0 string) string {
+	name := strings.TrimSuffix(filepath.Base(arg0), ".exe")
+	if knownBinaries[name] {
+		return name
+	}
+	return CanonicalBinary
+}
+
+// CommandFor rewrites a suggested command that starts with the canonical
+// binary name to start with bin, so it runs as printed.
+func CommandFor(cmd, bin string) string {
+	if bin == "" || bin == CanonicalBinary {
+		return cmd
+	}
+	if cmd == CanonicalBinary {
+		return bin
+	}
+	if rest, ok := strings.CutPrefix(cmd, CanonicalBinary+" "); ok {
+		return bin + " " + rest
+	}
+	return cmd
+}
+
+// commandMentionRe matches a "basecamp <subcommand>" mention in prose, such
+// as "Run: basecamp auth login" in an error hint. Capitalized "Basecamp" (the
+// product) never matches.
+var commandMentionRe = regexp.MustCompile("(^|[\\s:(`'\"])" + CanonicalBinary + "( [a-z-])")
+
+// commandsFor rewrites every command mention in text to use bin.
+func commandsFor(text, bin string) string {
+	if bin == "" || bin == CanonicalBinary || !strings.Contains(text, CanonicalBinary) {
+		return text
+	}
+	return commandMentionRe.ReplaceAllString(text, "${1}"+bin+"${2}")
+}
+
+// breadcrumbsFor returns crumbs with their commands rewritten for bin.
+func breadcrumbsFor(crumbs []Breadcrumb, bin string) []Breadcrumb {
+	if bin == "" || bin == CanonicalBinary || len(crumbs) == 0 {
+		return crumbs
+	}
+	out := make([]Breadcrumb, len(crumbs))
+	for i, c := range crumbs {
+		c.Cmd = CommandFor(c.Cmd, bin)
+		out[i] = c
+	}
+	return out
+}
@@ -0,0 +1,56 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBinaryName(t *testing.T) {
+	assert.Equal(t, "bcq", binaryName("/usr/local/bin/bcq"))
+	assert.Equal(t, "bcq", binaryName("bcq.exe"))
+	assert.Equal(t, "basecamp", binaryName("basecamp"))
+	assert.Equal(t, "basecamp", binaryName("/tmp/go-build123/exe/main"), "unknown names fall back")
+	assert.Equal(t, "basecamp", binaryName("output.test"))
+}
+
+func TestCommandFor(t *testing.T) {
+	assert.Equal(t, "bcq todos list", CommandFor("basecamp todos list", "bcq"))
+	assert.Equal(t, "bcq", CommandFor("basecamp", "bcq"))
+	assert.Equal(t, "basecamp todos list", CommandFor("basecamp todos list", "basecamp"))
+	assert.Equal(t, "open https://3.basecamp.com", CommandFor("open https://3.basecamp.com", "bcq"))
+	assert.Equal(t, "basecampx list", CommandFor("basecampx list", "bcq"))
+}
+
+func TestCommandsForRewritesOnlyCommandMentions(t *testing.T) {
+	assert.Equal(t,
+		"Run: bcq auth login (or `bcq setup`)",
+		commandsFor("Run: basecamp auth login (or `basecamp setup`)", "bcq"))
+	assert.Equal(t,
+		"Basecamp returned 404 for https://3.basecamp.com/x",
+		commandsFor("Basecamp returned 404 for https://3.basecamp.com/x", "bcq"))
+}
+
+func TestWriterPrintsCommandsForInvokedBinary(t *testing.T) {
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf, Binary: "bcq"})
+
+	require.NoError(t, w.OK(map[string]any{"id": 1},
+		WithNotice("Showing 10 of 20 — view all: basecamp todos list --all"),
+		WithBreadcrumbs(Breadcrumb{Action: "show", Cmd: "basecamp todos show 1", Description: "Show"}),
+	))
+	var resp Response
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "bcq todos show 1", resp.Breadcrumbs[0].Cmd)
+	assert.Equal(t, "Showing 10 of 20 — view all: bcq todos list --all", resp.Notice)
+	assert.Equal(t, "bcq todos show 1", w.LastBreadcrumbs()[0].Cmd)
+
+	buf.Reset()
+	require.NoError(t, w.Err(ErrAuth("Not authenticated")))
+	var errResp ErrorResponse
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &errResp))
+	assert.Equal(t, "Run: bcq auth login", errResp.Hint)
+}
@@ -28,5 +28,16 @@ const (
 	CodeAmbiguous = clioutput.CodeAmbiguous
 )
 
+// Interruption by Ctrl-C. The exit code follows the shell's 128+SIGINT.
+const (
+	ExitInterrupted = 130
+	CodeInterrupted = "interrupted"
+)
+
 // ExitCodeFor returns the exit code for a given error code.
-func ExitCodeFor(code string) int { return clioutput.ExitCodeFor(code) }
+func ExitCodeFor(code string) int {
+	if code == CodeInterrupted {
+		return ExitInterrupted
+	}
+	return clioutput.ExitCodeFor(code)
+}
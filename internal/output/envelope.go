@@ -9,6 +9,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/itchyny/gojq"
 
@@ -45,8 +46,14 @@ type Response struct {
 	DisplayData      any                       `json:"-"` // Alternate data for styled/markdown rendering (not serialized)
 	presenterOpts    []presenter.PresentOption // Display options for presenter (not serialized)
 	noticeDiagnostic bool                      // when true, emit Notice to stderr in quiet mode
+	outputFile       string                    // when set, Data is written here instead (--output-file)
+	styledView       StyledView                // when set, renders Data for styled output (not serialized)
 }
 
+// StyledView renders a response's data for styled output: r is styled for
+// the terminal, and what the view writes to w is shown in its place.
+type StyledView func(w io.Writer, r *Renderer)
+
 // Breadcrumb is a suggested follow-up action.
 type Breadcrumb struct {
 	Action      string `json:"action"`
@@ -83,6 +90,37 @@ type Options struct {
 	ErrWriter io.Writer // Diagnostic output (notices in quiet mode); defaults to os.Stderr.
 	Verbose   bool
 	JQFilter  string // jq expression to apply to JSON output (built-in via gojq)
+	Template  string // Go template applied to the data of each success response
+
+	// Columns selects list columns for styled and Markdown output (--columns).
+	// ColumnPrefs holds per-entity defaults from config; Columns wins.
+	Columns     []string
+	ColumnPrefs map[string][]string
+
+	// NumberBreadcrumbs prefixes styled hints with 1., 2., … so --interactive
+	// can offer them by number.
+	NumberBreadcrumbs bool
+
+	// Binary is the command name breadcrumbs and hints are printed with;
+	// empty means the name this process was invoked as (InvokedBinary).
+	Binary string
+
+	// Redact, when set, masks sensitive values in everything written
+	// (--redact).
+	Redact *Redactor
+
+	// Minimal drops the summary line and hints from styled and Markdown
+	// output, leaving the data and any notice (minimal_output config).
+	Minimal bool
+
+	// Emoji marks styled and Markdown summaries ✅, notices ⚠️, and errors
+	// ❌, for output pasted into chat (emoji config).
+	Emoji bool
+
+	// Capture, when set, receives each success response in place of
+	// writing it, so a caller can inspect what a command would print
+	// (--watch diffs successive runs this way).
+	Capture func(*Response)
 }
 
 // DefaultOptions returns options for standard output.
@@ -96,7 +134,58 @@ func DefaultOptions() Options {
 // Writer handles all output formatting.
 type Writer struct {
 	opts Options
-	jq   *gojq.Code // compiled jq filter, nil when JQFilter is empty
+	jq   *gojq.Code         // compiled jq filter, nil when JQFilter is empty
+	tmpl *template.Template // parsed Template, nil when Template is empty
+
+	lastBreadcrumbs []Breadcrumb // from the most recent OK response
+
+	listView    ListView
+	nextCommand string // picked in the list view
+}
+
+// ListView shows a list response in place of the styled list, for
+// --interactive on a terminal. It reports whether it showed the list and,
+// when the user picked a row, the command to run for it.
+type ListView func(resp *Response) (shown bool, next string, err error)
+
+// SetListView routes styled list responses through v.
+func (w *Writer) SetListView(v ListView) {
+	w.listView = v
+}
+
+// SetChrome sets Options.Minimal and Options.Emoji, which come from config
+// rather than flags and so apply whichever format the flags picked.
+func (w *Writer) SetChrome(minimal, emoji bool) {
+	w.opts.Minimal = minimal
+	w.opts.Emoji = emoji
+}
+
+// chrome applies Minimal and Emoji to a response about to be rendered for a
+// reader. Machine formats never pass through here, so JSON keeps the
+// summary and breadcrumbs verbatim.
+func (w *Writer) chrome(resp *Response) *Response {
+	if !w.opts.Minimal && !w.opts.Emoji {
+		return resp
+	}
+	cp := *resp
+	if w.opts.Minimal {
+		cp.Summary = ""
+		cp.Breadcrumbs = nil
+	}
+	if w.opts.Emoji {
+		if cp.Summary != "" {
+			cp.Summary = "✅ " + cp.Summary
+		}
+		if cp.Notice != "" {
+			cp.Notice = "⚠️ " + cp.Notice
+		}
+	}
+	return &cp
+}
+
+// NextCommand returns the command picked in the list view, if any.
+func (w *Writer) NextCommand() string {
+	return w.nextCommand
 }
 
 // New creates a new output writer.
@@ -109,6 +198,9 @@ func New(opts Options) *Writer {
 	if opts.ErrWriter == nil {
 		opts.ErrWriter = os.Stderr
 	}
+	if opts.Binary == "" {
+		opts.Binary = InvokedBinary()
+	}
 	w := &Writer{opts: opts}
 	if opts.JQFilter != "" {
 		q, err := gojq.Parse(opts.JQFilter)
@@ -123,6 +215,10 @@ func New(opts Options) *Writer {
 		// fallback writer built without early validation), writeJQ re-parses and
 		// returns the error on first use.
 	}
+	if opts.Template != "" {
+		// Same best-effort as jq: PersistentPreRunE rejects bad templates.
+		w.tmpl, _ = ParseTemplate(opts.Template)
+	}
 	return w
 }
 
@@ -149,9 +245,22 @@ func (w *Writer) OK(data any, opts ...ResponseOption) error {
 			return err
 		}
 	}
+	if w.opts.Capture != nil {
+		w.opts.Capture(resp)
+		return nil
+	}
+	resp.Breadcrumbs = breadcrumbsFor(resp.Breadcrumbs, w.opts.Binary)
+	resp.Notice = commandsFor(resp.Notice, w.opts.Binary)
+	w.lastBreadcrumbs = resp.Breadcrumbs
 	return w.write(resp)
 }
 
+// LastBreadcrumbs returns the breadcrumbs of the most recent OK response,
+// in the order they were rendered.
+func (w *Writer) LastBreadcrumbs() []Breadcrumb {
+	return w.lastBreadcrumbs
+}
+
 // Err outputs an error response.
 func (w *Writer) Err(err error, opts ...ErrorResponseOption) error {
 	e := AsError(err)
@@ -159,7 +268,7 @@ func (w *Writer) Err(err error, opts ...ErrorResponseOption) error {
 		OK:    false,
 		Error: e.Message,
 		Code:  e.Code,
-		Hint:  e.Hint,
+		Hint:  commandsFor(e.Hint, w.opts.Binary),
 	}
 	if requestID := RequestID(err); requestID != "" {
 		if resp.Meta == nil {
@@ -176,6 +285,16 @@ func (w *Writer) Err(err error, opts ...ErrorResponseOption) error {
 // ErrorResponseOption modifies an ErrorResponse.
 type ErrorResponseOption func(*ErrorResponse)
 
+// WithErrorMeta adds metadata to the error response.
+func WithErrorMeta(key string, value any) ErrorResponseOption {
+	return func(r *ErrorResponse) {
+		if r.Meta == nil {
+			r.Meta = make(map[string]any)
+		}
+		r.Meta[key] = value
+	}
+}
+
 // WithErrorStats adds session metrics to the error response metadata.
 func WithErrorStats(metrics *observability.SessionMetrics) ErrorResponseOption {
 	return func(r *ErrorResponse) {
@@ -199,6 +318,20 @@ func WithErrorStats(metrics *observability.SessionMetrics) ErrorResponseOption {
 }
 
 func (w *Writer) write(v any) error {
+	if w.opts.Redact != nil {
+		v = w.opts.Redact.redact(v)
+	}
+
+	// --output-file: the data goes to the file; stdout gets the rest of the
+	// envelope with a pointer to it.
+	if resp, ok := v.(*Response); ok && resp.outputFile != "" {
+		spilled, err := spillData(resp)
+		if err != nil {
+			return err
+		}
+		v = spilled
+	}
+
 	// In quiet mode (--agent/--quiet), surface diagnostic notices on stderr so
 	// automation consumers can detect degraded operations (e.g. unresolved
 	// mentions). Only notices marked as diagnostic emit here — informational
@@ -216,6 +349,12 @@ func (w *Writer) write(v any) error {
 		}
 	}
 
+	// --template: render the data of success responses; errors keep the
+	// envelope below so scripts can still detect them.
+	if resp, ok := v.(*Response); ok && w.opts.Template != "" {
+		return w.writeTemplate(resp)
+	}
+
 	// --jq flag: serialize to JSON, apply the jq filter, print results
 	if w.opts.JQFilter != "" {
 		return w.writeJQ(v)
@@ -232,6 +371,18 @@ func (w *Writer) write(v any) error {
 		}
 	}
 
+	if format == FormatStyled && w.listView != nil {
+		if resp, ok := v.(*Response); ok {
+			shown, next, err := w.listView(resp)
+			if shown {
+				// The view stands in for the numbered hints too.
+				w.lastBreadcrumbs = nil
+				w.nextCommand = next
+				return err
+			}
+		}
+	}
+
 	switch format {
 	case FormatQuiet:
 		if resp, ok := v.(*Response); ok {
@@ -513,6 +664,14 @@ func (w *Writer) writeCount(v any) error {
 
 // writeStyled outputs ANSI styled terminal output.
 func (w *Writer) writeStyled(v any) error {
+	if resp, ok := v.(*Response); ok {
+		v = w.chrome(resp)
+	}
+
+	if resp, ok := v.(*Response); ok && resp.styledView != nil {
+		return w.writeStyledView(resp)
+	}
+
 	// Schema-aware presenter is opt-in: only activates when a command
 	// explicitly sets WithEntity. This preserves the generic renderer as
 	// default and avoids surprising users when new schemas are added.
@@ -523,6 +682,8 @@ func (w *Writer) writeStyled(v any) error {
 	}
 
 	r := NewRenderer(w.opts.Writer, true) // Force styled
+	r.numberCrumbs = w.opts.NumberBreadcrumbs
+	r.emoji = w.opts.Emoji
 	switch resp := v.(type) {
 	case *Response:
 		return r.RenderResponse(w.opts.Writer, resp)
@@ -533,8 +694,40 @@ func (w *Writer) writeStyled(v any) error {
 	}
 }
 
+// writeStyledView renders a response through its WithStyledView view. The
+// view's text is rendered from the command's own data, so it is redacted
+// here as a whole.
+func (w *Writer) writeStyledView(resp *Response) error {
+	r := NewRenderer(w.opts.Writer, true)
+	r.numberCrumbs = w.opts.NumberBreadcrumbs
+
+	var out strings.Builder
+	resp.styledView(&out, r)
+
+	if notice := sanitizeText(resp.Notice, true, false); notice != "" {
+		out.WriteString("\n")
+		out.WriteString(r.Hint.Render(notice))
+		out.WriteString("\n")
+	}
+	if len(resp.Breadcrumbs) > 0 {
+		out.WriteString("\n")
+		r.renderBreadcrumbs(&out, resp.Breadcrumbs)
+	}
+
+	text := out.String()
+	if w.opts.Redact != nil {
+		text = w.opts.Redact.String(text)
+	}
+	_, err := io.WriteString(w.opts.Writer, text)
+	return err
+}
+
 // writeLiteralMarkdown outputs literal Markdown syntax (portable, pipeable).
 func (w *Writer) writeLiteralMarkdown(v any) error {
+	if resp, ok := v.(*Response); ok {
+		v = w.chrome(resp)
+	}
+
 	// Schema-aware presenter is opt-in (see writeStyled comment).
 	if resp, ok := v.(*Response); ok && resp.Entity != "" {
 		if w.presentMarkdownEntity(resp) {
@@ -543,6 +736,7 @@ func (w *Writer) writeLiteralMarkdown(v any) error {
 	}
 
 	r := NewMarkdownRenderer(w.opts.Writer)
+	r.emoji = w.opts.Emoji
 	switch resp := v.(type) {
 	case *Response:
 		return r.RenderResponse(w.opts.Writer, resp)
@@ -589,6 +783,11 @@ func WithoutBreadcrumbs() ResponseOption {
 	return func(r *Response) { r.Breadcrumbs = nil }
 }
 
+// WithoutContext removes the context section from the response.
+func WithoutContext() ResponseOption {
+	return func(r *Response) { r.Context = nil }
+}
+
 // WithContext adds context to the response.
 func WithContext(key string, value any) ResponseOption {
 	return func(r *Response) {
@@ -609,6 +808,56 @@ func WithMeta(key string, value any) ResponseOption {
 	}
 }
 
+// Pagination describes how much of a paginated list a response holds.
+type Pagination struct {
+	TotalCount int  // items on the server (X-Total-Count); 0 when not sent
+	Page       int  // requested page; 0 means from the first page on
+	Fetched    int  // items the API returned, before client-side filtering
+	Truncated  bool // more items remain (a Link next page, or a limit cut in)
+}
+
+// WithPagination adds total_count, page, fetched, and truncated to the
+// response metadata so scripts can tell whether they got everything.
+// Fetching from the first page is also truncated when total_count exceeds
+// fetched. Without an X-Total-Count, total_count is fetched for a complete
+// first-page-on list and left out otherwise.
+func WithPagination(p Pagination) ResponseOption {
+	return func(r *Response) {
+		if r.Meta == nil {
+			r.Meta = make(map[string]any)
+		}
+		firstPage := p.Page <= 1
+		truncated := p.Truncated || (firstPage && p.TotalCount > p.Fetched)
+		switch {
+		case p.TotalCount > 0:
+			r.Meta["total_count"] = p.TotalCount
+		case firstPage && !truncated:
+			r.Meta["total_count"] = p.Fetched
+		}
+		r.Meta["page"] = max(p.Page, 1)
+		r.Meta["fetched"] = p.Fetched
+		r.Meta["truncated"] = truncated
+	}
+}
+
+// WithInterrupted marks a response cut short by Ctrl-C: it holds what was
+// collected before the interrupt, so meta.truncated is true and
+// meta.interrupted says why.
+func WithInterrupted() ResponseOption {
+	return func(r *Response) {
+		if r.Meta == nil {
+			r.Meta = make(map[string]any)
+		}
+		r.Meta["truncated"] = true
+		r.Meta["interrupted"] = true
+		notice := "Interrupted: results are partial"
+		if r.Notice != "" {
+			notice = r.Notice + "; " + notice
+		}
+		r.Notice = notice
+	}
+}
+
 // WithStats adds session metrics to the response metadata.
 func WithStats(metrics *observability.SessionMetrics) ResponseOption {
 	return func(r *Response) {
@@ -645,6 +894,15 @@ func WithDisplayData(data any) ResponseOption {
 	return func(r *Response) { r.DisplayData = data }
 }
 
+// WithStyledView renders styled output with view instead of the generic
+// renderer, for data no presenter schema fits (trees, sync plans). The view
+// stands in for the summary and data; the notice and breadcrumbs follow it.
+// The response still goes through the writer, so --redact masks the view's
+// text and --jq, --template, and --output-file see the data as usual.
+func WithStyledView(view StyledView) ResponseOption {
+	return func(r *Response) { r.styledView = view }
+}
+
 // WithGroupBy overrides the schema's default group_by field for task list rendering.
 // For example, WithGroupBy("due_on") groups todos by due date instead of project.
 func WithGroupBy(field string) ResponseOption {
@@ -653,6 +911,21 @@ func WithGroupBy(field string) ResponseOption {
 	}
 }
 
+// columnOpts returns the response's presenter options plus the column
+// selection: the --columns flag if given, else the config preference for the
+// response's entity.
+func (w *Writer) columnOpts(resp *Response) []presenter.PresentOption {
+	opts := append([]presenter.PresentOption(nil), resp.presenterOpts...)
+	columns := w.opts.Columns
+	if len(columns) == 0 && resp.Entity != "" {
+		columns = w.opts.ColumnPrefs[resp.Entity]
+	}
+	if len(columns) > 0 {
+		opts = append(opts, presenter.WithColumns(columns...))
+	}
+	return opts
+}
+
 // presentStyledEntity attempts schema-aware rendering for styled output.
 // Returns true if the presenter handled it, false to fall back to generic.
 func (w *Writer) presentStyledEntity(resp *Response) bool {
@@ -663,12 +936,17 @@ func (w *Writer) presentStyledEntity(resp *Response) bool {
 	data := NormalizeData(src)
 	var buf strings.Builder
 
-	if !presenter.Present(&buf, data, resp.Entity, presenter.ModeStyled, resp.presenterOpts...) {
+	opts := w.columnOpts(resp)
+	if width, isTTY := terminalInfo(w.opts.Writer); isTTY {
+		opts = append(opts, presenter.WithWidth(width))
+	}
+	if !presenter.Present(&buf, data, resp.Entity, presenter.ModeStyled, opts...) {
 		return false
 	}
 
 	var out strings.Builder
 	r := NewRenderer(w.opts.Writer, true)
+	r.numberCrumbs = w.opts.NumberBreadcrumbs
 
 	// sanitizeText (single-line) defends against terminal injection from
 	// API-controlled summary/notice content and keeps each value on one line.
@@ -694,9 +972,9 @@ func (w *Writer) presentStyledEntity(resp *Response) bool {
 
 	out.WriteString(buf.String())
 
-	// Comments live on resp.Data, not on DisplayData (which may be set for
-	// chat_line etc.). The presenter only renders fields declared in YAML
-	// schemas, so comments must be appended separately.
+	// Comments and history live on resp.Data, not on DisplayData (which may
+	// be set for chat_line etc.). The presenter only renders fields declared
+	// in YAML schemas, so both must be appended separately.
 	if commentData, ok := NormalizeData(resp.Data).(map[string]any); ok {
 		if comments := topLevelComments(commentData); len(comments) > 0 {
 			out.WriteString("\n")
@@ -704,6 +982,12 @@ func (w *Writer) presentStyledEntity(resp *Response) bool {
 			out.WriteString("\n")
 			r.renderCommentsSection(&out, comments)
 		}
+		if history := topLevelHistory(commentData); len(history) > 0 {
+			out.WriteString("\n")
+			out.WriteString(r.Header.Render("History:"))
+			out.WriteString("\n")
+			r.renderHistorySection(&out, history)
+		}
 	}
 
 	if len(resp.Breadcrumbs) > 0 {
@@ -730,7 +1014,7 @@ func (w *Writer) presentMarkdownEntity(resp *Response) bool {
 	data := NormalizeData(src)
 	var buf strings.Builder
 
-	if !presenter.Present(&buf, data, resp.Entity, presenter.ModeMarkdown, resp.presenterOpts...) {
+	if !presenter.Present(&buf, data, resp.Entity, presenter.ModeMarkdown, w.columnOpts(resp)...) {
 		return false
 	}
 
@@ -756,12 +1040,16 @@ func (w *Writer) presentMarkdownEntity(resp *Response) bool {
 
 	out.WriteString(buf.String())
 
-	// Comments live on resp.Data (see styled presenter comment above).
+	// Comments and history live on resp.Data (see styled presenter comment above).
 	if commentData, ok := NormalizeData(resp.Data).(map[string]any); ok {
 		if comments := topLevelComments(commentData); len(comments) > 0 {
 			out.WriteString("\n## Comments\n\n")
 			mr.renderCommentsSection(&out, comments)
 		}
+		if history := topLevelHistory(commentData); len(history) > 0 {
+			out.WriteString("\n## History\n\n")
+			mr.renderHistorySection(&out, history)
+		}
 	}
 
 	if len(resp.Breadcrumbs) > 0 {
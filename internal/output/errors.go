@@ -64,6 +64,15 @@ func RequestID(err error) string {
 
 // App-specific error constructors with basecamp-cli hints.
 
+// ErrInterrupted reports a command stopped by Ctrl-C before it had anything
+// to show.
+func ErrInterrupted() *Error {
+	return &Error{
+		Code:    CodeInterrupted,
+		Message: "Interrupted",
+	}
+}
+
 func ErrAuth(msg string) *Error {
 	return &Error{
 		Code:    CodeAuth,
@@ -739,6 +739,25 @@ func TestWithMeta(t *testing.T) {
 	assert.Equal(t, 100, resp.Meta["total"])
 }
 
+func TestWithPagination(t *testing.T) {
+	meta := func(p Pagination) map[string]any {
+		resp := &Response{}
+		WithPagination(p)(resp)
+		return resp.Meta
+	}
+
+	assert.Equal(t, map[string]any{"total_count": 250, "page": 1, "fetched": 100, "truncated": true},
+		meta(Pagination{TotalCount: 250, Fetched: 100}), "a total above fetched is truncation")
+	assert.Equal(t, map[string]any{"total_count": 40, "page": 1, "fetched": 40, "truncated": false},
+		meta(Pagination{Fetched: 40}), "a complete list without X-Total-Count counts itself")
+	assert.Equal(t, map[string]any{"page": 1, "fetched": 10, "truncated": true},
+		meta(Pagination{Fetched: 10, Truncated: true}), "unknown total when cut short")
+	assert.Equal(t, map[string]any{"total_count": 250, "page": 2, "fetched": 50, "truncated": false},
+		meta(Pagination{TotalCount: 250, Page: 2, Fetched: 50}), "the last page is complete")
+	assert.Equal(t, map[string]any{"page": 3, "fetched": 50, "truncated": true},
+		meta(Pagination{Page: 3, Fetched: 50, Truncated: true}))
+}
+
 func TestWithStats(t *testing.T) {
 	startTime := time.Now().Add(-1 * time.Second)
 	endTime := time.Now()
@@ -1070,6 +1089,100 @@ func TestWriterMarkdownFormatBreadcrumbs(t *testing.T) {
 	assert.Contains(t, output, "basecamp show 1")
 }
 
+func TestWriterStyledNumbersBreadcrumbs(t *testing.T) {
+	crumbs := []Breadcrumb{
+		{Action: "show", Cmd: "basecamp show 1", Description: "View details"},
+		{Action: "complete", Cmd: "basecamp done 1", Description: "Complete"},
+	}
+
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatStyled, Writer: &buf, NumberBreadcrumbs: true})
+	require.NoError(t, w.OK(map[string]any{"id": 1}, WithBreadcrumbs(crumbs...)))
+	assert.Contains(t, buf.String(), "1. basecamp show 1")
+	assert.Contains(t, buf.String(), "2. basecamp done 1")
+	assert.Equal(t, crumbs, w.LastBreadcrumbs())
+
+	buf.Reset()
+	w = New(Options{Format: FormatStyled, Writer: &buf})
+	require.NoError(t, w.OK(map[string]any{"id": 1}, WithBreadcrumbs(crumbs...)))
+	assert.NotContains(t, buf.String(), "1. basecamp")
+}
+
+func TestWriterMinimalDropsSummaryAndHints(t *testing.T) {
+	crumbs := []Breadcrumb{{Action: "show", Cmd: "basecamp show 1", Description: "View details"}}
+
+	for _, format := range []Format{FormatStyled, FormatMarkdown} {
+		var buf bytes.Buffer
+		w := New(Options{Format: format, Writer: &buf, Minimal: true})
+		require.NoError(t, w.OK(map[string]any{"id": 1, "title": "Ship it"},
+			WithSummary("Todo #1"), WithNotice("heads up"), WithBreadcrumbs(crumbs...)))
+		out := buf.String()
+		assert.Contains(t, out, "Ship it")
+		assert.Contains(t, out, "heads up", "notices are not chrome")
+		assert.NotContains(t, out, "Todo #1")
+		assert.NotContains(t, out, "basecamp show 1")
+	}
+
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf, Minimal: true})
+	require.NoError(t, w.OK(map[string]any{"id": 1}, WithSummary("Todo #1"), WithBreadcrumbs(crumbs...)))
+	assert.Contains(t, buf.String(), `"summary": "Todo #1"`, "JSON keeps the full envelope")
+}
+
+func TestWriterEmojiMarkers(t *testing.T) {
+	for _, format := range []Format{FormatStyled, FormatMarkdown} {
+		var buf bytes.Buffer
+		w := New(Options{Format: format, Writer: &buf})
+		w.SetChrome(false, true)
+		require.NoError(t, w.OK(map[string]any{"id": 1}, WithSummary("Completed todo"), WithNotice("Showing 1 of 2")))
+		assert.Contains(t, buf.String(), "✅ Completed todo")
+		assert.Contains(t, buf.String(), "⚠️ Showing 1 of 2")
+
+		buf.Reset()
+		require.NoError(t, w.Err(ErrUsage("bad flag")))
+		assert.Contains(t, buf.String(), "❌")
+	}
+}
+
+func TestWriterListViewReplacesStyledOutput(t *testing.T) {
+	crumbs := []Breadcrumb{{Action: "show", Cmd: "basecamp show <id>", Description: "Show"}}
+
+	var buf bytes.Buffer
+	var seen *Response
+	w := New(Options{Format: FormatStyled, Writer: &buf})
+	w.SetListView(func(resp *Response) (bool, string, error) {
+		seen = resp
+		return true, "basecamp show 7", nil
+	})
+	require.NoError(t, w.OK([]map[string]any{{"id": 7}}, WithSummary("1 item"), WithBreadcrumbs(crumbs...)))
+
+	require.NotNil(t, seen)
+	assert.Equal(t, "1 item", seen.Summary)
+	assert.Empty(t, buf.String())
+	assert.Equal(t, "basecamp show 7", w.NextCommand())
+	assert.Empty(t, w.LastBreadcrumbs(), "the view replaces the numbered hints")
+}
+
+func TestWriterListViewFallsBackWhenNotShown(t *testing.T) {
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatStyled, Writer: &buf})
+	w.SetListView(func(*Response) (bool, string, error) { return false, "", nil })
+	require.NoError(t, w.OK(map[string]any{"id": 1}, WithSummary("One thing")))
+
+	assert.Contains(t, buf.String(), "One thing")
+	assert.Empty(t, w.NextCommand())
+
+	// Machine formats never reach the view.
+	buf.Reset()
+	w = New(Options{Format: FormatJSON, Writer: &buf})
+	w.SetListView(func(*Response) (bool, string, error) {
+		t.Fatal("list view called for JSON output")
+		return true, "", nil
+	})
+	require.NoError(t, w.OK([]map[string]any{{"id": 1}}))
+	assert.Contains(t, buf.String(), `"id": 1`)
+}
+
 func TestWriterMarkdownNoANSIWhenNotTTY(t *testing.T) {
 	var buf bytes.Buffer
 	w := New(Options{
@@ -4105,6 +4218,51 @@ func TestEntityPresenterRendersComments(t *testing.T) {
 	}
 }
 
+func TestRendersHistorySection(t *testing.T) {
+	data := map[string]any{
+		"id":    float64(42),
+		"title": "Buy milk",
+		"history": []any{
+			map[string]any{
+				"id":         float64(1),
+				"action":     "created",
+				"created_at": "2024-01-01T00:00:00Z",
+				"creator":    map[string]any{"name": "Annie Bryan"},
+			},
+			map[string]any{
+				"id":         float64(2),
+				"action":     "assignment_changed",
+				"created_at": "2024-01-02T00:00:00Z",
+				"creator":    map[string]any{"name": "Jason Fried"},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		name    string
+		format  Format
+		opts    []ResponseOption
+		heading string
+	}{
+		{"styled", FormatStyled, nil, "History:"},
+		{"markdown", FormatMarkdown, nil, "## History"},
+		{"styled_entity", FormatStyled, []ResponseOption{WithEntity("todo")}, "History:"},
+		{"markdown_entity", FormatMarkdown, []ResponseOption{WithEntity("todo")}, "## History"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := New(Options{Format: tc.format, Writer: &buf})
+			require.NoError(t, w.OK(data, tc.opts...))
+
+			out := ansi.Strip(buf.String())
+			assert.Contains(t, out, tc.heading)
+			assert.Contains(t, out, "Annie Bryan created")
+			assert.Contains(t, out, "Jason Fried assignment changed")
+			assert.Less(t, strings.Index(out, "Annie Bryan"), strings.Index(out, "Jason Fried"))
+		})
+	}
+}
+
 // =============================================================================
 // isCommentsArray Tests
 // =============================================================================
@@ -4485,3 +4643,63 @@ func TestAttachmentMetaSinksSanitized(t *testing.T) {
 	assertSinkNeutralized(t, render(FormatStyled), "styled attachment meta", true)
 	assertSinkNeutralized(t, render(FormatMarkdown), "markdown attachment meta", false)
 }
+
+func TestWithInterrupted(t *testing.T) {
+	resp := &Response{}
+	WithNotice("Showing 10 results")(resp)
+	WithInterrupted()(resp)
+
+	assert.Equal(t, true, resp.Meta["truncated"])
+	assert.Equal(t, true, resp.Meta["interrupted"])
+	assert.Equal(t, "Showing 10 results; Interrupted: results are partial", resp.Notice)
+}
+
+func TestErrInterruptedExitCode(t *testing.T) {
+	err := ErrInterrupted()
+	assert.Equal(t, CodeInterrupted, err.Code)
+	assert.Equal(t, ExitInterrupted, ExitCodeFor(err.Code))
+	assert.Equal(t, ExitUsage, ExitCodeFor(CodeUsage))
+}
+
+// =============================================================================
+// Template Tests
+// =============================================================================
+
+func TestWriterTemplatePerItem(t *testing.T) {
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf, Template: "{{.id}} {{.title}}"})
+
+	data := []map[string]any{
+		{"id": 1, "title": "Buy milk"},
+		{"id": 2, "title": "Ship feature"},
+	}
+	require.NoError(t, w.OK(data, WithSummary("2 todos")))
+	assert.Equal(t, "1 Buy milk\n2 Ship feature\n", buf.String())
+}
+
+func TestWriterTemplateSingleObjectAndFuncs(t *testing.T) {
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf, Template: "{{.creator.name}}: {{join .tags \", \"}}\n"})
+
+	data := map[string]any{"creator": map[string]any{"name": "Ann"}, "tags": []string{"a", "b"}}
+	require.NoError(t, w.OK(data))
+	assert.Equal(t, "Ann: a, b\n", buf.String(), "a template ending in a newline gets no second one")
+}
+
+func TestWriterTemplateLeavesErrorsAsJSON(t *testing.T) {
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf, Template: "{{.id}}"})
+
+	require.NoError(t, w.Err(ErrNotFound("todo", "1")))
+	var env map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &env))
+	assert.Equal(t, false, env["ok"])
+}
+
+func TestParseTemplateInvalid(t *testing.T) {
+	_, err := ParseTemplate("{{.id")
+	var e *Error
+	require.ErrorAs(t, err, &e)
+	assert.Equal(t, CodeUsage, e.Code)
+	assert.Contains(t, e.Message, "invalid --template")
+}
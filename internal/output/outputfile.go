@@ -0,0 +1,148 @@
+package output
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strings"
+)
+
+// Output file formats for --output-file.
+const (
+	OutputFileJSON  = "json"  // one JSON array
+	OutputFileJSONL = "jsonl" // one JSON value per line
+)
+
+// OutputFileFormat picks the --output-file format from the path: .jsonl and
+// .ndjson files get one item per line, anything else a JSON array.
+func OutputFileFormat(path string) string {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".jsonl", ".ndjson":
+		return OutputFileJSONL
+	default:
+		return OutputFileJSON
+	}
+}
+
+// WithOutputFile writes the response data to path instead of stdout; only
+// the summary, notices, and a pointer to the file are printed (--output-file).
+// The data is the command's finished result: the SDK fetches every page
+// before returning (see API-COVERAGE.md), so this keeps a large list off
+// the terminal, not out of memory.
+func WithOutputFile(path string) ResponseOption {
+	return func(r *Response) { r.outputFile = path }
+}
+
+// spillData writes resp.Data to its output file and returns a copy of the
+// response whose data describes the file instead.
+func spillData(resp *Response) (*Response, error) {
+	format := OutputFileFormat(resp.outputFile)
+	count, err := writeDataFile(resp.outputFile, resp.Data, format)
+	if err != nil {
+		return nil, fmt.Errorf("writing %s: %w", resp.outputFile, err)
+	}
+
+	c := *resp
+	c.Data = map[string]any{
+		"output_file": resp.outputFile,
+		"format":      format,
+		"count":       count,
+	}
+	c.Entity = ""
+	c.DisplayData = nil
+	c.presenterOpts = nil
+	c.styledView = nil
+	return &c, nil
+}
+
+// writeDataFile encodes data to path one item at a time, so no second,
+// serialized copy of a large list is built in memory. Lists are written
+// item by item; any other value counts as a single item. The file is
+// written to a temp file and renamed into place, so a failed write never
+// leaves a truncated file behind.
+func writeDataFile(path string, data any, format string) (int, error) {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return 0, err
+	}
+	tmpPath := tmp.Name()
+	defer func() { _ = os.Remove(tmpPath) }()
+
+	bw := bufio.NewWriter(tmp)
+	count, err := encodeItems(bw, data, format)
+	if err == nil {
+		err = bw.Flush()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	// On Windows, os.Rename fails if the destination exists.
+	if runtime.GOOS == "windows" {
+		_ = os.Remove(path)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+// encodeItems writes each item of data as JSON, either as array elements or
+// as lines.
+func encodeItems(w *bufio.Writer, data any, format string) (int, error) {
+	items := reflect.ValueOf(data)
+	isList := items.IsValid() && (items.Kind() == reflect.Slice || items.Kind() == reflect.Array) &&
+		items.Type().Elem().Kind() != reflect.Uint8 // json.RawMessage and []byte are single values
+	n := 0
+	switch {
+	case isList:
+		n = items.Len()
+	case items.IsValid():
+		n = 1
+	}
+
+	open, sep, end := "[\n", ",\n", "\n]\n"
+	if n == 0 {
+		open, end = "[", "]\n"
+	}
+	if format == OutputFileJSONL {
+		open, sep, end = "", "\n", "\n"
+		if n == 0 {
+			end = ""
+		}
+	}
+
+	if _, err := w.WriteString(open); err != nil {
+		return 0, err
+	}
+	for i := 0; i < n; i++ {
+		item := data
+		if isList {
+			item = items.Index(i).Interface()
+		}
+		b, err := json.Marshal(item)
+		if err != nil {
+			return 0, err
+		}
+		if i > 0 {
+			if _, err := w.WriteString(sep); err != nil {
+				return 0, err
+			}
+		}
+		if _, err := w.Write(b); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := w.WriteString(end); err != nil {
+		return 0, err
+	}
+	return n, nil
+}
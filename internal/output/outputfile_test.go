@@ -0,0 +1,105 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fileItem struct {
+	ID    int64  `json:"id"`
+	Title string `json:"title"`
+}
+
+func TestOutputFileFormat(t *testing.T) {
+	assert.Equal(t, OutputFileJSONL, OutputFileFormat("todos.jsonl"))
+	assert.Equal(t, OutputFileJSONL, OutputFileFormat("todos.NDJSON"))
+	assert.Equal(t, OutputFileJSON, OutputFileFormat("todos.json"))
+	assert.Equal(t, OutputFileJSON, OutputFileFormat("todos"))
+}
+
+func TestWriterOutputFileJSONArray(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.json")
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf})
+
+	items := []fileItem{{1, "One"}, {2, "Two <b>"}}
+	require.NoError(t, w.OK(items,
+		WithSummary("2 todos"),
+		WithEntity("todo"),
+		WithMeta("total_count", 2),
+		WithOutputFile(path),
+	))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var written []fileItem
+	require.NoError(t, json.Unmarshal(raw, &written))
+	assert.Equal(t, items, written)
+
+	var resp map[string]any
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &resp))
+	assert.Equal(t, "2 todos", resp["summary"])
+	assert.Equal(t, map[string]any{"output_file": path, "format": "json", "count": float64(2)}, resp["data"])
+	assert.Equal(t, map[string]any{"total_count": float64(2)}, resp["meta"])
+	assert.NotContains(t, buf.String(), "One", "items stay out of stdout")
+}
+
+func TestWriterOutputFileJSONL(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.jsonl")
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatQuiet, Writer: &buf})
+
+	require.NoError(t, w.OK([]fileItem{{1, "One"}, {2, "Two"}}, WithOutputFile(path)))
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(raw), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.JSONEq(t, `{"id":1,"title":"One"}`, lines[0])
+	assert.JSONEq(t, `{"id":2,"title":"Two"}`, lines[1])
+	assert.JSONEq(t, `{"output_file":"`+path+`","format":"jsonl","count":2}`, buf.String())
+}
+
+func TestWriterOutputFileEmptyList(t *testing.T) {
+	dir := t.TempDir()
+	w := New(Options{Format: FormatJSON, Writer: &bytes.Buffer{}})
+
+	require.NoError(t, w.OK([]fileItem{}, WithOutputFile(filepath.Join(dir, "out.json"))))
+	raw, err := os.ReadFile(filepath.Join(dir, "out.json"))
+	require.NoError(t, err)
+	assert.Equal(t, "[]\n", string(raw))
+
+	require.NoError(t, w.OK([]fileItem{}, WithOutputFile(filepath.Join(dir, "out.jsonl"))))
+	raw, err = os.ReadFile(filepath.Join(dir, "out.jsonl"))
+	require.NoError(t, err)
+	assert.Empty(t, raw)
+}
+
+func TestWriterOutputFileIsRedacted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "people.jsonl")
+	w := New(Options{Format: FormatJSON, Writer: &bytes.Buffer{}, Redact: NewRedactor([]string{"emails"})})
+
+	require.NoError(t, w.OK([]map[string]any{{"email_address": "alice@example.com"}}, WithOutputFile(path)))
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "alice@example.com")
+}
+
+func TestWriterOutputFileLeavesNoPartialFile(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "out.json")
+	w := New(Options{Format: FormatJSON, Writer: &bytes.Buffer{}})
+
+	err := w.OK([]any{1, func() {}}, WithOutputFile(path))
+	require.Error(t, err)
+	entries, readErr := os.ReadDir(dir)
+	require.NoError(t, readErr)
+	assert.Empty(t, entries, "a failed write must not leave the file or its temp behind")
+}
@@ -0,0 +1,156 @@
+package output
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces masked values in --redact output.
+const Redacted = "[redacted]"
+
+var (
+	emailRe = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+	urlRe   = regexp.MustCompile(`https?://[^\s"'<>()\[\]]+`)
+
+	// tokenSegmentRe matches URL path segments that carry a credential: long
+	// runs of base64/hex-ish characters, such as signed blob IDs in
+	// attachment download links.
+	tokenSegmentRe = regexp.MustCompile(`^[A-Za-z0-9_\-=]{24,}$`)
+)
+
+// Redactor masks sensitive values so a CLI transcript can be shared. It
+// works on copies; the data handed to the writer is never modified.
+type Redactor struct {
+	emails     bool
+	urls       bool
+	signedURLs bool
+	fields     map[string]bool
+}
+
+// NewRedactor builds a redactor from config redact rules: "emails", "urls",
+// "signed_urls", and "field:<name>". Unknown rules are ignored; config
+// validates them on load.
+func NewRedactor(rules []string) *Redactor {
+	r := &Redactor{fields: make(map[string]bool)}
+	for _, rule := range rules {
+		switch rule {
+		case "emails":
+			r.emails = true
+		case "urls":
+			r.urls = true
+		case "signed_urls":
+			r.signedURLs = true
+		default:
+			if name, ok := strings.CutPrefix(rule, "field:"); ok && name != "" {
+				r.fields[name] = true
+			}
+		}
+	}
+	return r
+}
+
+// String masks emails and URLs inside free text.
+func (r *Redactor) String(s string) string {
+	if r.urls {
+		s = urlRe.ReplaceAllString(s, Redacted)
+	} else if r.signedURLs {
+		s = urlRe.ReplaceAllStringFunc(s, redactSignedURL)
+	}
+	if r.emails {
+		s = emailRe.ReplaceAllString(s, Redacted)
+	}
+	return s
+}
+
+// redactSignedURL keeps a URL's host and ordinary path but drops its query
+// string and any token-like path segments.
+func redactSignedURL(u string) string {
+	if base, _, ok := strings.Cut(u, "?"); ok {
+		u = base + "?" + Redacted
+	}
+	scheme, rest, _ := strings.Cut(u, "://")
+	segs := strings.Split(rest, "/")
+	for i := 1; i < len(segs); i++ { // segs[0] is the host
+		seg, query, _ := strings.Cut(segs[i], "?")
+		if tokenSegmentRe.MatchString(seg) && strings.ContainsAny(seg, "0123456789") {
+			segs[i] = Redacted
+			if query != "" {
+				segs[i] += "?" + query
+			}
+		}
+	}
+	return scheme + "://" + strings.Join(segs, "/")
+}
+
+// Value returns a masked copy of normalized JSON data.
+func (r *Redactor) Value(v any) any {
+	switch t := v.(type) {
+	case map[string]any:
+		out := make(map[string]any, len(t))
+		for k, val := range t {
+			if r.fields[k] && val != nil {
+				out[k] = Redacted
+				continue
+			}
+			out[k] = r.Value(val)
+		}
+		return out
+	case []map[string]any:
+		out := make([]any, len(t))
+		for i, val := range t {
+			out[i] = r.Value(val)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, val := range t {
+			out[i] = r.Value(val)
+		}
+		return out
+	case string:
+		return r.String(t)
+	default:
+		return v
+	}
+}
+
+// redact returns a masked copy of a response envelope.
+func (r *Redactor) redact(v any) any {
+	switch resp := v.(type) {
+	case *Response:
+		c := *resp
+		c.Data = r.Value(NormalizeData(resp.Data))
+		if resp.DisplayData != nil {
+			c.DisplayData = r.Value(NormalizeData(resp.DisplayData))
+		}
+		c.Summary = r.String(resp.Summary)
+		c.Notice = r.String(resp.Notice)
+		if resp.Breadcrumbs != nil {
+			c.Breadcrumbs = make([]Breadcrumb, len(resp.Breadcrumbs))
+			for i, bc := range resp.Breadcrumbs {
+				bc.Cmd = r.String(bc.Cmd)
+				bc.Description = r.String(bc.Description)
+				c.Breadcrumbs[i] = bc
+			}
+		}
+		c.Context = r.mapValue(resp.Context)
+		c.Meta = r.mapValue(resp.Meta)
+		return &c
+	case *ErrorResponse:
+		c := *resp
+		c.Error = r.String(resp.Error)
+		c.Hint = r.String(resp.Hint)
+		c.Meta = r.mapValue(resp.Meta)
+		return &c
+	default:
+		return r.Value(NormalizeData(v))
+	}
+}
+
+func (r *Redactor) mapValue(m map[string]any) map[string]any {
+	if m == nil {
+		return nil
+	}
+	out, _ := r.Value(m).(map[string]any)
+	return out
+}
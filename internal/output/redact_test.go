@@ -0,0 +1,92 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const signedURL = "https://storage.3.basecamp.com/123/blobs/eyJfcmFpbHMiOnsibWVzc2FnZSI6IkJBaHBCQT09In19--9f1c2b3a/download/report.pdf?disposition=attachment&expires=1700000000"
+
+func TestRedactorString(t *testing.T) {
+	r := NewRedactor([]string{"emails", "signed_urls"})
+	assert.Equal(t, "Assigned to [redacted]", r.String("Assigned to alice@example.com"))
+	assert.Equal(t,
+		"https://storage.3.basecamp.com/123/blobs/[redacted]/download/report.pdf?[redacted]",
+		r.String(signedURL))
+	assert.Equal(t, "https://3.basecamp.com/123/buckets/456/todos/789",
+		r.String("https://3.basecamp.com/123/buckets/456/todos/789"), "plain app URLs are kept")
+
+	all := NewRedactor([]string{"urls"})
+	assert.Equal(t, "see [redacted] now", all.String("see https://3.basecamp.com/123 now"))
+	assert.Equal(t, "alice@example.com", all.String("alice@example.com"), "only the listed rules apply")
+}
+
+func TestRedactorValueCopies(t *testing.T) {
+	r := NewRedactor([]string{"emails", "field:attachable_sgid"})
+	data := map[string]any{
+		"id":              json.Number("42"),
+		"email_address":   "alice@example.com",
+		"attachable_sgid": "BAh7CEkiCGdpZAY6BkVU",
+		"creator":         map[string]any{"name": "Bob <bob@example.com>"},
+		"assignees":       []any{map[string]any{"attachable_sgid": nil}},
+	}
+
+	got := r.Value(data).(map[string]any)
+	assert.Equal(t, "[redacted]", got["email_address"])
+	assert.Equal(t, "[redacted]", got["attachable_sgid"])
+	assert.Equal(t, "Bob <[redacted]>", got["creator"].(map[string]any)["name"])
+	assert.Nil(t, got["assignees"].([]any)[0].(map[string]any)["attachable_sgid"], "null stays null")
+	assert.Equal(t, json.Number("42"), got["id"])
+
+	assert.Equal(t, "alice@example.com", data["email_address"], "input must not be modified")
+}
+
+func TestWriterRedactsStyledView(t *testing.T) {
+	type doc struct {
+		Title string `json:"title"`
+	}
+	data := doc{"Notes from alice@example.com"}
+	view := WithStyledView(func(w io.Writer, r *Renderer) {
+		fmt.Fprintln(w, r.Summary.Render(data.Title))
+	})
+
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatStyled, Writer: &buf, Redact: NewRedactor([]string{"emails"})})
+	require.NoError(t, w.OK(data, view, WithNotice("Shared with alice@example.com")))
+	assert.Contains(t, buf.String(), "Notes from "+Redacted)
+	assert.NotContains(t, buf.String(), "alice@example.com")
+
+	buf.Reset()
+	w = New(Options{Format: FormatStyled, Writer: &buf, Template: "{{.title}}"})
+	require.NoError(t, w.OK(data, view))
+	assert.Equal(t, "Notes from alice@example.com\n", buf.String(), "--template renders the data, not the view")
+}
+
+func TestWriterRedactsEnvelope(t *testing.T) {
+	var buf bytes.Buffer
+	w := New(Options{Format: FormatJSON, Writer: &buf, Redact: NewRedactor([]string{"emails"})})
+
+	type person struct {
+		Name  string `json:"name"`
+		Email string `json:"email_address"`
+	}
+	require.NoError(t, w.OK(person{"Alice", "alice@example.com"},
+		WithSummary("Found alice@example.com"),
+		WithBreadcrumbs(Breadcrumb{Action: "show", Cmd: "basecamp people show alice@example.com"}),
+	))
+	out := buf.String()
+	assert.NotContains(t, out, "alice@example.com")
+	assert.Contains(t, out, `"name": "Alice"`)
+	assert.Equal(t, "basecamp people show alice@example.com", w.LastBreadcrumbs()[0].Cmd,
+		"--interactive still runs the real command")
+
+	buf.Reset()
+	require.NoError(t, w.Err(ErrUsageHint("no access for bob@example.com", "Ask bob@example.com")))
+	assert.NotContains(t, buf.String(), "bob@example.com")
+}
@@ -22,8 +22,10 @@ import (
 
 // Renderer handles styled terminal output.
 type Renderer struct {
-	width  int
-	styled bool // whether to emit ANSI styling
+	width        int
+	styled       bool // whether to emit ANSI styling
+	numberCrumbs bool // prefix hints with 1., 2., … (--interactive)
+	emoji        bool // ❌ instead of ✗ on errors (emoji config)
 
 	// Text styles
 	Summary lipgloss.Style
@@ -176,6 +178,9 @@ func (r *Renderer) RenderError(w io.Writer, resp *ErrorResponse) error {
 	if r.styled {
 		// Create a styled error box with border
 		errorIcon := "✗"
+		if r.emoji {
+			errorIcon = "❌"
+		}
 		errorTitle := errorIcon + " Error"
 
 		// Wrap error message to fit in box (accounting for border and padding)
@@ -222,6 +227,9 @@ func (r *Renderer) RenderError(w io.Writer, resp *ErrorResponse) error {
 		b.WriteString("\n")
 	} else {
 		// Plain text output (no styling)
+		if r.emoji {
+			b.WriteString("❌ ")
+		}
 		b.WriteString("Error: " + errMsg)
 		b.WriteString("\n")
 
@@ -704,19 +712,28 @@ var skipObjectColumns = map[string]bool{
 }
 
 func topLevelComments(data map[string]any) []map[string]any {
-	comments, ok := data["comments"]
+	return topLevelMaps(data, "comments")
+}
+
+// topLevelHistory returns the recording events injected by --history.
+func topLevelHistory(data map[string]any) []map[string]any {
+	return topLevelMaps(data, "history")
+}
+
+func topLevelMaps(data map[string]any, key string) []map[string]any {
+	value, ok := data[key]
 	if !ok {
 		return nil
 	}
 
-	switch v := comments.(type) {
+	switch v := value.(type) {
 	case []map[string]any:
 		return v
 	case []any:
 		return toMapSlice(v)
 	}
 
-	normalized := NormalizeData(comments)
+	normalized := NormalizeData(value)
 	switch v := normalized.(type) {
 	case []map[string]any:
 		return v
@@ -731,15 +748,19 @@ func topLevelComments(data map[string]any) []map[string]any {
 // empty arrays). Used to decide whether to suppress the raw field — non-array
 // comment values (string, int) from `basecamp api get` should render as fields.
 func isCommentsArray(data map[string]any) bool {
-	comments, ok := data["comments"]
+	return isArrayField(data, "comments")
+}
+
+func isArrayField(data map[string]any, key string) bool {
+	value, ok := data[key]
 	if !ok {
 		return false
 	}
-	switch comments.(type) {
+	switch value.(type) {
 	case []map[string]any, []any:
 		return true
 	}
-	normalized := NormalizeData(comments)
+	normalized := NormalizeData(value)
 	switch normalized.(type) {
 	case []map[string]any, []any:
 		return true
@@ -837,6 +858,17 @@ func commentBody(comment map[string]any) string {
 	return strings.TrimSpace(sanitizeText(content, false, true))
 }
 
+// historyLine describes a recording event as "<who> <action>", turning the
+// API's snake_case action ("assignment_changed") into words.
+func historyLine(event map[string]any) string {
+	action, _ := event["action"].(string)
+	action = strings.ReplaceAll(sanitizeText(action, true, false), "_", " ")
+	if action == "" {
+		action = "changed"
+	}
+	return commentCreatorName(event) + " " + action
+}
+
 func (r *Renderer) renderCommentsSection(b *strings.Builder, comments []map[string]any) {
 	for i, comment := range comments {
 		if i > 0 {
@@ -866,6 +898,16 @@ func (r *Renderer) renderCommentsSection(b *strings.Builder, comments []map[stri
 	}
 }
 
+func (r *Renderer) renderHistorySection(b *strings.Builder, events []map[string]any) {
+	for _, event := range events {
+		line := r.Data.Render("- " + historyLine(event))
+		if timestamp := commentTimestamp(event); timestamp != "" {
+			line += r.Muted.Render(" — " + timestamp)
+		}
+		b.WriteString(line + "\n")
+	}
+}
+
 func (r *Renderer) renderAttachmentSections(b *strings.Builder, sections []attachmentSection) {
 	for i, section := range sections {
 		if i > 0 {
@@ -886,13 +928,15 @@ func (r *Renderer) renderAttachmentSections(b *strings.Builder, sections []attac
 func (r *Renderer) renderObject(b *strings.Builder, data map[string]any) {
 	comments := topLevelComments(data)
 	commentsIsArray := isCommentsArray(data)
+	history := topLevelHistory(data)
+	historyIsArray := isArrayField(data, "history")
 	attachmentSections := topLevelAttachmentSections(data)
 
 	// Collect fields with priority ordering
 	var fields []renderField
 
 	for k := range data {
-		if (k == "comments" && commentsIsArray) || k == "content_attachments" || k == "description_attachments" || skipObjectColumns[k] {
+		if (k == "comments" && commentsIsArray) || (k == "history" && historyIsArray) || k == "content_attachments" || k == "description_attachments" || skipObjectColumns[k] {
 			continue
 		}
 		// Skip nested objects
@@ -915,7 +959,7 @@ func (r *Renderer) renderObject(b *strings.Builder, data map[string]any) {
 		return fields[i].key < fields[j].key
 	})
 
-	if len(fields) == 0 && len(attachmentSections) == 0 && len(comments) == 0 {
+	if len(fields) == 0 && len(attachmentSections) == 0 && len(comments) == 0 && len(history) == 0 {
 		b.WriteString(r.Muted.Render("(no data)"))
 		b.WriteString("\n")
 		return
@@ -967,6 +1011,15 @@ func (r *Renderer) renderObject(b *strings.Builder, data map[string]any) {
 		b.WriteString("\n")
 		r.renderCommentsSection(b, comments)
 	}
+
+	if len(history) > 0 {
+		if len(fields) > 0 || len(attachmentSections) > 0 || len(comments) > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(r.Header.Render("History:"))
+		b.WriteString("\n")
+		r.renderHistorySection(b, history)
+	}
 }
 
 func (r *Renderer) renderList(b *strings.Builder, data []any) {
@@ -979,8 +1032,12 @@ func (r *Renderer) renderList(b *strings.Builder, data []any) {
 func (r *Renderer) renderBreadcrumbs(b *strings.Builder, crumbs []Breadcrumb) {
 	b.WriteString(r.Muted.Render("Hints:"))
 	b.WriteString("\n")
-	for _, bc := range crumbs {
-		line := r.Data.Render("  " + sanitizeText(bc.Cmd, true, false))
+	for i, bc := range crumbs {
+		prefix := "  "
+		if r.numberCrumbs {
+			prefix = fmt.Sprintf("  %d. ", i+1)
+		}
+		line := r.Data.Render(prefix + sanitizeText(bc.Cmd, true, false))
 		if bc.Description != "" {
 			line += r.Subtle.Render("  # " + sanitizeText(bc.Description, true, false))
 		}
@@ -1168,6 +1225,7 @@ func formatDateValue(key string, val any) string {
 		// Date-only: return formatted date
 		return t.Format("Jan 2, 2006")
 	}
+	t = t.Local()
 
 	// Full timestamp: show relative time for recent dates, otherwise formatted date
 	now := time.Now()
@@ -1207,6 +1265,7 @@ func formatDateValue(key string, val any) string {
 // MarkdownRenderer outputs literal Markdown syntax (portable, pipeable).
 type MarkdownRenderer struct {
 	width int
+	emoji bool // ❌ before errors (emoji config)
 }
 
 // NewMarkdownRenderer creates a renderer for literal Markdown output.
@@ -1272,6 +1331,9 @@ func (r *MarkdownRenderer) RenderError(w io.Writer, resp *ErrorResponse) error {
 
 	// Error and hint interpolate API-controlled strings; sanitize for the
 	// terminal (see (*Renderer).RenderError).
+	if r.emoji {
+		b.WriteString("❌ ")
+	}
 	b.WriteString("**Error:** " + sanitizeText(resp.Error, false, true) + "\n")
 	if hint := sanitizeText(resp.Hint, false, true); hint != "" {
 		b.WriteString("\n*Hint: " + hint + "*\n")
@@ -1425,6 +1487,16 @@ func (r *MarkdownRenderer) renderCommentsSection(b *strings.Builder, comments []
 	}
 }
 
+func (r *MarkdownRenderer) renderHistorySection(b *strings.Builder, events []map[string]any) {
+	for _, event := range events {
+		line := "- " + historyLine(event)
+		if timestamp := commentTimestamp(event); timestamp != "" {
+			line += " — " + timestamp
+		}
+		b.WriteString(line + "\n")
+	}
+}
+
 func (r *MarkdownRenderer) renderAttachmentSections(b *strings.Builder, sections []attachmentSection) {
 	for i, section := range sections {
 		if i > 0 {
@@ -1444,13 +1516,15 @@ func (r *MarkdownRenderer) renderAttachmentSections(b *strings.Builder, sections
 func (r *MarkdownRenderer) renderObject(b *strings.Builder, data map[string]any) {
 	comments := topLevelComments(data)
 	commentsIsArray := isCommentsArray(data)
+	history := topLevelHistory(data)
+	historyIsArray := isArrayField(data, "history")
 	attachmentSections := topLevelAttachmentSections(data)
 
 	// Collect fields with priority ordering (same as styled renderer)
 	var fields []renderField
 
 	for k := range data {
-		if (k == "comments" && commentsIsArray) || k == "content_attachments" || k == "description_attachments" || skipObjectColumns[k] {
+		if (k == "comments" && commentsIsArray) || (k == "history" && historyIsArray) || k == "content_attachments" || k == "description_attachments" || skipObjectColumns[k] {
 			continue
 		}
 		// Skip nested objects
@@ -1473,7 +1547,7 @@ func (r *MarkdownRenderer) renderObject(b *strings.Builder, data map[string]any)
 		return fields[i].key < fields[j].key
 	})
 
-	if len(fields) == 0 && len(attachmentSections) == 0 && len(comments) == 0 {
+	if len(fields) == 0 && len(attachmentSections) == 0 && len(comments) == 0 && len(history) == 0 {
 		b.WriteString("*No data*\n")
 		return
 	}
@@ -1498,6 +1572,14 @@ func (r *MarkdownRenderer) renderObject(b *strings.Builder, data map[string]any)
 		b.WriteString("## Comments\n\n")
 		r.renderCommentsSection(b, comments)
 	}
+
+	if len(history) > 0 {
+		if len(fields) > 0 || len(attachmentSections) > 0 || len(comments) > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString("## History\n\n")
+		r.renderHistorySection(b, history)
+	}
 }
 
 func (r *MarkdownRenderer) renderList(b *strings.Builder, data []any) {
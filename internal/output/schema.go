@@ -0,0 +1,238 @@
+package output
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+//go:embed envelope.schema.json
+var envelopeSchema []byte
+
+// EnvelopeSchema returns the JSON Schema (draft 2020-12) for the success and
+// error envelopes written by --json output. It ships with the binary so
+// downstream tooling can validate against the exact contract it runs with.
+func EnvelopeSchema() []byte {
+	return bytes.Clone(envelopeSchema)
+}
+
+// ValidateEnvelope checks one JSON document against EnvelopeSchema.
+//
+// Only the keywords the envelope schema uses are implemented, which keeps the
+// contract enforceable without a schema library. A keyword the validator
+// doesn't know is reported as an error rather than silently skipped, so the
+// schema can't grow rules that go unchecked.
+func ValidateEnvelope(doc []byte) error {
+	var schema map[string]any
+	if err := json.Unmarshal(envelopeSchema, &schema); err != nil {
+		return fmt.Errorf("envelope schema: %w", err)
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(doc))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return fmt.Errorf("not a JSON document: %w", err)
+	}
+	if dec.More() {
+		return errors.New("more than one JSON document")
+	}
+
+	return schemaValidator{root: schema}.validate(schema, v, "$")
+}
+
+// schemaAnnotations are keywords that document rather than constrain.
+var schemaAnnotations = map[string]bool{
+	"$schema":     true,
+	"$defs":       true,
+	"title":       true,
+	"description": true,
+	"examples":    true,
+}
+
+type schemaValidator struct {
+	root map[string]any
+}
+
+func (s schemaValidator) validate(schema map[string]any, v any, path string) error {
+	keywords := make([]string, 0, len(schema))
+	for kw := range schema {
+		keywords = append(keywords, kw)
+	}
+	sort.Strings(keywords)
+
+	for _, kw := range keywords {
+		arg := schema[kw]
+		var err error
+		switch kw {
+		case "$ref":
+			err = s.validateRef(arg, v, path)
+		case "oneOf":
+			err = s.validateOneOf(arg, v, path)
+		case "type":
+			if !hasJSONType(v, arg.(string)) {
+				err = fmt.Errorf("%s: want %s, got %s", path, arg, jsonTypeOf(v))
+			}
+		case "const":
+			if !reflect.DeepEqual(v, arg) {
+				err = fmt.Errorf("%s: want %v, got %v", path, arg, v)
+			}
+		case "required":
+			if obj, ok := v.(map[string]any); ok {
+				for _, name := range arg.([]any) {
+					if _, present := obj[name.(string)]; !present {
+						err = fmt.Errorf("%s: missing required field %q", path, name)
+						break
+					}
+				}
+			}
+		case "properties":
+			err = s.validateProperties(arg.(map[string]any), v, path)
+		case "additionalProperties":
+			err = validateAdditionalProperties(schema, arg, v, path)
+		case "items":
+			if arr, ok := v.([]any); ok {
+				for i, item := range arr {
+					if err = s.validate(arg.(map[string]any), item, fmt.Sprintf("%s[%d]", path, i)); err != nil {
+						break
+					}
+				}
+			}
+		case "minLength":
+			if str, ok := v.(string); ok && utf8.RuneCountInString(str) < schemaInt(arg) {
+				err = fmt.Errorf("%s: shorter than %d characters", path, schemaInt(arg))
+			}
+		case "minItems":
+			if arr, ok := v.([]any); ok && len(arr) < schemaInt(arg) {
+				err = fmt.Errorf("%s: fewer than %d items", path, schemaInt(arg))
+			}
+		default:
+			if !schemaAnnotations[kw] {
+				err = fmt.Errorf("%s: unsupported schema keyword %q", path, kw)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s schemaValidator) validateRef(arg, v any, path string) error {
+	name, ok := strings.CutPrefix(arg.(string), "#/$defs/")
+	if !ok {
+		return fmt.Errorf("%s: unsupported $ref %q", path, arg)
+	}
+	defs, _ := s.root["$defs"].(map[string]any)
+	def, ok := defs[name].(map[string]any)
+	if !ok {
+		return fmt.Errorf("%s: unknown $ref %q", path, arg)
+	}
+	return s.validate(def, v, path)
+}
+
+func (s schemaValidator) validateOneOf(arg, v any, path string) error {
+	var errs []string
+	matched := 0
+	for _, sub := range arg.([]any) {
+		if err := s.validate(sub.(map[string]any), v, path); err != nil {
+			errs = append(errs, err.Error())
+		} else {
+			matched++
+		}
+	}
+	switch matched {
+	case 1:
+		return nil
+	case 0:
+		return fmt.Errorf("%s: matches no allowed shape (%s)", path, strings.Join(errs, "; "))
+	default:
+		return fmt.Errorf("%s: matches %d shapes, want exactly one", path, matched)
+	}
+}
+
+func (s schemaValidator) validateProperties(props map[string]any, v any, path string) error {
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		value, present := obj[name]
+		if !present {
+			continue
+		}
+		if err := s.validate(props[name].(map[string]any), value, path+"."+name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateAdditionalProperties(schema map[string]any, arg, v any, path string) error {
+	allowed, ok := arg.(bool)
+	if !ok {
+		return fmt.Errorf("%s: unsupported additionalProperties %v", path, arg)
+	}
+	if allowed {
+		return nil
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil
+	}
+	props, _ := schema["properties"].(map[string]any)
+	var extra []string
+	for name := range obj {
+		if _, known := props[name]; !known {
+			extra = append(extra, name)
+		}
+	}
+	if len(extra) > 0 {
+		sort.Strings(extra)
+		return fmt.Errorf("%s: unexpected field(s) %s", path, strings.Join(extra, ", "))
+	}
+	return nil
+}
+
+func hasJSONType(v any, want string) bool {
+	got := jsonTypeOf(v)
+	return got == want || (want == "number" && got == "integer")
+}
+
+func jsonTypeOf(v any) string {
+	switch v := v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case json.Number:
+		if strings.ContainsAny(v.String(), ".eE") {
+			return "number"
+		}
+		return "integer"
+	case []any:
+		return "array"
+	case map[string]any:
+		return "object"
+	}
+	return fmt.Sprintf("%T", v)
+}
+
+// schemaInt reads a numeric keyword argument decoded from the schema.
+func schemaInt(arg any) int {
+	f, _ := arg.(float64)
+	return int(f)
+}
@@ -0,0 +1,131 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/observability"
+)
+
+// jsonFields returns the serialized field names of a struct type.
+func jsonFields(t reflect.Type) []string {
+	var names []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func schemaProperties(t *testing.T, def string) []string {
+	t.Helper()
+	var schema struct {
+		Defs map[string]struct {
+			Properties map[string]any `json:"properties"`
+		} `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(EnvelopeSchema(), &schema))
+	var names []string
+	for name := range schema.Defs[def].Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestEnvelopeSchemaMatchesTypes keeps the published schema in step with the
+// structs that are actually serialized.
+func TestEnvelopeSchemaMatchesTypes(t *testing.T) {
+	assert.Equal(t, jsonFields(reflect.TypeFor[Response]()), schemaProperties(t, "success"))
+	assert.Equal(t, jsonFields(reflect.TypeFor[ErrorResponse]()), schemaProperties(t, "error"))
+	assert.Equal(t, jsonFields(reflect.TypeFor[Breadcrumb]()), schemaProperties(t, "breadcrumb"))
+}
+
+// TestWriterEnvelopesMatchSchema validates every envelope shape the Writer
+// can produce. Commands only emit JSON through Writer.OK and Writer.Err, so
+// this covers the envelope of every command.
+func TestWriterEnvelopesMatchSchema(t *testing.T) {
+	metrics := &observability.SessionMetrics{
+		StartTime:     time.Now().Add(-time.Second),
+		EndTime:       time.Now(),
+		TotalRequests: 3,
+	}
+
+	tests := []struct {
+		name  string
+		write func(w *Writer) error
+	}{
+		{"bare", func(w *Writer) error { return w.OK(nil) }},
+		{"list", func(w *Writer) error { return w.OK([]map[string]any{{"id": int64(1) << 60}}) }},
+		{"every option", func(w *Writer) error {
+			return w.OK(map[string]any{"id": 1, "name": "Launch"},
+				WithSummary("Project Launch"),
+				WithNotice("Showing 1 of 2"),
+				WithBreadcrumbs(Breadcrumb{Action: "show", Cmd: "basecamp projects show 1", Description: "Show project"}),
+				WithContext("project_id", "1"),
+				WithMeta("page", 1),
+				WithStats(metrics),
+				WithEntity("project"),
+				WithDisplayData([]string{"Launch"}),
+			)
+		}},
+		{"diagnostic", func(w *Writer) error { return w.OK("done", WithDiagnostic("1 mention unresolved")) }},
+		{"error", func(w *Writer) error { return w.Err(ErrNotFound("project", "42")) }},
+		{"usage error with hint and stats", func(w *Writer) error {
+			return w.Err(ErrUsageHint("Invalid project ID", "Use a numeric ID"), WithErrorStats(metrics))
+		}},
+		{"plain error", func(w *Writer) error { return w.Err(assert.AnError) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := New(Options{Format: FormatJSON, Writer: &buf})
+			require.NoError(t, tt.write(w))
+			assert.NoError(t, ValidateEnvelope(buf.Bytes()), buf.String())
+		})
+	}
+}
+
+func TestValidateEnvelopeRejects(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"not JSON", `ok`, "not a JSON document"},
+		{"not an object", `[1]`, "matches no allowed shape"},
+		{"missing ok", `{"data": 1}`, `missing required field "ok"`},
+		{"unknown field", `{"ok": true, "items": []}`, "unexpected field(s) items"},
+		{"error fields on success", `{"ok": true, "error": "boom", "code": "api_error"}`, "unexpected field(s) code, error"},
+		{"error without code", `{"ok": false, "error": "boom"}`, `missing required field "code"`},
+		{"empty code", `{"ok": false, "error": "boom", "code": ""}`, "$.code: shorter than 1 characters"},
+		{"incomplete breadcrumb", `{"ok": true, "breadcrumbs": [{"action": "show", "description": "x"}]}`, `$.breadcrumbs[0]: missing required field "cmd"`},
+		{"summary type", `{"ok": true, "summary": 5}`, "$.summary: want string, got integer"},
+		{"two documents", `{"ok": true} {"ok": true}`, "more than one JSON document"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateEnvelope([]byte(tt.doc))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.want)
+		})
+	}
+}
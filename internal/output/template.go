@@ -0,0 +1,83 @@
+package output
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// templateFuncs are the helpers available to --template beyond Go's
+// built-ins.
+var templateFuncs = template.FuncMap{
+	// json renders a value as compact JSON, for nested fields.
+	"json": func(v any) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+	// join joins a list with sep: {{join .tags ", "}}.
+	"join": func(v any, sep string) string {
+		items, _ := v.([]any)
+		parts := make([]string, 0, len(items))
+		for _, item := range items {
+			parts = append(parts, fmt.Sprint(item))
+		}
+		return strings.Join(parts, sep)
+	},
+}
+
+// ParseTemplate parses a --template string. Fields are the JSON names of
+// the data ({{.id}}, {{.creator.name}}).
+func ParseTemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("output").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return nil, ErrUsage(fmt.Sprintf("invalid --template: %s", err))
+	}
+	return tmpl, nil
+}
+
+// writeTemplate renders a response's data through the --template. A list
+// runs the template once per item; anything else runs it once. Each run
+// ends with a newline unless the template supplied one.
+func (w *Writer) writeTemplate(resp *Response) error {
+	tmpl := w.tmpl
+	if tmpl == nil {
+		var err error
+		if tmpl, err = ParseTemplate(w.opts.Template); err != nil {
+			return err
+		}
+	}
+
+	// Round-trip through JSON so fields are addressed by their JSON names.
+	raw, err := json.Marshal(NormalizeData(resp.Data))
+	if err != nil {
+		return fmt.Errorf("template: marshal: %w", err)
+	}
+	var data any
+	if err := json.Unmarshal(raw, &data); err != nil {
+		return fmt.Errorf("template: unmarshal: %w", err)
+	}
+
+	// Like --jq, sanitize only on a terminal; piped output passes verbatim.
+	if isTTY(w.opts.Writer) {
+		data = sanitizeJSONValue(data)
+	}
+
+	items, ok := data.([]any)
+	if !ok {
+		items = []any{data}
+	}
+	var buf bytes.Buffer
+	for _, item := range items {
+		start := buf.Len()
+		if err := tmpl.Execute(&buf, item); err != nil {
+			return ErrUsage(fmt.Sprintf("template error: %s", err))
+		}
+		if buf.Len() == start || buf.Bytes()[buf.Len()-1] != '\n' {
+			buf.WriteByte('\n')
+		}
+	}
+	_, err = w.opts.Writer.Write(buf.Bytes())
+	return err
+}
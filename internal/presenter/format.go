@@ -15,7 +15,7 @@ import (
 func FormatField(spec FieldSpec, key string, val any, locale Locale) string {
 	switch spec.Format {
 	case "boolean":
-		return formatBoolean(spec, val)
+		return formatBoolean(spec, val, locale)
 	case "date":
 		return formatDate(val, locale)
 	case "relative_time":
@@ -86,17 +86,18 @@ func stepPosition(m map[string]any) int {
 	return 1<<31 - 1
 }
 
-// formatBoolean converts a boolean to a label from the spec, or "yes"/"no".
-func formatBoolean(spec FieldSpec, val any) string {
+// formatBoolean converts a boolean to a label from the spec, or "yes"/"no",
+// translated for the locale.
+func formatBoolean(spec FieldSpec, val any, locale Locale) string {
 	b := toBool(val)
 	key := fmt.Sprintf("%v", b)
 	if label, ok := spec.Labels[key]; ok {
-		return label
+		return locale.T(label)
 	}
 	if b {
-		return "yes"
+		return locale.T("yes")
 	}
-	return "no"
+	return locale.T("no")
 }
 
 // formatDate formats a date string using the locale's preferred date layout.
@@ -111,9 +112,9 @@ func formatDate(val any, locale Locale) string {
 	// CR/newlines/tabs too — a malformed value can't break the row.
 	str = richtext.SanitizeSingleLine(str)
 
-	// Try ISO8601 full timestamp
+	// Try ISO8601 full timestamp, shown on its local calendar day
 	if t, err := time.Parse(time.RFC3339, str); err == nil {
-		return locale.FormatDate(t)
+		return locale.FormatDate(t.Local())
 	}
 	// Try date-only
 	if t, err := time.Parse("2006-01-02", str); err == nil {
@@ -136,7 +137,9 @@ func formatRelativeTime(val any, locale Locale) string {
 	str = richtext.SanitizeSingleLine(str)
 
 	t, err := time.Parse(time.RFC3339, str)
-	if err != nil {
+	if err == nil {
+		t = t.Local()
+	} else {
 		// Try date-only
 		t, err = time.Parse("2006-01-02", str)
 		if err != nil {
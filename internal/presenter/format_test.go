@@ -364,3 +364,28 @@ func TestFormatRelativeTimeCollapsesWhitespaceInUnparseableInput(t *testing.T) {
 	}
 	assertSingleLine(t, "formatRelativeTime", got)
 }
+
+func TestFormatDateUsesLocalZone(t *testing.T) {
+	orig := time.Local
+	t.Cleanup(func() { time.Local = orig })
+	loc, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("zone database unavailable")
+	}
+	time.Local = loc
+
+	locale := NewLocale("")
+	// 23:30 UTC is already the next day in Berlin.
+	got := formatDate("2024-03-04T23:30:00Z", locale)
+	want := locale.FormatDate(time.Date(2024, 3, 5, 0, 30, 0, 0, loc))
+	if got != want {
+		t.Errorf("formatDate(late UTC timestamp) = %q, want %q", got, want)
+	}
+
+	// Date-only values have no zone and stay on their day.
+	got = formatDate("2024-03-04", locale)
+	want = locale.FormatDate(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC))
+	if got != want {
+		t.Errorf("formatDate(date-only) = %q, want %q", got, want)
+	}
+}
@@ -11,20 +11,26 @@ import (
 	"golang.org/x/text/number"
 )
 
-// Locale holds resolved formatting conventions for dates and numbers.
+// Locale holds resolved formatting conventions for dates and numbers, and
+// the message catalog for labels (nil for English).
 // It supports separate tags for date and number formatting to honor
 // POSIX category-specific overrides (LC_TIME vs LC_NUMERIC).
 type Locale struct {
-	dateTag language.Tag
-	numTag  language.Tag
-	printer *message.Printer
+	dateTag  language.Tag
+	numTag   language.Tag
+	printer  *message.Printer
+	messages map[string]string
 }
 
 // DetectLocale resolves the user's locale from environment variables.
 // Respects the POSIX precedence: LC_ALL overrides everything, then
 // category-specific variables (LC_TIME for dates, LC_NUMERIC for numbers),
 // then LANG as fallback. Falls back to en-US if nothing is set.
+// Labels stay in English unless --locale chose a language (see SetLocale).
 func DetectLocale() Locale {
+	if localeOverride != "" {
+		return NewLocale(localeOverride).WithMessages(localeOverride)
+	}
 	dateRaw := localeEnv("LC_TIME")
 	numRaw := localeEnv("LC_NUMERIC")
 	return NewLocaleSplit(dateRaw, numRaw)
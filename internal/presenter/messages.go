@@ -0,0 +1,79 @@
+package presenter
+
+import (
+	"embed"
+	"strings"
+	"sync"
+
+	"golang.org/x/text/language"
+	"gopkg.in/yaml.v3"
+)
+
+// Message catalogs translate the labels the presenter prints — field
+// labels, section headings, value labels, and affordance descriptions.
+// Each catalog maps the English label to its translation; labels a catalog
+// lacks stay in English.
+//
+//go:embed locales/*.yaml
+var localesFS embed.FS
+
+var (
+	catalogsOnce sync.Once
+	catalogs     map[string]map[string]string // "de" → English → German
+)
+
+// localeOverride is the --locale value; see SetLocale.
+var localeOverride string
+
+// SetLocale makes presenter output use raw (a POSIX locale or BCP 47 tag)
+// for labels as well as date and number formats, instead of the locale
+// detected from the environment. Call it during startup.
+func SetLocale(raw string) {
+	localeOverride = raw
+}
+
+func loadCatalogs() {
+	catalogs = make(map[string]map[string]string)
+	entries, err := localesFS.ReadDir("locales")
+	if err != nil {
+		return
+	}
+	for _, entry := range entries {
+		data, err := localesFS.ReadFile("locales/" + entry.Name())
+		if err != nil {
+			continue
+		}
+		var messages map[string]string
+		if yaml.Unmarshal(data, &messages) != nil {
+			continue
+		}
+		catalogs[strings.TrimSuffix(entry.Name(), ".yaml")] = messages
+	}
+}
+
+// catalogFor returns the catalog for tag: an exact match (pt-BR) first,
+// then its base language (pt).
+func catalogFor(tag language.Tag) map[string]string {
+	catalogsOnce.Do(loadCatalogs)
+	if messages, ok := catalogs[tag.String()]; ok {
+		return messages
+	}
+	base, _ := tag.Base()
+	return catalogs[base.String()]
+}
+
+// WithMessages returns l with labels translated for raw. Without a catalog
+// for raw, labels stay in English.
+func (l Locale) WithMessages(raw string) Locale {
+	l.messages = catalogFor(parseTag(raw))
+	return l
+}
+
+// T translates an English label, returning it unchanged when there is no
+// translation.
+func (l Locale) T(label string) string {
+	if translated, ok := l.messages[label]; ok && label != "" {
+		return translated
+	}
+	return label
+}
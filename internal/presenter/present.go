@@ -18,7 +18,9 @@ const (
 type PresentOption func(*presentOpts)
 
 type presentOpts struct {
-	groupBy string // overrides schema's markdown group_by
+	groupBy string   // overrides schema's markdown group_by
+	columns []string // overrides schema's list columns
+	width   int      // terminal width for styled lists; 0 = unbounded
 }
 
 // WithGroupBy overrides the schema's default group_by field for task list rendering.
@@ -26,6 +28,19 @@ func WithGroupBy(field string) PresentOption {
 	return func(o *presentOpts) { o.groupBy = field }
 }
 
+// WithColumns selects and orders the list columns. Names the schema doesn't
+// know are ignored; if none are known, the schema's defaults apply.
+func WithColumns(columns ...string) PresentOption {
+	return func(o *presentOpts) { o.columns = columns }
+}
+
+// WithWidth bounds styled list rows to the given terminal width. Trailing
+// non-title columns are dropped until rows fit, so narrow terminals lose
+// whole columns instead of wrapping mid-field.
+func WithWidth(width int) PresentOption {
+	return func(o *presentOpts) { o.width = width }
+}
+
 func buildOpts(opts []PresentOption) presentOpts {
 	var o presentOpts
 	for _, fn := range opts {
@@ -63,11 +78,11 @@ func presentWith(w io.Writer, data any, schema *EntitySchema, theme tui.Theme, l
 	case ModeMarkdown:
 		return presentMarkdown(w, data, schema, locale, opts)
 	default:
-		return presentStyled(w, data, schema, theme, locale)
+		return presentStyled(w, data, schema, theme, locale, opts)
 	}
 }
 
-func presentStyled(w io.Writer, data any, schema *EntitySchema, theme tui.Theme, locale Locale) bool {
+func presentStyled(w io.Writer, data any, schema *EntitySchema, theme tui.Theme, locale Locale, opts presentOpts) bool {
 	styles := NewStyles(theme, true)
 
 	switch d := data.(type) {
@@ -77,7 +92,7 @@ func presentStyled(w io.Writer, data any, schema *EntitySchema, theme tui.Theme,
 		}
 		return true
 	case []map[string]any:
-		if err := RenderList(w, schema, d, styles, locale); err != nil {
+		if err := renderList(w, schema, d, styles, locale, opts); err != nil {
 			return false
 		}
 		return true
@@ -93,7 +108,7 @@ func presentMarkdown(w io.Writer, data any, schema *EntitySchema, locale Locale,
 		}
 		return true
 	case []map[string]any:
-		if err := RenderListMarkdown(w, schema, d, locale, opts.groupBy); err != nil {
+		if err := renderListMarkdown(w, schema, d, locale, opts); err != nil {
 			return false
 		}
 		return true
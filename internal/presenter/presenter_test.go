@@ -1,6 +1,8 @@
 package presenter
 
 import (
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 	"time"
@@ -1004,6 +1006,89 @@ func TestRenderDetailMarkdown(t *testing.T) {
 	}
 }
 
+func TestRenderDetailMarkdownGerman(t *testing.T) {
+	schema := LookupByName("todo")
+	if schema == nil {
+		t.Fatal("Expected todo schema")
+	}
+
+	data := map[string]any{
+		"id":        float64(12345),
+		"content":   "Fix the login bug",
+		"completed": true,
+		"due_on":    "2026-01-15",
+	}
+
+	var buf strings.Builder
+	if err := RenderDetailMarkdown(&buf, schema, data, NewLocale("de-DE").WithMessages("de-DE")); err != nil {
+		t.Fatalf("RenderDetailMarkdown failed: %v", err)
+	}
+	out := buf.String()
+
+	for _, want := range []string{
+		"- **Fällig:** 15. Jan 2026",
+		"- **Erledigt:** erledigt",
+		"#### Metadaten",
+		"#### Hinweise",
+		"`basecamp todos uncomplete 12345` — Wieder öffnen",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("German detail should contain %q, got:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderDetailTranslatedLabelsAlign(t *testing.T) {
+	schema := LookupByName("todo")
+	data := map[string]any{
+		"id":        float64(12345),
+		"content":   "Fix the login bug",
+		"completed": true,
+		"due_on":    "2026-01-15",
+		"assignees": []any{map[string]any{"name": "Alice"}},
+	}
+
+	var buf strings.Builder
+	if err := RenderDetail(&buf, schema, data, NewStyles(tui.NoColorTheme(), false), NewLocale("fr").WithMessages("fr")); err != nil {
+		t.Fatalf("RenderDetail failed: %v", err)
+	}
+	out := buf.String()
+
+	// Labels pad by character, not byte, so "Échéance" lines up.
+	if !strings.Contains(out, "  Responsables  Alice") || !strings.Contains(out, "  Échéance      ") {
+		t.Errorf("French labels should be aligned, got:\n%s", out)
+	}
+	if !strings.Contains(out, "Rouvrir") {
+		t.Errorf("French detail should translate hints, got:\n%s", out)
+	}
+}
+
+func TestLocaleWithoutCatalogKeepsEnglish(t *testing.T) {
+	locale := NewLocale("ja-JP").WithMessages("ja-JP")
+	if got := locale.T("Due"); got != "Due" {
+		t.Errorf("T(Due) = %q, want Due", got)
+	}
+	if got := enUS.T("Hints"); got != "Hints" {
+		t.Errorf("T(Hints) = %q, want Hints", got)
+	}
+	// Region-specific tags fall back to the base language's catalog.
+	if got := NewLocale("es_MX.UTF-8").WithMessages("es_MX.UTF-8").T("No results"); got != "Sin resultados" {
+		t.Errorf("T(No results) = %q, want Sin resultados", got)
+	}
+}
+
+func TestSetLocaleOverridesEnvironment(t *testing.T) {
+	t.Setenv("LC_ALL", "en_US.UTF-8")
+	SetLocale("de")
+	t.Cleanup(func() { SetLocale("") })
+
+	var buf strings.Builder
+	Present(&buf, []map[string]any{}, "project", ModeMarkdown)
+	if !strings.Contains(buf.String(), "*Keine Ergebnisse*") {
+		t.Errorf("--locale de should translate the empty list, got:\n%s", buf.String())
+	}
+}
+
 func TestRenderDetailSkipsEmptySections(t *testing.T) {
 	schema := LookupByName("todo")
 	require.NotNil(t, schema)
@@ -2254,7 +2339,7 @@ func TestRenderTableMarkdownHTMLCell(t *testing.T) {
 	}
 
 	var buf strings.Builder
-	if err := renderTableMarkdown(&buf, schema, data, enUS); err != nil {
+	if err := renderTableMarkdown(&buf, schema, data, enUS, nil); err != nil {
 		t.Fatalf("renderTableMarkdown failed: %v", err)
 	}
 
@@ -2405,7 +2490,7 @@ func TestMessageSchemaLoads(t *testing.T) {
 	assert.Equal(t, "Message", schema.TypeKey)
 
 	// List columns
-	assert.Equal(t, []string{"id", "subject", "creator", "created_at"}, schema.Views.List.Columns)
+	assert.Equal(t, []string{"id", "subject", "creator", "created_at", "comments_count", "last_comment_at"}, schema.Views.List.Columns)
 
 	// No affordances — commands supply breadcrumbs
 	assert.Empty(t, schema.Actions, "affordances must be empty to avoid duplicate hints")
@@ -2465,3 +2550,173 @@ func TestMessageRenderDetailHeadline(t *testing.T) {
 	assert.Contains(t, out, "Weekly update", "detail should show subject as headline")
 	assert.Contains(t, out, "Here is the update", "detail should show HTML-stripped content")
 }
+
+// =============================================================================
+// Column Selection Tests
+// =============================================================================
+
+func TestListColumnsOverride(t *testing.T) {
+	schema := LookupByName("todo")
+	require.NotNil(t, schema)
+
+	// Caller order wins; "title" resolves to the label field; unknown and
+	// duplicate names are dropped.
+	assert.Equal(t, []string{"due_on", "content", "assignees"},
+		listColumns(schema, []string{"due_on", "title", "bogus", "content", "assignees"}))
+
+	// Nothing usable falls back to the schema's list columns.
+	assert.Equal(t, schema.Views.List.Columns, listColumns(schema, []string{"bogus"}))
+	assert.Equal(t, schema.Views.List.Columns, listColumns(schema, nil))
+}
+
+func TestRenderListWithColumns(t *testing.T) {
+	schema := LookupByName("todo")
+	require.NotNil(t, schema)
+
+	data := []map[string]any{
+		{"id": float64(7), "content": "Fix bug", "completed": false, "due_on": "2026-02-01", "assignees": []any{}},
+	}
+	styles := NewStyles(tui.NoColorTheme(), false)
+
+	var buf strings.Builder
+	require.NoError(t, renderList(&buf, schema, data, styles, enUS, presentOpts{columns: []string{"id", "title"}}))
+
+	assert.Equal(t, "7  Fix bug\n", buf.String())
+}
+
+func TestRenderListWidthDropsTrailingColumns(t *testing.T) {
+	schema := LookupByName("todo")
+	require.NotNil(t, schema)
+
+	data := []map[string]any{
+		{"id": float64(7), "content": "Fix the login bug", "completed": false, "due_on": "2026-02-01",
+			"assignees": []any{map[string]any{"name": "Alice Smith"}}},
+		{"id": float64(8), "content": "Ship", "completed": false, "due_on": "", "assignees": []any{}},
+	}
+	styles := NewStyles(tui.NoColorTheme(), false)
+	opts := presentOpts{columns: []string{"title", "id", "assignees"}}
+
+	var wide strings.Builder
+	require.NoError(t, renderList(&wide, schema, data, styles, enUS, opts))
+	assert.Contains(t, wide.String(), "Alice Smith")
+
+	// Too narrow for assignees: the column is dropped for every row, not
+	// truncated, and the title survives.
+	opts.width = 22
+	var narrow strings.Builder
+	require.NoError(t, renderList(&narrow, schema, data, styles, enUS, opts))
+	lines := strings.Split(strings.TrimRight(narrow.String(), "\n"), "\n")
+	require.Len(t, lines, 2)
+	assert.Equal(t, "Fix the login bug  7", lines[0])
+	assert.NotContains(t, narrow.String(), "Alice")
+
+	// Narrower than the title itself: the title is never dropped.
+	opts.width = 5
+	narrow.Reset()
+	require.NoError(t, renderList(&narrow, schema, data, styles, enUS, opts))
+	assert.Equal(t, "Fix the login bug\nShip\n", narrow.String())
+}
+
+func TestRenderListMarkdownWithColumns(t *testing.T) {
+	project := LookupByName("project")
+	require.NotNil(t, project)
+
+	var buf strings.Builder
+	require.NoError(t, renderListMarkdown(&buf, project, []map[string]any{
+		{"id": float64(5), "name": "Alpha"},
+	}, enUS, presentOpts{columns: []string{"name", "id"}}))
+	assert.True(t, strings.HasPrefix(buf.String(), "| Name | Id |"), "got:\n%s", buf.String())
+
+	// Task lists keep their shape; columns pick the inline metadata.
+	todo := LookupByName("todo")
+	require.NotNil(t, todo)
+	buf.Reset()
+	require.NoError(t, renderListMarkdown(&buf, todo, []map[string]any{
+		{"id": float64(7), "content": "Fix bug", "completed": false, "due_on": "2026-02-01",
+			"assignees": []any{map[string]any{"name": "Alice"}}},
+	}, enUS, presentOpts{columns: []string{"title", "assignees"}}))
+	assert.Contains(t, buf.String(), "- [ ] Fix bug")
+	assert.Contains(t, buf.String(), "@Alice")
+	assert.NotContains(t, buf.String(), "due:")
+	assert.NotContains(t, buf.String(), "#7")
+}
+
+// =============================================================================
+// User Schema Tests
+// =============================================================================
+
+// withUserSchemas points the registry at a temp dir holding the given files
+// and restores the embedded-only registry afterwards.
+func withUserSchemas(t *testing.T, files map[string]string) {
+	t.Helper()
+	dir := t.TempDir()
+	for name, content := range files {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+	SetUserSchemaDir(dir)
+	t.Cleanup(func() { SetUserSchemaDir("") })
+}
+
+func TestUserSchemaMergesOverEmbedded(t *testing.T) {
+	withUserSchemas(t, map[string]string{
+		"todo.json": `{
+			"headline": {"default": {"template": "TODO {{.content}}"}},
+			"fields": {"content": {"role": "title", "emphasis": "warning", "format": "text"}},
+			"views": {"list": {"columns": ["content", "due_on"]}}
+		}`,
+	})
+
+	schema := LookupByName("todo")
+	require.NotNil(t, schema)
+	assert.Equal(t, []string{"content", "due_on"}, schema.Views.List.Columns)
+	assert.Equal(t, "warning", schema.Fields["content"].Emphasis)
+	assert.Equal(t, "TODO {{.content}}", schema.Headline["default"].Template)
+
+	// Untouched parts of the embedded schema survive the merge.
+	assert.Equal(t, "people", schema.Fields["assignees"].Format)
+	assert.Equal(t, "[done] {{.content}}", schema.Headline["completed"].Template)
+	require.NotNil(t, schema.Views.List.Markdown)
+	assert.Equal(t, "tasklist", schema.Views.List.Markdown.Style)
+	assert.Same(t, schema, LookupByTypeKey("Todo"))
+
+	loaded, skipped := UserSchemas()
+	assert.Equal(t, []string{"todo"}, loaded)
+	assert.Empty(t, skipped)
+}
+
+func TestUserSchemaAddsEntity(t *testing.T) {
+	withUserSchemas(t, map[string]string{
+		"card.json": `{
+			"type_key": "Kanban::Card",
+			"identity": {"label": "title", "id": "id"},
+			"fields": {"title": {"role": "title"}, "id": {"role": "meta"}},
+			"views": {"list": {"columns": ["id", "title"]}}
+		}`,
+	})
+
+	schema := Detect([]map[string]any{{"type": "Kanban::Card", "id": float64(1), "title": "Card"}}, "")
+	require.NotNil(t, schema)
+	assert.Equal(t, "card", schema.Entity, "entity defaults to the file name")
+	assert.Same(t, schema, LookupByName("card"))
+}
+
+func TestUserSchemaSkipsInvalidFiles(t *testing.T) {
+	withUserSchemas(t, map[string]string{
+		"broken.json": `{"entity": `,
+		"typo.json":   `{"entity": "todo", "views": {"list": {"colums": ["id"]}}}`,
+		"empty.json":  `{"entity": "widget"}`,
+		"notes.txt":   `ignored`,
+	})
+
+	loaded, skipped := UserSchemas()
+	assert.Empty(t, loaded)
+	require.Len(t, skipped, 3)
+	assert.Contains(t, skipped[0].Error(), "broken.json")
+	assert.Contains(t, skipped[1].Error(), "empty.json")
+	assert.Contains(t, skipped[2].Error(), "typo.json")
+
+	// A rejected override leaves the embedded schema in place.
+	schema := LookupByName("todo")
+	require.NotNil(t, schema)
+	assert.Contains(t, schema.Views.List.Columns, "assignees")
+}
@@ -1,8 +1,15 @@
 package presenter
 
 import (
+	"bytes"
 	"embed"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
 	"sync"
 
 	"gopkg.in/yaml.v3"
@@ -20,6 +27,24 @@ type Registry struct {
 	byName  map[string]*EntitySchema // "todo" → schema
 	byType  map[string]*EntitySchema // "Todo" → schema
 	loadErr error
+
+	userDir     string   // directory of user schema overrides (*.json)
+	userLoaded  []string // entity names loaded or overridden from userDir
+	userSkipped []error  // user schema files that failed to load
+}
+
+// SetUserSchemaDir points the registry at a directory of user-provided
+// schemas (*.json). It resets the registry, so call it during startup before
+// the first lookup.
+func SetUserSchemaDir(dir string) {
+	registry = &Registry{userDir: dir}
+}
+
+// UserSchemas reports the entity names loaded from the user schema directory
+// and the files that were skipped, with the reason.
+func UserSchemas() (loaded []string, skipped []error) {
+	registry.load()
+	return registry.userLoaded, registry.userSkipped
 }
 
 // load parses all embedded YAML schemas.
@@ -54,6 +79,10 @@ func (r *Registry) load() {
 				r.byType[schema.TypeKey] = schema
 			}
 		}
+
+		if r.userDir != "" {
+			r.loadUserSchemas()
+		}
 	})
 }
 
@@ -99,3 +128,84 @@ func Detect(data any, entityHint string) *EntitySchema {
 
 	return nil
 }
+
+// loadUserSchemas applies *.json schemas from the user directory, in file
+// name order. A file naming an existing entity is merged over it: fields and
+// headlines merge by key, everything else it sets replaces the embedded
+// value. A file naming a new entity adds it. The entity comes from the
+// "entity" key, or the file name when that's absent.
+func (r *Registry) loadUserSchemas() {
+	paths, _ := filepath.Glob(filepath.Join(r.userDir, "*.json"))
+	sort.Strings(paths)
+
+	for _, path := range paths {
+		schema, err := r.loadUserSchema(path)
+		if err != nil {
+			r.userSkipped = append(r.userSkipped, fmt.Errorf("%s: %w", filepath.Base(path), err))
+			continue
+		}
+
+		// Re-key the type index: an override may change or clear type_key.
+		if prev := r.byName[schema.Entity]; prev != nil {
+			for key, s := range r.byType {
+				if s == prev {
+					delete(r.byType, key)
+				}
+			}
+		}
+		r.byName[schema.Entity] = schema
+		if schema.TypeKey != "" {
+			r.byType[schema.TypeKey] = schema
+		}
+		r.userLoaded = append(r.userLoaded, schema.Entity)
+	}
+}
+
+func (r *Registry) loadUserSchema(path string) (*EntitySchema, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // G304: Path is from the user's own config dir
+	if err != nil {
+		return nil, err
+	}
+
+	// Schemas are declared with yaml tags, so round-trip the JSON through
+	// YAML rather than maintaining a parallel set of json tags.
+	var doc map[string]any
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+	if doc == nil {
+		return nil, fmt.Errorf("expected a JSON object")
+	}
+	entity, _ := doc["entity"].(string)
+	if entity == "" {
+		entity = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		doc["entity"] = entity
+	}
+	converted, err := yaml.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	schema := new(EntitySchema)
+	if base := r.byName[entity]; base != nil {
+		copied := *base
+		copied.Fields = maps.Clone(base.Fields)
+		copied.Headline = maps.Clone(base.Headline)
+		copied.Relations = maps.Clone(base.Relations)
+		if base.Views.List.Markdown != nil {
+			md := *base.Views.List.Markdown
+			copied.Views.List.Markdown = &md
+		}
+		schema = &copied
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(converted))
+	dec.KnownFields(true)
+	if err := dec.Decode(schema); err != nil {
+		return nil, err
+	}
+
+	if schema.Identity.Label == "" || len(schema.Fields) == 0 {
+		return nil, fmt.Errorf("schema for %q needs identity.label and fields", entity)
+	}
+	return schema, nil
+}
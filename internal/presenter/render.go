@@ -5,6 +5,7 @@ import (
 	"io"
 	"sort"
 	"strings"
+	"unicode/utf8"
 
 	"charm.land/lipgloss/v2"
 
@@ -104,7 +105,7 @@ func RenderDetail(w io.Writer, schema *EntitySchema, data map[string]any, styles
 
 	// Affordances
 	if len(schema.Actions) > 0 {
-		renderAffordances(&b, schema, data, styles)
+		renderAffordances(&b, schema, data, styles, locale)
 	}
 
 	_, err := io.WriteString(w, b.String())
@@ -113,38 +114,28 @@ func RenderDetail(w io.Writer, schema *EntitySchema, data map[string]any, styles
 
 // RenderList renders a slice of entities using the schema's list view.
 func RenderList(w io.Writer, schema *EntitySchema, data []map[string]any, styles Styles, locale Locale) error {
-	var b strings.Builder
+	return renderList(w, schema, data, styles, locale, presentOpts{})
+}
 
-	columns := schema.Views.List.Columns
-	if len(columns) == 0 {
-		// Fall back to fields with title/detail roles, sorted for deterministic output
-		var candidates []string
-		for name, spec := range schema.Fields {
-			if spec.Role == "title" || spec.Role == "detail" {
-				candidates = append(candidates, name)
-			}
-		}
-		sort.Strings(candidates)
-		columns = candidates
-	}
+func renderList(w io.Writer, schema *EntitySchema, data []map[string]any, styles Styles, locale Locale, opts presentOpts) error {
+	var b strings.Builder
 
+	columns := listColumns(schema, opts.columns)
 	if len(columns) == 0 || len(data) == 0 {
 		return nil
 	}
 
-	// Pre-pass: compute max width for meta-role columns (e.g. IDs) so they
-	// right-align. Non-meta columns are left as-is to avoid wasting space
-	// on variable-length content like todo titles.
+	// Pre-pass: measure every column. Meta-role columns (e.g. IDs) use the
+	// width to right-align; the rest are only measured for fitting so
+	// variable-length content like todo titles isn't padded.
+	measured := measureColumns(schema, columns, data, locale)
+	if opts.width > 0 {
+		columns, measured = fitColumns(schema, columns, measured, opts.width)
+	}
 	widths := make([]int, len(columns))
 	for i, col := range columns {
-		if schema.Fields[col].Role != "meta" {
-			continue
-		}
-		for _, item := range data {
-			formatted := singleLine(FormatField(schema.Fields[col], col, item[col], locale))
-			if len(formatted) > widths[i] {
-				widths[i] = len(formatted)
-			}
+		if schema.Fields[col].Role == "meta" {
+			widths[i] = measured[i]
 		}
 	}
 
@@ -157,6 +148,92 @@ func RenderList(w io.Writer, schema *EntitySchema, data []map[string]any, styles
 	return err
 }
 
+// listColumns resolves the columns a list renders. A non-empty override keeps
+// the fields the schema knows (see ListField), in the caller's order, without
+// duplicates;
+// otherwise the schema's list columns apply, falling back to title/detail
+// fields sorted for deterministic output.
+func listColumns(schema *EntitySchema, override []string) []string {
+	if len(override) > 0 {
+		seen := make(map[string]bool, len(override))
+		var columns []string
+		for _, name := range override {
+			col, ok := schema.ListField(name)
+			if !ok || seen[col] {
+				continue
+			}
+			seen[col] = true
+			columns = append(columns, col)
+		}
+		if len(columns) > 0 {
+			return columns
+		}
+	}
+
+	if len(schema.Views.List.Columns) > 0 {
+		return schema.Views.List.Columns
+	}
+
+	var candidates []string
+	for name, spec := range schema.Fields {
+		if spec.Role == "title" || spec.Role == "detail" {
+			candidates = append(candidates, name)
+		}
+	}
+	sort.Strings(candidates)
+	return candidates
+}
+
+// measureColumns returns the widest formatted value of each column.
+func measureColumns(schema *EntitySchema, columns []string, data []map[string]any, locale Locale) []int {
+	widths := make([]int, len(columns))
+	for i, col := range columns {
+		for _, item := range data {
+			formatted := singleLine(FormatField(schema.Fields[col], col, item[col], locale))
+			if n := lipgloss.Width(formatted); n > widths[i] {
+				widths[i] = n
+			}
+		}
+	}
+	return widths
+}
+
+// fitColumns drops columns from the right, skipping title columns, until the
+// widest row fits in width. The choice depends only on the data, so every
+// row of a list shows the same columns.
+func fitColumns(schema *EntitySchema, columns []string, widths []int, width int) ([]string, []int) {
+	columns = append([]string(nil), columns...)
+	widths = append([]int(nil), widths...)
+	for rowWidth(widths) > width {
+		drop := -1
+		for i := len(columns) - 1; i >= 0; i-- {
+			if schema.Fields[columns[i]].Role != "title" {
+				drop = i
+				break
+			}
+		}
+		if drop < 0 || len(columns) == 1 {
+			break
+		}
+		columns = append(columns[:drop], columns[drop+1:]...)
+		widths = append(widths[:drop], widths[drop+1:]...)
+	}
+	return columns, widths
+}
+
+// rowWidth is the rendered width of a row with the given column widths,
+// including the two-space separators renderListRow puts between them.
+func rowWidth(widths []int) int {
+	total := 0
+	for _, n := range widths {
+		total += n
+	}
+	if len(widths) > 1 {
+		total += 2 * (len(widths) - 1)
+	}
+	return total
+}
+
 // renderedField pairs a section field with its formatted output.
 type renderedField struct {
 	name      string
@@ -207,14 +284,14 @@ func renderDetailSection(b *strings.Builder, schema *EntitySchema, section Detai
 		if schema.Fields[f.name].Role == "body" {
 			continue
 		}
-		if label := fieldLabel(f.name); len(label) > maxLen {
-			maxLen = len(label)
+		if label := locale.T(fieldLabel(f.name)); utf8.RuneCountInString(label) > maxLen {
+			maxLen = utf8.RuneCountInString(label)
 		}
 	}
 
 	if section.Heading != "" {
 		b.WriteString("\n")
-		b.WriteString(styles.Heading.Render(section.Heading))
+		b.WriteString(styles.Heading.Render(locale.T(section.Heading)))
 		b.WriteString("\n")
 	}
 
@@ -257,7 +334,7 @@ func renderDetailSection(b *strings.Builder, schema *EntitySchema, section Detai
 			continue
 		}
 
-		label := fieldLabel(f.name)
+		label := locale.T(fieldLabel(f.name))
 		b.WriteString(styles.Label.Render(fmt.Sprintf("  %-*s  ", maxLen, label)))
 		b.WriteString(style.Render(f.formatted))
 		b.WriteString("\n")
@@ -321,7 +398,7 @@ func renderAllFields(b *strings.Builder, schema *EntitySchema, data map[string]a
 					}
 				}
 			} else {
-				label := fieldLabel(name)
+				label := locale.T(fieldLabel(name))
 				b.WriteString(styles.Label.Render(fmt.Sprintf("  %-12s  ", label)))
 				b.WriteString(style.Render(formatted))
 				b.WriteString("\n")
@@ -330,7 +407,7 @@ func renderAllFields(b *strings.Builder, schema *EntitySchema, data map[string]a
 	}
 }
 
-func renderAffordances(b *strings.Builder, schema *EntitySchema, data map[string]any, styles Styles) {
+func renderAffordances(b *strings.Builder, schema *EntitySchema, data map[string]any, styles Styles, locale Locale) {
 	var visible []Affordance
 	for _, a := range schema.Actions {
 		if EvalCondition(a.When, data) {
@@ -346,7 +423,7 @@ func renderAffordances(b *strings.Builder, schema *EntitySchema, data map[string
 	b.WriteString("\n")
 	b.WriteString(styles.Muted.Render("─────"))
 	b.WriteString("\n")
-	b.WriteString(styles.Subtle.Render("Hints:"))
+	b.WriteString(styles.Subtle.Render(locale.T("Hints") + ":"))
 	b.WriteString("\n")
 
 	// Find max command width for alignment
@@ -363,7 +440,7 @@ func renderAffordances(b *strings.Builder, schema *EntitySchema, data map[string
 
 	for i, a := range visible {
 		cmd := renderedCmds[i]
-		line := fmt.Sprintf("  %-*s  %s", maxCmd, cmd, a.Label)
+		line := fmt.Sprintf("  %-*s  %s", maxCmd, cmd, locale.T(a.Label))
 		b.WriteString(styles.Subtle.Render(line))
 		b.WriteString("\n")
 	}
@@ -475,7 +552,7 @@ func RenderDetailMarkdown(w io.Writer, schema *EntitySchema, data map[string]any
 
 	// Affordances
 	if len(schema.Actions) > 0 {
-		renderAffordancesMarkdown(&b, schema, data)
+		renderAffordancesMarkdown(&b, schema, data, locale)
 	}
 
 	_, err := io.WriteString(w, b.String())
@@ -487,31 +564,25 @@ func RenderDetailMarkdown(w io.Writer, schema *EntitySchema, data map[string]any
 // with optional grouping. Otherwise falls back to a GFM pipe table.
 // The groupByOverride, when non-empty, replaces the schema's group_by field.
 func RenderListMarkdown(w io.Writer, schema *EntitySchema, data []map[string]any, locale Locale, groupByOverride string) error {
+	return renderListMarkdown(w, schema, data, locale, presentOpts{groupBy: groupByOverride})
+}
+
+func renderListMarkdown(w io.Writer, schema *EntitySchema, data []map[string]any, locale Locale, opts presentOpts) error {
 	if md := schema.Views.List.Markdown; md != nil && md.Style == "tasklist" {
 		groupBy := md.GroupBy
-		if groupByOverride != "" {
-			groupBy = groupByOverride
+		if opts.groupBy != "" {
+			groupBy = opts.groupBy
 		}
-		return renderTaskListMarkdown(w, schema, data, locale, groupBy)
+		return renderTaskListMarkdown(w, schema, data, locale, groupBy, opts.columns)
 	}
-	return renderTableMarkdown(w, schema, data, locale)
+	return renderTableMarkdown(w, schema, data, locale, opts.columns)
 }
 
 // renderTableMarkdown renders a GFM pipe table (the original default).
-func renderTableMarkdown(w io.Writer, schema *EntitySchema, data []map[string]any, locale Locale) error {
-	columns := schema.Views.List.Columns
-	if len(columns) == 0 {
-		var candidates []string
-		for name, spec := range schema.Fields {
-			if spec.Role == "title" || spec.Role == "detail" {
-				candidates = append(candidates, name)
-			}
-		}
-		sort.Strings(candidates)
-		columns = candidates
-	}
+func renderTableMarkdown(w io.Writer, schema *EntitySchema, data []map[string]any, locale Locale, override []string) error {
+	columns := listColumns(schema, override)
 	if len(columns) == 0 || len(data) == 0 {
-		_, err := io.WriteString(w, "*No results*\n")
+		_, err := io.WriteString(w, "*"+locale.T("No results")+"*\n")
 		return err
 	}
 
@@ -521,7 +592,7 @@ func renderTableMarkdown(w io.Writer, schema *EntitySchema, data []map[string]an
 	var headers []string
 	var dividers []string
 	for _, col := range columns {
-		headers = append(headers, fieldLabel(col))
+		headers = append(headers, locale.T(fieldLabel(col)))
 		dividers = append(dividers, "---")
 	}
 	b.WriteString("| " + strings.Join(headers, " | ") + " |\n")
@@ -549,12 +620,14 @@ type taskGroup struct {
 }
 
 // renderTaskListMarkdown renders items as a Markdown task list with optional grouping.
-func renderTaskListMarkdown(w io.Writer, schema *EntitySchema, data []map[string]any, locale Locale, groupBy string) error {
+func renderTaskListMarkdown(w io.Writer, schema *EntitySchema, data []map[string]any, locale Locale, groupBy string, override []string) error {
 	if len(data) == 0 {
-		_, err := io.WriteString(w, "*No results*\n")
+		_, err := io.WriteString(w, "*"+locale.T("No results")+"*\n")
 		return err
 	}
 
+	columns := listColumns(schema, override)
+
 	groups := groupByDotPath(data, groupBy)
 
 	var b strings.Builder
@@ -577,7 +650,7 @@ func renderTaskListMarkdown(w io.Writer, schema *EntitySchema, data []map[string
 			b.WriteString("## " + heading + "\n")
 		}
 		for _, item := range g.items {
-			renderTaskItem(&b, schema, item, locale)
+			renderTaskItem(&b, schema, columns, item, locale)
 		}
 	}
 
@@ -590,7 +663,7 @@ func renderTaskListMarkdown(w io.Writer, schema *EntitySchema, data []map[string
 }
 
 // renderTaskItem renders a single `- [ ] content (metadata)` line.
-func renderTaskItem(b *strings.Builder, schema *EntitySchema, item map[string]any, locale Locale) {
+func renderTaskItem(b *strings.Builder, schema *EntitySchema, columns []string, item map[string]any, locale Locale) {
 	completed := toBool(item["completed"])
 	checkbox := "- [ ] "
 	if completed {
@@ -602,7 +675,7 @@ func renderTaskItem(b *strings.Builder, schema *EntitySchema, item map[string]an
 
 	// Inline metadata from columns (excluding content and completed, which are structural)
 	var meta []string
-	for _, col := range schema.Views.List.Columns {
+	for _, col := range columns {
 		if col == "content" || col == "completed" {
 			continue
 		}
@@ -618,16 +691,16 @@ func renderTaskItem(b *strings.Builder, schema *EntitySchema, item map[string]an
 			meta = append(meta, "#"+formatted)
 		case spec.Format == "date":
 			if col == "due_on" {
-				meta = append(meta, "due: "+formatted)
+				meta = append(meta, locale.T("due")+": "+formatted)
 			} else {
-				meta = append(meta, fieldLabel(col)+": "+formatted)
+				meta = append(meta, locale.T(fieldLabel(col))+": "+formatted)
 			}
 		case spec.Format == "people":
 			for _, name := range extractPeopleNames(val) {
 				meta = append(meta, "@"+name)
 			}
 		default:
-			meta = append(meta, fieldLabel(col)+": "+formatted)
+			meta = append(meta, locale.T(fieldLabel(col))+": "+formatted)
 		}
 	}
 
@@ -712,7 +785,7 @@ func renderDetailSectionMarkdown(b *strings.Builder, schema *EntitySchema, secti
 	}
 
 	if section.Heading != "" {
-		b.WriteString("\n#### " + section.Heading + "\n\n")
+		b.WriteString("\n#### " + locale.T(section.Heading) + "\n\n")
 	}
 
 	for _, f := range visible {
@@ -721,7 +794,7 @@ func renderDetailSectionMarkdown(b *strings.Builder, schema *EntitySchema, secti
 			continue
 		}
 
-		b.WriteString("- **" + fieldLabel(f.name) + ":** " + f.formatted + "\n")
+		b.WriteString("- **" + locale.T(fieldLabel(f.name)) + ":** " + f.formatted + "\n")
 	}
 }
 
@@ -755,14 +828,14 @@ func renderAllFieldsMarkdown(b *strings.Builder, schema *EntitySchema, data map[
 			if spec.Role == "body" {
 				b.WriteString("\n" + formatted + "\n")
 			} else {
-				label := fieldLabel(name)
+				label := locale.T(fieldLabel(name))
 				b.WriteString("- **" + label + ":** " + formatted + "\n")
 			}
 		}
 	}
 }
 
-func renderAffordancesMarkdown(b *strings.Builder, schema *EntitySchema, data map[string]any) {
+func renderAffordancesMarkdown(b *strings.Builder, schema *EntitySchema, data map[string]any, locale Locale) {
 	var visible []Affordance
 	for _, a := range schema.Actions {
 		if EvalCondition(a.When, data) {
@@ -773,9 +846,9 @@ func renderAffordancesMarkdown(b *strings.Builder, schema *EntitySchema, data ma
 		return
 	}
 
-	b.WriteString("\n#### Hints\n\n")
+	b.WriteString("\n#### " + locale.T("Hints") + "\n\n")
 	for _, a := range visible {
 		cmd := RenderTemplate(a.Cmd, data)
-		b.WriteString("- `" + cmd + "` — " + a.Label + "\n")
+		b.WriteString("- `" + cmd + "` — " + locale.T(a.Label) + "\n")
 	}
 }
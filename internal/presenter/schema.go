@@ -3,6 +3,8 @@
 // schemas to transform generic data into human-centered terminal output.
 package presenter
 
+import "strings"
+
 // EntitySchema describes how a Basecamp entity wants to be presented.
 // Schemas are declarative metadata loaded from YAML files.
 type EntitySchema struct {
@@ -90,3 +92,19 @@ type Affordance struct {
 	Label  string `yaml:"label"`
 	When   string `yaml:"when"`
 }
+
+// ListField resolves a user-supplied list column name to a schema field.
+// "title" is accepted for whichever field the schema labels with, so
+// "title,due_on" works for todos (content) and projects (name) alike.
+func (s *EntitySchema) ListField(name string) (string, bool) {
+	name = strings.TrimSpace(name)
+	if _, ok := s.Fields[name]; ok {
+		return name, true
+	}
+	if name == "title" && s.Identity.Label != "" {
+		if _, ok := s.Fields[s.Identity.Label]; ok {
+			return s.Identity.Label, true
+		}
+	}
+	return "", false
+}
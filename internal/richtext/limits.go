@@ -0,0 +1,36 @@
+package richtext
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// MaxContentBytes is the largest rich text body the CLI sends without
+// --force. Basecamp answers much larger payloads with opaque 422s or 500s.
+const MaxContentBytes = 1 << 20
+
+// reDataURIImage matches <img> tags whose src is an inline data: URI.
+var reDataURIImage = regexp.MustCompile(`(?is)<img\b[^>]*?\bsrc\s*=\s*(?:"\s*data:[^"]*"|'\s*data:[^']*')[^>]*>`)
+
+// StripDataURIImages removes <img> tags with inline data: sources, which
+// Basecamp rejects, and returns the cleaned HTML with the number removed.
+func StripDataURIImages(html string) (string, int) {
+	n := 0
+	html = reDataURIImage.ReplaceAllStringFunc(html, func(string) string {
+		n++
+		return ""
+	})
+	return html, n
+}
+
+// FormatBytes renders a byte count for messages, e.g. "1.4 MB" or "820 KB".
+func FormatBytes(n int) string {
+	switch {
+	case n >= 1<<20:
+		return fmt.Sprintf("%.1f MB", float64(n)/(1<<20))
+	case n >= 1<<10:
+		return fmt.Sprintf("%d KB", n/(1<<10))
+	default:
+		return fmt.Sprintf("%d B", n)
+	}
+}
@@ -0,0 +1,31 @@
+package richtext
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripDataURIImages(t *testing.T) {
+	in := `<p>Chart:</p><img src="data:image/png;base64,iVBORw0KGgo=" alt="chart">` +
+		`<img alt='x' src='DATA:image/gif;base64,R0lG'/>` +
+		`<img src="https://example.com/a.png" alt="remote">`
+
+	out, n := StripDataURIImages(in)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, `<p>Chart:</p><img src="https://example.com/a.png" alt="remote">`, out)
+}
+
+func TestStripDataURIImagesLeavesOtherContent(t *testing.T) {
+	in := `<p>data: is fine in text</p><a href="https://example.com">link</a>`
+	out, n := StripDataURIImages(in)
+	assert.Equal(t, 0, n)
+	assert.Equal(t, in, out)
+}
+
+func TestFormatBytes(t *testing.T) {
+	assert.Equal(t, "512 B", FormatBytes(512))
+	assert.Equal(t, "820 KB", FormatBytes(820*1024))
+	assert.Equal(t, "1.0 MB", FormatBytes(MaxContentBytes))
+	assert.Equal(t, "1.5 MB", FormatBytes(3<<19))
+}
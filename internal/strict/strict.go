@@ -0,0 +1,361 @@
+// Package strict checks parsed API data against the responses it came from
+// (--strict).
+//
+// The SDK decodes responses into typed structs, silently dropping any field
+// the type doesn't declare and leaving zero values for fields the API stopped
+// sending. With --strict the CLI's HTTP transport keeps each JSON response it
+// sees, and before a command prints its result the SDK values in that result
+// are compared with the objects they were parsed from, matched by ID. Any
+// difference fails the command, so API changes and SDK mapping bugs surface
+// as errors instead of as quietly missing output.
+package strict
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+// sdkPkg is the import path of the SDK's types; only values of these types
+// are checked.
+var sdkPkg = reflect.TypeFor[basecamp.Card]().PkgPath()
+
+// maxHintIssues bounds how many issues the error hint lists.
+const maxHintIssues = 5
+
+// Problem classifies an Issue.
+type Problem string
+
+const (
+	// Unknown is a field the response carries that the SDK type doesn't
+	// declare, so parsing dropped it.
+	Unknown Problem = "unknown"
+
+	// Dropped is a field the SDK type declares and the response set, but
+	// that came out of parsing empty.
+	Dropped Problem = "dropped"
+
+	// Missing is a field the SDK type requires that the response lacks.
+	Missing Problem = "missing"
+)
+
+// Issue is one difference between a response and its parsed value.
+type Issue struct {
+	Path    string // e.g. "Kanban::Card 42.steps[0].due_on"
+	Problem Problem
+}
+
+func (i Issue) String() string {
+	switch i.Problem {
+	case Unknown:
+		return i.Path + " is not in the SDK type"
+	case Dropped:
+		return i.Path + " was lost in parsing"
+	default:
+		return i.Path + " is missing from the response"
+	}
+}
+
+// Recorder is an http.RoundTripper that keeps the JSON objects of successful
+// responses, indexed by ID, while Enabled.
+type Recorder struct {
+	Inner   http.RoundTripper
+	Enabled bool // set before the first request
+
+	mu      sync.Mutex
+	objects map[string][]map[string]any
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := r.Inner.RoundTrip(req)
+	if err != nil || !r.Enabled || resp.StatusCode/100 != 2 || !isJSON(resp.Header.Get("Content-Type")) {
+		return resp, err
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	_ = resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(data))
+	r.record(data)
+	return resp, nil
+}
+
+func isJSON(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	return err == nil && (mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"))
+}
+
+// record indexes every object with an ID in a response body. Bodies that
+// aren't valid JSON are ignored; the SDK reports those itself.
+func (r *Recorder) record(data []byte) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if dec.Decode(&doc) != nil {
+		return
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.objects == nil {
+		r.objects = make(map[string][]map[string]any)
+	}
+	var walk func(v any)
+	walk = func(v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			if id := jsonID(v["id"]); id != "" {
+				r.objects[id] = append(r.objects[id], v)
+			}
+			for _, child := range v {
+				walk(child)
+			}
+		case []any:
+			for _, child := range v {
+				walk(child)
+			}
+		}
+	}
+	walk(doc)
+}
+
+func jsonID(v any) string {
+	switch v := v.(type) {
+	case json.Number:
+		return v.String()
+	case string:
+		return v
+	}
+	return ""
+}
+
+// Verify checks data and returns an API error describing the differences,
+// or nil when there are none (or the recorder is off).
+func (r *Recorder) Verify(data any) error {
+	if r == nil || !r.Enabled {
+		return nil
+	}
+	issues := r.Check(data)
+	if len(issues) == 0 {
+		return nil
+	}
+
+	noun := "difference"
+	if len(issues) != 1 {
+		noun = "differences"
+	}
+	shown := make([]string, 0, maxHintIssues)
+	for _, issue := range issues[:min(len(issues), maxHintIssues)] {
+		shown = append(shown, issue.String())
+	}
+	hint := strings.Join(shown, "; ")
+	if len(issues) > maxHintIssues {
+		hint += fmt.Sprintf("; and %d more", len(issues)-maxHintIssues)
+	}
+	return &output.Error{
+		Code:    output.CodeAPI,
+		Message: fmt.Sprintf("API response doesn't match the SDK types (%d %s)", len(issues), noun),
+		Hint:    hint + ". Rerun without --strict to accept the parsed result",
+	}
+}
+
+// Check compares every SDK value in data with the recorded response object
+// of the same ID. Values with no recorded object (served from cache, or
+// built by the CLI) are skipped.
+func (r *Recorder) Check(data any) []Issue {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var issues []Issue
+	r.walk(reflect.ValueOf(data), &issues)
+	return issues
+}
+
+// walk finds the SDK entities in v, wherever the command put them.
+func (r *Recorder) walk(v reflect.Value, issues *[]Issue) {
+	switch v.Kind() {
+	case reflect.Interface, reflect.Pointer:
+		if !v.IsNil() {
+			r.walk(v.Elem(), issues)
+		}
+	case reflect.Struct:
+		if v.Type().PkgPath() == sdkPkg {
+			if id, ok := fieldByName(v, "id"); ok {
+				r.checkEntity(v, fmt.Sprint(id.Interface()), issues)
+				return
+			}
+		}
+		for i := range v.NumField() {
+			if v.Type().Field(i).IsExported() {
+				r.walk(v.Field(i), issues)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range v.Len() {
+			r.walk(v.Index(i), issues)
+		}
+	case reflect.Map:
+		iter := v.MapRange()
+		for iter.Next() {
+			r.walk(iter.Value(), issues)
+		}
+	}
+}
+
+func (r *Recorder) checkEntity(v reflect.Value, id string, issues *[]Issue) {
+	candidates := r.objects[id]
+	if len(candidates) == 0 {
+		return
+	}
+	raw := candidates[0]
+	typ := ""
+	if f, ok := fieldByName(v, "type"); ok && f.Kind() == reflect.String {
+		typ = f.String()
+	}
+	if typ != "" {
+		for _, c := range candidates {
+			if c["type"] == typ {
+				raw = c
+				break
+			}
+		}
+	}
+
+	label := "#" + id
+	if typ != "" {
+		label = typ + " " + id
+	}
+	compare(raw, v, label, issues)
+}
+
+// compare checks one parsed struct against its response object, descending
+// into nested SDK structs.
+func compare(raw map[string]any, v reflect.Value, path string, issues *[]Issue) {
+	fields := jsonFields(v.Type())
+
+	keys := make([]string, 0, len(raw))
+	for k := range raw {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		rv := raw[k]
+		if isEmptyJSON(rv) {
+			continue
+		}
+		field, ok := fields[k]
+		if !ok {
+			*issues = append(*issues, Issue{Path: path + "." + k, Problem: Unknown})
+			continue
+		}
+		fv, err := v.FieldByIndexErr(field.index)
+		if err != nil || fv.IsZero() {
+			*issues = append(*issues, Issue{Path: path + "." + k, Problem: Dropped})
+			continue
+		}
+		compareNested(rv, fv, path+"."+k, issues)
+	}
+
+	names := make([]string, 0, len(fields))
+	for name, field := range fields {
+		if !field.omitempty {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		if _, ok := raw[name]; !ok {
+			*issues = append(*issues, Issue{Path: path + "." + name, Problem: Missing})
+		}
+	}
+}
+
+func compareNested(rv any, fv reflect.Value, path string, issues *[]Issue) {
+	for fv.Kind() == reflect.Pointer || fv.Kind() == reflect.Interface {
+		if fv.IsNil() {
+			return
+		}
+		fv = fv.Elem()
+	}
+	switch fv.Kind() {
+	case reflect.Struct:
+		if obj, ok := rv.(map[string]any); ok && fv.Type().PkgPath() == sdkPkg {
+			compare(obj, fv, path, issues)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := rv.([]any)
+		if !ok {
+			return
+		}
+		for i := range min(len(arr), fv.Len()) {
+			compareNested(arr[i], fv.Index(i), fmt.Sprintf("%s[%d]", path, i), issues)
+		}
+	}
+}
+
+type jsonField struct {
+	index     []int
+	omitempty bool
+}
+
+// jsonFields maps a struct's JSON keys to its fields, promoting embedded
+// structs the way encoding/json does.
+func jsonFields(t reflect.Type) map[string]jsonField {
+	fields := make(map[string]jsonField)
+	for _, f := range reflect.VisibleFields(t) {
+		tag, hasTag := f.Tag.Lookup("json")
+		if !f.IsExported() || tag == "-" || (f.Anonymous && !hasTag) {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = f.Name
+		}
+		fields[name] = jsonField{index: f.Index, omitempty: slices.Contains(strings.Split(opts, ","), "omitempty")}
+	}
+	return fields
+}
+
+func fieldByName(v reflect.Value, name string) (reflect.Value, bool) {
+	field, ok := jsonFields(v.Type())[name]
+	if !ok {
+		return reflect.Value{}, false
+	}
+	fv, err := v.FieldByIndexErr(field.index)
+	return fv, err == nil
+}
+
+// isEmptyJSON reports whether a response value carries nothing a parser
+// could lose: null, "", 0, false, or an empty array or object.
+func isEmptyJSON(v any) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case bool:
+		return !v
+	case json.Number:
+		f, err := v.Float64()
+		return err == nil && f == 0
+	case []any:
+		return len(v) == 0
+	case map[string]any:
+		return len(v) == 0
+	}
+	return false
+}
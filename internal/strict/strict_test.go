@@ -0,0 +1,119 @@
+package strict
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+)
+
+const cardJSON = `{
+  "id": 42, "type": "Kanban::Card", "title": "Launch", "status": "active",
+  "visible_to_clients": false, "created_at": "2026-01-01T00:00:00Z",
+  "updated_at": "2026-01-02T00:00:00Z", "inherits_status": true,
+  "url": "u", "app_url": "a", "bookmark_url": "b", "position": 1,
+  "completed": false, "comments_count": 0, "comment_count": 0, "boosts_count": 0,
+  "steps": [
+    {"id": 7, "type": "Kanban::Step", "title": "Draft", "status": "active",
+     "visible_to_clients": false, "created_at": "2026-01-01T00:00:00Z",
+     "updated_at": "2026-01-01T00:00:00Z", "inherits_status": true,
+     "url": "u", "app_url": "a", "bookmark_url": "b", "position": 0,
+     "completed": false, "due_on": "2026-02-01", "priority": "high"}
+  ]
+}`
+
+// record serves body through a Recorder and returns it.
+func record(t *testing.T, contentType, body string) *Recorder {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		_, _ = io.WriteString(w, body)
+	}))
+	t.Cleanup(server.Close)
+
+	rec := &Recorder{Inner: http.DefaultTransport, Enabled: true}
+	resp, err := (&http.Client{Transport: rec}).Get(server.URL)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	got, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	assert.Equal(t, body, string(got), "body passes through unchanged")
+	return rec
+}
+
+func parsedCard() basecamp.Card {
+	jan1 := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	return basecamp.Card{
+		ID: 42, Type: "Kanban::Card", Title: "Launch", Status: "active",
+		CreatedAt: jan1, UpdatedAt: jan1.AddDate(0, 0, 1),
+		InheritsStatus: true, URL: "u", AppURL: "a", BookmarkURL: "b", Position: 1,
+		Steps: []basecamp.CardStep{{
+			ID: 7, Type: "Kanban::Step", Title: "Draft", Status: "active",
+			CreatedAt: jan1, UpdatedAt: jan1,
+			InheritsStatus: true, URL: "u", AppURL: "a", BookmarkURL: "b",
+			DueOn: "2026-02-01",
+		}},
+	}
+}
+
+func TestCheck_FindsUnknownDroppedAndMissing(t *testing.T) {
+	rec := record(t, "application/json; charset=utf-8", cardJSON)
+	card := parsedCard()
+	card.Steps[0].DueOn = "" // lost in parsing
+
+	issues := rec.Check(map[string]any{"card": &card})
+	assert.Equal(t, []Issue{
+		{Path: "Kanban::Card 42.steps[0].due_on", Problem: Dropped},
+		{Path: "Kanban::Card 42.steps[0].priority", Problem: Unknown},
+	}, issues)
+
+	// created_at and updated_at aren't in the response at all.
+	rec = record(t, "application/json", `{"id": 42, "type": "Kanban::Card", "title": "Launch"}`)
+	issues = rec.Check(basecamp.Card{ID: 42, Type: "Kanban::Card", Title: "Launch"})
+	assert.Contains(t, issues, Issue{Path: "Kanban::Card 42.created_at", Problem: Missing})
+	assert.NotContains(t, issues, Issue{Path: "Kanban::Card 42.due_on", Problem: Missing}, "omitempty fields are optional")
+}
+
+func TestCheck_SkipsUnrecordedValues(t *testing.T) {
+	rec := record(t, "application/json", cardJSON)
+	other := parsedCard()
+	other.ID = 99
+	assert.Empty(t, rec.Check([]basecamp.Card{other}))
+	assert.Empty(t, rec.Check(map[string]any{"id": 42, "note": "built by the CLI"}))
+}
+
+func TestRecorder_IgnoresNonJSONAndDisabled(t *testing.T) {
+	rec := record(t, "text/html", cardJSON)
+	card := parsedCard()
+	card.Title = ""
+	assert.Empty(t, rec.Check(card))
+
+	rec = &Recorder{Inner: http.DefaultTransport}
+	rec.record([]byte(cardJSON))
+	assert.NoError(t, rec.Verify(card), "Verify is inert while disabled")
+}
+
+func TestVerify_ReturnsAPIError(t *testing.T) {
+	rec := record(t, "application/json", cardJSON)
+	card := parsedCard()
+	card.Title = ""
+	card.Steps[0].Title = ""
+	card.Steps[0].DueOn = ""
+
+	err := rec.Verify(card)
+	require.Error(t, err)
+	e := output.AsError(err)
+	assert.Equal(t, output.CodeAPI, e.Code)
+	assert.Contains(t, e.Message, "(4 differences)")
+	assert.Contains(t, e.Hint, "Kanban::Card 42.title was lost in parsing")
+	assert.Contains(t, e.Hint, "--strict")
+
+	assert.NoError(t, (*Recorder)(nil).Verify(card))
+}
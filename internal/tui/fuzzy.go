@@ -0,0 +1,18 @@
+package tui
+
+import "strings"
+
+// FuzzyMatch reports whether query's characters appear in s in order, case
+// insensitively ("tdo" matches "Todos"). The quick-jump overlay and fuzzy
+// pickers filter with it.
+func FuzzyMatch(s, query string) bool {
+	s = strings.ToLower(s)
+	queryRunes := []rune(strings.ToLower(query))
+	qi := 0
+	for _, r := range s {
+		if qi < len(queryRunes) && r == queryRunes[qi] {
+			qi++
+		}
+	}
+	return qi == len(queryRunes)
+}
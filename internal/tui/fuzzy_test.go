@@ -0,0 +1,21 @@
+package tui
+
+import "testing"
+
+func TestFuzzyMatch(t *testing.T) {
+	tests := []struct {
+		s, query string
+		want     bool
+	}{
+		{"Todos", "tdo", true},
+		{"Message Board", "mb", true},
+		{"Message Board", "", true},
+		{"Todos", "sot", false},
+		{"Todos", "todoss", false},
+	}
+	for _, tt := range tests {
+		if got := FuzzyMatch(tt.s, tt.query); got != tt.want {
+			t.Errorf("FuzzyMatch(%q, %q) = %v, want %v", tt.s, tt.query, got, tt.want)
+		}
+	}
+}
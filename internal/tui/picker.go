@@ -52,6 +52,7 @@ type pickerModel struct {
 	emptyMessage     string                // Custom message when no items
 	autoSelectSingle bool                  // Auto-select if only one item
 	showHelp         bool                  // Show keyboard shortcuts help
+	fuzzy            bool                  // Filter by subsequence, not substring
 }
 
 // PickerOption configures a picker.
@@ -90,6 +91,14 @@ func WithRecentItems(items []PickerItem) PickerOption {
 	}
 }
 
+// WithFuzzyFilter filters by subsequence (see FuzzyMatch) rather than
+// substring, as the quick-jump overlay does.
+func WithFuzzyFilter() PickerOption {
+	return func(m *pickerModel) {
+		m.fuzzy = true
+	}
+}
+
 // WithEmptyMessage sets a custom message shown when no items are available.
 func WithEmptyMessage(msg string) PickerOption {
 	return func(m *pickerModel) {
@@ -220,7 +229,7 @@ func (m pickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.quitting = true
 			return m, tea.Quit
 		}
-		m.items = msg.Items
+		m.items = m.mergeWithRecents(msg.Items)
 		m.filtered = m.filter(m.textInput.Value())
 
 		// Update originalItems map with loaded items
@@ -355,7 +364,11 @@ func (m pickerModel) filter(query string) []PickerItem {
 	query = strings.ToLower(query)
 	var result []PickerItem
 	for _, item := range m.items {
-		if strings.Contains(strings.ToLower(item.FilterValue()), query) {
+		match := strings.Contains(strings.ToLower(item.FilterValue()), query)
+		if m.fuzzy {
+			match = FuzzyMatch(item.FilterValue(), query)
+		}
+		if match {
 			result = append(result, item)
 		}
 	}
@@ -324,3 +324,40 @@ type testError struct {
 func (e *testError) Error() string {
 	return e.msg
 }
+
+func TestPickerModel_FuzzyFilter(t *testing.T) {
+	items := []PickerItem{
+		{ID: "1", Title: "Ship the launch post"},
+		{ID: "2", Title: "Stand-up notes"},
+	}
+
+	m := newPickerModel(items)
+	if got := m.filter("slp"); len(got) != 0 {
+		t.Errorf("substring filter matched %d items for %q, want 0", len(got), "slp")
+	}
+
+	m = newPickerModel(items, WithFuzzyFilter())
+	got := m.filter("slp")
+	if len(got) != 1 || got[0].ID != "1" {
+		t.Errorf("fuzzy filter = %v, want only item 1", got)
+	}
+}
+
+func TestPickerModel_LoadedItemsKeepRecents(t *testing.T) {
+	m := newPickerModel(nil, WithRecentItems([]PickerItem{{ID: "9", Title: "Recent doc"}}))
+	updated, _ := m.Update(PickerItemsLoadedMsg{Items: []PickerItem{
+		{ID: "9", Title: "Recent doc"},
+		{ID: "1", Title: "Loaded todo"},
+	}})
+	m = updated.(pickerModel)
+
+	if len(m.items) != 2 {
+		t.Fatalf("items = %v, want the recent item then the loaded one", m.items)
+	}
+	if m.items[0].ID != "9" || m.items[0].Title != "* Recent doc" {
+		t.Errorf("items[0] = %+v, want the decorated recent item", m.items[0])
+	}
+	if m.items[1].ID != "1" {
+		t.Errorf("items[1] = %+v, want the loaded item", m.items[1])
+	}
+}
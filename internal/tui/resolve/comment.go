@@ -9,8 +9,6 @@ import (
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 
 	"github.com/basecamp/basecamp-cli/internal/output"
-	"github.com/basecamp/basecamp-cli/internal/tui"
-	"github.com/basecamp/basecamp-cli/internal/tui/format"
 )
 
 // CommentTarget holds the resolved target for a comment.
@@ -48,70 +46,15 @@ func (r *Resolver) Comment(ctx context.Context, onFlag string, projectID string)
 	}
 
 	// 2. Try interactive prompt if available
-	if !r.IsInteractive() {
-		return nil, output.ErrUsage("recording ID is required")
-	}
-
-	// Fetch recent recordings from the project to show as options
-	recordings, err := r.fetchCommentableRecordings(ctx, parsedProjectID)
+	target, err := r.Recording(ctx, "Select item to comment on", projectID)
 	if err != nil {
 		return nil, err
 	}
-
-	if len(recordings) == 0 {
-		return nil, output.ErrNotFound("recordings", projectID)
-	}
-
-	// Convert to picker items
-	items := make([]tui.PickerItem, len(recordings))
-	for i, rec := range recordings {
-		formatted := format.Recording{
-			ID:        rec.ID,
-			Type:      rec.Type,
-			Title:     rec.Title,
-			CreatedAt: rec.CreatedAt,
-		}
-		if rec.Creator != nil {
-			formatted.Creator = rec.Creator.Name
-		}
-
-		items[i] = tui.PickerItem{
-			ID:          fmt.Sprintf("%d", rec.ID),
-			Title:       formatted.ToPickerTitle(),
-			Description: formatted.ToPickerDescription(),
-		}
-	}
-
-	// Show picker
-	selected, err := tui.NewPicker(items,
-		tui.WithPickerTitle("Select item to comment on"),
-		tui.WithEmptyMessage("No items found in this project"),
-	).Run()
-
-	if err != nil {
-		return nil, fmt.Errorf("recording selection failed: %w", err)
-	}
-	if selected == nil {
-		return nil, output.ErrUsage("recording selection canceled")
-	}
-
-	// Find the selected recording to get its type
-	var selectedType string
-	for _, rec := range recordings {
-		if fmt.Sprintf("%d", rec.ID) == selected.ID {
-			selectedType = rec.Type
-			break
-		}
-	}
-
-	// Parse selected recording ID (should always succeed since we created the picker items)
-	selectedRecordingID, _ := strconv.ParseInt(selected.ID, 10, 64)
-
 	return &CommentTarget{
-		RecordingID: selectedRecordingID,
+		RecordingID: target.RecordingID,
 		ProjectID:   parsedProjectID,
-		Type:        selectedType,
-		Title:       selected.Title,
+		Type:        target.Type,
+		Title:       target.Title,
 	}, nil
 }
 
@@ -75,7 +75,7 @@ func PersistValue(key, value, scope string) error {
 
 	// Set the value (use native JSON types for boolean keys)
 	switch key {
-	case "onboarded", "hints", "stats", "cache_enabled":
+	case "onboarded", "hints", "stats", "usage_stats", "cache_enabled":
 		switch strings.ToLower(strings.TrimSpace(value)) {
 		case "true", "1":
 			configData[key] = true
@@ -84,6 +84,16 @@ func PersistValue(key, value, scope string) error {
 		default:
 			configData[key] = value
 		}
+	case "tui_mute":
+		entries, err := config.ParseMuteList(value)
+		if err != nil {
+			return err
+		}
+		if len(entries) == 0 {
+			delete(configData, key)
+		} else {
+			configData[key] = entries
+		}
 	default:
 		configData[key] = value
 	}
@@ -17,7 +17,7 @@ func TestPersistValueBooleanKeys(t *testing.T) {
 
 	configPath := filepath.Join(tmpDir, "basecamp", "config.json")
 
-	boolKeys := []string{"onboarded", "hints", "stats", "cache_enabled"}
+	boolKeys := []string{"onboarded", "hints", "stats", "usage_stats", "cache_enabled"}
 
 	for _, key := range boolKeys {
 		t.Run(key+"=true", func(t *testing.T) {
@@ -102,3 +102,31 @@ func TestPersistValueBooleanWhitespaceTolerance(t *testing.T) {
 	require.True(t, ok)
 	assert.Equal(t, true, val, "whitespace-padded 'true' should persist as boolean true")
 }
+
+func TestPersistValueTUIMute(t *testing.T) {
+	tmpDir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", tmpDir)
+
+	configPath := filepath.Join(tmpDir, "basecamp", "config.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(configPath), 0700))
+	require.NoError(t, os.WriteFile(configPath, []byte(`{"account_id": "1"}`), 0600))
+
+	require.NoError(t, PersistValue("tui_mute", "456/chat,123", "global"))
+
+	data, err := os.ReadFile(configPath)
+	require.NoError(t, err)
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.Equal(t, []any{"123", "456/chat"}, raw["tui_mute"], "mute entries persist as a list")
+
+	// An empty list removes the key instead of leaving [] behind.
+	require.NoError(t, PersistValue("tui_mute", "", "global"))
+	data, err = os.ReadFile(configPath)
+	require.NoError(t, err)
+	raw = nil
+	require.NoError(t, json.Unmarshal(data, &raw))
+	assert.NotContains(t, raw, "tui_mute")
+	assert.Equal(t, "1", raw["account_id"])
+
+	assert.Error(t, PersistValue("tui_mute", "launch", "global"))
+}
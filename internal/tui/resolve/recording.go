@@ -0,0 +1,134 @@
+package resolve
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"sync"
+
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/format"
+	"github.com/basecamp/basecamp-cli/internal/tui/recents"
+)
+
+// RecordingTarget is a recording picked interactively.
+type RecordingTarget struct {
+	RecordingID int64
+	ProjectID   int64 // 0 when a recent item didn't record its project
+	Type        string
+	Title       string
+}
+
+// Recording prompts for a recording to act on, for commands run without the
+// ID they need. Recordings recently opened in the TUI come first, then, when
+// projectID is set, the project's most recently updated todos, messages, and
+// documents. Typing filters fuzzily, as in the TUI's quick-jump.
+//
+// Returns a usage error when the terminal isn't interactive or the picker is
+// canceled.
+func (r *Resolver) Recording(ctx context.Context, title, projectID string) (*RecordingTarget, error) {
+	if !r.IsInteractive() {
+		return nil, output.ErrUsage("recording ID is required")
+	}
+	if r.config.AccountID == "" {
+		return nil, output.ErrUsage("Account must be resolved before picking a recording")
+	}
+
+	var parsedProjectID int64
+	if projectID != "" {
+		var err error
+		if parsedProjectID, err = parseRequiredInt64(projectID, "project ID"); err != nil {
+			return nil, err
+		}
+	}
+
+	recent := r.recentRecordings(projectID)
+	if len(recent) == 0 && parsedProjectID == 0 {
+		return nil, output.ErrUsageHint("recording ID is required",
+			"Pass the ID or URL, or use --in <project> to pick from the project's recent items")
+	}
+
+	// Picker ID → recording type. The loader runs alongside the picker, so
+	// a recent item may be picked while it is still filling this in.
+	var mu sync.Mutex
+	types := make(map[string]string)
+	loader := func() ([]tui.PickerItem, error) {
+		if parsedProjectID == 0 {
+			return nil, nil
+		}
+		recordings, err := r.fetchCommentableRecordings(ctx, parsedProjectID)
+		if err != nil {
+			return nil, err
+		}
+		items := make([]tui.PickerItem, len(recordings))
+		for i, rec := range recordings {
+			formatted := format.Recording{
+				ID:        rec.ID,
+				Type:      rec.Type,
+				Title:     rec.Title,
+				CreatedAt: rec.CreatedAt,
+			}
+			if rec.Creator != nil {
+				formatted.Creator = rec.Creator.Name
+			}
+			id := strconv.FormatInt(rec.ID, 10)
+			mu.Lock()
+			types[id] = rec.Type
+			mu.Unlock()
+			items[i] = tui.PickerItem{
+				ID:          id,
+				Title:       formatted.ToPickerTitle(),
+				Description: formatted.ToPickerDescription(),
+			}
+		}
+		return items, nil
+	}
+
+	recentItems := make([]tui.PickerItem, len(recent))
+	recentProjects := make(map[string]string, len(recent))
+	for i, item := range recent {
+		recentItems[i] = tui.PickerItem{ID: item.ID, Title: item.Title, Description: item.Description}
+		recentProjects[item.ID] = item.ProjectID
+		types[item.ID] = item.Description // the TUI records the type as the description
+	}
+
+	selected, err := tui.NewPickerWithLoader(loader,
+		tui.WithPickerTitle(title),
+		tui.WithRecentItems(recentItems),
+		tui.WithFuzzyFilter(),
+		tui.WithEmptyMessage("No recent items"),
+		tui.WithLoading("Loading recent items..."),
+	).Run()
+	if err != nil {
+		return nil, fmt.Errorf("recording selection failed: %w", err)
+	}
+	if selected == nil {
+		return nil, output.ErrUsage("recording selection canceled")
+	}
+
+	// IDs come from the picker items built above.
+	recordingID, _ := strconv.ParseInt(selected.ID, 10, 64)
+	mu.Lock()
+	recordingType := types[selected.ID]
+	mu.Unlock()
+	target := &RecordingTarget{
+		RecordingID: recordingID,
+		ProjectID:   parsedProjectID,
+		Type:        recordingType,
+		Title:       selected.Title,
+	}
+	if p, ok := recentProjects[selected.ID]; ok {
+		target.ProjectID, _ = strconv.ParseInt(p, 10, 64)
+	}
+	return target, nil
+}
+
+// recentRecordings returns the recordings recently opened in the TUI for the
+// current account, limited to projectID when set.
+func (r *Resolver) recentRecordings(projectID string) []recents.Item {
+	if r.config.CacheDir == "" {
+		return nil
+	}
+	return recents.NewStore(r.config.CacheDir).Get(recents.TypeRecording, r.config.AccountID, projectID)
+}
@@ -0,0 +1,45 @@
+package resolve
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/config"
+	"github.com/basecamp/basecamp-cli/internal/output"
+	"github.com/basecamp/basecamp-cli/internal/tui/recents"
+)
+
+func TestRecentRecordingsFiltersByAccountAndProject(t *testing.T) {
+	cacheDir := t.TempDir()
+	store := recents.NewStore(cacheDir)
+	store.Add(recents.Item{ID: "1", Title: "Ship it", Description: "Todo", Type: recents.TypeRecording, AccountID: "99", ProjectID: "5"})
+	store.Add(recents.Item{ID: "2", Title: "Kickoff", Description: "Message", Type: recents.TypeRecording, AccountID: "99", ProjectID: "6"})
+	store.Add(recents.Item{ID: "3", Title: "Elsewhere", Type: recents.TypeRecording, AccountID: "42"})
+	store.Add(recents.Item{ID: "5", Title: "A project", Type: recents.TypeProject, AccountID: "99"})
+
+	r := New(nil, nil, &config.Config{AccountID: "99", CacheDir: cacheDir})
+
+	ids := func(items []recents.Item) []string {
+		var out []string
+		for _, it := range items {
+			out = append(out, it.ID)
+		}
+		return out
+	}
+	assert.ElementsMatch(t, []string{"1", "2"}, ids(r.recentRecordings("")))
+	assert.Equal(t, []string{"1"}, ids(r.recentRecordings("5")))
+}
+
+func TestRecordingRequiresInteractiveTerminal(t *testing.T) {
+	r := New(nil, nil, &config.Config{AccountID: "99", CacheDir: t.TempDir()}, WithFlags(&Flags{JSON: true}))
+
+	_, err := r.Recording(context.Background(), "Pick", "5")
+	require.Error(t, err)
+	var e *output.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, output.CodeUsage, e.Code)
+}
@@ -49,6 +49,16 @@ func DefaultTheme(dark bool) Theme {
 	}
 }
 
+// AccountColor is the accent for the account numbered index (1-based, the
+// account switcher's numbering). Accounts take the room palette in turn, so
+// up to eight accounts never share a color; index 0 (no account) is Muted.
+func (t Theme) AccountColor(index int) color.Color {
+	if index <= 0 || len(t.RoomColors) == 0 {
+		return t.Muted
+	}
+	return t.RoomColors[(index-1)%len(t.RoomColors)]
+}
+
 // Styles holds the styled components for the TUI.
 type Styles struct {
 	theme Theme
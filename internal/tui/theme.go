@@ -53,6 +53,22 @@ func ResolveTheme(dark bool) Theme {
 	return DefaultTheme(dark)
 }
 
+// ResolveThemeMode resolves the theme for a tui_theme setting: "dark" and
+// "light" pin the palette regardless of the detected background, "none"
+// drops color, and anything else ("auto", "") defers to ResolveTheme.
+func ResolveThemeMode(mode string, dark bool) Theme {
+	switch mode {
+	case "dark":
+		return ResolveTheme(true)
+	case "light":
+		return ResolveTheme(false)
+	case "none":
+		return NoColorTheme()
+	default:
+		return ResolveTheme(dark)
+	}
+}
+
 // ThemeFilePath returns the resolved path to the active theme file,
 // or "" if using defaults or NO_COLOR is set.
 func ThemeFilePath() string {
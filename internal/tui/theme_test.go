@@ -309,6 +309,18 @@ foreground = "#ffffff"
 	})
 }
 
+func TestResolveThemeMode(t *testing.T) {
+	unsetenvForTest(t, "NO_COLOR")
+	unsetenvForTest(t, "BASECAMP_THEME")
+	t.Setenv("HOME", t.TempDir())
+
+	assert.True(t, ResolveThemeMode("dark", false).Dark, "dark pins the dark palette")
+	assert.False(t, ResolveThemeMode("light", true).Dark, "light pins the light palette")
+	assert.Equal(t, lipgloss.NoColor{}, ResolveThemeMode("none", true).Primary)
+	assert.False(t, ResolveThemeMode("auto", false).Dark, "auto follows the detected background")
+	assert.True(t, ResolveThemeMode("", true).Dark)
+}
+
 func TestLoadUserTheme(t *testing.T) {
 	t.Run("loads theme from user config dir", func(t *testing.T) {
 		unsetenvForTest(t, "NO_COLOR")
@@ -345,3 +357,20 @@ func TestDetectDark(t *testing.T) {
 	// return true (the deterministic non-TTY default).
 	assert.True(t, DetectDark(), "DetectDark should default to true in non-TTY (test) environment")
 }
+
+func TestThemeAccountColor(t *testing.T) {
+	theme := DefaultTheme(true)
+
+	assert.Equal(t, theme.Muted, theme.AccountColor(0), "no account is muted")
+	seen := make(map[any]int)
+	for i := 1; i <= len(theme.RoomColors); i++ {
+		c := theme.AccountColor(i)
+		if prev, dup := seen[c]; dup {
+			t.Errorf("accounts %d and %d share a color", prev, i)
+		}
+		seen[c] = i
+	}
+	assert.Equal(t, theme.AccountColor(1), theme.AccountColor(len(theme.RoomColors)+1), "the palette wraps")
+
+	assert.Equal(t, Theme{Muted: lipgloss.NoColor{}}.AccountColor(3), lipgloss.NoColor{}, "no palette falls back to muted")
+}
@@ -0,0 +1,72 @@
+package workspace
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/bubbles/v2/key"
+)
+
+// KeyMapSection is one titled block of a keymap cheatsheet, grouped the
+// same way as the help overlay.
+type KeyMapSection struct {
+	Title  string
+	Groups [][]key.Binding
+}
+
+// RenderKeyMapMarkdown renders keymap sections as a printable markdown
+// cheatsheet: one heading and key/action table per section. Disabled
+// bindings, bindings without help text, and repeats within a section are
+// left out; sections with nothing left are dropped.
+func RenderKeyMapMarkdown(title string, sections []KeyMapSection) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n", title)
+
+	for _, section := range sections {
+		rows := keyMapRows(section.Groups)
+		if len(rows) == 0 {
+			continue
+		}
+		fmt.Fprintf(&b, "\n## %s\n\n", section.Title)
+		b.WriteString("| Key | Action |\n")
+		b.WriteString("|-----|--------|\n")
+		for _, row := range rows {
+			fmt.Fprintf(&b, "| %s | %s |\n", markdownKey(row.Key), markdownCell(row.Desc))
+		}
+	}
+
+	return b.String()
+}
+
+// keyMapRows flattens help groups into unique key/description pairs,
+// preserving the order they appear in the help overlay.
+func keyMapRows(groups [][]key.Binding) []key.Help {
+	var rows []key.Help
+	seen := make(map[key.Help]bool)
+	for _, group := range groups {
+		for _, binding := range group {
+			help := binding.Help()
+			if !binding.Enabled() || help.Key == "" || seen[help] {
+				continue
+			}
+			seen[help] = true
+			rows = append(rows, help)
+		}
+	}
+	return rows
+}
+
+// markdownKey formats a key as inline code, widening the fence when the key
+// itself is a backtick.
+func markdownKey(k string) string {
+	k = markdownCell(k)
+	if strings.Contains(k, "`") {
+		return "`` " + k + " ``"
+	}
+	return "`" + k + "`"
+}
+
+// markdownCell escapes pipes so they don't split a table cell.
+func markdownCell(s string) string {
+	return strings.ReplaceAll(s, "|", `\|`)
+}
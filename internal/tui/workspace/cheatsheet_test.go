@@ -0,0 +1,58 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"charm.land/bubbles/v2/key"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderKeyMapMarkdown(t *testing.T) {
+	open := key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open"))
+	disabled := key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "hidden"))
+	disabled.SetEnabled(false)
+
+	out := RenderKeyMapMarkdown("Keys", []KeyMapSection{
+		{Title: "Global", Groups: [][]key.Binding{
+			{open, disabled},
+			{open, key.NewBinding(key.WithKeys("`"), key.WithHelp("`", "pool monitor"))},
+		}},
+		{Title: "Empty", Groups: [][]key.Binding{{disabled}}},
+		{Title: "Cards", Groups: [][]key.Binding{
+			{key.NewBinding(key.WithKeys("|"), key.WithHelp("|", "split | join"))},
+		}},
+	})
+
+	assert.Equal(t, "# Keys\n"+
+		"\n## Global\n\n"+
+		"| Key | Action |\n"+
+		"|-----|--------|\n"+
+		"| `enter` | open |\n"+
+		"| `` ` `` | pool monitor |\n"+
+		"\n## Cards\n\n"+
+		"| Key | Action |\n"+
+		"|-----|--------|\n"+
+		"| `\\|` | split \\| join |\n", out)
+}
+
+func TestEffectiveGlobalKeyMap_AppliesOverrides(t *testing.T) {
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", dir)
+	t.Setenv("HOME", dir)
+
+	path, err := KeyOverridesPath()
+	if err != nil {
+		t.Skip("no user config dir on this platform")
+	}
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+	require.NoError(t, os.WriteFile(path, []byte(`{"hey": "ctrl+h"}`), 0o644))
+
+	keys, err := EffectiveGlobalKeyMap()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ctrl+h"}, keys.Hey.Keys())
+	assert.Equal(t, "hey! inbox", keys.Hey.Help().Desc)
+	assert.Equal(t, []string{"q"}, keys.Quit.Keys())
+}
@@ -30,6 +30,11 @@ type AccountSwitchCloseMsg struct{}
 type AccountSwitcher struct {
 	styles *tui.Styles
 
+	// title heads the overlay; withAll offers "All Accounts" (numbered 0)
+	// ahead of the real accounts, which otherwise number from 1.
+	title   string
+	withAll bool
+
 	accounts []AccountEntry
 	cursor   int
 	err      error
@@ -39,8 +44,19 @@ type AccountSwitcher struct {
 
 // NewAccountSwitcher creates a new account switcher component.
 func NewAccountSwitcher(styles *tui.Styles) AccountSwitcher {
+	return AccountSwitcher{
+		styles:  styles,
+		title:   "Switch Account",
+		withAll: true,
+	}
+}
+
+// NewAccountPicker creates an account switcher that only offers real
+// accounts, for choosing a single default account.
+func NewAccountPicker(styles *tui.Styles, title string) AccountSwitcher {
 	return AccountSwitcher{
 		styles: styles,
+		title:  title,
 	}
 }
 
@@ -48,7 +64,7 @@ func NewAccountSwitcher(styles *tui.Styles) AccountSwitcher {
 func (a *AccountSwitcher) Focus(accounts []AccountEntry) tea.Cmd {
 	a.cursor = 0
 	a.err = nil
-	if len(accounts) > 1 {
+	if a.withAll && len(accounts) > 1 {
 		a.accounts = append([]AccountEntry{{ID: "", Name: "All Accounts"}}, accounts...)
 	} else {
 		a.accounts = accounts
@@ -109,8 +125,8 @@ func (a *AccountSwitcher) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 
 	// Digit-key selection: 0 selects "All Accounts", 1-9 select accounts.
 	if runes := []rune(msg.Text); len(runes) == 1 && runes[0] >= '0' && runes[0] <= '9' {
-		idx := int(runes[0] - '0')
-		if idx < len(a.accounts) {
+		idx := int(runes[0]-'0') - a.firstNumber()
+		if idx >= 0 && idx < len(a.accounts) {
 			acct := a.accounts[idx]
 			return func() tea.Msg {
 				return AccountSwitchedMsg{
@@ -123,6 +139,14 @@ func (a *AccountSwitcher) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 	return nil
 }
 
+// firstNumber is the digit shown beside the first entry.
+func (a AccountSwitcher) firstNumber() int {
+	if a.withAll {
+		return 0
+	}
+	return 1
+}
+
 // maxSwitcherItems is the maximum number of accounts shown at once.
 const maxSwitcherItems = 12
 
@@ -149,7 +173,7 @@ func (a AccountSwitcher) View() string {
 	title := lipgloss.NewStyle().
 		Foreground(theme.Primary).
 		Bold(true).
-		Render("Switch Account")
+		Render(a.title)
 
 	// Separator
 	sep := lipgloss.NewStyle().
@@ -181,11 +205,18 @@ func (a AccountSwitcher) View() string {
 		for vi, acct := range visible {
 			i := start + vi
 			// Number prefix: "0" for All Accounts, "1"-"9" for real accounts
-			numStr := fmt.Sprintf("%d", i)
+			numStr := fmt.Sprintf("%d", i+a.firstNumber())
 			numPrefix := lipgloss.NewStyle().Foreground(theme.Muted).Render(numStr + "  ")
 
+			// A swatch in the account's color, matching the breadcrumb badge
+			// and the cross-account list rows
+			swatch := "  "
+			if acct.ID != "" {
+				swatch = lipgloss.NewStyle().Foreground(theme.AccountColor(i + a.firstNumber())).Render("● ")
+			}
+
 			name := lipgloss.NewStyle().Foreground(theme.Primary).Render(acct.Name)
-			line := numPrefix + name
+			line := numPrefix + swatch + name
 			if acct.ID != "" {
 				line += lipgloss.NewStyle().Foreground(theme.Muted).Render("  #" + acct.ID)
 			}
@@ -193,7 +224,11 @@ func (a AccountSwitcher) View() string {
 
 			if i == a.cursor {
 				hlNum := lipgloss.NewStyle().Foreground(theme.Muted).Background(theme.Border).Render(numStr + "  ")
-				highlighted := hlNum + lipgloss.NewStyle().Foreground(theme.Primary).Background(theme.Border).Render(acct.Name)
+				hlSwatch := lipgloss.NewStyle().Background(theme.Border).Render("  ")
+				if acct.ID != "" {
+					hlSwatch = lipgloss.NewStyle().Foreground(theme.AccountColor(i + a.firstNumber())).Background(theme.Border).Render("● ")
+				}
+				highlighted := hlNum + hlSwatch + lipgloss.NewStyle().Foreground(theme.Primary).Background(theme.Border).Render(acct.Name)
 				if acct.ID != "" {
 					highlighted += lipgloss.NewStyle().Foreground(theme.Muted).Background(theme.Border).Render("  #" + acct.ID)
 				}
@@ -207,7 +242,7 @@ func (a AccountSwitcher) View() string {
 	}
 
 	// Footer hint
-	footer := lipgloss.NewStyle().Foreground(theme.Muted).Render("0-9/enter select  esc cancel")
+	footer := lipgloss.NewStyle().Foreground(theme.Muted).Render(fmt.Sprintf("%d-9/enter select  esc cancel", a.firstNumber()))
 
 	// Assemble
 	sections := make([]string, 0, 2+len(rows)+2)
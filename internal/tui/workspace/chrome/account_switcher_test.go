@@ -1,9 +1,11 @@
 package chrome
 
 import (
+	"strings"
 	"testing"
 
 	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -88,3 +90,21 @@ func TestAccountSwitcher_NumberedDisplay(t *testing.T) {
 	// Verify footer hint
 	assert.Contains(t, view, "0-9/enter select")
 }
+
+func TestAccountSwitcher_AccountSwatches(t *testing.T) {
+	accounts := []AccountEntry{
+		{ID: "1", Name: "Acme Corp"},
+		{ID: "2", Name: "Beta Inc"},
+		{ID: "3", Name: "Gamma LLC"},
+	}
+	s := testSwitcher(accounts)
+	theme := s.styles.Theme()
+
+	view := s.View()
+	// The cursor sits on All Accounts, so every real account renders plain
+	for i := 1; i <= len(accounts); i++ {
+		swatch := lipgloss.NewStyle().Foreground(theme.AccountColor(i)).Render("● ")
+		assert.Contains(t, view, swatch, "account %d should show its color", i)
+	}
+	assert.Equal(t, len(accounts), strings.Count(view, "●"), "All Accounts has no swatch")
+}
@@ -38,7 +38,7 @@ func (b *Breadcrumb) SetAccountBadge(label string, global bool) {
 }
 
 // SetAccountBadgeIndexed sets a scoped account badge with a numbered index.
-// The index is rendered in Foreground and the name in Muted to visually
+// The badge takes the account's color, and the index is bold, to visually
 // connect to the account switcher's numbered shortcuts.
 func (b *Breadcrumb) SetAccountBadgeIndexed(index int, name string) {
 	b.accountBadge = name
@@ -118,12 +118,14 @@ func (b Breadcrumb) View() string {
 				Foreground(theme.Secondary).
 				Render("[" + b.accountBadge + "]")
 		} else if b.badgeIndex > 0 {
-			// Indexed scoped badge: index in Foreground, name in Muted
+			// Indexed scoped badge in the account's color, index bold
+			accent := theme.AccountColor(b.badgeIndex)
 			idxPart := lipgloss.NewStyle().
-				Foreground(theme.Foreground).
+				Foreground(accent).
+				Bold(true).
 				Render(fmt.Sprintf("[%d:", b.badgeIndex))
 			namePart := lipgloss.NewStyle().
-				Foreground(theme.Muted).
+				Foreground(accent).
 				Render(b.accountBadge + "]")
 			badge = idxPart + namePart
 		} else {
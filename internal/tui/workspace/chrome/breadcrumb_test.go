@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"charm.land/lipgloss/v2"
+	"github.com/stretchr/testify/assert"
 
 	"github.com/basecamp/basecamp-cli/internal/tui"
 )
@@ -101,3 +102,16 @@ func containsEllipsis(s string) bool {
 	}
 	return false
 }
+
+func TestBreadcrumb_IndexedBadgeTakesAccountColor(t *testing.T) {
+	styles := tui.NewStyles()
+	b := NewBreadcrumb(styles)
+	b.SetWidth(80)
+	b.SetCrumbs([]string{"Projects"})
+	b.SetAccountBadgeIndexed(3, "Gamma")
+
+	accent := styles.Theme().AccountColor(3)
+	view := b.View()
+	assert.Contains(t, view, lipgloss.NewStyle().Foreground(accent).Render("Gamma]"))
+	assert.Contains(t, view, lipgloss.NewStyle().Foreground(accent).Bold(true).Render("[3:"))
+}
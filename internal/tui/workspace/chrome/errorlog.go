@@ -0,0 +1,215 @@
+package chrome
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/basecamp/basecamp-cli/internal/tui"
+)
+
+// errorLogSize is how many errors the log keeps; older ones are dropped.
+const errorLogSize = 50
+
+// ErrorEntry is one error kept by the error log.
+type ErrorEntry struct {
+	At      time.Time
+	View    string // title of the view that was showing
+	Context string // what was being attempted
+	Message string // the full error text, not the toast's shortened one
+}
+
+// ErrorLogCopiedMsg reports that the error log put entries on the clipboard.
+type ErrorLogCopiedMsg struct {
+	Count int
+}
+
+// ErrorLog keeps the most recent errors and renders them as a full-screen
+// overlay, newest first, so a failure that only flashed by as a toast can
+// be read in full and copied into a report.
+type ErrorLog struct {
+	styles  *tui.Styles
+	width   int
+	height  int
+	entries []ErrorEntry // oldest first
+	cursor  int          // index into the newest-first list
+	offset  int
+}
+
+// NewErrorLog creates an empty error log.
+func NewErrorLog(styles *tui.Styles) ErrorLog {
+	return ErrorLog{styles: styles}
+}
+
+// SetSize sets the available dimensions for the overlay.
+func (l *ErrorLog) SetSize(width, height int) {
+	l.width = width
+	l.height = height
+}
+
+// Record adds an error, dropping the oldest once the log is full.
+func (l *ErrorLog) Record(e ErrorEntry) {
+	l.entries = append(l.entries, e)
+	if over := len(l.entries) - errorLogSize; over > 0 {
+		l.entries = append(l.entries[:0:0], l.entries[over:]...)
+	}
+}
+
+// Entries returns the recorded errors, newest first.
+func (l ErrorLog) Entries() []ErrorEntry {
+	out := make([]ErrorEntry, 0, len(l.entries))
+	for i := len(l.entries) - 1; i >= 0; i-- {
+		out = append(out, l.entries[i])
+	}
+	return out
+}
+
+// Update processes key events for the overlay. It returns true when the
+// overlay should be closed; c copies the selected error and C all of them.
+func (l *ErrorLog) Update(msg tea.KeyPressMsg) (shouldClose bool, cmd tea.Cmd) {
+	switch msg.String() {
+	case "esc", "q", "ctrl+x":
+		return true, nil
+	case "j", "down":
+		l.moveCursor(1)
+	case "k", "up":
+		l.moveCursor(-1)
+	case "ctrl+d":
+		l.moveCursor(l.visibleHeight() / 4)
+	case "ctrl+u":
+		l.moveCursor(-l.visibleHeight() / 4)
+	case "c":
+		entries := l.Entries()
+		if l.cursor < len(entries) {
+			return false, l.copy(entries[l.cursor : l.cursor+1])
+		}
+	case "C":
+		if len(l.entries) > 0 {
+			return false, l.copy(l.Entries())
+		}
+	}
+	return false, nil
+}
+
+func (l *ErrorLog) copy(entries []ErrorEntry) tea.Cmd {
+	n := len(entries)
+	return tea.Batch(
+		tea.SetClipboard(FormatErrorEntries(entries)),
+		func() tea.Msg { return ErrorLogCopiedMsg{Count: n} },
+	)
+}
+
+// ResetScroll returns to the newest error.
+func (l *ErrorLog) ResetScroll() {
+	l.cursor = 0
+	l.offset = 0
+}
+
+// FormatErrorEntries renders entries as plain text for a bug report.
+func FormatErrorEntries(entries []ErrorEntry) string {
+	var b strings.Builder
+	for i, e := range entries {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "[%s] %s\n", e.At.Format(time.RFC3339), errorHeading(e))
+		b.WriteString(e.Message)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// errorHeading names where an error happened: "Todos › loading todos".
+func errorHeading(e ErrorEntry) string {
+	switch {
+	case e.View != "" && e.Context != "":
+		return e.View + " › " + e.Context
+	case e.Context != "":
+		return e.Context
+	case e.View != "":
+		return e.View
+	}
+	return "error"
+}
+
+// visibleHeight returns the number of content lines that fit, less the
+// container padding and the footer, as in Help.
+func (l ErrorLog) visibleHeight() int {
+	return max(1, l.height-4)
+}
+
+func (l *ErrorLog) moveCursor(delta int) {
+	l.cursor = min(max(l.cursor+delta, 0), max(len(l.entries)-1, 0))
+	lines, lineOf := l.layout()
+	if len(lineOf) == 0 {
+		return
+	}
+	// Keep the whole selected entry on screen when it fits.
+	start := lineOf[l.cursor]
+	end := len(lines)
+	if l.cursor+1 < len(lineOf) {
+		end = lineOf[l.cursor+1]
+	}
+	visible := l.visibleHeight()
+	if end-l.offset > visible {
+		l.offset = end - visible
+	}
+	if start < l.offset {
+		l.offset = start
+	}
+	l.offset = min(max(l.offset, 0), max(len(lines)-visible, 0))
+}
+
+// layout renders the content lines and returns the line each entry starts on.
+func (l ErrorLog) layout() (lines []string, lineOf []int) {
+	theme := l.styles.Theme()
+	muted := lipgloss.NewStyle().Foreground(theme.Muted)
+	heading := lipgloss.NewStyle().Foreground(theme.Foreground)
+	message := lipgloss.NewStyle().Foreground(theme.Error).Width(max(10, l.width-8))
+
+	title := lipgloss.NewStyle().Bold(true).Foreground(theme.Primary).
+		Render(fmt.Sprintf("Errors (%d)", len(l.entries)))
+	lines = append(lines, title, "")
+
+	entries := l.Entries()
+	if len(entries) == 0 {
+		return append(lines, muted.Render("No errors this session")), nil
+	}
+	for i, e := range entries {
+		marker, h := "  ", heading
+		if i == l.cursor {
+			marker, h = "› ", heading.Bold(true)
+		}
+		lineOf = append(lineOf, len(lines))
+		lines = append(lines, marker+muted.Render(e.At.Format("15:04:05"))+"  "+h.Render(errorHeading(e)))
+		for _, ml := range strings.Split(message.Render(e.Message), "\n") {
+			lines = append(lines, "    "+ml)
+		}
+		lines = append(lines, "")
+	}
+	return lines, lineOf
+}
+
+// View renders the error log overlay.
+func (l ErrorLog) View() string {
+	theme := l.styles.Theme()
+
+	lines, _ := l.layout()
+	visible := l.visibleHeight()
+	if len(lines) > visible {
+		start := min(l.offset, len(lines))
+		lines = lines[start:min(start+visible, len(lines))]
+	}
+
+	footer := lipgloss.NewStyle().Foreground(theme.Muted).
+		Render("j/k select  c copy  C copy all  esc close")
+
+	container := lipgloss.NewStyle().
+		Width(l.width).
+		Height(l.height).
+		Padding(1, 2)
+	return container.Render(lipgloss.JoinVertical(lipgloss.Left, strings.Join(lines, "\n"), "", footer))
+}
@@ -0,0 +1,70 @@
+package chrome
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/tui"
+)
+
+func testErrorLog(n int) ErrorLog {
+	l := NewErrorLog(tui.NewStyles())
+	l.SetSize(80, 20)
+	start := time.Date(2026, 10, 16, 9, 0, 0, 0, time.UTC)
+	for i := range n {
+		l.Record(ErrorEntry{
+			At:      start.Add(time.Duration(i) * time.Minute),
+			View:    "Todos",
+			Context: "loading todos",
+			Message: fmt.Sprintf("error %d", i),
+		})
+	}
+	return l
+}
+
+func TestErrorLog_KeepsNewestFifty(t *testing.T) {
+	l := testErrorLog(60)
+
+	entries := l.Entries()
+	require.Len(t, entries, 50)
+	assert.Equal(t, "error 59", entries[0].Message, "newest first")
+	assert.Equal(t, "error 10", entries[49].Message, "the oldest ten are dropped")
+}
+
+func TestErrorLog_ViewShowsTimestampContextAndMessage(t *testing.T) {
+	l := testErrorLog(1)
+
+	view := l.View()
+	assert.Contains(t, view, "Errors (1)")
+	assert.Contains(t, view, "09:00:00")
+	assert.Contains(t, view, "Todos › loading todos")
+	assert.Contains(t, view, "error 0")
+
+	empty := NewErrorLog(tui.NewStyles())
+	empty.SetSize(80, 20)
+	assert.Contains(t, empty.View(), "No errors this session")
+}
+
+func TestErrorLog_CopySelected(t *testing.T) {
+	l := testErrorLog(3)
+
+	l.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	closed, cmd := l.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	assert.False(t, closed)
+	require.NotNil(t, cmd)
+
+	assert.Equal(t, "[2026-10-16T09:01:00Z] Todos › loading todos\nerror 1\n",
+		FormatErrorEntries(l.Entries()[l.cursor:l.cursor+1]))
+}
+
+func TestErrorLog_EscCloses(t *testing.T) {
+	l := testErrorLog(1)
+
+	closed, _ := l.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.True(t, closed)
+}
@@ -10,7 +10,21 @@ import (
 	"github.com/basecamp/basecamp-cli/internal/tui"
 )
 
-// Help renders the full-screen keyboard shortcuts overlay.
+// HelpRunMsg asks the workspace to run the binding picked in the help
+// overlay, as if its key had been pressed.
+type HelpRunMsg struct {
+	Key string
+}
+
+// helpRow is one binding listed in the overlay.
+type helpRow struct {
+	section string
+	binding key.Binding
+}
+
+// Help renders the full-screen keyboard shortcuts overlay. It doubles as an
+// action menu: j/k select a binding and enter runs it, and / filters the
+// list by key or description.
 type Help struct {
 	styles     *tui.Styles
 	width      int
@@ -19,6 +33,9 @@ type Help struct {
 	viewTitle  string
 	viewKeys   [][]key.Binding
 	offset     int
+	cursor     int // index into rows()
+	searching  bool
+	query      string
 }
 
 // NewHelp creates a new help overlay component.
@@ -35,6 +52,7 @@ func (h *Help) SetSize(width, height int) {
 // SetGlobalKeys sets the global keybinding groups displayed in the overlay.
 func (h *Help) SetGlobalKeys(keys [][]key.Binding) {
 	h.globalKeys = keys
+	h.clampCursor()
 }
 
 // SetViewTitle sets the name of the current view's section header.
@@ -45,37 +63,114 @@ func (h *Help) SetViewTitle(title string) {
 // SetViewKeys sets the view-specific keybinding groups.
 func (h *Help) SetViewKeys(keys [][]key.Binding) {
 	h.viewKeys = keys
+	h.clampCursor()
 }
 
 // Update processes key events for the help overlay. It returns true when
-// the overlay should be closed.
+// the overlay should be closed; picking a binding also returns a command
+// that sends HelpRunMsg.
 func (h *Help) Update(msg tea.KeyPressMsg) (shouldClose bool, cmd tea.Cmd) {
 	switch msg.String() {
-	case "esc", "q", "?":
-		return true, nil
-	case "j", "down":
-		h.offset++
-		h.clampOffset()
+	case "enter":
+		return h.run()
+	case "down", "ctrl+j", "ctrl+n":
+		h.moveCursor(1)
 		return false, nil
-	case "k", "up":
-		h.offset--
-		h.clampOffset()
+	case "up", "ctrl+k", "ctrl+p":
+		h.moveCursor(-1)
 		return false, nil
 	case "ctrl+d":
-		h.offset += h.visibleHeight() / 2
-		h.clampOffset()
+		h.moveCursor(h.visibleHeight() / 2)
 		return false, nil
 	case "ctrl+u":
-		h.offset -= h.visibleHeight() / 2
-		h.clampOffset()
+		h.moveCursor(-h.visibleHeight() / 2)
 		return false, nil
 	}
+
+	if h.searching {
+		return false, h.updateSearch(msg)
+	}
+
+	switch msg.String() {
+	case "esc", "q", "?":
+		return true, nil
+	case "j":
+		h.moveCursor(1)
+	case "k":
+		h.moveCursor(-1)
+	case "/":
+		h.searching = true
+	}
 	return false, nil
 }
 
-// ResetScroll resets the scroll position to the top.
+// updateSearch edits the filter query. Esc, or backspace on an empty
+// query, leaves search with the full list back.
+func (h *Help) updateSearch(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc":
+		h.searching = false
+		h.query = ""
+	case "backspace":
+		if h.query == "" {
+			h.searching = false
+			break
+		}
+		r := []rune(h.query)
+		h.query = string(r[:len(r)-1])
+	default:
+		if msg.Text == "" {
+			return nil
+		}
+		h.query += msg.Text
+	}
+	h.cursor, h.offset = 0, 0
+	return nil
+}
+
+// run closes the overlay and sends the selected binding's key.
+func (h *Help) run() (bool, tea.Cmd) {
+	rows := h.rows()
+	if h.cursor >= len(rows) {
+		return false, nil
+	}
+	b := rows[h.cursor].binding
+	keys := b.Keys()
+	if !b.Enabled() || len(keys) == 0 {
+		return false, nil
+	}
+	return true, func() tea.Msg { return HelpRunMsg{Key: keys[0]} }
+}
+
+// ResetScroll returns to the top of the full list, leaving search.
 func (h *Help) ResetScroll() {
 	h.offset = 0
+	h.cursor = 0
+	h.searching = false
+	h.query = ""
+}
+
+// rows lists the bindings that match the query, global ones first.
+func (h Help) rows() []helpRow {
+	q := strings.ToLower(strings.TrimSpace(h.query))
+	var rows []helpRow
+	add := func(section string, groups [][]key.Binding) {
+		for _, group := range groups {
+			for _, b := range group {
+				help := b.Help()
+				if q != "" && !strings.Contains(strings.ToLower(help.Key), q) &&
+					!strings.Contains(strings.ToLower(help.Desc), q) {
+					continue
+				}
+				rows = append(rows, helpRow{section: section, binding: b})
+			}
+		}
+	}
+	add("Global", h.globalKeys)
+	if h.viewTitle != "" {
+		add(h.viewTitle, h.viewKeys)
+	}
+	return rows
 }
 
 // visibleHeight returns the number of content lines that fit in the viewport.
@@ -89,6 +184,27 @@ func (h Help) visibleHeight() int {
 	return vh
 }
 
+// moveCursor moves the selection and scrolls along with it, keeping the
+// selected binding where the first one sits until the list runs out.
+func (h *Help) moveCursor(delta int) {
+	h.cursor += delta
+	h.clampCursor()
+	_, lineOf := h.layout()
+	if len(lineOf) > 0 {
+		h.offset = lineOf[h.cursor] - lineOf[0]
+	}
+	h.clampOffset()
+}
+
+func (h *Help) clampCursor() {
+	if n := len(h.rows()); h.cursor >= n {
+		h.cursor = n - 1
+	}
+	if h.cursor < 0 {
+		h.cursor = 0
+	}
+}
+
 func (h *Help) clampOffset() {
 	if h.offset < 0 {
 		h.offset = 0
@@ -104,56 +220,55 @@ func (h *Help) clampOffset() {
 
 // contentLineCount returns the number of rendered content lines (excluding footer).
 func (h Help) contentLineCount() int {
-	count := 2 // title + blank line
-	count++    // "Global" header
-	for _, row := range h.globalKeys {
-		count += len(row)
-	}
-	if h.viewTitle != "" && len(h.viewKeys) > 0 {
-		count++ // blank line
-		count++ // section header
-		for _, row := range h.viewKeys {
-			count += len(row)
-		}
-	}
-	return count
+	lines, _ := h.layout()
+	return len(lines)
 }
 
-// View renders the help overlay.
-func (h Help) View() string {
+// layout renders the content lines and returns the line each row is on.
+func (h Help) layout() (lines []string, lineOf []int) {
 	theme := h.styles.Theme()
 
 	keyCol := lipgloss.NewStyle().Foreground(theme.Primary).Width(16)
 	descCol := lipgloss.NewStyle().Foreground(theme.Muted)
+	selected := lipgloss.NewStyle().Foreground(theme.Foreground).Bold(true)
 	sectionHeader := lipgloss.NewStyle().Bold(true).Foreground(theme.Foreground)
 
-	var lines []string
-
-	// Title
-	lines = append(lines,
-		lipgloss.NewStyle().Bold(true).Foreground(theme.Primary).Render("Keyboard Shortcuts"))
-	lines = append(lines, "")
-
-	// Global keys
-	lines = append(lines, sectionHeader.Render("Global"))
-	for _, row := range h.globalKeys {
-		for _, k := range row {
-			help := k.Help()
-			lines = append(lines, "  "+keyCol.Render(help.Key)+descCol.Render(help.Desc))
-		}
+	// Title, with the query while searching
+	title := lipgloss.NewStyle().Bold(true).Foreground(theme.Primary).Render("Keyboard Shortcuts")
+	if h.searching {
+		title += "  " + descCol.Render("/") + h.query + descCol.Render("▏")
 	}
+	lines = append(lines, title, "")
 
-	// View-specific keys
-	if h.viewTitle != "" && len(h.viewKeys) > 0 {
-		lines = append(lines, "")
-		lines = append(lines, sectionHeader.Render(h.viewTitle))
-		for _, row := range h.viewKeys {
-			for _, k := range row {
-				help := k.Help()
-				lines = append(lines, "  "+keyCol.Render(help.Key)+descCol.Render(help.Desc))
+	rows := h.rows()
+	section := ""
+	for i, row := range rows {
+		if row.section != section {
+			if section != "" {
+				lines = append(lines, "")
 			}
+			section = row.section
+			lines = append(lines, sectionHeader.Render(section))
 		}
+		help := row.binding.Help()
+		line := "  " + keyCol.Render(help.Key) + descCol.Render(help.Desc)
+		if i == h.cursor {
+			line = "› " + keyCol.Bold(true).Render(help.Key) + selected.Render(help.Desc)
+		}
+		lineOf = append(lineOf, len(lines))
+		lines = append(lines, line)
+	}
+	if len(rows) == 0 && h.query != "" {
+		lines = append(lines, descCol.Render("No matching shortcuts"))
 	}
+	return lines, lineOf
+}
+
+// View renders the help overlay.
+func (h Help) View() string {
+	theme := h.styles.Theme()
+
+	lines, _ := h.layout()
 
 	// Window the content lines
 	visible := h.visibleHeight()
@@ -173,9 +288,14 @@ func (h Help) View() string {
 	}
 
 	// Footer
-	footerText := "esc close"
-	if overflows {
-		footerText = "j/k scroll  esc close"
+	var footerText string
+	switch {
+	case h.searching:
+		footerText = "↑/↓ select  enter run  esc clear"
+	case overflows:
+		footerText = "j/k scroll  enter run  / search  esc close"
+	default:
+		footerText = "j/k select  enter run  / search  esc close"
 	}
 	footer := lipgloss.NewStyle().Foreground(theme.Muted).Render(footerText)
 
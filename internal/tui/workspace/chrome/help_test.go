@@ -146,3 +146,94 @@ func TestHelp_Overflow_ShowsScrollHint(t *testing.T) {
 	assert.Contains(t, view, "j/k scroll")
 	assert.Contains(t, view, "esc close")
 }
+
+func namedBindings() [][]key.Binding {
+	return [][]key.Binding{{
+		key.NewBinding(key.WithKeys("ctrl+p"), key.WithHelp("ctrl+p", "command palette")),
+		key.NewBinding(key.WithKeys("r"), key.WithHelp("r", "refresh")),
+		key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit")),
+	}}
+}
+
+func typeText(h *Help, s string) {
+	for _, r := range s {
+		h.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+}
+
+func TestHelp_EnterRunsSelectedBinding(t *testing.T) {
+	h := testHelp(80, 30)
+	h.SetGlobalKeys(namedBindings())
+
+	h.Update(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	shouldClose, cmd := h.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	assert.True(t, shouldClose)
+	if assert.NotNil(t, cmd) {
+		assert.Equal(t, HelpRunMsg{Key: "r"}, cmd())
+	}
+}
+
+func TestHelp_SearchFiltersBindings(t *testing.T) {
+	h := testHelp(80, 30)
+	h.SetGlobalKeys(namedBindings())
+
+	h.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	typeText(&h, "pal")
+
+	rows := h.rows()
+	if assert.Len(t, rows, 1) {
+		assert.Equal(t, "command palette", rows[0].binding.Help().Desc)
+	}
+	assert.Equal(t, "pal", h.query)
+
+	shouldClose, cmd := h.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.True(t, shouldClose)
+	if assert.NotNil(t, cmd) {
+		assert.Equal(t, HelpRunMsg{Key: "ctrl+p"}, cmd())
+	}
+}
+
+func TestHelp_SearchTypesCloseKeys(t *testing.T) {
+	h := testHelp(80, 30)
+	h.SetGlobalKeys(namedBindings())
+
+	h.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	shouldClose, _ := h.Update(tea.KeyPressMsg{Code: 'q', Text: "q"})
+	assert.False(t, shouldClose, "q is part of the query while searching")
+	assert.Len(t, h.rows(), 1)
+
+	// Esc leaves search before it closes the overlay.
+	shouldClose, _ = h.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.False(t, shouldClose)
+	assert.Len(t, h.rows(), 3)
+
+	shouldClose, _ = h.Update(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.True(t, shouldClose)
+}
+
+func TestHelp_SearchNoMatches(t *testing.T) {
+	h := testHelp(80, 30)
+	h.SetGlobalKeys(namedBindings())
+
+	h.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	typeText(&h, "zzz")
+
+	assert.Contains(t, h.View(), "No matching shortcuts")
+	shouldClose, cmd := h.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.False(t, shouldClose)
+	assert.Nil(t, cmd)
+}
+
+func TestHelp_ResetScrollClearsSearch(t *testing.T) {
+	h := testHelp(80, 30)
+	h.SetGlobalKeys(namedBindings())
+
+	h.Update(tea.KeyPressMsg{Code: '/', Text: "/"})
+	typeText(&h, "quit")
+	h.ResetScroll()
+
+	assert.False(t, h.searching)
+	assert.Len(t, h.rows(), 3)
+	assert.Equal(t, 0, h.cursor)
+}
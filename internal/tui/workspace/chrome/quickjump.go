@@ -34,6 +34,9 @@ type QuickJumpExecMsg struct {
 type QuickJump struct {
 	styles *tui.Styles
 
+	title  string
+	footer string
+
 	input    textinput.Model
 	items    []quickJumpItem
 	filtered []quickJumpItem
@@ -51,10 +54,19 @@ func NewQuickJump(styles *tui.Styles) QuickJump {
 
 	return QuickJump{
 		styles: styles,
+		title:  "Jump to...",
+		footer: "↑/↓ navigate  enter jump  esc cancel",
 		input:  ti,
 	}
 }
 
+// SetLabels replaces the overlay's title and key hint footer, for reuse as
+// a plain project picker.
+func (q *QuickJump) SetLabels(title, footer string) {
+	q.title = title
+	q.footer = footer
+}
+
 // QuickJumpSource provides the data needed to populate the quick-jump list.
 // This avoids importing workspace/data and recents directly, breaking the
 // dependency direction.
@@ -318,7 +330,7 @@ func (q *QuickJump) refilter() {
 	} else {
 		q.filtered = q.filtered[:0]
 		for _, item := range q.items {
-			if quickJumpFuzzyMatch(item.Title, query) {
+			if tui.FuzzyMatch(item.Title, query) {
 				q.filtered = append(q.filtered, item)
 			}
 		}
@@ -331,19 +343,6 @@ func (q *QuickJump) refilter() {
 	}
 }
 
-// quickJumpFuzzyMatch performs subsequence matching.
-func quickJumpFuzzyMatch(s, query string) bool {
-	s = strings.ToLower(s)
-	queryRunes := []rune(strings.ToLower(query))
-	qi := 0
-	for _, r := range s {
-		if qi < len(queryRunes) && r == queryRunes[qi] {
-			qi++
-		}
-	}
-	return qi == len(queryRunes)
-}
-
 // categoryLabel maps internal category keys to display labels.
 func categoryLabel(cat string) string {
 	switch cat {
@@ -388,7 +387,7 @@ func (q QuickJump) View() string {
 	title := lipgloss.NewStyle().
 		Foreground(theme.Primary).
 		Bold(true).
-		Render("Jump to...")
+		Render(q.title)
 
 	// Separator
 	sep := lipgloss.NewStyle().
@@ -436,7 +435,7 @@ func (q QuickJump) View() string {
 	}
 
 	// Footer
-	footer := lipgloss.NewStyle().Foreground(theme.Muted).Render("↑/↓ navigate  enter jump  esc cancel")
+	footer := lipgloss.NewStyle().Foreground(theme.Muted).Render(q.footer)
 
 	// Assemble
 	sections := make([]string, 0, 4+len(rows)+2)
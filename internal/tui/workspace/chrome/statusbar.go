@@ -18,8 +18,13 @@ type PoolMetricsSummary struct {
 	ActivePools int
 	P50Latency  time.Duration
 	ErrorRate   float64
+	LastFetch   time.Time // most recent successful fetch; zero hides the age
 }
 
+// statusBarNow is the clock the refresh age is measured against.
+// Extracted for testability.
+var statusBarNow = time.Now
+
 // StatusBar renders the bottom status bar with key hints and status info.
 type StatusBar struct {
 	styles          *tui.Styles
@@ -32,6 +37,9 @@ type StatusBar struct {
 	keyHints        []key.Binding
 	globalHints     []key.Binding
 	metrics         *PoolMetricsSummary
+	filterQuery     string
+	filterMatched   int
+	filterTotal     int
 }
 
 // NewStatusBar creates a new status bar.
@@ -83,6 +91,14 @@ func (s *StatusBar) SetGlobalHints(hints []key.Binding) {
 	s.globalHints = hints
 }
 
+// SetFilter pins a list filter chip to the left of the key hints. An empty
+// query removes it.
+func (s *StatusBar) SetFilter(query string, matched, total int) {
+	s.filterQuery = query
+	s.filterMatched = matched
+	s.filterTotal = total
+}
+
 // SetMetrics updates the pool health metrics display.
 func (s *StatusBar) SetMetrics(summary *PoolMetricsSummary) {
 	s.metrics = summary
@@ -131,6 +147,13 @@ func (s StatusBar) View() string {
 		}
 	}
 	left := strings.Join(hints, "  ")
+	if chip := s.renderFilterChip(theme); chip != "" {
+		if left != "" {
+			left = chip + "  " + left
+		} else {
+			left = chip
+		}
+	}
 
 	// Build right side: metrics + status/hints
 	metricsStr := s.renderMetrics(theme)
@@ -166,7 +189,25 @@ func (s StatusBar) View() string {
 	return barStyle.MaxWidth(s.width).Render(left + strings.Repeat(" ", gap) + right)
 }
 
-// renderMetrics renders the pool health indicator: ● 4 pools · 180ms
+// filterChipMaxQuery bounds how much of the filter query the chip shows.
+const filterChipMaxQuery = 24
+
+// renderFilterChip renders the pinned list filter: filter: api · 12/240
+func (s StatusBar) renderFilterChip(theme tui.Theme) string {
+	if s.filterQuery == "" {
+		return ""
+	}
+	query := s.filterQuery
+	if runes := []rune(query); len(runes) > filterChipMaxQuery {
+		query = string(runes[:filterChipMaxQuery-1]) + "…"
+	}
+	return lipgloss.NewStyle().Foreground(theme.Muted).Render("filter: ") +
+		lipgloss.NewStyle().Foreground(theme.Primary).Bold(true).Render(query) +
+		lipgloss.NewStyle().Foreground(theme.Muted).Render(
+			fmt.Sprintf(" · %d/%d", s.filterMatched, s.filterTotal))
+}
+
+// renderMetrics renders the pool health indicator: ● 4 pools · 180ms · ↻ 12s ago
 func (s StatusBar) renderMetrics(theme tui.Theme) string {
 	if s.metrics == nil || s.metrics.ActivePools == 0 {
 		return ""
@@ -179,7 +220,24 @@ func (s StatusBar) renderMetrics(theme tui.Theme) string {
 	}
 	return lipgloss.NewStyle().Foreground(color).Render(indicator) +
 		lipgloss.NewStyle().Foreground(theme.Muted).Render(
-			fmt.Sprintf(" %d pools · %dms", s.metrics.ActivePools, s.metrics.P50Latency.Milliseconds()))
+			fmt.Sprintf(" %d pools · %dms%s", s.metrics.ActivePools, s.metrics.P50Latency.Milliseconds(), s.refreshAge()))
+}
+
+// refreshAge renders how long ago data was last fetched, or "" before the
+// first fetch completes.
+func (s StatusBar) refreshAge() string {
+	if s.metrics.LastFetch.IsZero() {
+		return ""
+	}
+	age := max(statusBarNow().Sub(s.metrics.LastFetch), 0)
+	switch {
+	case age < time.Minute:
+		return fmt.Sprintf(" · ↻ %ds ago", int(age.Seconds()))
+	case age < time.Hour:
+		return fmt.Sprintf(" · ↻ %dm ago", int(age.Minutes()))
+	default:
+		return fmt.Sprintf(" · ↻ %dh ago", int(age.Hours()))
+	}
 }
 
 // renderGlobalHints renders as many global hints as fit given the left zone width.
@@ -3,9 +3,11 @@ package chrome
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/lipgloss/v2"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/basecamp/basecamp-cli/internal/tui"
@@ -175,3 +177,42 @@ func stripAnsi(s string) string {
 	}
 	return result.String()
 }
+
+func TestStatusBar_MetricsShowLastRefreshAge(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	orig := statusBarNow
+	statusBarNow = func() time.Time { return now }
+	t.Cleanup(func() { statusBarNow = orig })
+
+	s := testStatusBar(120)
+	s.SetMetrics(&PoolMetricsSummary{ActivePools: 3, P50Latency: 180 * time.Millisecond})
+	assert.NotContains(t, stripAnsi(s.View()), "↻", "no age before the first fetch")
+
+	s.SetMetrics(&PoolMetricsSummary{ActivePools: 3, LastFetch: now.Add(-12 * time.Second)})
+	assert.Contains(t, stripAnsi(s.View()), "3 pools · 0ms · ↻ 12s ago")
+
+	s.SetMetrics(&PoolMetricsSummary{ActivePools: 3, LastFetch: now.Add(-3 * time.Minute)})
+	assert.Contains(t, stripAnsi(s.View()), "↻ 3m ago")
+}
+
+func TestStatusBar_FilterChip(t *testing.T) {
+	s := testStatusBar(100)
+	s.SetKeyHints([]key.Binding{helpBinding()})
+	s.SetFilter("api", 12, 240)
+
+	view := ansi.Strip(s.View())
+	assert.Contains(t, view, "filter: api · 12/240")
+	assert.Less(t, strings.Index(view, "filter:"), strings.Index(view, "help"), "chip leads the key hints")
+
+	s.SetFilter("", 0, 0)
+	assert.NotContains(t, s.View(), "filter:")
+}
+
+func TestStatusBar_FilterChipTruncatesLongQuery(t *testing.T) {
+	s := testStatusBar(100)
+	s.SetFilter(strings.Repeat("x", 40), 0, 3)
+
+	view := ansi.Strip(s.View())
+	assert.Contains(t, view, strings.Repeat("x", filterChipMaxQuery-1)+"… · 0/3")
+	assert.NotContains(t, view, strings.Repeat("x", filterChipMaxQuery))
+}
@@ -0,0 +1,175 @@
+package workspace
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/basecamp/basecamp-cli/internal/config"
+)
+
+// CustomAction is a palette action defined in config palette_actions.
+// Exactly one of Args and Shell is set; both may use {{variable}}
+// placeholders from config.PaletteVariables.
+type CustomAction struct {
+	Name        string
+	Description string
+	Args        []string // arguments for this binary, split before interpolation
+	Shell       string   // command line for sh -c; values are shell-quoted
+}
+
+// WithCustomActions adds user-defined actions to the command palette.
+func WithCustomActions(actions []CustomAction) Option {
+	return func(w *Workspace) {
+		for _, a := range actions {
+			w.registry.Register(a.action())
+		}
+	}
+}
+
+// action turns a custom action into a palette entry. The scope it needs
+// follows from the variables it uses.
+func (c CustomAction) action() Action {
+	name := c.Name
+	if !strings.HasPrefix(name, ":") {
+		name = ":" + name
+	}
+	desc := c.Description
+	if desc == "" {
+		desc = c.commandLine()
+	}
+
+	a := Action{
+		Name:        name,
+		Description: desc,
+		Category:    "custom",
+		Scope:       ScopeAny,
+		Execute: func(s *Session) tea.Cmd {
+			return runCustomAction(s.Context(), name, c, s.Scope())
+		},
+	}
+	vars, _ := config.PaletteTemplateVars(c.commandLine())
+	var needTool, needRecording bool
+	for _, v := range vars {
+		switch v {
+		case "account_id":
+			if a.Scope == ScopeAny {
+				a.Scope = ScopeAccount
+			}
+		case "tool_id", "tool_type":
+			a.Scope, needTool = ScopeProject, true
+		case "recording_id", "recording_type":
+			a.Scope, needRecording = ScopeProject, true
+		default:
+			a.Scope = ScopeProject
+		}
+	}
+	if needTool || needRecording {
+		a.Available = func(scope Scope) bool {
+			return (!needTool || scope.ToolID != 0) && (!needRecording || scope.RecordingID != 0)
+		}
+	}
+	return a
+}
+
+// commandLine is the action's command as written in config.
+func (c CustomAction) commandLine() string {
+	if c.Shell != "" {
+		return c.Shell
+	}
+	return strings.Join(c.Args, " ")
+}
+
+// command builds the process for the action in the given scope.
+func (c CustomAction) command(ctx context.Context, scope Scope) (*exec.Cmd, error) {
+	values := paletteValues(scope)
+	if c.Shell != "" {
+		return exec.CommandContext(ctx, "sh", "-c", config.ExpandPaletteTemplate(c.Shell, values, config.ShellQuote)), nil //nolint:gosec // G204: the user's own configured command
+	}
+	self, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, len(c.Args))
+	for i, arg := range c.Args {
+		args[i] = config.ExpandPaletteTemplate(arg, values, nil)
+	}
+	cmd := exec.CommandContext(ctx, self, args...) //nolint:gosec // G204: re-invokes this binary with the user's configured arguments
+	cmd.Env = append(os.Environ(), "BASECAMP_NONINTERACTIVE=1")
+	return cmd, nil
+}
+
+// paletteValues maps the palette variables to the scope's values.
+func paletteValues(scope Scope) map[string]string {
+	id := func(n int64) string {
+		if n == 0 {
+			return ""
+		}
+		return strconv.FormatInt(n, 10)
+	}
+	return map[string]string{
+		"account_id":     scope.AccountID,
+		"project_id":     id(scope.ProjectID),
+		"project_name":   scope.ProjectName,
+		"tool_type":      scope.ToolType,
+		"tool_id":        id(scope.ToolID),
+		"recording_id":   id(scope.RecordingID),
+		"recording_type": scope.RecordingType,
+	}
+}
+
+// runCustomAction runs the action in the background and reports the
+// outcome in the status bar.
+func runCustomAction(ctx context.Context, name string, c CustomAction, scope Scope) tea.Cmd {
+	return func() tea.Msg {
+		cmd, err := c.command(ctx, scope)
+		if err != nil {
+			return ErrorMsg{Err: err, Context: "running " + name}
+		}
+		out, err := cmd.CombinedOutput()
+		if err != nil {
+			if line := customActionOutput(out); line != "" {
+				err = fmt.Errorf("%w: %s", err, line)
+			}
+			return ErrorMsg{Err: err, Context: "running " + name}
+		}
+		text := customActionOutput(out)
+		if text == "" {
+			text = "Ran " + name
+		}
+		return StatusMsg{Text: text}
+	}
+}
+
+// maxCustomActionStatus caps the output shown in the status bar.
+const maxCustomActionStatus = 120
+
+// customActionOutput reduces a command's output to one status line: the
+// summary or error of a basecamp JSON envelope, or else the last non-empty
+// line.
+func customActionOutput(out []byte) string {
+	var envelope struct {
+		Summary string `json:"summary"`
+		Error   string `json:"error"`
+	}
+	var line string
+	if json.Unmarshal(out, &envelope) == nil && (envelope.Summary != "" || envelope.Error != "") {
+		line = envelope.Summary
+		if envelope.Error != "" {
+			line = envelope.Error
+		}
+	} else {
+		lines := strings.Split(strings.TrimSpace(string(out)), "\n")
+		line = strings.TrimSpace(lines[len(lines)-1])
+	}
+	if r := []rune(line); len(r) > maxCustomActionStatus {
+		line = string(r[:maxCustomActionStatus-1]) + "…"
+	}
+	return line
+}
@@ -0,0 +1,60 @@
+package workspace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCustomAction_ScopeFollowsVariables(t *testing.T) {
+	anywhere := CustomAction{Name: "hello", Shell: "echo hi"}.action()
+	assert.Equal(t, ":hello", anywhere.Name)
+	assert.Equal(t, "echo hi", anywhere.Description, "command stands in for a missing description")
+	assert.Equal(t, "custom", anywhere.Category)
+	assert.Equal(t, ScopeAny, anywhere.Scope)
+
+	project := CustomAction{Name: ":standup", Args: []string{"chat", "post", "hi", "--in", "{{project_id}}"}}.action()
+	assert.Equal(t, ":standup", project.Name)
+	assert.Equal(t, ScopeProject, project.Scope)
+
+	r := NewRegistry()
+	r.Register(CustomAction{Name: "copy", Shell: "echo {{recording_id}} {{account_id}}"}.action())
+	assert.Empty(t, r.ForScope(Scope{AccountID: "1", ProjectID: 2}), "needs a recording")
+	assert.Len(t, r.ForScope(Scope{AccountID: "1", ProjectID: 2, RecordingID: 3}), 1)
+}
+
+func TestCustomAction_CommandInterpolates(t *testing.T) {
+	scope := Scope{AccountID: "99", ProjectID: 42, ProjectName: "Bob's launch"}
+
+	shell, err := CustomAction{Shell: "notify {{project_name}} {{project_id}}"}.command(context.Background(), scope)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sh", "-c", `notify 'Bob'\''s launch' '42'`}, shell.Args)
+
+	run, err := CustomAction{Args: []string{"projects", "show", "{{project_id}}", "--note={{project_name}}"}}.command(context.Background(), scope)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"projects", "show", "42", "--note=Bob's launch"}, run.Args[1:], "values never split arguments")
+	assert.Contains(t, run.Env, "BASECAMP_NONINTERACTIVE=1")
+}
+
+func TestCustomAction_RunReportsOutput(t *testing.T) {
+	msg := runCustomAction(context.Background(), ":greet", CustomAction{Shell: "echo first; echo {{project_id}}"}, Scope{ProjectID: 7})()
+	assert.Equal(t, StatusMsg{Text: "7"}, msg, "last line of output")
+
+	msg = runCustomAction(context.Background(), ":quiet", CustomAction{Shell: "true"}, Scope{})()
+	assert.Equal(t, StatusMsg{Text: "Ran :quiet"}, msg)
+
+	msg = runCustomAction(context.Background(), ":fail", CustomAction{Shell: "echo nope; exit 3"}, Scope{})()
+	errMsg, ok := msg.(ErrorMsg)
+	require.True(t, ok)
+	assert.Equal(t, "running :fail", errMsg.Context)
+	assert.Contains(t, errMsg.Err.Error(), "nope")
+}
+
+func TestCustomActionOutput(t *testing.T) {
+	assert.Equal(t, "3 todos", customActionOutput([]byte(`{"ok": true, "summary": "3 todos", "data": []}`)))
+	assert.Equal(t, "Not found", customActionOutput([]byte(`{"ok": false, "error": "Not found"}`)))
+	assert.Equal(t, "done", customActionOutput([]byte("working\ndone\n\n")))
+	assert.Empty(t, customActionOutput(nil))
+}
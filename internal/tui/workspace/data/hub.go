@@ -12,6 +12,10 @@ import (
 
 var hubNow = time.Now
 
+// mutationCoalesceWindow batches the re-fetches that follow rapid
+// optimistic mutations (e.g. toggling several todos in a row).
+const mutationCoalesceWindow = 400 * time.Millisecond
+
 // Hub is the central data coordinator providing typed, realm-scoped pool access.
 //
 // Hub manages three realm tiers:
@@ -34,6 +38,7 @@ type Hub struct {
 	metrics         *PoolMetrics
 	roomStore       *RoomStore                     // optional; filters BonfireRooms when non-nil
 	recentProjects  func(accountID string) []int64 // optional; returns recent project IDs scoped to one account
+	refresh         map[string]time.Duration       // per-view poll overrides; negative turns polling off
 	cache           *PoolCache
 }
 
@@ -72,6 +77,39 @@ func (h *Hub) SetRecentProjects(fn func(accountID string) []int64) {
 	h.recentProjects = fn
 }
 
+// SetRefreshIntervals overrides the polling cadence of the pools behind each
+// kind of view ("campfire", "todos", ...). A negative interval turns polling
+// off. Overrides apply to pools created afterwards, so call this before the
+// first view loads.
+func (h *Hub) SetRefreshIntervals(intervals map[string]time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.refresh = intervals
+}
+
+// pollConfig applies the refresh override for view, if any, to a pool's
+// built-in timing.
+func (h *Hub) pollConfig(view string, cfg PoolConfig) PoolConfig {
+	h.mu.RLock()
+	interval, ok := h.refresh[view]
+	h.mu.RUnlock()
+	if !ok {
+		return cfg
+	}
+	if interval < 0 {
+		cfg.PollBase, cfg.PollBg, cfg.PollMax = 0, 0, 0
+		return cfg
+	}
+	cfg.PollBase = interval
+	cfg.PollBg = max(cfg.PollBg, interval)
+	cfg.PollMax = max(cfg.PollMax, interval)
+	// Data must go stale before the next poll fires, or the poll is a no-op.
+	if cfg.FreshTTL == 0 || cfg.FreshTTL >= interval {
+		cfg.FreshTTL = interval * 4 / 5
+	}
+	return cfg
+}
+
 // Global returns the app-lifetime realm.
 func (h *Hub) Global() *Realm {
 	h.mu.RLock()
@@ -198,6 +236,20 @@ func (h *Hub) SetTerminalFocused(focused bool) {
 	}
 }
 
+// InvalidateAll marks every pool in the active realms stale, so the next
+// FetchIfStale on each goes to the network regardless of its TTL.
+func (h *Hub) InvalidateAll() {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	h.global.Invalidate()
+	if h.account != nil {
+		h.account.Invalidate()
+	}
+	if h.project != nil {
+		h.project.Invalidate()
+	}
+}
+
 // Shutdown tears down all realms. Call on program exit.
 func (h *Hub) Shutdown() {
 	h.mu.Lock()
@@ -286,6 +338,7 @@ func (h *Hub) ScheduleEntries(projectID, scheduleID int64) *Pool[[]ScheduleEntry
 					ID:           e.ID,
 					Summary:      title,
 					StartsAt:     startsAt,
+					StartsAtTS:   e.StartsAt.Unix(),
 					EndsAt:       endsAt,
 					AllDay:       e.AllDay,
 					Participants: names,
@@ -493,7 +546,7 @@ func (h *Hub) Todolists(projectID, todosetID int64) *Pool[[]TodolistInfo] {
 	realm := h.EnsureProject(projectID)
 	key := fmt.Sprintf("todolists:%d:%d", projectID, todosetID)
 	p := RealmPool(realm, key, func() *Pool[[]TodolistInfo] {
-		return NewPool(key, PoolConfig{}, func(ctx context.Context) ([]TodolistInfo, error) {
+		return NewPool(key, h.pollConfig("todos", PoolConfig{}), func(ctx context.Context) ([]TodolistInfo, error) {
 			client := h.accountClient()
 			result, err := client.Todolists().List(ctx, todosetID, &basecamp.TodolistListOptions{})
 			if err != nil {
@@ -521,7 +574,7 @@ func (h *Hub) Todos(projectID, todolistID int64) *MutatingPool[[]TodoInfo] {
 	realm := h.EnsureProject(projectID)
 	key := fmt.Sprintf("todos:%d:%d", projectID, todolistID)
 	mp := RealmPool(realm, key, func() *MutatingPool[[]TodoInfo] {
-		return NewMutatingPool(key, PoolConfig{}, func(ctx context.Context) ([]TodoInfo, error) {
+		return NewMutatingPool(key, h.pollConfig("todos", PoolConfig{Coalesce: mutationCoalesceWindow}), func(ctx context.Context) ([]TodoInfo, error) {
 			client := h.accountClient()
 			result, err := client.Todos().List(ctx, todolistID, &basecamp.TodoListOptions{})
 			if err != nil {
@@ -601,7 +654,7 @@ func (h *Hub) Cards(projectID, tableID int64) *MutatingPool[[]CardColumnInfo] {
 	realm := h.EnsureProject(projectID)
 	key := fmt.Sprintf("cards:%d:%d", projectID, tableID)
 	mp := RealmPool(realm, key, func() *MutatingPool[[]CardColumnInfo] {
-		return NewMutatingPool(key, PoolConfig{}, func(ctx context.Context) ([]CardColumnInfo, error) {
+		return NewMutatingPool(key, PoolConfig{Coalesce: mutationCoalesceWindow}, func(ctx context.Context) ([]CardColumnInfo, error) {
 			client := h.accountClient()
 			cardTable, err := client.CardTables().Get(ctx, tableID)
 			if err != nil {
@@ -720,13 +773,13 @@ func (h *Hub) ChatLines(projectID, chatID int64) *Pool[ChatLinesResult] {
 	realm := h.EnsureProject(projectID)
 	key := fmt.Sprintf("chat-lines:%d:%d", projectID, chatID)
 	p := RealmPool(realm, key, func() *Pool[ChatLinesResult] {
-		return NewPool(key, PoolConfig{
+		return NewPool(key, h.pollConfig("campfire", PoolConfig{
 			FreshTTL: 4 * time.Second, // expire before PollBase fires
 			StaleTTL: 5 * time.Minute, // serve stale during re-fetch
 			PollBase: 5 * time.Second,
 			PollBg:   30 * time.Second,
 			PollMax:  2 * time.Minute,
-		}, func(ctx context.Context) (ChatLinesResult, error) {
+		}), func(ctx context.Context) (ChatLinesResult, error) {
 			client := h.accountClient()
 			result, err := client.Campfires().ListLines(ctx, chatID, nil)
 			if err != nil {
@@ -817,10 +870,10 @@ func (h *Hub) ProjectTimeline(projectID int64) *Pool[[]TimelineEventInfo] {
 	realm := h.EnsureProject(projectID)
 	key := fmt.Sprintf("project-timeline:%d", projectID)
 	p := RealmPool(realm, key, func() *Pool[[]TimelineEventInfo] {
-		return NewPool(key, PoolConfig{
+		return NewPool(key, h.pollConfig("timeline", PoolConfig{
 			FreshTTL: 30 * time.Second,
 			StaleTTL: 5 * time.Minute,
-		}, func(ctx context.Context) ([]TimelineEventInfo, error) {
+		}), func(ctx context.Context) ([]TimelineEventInfo, error) {
 			client := h.accountClient()
 			acct := h.currentAccountInfo()
 			result, err := client.Timeline().ProjectTimeline(ctx, projectID, nil)
@@ -963,6 +1016,15 @@ func (h *Hub) TrashRecording(ctx context.Context, accountID string, projectID, r
 	return client.Recordings().Trash(ctx, recordingID)
 }
 
+// MarkRead marks Hey! readings as read by their readable SGIDs.
+func (h *Hub) MarkRead(ctx context.Context, accountID string, sgids []string) error {
+	client := h.multi.ClientFor(accountID)
+	if client == nil {
+		return fmt.Errorf("no client for account %s", accountID)
+	}
+	return client.MyNotifications().MarkAsRead(ctx, sgids)
+}
+
 // CreateDocument creates a new document in a vault.
 func (h *Hub) CreateDocument(ctx context.Context, accountID string, projectID, vaultID int64, title string) error {
 	client := h.multi.ClientFor(accountID)
@@ -1061,6 +1123,16 @@ func (h *Hub) UpdateMessage(ctx context.Context, accountID string, projectID, me
 	return err
 }
 
+// UpdateDocument updates a document's fields.
+func (h *Hub) UpdateDocument(ctx context.Context, accountID string, projectID, documentID int64, req *basecamp.UpdateDocumentRequest) error {
+	client := h.multi.ClientFor(accountID)
+	if client == nil {
+		return fmt.Errorf("no client for account %s", accountID)
+	}
+	_, err := client.Documents().Update(ctx, documentID, req)
+	return err
+}
+
 // PinMessage pins a message to the top of its board.
 func (h *Hub) PinMessage(ctx context.Context, accountID string, projectID, messageID int64) error {
 	client := h.multi.ClientFor(accountID)
@@ -1169,6 +1241,43 @@ func (h *Hub) CreateCheckinAnswer(ctx context.Context, accountID string, project
 	return err
 }
 
+// QuickAddTodo creates a todo in the first todolist of a project's todoset,
+// for quick capture where no list has been chosen. Returns the created todo
+// and the title of the list it landed in.
+func (h *Hub) QuickAddTodo(ctx context.Context, accountID string, projectID int64, req *basecamp.CreateTodoRequest) (*basecamp.Todo, string, error) {
+	client := h.multi.ClientFor(accountID)
+	if client == nil {
+		return nil, "", fmt.Errorf("no client for account %s", accountID)
+	}
+	project, err := client.Projects().Get(ctx, projectID)
+	if err != nil {
+		return nil, "", err
+	}
+	var todosetID int64
+	for _, tool := range project.Dock {
+		if tool.Name == "todoset" && tool.Enabled {
+			todosetID = tool.ID
+			break
+		}
+	}
+	if todosetID == 0 {
+		return nil, "", fmt.Errorf("project has no to-dos tool")
+	}
+	lists, err := client.Todolists().List(ctx, todosetID, &basecamp.TodolistListOptions{})
+	if err != nil {
+		return nil, "", err
+	}
+	if len(lists.Todolists) == 0 {
+		return nil, "", fmt.Errorf("project has no todolists")
+	}
+	list := lists.Todolists[0]
+	todo, err := client.Todos().Create(ctx, list.ID, req)
+	if err != nil {
+		return nil, "", err
+	}
+	return todo, list.Title, nil
+}
+
 // mapChatLines converts SDK chat lines to ChatLineInfo.
 // Shared by ChatLines (project-scoped) and BonfireLines (global-scoped).
 func mapChatLines(lines []basecamp.CampfireLine) []ChatLineInfo {
@@ -4,9 +4,12 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strconv"
 	"time"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/urlarg"
 )
 
 // -- Helpers
@@ -35,18 +38,21 @@ func (h *Hub) currentAccountInfo() AccountInfo {
 	return AccountInfo{ID: id}
 }
 
+// HeyActivityKey is the pool key of HeyActivity, for matching PoolUpdatedMsg.
+const HeyActivityKey = "hey:activity"
+
 // HeyActivity returns a global-scope pool of cross-account activity entries.
 // The pool fans out Recordings.List across all accounts, caches for 30s (fresh)
 // / 5m (stale), and polls at 30s/2m intervals.
 func (h *Hub) HeyActivity() *Pool[[]ActivityEntryInfo] {
-	p := RealmPool(h.Global(), "hey:activity", func() *Pool[[]ActivityEntryInfo] {
-		return NewPool("hey:activity", PoolConfig{
+	p := RealmPool(h.Global(), HeyActivityKey, func() *Pool[[]ActivityEntryInfo] {
+		return NewPool(HeyActivityKey, h.pollConfig("hey", PoolConfig{
 			FreshTTL: 30 * time.Second,
 			StaleTTL: 5 * time.Minute,
 			PollBase: 45 * time.Second,
 			PollBg:   2 * time.Minute,
 			PollMax:  5 * time.Minute,
-		}, func(ctx context.Context) ([]ActivityEntryInfo, error) {
+		}), func(ctx context.Context) ([]ActivityEntryInfo, error) {
 			types := []basecamp.RecordingType{
 				basecamp.RecordingTypeMessage,
 				basecamp.RecordingTypeTodo,
@@ -91,6 +97,51 @@ func (h *Hub) HeyActivity() *Pool[[]ActivityEntryInfo] {
 	return p
 }
 
+// HeyUnreadsKey is the pool key of HeyUnreads, for matching PoolUpdatedMsg.
+const HeyUnreadsKey = "hey:unreads"
+
+// HeyUnreads returns a global-scope pool of unread Hey! readings across all
+// accounts, as activity entries keyed to the recording each one is about.
+// It polls alongside HeyActivity.
+func (h *Hub) HeyUnreads() *Pool[[]ActivityEntryInfo] {
+	p := RealmPool(h.Global(), HeyUnreadsKey, func() *Pool[[]ActivityEntryInfo] {
+		return NewPool(HeyUnreadsKey, h.pollConfig("hey", PoolConfig{
+			FreshTTL: 30 * time.Second,
+			StaleTTL: 5 * time.Minute,
+			PollBase: 45 * time.Second,
+			PollBg:   2 * time.Minute,
+			PollMax:  5 * time.Minute,
+		}), func(ctx context.Context) ([]ActivityEntryInfo, error) {
+			accounts := h.multi.Accounts()
+			if len(accounts) == 0 {
+				acct := h.currentAccountInfo()
+				if acct.ID == "" {
+					return nil, nil
+				}
+				return fetchUnreadReadings(ctx, h.multi.ClientFor(acct.ID), acct)
+			}
+
+			results := FanOut[[]ActivityEntryInfo](ctx, h.multi, func(acct AccountInfo, client *basecamp.AccountClient) ([]ActivityEntryInfo, error) {
+				return fetchUnreadReadings(ctx, client, acct)
+			})
+
+			var all []ActivityEntryInfo
+			for _, r := range results {
+				if r.Err == nil {
+					all = append(all, r.Data...)
+				}
+			}
+			sort.Slice(all, func(i, j int) bool {
+				return all[i].UpdatedAtTS > all[j].UpdatedAtTS
+			})
+			return all, nil
+		})
+	})
+	p.SetMetrics(h.metrics)
+	p.SetCache(h.cache)
+	return p
+}
+
 // Pulse returns a global-scope pool of cross-account recent activity.
 // Like HeyActivity but includes more recording types and groups by account.
 func (h *Hub) Pulse() *Pool[[]ActivityEntryInfo] {
@@ -431,12 +482,12 @@ func (h *Hub) BonfireRooms() *Pool[[]BonfireRoomConfig] {
 func (h *Hub) BonfireLines(room RoomID) *Pool[ChatLinesResult] {
 	key := fmt.Sprintf("bonfire-lines:%s", room.Key())
 	p := RealmPool(h.Global(), key, func() *Pool[ChatLinesResult] {
-		return NewPool(key, PoolConfig{
+		return NewPool(key, h.pollConfig("bonfire", PoolConfig{
 			FreshTTL: 5 * time.Second,
 			StaleTTL: 30 * time.Second,
 			PollBase: 15 * time.Second,
 			PollMax:  2 * time.Minute,
-		}, func(ctx context.Context) (ChatLinesResult, error) {
+		}), func(ctx context.Context) (ChatLinesResult, error) {
 			client := h.multi.ClientFor(room.AccountID)
 			if client == nil {
 				return ChatLinesResult{}, fmt.Errorf("no client for account %s", room.AccountID)
@@ -466,12 +517,12 @@ func (h *Hub) BonfireLines(room RoomID) *Pool[ChatLinesResult] {
 // without depending on another view having fetched rooms first.
 func (h *Hub) BonfireDigest() *Pool[[]BonfireDigestEntry] {
 	p := RealmPool(h.Global(), "bonfire-digest", func() *Pool[[]BonfireDigestEntry] {
-		return NewPool("bonfire-digest", PoolConfig{
+		return NewPool("bonfire-digest", h.pollConfig("bonfire", PoolConfig{
 			FreshTTL: 10 * time.Second,
 			StaleTTL: 1 * time.Minute,
 			PollBase: 15 * time.Second,
 			PollMax:  2 * time.Minute,
-		}, func(ctx context.Context) ([]BonfireDigestEntry, error) {
+		}), func(ctx context.Context) ([]BonfireDigestEntry, error) {
 			roomPool := h.BonfireRooms()
 			snap := roomPool.Get()
 			if !snap.HasData {
@@ -547,13 +598,13 @@ func (h *Hub) BonfireDigest() *Pool[[]BonfireDigestEntry] {
 // Uses the richer Timeline.Progress() API instead of Recordings.List.
 func (h *Hub) Timeline() *Pool[[]TimelineEventInfo] {
 	p := RealmPool(h.Global(), "timeline", func() *Pool[[]TimelineEventInfo] {
-		return NewPool("timeline", PoolConfig{
+		return NewPool("timeline", h.pollConfig("activity", PoolConfig{
 			FreshTTL: 30 * time.Second,
 			StaleTTL: 5 * time.Minute,
 			PollBase: 45 * time.Second,
 			PollBg:   2 * time.Minute,
 			PollMax:  5 * time.Minute,
-		}, func(ctx context.Context) ([]TimelineEventInfo, error) {
+		}), func(ctx context.Context) ([]TimelineEventInfo, error) {
 			accounts := h.multi.Accounts()
 			if len(accounts) == 0 {
 				acct := h.currentAccountInfo()
@@ -670,6 +721,62 @@ func fetchRecordingsAsActivity(ctx context.Context, client *basecamp.AccountClie
 	return entries
 }
 
+// readingRecordingTypes maps a reading's URL path type to the recording type
+// activity entries carry, so a comment's reading opens the item it is on.
+var readingRecordingTypes = map[string]string{
+	"todos":            "Todo",
+	"messages":         "Message",
+	"documents":        "Document",
+	"cards":            "Kanban::Card",
+	"uploads":          "Upload",
+	"schedule_entries": "Schedule::Entry",
+	"question_answers": "Question::Answer",
+}
+
+// fetchUnreadReadings fetches the first page of an account's Hey! readings
+// and returns the unread ones. Each is keyed to its recording (comments
+// resolve to the item they are on) so it lines up with activity entries.
+func fetchUnreadReadings(ctx context.Context, client *basecamp.AccountClient, acct AccountInfo) ([]ActivityEntryInfo, error) {
+	if client == nil {
+		return nil, fmt.Errorf("no client for account %s", acct.ID)
+	}
+	result, err := client.MyNotifications().Get(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+	entries := make([]ActivityEntryInfo, 0, len(result.Unreads))
+	for _, n := range result.Unreads {
+		parsed := urlarg.Parse(n.AppURL)
+		if parsed == nil || n.ReadableSGID == "" {
+			continue
+		}
+		recordingID, err := strconv.ParseInt(parsed.RecordingID, 10, 64)
+		if err != nil {
+			continue
+		}
+		projectID, _ := strconv.ParseInt(parsed.ProjectID, 10, 64)
+		recordingType := n.Type
+		if t, ok := readingRecordingTypes[parsed.Type]; ok {
+			recordingType = t
+		}
+		entries = append(entries, ActivityEntryInfo{
+			ID:           recordingID,
+			Title:        n.Title,
+			Type:         recordingType,
+			Creator:      personName(n.Creator),
+			Account:      acct.Name,
+			AccountID:    acct.ID,
+			Project:      n.BucketName,
+			ProjectID:    projectID,
+			UpdatedAt:    n.UpdatedAt.Format("Jan 2 3:04pm"),
+			UpdatedAtTS:  n.UpdatedAt.Unix(),
+			Unread:       true,
+			ReadableSGID: n.ReadableSGID,
+		})
+	}
+	return entries, nil
+}
+
 // fetchAccountAssignments fetches todos assigned to the current user via the
 // Reports API which returns only the user's assignments with richer data
 // (DueOn, Completed, Parent todolist, Assignees).
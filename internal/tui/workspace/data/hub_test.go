@@ -421,6 +421,72 @@ func TestHubChatLines(t *testing.T) {
 	assert.NotZero(t, pool.PollInterval(), "chat pool should have non-zero poll interval")
 }
 
+func TestHubSetRefreshIntervals(t *testing.T) {
+	h := NewHub(NewMultiStore(nil), "")
+	h.EnsureAccount("aaa")
+	h.SetRefreshIntervals(map[string]time.Duration{
+		"campfire": 15 * time.Second,
+		"todos":    2 * time.Minute,
+		"hey":      -1,
+	})
+
+	assert.Equal(t, 15*time.Second, h.ChatLines(42, 55).PollInterval())
+	assert.Equal(t, 2*time.Minute, h.Todolists(42, 7).PollInterval(), "todos polling is opt-in")
+	assert.Zero(t, h.HeyActivity().PollInterval(), "off disables polling")
+	assert.Equal(t, 45*time.Second, h.Timeline().PollInterval(), "views without an override keep their default")
+}
+
+func TestHubPollConfigOverride(t *testing.T) {
+	h := NewHub(NewMultiStore(nil), "")
+	h.SetRefreshIntervals(map[string]time.Duration{"campfire": 15 * time.Second, "todos": time.Minute})
+
+	chat := h.pollConfig("campfire", PoolConfig{
+		FreshTTL: 4 * time.Second,
+		PollBase: 5 * time.Second,
+		PollBg:   30 * time.Second,
+		PollMax:  2 * time.Minute,
+	})
+	assert.Equal(t, PoolConfig{
+		FreshTTL: 4 * time.Second,
+		PollBase: 15 * time.Second,
+		PollBg:   30 * time.Second,
+		PollMax:  2 * time.Minute,
+	}, chat)
+
+	todos := h.pollConfig("todos", PoolConfig{})
+	assert.Equal(t, PoolConfig{
+		FreshTTL: 48 * time.Second,
+		PollBase: time.Minute,
+		PollBg:   time.Minute,
+		PollMax:  time.Minute,
+	}, todos)
+
+	unset := PoolConfig{PollBase: 45 * time.Second}
+	assert.Equal(t, unset, h.pollConfig("hey", unset))
+}
+
+func TestHubInvalidateAll(t *testing.T) {
+	h := NewHub(NewMultiStore(nil), "")
+	h.EnsureAccount("aaa")
+	h.EnsureProject(42)
+
+	globalPool := NewPool[int]("g", PoolConfig{}, nil)
+	acctPool := NewPool[int]("a", PoolConfig{}, nil)
+	projPool := NewPool[int]("p", PoolConfig{}, nil)
+	h.Global().Register("g", globalPool)
+	h.Account().Register("a", acctPool)
+	h.Project().Register("p", projPool)
+	for _, p := range []*Pool[int]{globalPool, acctPool, projPool} {
+		p.Set(1)
+	}
+
+	h.InvalidateAll()
+
+	assert.Equal(t, StateStale, globalPool.Get().State)
+	assert.Equal(t, StateStale, acctPool.Get().State)
+	assert.Equal(t, StateStale, projPool.Get().State)
+}
+
 func TestHubMessages(t *testing.T) {
 	h := NewHub(NewMultiStore(nil), "")
 	h.EnsureAccount("aaa")
@@ -699,3 +765,43 @@ func TestMapCardInfo_AllStepsComplete(t *testing.T) {
 	assert.Equal(t, 2, info.StepsTotal)
 	assert.Equal(t, 2, info.StepsDone)
 }
+
+type hubReadingsTransport struct{}
+
+func (hubReadingsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+	body := `{"unreads": [
+		{"id": 1, "title": "Re: Launch plan", "type": "Comment", "bucket_name": "Launch", "readable_sgid": "sgid-1",
+		 "app_url": "https://3.basecamp.com/99999/buckets/20/documents/300#__recording_301",
+		 "updated_at": "2026-03-25T09:30:00Z"},
+		{"id": 2, "title": "No SGID", "app_url": "https://3.basecamp.com/99999/buckets/20/todos/400"}
+	], "reads": [
+		{"id": 3, "title": "Old news", "readable_sgid": "sgid-3", "app_url": "https://3.basecamp.com/99999/buckets/20/messages/500"}
+	]}`
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Body:       io.NopCloser(strings.NewReader(body)),
+		Header:     header,
+	}, nil
+}
+
+func TestFetchUnreadReadingsKeysReadingsToRecordings(t *testing.T) {
+	sdk := basecamp.NewClient(&basecamp.Config{BaseURL: "https://3.basecampapi.com"}, hubCheckinsTestTokenProvider{},
+		basecamp.WithTransport(hubReadingsTransport{}),
+		basecamp.WithMaxRetries(1),
+	)
+
+	entries, err := fetchUnreadReadings(context.Background(), sdk.ForAccount("99999"), AccountInfo{ID: "99999", Name: "Acme"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1, "reads and unreads without an SGID are skipped")
+
+	e := entries[0]
+	assert.Equal(t, int64(300), e.ID, "a comment's reading is keyed to the item it is on")
+	assert.Equal(t, "Document", e.Type)
+	assert.Equal(t, int64(20), e.ProjectID)
+	assert.Equal(t, "Launch", e.Project)
+	assert.Equal(t, "Acme", e.Account)
+	assert.True(t, e.Unread)
+	assert.Equal(t, "sgid-1", e.ReadableSGID)
+}
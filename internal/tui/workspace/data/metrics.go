@@ -16,6 +16,7 @@ const (
 	CacheMiss
 	CacheSeeded
 	PoolInvalidated
+	MutationCoalesced
 )
 
 // PoolEvent records a single pool lifecycle event.
@@ -50,6 +51,7 @@ type MetricsSummary struct {
 	P50Latency  time.Duration
 	ErrorRate   float64
 	Apdex       float64
+	LastFetch   time.Time // most recent successful fetch (zero if none yet)
 }
 
 // PoolStatus is a live status snapshot from a registered pool.
@@ -140,6 +142,9 @@ func (m *PoolMetrics) Summary() MetricsSummary {
 		e := m.events[i]
 		switch e.EventType {
 		case FetchComplete:
+			if summary.LastFetch.IsZero() {
+				summary.LastFetch = e.Timestamp
+			}
 			latencies = append(latencies, e.Duration)
 			total++
 		case FetchError:
@@ -42,6 +42,7 @@ type MutatingPool[T any] struct {
 	lastCachedFetchedAt time.Time
 	hasRemoteData       bool
 	mutSeq              uint64 // monotonic mutation ID
+	refreshSeq          uint64 // bumped per successful remote apply; latest owns the re-fetch
 }
 
 // NewMutatingPool creates a MutatingPool with the given key, config, and fetch function.
@@ -55,6 +56,11 @@ func NewMutatingPool[T any](key string, config PoolConfig, fetchFn FetchFunc[T])
 //  1. Applies locally to the snapshot (immediate, synchronous)
 //  2. Returns a Cmd that applies remotely, then re-fetches and reconciles
 //
+// When the pool's config sets Coalesce, the re-fetch waits out that window
+// and is skipped if another mutation lands in the meantime — the last one
+// re-fetches once and its reconcile prunes every mutation it reflects. Rapid
+// actions (toggling several todos) then cost one refresh instead of N.
+//
 // The caller should read pool.Get() after calling Apply to get the
 // optimistic data for immediate rendering.
 func (mp *MutatingPool[T]) Apply(ctx context.Context, mutation Mutation[T]) tea.Cmd {
@@ -87,6 +93,7 @@ func (mp *MutatingPool[T]) Apply(ctx context.Context, mutation Mutation[T]) tea.
 	}
 	key := mp.key
 	fetchFn := mp.fetchFn
+	window := mp.config.Coalesce
 	mp.mu.Unlock()
 
 	return func() tea.Msg {
@@ -98,6 +105,12 @@ func (mp *MutatingPool[T]) Apply(ctx context.Context, mutation Mutation[T]) tea.
 			}
 		}
 
+		if window > 0 && mp.superseded(window) {
+			// A later mutation owns the re-fetch; the local state is
+			// already optimistically correct.
+			return nil
+		}
+
 		// Re-fetch with telemetry + cache, same as MutatingPool.Fetch.
 		mp.mu.RLock()
 		m := mp.metrics
@@ -139,6 +152,28 @@ func (mp *MutatingPool[T]) Apply(ctx context.Context, mutation Mutation[T]) tea.
 	}
 }
 
+// superseded claims the post-mutation re-fetch, waits out the coalescing
+// window, and reports whether a later mutation claimed it since.
+func (mp *MutatingPool[T]) superseded(window time.Duration) bool {
+	mp.mu.Lock()
+	mp.refreshSeq++
+	seq := mp.refreshSeq
+	mp.mu.Unlock()
+
+	time.Sleep(window)
+
+	mp.mu.RLock()
+	later := mp.refreshSeq != seq
+	m := mp.metrics
+	key := mp.key
+	mp.mu.RUnlock()
+
+	if later && m != nil {
+		m.Record(PoolEvent{Timestamp: time.Now(), PoolKey: key, EventType: MutationCoalesced})
+	}
+	return later
+}
+
 // Fetch overrides Pool.Fetch to reconcile pending mutations after
 // a successful fetch rather than overwriting them.
 func (mp *MutatingPool[T]) Fetch(ctx context.Context) tea.Cmd {
@@ -187,7 +222,7 @@ func (mp *MutatingPool[T]) Fetch(ctx context.Context) tea.Cmd {
 		}
 
 		if err != nil {
-			mp.snapshot.State = StateError
+			mp.snapshot.State = mp.failedFetchStateLocked(err)
 			mp.snapshot.Err = err
 			mp.mu.Unlock()
 			return PoolUpdatedMsg{Key: mp.key}
@@ -3,6 +3,8 @@ package data
 import (
 	"context"
 	"errors"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -501,3 +503,42 @@ func TestMutatingPoolMultipleMutations(t *testing.T) {
 	cmd1()
 	cmd2()
 }
+
+func TestMutatingPoolApplyCoalescesRefetches(t *testing.T) {
+	var fetches atomic.Int32
+	mp := NewMutatingPool("items", PoolConfig{Coalesce: 50 * time.Millisecond}, func(ctx context.Context) ([]testItem, error) {
+		fetches.Add(1)
+		return []testItem{{ID: 1, Completed: true}, {ID: 2, Completed: true}, {ID: 3}}, nil
+	})
+	mp.Set([]testItem{{ID: 1}, {ID: 2}, {ID: 3}})
+
+	cmd1 := mp.Apply(context.Background(), completeMutation{itemID: 1})
+	cmd2 := mp.Apply(context.Background(), completeMutation{itemID: 2})
+
+	var wg sync.WaitGroup
+	msgs := make([]tea.Msg, 2)
+	for i, cmd := range []tea.Cmd{cmd1, cmd2} {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			msgs[i] = cmd()
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), fetches.Load(), "rapid mutations should share one re-fetch")
+	updated := 0
+	for _, msg := range msgs {
+		if _, ok := msg.(PoolUpdatedMsg); ok {
+			updated++
+		}
+	}
+	assert.Equal(t, 1, updated, "only the re-fetching mutation reports an update")
+
+	mp.mu.RLock()
+	pending := len(mp.pendingMutations)
+	mp.mu.RUnlock()
+	assert.Equal(t, 0, pending, "reconcile should prune both mutations")
+	assert.True(t, mp.Get().Data[0].Completed)
+	assert.True(t, mp.Get().Data[1].Completed)
+}
@@ -2,6 +2,7 @@ package data
 
 import (
 	"context"
+	"slices"
 
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 )
@@ -46,3 +47,35 @@ func (m TodoCompleteMutation) IsReflectedIn(todos []TodoInfo) bool {
 	}
 	return false
 }
+
+// TodoRepositionMutation moves a todo to another place in its todolist.
+// Implements Mutation[[]TodoInfo] for use with MutatingPool.
+type TodoRepositionMutation struct {
+	TodoID   int64
+	Index    int // target index in the pool's todos
+	Position int // 1-based position sent to the API
+	Client   *basecamp.AccountClient
+}
+
+// ApplyLocally moves the todo to Index in the local data.
+func (m TodoRepositionMutation) ApplyLocally(todos []TodoInfo) []TodoInfo {
+	result := slices.Clone(todos)
+	from := slices.IndexFunc(result, func(t TodoInfo) bool { return t.ID == m.TodoID })
+	if from < 0 {
+		return result
+	}
+	moved := result[from]
+	moved.Position = m.Position
+	result = slices.Delete(result, from, from+1)
+	return slices.Insert(result, min(max(m.Index, 0), len(result)), moved)
+}
+
+// ApplyRemotely calls the SDK to reposition the todo.
+func (m TodoRepositionMutation) ApplyRemotely(ctx context.Context) error {
+	return m.Client.Todos().Reposition(ctx, m.TodoID, m.Position, nil)
+}
+
+// IsReflectedIn returns true when the remote data has the todo at Index.
+func (m TodoRepositionMutation) IsReflectedIn(todos []TodoInfo) bool {
+	return m.Index >= 0 && m.Index < len(todos) && todos[m.Index].ID == m.TodoID
+}
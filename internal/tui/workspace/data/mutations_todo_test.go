@@ -0,0 +1,48 @@
+package data
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func sampleTodos() []TodoInfo {
+	return []TodoInfo{
+		{ID: 1, Content: "First", Position: 1},
+		{ID: 2, Content: "Second", Position: 2},
+		{ID: 3, Content: "Third", Position: 3},
+	}
+}
+
+func todoIDs(todos []TodoInfo) []int64 {
+	ids := make([]int64, len(todos))
+	for i, t := range todos {
+		ids[i] = t.ID
+	}
+	return ids
+}
+
+func TestTodoRepositionMutation_ApplyLocally(t *testing.T) {
+	todos := sampleTodos()
+
+	down := TodoRepositionMutation{TodoID: 1, Index: 1, Position: 2}.ApplyLocally(todos)
+	assert.Equal(t, []int64{2, 1, 3}, todoIDs(down))
+	assert.Equal(t, 2, down[1].Position)
+
+	up := TodoRepositionMutation{TodoID: 3, Index: 0, Position: 1}.ApplyLocally(todos)
+	assert.Equal(t, []int64{3, 1, 2}, todoIDs(up))
+
+	assert.Equal(t, []int64{1, 2, 3}, todoIDs(todos), "input is not modified")
+}
+
+func TestTodoRepositionMutation_ApplyLocally_UnknownTodo(t *testing.T) {
+	result := TodoRepositionMutation{TodoID: 99, Index: 0, Position: 1}.ApplyLocally(sampleTodos())
+	assert.Equal(t, []int64{1, 2, 3}, todoIDs(result))
+}
+
+func TestTodoRepositionMutation_IsReflectedIn(t *testing.T) {
+	m := TodoRepositionMutation{TodoID: 1, Index: 1, Position: 2}
+	assert.False(t, m.IsReflectedIn(sampleTodos()))
+	assert.True(t, m.IsReflectedIn(m.ApplyLocally(sampleTodos())))
+	assert.False(t, TodoRepositionMutation{TodoID: 1, Index: 5}.IsReflectedIn(sampleTodos()))
+}
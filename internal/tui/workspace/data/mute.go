@@ -0,0 +1,146 @@
+package data
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MuteList records the projects and dock tools muted in the TUI. Muted
+// updates raise no toasts and don't count toward unread badges. Entries use
+// the tui_mute config form: "123" for a whole project, "123/chat" for one of
+// its dock tools.
+type MuteList struct {
+	mu      sync.RWMutex
+	entries map[string]bool
+}
+
+// NewMuteList creates a MuteList seeded with config entries.
+func NewMuteList(entries []string) *MuteList {
+	m := &MuteList{entries: make(map[string]bool, len(entries))}
+	for _, e := range entries {
+		m.entries[e] = true
+	}
+	return m
+}
+
+func muteKey(projectID int64, tool string) string {
+	if tool == "" {
+		return fmt.Sprintf("%d", projectID)
+	}
+	return fmt.Sprintf("%d/%s", projectID, tool)
+}
+
+// Muted reports whether updates from the project's tool are silenced, either
+// by muting the tool or the whole project. An empty tool checks the project.
+func (m *MuteList) Muted(projectID int64, tool string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.entries[muteKey(projectID, "")] {
+		return true
+	}
+	return tool != "" && m.entries[muteKey(projectID, tool)]
+}
+
+// Toggle flips the mute entry for a project (empty tool) or one of its
+// tools and returns whether it is now muted.
+func (m *MuteList) Toggle(projectID int64, tool string) bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	key := muteKey(projectID, tool)
+	if m.entries[key] {
+		delete(m.entries, key)
+		return false
+	}
+	m.entries[key] = true
+	return true
+}
+
+// Entries returns the mute entries, sorted, in config form.
+func (m *MuteList) Entries() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	entries := make([]string, 0, len(m.entries))
+	for e := range m.entries {
+		entries = append(entries, e)
+	}
+	sort.Strings(entries)
+	return entries
+}
+
+// ToolForRecordingType maps a recording or timeline target type ("Todo",
+// "Kanban::Card", ...) to the dock tool it lives in, or "" when unknown.
+func ToolForRecordingType(recordingType string) string {
+	switch strings.ToLower(recordingType) {
+	case "todo", "todolist", "todoset", "todolist::group":
+		return "todoset"
+	case "message", "message::board":
+		return "message_board"
+	case "chat::transcript", "chat::line", "chat":
+		return "chat"
+	case "schedule", "schedule::entry":
+		return "schedule"
+	case "kanban::board", "kanban::card", "kanban::column", "kanban::step":
+		return "kanban_board"
+	case "vault", "document", "upload":
+		return "vault"
+	case "questionnaire", "question", "question::answer":
+		return "questionnaire"
+	case "inbox", "inbox::forward", "inbox::forward::reply":
+		return "inbox"
+	default:
+		return ""
+	}
+}
+
+// ToolVisits remembers when each project dock tool was last opened this
+// session. Updates newer than that are unread; tools not yet opened count
+// from the start of the session.
+type ToolVisits struct {
+	mu      sync.Mutex
+	started time.Time
+	visits  map[string]time.Time
+}
+
+// NewToolVisits creates a ToolVisits whose unread window starts now.
+func NewToolVisits() *ToolVisits {
+	return &ToolVisits{started: time.Now(), visits: make(map[string]time.Time)}
+}
+
+// Visit records that the project's tool was opened at the given time.
+func (tv *ToolVisits) Visit(projectID int64, tool string, at time.Time) {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	tv.visits[muteKey(projectID, tool)] = at
+}
+
+// Since returns the time after which the project's tool has unread updates.
+func (tv *ToolVisits) Since(projectID int64, tool string) time.Time {
+	tv.mu.Lock()
+	defer tv.mu.Unlock()
+	if at, ok := tv.visits[muteKey(projectID, tool)]; ok {
+		return at
+	}
+	return tv.started
+}
+
+// UnreadByTool counts timeline events per dock tool that happened after the
+// tool was last visited, skipping muted tools.
+func UnreadByTool(projectID int64, events []TimelineEventInfo, visits *ToolVisits, mutes *MuteList) map[string]int {
+	counts := make(map[string]int)
+	if mutes != nil && mutes.Muted(projectID, "") {
+		return counts
+	}
+	for _, e := range events {
+		tool := ToolForRecordingType(e.Target)
+		if tool == "" || (mutes != nil && mutes.Muted(projectID, tool)) {
+			continue
+		}
+		if e.CreatedAtTS > visits.Since(projectID, tool).Unix() {
+			counts[tool]++
+		}
+	}
+	return counts
+}
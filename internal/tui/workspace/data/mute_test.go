@@ -0,0 +1,50 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMuteList(t *testing.T) {
+	m := NewMuteList([]string{"1", "2/chat"})
+
+	assert.True(t, m.Muted(1, ""), "muted project")
+	assert.True(t, m.Muted(1, "todoset"), "every tool of a muted project")
+	assert.False(t, m.Muted(2, ""), "muting a tool leaves the project")
+	assert.True(t, m.Muted(2, "chat"))
+	assert.False(t, m.Muted(2, "todoset"))
+
+	assert.True(t, m.Toggle(2, "todoset"))
+	assert.False(t, m.Toggle(1, ""))
+	assert.Equal(t, []string{"2/chat", "2/todoset"}, m.Entries())
+}
+
+func TestToolForRecordingType(t *testing.T) {
+	assert.Equal(t, "todoset", ToolForRecordingType("Todo"))
+	assert.Equal(t, "kanban_board", ToolForRecordingType("Kanban::Card"))
+	assert.Equal(t, "chat", ToolForRecordingType("Chat::Line"))
+	assert.Equal(t, "vault", ToolForRecordingType("Upload"))
+	assert.Equal(t, "", ToolForRecordingType("Project"))
+}
+
+func TestUnreadByTool(t *testing.T) {
+	visits := NewToolVisits()
+	start := visits.Since(7, "todoset")
+	visits.Visit(7, "message_board", start.Add(time.Hour))
+
+	after := start.Add(time.Minute).Unix()
+	events := []TimelineEventInfo{
+		{Target: "Todo", CreatedAtTS: after},
+		{Target: "Todo", CreatedAtTS: after},
+		{Target: "Todo", CreatedAtTS: start.Add(-time.Minute).Unix()}, // before the session
+		{Target: "Message", CreatedAtTS: after},                       // before the last visit
+		{Target: "Chat::Line", CreatedAtTS: after},
+		{Target: "Project", CreatedAtTS: after}, // no dock tool
+	}
+
+	assert.Equal(t, map[string]int{"todoset": 2, "chat": 1}, UnreadByTool(7, events, visits, nil))
+	assert.Equal(t, map[string]int{"todoset": 2}, UnreadByTool(7, events, visits, NewMuteList([]string{"7/chat"})))
+	assert.Empty(t, UnreadByTool(7, events, visits, NewMuteList([]string{"7"})))
+}
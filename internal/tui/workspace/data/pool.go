@@ -2,11 +2,13 @@ package data
 
 import (
 	"context"
+	"errors"
 	"log"
 	"sync"
 	"time"
 
 	tea "charm.land/bubbletea/v2"
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 )
 
 // PoolUpdatedMsg is sent when a pool's snapshot changes.
@@ -26,6 +28,7 @@ type PoolConfig struct {
 	PollBase time.Duration // base polling interval when focused (0 = no auto-poll)
 	PollBg   time.Duration // background polling interval when blurred
 	PollMax  time.Duration // max interval after consecutive misses
+	Coalesce time.Duration // MutatingPool: batch post-mutation re-fetches within this window (0 = re-fetch after each)
 }
 
 // Pooler is the non-generic interface for pool lifecycle management.
@@ -209,7 +212,7 @@ func (p *Pool[T]) Fetch(ctx context.Context) tea.Cmd {
 		var cacheKey string
 
 		if err != nil {
-			p.snapshot.State = StateError
+			p.snapshot.State = p.failedFetchStateLocked(err)
 			p.snapshot.Err = err
 		} else {
 			p.snapshot.Data = data
@@ -238,6 +241,25 @@ func (p *Pool[T]) Fetch(ctx context.Context) tea.Cmd {
 	}
 }
 
+// failedFetchStateLocked returns the state a snapshot takes when a fetch
+// fails. A transient failure keeps still-usable data as stale, so polling
+// rides out a blip without flashing an error; the next poll tries again.
+// Caller must hold p.mu.
+func (p *Pool[T]) failedFetchStateLocked(err error) SnapshotState {
+	if p.snapshot.HasData && IsTransient(err) {
+		return StateStale
+	}
+	return StateError
+}
+
+// IsTransient reports whether err is a temporary failure: a network error,
+// rate limit, or 5xx. The SDK has already retried these for GETs by the
+// time a fetch returns.
+func IsTransient(err error) bool {
+	var sdkErr *basecamp.Error
+	return errors.As(err, &sdkErr) && sdkErr.Retryable && sdkErr.Code != basecamp.CodeAuth
+}
+
 // FetchIfStale returns a Fetch Cmd if data is stale or empty, nil if fresh.
 func (p *Pool[T]) FetchIfStale(ctx context.Context) tea.Cmd {
 	if p.isFreshOrFetching() {
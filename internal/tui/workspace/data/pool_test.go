@@ -3,10 +3,12 @@ package data
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
 
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -75,6 +77,45 @@ func TestPoolFetchErrorPreservesExistingData(t *testing.T) {
 	assert.Equal(t, "good", snap.Data)
 }
 
+func TestPoolTransientErrorKeepsDataStale(t *testing.T) {
+	calls := 0
+	transient := basecamp.ErrNetwork(errors.New("connection reset by peer"))
+	p := NewPool("items", PoolConfig{FreshTTL: time.Minute}, func(ctx context.Context) (string, error) {
+		calls++
+		if calls == 1 {
+			return "good", nil
+		}
+		return "", transient
+	})
+
+	p.Fetch(context.Background())()
+	p.Fetch(context.Background())()
+
+	snap := p.Get()
+	assert.Equal(t, StateStale, snap.State, "a transient refresh failure must not surface as an error")
+	assert.Equal(t, "good", snap.Data)
+	assert.Equal(t, transient, snap.Err)
+	assert.NotNil(t, p.FetchIfStale(context.Background()), "next poll retries")
+}
+
+func TestPoolTransientErrorWithoutDataIsError(t *testing.T) {
+	p := NewPool("items", PoolConfig{}, func(ctx context.Context) (string, error) {
+		return "", basecamp.ErrNetwork(errors.New("timeout"))
+	})
+
+	p.Fetch(context.Background())()
+	assert.Equal(t, StateError, p.Get().State)
+}
+
+func TestIsTransient(t *testing.T) {
+	assert.True(t, IsTransient(basecamp.ErrNetwork(errors.New("reset"))))
+	assert.True(t, IsTransient(fmt.Errorf("request failed after 3 retries: %w",
+		&basecamp.Error{Code: basecamp.CodeAPI, HTTPStatus: 503, Retryable: true})))
+	assert.False(t, IsTransient(&basecamp.Error{Code: basecamp.CodeNotFound, HTTPStatus: 404}))
+	assert.False(t, IsTransient(&basecamp.Error{Code: basecamp.CodeAuth, Retryable: true}))
+	assert.False(t, IsTransient(errors.New("fail")))
+}
+
 func TestPoolFetchDedup(t *testing.T) {
 	var count atomic.Int32
 	started := make(chan struct{})
@@ -15,6 +15,7 @@ type ScheduleEntryInfo struct {
 	ID           int64
 	Summary      string
 	StartsAt     string
+	StartsAtTS   int64 // unix timestamp for date navigation
 	EndsAt       string
 	AllDay       bool
 	Participants []string
@@ -182,6 +183,10 @@ type ActivityEntryInfo struct {
 	ProjectID   int64
 	UpdatedAt   string // formatted time
 	UpdatedAtTS int64  // unix timestamp for sorting
+
+	// Unread entries come from the Hey! readings; ReadableSGID marks them read.
+	Unread       bool
+	ReadableSGID string
 }
 
 // AssignmentInfo represents a todo assigned to the current user.
@@ -3,9 +3,12 @@ package workspace
 import (
 	"encoding/json"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 
 	"charm.land/bubbles/v2/key"
+	tea "charm.land/bubbletea/v2"
 )
 
 // GlobalKeyMap defines keybindings that work in every context.
@@ -22,10 +25,13 @@ type GlobalKeyMap struct {
 	Sidebar       key.Binding
 	SidebarFocus  key.Binding
 	Refresh       key.Binding
+	RefreshAll    key.Binding
 	Open          key.Binding
 	Jump          key.Binding
 	Metrics       key.Binding
 	Bonfire       key.Binding
+	Export        key.Binding
+	ErrorLog      key.Binding
 }
 
 // DefaultGlobalKeyMap returns the default global keybindings.
@@ -79,6 +85,10 @@ func DefaultGlobalKeyMap() GlobalKeyMap {
 			key.WithKeys("r"),
 			key.WithHelp("r", "refresh"),
 		),
+		RefreshAll: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "refresh all"),
+		),
 		Open: key.NewBinding(
 			key.WithKeys("o"),
 			key.WithHelp("o", "open in browser"),
@@ -95,6 +105,14 @@ func DefaultGlobalKeyMap() GlobalKeyMap {
 			key.WithKeys("ctrl+g"),
 			key.WithHelp("ctrl+g", "bonfire"),
 		),
+		Export: key.NewBinding(
+			key.WithKeys("ctrl+e"),
+			key.WithHelp("ctrl+e", "export view"),
+		),
+		ErrorLog: key.NewBinding(
+			key.WithKeys("ctrl+x"),
+			key.WithHelp("ctrl+x", "recent errors"),
+		),
 	}
 }
 
@@ -160,7 +178,7 @@ func (k GlobalKeyMap) FullHelp() [][]key.Binding {
 		{k.Back, k.Quit},
 		{k.Search, k.Palette},
 		{k.AccountSwitch, k.Hey, k.MyStuff, k.Activity},
-		{k.Help, k.Refresh, k.Open, k.Jump, k.Sidebar, k.Metrics, k.Bonfire},
+		{k.Help, k.Refresh, k.RefreshAll, k.Open, k.Jump, k.Sidebar, k.Metrics, k.Bonfire, k.Export, k.ErrorLog},
 	}
 }
 
@@ -178,10 +196,13 @@ var actionFieldMap = map[string]string{
 	"sidebar":        "Sidebar",
 	"sidebar_focus":  "SidebarFocus",
 	"refresh":        "Refresh",
+	"refresh_all":    "RefreshAll",
 	"open":           "Open",
 	"jump":           "Jump",
 	"metrics":        "Metrics",
 	"bonfire":        "Bonfire",
+	"export":         "Export",
+	"error_log":      "ErrorLog",
 }
 
 // LoadKeyOverrides reads keybinding overrides from a JSON file.
@@ -201,6 +222,31 @@ func LoadKeyOverrides(path string) (map[string]string, error) {
 	return overrides, nil
 }
 
+// KeyOverridesPath returns the location of the user's keybindings.json.
+func KeyOverridesPath() (string, error) {
+	configDir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Clean(configDir), "basecamp", "keybindings.json"), nil
+}
+
+// EffectiveGlobalKeyMap returns the default global keybindings with the
+// user's keybindings.json overrides applied. On a read or parse error the
+// defaults are returned along with the error.
+func EffectiveGlobalKeyMap() (GlobalKeyMap, error) {
+	keys := DefaultGlobalKeyMap()
+	path, err := KeyOverridesPath()
+	if err != nil {
+		return keys, nil //nolint:nilerr // no config dir means no overrides
+	}
+	overrides, err := LoadKeyOverrides(path)
+	if len(overrides) > 0 {
+		ApplyOverrides(&keys, overrides)
+	}
+	return keys, err
+}
+
 // ApplyOverrides remaps keybindings in km according to the overrides map.
 // Keys are action names (e.g. "hey"), values are key strings (e.g. "ctrl+h").
 // Unknown actions are silently ignored.
@@ -226,3 +272,56 @@ func ApplyOverrides(km *GlobalKeyMap, overrides map[string]string) {
 		)))
 	}
 }
+
+// namedKeys maps key names used in bindings to their key codes.
+var namedKeys = map[string]rune{
+	"enter":     tea.KeyEnter,
+	"esc":       tea.KeyEscape,
+	"tab":       tea.KeyTab,
+	"backspace": tea.KeyBackspace,
+	"delete":    tea.KeyDelete,
+	"space":     tea.KeySpace,
+	"up":        tea.KeyUp,
+	"down":      tea.KeyDown,
+	"left":      tea.KeyLeft,
+	"right":     tea.KeyRight,
+	"home":      tea.KeyHome,
+	"end":       tea.KeyEnd,
+	"pgup":      tea.KeyPgUp,
+	"pgdown":    tea.KeyPgDown,
+}
+
+// keyPressFor builds the key press a binding key string such as "ctrl+p",
+// "shift+tab", or "G" stands for, so a binding can be triggered without
+// the keyboard. It reports false for keys it can't reproduce exactly.
+func keyPressFor(s string) (tea.KeyPressMsg, bool) {
+	var press tea.KeyPressMsg
+	name := s
+	if name != "+" {
+		parts := strings.Split(s, "+")
+		name = parts[len(parts)-1]
+		for _, mod := range parts[:len(parts)-1] {
+			switch mod {
+			case "ctrl":
+				press.Mod |= tea.ModCtrl
+			case "alt":
+				press.Mod |= tea.ModAlt
+			case "shift":
+				press.Mod |= tea.ModShift
+			default:
+				return tea.KeyPressMsg{}, false
+			}
+		}
+	}
+	if code, ok := namedKeys[name]; ok {
+		press.Code = code
+	} else if r := []rune(name); len(r) == 1 {
+		press.Code = r[0]
+		if press.Mod == 0 {
+			press.Text = name
+		}
+	} else {
+		return tea.KeyPressMsg{}, false
+	}
+	return press, press.String() == s
+}
@@ -110,3 +110,19 @@ func TestShortHelp_IncludesHeyAndJump(t *testing.T) {
 	assert.NotContains(t, helpKeys, "q", "ShortHelp should not include Quit")
 	assert.NotContains(t, helpKeys, "esc", "ShortHelp should not include Back")
 }
+
+func TestKeyPressFor_RoundTrips(t *testing.T) {
+	for _, k := range []string{"q", "G", "?", "/", "1", "ctrl+p", "ctrl+y", "shift+tab", "enter", "esc", "space", "alt+x", "pgdown"} {
+		press, ok := keyPressFor(k)
+		if assert.True(t, ok, k) {
+			assert.Equal(t, k, press.String())
+		}
+	}
+}
+
+func TestKeyPressFor_Unknown(t *testing.T) {
+	for _, k := range []string{"", "hyper+x", "f13", "ctrl+"} {
+		_, ok := keyPressFor(k)
+		assert.False(t, ok, k)
+	}
+}
@@ -102,6 +102,10 @@ type Scope struct {
 	// Stripped from session scope during navigate() and restored in the view scope.
 	OriginView string // source view name ("Activity", "Hey!", "Pulse")
 	OriginHint string // context ("completed Todo", "needs your attention")
+
+	// SearchQuery pre-fills and runs a query when navigating to ViewSearch.
+	// Ephemeral like the origin fields.
+	SearchQuery string
 }
 
 // Data messages
@@ -0,0 +1,538 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/auth"
+	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/resolve"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/chrome"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/data"
+)
+
+// NeedsOnboarding reports whether the workspace should open with the
+// first-run setup flow: nobody is logged in, or no default account has been
+// chosen and setup was never completed.
+func NeedsOnboarding(app *appctx.App) bool {
+	if !app.Auth.IsAuthenticated() {
+		return true
+	}
+	onboarded := app.Config.Onboarded != nil && *app.Config.Onboarded
+	return app.Config.AccountID == "" && !onboarded
+}
+
+// OnboardingResult holds the defaults chosen during onboarding.
+type OnboardingResult struct {
+	AccountID   string
+	AccountName string
+	ProjectID   int64 // 0 when skipped
+	ProjectName string
+	Theme       string // a config.TUIThemes value
+
+	// SaveErr is set when the choices could not be written to the global
+	// config; they still apply to this session.
+	SaveErr error
+}
+
+type onboardingStep int
+
+const (
+	onboardLogin onboardingStep = iota
+	onboardAccount
+	onboardProject
+	onboardTheme
+	onboardDone
+)
+
+type onboardingLoginMsg struct{ err error }
+
+type onboardingAccountsMsg struct {
+	accounts []data.AccountInfo
+	err      error
+}
+
+type onboardingProjectsMsg struct {
+	accountID string
+	projects  []data.ProjectInfo
+	err       error
+}
+
+type onboardingProjectPickedMsg struct{ projectID int64 }
+
+// onboardingThemes are the theme step's choices, in display order.
+var onboardingThemes = []struct {
+	mode, label, hint string
+}{
+	{"auto", "Auto", "follow the terminal's light or dark background"},
+	{"dark", "Dark", "always use the dark palette"},
+	{"light", "Light", "always use the light palette"},
+	{"none", "No color", "plain text, like NO_COLOR"},
+}
+
+// Onboarding is the first-run setup flow shown before the workspace when
+// there is no login or default account: log in, pick a default account and
+// (optionally) project, and pick a theme. It runs as its own program and
+// reuses the account switcher and quick-jump overlays as pickers.
+type Onboarding struct {
+	session *Session
+	styles  *tui.Styles
+
+	step     onboardingStep
+	loading  string // non-empty while a step waits on the network
+	err      error
+	accounts chrome.AccountSwitcher
+	projects chrome.QuickJump
+	loaded   []data.ProjectInfo
+	themeIdx int
+
+	result   OnboardingResult
+	canceled bool
+
+	width, height int
+
+	// Side effects, replaceable in tests.
+	loginFunc    func() tea.Cmd
+	discoverFunc func() tea.Cmd
+	projectsFunc func(accountID string) tea.Cmd
+	persistFunc  func(OnboardingResult) error
+}
+
+// NewOnboarding creates the onboarding flow for a session whose app may not
+// be logged in yet.
+func NewOnboarding(session *Session) *Onboarding {
+	styles := session.Styles()
+	o := &Onboarding{
+		session:  session,
+		styles:   styles,
+		accounts: chrome.NewAccountPicker(styles, "Choose your default account"),
+		projects: chrome.NewQuickJump(styles),
+	}
+	o.projects.SetLabels("Choose a default project", "↑/↓ navigate  enter select  esc skip")
+	o.loginFunc = o.login
+	o.discoverFunc = o.discover
+	o.projectsFunc = o.fetchProjects
+	o.persistFunc = persistOnboarding
+
+	o.result.Theme = "auto"
+	if app := session.App(); app != nil {
+		if app.Auth.IsAuthenticated() {
+			o.step = onboardAccount
+		}
+		if app.Config.TUITheme != "" {
+			o.result.Theme = app.Config.TUITheme
+		}
+	}
+	for i, t := range onboardingThemes {
+		if t.mode == o.result.Theme {
+			o.themeIdx = i
+		}
+	}
+	return o
+}
+
+// Result returns the chosen defaults, or false when the user quit before
+// finishing.
+func (o *Onboarding) Result() (OnboardingResult, bool) {
+	return o.result, !o.canceled && o.step == onboardDone
+}
+
+// Init implements tea.Model.
+func (o *Onboarding) Init() tea.Cmd {
+	if o.step == onboardAccount {
+		o.loading = "Finding your Basecamp accounts…"
+		return o.discoverFunc()
+	}
+	return nil
+}
+
+// Update implements tea.Model.
+func (o *Onboarding) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		o.width, o.height = msg.Width, msg.Height
+		o.accounts.SetSize(msg.Width, msg.Height)
+		o.projects.SetSize(msg.Width, msg.Height)
+		return o, nil
+
+	case tea.KeyPressMsg:
+		return o, o.handleKey(msg)
+
+	case onboardingLoginMsg:
+		if msg.err != nil {
+			o.err = fmt.Errorf("login failed: %w", msg.err)
+			return o, nil
+		}
+		o.err = nil
+		o.step = onboardAccount
+		o.loading = "Finding your Basecamp accounts…"
+		return o, o.discoverFunc()
+
+	case onboardingAccountsMsg:
+		o.loading = ""
+		switch {
+		case msg.err != nil:
+			o.err = msg.err
+			return o, nil
+		case len(msg.accounts) == 0:
+			o.err = fmt.Errorf("no Basecamp accounts found for this login")
+			return o, nil
+		case len(msg.accounts) == 1:
+			return o, o.selectAccount(msg.accounts[0].ID, msg.accounts[0].Name)
+		}
+		entries := make([]chrome.AccountEntry, len(msg.accounts))
+		for i, a := range msg.accounts {
+			entries[i] = chrome.AccountEntry{ID: a.ID, Name: a.Name}
+		}
+		return o, o.accounts.Focus(entries)
+
+	case chrome.AccountSwitchedMsg:
+		return o, o.selectAccount(msg.AccountID, msg.AccountName)
+
+	case chrome.AccountSwitchCloseMsg:
+		o.canceled = true
+		return o, tea.Quit
+
+	case onboardingProjectsMsg:
+		if msg.accountID != o.result.AccountID {
+			return o, nil // stale: the account changed
+		}
+		o.loading = ""
+		if msg.err != nil {
+			o.err = fmt.Errorf("could not load projects: %w", msg.err)
+			return o, nil
+		}
+		if len(msg.projects) == 0 {
+			o.step = onboardTheme
+			return o, nil
+		}
+		o.loaded = msg.projects
+		return o, o.projects.Focus(chrome.QuickJumpSource{
+			Projects:  msg.projects,
+			AccountID: msg.accountID,
+			NavigateProject: func(projectID int64, _ string) tea.Cmd {
+				return func() tea.Msg { return onboardingProjectPickedMsg{projectID: projectID} }
+			},
+		})
+
+	case chrome.QuickJumpExecMsg:
+		return o, msg.Cmd
+
+	case onboardingProjectPickedMsg:
+		o.projects.Blur()
+		o.result.ProjectID = msg.projectID
+		for _, p := range o.loaded {
+			if p.ID == msg.projectID {
+				o.result.ProjectName = p.Name
+			}
+		}
+		o.step = onboardTheme
+		return o, nil
+	}
+	return o, nil
+}
+
+func (o *Onboarding) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if msg.String() == "ctrl+c" {
+		o.canceled = true
+		return tea.Quit
+	}
+	if o.loading != "" {
+		return nil
+	}
+
+	// Errors pause the current step: enter retries (or skips the optional
+	// project step), q quits.
+	if o.err != nil {
+		switch msg.String() {
+		case "enter":
+			o.err = nil
+			switch o.step {
+			case onboardLogin:
+				return o.loginFunc()
+			case onboardAccount:
+				o.loading = "Finding your Basecamp accounts…"
+				return o.discoverFunc()
+			case onboardProject:
+				o.step = onboardTheme
+			}
+		case "q", "esc":
+			o.canceled = true
+			return tea.Quit
+		}
+		return nil
+	}
+
+	switch o.step {
+	case onboardLogin:
+		switch msg.String() {
+		case "enter":
+			return o.loginFunc()
+		case "q", "esc":
+			o.canceled = true
+			return tea.Quit
+		}
+
+	case onboardAccount:
+		return o.accounts.Update(msg)
+
+	case onboardProject:
+		if msg.String() == "esc" {
+			o.projects.Blur()
+			o.step = onboardTheme
+			return nil
+		}
+		return o.projects.Update(msg)
+
+	case onboardTheme:
+		switch msg.String() {
+		case "up", "k":
+			if o.themeIdx > 0 {
+				o.themeIdx--
+				o.session.SetThemeMode(onboardingThemes[o.themeIdx].mode)
+			}
+		case "down", "j":
+			if o.themeIdx < len(onboardingThemes)-1 {
+				o.themeIdx++
+				o.session.SetThemeMode(onboardingThemes[o.themeIdx].mode)
+			}
+		case "enter":
+			o.result.Theme = onboardingThemes[o.themeIdx].mode
+			o.result.SaveErr = o.persistFunc(o.result)
+			o.step = onboardDone
+		}
+
+	case onboardDone:
+		if msg.String() == "enter" {
+			return tea.Quit
+		}
+	}
+	return nil
+}
+
+func (o *Onboarding) selectAccount(id, name string) tea.Cmd {
+	o.accounts.Blur()
+	o.result.AccountID = id
+	o.result.AccountName = name
+	o.step = onboardProject
+	o.loading = "Loading projects…"
+	return o.projectsFunc(id)
+}
+
+// View implements tea.Model.
+func (o *Onboarding) View() tea.View {
+	theme := o.styles.Theme()
+	muted := lipgloss.NewStyle().Foreground(theme.Muted)
+
+	sections := []string{tui.RenderWordmark(theme), "", o.progress(), ""}
+
+	switch {
+	case o.loading != "":
+		sections = append(sections, muted.Render(o.loading))
+	case o.err != nil:
+		sections = append(sections,
+			lipgloss.NewStyle().Foreground(theme.Error).Render(o.err.Error()),
+			"",
+			muted.Render(o.errorHint()),
+		)
+	default:
+		sections = append(sections, o.stepView())
+	}
+
+	ui := lipgloss.NewStyle().
+		Width(o.width).
+		Align(lipgloss.Center).
+		Render(lipgloss.JoinVertical(lipgloss.Center, sections...))
+	v := tea.NewView(ui)
+	v.AltScreen = true
+	v.WindowTitle = "basecamp setup"
+	return v
+}
+
+// progress renders the step trail, highlighting the current step.
+func (o *Onboarding) progress() string {
+	theme := o.styles.Theme()
+	names := []string{"Log in", "Account", "Project", "Theme"}
+	parts := make([]string, len(names))
+	for i, name := range names {
+		style := lipgloss.NewStyle().Foreground(theme.Muted)
+		switch {
+		case onboardingStep(i) == o.step:
+			style = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+		case onboardingStep(i) < o.step:
+			style = lipgloss.NewStyle().Foreground(theme.Success)
+		}
+		parts[i] = style.Render(name)
+	}
+	return strings.Join(parts, lipgloss.NewStyle().Foreground(theme.Border).Render("  ›  "))
+}
+
+func (o *Onboarding) errorHint() string {
+	if o.step == onboardProject {
+		return "enter skip  q quit"
+	}
+	return "enter retry  q quit"
+}
+
+func (o *Onboarding) stepView() string {
+	theme := o.styles.Theme()
+	muted := lipgloss.NewStyle().Foreground(theme.Muted)
+	primary := lipgloss.NewStyle().Foreground(theme.Primary)
+
+	switch o.step {
+	case onboardLogin:
+		return lipgloss.JoinVertical(lipgloss.Center,
+			lipgloss.NewStyle().Foreground(theme.Foreground).Bold(true).Render("Welcome to Basecamp"),
+			"",
+			"You're not logged in yet.",
+			"Press enter to log in with your browser.",
+			"",
+			muted.Render("enter log in  q quit"),
+		)
+
+	case onboardAccount:
+		return o.accounts.View()
+
+	case onboardProject:
+		return o.projects.View()
+
+	case onboardTheme:
+		rows := []string{lipgloss.NewStyle().Foreground(theme.Foreground).Bold(true).Render("Pick a theme"), ""}
+		for i, t := range onboardingThemes {
+			line := fmt.Sprintf("%-9s %s", t.label, muted.Render(t.hint))
+			if i == o.themeIdx {
+				line = primary.Bold(true).Render("› ") + line
+			} else {
+				line = "  " + line
+			}
+			rows = append(rows, line)
+		}
+		rows = append(rows, "", muted.Render("↑/↓ preview  enter choose"))
+		return lipgloss.JoinVertical(lipgloss.Left, rows...)
+
+	case onboardDone:
+		rows := []string{
+			lipgloss.NewStyle().Foreground(theme.Success).Bold(true).Render("You're all set"),
+			"",
+			"Account: " + primary.Render(o.result.AccountName),
+		}
+		if o.result.ProjectName != "" {
+			rows = append(rows, "Project: "+primary.Render(o.result.ProjectName))
+		}
+		rows = append(rows, "Theme:   "+primary.Render(onboardingThemes[o.themeIdx].label), "")
+		if o.result.SaveErr != nil {
+			rows = append(rows, lipgloss.NewStyle().Foreground(theme.Warning).Render(
+				"Could not save these defaults: "+o.result.SaveErr.Error()))
+		} else {
+			rows = append(rows, muted.Render("Saved to your global config. Change them with: basecamp config set"))
+		}
+		rows = append(rows, "", muted.Render("enter open workspace"))
+		return lipgloss.JoinVertical(lipgloss.Left, rows...)
+	}
+	return ""
+}
+
+// login hands the terminal to the browser login flow, which prints its own
+// progress and may ask for a pasted callback URL over SSH.
+func (o *Onboarding) login() tea.Cmd {
+	return tea.Exec(&loginExec{app: o.session.App(), ctx: o.session.Context()}, func(err error) tea.Msg {
+		return onboardingLoginMsg{err: err}
+	})
+}
+
+func (o *Onboarding) discover() tea.Cmd {
+	app := o.session.App()
+	ms := o.session.MultiStore()
+	ctx := o.session.Context()
+	return func() tea.Msg {
+		endpoint, err := app.Auth.AuthorizationEndpoint(ctx)
+		if err != nil {
+			return onboardingAccountsMsg{err: err}
+		}
+		accounts, err := ms.DiscoverAccounts(ctx, endpoint)
+		return onboardingAccountsMsg{accounts: accounts, err: err}
+	}
+}
+
+func (o *Onboarding) fetchProjects(accountID string) tea.Cmd {
+	client := o.session.MultiStore().ClientFor(accountID)
+	ctx := o.session.Context()
+	return func() tea.Msg {
+		result, err := client.Projects().List(ctx, &basecamp.ProjectListOptions{})
+		if err != nil {
+			return onboardingProjectsMsg{accountID: accountID, err: err}
+		}
+		projects := make([]data.ProjectInfo, 0, len(result.Projects))
+		for _, p := range result.Projects {
+			projects = append(projects, data.ProjectInfo{
+				ID:         p.ID,
+				Name:       p.Name,
+				Bookmarked: p.Bookmarked,
+				AccountID:  accountID,
+			})
+		}
+		return onboardingProjectsMsg{accountID: accountID, projects: projects}
+	}
+}
+
+// persistOnboarding writes the chosen defaults to the global config and
+// marks setup as done so the flow doesn't return.
+func persistOnboarding(r OnboardingResult) error {
+	values := [][2]string{{"account_id", r.AccountID}}
+	if r.ProjectID != 0 {
+		values = append(values, [2]string{"project_id", strconv.FormatInt(r.ProjectID, 10)})
+	}
+	values = append(values, [2]string{"tui_theme", r.Theme}, [2]string{"onboarded", "true"})
+	for _, kv := range values {
+		if err := resolve.PersistValue(kv[0], kv[1], "global"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// loginExec runs the OAuth login while bubbletea has released the terminal.
+type loginExec struct {
+	app    *appctx.App
+	ctx    context.Context
+	stdin  io.Reader
+	stdout io.Writer
+}
+
+func (l *loginExec) SetStdin(r io.Reader)  { l.stdin = r }
+func (l *loginExec) SetStdout(w io.Writer) { l.stdout = w }
+func (l *loginExec) SetStderr(io.Writer)   {}
+
+func (l *loginExec) Run() error {
+	fmt.Fprintln(l.stdout, "Opening browser for Basecamp login...")
+	_, err := l.app.Auth.Login(l.ctx, auth.LoginOptions{
+		InputReader: l.stdin,
+		Logger:      func(msg string) { fmt.Fprintln(l.stdout, "  "+msg) },
+	})
+	if err != nil {
+		return err
+	}
+
+	// Record who logged in, as the setup wizard does.
+	resp, err := l.app.SDK.Get(l.ctx, "/my/profile.json")
+	if err != nil {
+		return nil //nolint:nilerr // identity is best-effort; the login itself succeeded
+	}
+	var profile struct {
+		ID    int64  `json:"id"`
+		Email string `json:"email_address"`
+	}
+	if resp.UnmarshalData(&profile) == nil {
+		_ = l.app.Auth.SetUserIdentity(strconv.FormatInt(profile.ID, 10), profile.Email)
+	}
+	return nil
+}
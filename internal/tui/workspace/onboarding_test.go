@@ -0,0 +1,146 @@
+package workspace
+
+import (
+	"errors"
+	"testing"
+
+	tea "charm.land/bubbletea/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/data"
+)
+
+// fakeOnboarding returns an onboarding flow for a logged-out session whose
+// network steps answer from the given accounts and projects.
+func fakeOnboarding(t *testing.T, accounts []data.AccountInfo, projects []data.ProjectInfo) (*Onboarding, *[]OnboardingResult) {
+	t.Helper()
+	o := NewOnboarding(NewTestSession())
+	o.loginFunc = func() tea.Cmd {
+		return func() tea.Msg { return onboardingLoginMsg{} }
+	}
+	o.discoverFunc = func() tea.Cmd {
+		return func() tea.Msg { return onboardingAccountsMsg{accounts: accounts} }
+	}
+	o.projectsFunc = func(accountID string) tea.Cmd {
+		return func() tea.Msg { return onboardingProjectsMsg{accountID: accountID, projects: projects} }
+	}
+	var saved []OnboardingResult
+	o.persistFunc = func(r OnboardingResult) error {
+		saved = append(saved, r)
+		return nil
+	}
+	o.Update(tea.WindowSizeMsg{Width: 100, Height: 40})
+	return o, &saved
+}
+
+// feedOnboarding runs cmd and feeds what it produces back into o, following
+// batches. Returns true if the flow asked to quit.
+func feedOnboarding(o *Onboarding, cmd tea.Cmd) bool {
+	if cmd == nil {
+		return false
+	}
+	switch msg := cmd().(type) {
+	case nil:
+		return false
+	case tea.QuitMsg:
+		return true
+	case tea.BatchMsg:
+		quit := false
+		for _, c := range msg {
+			quit = feedOnboarding(o, c) || quit
+		}
+		return quit
+	default:
+		_, next := o.Update(msg)
+		return feedOnboarding(o, next)
+	}
+}
+
+func pressOnboarding(o *Onboarding, key tea.KeyPressMsg) bool {
+	_, cmd := o.Update(key)
+	return feedOnboarding(o, cmd)
+}
+
+func TestOnboardingFullFlow(t *testing.T) {
+	o, saved := fakeOnboarding(t,
+		[]data.AccountInfo{{ID: "1", Name: "Acme"}, {ID: "2", Name: "Globex"}},
+		[]data.ProjectInfo{{ID: 11, Name: "Launch", AccountID: "2"}, {ID: 12, Name: "Ops", AccountID: "2"}},
+	)
+	require.Nil(t, o.Init(), "a logged-out session starts on the login step")
+	assert.Contains(t, o.View().Content, "log in with your browser")
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Equal(t, onboardAccount, o.step)
+	assert.Contains(t, o.View().Content, "Choose your default account")
+	assert.NotContains(t, o.View().Content, "All Accounts", "a default must be a real account")
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: '2', Text: "2"})
+	require.Equal(t, onboardProject, o.step)
+	assert.Contains(t, o.View().Content, "Choose a default project")
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyDown})
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Equal(t, onboardTheme, o.step)
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyDown})
+	assert.Equal(t, "dark", o.session.themeMode, "moving the cursor previews the theme")
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Equal(t, onboardDone, o.step)
+	assert.Contains(t, o.View().Content, "You're all set")
+
+	want := OnboardingResult{AccountID: "2", AccountName: "Globex", ProjectID: 12, ProjectName: "Ops", Theme: "dark"}
+	assert.Equal(t, []OnboardingResult{want}, *saved)
+
+	assert.True(t, pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter}))
+	result, ok := o.Result()
+	require.True(t, ok)
+	assert.Equal(t, want, result)
+}
+
+func TestOnboardingSingleAccountAndSkippedProject(t *testing.T) {
+	o, saved := fakeOnboarding(t,
+		[]data.AccountInfo{{ID: "1", Name: "Acme"}},
+		[]data.ProjectInfo{{ID: 11, Name: "Launch", AccountID: "1"}},
+	)
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.Equal(t, onboardProject, o.step, "the only account is chosen automatically")
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEscape})
+	require.Equal(t, onboardTheme, o.step)
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+
+	require.Len(t, *saved, 1)
+	assert.Equal(t, OnboardingResult{AccountID: "1", AccountName: "Acme", Theme: "auto"}, (*saved)[0])
+}
+
+func TestOnboardingLoginFailureRetries(t *testing.T) {
+	o, _ := fakeOnboarding(t, []data.AccountInfo{{ID: "1", Name: "Acme"}}, nil)
+	attempts := 0
+	o.loginFunc = func() tea.Cmd {
+		attempts++
+		return func() tea.Msg {
+			if attempts == 1 {
+				return onboardingLoginMsg{err: errors.New("state mismatch")}
+			}
+			return onboardingLoginMsg{}
+		}
+	}
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Equal(t, onboardLogin, o.step)
+	assert.Contains(t, o.View().Content, "login failed: state mismatch")
+
+	pressOnboarding(o, tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.Equal(t, 2, attempts)
+	assert.Equal(t, onboardTheme, o.step, "no projects skips the project step")
+}
+
+func TestOnboardingQuitIsNotAResult(t *testing.T) {
+	o, saved := fakeOnboarding(t, nil, nil)
+	assert.True(t, pressOnboarding(o, tea.KeyPressMsg{Code: 'q', Text: "q"}))
+
+	_, ok := o.Result()
+	assert.False(t, ok)
+	assert.Empty(t, *saved)
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 
 	"github.com/basecamp/basecamp-cli/internal/appctx"
+	"github.com/basecamp/basecamp-cli/internal/chatread"
 	"github.com/basecamp/basecamp-cli/internal/tui"
 	"github.com/basecamp/basecamp-cli/internal/tui/recents"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace/data"
@@ -22,16 +23,20 @@ type Session struct {
 	app        *appctx.App
 	scope      Scope
 	recents    *recents.Store
+	chatReads  *chatread.Store
 	styles     *tui.Styles
 	multiStore *data.MultiStore
 	hub        *data.Hub
 	summarizer *summarize.Summarizer
+	mutes      *data.MuteList
+	visits     *data.ToolVisits
 
 	// Deep-link: initial navigation target set via CLI args.
 	initialTarget *ViewTarget
 	initialScope  *Scope
 
-	hasDarkBG bool // terminal background detected or defaulted
+	hasDarkBG bool   // terminal background detected or defaulted
+	themeMode string // tui_theme: "auto" (or ""), "dark", "light", "none"
 
 	mu     sync.RWMutex
 	ctx    context.Context
@@ -58,20 +63,25 @@ func NewSession(app *appctx.App) (*Session, error) {
 	ms := data.NewMultiStore(app.SDK)
 	s := &Session{
 		app:        app,
-		styles:     tui.NewStylesWithTheme(tui.ResolveTheme(true)),
+		styles:     tui.NewStylesWithTheme(tui.ResolveThemeMode(app.Config.TUITheme, true)),
 		hasDarkBG:  true,
+		themeMode:  app.Config.TUITheme,
 		multiStore: ms,
 		hub:        data.NewHub(ms, app.Config.CacheDir),
+		mutes:      data.NewMuteList(app.Config.TUIMute),
+		visits:     data.NewToolVisits(),
 		ctx:        ctx,
 		cancel:     cancel,
 	}
 
 	// Initialize scope from config
 	s.scope.AccountID = app.Config.AccountID
+	s.hub.SetRefreshIntervals(app.Config.Refresh)
 
 	// Initialize recents store and room selection filter
 	if app.Config.CacheDir != "" {
 		s.recents = recents.NewStore(app.Config.CacheDir)
+		s.chatReads = chatread.NewStore(filepath.Join(app.Config.CacheDir, chatread.DirName))
 		s.hub.SetRoomStore(data.NewRoomStore(app.Config.CacheDir))
 		s.hub.SetRecentProjects(func(accountID string) []int64 {
 			items := s.recents.Get(recents.TypeProject, accountID, "")
@@ -134,6 +144,11 @@ func (s *Session) Recents() *recents.Store {
 	return s.recents
 }
 
+// ChatReads returns the chat read-position store (may be nil if no cache dir).
+func (s *Session) ChatReads() *chatread.Store {
+	return s.chatReads
+}
+
 // AccountClient returns the SDK client for the current account.
 // Panics if AccountID is not set — call RequireAccount first.
 // Thread-safe: reads scope under lock.
@@ -161,6 +176,12 @@ func (s *Session) Hub() *data.Hub {
 	return s.hub
 }
 
+// Mutes returns the projects and dock tools muted for toasts and badges.
+func (s *Session) Mutes() *data.MuteList { return s.mutes }
+
+// ToolVisits returns when each dock tool was last opened, for unread badges.
+func (s *Session) ToolVisits() *data.ToolVisits { return s.visits }
+
 // Summarizer returns the smart zoom summarizer.
 func (s *Session) Summarizer() *summarize.Summarizer { return s.summarizer }
 
@@ -201,6 +222,8 @@ func NewTestSession() *Session {
 	return &Session{
 		styles:     tui.NewStyles(),
 		multiStore: data.NewMultiStore(nil),
+		mutes:      data.NewMuteList(nil),
+		visits:     data.NewToolVisits(),
 		ctx:        ctx,
 		cancel:     cancel,
 	}
@@ -224,6 +247,17 @@ func NewTestSessionWithScope(scope Scope) *Session {
 	return s
 }
 
+// NewTestSessionWithSDK is like NewTestSessionWithScope but carries an SDK
+// client, so handlers that build mutations can run. Nothing is sent unless a
+// test executes the returned Cmd.
+func NewTestSessionWithSDK(scope Scope) *Session {
+	s := NewTestSessionWithScope(scope)
+	s.app = &appctx.App{
+		SDK: basecamp.NewClient(&basecamp.Config{BaseURL: "http://localhost"}, &basecamp.StaticTokenProvider{Token: "test"}),
+	}
+	return s
+}
+
 // NewTestSessionWithRecents is like NewTestSession but includes a recents store.
 func NewTestSessionWithRecents(r *recents.Store) *Session {
 	s := NewTestSession()
@@ -231,6 +265,14 @@ func NewTestSessionWithRecents(r *recents.Store) *Session {
 	return s
 }
 
+// NewTestSessionWithChatReads is like NewTestSession but includes a chat
+// read-position store.
+func NewTestSessionWithChatReads(store *chatread.Store) *Session {
+	s := NewTestSession()
+	s.chatReads = store
+	return s
+}
+
 // SetInitialView configures a deep-link target to navigate to on startup
 // instead of Home. Called from the tui command when a URL argument is provided.
 func (s *Session) SetInitialView(target ViewTarget, scope Scope) {
@@ -259,6 +301,14 @@ func (s *Session) SetDarkBackground(dark bool) {
 	s.hasDarkBG = dark
 }
 
+// SetThemeMode switches the tui_theme setting and reloads the styles.
+func (s *Session) SetThemeMode(mode string) {
+	s.mu.Lock()
+	s.themeMode = mode
+	s.mu.Unlock()
+	s.ReloadTheme()
+}
+
 // ReloadTheme re-reads the theme from disk and updates the shared Styles
 // in place. All components holding *Styles see new colors on the next render.
 // Thread-safe: serializes the full resolve+apply through the write lock so
@@ -266,7 +316,7 @@ func (s *Session) SetDarkBackground(dark bool) {
 func (s *Session) ReloadTheme() {
 	s.mu.Lock()
 	dark := s.hasDarkBG
-	theme := tui.ResolveTheme(dark)
+	theme := tui.ResolveThemeMode(s.themeMode, dark)
 	s.styles.UpdateTheme(theme)
 	s.mu.Unlock()
 }
@@ -41,6 +41,22 @@ type Filterable interface {
 	StartFilter()
 }
 
+// FilterState describes a view's list filter: the query and how many of the
+// list's items it matches. A zero value means no filter is applied.
+type FilterState struct {
+	Query   string
+	Matched int
+	Total   int
+}
+
+// FilterReporter is an optional interface for filterable views whose filter
+// stays applied after the prompt closes. The workspace pins the filter to the
+// status bar, and Esc clears it before navigating back.
+type FilterReporter interface {
+	FilterState() FilterState
+	ClearFilter()
+}
+
 // FocusedItemScope holds the account/project/recording context of the
 // currently focused list item.  Zero-valued fields mean "unknown/same as
 // the session scope".
@@ -57,9 +73,31 @@ type FocusedRecording interface {
 	FocusedItem() FocusedItemScope
 }
 
+// FocusedURLOpener is an optional interface for views whose focused item has
+// a direct URL of its own (e.g., an upload's download URL). When FocusedURL
+// returns a non-empty URL, open-in-browser opens it instead of the
+// recording's Basecamp page.
+type FocusedURLOpener interface {
+	FocusedURL() string
+}
+
 // SplitPaneFocuser is an optional interface for views that use a split-pane
 // layout with internal tab-cycling. When the sidebar is open, the workspace
 // routes tab to the view instead of consuming it for sidebar focus switching.
 type SplitPaneFocuser interface {
 	HasSplitPane() bool
 }
+
+// ViewExport is a snapshot of a view's underlying data, ready to be saved
+// to a file or copied to the clipboard.
+type ViewExport struct {
+	Name    string // slug used in the default file name, e.g. "hey"
+	Format  string // file extension: "json" for lists, "md" for details
+	Content []byte
+}
+
+// Exporter is an optional interface for views whose data can be exported.
+// The workspace calls Export on ctrl+e and asks where to send the result.
+type Exporter interface {
+	Export() (ViewExport, error)
+}
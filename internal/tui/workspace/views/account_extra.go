@@ -35,6 +35,16 @@ func accountExtra(accounts []data.AccountInfo, accountID, extra string) string {
 	return fmt.Sprintf("%d\u00b7%s", idx, extra)
 }
 
+// accountNumber is the ListItem.Account for an item in accountID: its
+// 1-based index when multi-account, so Extra takes the account's color, and
+// 0 otherwise, matching when accountExtra adds the index prefix.
+func accountNumber(accounts []data.AccountInfo, accountID string) int {
+	if len(accounts) <= 1 {
+		return 0
+	}
+	return accountIndex(accounts, accountID)
+}
+
 // accountIndex returns the 1-based position of accountID in accounts, or 0.
 // Ordering matches MultiStore.Accounts() which is the same ordering used by
 // the account switcher and breadcrumb badge.
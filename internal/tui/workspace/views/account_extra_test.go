@@ -241,3 +241,18 @@ func TestSearch_OpenSelected_UsesMeta_NotPrefixedExtra(t *testing.T) {
 	assert.Equal(t, "Message", saved[0].Description,
 		"recents Description must be raw type from resultMeta, not prefixed Extra")
 }
+
+func TestAccountNumber(t *testing.T) {
+	single := []data.AccountInfo{{ID: "aaa", Name: "Acme"}}
+	multi := []data.AccountInfo{
+		{ID: "aaa", Name: "Acme"},
+		{ID: "bbb", Name: "Beta"},
+		{ID: "ccc", Name: "Gamma"},
+	}
+
+	assert.Equal(t, 0, accountNumber(single, "aaa"), "single account: no color")
+	assert.Equal(t, 0, accountNumber(nil, "aaa"), "project-scoped views pass no accounts")
+	assert.Equal(t, 1, accountNumber(multi, "aaa"))
+	assert.Equal(t, 3, accountNumber(multi, "ccc"))
+	assert.Equal(t, 0, accountNumber(multi, "zzz"), "unknown account: no color")
+}
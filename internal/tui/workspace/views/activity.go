@@ -31,6 +31,9 @@ type Activity struct {
 	// Entries metadata for navigation
 	entryMeta map[string]workspace.TimelineEventInfo
 
+	// ":" goto-date prompt
+	jump dateJump
+
 	pollGen       uint64
 	width, height int
 }
@@ -62,6 +65,11 @@ func NewActivity(session *workspace.Session) *Activity {
 
 func (v *Activity) Title() string { return "Activity" }
 
+// Export implements workspace.Exporter.
+func (v *Activity) Export() (workspace.ViewExport, error) {
+	return exportList("activity", v.list, v.entryMeta)
+}
+
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Activity) FocusedItem() workspace.FocusedItemScope {
 	item := v.list.Selected()
@@ -83,9 +91,13 @@ func (v *Activity) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
 		return filterHints()
 	}
+	if v.jump.active {
+		return dateJumpHints()
+	}
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("j/k"), key.WithHelp("j/k", "navigate")),
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
+		key.NewBinding(key.WithKeys(":"), key.WithHelp(":", "go to date")),
 	}
 }
 
@@ -96,8 +108,17 @@ func (v *Activity) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Activity) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Activity) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Activity) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
-func (v *Activity) InputActive() bool { return v.list.Filtering() }
+func (v *Activity) InputActive() bool { return v.list.Filtering() || v.jump.active }
+
+// IsModal implements workspace.ModalActive.
+func (v *Activity) IsModal() bool { return v.jump.active }
 
 func (v *Activity) SetSize(w, h int) {
 	v.width = w
@@ -177,8 +198,16 @@ func (v *Activity) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		if v.loading {
 			return v, nil
 		}
+		if v.jump.active {
+			return v, v.handleJumpKey(msg)
+		}
+		if v.list.Filtering() {
+			return v, v.list.Update(msg)
+		}
 		keys := workspace.DefaultListKeyMap()
 		switch {
+		case msg.String() == ":":
+			return v, v.jump.start()
 		case key.Matches(msg, keys.Open):
 			return v, v.openSelected()
 		default:
@@ -196,9 +225,26 @@ func (v *Activity) View() string {
 			Padding(1, 2).
 			Render(v.spinner.View() + " Loading timeline…")
 	}
+	if v.jump.active {
+		return v.list.View() + "\n" + v.jump.view(v.styles)
+	}
 	return v.list.View()
 }
 
+// handleJumpKey routes keys to the goto-date prompt and, on submit, moves
+// the cursor to the event nearest the requested day.
+func (v *Activity) handleJumpKey(msg tea.KeyPressMsg) tea.Cmd {
+	day, ok, cmd := v.jump.handleKey(msg)
+	if !ok {
+		return cmd
+	}
+	found, exact := jumpToDate(v.list, day, func(id string) (int64, bool) {
+		meta, ok := v.entryMeta[id]
+		return meta.CreatedAtTS, ok
+	})
+	return dateJumpStatus(day, found, exact, "activity")
+}
+
 func (v *Activity) syncEntries(entries []workspace.TimelineEventInfo) {
 	accounts := sessionAccounts(v.session)
 	v.entryMeta = syncTimelineEntries(entries, v.list, accounts)
@@ -7,6 +7,7 @@ import (
 	"testing"
 	"time"
 
+	tea "charm.land/bubbletea/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -303,3 +304,29 @@ func TestActivity_TerminalFocusMsg_BumpsPollGen(t *testing.T) {
 	v.Update(workspace.TerminalFocusMsg{})
 	assert.Equal(t, uint64(2), v.pollGen, "each TerminalFocusMsg should bump pollGen")
 }
+
+func TestActivity_GotoDate_SelectsEventOnDay(t *testing.T) {
+	today := time.Now()
+	lastWeek := today.AddDate(0, 0, -7)
+	entries := sampleTimeline()
+	entries = append(entries, data.TimelineEventInfo{
+		ID:          102,
+		RecordingID: 5003,
+		CreatedAtTS: lastWeek.Unix(),
+		Action:      "created",
+		Target:      "Document",
+		Title:       "Old doc",
+		AccountID:   "a1",
+	})
+	v := testActivity(entries)
+
+	v.Update(tea.KeyPressMsg{Code: ':', Text: ":"})
+	require.True(t, v.jump.active)
+	assert.True(t, v.InputActive())
+
+	v.jump.input.SetValue(lastWeek.Format("2006-01-02"))
+	v.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.False(t, v.jump.active)
+	require.NotNil(t, v.list.Selected())
+	assert.Equal(t, "a1:102", v.list.Selected().ID)
+}
@@ -76,6 +76,11 @@ func NewAssignments(session *workspace.Session) *Assignments {
 
 func (v *Assignments) Title() string { return "Assignments" }
 
+// Export implements workspace.Exporter.
+func (v *Assignments) Export() (workspace.ViewExport, error) {
+	return exportList("assignments", v.list, v.assignmentMeta)
+}
+
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Assignments) FocusedItem() workspace.FocusedItemScope {
 	item := v.list.Selected()
@@ -113,6 +118,12 @@ func (v *Assignments) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Assignments) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Assignments) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Assignments) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Assignments) InputActive() bool { return v.list.Filtering() }
 
@@ -299,6 +310,7 @@ func (v *Assignments) syncAssignments(assignments []workspace.AssignmentInfo) {
 				Title:       a.Content,
 				Description: desc,
 				Extra:       extra,
+				Account:     accountNumber(accounts, a.AccountID),
 				Marked:      a.Overdue,
 			})
 		}
@@ -100,6 +100,12 @@ type Chat struct {
 	lastID         int64 // highest line ID seen, for detecting new lines
 	selectedLineID int64 // the line currently selected for boost/action
 
+	// Read position: lines after readMarker arrived since the chat was last
+	// read and sit below a "new messages" separator. markedID is the
+	// highest line ID saved as read so far.
+	readMarker int64
+	markedID   int64
+
 	// Pagination
 	totalCount  int  // total lines available (from X-Total-Count)
 	currentPage int  // last page loaded (1-based)
@@ -127,11 +133,16 @@ func NewChat(session *workspace.Session) *Chat {
 
 	pool := session.Hub().ChatLines(scope.ProjectID, scope.ToolID)
 
+	var readMarker int64
+	if store := session.ChatReads(); store != nil {
+		readMarker = store.LastRead(scope.AccountID, scope.ToolID)
+	}
+
 	comp := widget.NewComposer(styles,
 		widget.WithMode(widget.ComposerQuick),
 		widget.WithAutoExpand(true),
 		widget.WithAttachmentsDisabled(),
-		widget.WithPlaceholder("Type a message..."),
+		widget.WithPlaceholder("Type a message, or /help for commands..."),
 	)
 
 	return &Chat{
@@ -147,6 +158,8 @@ func NewChat(session *workspace.Session) *Chat {
 		spinner:     s,
 		loading:     true,
 		currentPage: 1,
+		readMarker:  readMarker,
+		markedID:    readMarker,
 	}
 }
 
@@ -229,6 +242,7 @@ func (v *Chat) Init() tea.Cmd {
 		}
 		v.renderMessages()
 		v.loading = false
+		cmds = append(cmds, v.markRead())
 	}
 	if !snap.Fresh() {
 		cmds = append(cmds, v.pool.FetchIfStale(v.session.Hub().ProjectContext()))
@@ -273,6 +287,7 @@ func (v *Chat) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 				v.loading = false
 				return v, workspace.ReportError(snap.Err, "loading chat")
 			}
+			return v, v.markRead()
 		}
 		return v, nil
 
@@ -382,6 +397,11 @@ func (v *Chat) handleComposerSubmit(msg widget.ComposerSubmitMsg) tea.Cmd {
 	v.composer.Focus()
 	v.resizeViewport()
 
+	if c, ok := parseChatCommand(content.Markdown); ok {
+		return v.runCommand(c)
+	}
+	content.Markdown = unescapeChatSlash(content.Markdown)
+
 	if content.IsPlain {
 		return v.sendLine(content.Markdown, false)
 	}
@@ -529,6 +549,7 @@ func (v *Chat) sendLine(content string, isHTML bool) tea.Cmd {
 		isHTML:  isHTML,
 		sentAt:  time.Now(),
 	})
+	v.readMarker = 0 // replying means the new messages have been seen
 	v.renderMessages()
 
 	chatID := v.chatID
@@ -596,6 +617,7 @@ func (v *Chat) renderMessages() {
 	}
 
 	dateStyle := lipgloss.NewStyle().Foreground(theme.Muted).Align(lipgloss.Center).Width(bodyWidth)
+	newStyle := lipgloss.NewStyle().Foreground(theme.Primary).Align(lipgloss.Center).Width(bodyWidth)
 
 	for i, line := range v.lines {
 		// Date separator when the day changes
@@ -611,10 +633,20 @@ func (v *Chat) renderMessages() {
 			}
 		}
 
+		// "New messages" separator before the first line since the last read
+		firstNew := v.isFirstNewLine(i)
+		if firstNew {
+			if !dayChanged {
+				b.WriteString("\n")
+			}
+			b.WriteString(newStyle.Render("── new messages ──"))
+			b.WriteString("\n")
+		}
+
 		// Group consecutive messages from same sender within 5 minutes,
-		// but always show header after a date boundary.
+		// but always show header after a date or new-messages boundary.
 		showHeader := true
-		if i > 0 && !dayChanged {
+		if i > 0 && !dayChanged && !firstNew {
 			prev := v.lines[i-1]
 			if prev.Creator == line.Creator && sameTimeGroup(prev.CreatedAtTS, line.CreatedAtTS) {
 				showHeader = false
@@ -622,8 +654,8 @@ func (v *Chat) renderMessages() {
 		}
 
 		if showHeader {
-			// Add spacing before header, unless a date separator was just written
-			if i > 0 && !dayChanged {
+			// Add spacing before header, unless a separator was just written
+			if i > 0 && !dayChanged && !firstNew {
 				b.WriteString("\n")
 			}
 			b.WriteString(nameStyle.Render(line.Creator))
@@ -831,6 +863,33 @@ func (v *Chat) FocusedItem() workspace.FocusedItemScope {
 	return workspace.FocusedItemScope{} // no single-item URL for chat stream
 }
 
+// isFirstNewLine reports whether lines[i] is the first line after the read
+// position. The separator is only drawn below an already-read line: when
+// every loaded line is new, older unread ones may still be above.
+func (v *Chat) isFirstNewLine(i int) bool {
+	return v.readMarker > 0 && i > 0 &&
+		v.lines[i].ID > v.readMarker && v.lines[i-1].ID <= v.readMarker
+}
+
+// markRead saves the newest loaded line as this chat's read position, off
+// the UI loop. Best-effort: a failed save only means the lines show as new
+// next time.
+func (v *Chat) markRead() tea.Cmd {
+	if v.lastID <= v.markedID || v.session == nil {
+		return nil
+	}
+	store := v.session.ChatReads()
+	if store == nil {
+		return nil
+	}
+	v.markedID = v.lastID
+	accountID, chatID, lineID := v.session.Scope().AccountID, v.chatID, v.lastID
+	return func() tea.Msg {
+		_ = store.MarkRead(accountID, chatID, lineID)
+		return nil
+	}
+}
+
 func (v *Chat) updateLastID() {
 	for _, line := range v.lines {
 		if line.ID > v.lastID {
@@ -0,0 +1,147 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
+
+	"github.com/basecamp/basecamp-cli/internal/dateparse"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+)
+
+// chatCommandHelp lists the slash commands the chat composer understands.
+const chatCommandHelp = "/todo <what> [when] · /done <id> · /search <query> · //text to post a leading slash"
+
+// chatCommandRe matches a slash command: a leading "/" and a lowercase word,
+// optionally followed by arguments. "/usr/bin is broken" doesn't match, so
+// it is posted as chat.
+var chatCommandRe = regexp.MustCompile(`^/([a-zA-Z]+)(?:\s+(.*))?$`)
+
+// chatTodoRefRe extracts a todo ID from "123", "#123", or a todo URL.
+var chatTodoRefRe = regexp.MustCompile(`^(?:#?(\d+)|\S*/todos/(\d+)\S*)$`)
+
+// chatCommand is a slash command typed into the chat composer. Commands are
+// handled client-side and never posted as chat lines.
+type chatCommand struct {
+	name string
+	arg  string
+}
+
+// parseChatCommand recognizes a single-line slash command. Text starting
+// with "//" is an escaped slash and is not a command.
+func parseChatCommand(text string) (chatCommand, bool) {
+	text = strings.TrimSpace(text)
+	if strings.HasPrefix(text, "//") || strings.Contains(text, "\n") {
+		return chatCommand{}, false
+	}
+	m := chatCommandRe.FindStringSubmatch(text)
+	if m == nil {
+		return chatCommand{}, false
+	}
+	return chatCommand{name: strings.ToLower(m[1]), arg: strings.TrimSpace(m[2])}, true
+}
+
+// unescapeChatSlash turns "//text" into "/text" so a line can start with a
+// slash without being taken as a command.
+func unescapeChatSlash(text string) string {
+	if trimmed := strings.TrimLeft(text, " \t"); strings.HasPrefix(trimmed, "//") {
+		return trimmed[1:]
+	}
+	return text
+}
+
+// splitTodoDue splits a trailing due date ("tomorrow", "next week",
+// "in 3 days", "2026-01-15") off quick-add todo text. The longest trailing
+// phrase that parses as a date wins; text that is only a date stays content.
+func splitTodoDue(text string, now time.Time) (content, dueOn string) {
+	words := strings.Fields(text)
+	for n := min(3, len(words)-1); n >= 1; n-- {
+		phrase := strings.Join(words[len(words)-n:], " ")
+		if parsed := dateparse.ParseFrom(phrase, now); isISODate(parsed) {
+			return strings.Join(words[:len(words)-n], " "), parsed
+		}
+	}
+	return strings.Join(words, " "), ""
+}
+
+func isISODate(s string) bool {
+	_, err := time.Parse("2006-01-02", s)
+	return err == nil
+}
+
+// parseChatTodoRef returns the todo ID named by a /done argument.
+func parseChatTodoRef(arg string) (int64, bool) {
+	m := chatTodoRefRe.FindStringSubmatch(strings.TrimSpace(arg))
+	if m == nil {
+		return 0, false
+	}
+	ref := m[1]
+	if ref == "" {
+		ref = m[2]
+	}
+	id, err := strconv.ParseInt(ref, 10, 64)
+	return id, err == nil && id > 0
+}
+
+// runCommand executes a slash command through the Hub and reports the
+// outcome in the status bar.
+func (v *Chat) runCommand(c chatCommand) tea.Cmd {
+	hub := v.session.Hub()
+	scope := v.session.Scope()
+	ctx := hub.ProjectContext()
+	accountID := scope.AccountID
+	projectID := v.projectID
+
+	switch c.name {
+	case "todo":
+		content, dueOn := splitTodoDue(c.arg, time.Now())
+		if content == "" {
+			return workspace.SetStatus("Usage: /todo <what> [when]", true)
+		}
+		return func() tea.Msg {
+			todo, list, err := hub.QuickAddTodo(ctx, accountID, projectID, &basecamp.CreateTodoRequest{
+				Content: content,
+				DueOn:   dueOn,
+			})
+			if err != nil {
+				return workspace.ErrorMsg{Err: err, Context: "adding todo"}
+			}
+			text := fmt.Sprintf("Added #%d to %s", todo.ID, list)
+			if dueOn != "" {
+				text += ", due " + dueOn
+			}
+			return workspace.StatusMsg{Text: text}
+		}
+
+	case "done":
+		todoID, ok := parseChatTodoRef(c.arg)
+		if !ok {
+			return workspace.SetStatus("Usage: /done <todo id>", true)
+		}
+		return func() tea.Msg {
+			if err := hub.CompleteTodo(ctx, accountID, projectID, todoID); err != nil {
+				return workspace.ErrorMsg{Err: err, Context: "completing todo"}
+			}
+			return workspace.StatusMsg{Text: fmt.Sprintf("Completed #%d", todoID)}
+		}
+
+	case "search":
+		if c.arg == "" {
+			return workspace.SetStatus("Usage: /search <query>", true)
+		}
+		scope.SearchQuery = c.arg
+		return workspace.Navigate(workspace.ViewSearch, scope)
+
+	case "help":
+		return workspace.SetStatus(chatCommandHelp, false)
+
+	default:
+		return workspace.SetStatus(fmt.Sprintf("Unknown command /%s — %s", c.name, chatCommandHelp), true)
+	}
+}
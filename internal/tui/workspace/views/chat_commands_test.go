@@ -0,0 +1,105 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
+)
+
+func TestParseChatCommand(t *testing.T) {
+	tests := []struct {
+		text string
+		want chatCommand
+		ok   bool
+	}{
+		{"/todo Buy milk tomorrow", chatCommand{name: "todo", arg: "Buy milk tomorrow"}, true},
+		{"  /done 123  ", chatCommand{name: "done", arg: "123"}, true},
+		{"/Search  foo bar", chatCommand{name: "search", arg: "foo bar"}, true},
+		{"/help", chatCommand{name: "help"}, true},
+		{"//todo not a command", chatCommand{}, false},
+		{"/usr/bin is broken", chatCommand{}, false},
+		{"hello /todo", chatCommand{}, false},
+		{"/todo first\nsecond", chatCommand{}, false},
+	}
+	for _, tt := range tests {
+		got, ok := parseChatCommand(tt.text)
+		assert.Equal(t, tt.ok, ok, "text %q", tt.text)
+		assert.Equal(t, tt.want, got, "text %q", tt.text)
+	}
+}
+
+func TestUnescapeChatSlash(t *testing.T) {
+	assert.Equal(t, "/shrug", unescapeChatSlash("//shrug"))
+	assert.Equal(t, "/usr/bin", unescapeChatSlash("/usr/bin"))
+	assert.Equal(t, "hi", unescapeChatSlash("hi"))
+}
+
+func TestSplitTodoDue(t *testing.T) {
+	now := time.Date(2026, 3, 10, 9, 0, 0, 0, time.UTC) // a Tuesday
+
+	tests := []struct {
+		text, content, due string
+	}{
+		{"Buy milk tomorrow", "Buy milk", "2026-03-11"},
+		{"Ship release next week", "Ship release", "2026-03-17"},
+		{"Renew domain in 3 days", "Renew domain", "2026-03-13"},
+		{"File taxes 2026-04-15", "File taxes", "2026-04-15"},
+		{"Buy milk", "Buy milk", ""},
+		{"tomorrow", "tomorrow", ""},
+	}
+	for _, tt := range tests {
+		content, due := splitTodoDue(tt.text, now)
+		assert.Equal(t, tt.content, content, "text %q", tt.text)
+		assert.Equal(t, tt.due, due, "text %q", tt.text)
+	}
+}
+
+func TestParseChatTodoRef(t *testing.T) {
+	for arg, want := range map[string]int64{
+		"123":  123,
+		"#456": 456,
+		"https://3.basecamp.com/1/buckets/2/todos/789": 789,
+	} {
+		id, ok := parseChatTodoRef(arg)
+		assert.True(t, ok, arg)
+		assert.Equal(t, want, id, arg)
+	}
+	for _, arg := range []string{"", "abc", "12 34", "#0"} {
+		_, ok := parseChatTodoRef(arg)
+		assert.False(t, ok, arg)
+	}
+}
+
+func TestChat_SearchCommandNavigatesWithQuery(t *testing.T) {
+	v := testPollingChat()
+	v.session.SetScope(workspace.Scope{AccountID: "test-account", ProjectID: 99})
+
+	cmd := v.handleComposerSubmit(widget.ComposerSubmitMsg{
+		Content: widget.ComposerContent{Markdown: "/search launch plan", IsPlain: true},
+	})
+	require.NotNil(t, cmd)
+
+	nav, ok := cmd().(workspace.NavigateMsg)
+	require.True(t, ok)
+	assert.Equal(t, workspace.ViewSearch, nav.Target)
+	assert.Equal(t, "launch plan", nav.Scope.SearchQuery)
+	assert.Empty(t, v.pending, "commands are not posted as chat lines")
+}
+
+func TestChat_CommandUsageErrors(t *testing.T) {
+	v := testPollingChat()
+
+	for _, text := range []string{"/todo", "/done nope", "/search", "/frobnicate"} {
+		c, ok := parseChatCommand(text)
+		require.True(t, ok, text)
+		status, ok := v.runCommand(c)().(workspace.StatusMsg)
+		require.True(t, ok, text)
+		assert.True(t, status.IsError, text)
+	}
+	assert.Empty(t, v.pending)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	"github.com/basecamp/basecamp-cli/internal/chatread"
 	"github.com/basecamp/basecamp-cli/internal/tui"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace/data"
@@ -245,6 +246,47 @@ func TestChat_UTCTimestamps_LocalDaySeparators(t *testing.T) {
 	assert.Equal(t, 2, dateSepCount, "same local day should produce one date separator (2 dashes)")
 }
 
+func TestChat_NewMessagesSeparator(t *testing.T) {
+	now := time.Date(2026, 1, 15, 12, 0, 0, 0, time.UTC)
+	lines := []workspace.ChatLineInfo{
+		{ID: 10, Body: "read one", Creator: "Alice", CreatedAt: "9:00am", CreatedAtTS: now},
+		{ID: 11, Body: "read two", Creator: "Alice", CreatedAt: "9:00am", CreatedAtTS: now.Add(time.Second)},
+		{ID: 12, Body: "fresh", Creator: "Alice", CreatedAt: "9:01am", CreatedAtTS: now.Add(time.Minute)},
+	}
+
+	v := testChatWithLines(lines)
+	v.readMarker = 11
+	v.renderMessages()
+	content := ansi.Strip(v.viewport.View())
+	require.Equal(t, 1, strings.Count(content, "new messages"))
+	assert.Less(t, strings.Index(content, "read two"), strings.Index(content, "new messages"))
+	assert.Less(t, strings.Index(content, "new messages"), strings.Index(content, "fresh"))
+	assert.Equal(t, 2, strings.Count(content, "Alice"), "the separator breaks message grouping")
+
+	for _, marker := range []int64{0, 9, 12} {
+		v = testChatWithLines(lines)
+		v.readMarker = marker
+		v.renderMessages()
+		assert.NotContains(t, ansi.Strip(v.viewport.View()), "new messages",
+			"read marker %d: no separator without both read and new lines loaded", marker)
+	}
+}
+
+func TestChat_MarkReadSavesNewestLine(t *testing.T) {
+	store := chatread.NewStore(t.TempDir())
+	v := testChatWithLines([]workspace.ChatLineInfo{{ID: 5}, {ID: 8}})
+	v.session = workspace.NewTestSessionWithChatReads(store)
+	v.chatID = 42
+	v.updateLastID()
+
+	cmd := v.markRead()
+	require.NotNil(t, cmd)
+	cmd()
+	assert.Equal(t, int64(8), store.LastRead("", 42))
+
+	assert.Nil(t, v.markRead(), "nothing newer to save")
+}
+
 func testChat() *Chat {
 	styles := tui.NewStyles()
 	comp := widget.NewComposer(styles, widget.WithMode(widget.ComposerQuick))
@@ -165,15 +165,23 @@ func (v *Checkins) FullHelp() [][]key.Binding {
 	return [][]key.Binding{v.ShortHelp()}
 }
 
-// StartFilter implements workspace.Filterable.
-func (v *Checkins) StartFilter() {
+// filterList returns the focused pane's list, which / filters.
+func (v *Checkins) filterList() *widget.List {
 	if v.focus == checkinsPaneLeft {
-		v.listQuestions.StartFilter()
-	} else {
-		v.listAnswers.StartFilter()
+		return v.listQuestions
 	}
+	return v.listAnswers
 }
 
+// StartFilter implements workspace.Filterable.
+func (v *Checkins) StartFilter() { v.filterList().StartFilter() }
+
+// FilterState implements workspace.FilterReporter.
+func (v *Checkins) FilterState() workspace.FilterState { return v.filterList().FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Checkins) ClearFilter() { v.filterList().StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Checkins) InputActive() bool {
 	return v.listQuestions.Filtering() || v.listAnswers.Filtering() || v.answering
@@ -12,6 +12,7 @@ import (
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/spinner"
 	"charm.land/bubbles/v2/textinput"
+	"charm.land/bubbles/v2/viewport"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
 
@@ -55,6 +56,7 @@ type detailData struct {
 	boostDetails []detailBoost
 	subscribed   bool
 	appURL       string
+	apiURL       string // the recording's API URL, for the raw JSON overlay
 }
 
 // detailLoadedMsg is sent when the recording detail is fetched.
@@ -112,9 +114,12 @@ type Detail struct {
 	editing   bool
 	editInput textinput.Model
 
-	// Body editing (messages)
+	// Body editing (messages and documents)
 	editingBody      bool
 	bodyEditComposer *widget.Composer
+	bodyOriginal     string
+	bodyDiff         *widget.Diff
+	showingBodyDiff  bool
 
 	// Due date / assign inline inputs
 	settingDue  bool
@@ -131,6 +136,19 @@ type Detail struct {
 	commentEditComposer *widget.Composer
 	commentTrashPending bool
 
+	// Split comments pane (C): comments scroll below the body on their own,
+	// and tab moves scrolling between the two.
+	splitComments   bool
+	commentsFocused bool
+	commentsPane    *widget.Content
+
+	// Raw JSON overlay (J)
+	showingRaw bool
+	rawLoading bool
+	rawPath    string
+	rawText    string
+	rawView    viewport.Model
+
 	width, height int
 }
 
@@ -173,6 +191,7 @@ func NewDetail(session *workspace.Session, recordingID int64, recordingType, ori
 		originView:     originView,
 		originHint:     originHint,
 		preview:        widget.NewPreview(styles),
+		commentsPane:   widget.NewContent(styles),
 		spinner:        s,
 		loading:        true,
 		composer:       comp,
@@ -187,18 +206,51 @@ func (v *Detail) Title() string {
 	return "Detail"
 }
 
+// Export implements workspace.Exporter.
+func (v *Detail) Export() (workspace.ViewExport, error) {
+	if v.data == nil {
+		return workspace.ViewExport{}, errNothingToExport
+	}
+	name := fmt.Sprintf("recording-%d", v.recordingID)
+	return workspace.ViewExport{Name: name, Format: "md", Content: []byte(detailMarkdown(v.data))}, nil
+}
+
 // InputActive implements workspace.InputCapturer.
 func (v *Detail) InputActive() bool {
 	return v.composing || v.editing || v.editingComment || v.editingBody || v.settingDue || v.assigning
 }
 
+// HasSplitPane implements workspace.SplitPaneFocuser.
+func (v *Detail) HasSplitPane() bool {
+	return v.showsCommentsPane()
+}
+
+// showsCommentsPane reports whether comments render in their own pane.
+func (v *Detail) showsCommentsPane() bool {
+	return v.splitComments && v.data != nil && len(v.data.comments) > 0
+}
+
 // IsModal implements workspace.ModalActive.
 func (v *Detail) IsModal() bool {
-	return v.composing || v.editing || v.editingComment || v.editingBody || v.settingDue || v.assigning
+	return v.composing || v.editing || v.editingComment || v.editingBody || v.settingDue || v.assigning || v.showingRaw
 }
 
 func (v *Detail) ShortHelp() []key.Binding {
-	if v.editingComment || v.editingBody {
+	if v.showingRaw {
+		return v.rawShortHelp()
+	}
+	if v.editingBody {
+		diffHelp := "diff"
+		if v.showingBodyDiff {
+			diffHelp = "preview"
+		}
+		return []key.Binding{
+			key.NewBinding(key.WithKeys("ctrl+enter"), key.WithHelp("ctrl+enter", "save")),
+			key.NewBinding(key.WithKeys("ctrl+d"), key.WithHelp("ctrl+d", diffHelp)),
+			key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+		}
+	}
+	if v.editingComment {
 		return []key.Binding{
 			key.NewBinding(key.WithKeys("ctrl+enter"), key.WithHelp("ctrl+enter", "save")),
 			key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
@@ -242,7 +294,7 @@ func (v *Detail) ShortHelp() []key.Binding {
 		if rt == "todo" || rt == "card" {
 			hints = append(hints, key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit title")))
 		}
-		if rt == "message" {
+		if rt == "message" || rt == "document" {
 			hints = append(hints, key.NewBinding(key.WithKeys("e"), key.WithHelp("e", "edit body")))
 		}
 	}
@@ -258,10 +310,21 @@ func (v *Detail) ShortHelp() []key.Binding {
 			key.NewBinding(key.WithKeys("E"), key.WithHelp("E", "edit comment")),
 			key.NewBinding(key.WithKeys("T"), key.WithHelp("T", "trash comment")),
 		)
+		splitHelp := "split comments"
+		if v.splitComments {
+			splitHelp = "inline comments"
+		}
+		hints = append(hints, key.NewBinding(key.WithKeys("C"), key.WithHelp("C", splitHelp)))
+		if v.splitComments {
+			hints = append(hints, key.NewBinding(key.WithKeys("tab"), key.WithHelp("tab", "switch pane")))
+		}
 	}
 	if v.session != nil && v.session.Scope().ProjectID != 0 {
 		hints = append(hints, key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "project")))
 	}
+	if v.data != nil {
+		hints = append(hints, key.NewBinding(key.WithKeys("J"), key.WithHelp("J", "raw JSON")))
+	}
 	if v.composing {
 		hints = append(hints,
 			key.NewBinding(key.WithKeys("ctrl+enter"), key.WithHelp("ctrl+enter", "post comment")),
@@ -286,6 +349,11 @@ func (v *Detail) SetSize(w, h int) {
 }
 
 func (v *Detail) relayout() {
+	if v.showingRaw {
+		v.rawView.SetWidth(max(0, v.width-2))
+		v.rawView.SetHeight(max(1, v.height-1)) // -1 for the title rule
+		return
+	}
 	if v.composing {
 		composerHeight := 6
 		previewHeight := v.height - composerHeight - 1 // -1 for separator
@@ -310,13 +378,34 @@ func (v *Detail) relayout() {
 		}
 		v.preview.SetSize(max(0, v.width-2), previewHeight)
 		v.bodyEditComposer.SetSize(max(0, v.width-2), composerHeight)
+		if v.bodyDiff != nil {
+			v.bodyDiff.SetSize(max(0, v.width-2), previewHeight)
+		}
 	} else {
-		inputLines := 0
-		if v.editing || v.settingDue || v.assigning {
-			inputLines = 1
+		available := v.height - v.inputLines()
+		if v.showsCommentsPane() {
+			bodyHeight, commentsHeight := splitCommentsHeights(available)
+			v.preview.SetSize(max(0, v.width-2), bodyHeight)
+			v.commentsPane.SetSize(max(0, v.width-2), commentsHeight)
+			return
 		}
-		v.preview.SetSize(max(0, v.width-2), max(1, v.height-inputLines))
+		v.preview.SetSize(max(0, v.width-2), max(1, available))
+	}
+}
+
+// inputLines is the height of the open inline input, if any.
+func (v *Detail) inputLines() int {
+	if v.editing || v.settingDue || v.assigning {
+		return 1
 	}
+	return 0
+}
+
+// splitCommentsHeights divides height between the body and the comments
+// pane, leaving a line for the separator between them.
+func splitCommentsHeights(height int) (body, comments int) {
+	body = max(3, (height-1)/2)
+	return body, max(1, height-1-body)
 }
 
 func (v *Detail) Init() tea.Cmd {
@@ -334,6 +423,20 @@ func (v *Detail) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		v.syncPreview()
 		return v, nil
 
+	case detailRawLoadedMsg:
+		if !v.showingRaw {
+			return v, nil
+		}
+		v.rawLoading = false
+		if msg.err != nil {
+			v.closeRawJSON()
+			return v, workspace.ReportError(msg.err, "loading raw JSON")
+		}
+		v.rawPath = msg.path
+		v.rawText = msg.text
+		v.rawView.SetContent(msg.text)
+		return v, nil
+
 	case workspace.CommentCreatedMsg:
 		v.submitting = false
 		if msg.Err != nil {
@@ -356,15 +459,10 @@ func (v *Detail) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		}
 		if v.editingBody {
 			content := strings.TrimSpace(msg.Content.Markdown)
+			v.stopEditBody()
 			if content == "" {
-				v.editingBody = false
-				v.bodyEditComposer = nil
-				v.relayout()
 				return v, nil
 			}
-			v.editingBody = false
-			v.bodyEditComposer = nil
-			v.relayout()
 			return v, v.submitEditBody(content)
 		}
 		if v.editingComment {
@@ -450,11 +548,15 @@ func (v *Detail) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		return v, workspace.SetStatus("Title updated", false)
 
 	case editBodyResultMsg:
+		noun := "Message"
+		if v.data != nil && strings.EqualFold(v.data.recordType, "Document") {
+			noun = "Document"
+		}
 		if msg.err != nil {
-			return v, workspace.ReportError(msg.err, "editing message body")
+			return v, workspace.ReportError(msg.err, "editing "+strings.ToLower(noun)+" body")
 		}
 		v.loading = true
-		return v, tea.Batch(v.spinner.Tick, v.fetchDetail(), workspace.SetStatus("Message updated", false))
+		return v, tea.Batch(v.spinner.Tick, v.fetchDetail(), workspace.SetStatus(noun+" updated", false))
 
 	case subscribeResultMsg:
 		if msg.err != nil {
@@ -531,6 +633,7 @@ func (v *Detail) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		case v.editingBody && v.bodyEditComposer != nil:
 			text, cmd := v.bodyEditComposer.ProcessPaste(msg.Content)
 			v.bodyEditComposer.InsertPaste(text)
+			v.refreshBodyDiff()
 			return v, cmd
 		case v.composing:
 			text, cmd := v.composer.ProcessPaste(msg.Content)
@@ -551,7 +654,9 @@ func (v *Detail) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		}
 	}
 	if v.editingBody && v.bodyEditComposer != nil {
-		if cmd := v.bodyEditComposer.Update(msg); cmd != nil {
+		cmd := v.bodyEditComposer.Update(msg)
+		v.refreshBodyDiff()
+		if cmd != nil {
 			return v, cmd
 		}
 	}
@@ -560,6 +665,9 @@ func (v *Detail) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 }
 
 func (v *Detail) handleKey(msg tea.KeyPressMsg) tea.Cmd {
+	if v.showingRaw {
+		return v.handleRawKey(msg)
+	}
 	if v.editing {
 		return v.handleEditingKey(msg)
 	}
@@ -613,7 +721,7 @@ func (v *Detail) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		if rt == "todo" || rt == "card" {
 			return v.startEditTitle()
 		}
-		if rt == "message" {
+		if rt == "message" || rt == "document" {
 			return v.startEditBody()
 		}
 	case "s":
@@ -655,6 +763,12 @@ func (v *Detail) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 			workspace.SetStatus("Press t again to trash", false),
 			v.trashConfirmTimeout(),
 		)
+	case "C":
+		return v.toggleCommentsPane()
+	case "tab", "shift+tab":
+		if v.showsCommentsPane() {
+			v.commentsFocused = !v.commentsFocused
+		}
 	case "]":
 		return v.nextComment()
 	case "[":
@@ -665,18 +779,52 @@ func (v *Detail) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		return v.handleCommentTrash()
 	case "g":
 		return v.goToProject()
+	case "J":
+		return v.openRawJSON()
 	case "j", "down":
-		v.preview.ScrollDown(1)
+		v.scroll(1)
 	case "k", "up":
-		v.preview.ScrollUp(1)
+		v.scroll(-1)
 	case "ctrl+d":
-		v.preview.ScrollDown(v.height / 2)
+		v.scroll(v.height / 2)
 	case "ctrl+u":
-		v.preview.ScrollUp(v.height / 2)
+		v.scroll(-v.height / 2)
 	}
 	return nil
 }
 
+// scroll moves the focused pane by n lines (up when negative).
+func (v *Detail) scroll(n int) {
+	if v.showsCommentsPane() && v.commentsFocused {
+		if n < 0 {
+			v.commentsPane.ScrollUp(-n)
+		} else {
+			v.commentsPane.ScrollDown(n)
+		}
+		return
+	}
+	if n < 0 {
+		v.preview.ScrollUp(-n)
+	} else {
+		v.preview.ScrollDown(n)
+	}
+}
+
+// toggleCommentsPane switches comments between their own pane and the end
+// of the body.
+func (v *Detail) toggleCommentsPane() tea.Cmd {
+	if v.data == nil || len(v.data.comments) == 0 {
+		return workspace.SetStatus("No comments", false)
+	}
+	v.splitComments = !v.splitComments
+	v.commentsFocused = v.splitComments
+	v.syncPreview()
+	if v.splitComments {
+		return workspace.SetStatus("Comments in their own pane (tab switches)", false)
+	}
+	return workspace.SetStatus("Comments inline", false)
+}
+
 func (v *Detail) toggleComplete() tea.Cmd {
 	newState := !v.data.completed
 	scope := v.session.Scope()
@@ -1072,7 +1220,7 @@ func (v *Detail) submitEditTitle(title string) tea.Cmd {
 	}
 }
 
-// -- Body editing (messages) --
+// -- Body editing (messages and documents) --
 
 func (v *Detail) startEditBody() tea.Cmd {
 	if v.data == nil {
@@ -1080,16 +1228,20 @@ func (v *Detail) startEditBody() tea.Cmd {
 	}
 	// Fail closed on table-bearing content: HTMLToMarkdown has no table handling,
 	// so entering edit mode and resubmitting would strip the table. Block the edit.
+	noun := strings.ToLower(v.data.recordType)
 	if richtext.HasTableHTML(v.data.content) {
-		return workspace.SetStatus("This message contains a table — edit it on Basecamp web", true)
+		return workspace.SetStatus("This "+noun+" contains a table — edit it on Basecamp web", true)
 	}
 	v.editingBody = true
 	v.bodyEditComposer = widget.NewComposer(v.styles,
 		widget.WithMode(widget.ComposerRich),
 		widget.WithAutoExpand(false),
-		widget.WithPlaceholder("Edit message body..."),
+		widget.WithPlaceholder("Edit "+noun+" body..."),
 	)
-	v.bodyEditComposer.SetValue(richtext.HTMLToMarkdown(v.data.content))
+	v.bodyOriginal = richtext.HTMLToMarkdown(v.data.content)
+	v.bodyEditComposer.SetValue(v.bodyOriginal)
+	v.bodyDiff = widget.NewDiff(v.styles)
+	v.showingBodyDiff = false
 	v.relayout()
 	return v.bodyEditComposer.Focus()
 }
@@ -1097,27 +1249,64 @@ func (v *Detail) startEditBody() tea.Cmd {
 func (v *Detail) handleEditingBodyKey(msg tea.KeyPressMsg) tea.Cmd {
 	switch {
 	case msg.String() == "esc":
-		v.editingBody = false
-		v.bodyEditComposer = nil
-		v.relayout()
+		v.stopEditBody()
+		return nil
+	case msg.String() == "ctrl+d":
+		// Swap the rendered preview for a diff against the saved body, so a
+		// large accidental deletion is visible before it's submitted.
+		v.showingBodyDiff = !v.showingBodyDiff
+		v.refreshBodyDiff()
+		return nil
+	case v.showingBodyDiff && msg.String() == "pgdown":
+		v.bodyDiff.ScrollDown(max(1, v.height/2))
+		return nil
+	case v.showingBodyDiff && msg.String() == "pgup":
+		v.bodyDiff.ScrollUp(max(1, v.height/2))
 		return nil
 	default:
 		if v.bodyEditComposer != nil {
-			return v.bodyEditComposer.Update(msg)
+			cmd := v.bodyEditComposer.Update(msg)
+			v.refreshBodyDiff()
+			return cmd
 		}
 		return nil
 	}
 }
 
+// refreshBodyDiff recomputes the body diff from the composer, but only while
+// it is on screen.
+func (v *Detail) refreshBodyDiff() {
+	if !v.showingBodyDiff || v.bodyDiff == nil || v.bodyEditComposer == nil {
+		return
+	}
+	v.bodyDiff.SetText(v.bodyOriginal, v.bodyEditComposer.Value())
+}
+
+func (v *Detail) stopEditBody() {
+	v.editingBody = false
+	v.bodyEditComposer = nil
+	v.bodyOriginal = ""
+	v.bodyDiff = nil
+	v.showingBodyDiff = false
+	v.relayout()
+}
+
 func (v *Detail) submitEditBody(markdown string) tea.Cmd {
 	scope := v.session.Scope()
 	hub := v.session.Hub()
 	ctx := v.session.Context()
 	recordingID := v.recordingID
+	isDocument := v.data != nil && strings.EqualFold(v.data.recordType, "Document")
 	html := richtext.MarkdownToHTML(markdown)
 	return func() tea.Msg {
-		err := hub.UpdateMessage(ctx, scope.AccountID, scope.ProjectID, recordingID,
-			&basecamp.UpdateMessageRequest{Content: html})
+		var err error
+		if isDocument {
+			err = hub.UpdateDocument(ctx, scope.AccountID, scope.ProjectID, recordingID,
+				&basecamp.UpdateDocumentRequest{Content: html})
+		} else {
+			err = hub.UpdateMessage(ctx, scope.AccountID, scope.ProjectID, recordingID,
+				&basecamp.UpdateMessageRequest{Content: html})
+		}
 		return editBodyResultMsg{err: err}
 	}
 }
@@ -1197,15 +1386,23 @@ func (v *Detail) View() string {
 			Render(v.spinner.View() + " Loading detail…")
 	}
 
+	if v.showingRaw {
+		return v.rawJSONView()
+	}
+
 	if v.editingBody && v.bodyEditComposer != nil {
 		theme := v.styles.Theme()
+		top, label := v.preview.View(), "─ Edit Body ─"
+		if v.showingBodyDiff && v.bodyDiff != nil {
+			top, label = v.bodyDiff.View(), "─ Edit Body (diff) ─"
+		}
 		sep := lipgloss.NewStyle().
 			Width(max(0, v.width-2)).
 			Foreground(theme.Border).
-			Render("─ Edit Body ─")
+			Render(label)
 		return lipgloss.NewStyle().Padding(0, 1).Render(
 			lipgloss.JoinVertical(lipgloss.Left,
-				v.preview.View(),
+				top,
 				sep,
 				v.bodyEditComposer.View(),
 			),
@@ -1228,6 +1425,9 @@ func (v *Detail) View() string {
 	}
 
 	view := v.preview.View()
+	if v.showsCommentsPane() && !v.editingComment {
+		view = v.splitCommentsView()
+	}
 
 	// Inline loading/submitting indicator at bottom of existing content
 	if v.submitting {
@@ -1344,18 +1544,40 @@ func (v *Detail) syncPreview() {
 	v.preview.SetFields(fields)
 
 	body := v.data.content
-	if len(v.data.comments) > 0 {
-		body += v.buildCommentsHTML()
+	if v.showsCommentsPane() {
+		v.commentsPane.SetContent(v.buildCommentsHTML())
+	} else if len(v.data.comments) > 0 {
+		body += "<hr><h3>Comments</h3>" + v.buildCommentsHTML()
 	}
 	v.preview.SetBody(body)
+	// Fields and the comments split both change how the height divides.
+	v.relayout()
+}
+
+// splitCommentsView stacks the body over the comments pane. The separator
+// is highlighted on the side that scrolls.
+func (v *Detail) splitCommentsView() string {
+	theme := v.styles.Theme()
+	bodyHeight, _ := splitCommentsHeights(v.height - v.inputLines())
+	label := fmt.Sprintf("─ Comments (%d) ─", len(v.data.comments))
+	color := theme.Border
+	if v.commentsFocused {
+		label = fmt.Sprintf("─ Comments (%d) · scrolling ─", len(v.data.comments))
+		color = theme.Primary
+	}
+	sep := lipgloss.NewStyle().
+		Width(max(0, v.width-2)).
+		Foreground(color).
+		Render(label)
+	body := lipgloss.NewStyle().Height(bodyHeight).MaxHeight(bodyHeight).Render(v.preview.View())
+	return lipgloss.JoinVertical(lipgloss.Left, body, sep, v.commentsPane.View())
 }
 
-// buildCommentsHTML renders comments as HTML to be appended to the body content.
-// The combined HTML flows through the Content widget's HTML→Markdown→glamour pipeline,
-// so everything scrolls together as a single document.
+// buildCommentsHTML renders comments as HTML, either appended to the body
+// content or in the comments pane. It flows through the Content widget's
+// HTML→Markdown→glamour pipeline.
 func (v *Detail) buildCommentsHTML() string {
 	var b strings.Builder
-	b.WriteString("<hr><h3>Comments</h3>")
 	for _, c := range v.data.comments {
 		b.WriteString("<p><strong>")
 		b.WriteString(html.EscapeString(c.creator))
@@ -1404,6 +1626,7 @@ func (v *Detail) fetchDetail() tea.Cmd {
 				dueOn:      todo.DueOn,
 				boosts:     todo.BoostsCount,
 				appURL:     todo.AppURL,
+				apiURL:     todo.URL,
 			}
 
 		case "message", "Message":
@@ -1428,6 +1651,7 @@ func (v *Detail) fetchDetail() tea.Cmd {
 				category:   category,
 				boosts:     msg.BoostsCount,
 				appURL:     msg.AppURL,
+				apiURL:     msg.URL,
 			}
 
 		case "card", "Card":
@@ -1454,6 +1678,7 @@ func (v *Detail) fetchDetail() tea.Cmd {
 				dueOn:      card.DueOn,
 				boosts:     card.BoostsCount,
 				appURL:     card.AppURL,
+				apiURL:     card.URL,
 			}
 
 		default:
@@ -1471,6 +1696,7 @@ func (v *Detail) fetchDetail() tea.Cmd {
 				Content   string    `json:"content"`
 				Type      string    `json:"type"`
 				AppURL    string    `json:"app_url"`
+				URL       string    `json:"url"`
 				CreatedAt time.Time `json:"created_at"`
 				Creator   *struct {
 					Name string `json:"name"`
@@ -1500,6 +1726,7 @@ func (v *Detail) fetchDetail() tea.Cmd {
 					creator:    creator,
 					createdAt:  generic.CreatedAt,
 					appURL:     generic.AppURL,
+					apiURL:     generic.URL,
 				}
 			}
 		}
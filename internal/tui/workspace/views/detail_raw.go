@@ -0,0 +1,119 @@
+package views
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/viewport"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+)
+
+// detailRawLoadedMsg carries the recording's API response for the raw JSON
+// overlay.
+type detailRawLoadedMsg struct {
+	path string
+	text string // pretty-printed
+	err  error
+}
+
+// openRawJSON shows the raw JSON overlay and fetches the recording as the
+// API returns it, for checking how fields map onto the view.
+func (v *Detail) openRawJSON() tea.Cmd {
+	if v.data == nil || v.session == nil {
+		return nil
+	}
+	v.showingRaw = true
+	v.rawLoading = true
+	v.rawPath = ""
+	v.rawText = ""
+	v.rawView = viewport.New()
+	v.relayout()
+
+	path := v.data.apiURL
+	if path == "" {
+		path = fmt.Sprintf("/buckets/%d/recordings/%d.json", v.session.Scope().ProjectID, v.recordingID)
+	}
+	session := v.session
+	return func() tea.Msg {
+		resp, err := session.AccountClient().Get(session.Hub().ProjectContext(), path)
+		if err != nil {
+			return detailRawLoadedMsg{path: path, err: err}
+		}
+		var buf bytes.Buffer
+		if err := json.Indent(&buf, resp.Data, "", "  "); err != nil {
+			return detailRawLoadedMsg{path: path, text: string(resp.Data)}
+		}
+		return detailRawLoadedMsg{path: path, text: buf.String()}
+	}
+}
+
+func (v *Detail) closeRawJSON() {
+	v.showingRaw = false
+	v.rawLoading = false
+	v.rawText = ""
+	v.relayout()
+}
+
+// handleRawKey scrolls, copies, or closes the raw JSON overlay.
+func (v *Detail) handleRawKey(msg tea.KeyPressMsg) tea.Cmd {
+	switch msg.String() {
+	case "esc", "J":
+		v.closeRawJSON()
+	case "y":
+		if v.rawText == "" {
+			return nil
+		}
+		return tea.Batch(
+			tea.SetClipboard(v.rawText),
+			workspace.SetStatus("Copied JSON to clipboard", false),
+		)
+	case "j", "down":
+		v.rawView.ScrollDown(1)
+	case "k", "up":
+		v.rawView.ScrollUp(1)
+	case "ctrl+d":
+		v.rawView.HalfPageDown()
+	case "ctrl+u":
+		v.rawView.HalfPageUp()
+	case "g":
+		v.rawView.GotoTop()
+	case "G":
+		v.rawView.GotoBottom()
+	}
+	return nil
+}
+
+func (v *Detail) rawShortHelp() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("j/k"), key.WithHelp("j/k", "scroll")),
+		key.NewBinding(key.WithKeys("y"), key.WithHelp("y", "copy JSON")),
+		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "close")),
+	}
+}
+
+// rawJSONView renders the overlay: a title rule over the scrollable JSON.
+func (v *Detail) rawJSONView() string {
+	theme := v.styles.Theme()
+	label := "─ Raw JSON ─"
+	if v.rawPath != "" {
+		label = fmt.Sprintf("─ Raw JSON: %s ─", v.rawPath)
+	}
+	header := lipgloss.NewStyle().
+		Width(max(0, v.width-2)).
+		MaxWidth(max(0, v.width-2)).
+		Foreground(theme.Border).
+		Render(label)
+
+	body := v.rawView.View()
+	if v.rawLoading {
+		body = lipgloss.NewStyle().Foreground(theme.Muted).Render(v.spinner.View() + " Loading JSON…")
+	}
+	return lipgloss.NewStyle().Padding(0, 1).Render(
+		lipgloss.JoinVertical(lipgloss.Left, header, body),
+	)
+}
@@ -1,6 +1,8 @@
 package views
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
@@ -25,6 +27,7 @@ func testDetail(originView, originHint string) *Detail {
 		originView:    originView,
 		originHint:    originHint,
 		preview:       widget.NewPreview(styles),
+		commentsPane:  widget.NewContent(styles),
 		data: &detailData{
 			title:      "Test Todo",
 			recordType: "Todo",
@@ -45,6 +48,7 @@ func testDetailWithSession(recordType string, completed bool) *Detail {
 		recordingID:   100,
 		recordingType: recordType,
 		preview:       widget.NewPreview(styles),
+		commentsPane:  widget.NewContent(styles),
 		data: &detailData{
 			title:      "Test " + recordType,
 			recordType: recordType,
@@ -553,6 +557,60 @@ func TestDetail_CommentFocus_Navigation(t *testing.T) {
 	assert.Equal(t, -1, v.focusedComment, "should unfocus when going past first")
 }
 
+func TestDetail_CommentsPane_Toggle(t *testing.T) {
+	v := detailWithComments()
+	v.data.content = "<p>The body</p>"
+	v.SetSize(80, 30)
+	v.syncPreview()
+	assert.Contains(t, v.preview.View(), "First comment", "comments start inline")
+	assert.False(t, v.HasSplitPane())
+
+	cmd := v.handleKey(runeKey('C'))
+	require.NotNil(t, cmd)
+	assert.True(t, v.HasSplitPane())
+	assert.True(t, v.commentsFocused, "the new pane takes scrolling")
+	assert.NotContains(t, v.preview.View(), "First comment")
+	assert.Contains(t, v.commentsPane.View(), "First comment")
+	assert.Contains(t, v.View(), "Comments (2)")
+
+	v.handleKey(runeKey('C'))
+	assert.False(t, v.HasSplitPane())
+	assert.Contains(t, v.preview.View(), "First comment")
+}
+
+func TestDetail_CommentsPane_ScrollsIndependently(t *testing.T) {
+	v := detailWithComments()
+	var body, comments strings.Builder
+	for i := range 40 {
+		fmt.Fprintf(&body, "<p>body line %d</p>", i)
+		fmt.Fprintf(&comments, "<p>reply line %d</p>", i)
+	}
+	v.data.content = body.String()
+	v.data.comments[1].content = comments.String()
+	v.SetSize(80, 30)
+	v.handleKey(runeKey('C'))
+
+	bodyView, commentsView := v.preview.View(), v.commentsPane.View()
+	v.handleKey(runeKey('j'))
+	assert.Equal(t, bodyView, v.preview.View(), "body stays put while comments scroll")
+	assert.NotEqual(t, commentsView, v.commentsPane.View())
+
+	v.handleKey(tea.KeyPressMsg{Code: tea.KeyTab})
+	assert.False(t, v.commentsFocused)
+	commentsView = v.commentsPane.View()
+	v.handleKey(runeKey('j'))
+	assert.NotEqual(t, bodyView, v.preview.View())
+	assert.Equal(t, commentsView, v.commentsPane.View())
+}
+
+func TestDetail_CommentsPane_NeedsComments(t *testing.T) {
+	v := testDetailWithSession("Todo", false)
+	cmd := v.handleKey(runeKey('C'))
+	require.NotNil(t, cmd)
+	assert.False(t, v.splitComments)
+	assert.False(t, v.HasSplitPane())
+}
+
 func TestDetail_CommentEdit_OpensInput(t *testing.T) {
 	v := detailWithComments()
 	v.focusedComment = 0
@@ -945,3 +1003,93 @@ func TestDetail_FocusMsg_NoData_ShowsSpinner(t *testing.T) {
 
 	assert.True(t, v.loading, "FocusMsg with no data should set loading to true")
 }
+
+func TestDetail_E_StartsEditBody_ForDocument(t *testing.T) {
+	v := testDetailWithSession("Document", false)
+	v.data.content = "<p>doc body</p>"
+	cmd := v.handleKey(runeKey('e'))
+	assert.NotNil(t, cmd, "e on Document should start body editing")
+	assert.True(t, v.editingBody)
+}
+
+func TestDetail_EditBody_CtrlDTogglesDiff(t *testing.T) {
+	v := testDetailWithSession("Message", false)
+	v.width, v.height = 80, 30
+	v.data.content = "<p>first</p><p>second</p>"
+	v.startEditBody()
+
+	v.bodyEditComposer.SetValue("first")
+	v.handleKey(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	require.True(t, v.showingBodyDiff)
+	view := v.View()
+	assert.Contains(t, view, "Edit Body (diff)")
+	assert.Contains(t, view, "- second", "the deleted paragraph shows in the diff")
+	_, removed := v.bodyDiff.Stats()
+	assert.Positive(t, removed)
+
+	hints := v.ShortHelp()
+	found := false
+	for _, h := range hints {
+		if h.Help().Key == "ctrl+d" {
+			found = true
+			assert.Equal(t, "preview", h.Help().Desc)
+		}
+	}
+	assert.True(t, found, "ctrl+d hint shown while editing the body")
+
+	v.handleKey(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+	assert.False(t, v.showingBodyDiff)
+	assert.NotContains(t, v.View(), "Edit Body (diff)")
+}
+
+func TestDetail_EditBody_EscClearsDiffState(t *testing.T) {
+	v := testDetailWithSession("Document", false)
+	v.data.content = "<p>body</p>"
+	v.startEditBody()
+	v.handleKey(tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl})
+
+	v.handleKey(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.False(t, v.editingBody)
+	assert.False(t, v.showingBodyDiff)
+	assert.Nil(t, v.bodyDiff)
+}
+
+func TestDetail_RawJSON_OpensAndCloses(t *testing.T) {
+	v := testDetailWithSession("Todo", false)
+	v.data.apiURL = "https://3.basecampapi.com/1/buckets/42/todos/100.json"
+	v.width, v.height = 80, 24
+
+	cmd := v.handleKey(runeKey('J'))
+	require.NotNil(t, cmd, "J should fetch the recording")
+	assert.True(t, v.showingRaw)
+	assert.True(t, v.IsModal(), "overlay should capture esc")
+
+	v.Update(detailRawLoadedMsg{path: v.data.apiURL, text: "{\n  \"id\": 100\n}"})
+	assert.False(t, v.rawLoading)
+	assert.Contains(t, v.View(), `"id": 100`)
+
+	v.handleKey(tea.KeyPressMsg{Code: tea.KeyEscape})
+	assert.False(t, v.showingRaw)
+	assert.False(t, v.IsModal())
+}
+
+func TestDetail_RawJSON_CopyNeedsContent(t *testing.T) {
+	v := testDetailWithSession("Todo", false)
+	v.width, v.height = 80, 24
+	v.handleKey(runeKey('J'))
+
+	assert.Nil(t, v.handleKey(runeKey('y')), "nothing to copy while loading")
+
+	v.Update(detailRawLoadedMsg{text: "{}"})
+	assert.NotNil(t, v.handleKey(runeKey('y')))
+}
+
+func TestDetail_RawJSON_ErrorCloses(t *testing.T) {
+	v := testDetailWithSession("Todo", false)
+	v.width, v.height = 80, 24
+	v.handleKey(runeKey('J'))
+
+	_, cmd := v.Update(detailRawLoadedMsg{err: assert.AnError})
+	assert.NotNil(t, cmd)
+	assert.False(t, v.showingRaw)
+}
@@ -3,6 +3,7 @@ package views
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"charm.land/bubbles/v2/key"
 	"charm.land/bubbles/v2/spinner"
@@ -25,6 +26,7 @@ type dockKeyMap struct {
 	Cards    key.Binding
 	Schedule key.Binding
 	Activity key.Binding
+	Mute     key.Binding
 }
 
 func defaultDockKeyMap() dockKeyMap {
@@ -53,10 +55,15 @@ func defaultDockKeyMap() dockKeyMap {
 			key.WithKeys("a"),
 			key.WithHelp("a", "activity"),
 		),
+		Mute: key.NewBinding(
+			key.WithKeys("M"),
+			key.WithHelp("M", "mute tool"),
+		),
 	}
 }
 
-// Dock shows a project's tool grid with peek previews.
+// Dock shows a project's tool grid with peek previews and per-tool badges
+// for updates since each tool was last opened.
 type Dock struct {
 	session *workspace.Session
 	styles  *tui.Styles
@@ -67,6 +74,11 @@ type Dock struct {
 	loading     bool
 	keys        dockKeyMap
 
+	// Project timeline feeding the unread badges (nil without a Hub).
+	timeline *data.Pool[[]data.TimelineEventInfo]
+	unread   map[string]int
+	pollGen  uint64
+
 	width, height int
 }
 
@@ -89,6 +101,9 @@ func NewDock(session *workspace.Session, projectID int64) *Dock {
 		spinner: s,
 		keys:    defaultDockKeyMap(),
 	}
+	if hub := session.Hub(); hub != nil {
+		v.timeline = hub.ProjectTimeline(projectID)
+	}
 
 	// Try to find project in the Hub's Projects pool
 	snap := session.Hub().Projects().Get()
@@ -116,6 +131,11 @@ func (v *Dock) Title() string {
 	return "Project"
 }
 
+// Export implements workspace.Exporter.
+func (v *Dock) Export() (workspace.ViewExport, error) {
+	return exportItems("dock", v.list)
+}
+
 // ShortHelp implements View.
 func (v *Dock) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
@@ -136,12 +156,19 @@ func (v *Dock) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{v.keys.Todos, v.keys.Chat, v.keys.Messages},
 		{v.keys.Cards, v.keys.Schedule, v.keys.Activity},
+		{v.keys.Mute},
 	}
 }
 
 // StartFilter implements workspace.Filterable.
 func (v *Dock) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Dock) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Dock) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Dock) InputActive() bool { return v.list.Filtering() }
 
@@ -166,10 +193,11 @@ func (v *Dock) Init() tea.Cmd {
 		}
 	}
 
+	cmds := []tea.Cmd{v.fetchTimeline(false), v.schedulePoll()}
 	if v.loading {
-		return tea.Batch(v.spinner.Tick, v.fetchProject())
+		cmds = append(cmds, v.spinner.Tick, v.fetchProject())
 	}
-	return nil
+	return tea.Batch(cmds...)
 }
 
 // Update implements tea.Model.
@@ -198,6 +226,7 @@ func (v *Dock) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 			Dock:        dock,
 		}
 		v.syncTools()
+		v.syncUnread()
 		// Record project visit in recents (cold-load path)
 		if r := v.session.Recents(); r != nil {
 			r.Add(recents.Item{
@@ -211,7 +240,24 @@ func (v *Dock) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 
 	case workspace.RefreshMsg:
 		v.loading = true
-		return v, tea.Batch(v.spinner.Tick, v.fetchProject())
+		return v, tea.Batch(v.spinner.Tick, v.fetchProject(), v.fetchTimeline(true))
+
+	case data.PoolUpdatedMsg:
+		if v.timeline != nil && msg.Key == v.timeline.Key() {
+			v.syncUnread()
+		}
+		return v, nil
+
+	case data.PollMsg:
+		if v.timeline != nil && msg.Tag == v.timeline.Key() && msg.Gen == v.pollGen {
+			return v, tea.Batch(v.fetchTimeline(false), v.schedulePoll())
+		}
+		return v, nil
+
+	case workspace.FocusMsg:
+		// Returning from a tool: its visit cleared the badge.
+		v.syncUnread()
+		return v, v.fetchTimeline(false)
 
 	case spinner.TickMsg:
 		if v.loading {
@@ -250,6 +296,8 @@ func (v *Dock) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 	case key.Matches(msg, dk.Activity):
 		scope := v.session.Scope()
 		return workspace.Navigate(workspace.ViewTimeline, scope)
+	case key.Matches(msg, dk.Mute):
+		return v.toggleMute()
 	case key.Matches(msg, listKeys.Open):
 		return v.openSelectedTool()
 	default:
@@ -288,12 +336,101 @@ func (v *Dock) syncTools() {
 			ID:          fmt.Sprintf("%d", tool.ID),
 			Title:       title,
 			Description: dockToolDisplayName(tool.Name),
-			Extra:       toolHotkey(tool.Name),
+			Extra:       v.toolExtra(tool.Name),
 		})
 	}
 	v.list.SetItems(items)
 }
 
+// toolExtra renders a tool's mute state or unread badge ahead of its hotkey.
+func (v *Dock) toolExtra(toolName string) string {
+	var parts []string
+	if v.session.Mutes().Muted(v.projectInfo.ID, toolName) {
+		parts = append(parts, "muted")
+	} else if n := v.unread[toolName]; n > 0 {
+		parts = append(parts, fmt.Sprintf("●%d", n))
+	}
+	if hk := toolHotkey(toolName); hk != "" {
+		parts = append(parts, hk)
+	}
+	return strings.Join(parts, "  ")
+}
+
+// syncUnread recounts the unread badges from the project timeline.
+func (v *Dock) syncUnread() {
+	if v.timeline == nil || v.projectInfo == nil {
+		return
+	}
+	snap := v.timeline.Get()
+	if !snap.Usable() {
+		return
+	}
+	v.unread = data.UnreadByTool(v.projectInfo.ID, snap.Data, v.session.ToolVisits(), v.session.Mutes())
+	v.syncTools()
+}
+
+// visitTool clears a tool's unread badge as it is opened.
+func (v *Dock) visitTool(toolName string) {
+	if v.projectInfo == nil {
+		return
+	}
+	v.session.ToolVisits().Visit(v.projectInfo.ID, toolName, time.Now())
+	delete(v.unread, toolName)
+	v.syncTools()
+}
+
+// toggleMute mutes or unmutes the selected tool and saves the choice.
+func (v *Dock) toggleMute() tea.Cmd {
+	item := v.list.Selected()
+	if item == nil || v.projectInfo == nil {
+		return nil
+	}
+	var toolID int64
+	fmt.Sscanf(item.ID, "%d", &toolID)
+	for _, tool := range v.projectInfo.Dock {
+		if tool.ID != toolID {
+			continue
+		}
+		muted := v.session.Mutes().Toggle(v.projectInfo.ID, tool.Name)
+		v.syncUnread()
+		v.syncTools()
+		status := "Unmuted " + item.Title
+		if muted {
+			status = "Muted " + item.Title
+		}
+		return tea.Batch(persistMutes(v.session), workspace.SetStatus(status, false))
+	}
+	return nil
+}
+
+func (v *Dock) fetchTimeline(force bool) tea.Cmd {
+	if v.timeline == nil {
+		return nil
+	}
+	ctx := v.session.Hub().ProjectContext()
+	if force {
+		v.timeline.Invalidate()
+		return v.timeline.Fetch(ctx)
+	}
+	return v.timeline.FetchIfStale(ctx)
+}
+
+func (v *Dock) schedulePoll() tea.Cmd {
+	if v.timeline == nil {
+		return nil
+	}
+	interval := v.timeline.PollInterval()
+	if interval == 0 {
+		return nil
+	}
+	v.pollGen++
+	gen := v.pollGen
+	key := v.timeline.Key()
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return data.PollMsg{Tag: key, Gen: gen}
+	})
+}
+
 func (v *Dock) navigateToTool(toolName string, target workspace.ViewTarget) tea.Cmd {
 	if v.projectInfo == nil {
 		return nil
@@ -301,6 +438,7 @@ func (v *Dock) navigateToTool(toolName string, target workspace.ViewTarget) tea.
 
 	for _, tool := range v.projectInfo.Dock {
 		if tool.Name == toolName && tool.Enabled {
+			v.visitTool(tool.Name)
 			scope := v.session.Scope()
 			scope.ToolType = toolName
 			scope.ToolID = tool.ID
@@ -324,6 +462,7 @@ func (v *Dock) openSelectedTool() tea.Cmd {
 	for _, tool := range v.projectInfo.Dock {
 		if tool.ID == toolID {
 			if target, ok := toolNameToView(tool.Name); ok {
+				v.visitTool(tool.Name)
 				scope := v.session.Scope()
 				scope.ToolType = tool.Name
 				scope.ToolID = tool.ID
@@ -12,6 +12,7 @@ import (
 	"github.com/basecamp/basecamp-cli/internal/tui"
 	"github.com/basecamp/basecamp-cli/internal/tui/recents"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/data"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
 )
 
@@ -110,3 +111,39 @@ func TestDock_ColdLoad_RecordsRecents(t *testing.T) {
 	assert.Equal(t, "Test Project", items[0].Title)
 	assert.Equal(t, recents.TypeProject, items[0].Type)
 }
+
+func TestDock_UnreadBadgesAndMute(t *testing.T) {
+	v := testDockView()
+	v.projectInfo = &data.ProjectInfo{ID: 5, Dock: []data.DockToolInfo{
+		{ID: 10, Name: "todoset", Title: "Todos", Enabled: true},
+		{ID: 11, Name: "chat", Title: "Chat", Enabled: true},
+	}}
+	v.unread = map[string]int{"chat": 3}
+	v.syncTools()
+
+	items := v.list.Items()
+	require.Len(t, items, 2)
+	assert.Equal(t, "t", items[0].Extra, "no badge without unread updates")
+	assert.Equal(t, "●3  c", items[1].Extra)
+
+	require.True(t, v.list.SelectByID("11"))
+	cmd := v.handleKey(tea.KeyPressMsg{Code: 'M', Text: "M"})
+	require.NotNil(t, cmd)
+	assert.Equal(t, []string{"5/chat"}, v.session.Mutes().Entries())
+	assert.Equal(t, "muted  c", v.list.Items()[1].Extra)
+}
+
+func TestDock_OpeningToolClearsBadge(t *testing.T) {
+	v := testDockView()
+	v.projectInfo = &data.ProjectInfo{ID: 5, Dock: []data.DockToolInfo{
+		{ID: 11, Name: "chat", Title: "Chat", Enabled: true},
+	}}
+	v.unread = map[string]int{"chat": 2}
+	v.syncTools()
+
+	cmd := v.handleKey(tea.KeyPressMsg{Code: 'c', Text: "c"})
+	require.NotNil(t, cmd)
+	assert.Zero(t, v.unread["chat"])
+	assert.Equal(t, "c", v.list.Items()[0].Extra)
+	assert.False(t, v.session.ToolVisits().Since(5, "chat").IsZero())
+}
@@ -2,6 +2,7 @@ package views
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"charm.land/bubbles/v2/textinput"
 	tea "charm.land/bubbletea/v2"
 	"charm.land/lipgloss/v2"
+	"github.com/basecamp/basecamp-sdk/go/pkg/basecamp"
 
 	"github.com/basecamp/basecamp-cli/internal/tui"
 	"github.com/basecamp/basecamp-cli/internal/tui/empty"
@@ -41,6 +43,17 @@ type docsFilesTrashResultMsg struct {
 // docsFilesTrashTimeoutMsg resets the double-press trash confirmation.
 type docsFilesTrashTimeoutMsg struct{}
 
+// docsFilesPreviewMsg carries the preview pane content for a document or upload.
+type docsFilesPreviewMsg struct {
+	itemID      int64
+	title       string
+	fields      []widget.PreviewField
+	body        string // HTML
+	appURL      string
+	downloadURL string // uploads only
+	err         error
+}
+
 // folderEntry tracks the state needed to restore cursor when navigating back.
 type folderEntry struct {
 	vaultID      int64
@@ -49,18 +62,26 @@ type folderEntry struct {
 	cursorItemID string
 }
 
-// DocsFiles is the list view for a project's vault (Docs & Files).
+// DocsFiles is the split-pane view for a project's vault (Docs & Files):
+// folder contents on the left, a preview of the selected item on the right.
 type DocsFiles struct {
 	session *workspace.Session
 	pool    *data.Pool[[]data.DocsFilesItemInfo]
 	styles  *tui.Styles
 
 	// Layout
+	split         *widget.SplitPane
 	list          *widget.List
+	preview       *widget.Preview
 	spinner       spinner.Model
 	loading       bool
 	width, height int
 
+	// Preview
+	selectedID    int64
+	fetching      int64 // item ID currently being fetched for preview (0 = none)
+	cachedPreview map[int64]*docsFilesPreviewMsg
+
 	// Data
 	items []data.DocsFilesItemInfo
 
@@ -97,9 +118,12 @@ func NewDocsFiles(session *workspace.Session) *DocsFiles {
 		session:        session,
 		pool:           pool,
 		styles:         styles,
+		split:          widget.NewSplitPane(styles, 0.4),
 		list:           list,
+		preview:        widget.NewPreview(styles),
 		spinner:        s,
 		loading:        true,
+		cachedPreview:  make(map[int64]*docsFilesPreviewMsg),
 		currentVaultID: scope.ToolID,
 		currentTitle:   "Docs & Files",
 	}
@@ -110,12 +134,37 @@ func (v *DocsFiles) Title() string {
 	return v.currentTitle
 }
 
+// Export implements workspace.Exporter.
+func (v *DocsFiles) Export() (workspace.ViewExport, error) {
+	return exportItems("docs-files", v.list)
+}
+
 // IsModal implements workspace.ModalActive.
 // Modal when inside a sub-folder (Esc should pop the folder, not navigate back).
 func (v *DocsFiles) IsModal() bool {
 	return len(v.folderStack) > 0
 }
 
+// FocusedItem implements workspace.FocusedRecording.
+func (v *DocsFiles) FocusedItem() workspace.FocusedItemScope {
+	item := v.list.Selected()
+	if item == nil {
+		return workspace.FocusedItemScope{}
+	}
+	id, _ := strconv.ParseInt(item.ID, 10, 64)
+	return workspace.FocusedItemScope{RecordingID: id}
+}
+
+// FocusedURL implements workspace.FocusedURLOpener. For a previewed upload
+// it returns the download URL so `o` fetches the file itself rather than
+// opening its Basecamp page.
+func (v *DocsFiles) FocusedURL() string {
+	if p, ok := v.cachedPreview[v.selectedID]; ok {
+		return p.downloadURL
+	}
+	return ""
+}
+
 // ShortHelp implements View.
 func (v *DocsFiles) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
@@ -130,6 +179,7 @@ func (v *DocsFiles) ShortHelp() []key.Binding {
 	hints := []key.Binding{
 		key.NewBinding(key.WithKeys("j/k"), key.WithHelp("j/k", "navigate")),
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
+		key.NewBinding(key.WithKeys("o"), key.WithHelp("o", "open/download")),
 		key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "new doc")),
 		key.NewBinding(key.WithKeys("N"), key.WithHelp("N", "new folder")),
 		key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "trash")),
@@ -145,6 +195,12 @@ func (v *DocsFiles) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *DocsFiles) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *DocsFiles) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *DocsFiles) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *DocsFiles) InputActive() bool {
 	return v.list.Filtering() || v.creatingDoc || v.creatingFolder
@@ -154,7 +210,9 @@ func (v *DocsFiles) InputActive() bool {
 func (v *DocsFiles) SetSize(w, h int) {
 	v.width = w
 	v.height = h
-	v.list.SetSize(w, h)
+	v.split.SetSize(w, h)
+	v.list.SetSize(v.split.LeftWidth(), h)
+	v.preview.SetSize(v.split.RightWidth(), h)
 }
 
 // Init implements tea.Model.
@@ -165,7 +223,7 @@ func (v *DocsFiles) Init() tea.Cmd {
 		v.syncList()
 		v.loading = false
 		if snap.Fresh() {
-			return nil
+			return v.previewSelected()
 		}
 	}
 	return tea.Batch(v.spinner.Tick, v.pool.FetchIfStale(v.session.Hub().ProjectContext()))
@@ -192,6 +250,24 @@ func (v *DocsFiles) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 			if snap.Loading() && !snap.HasData {
 				v.loading = true
 			}
+			if !v.loading {
+				return v, v.previewSelected()
+			}
+		}
+		return v, nil
+
+	case docsFilesPreviewMsg:
+		if msg.err != nil {
+			if msg.itemID == v.fetching {
+				v.fetching = 0
+			}
+			return v, workspace.ReportError(msg.err, "loading preview")
+		}
+		v.cachedPreview[msg.itemID] = &msg
+		// Only update preview if this is still the selected item
+		if msg.itemID == v.selectedID {
+			v.fetching = 0
+			v.showPreview(&msg)
 		}
 		return v, nil
 
@@ -245,10 +321,12 @@ func (v *DocsFiles) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 	case workspace.RefreshMsg:
 		v.pool.Invalidate()
 		v.loading = true
+		v.selectedID = 0
+		v.cachedPreview = make(map[int64]*docsFilesPreviewMsg)
 		return v, tea.Batch(v.spinner.Tick, v.pool.Fetch(v.session.Hub().ProjectContext()))
 
 	case spinner.TickMsg:
-		if v.loading {
+		if v.loading || v.fetching != 0 {
 			var cmd tea.Cmd
 			v.spinner, cmd = v.spinner.Update(msg)
 			return v, cmd
@@ -293,7 +371,12 @@ func (v *DocsFiles) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 	case msg.Code == tea.KeyEscape || msg.Code == tea.KeyBackspace:
 		return v.goBackFolder()
 	default:
-		return v.list.Update(msg)
+		prevIdx := v.list.SelectedIndex()
+		cmd := v.list.Update(msg)
+		if v.list.SelectedIndex() != prevIdx {
+			return tea.Batch(cmd, v.previewSelected())
+		}
+		return cmd
 	}
 }
 
@@ -356,6 +439,7 @@ func (v *DocsFiles) enterFolder(vaultID int64, title string) tea.Cmd {
 	v.currentVaultID = vaultID
 	v.currentTitle = title
 	v.pool = v.session.Hub().DocsFiles(v.session.Scope().ProjectID, vaultID)
+	v.selectedID = 0
 
 	snap := v.pool.Get()
 	if snap.Usable() {
@@ -363,10 +447,14 @@ func (v *DocsFiles) enterFolder(vaultID int64, title string) tea.Cmd {
 		v.syncList()
 		v.loading = false
 		if snap.Fresh() {
-			return func() tea.Msg { return workspace.ChromeSyncMsg{} }
+			return tea.Batch(
+				v.previewSelected(),
+				func() tea.Msg { return workspace.ChromeSyncMsg{} },
+			)
 		}
 		// Stale but usable — show cached data and refresh in background
 		return tea.Batch(
+			v.previewSelected(),
 			v.pool.FetchIfStale(v.session.Hub().ProjectContext()),
 			func() tea.Msg { return workspace.ChromeSyncMsg{} },
 		)
@@ -391,6 +479,7 @@ func (v *DocsFiles) goBackFolder() tea.Cmd {
 	v.currentVaultID = entry.vaultID
 	v.currentTitle = entry.title
 	v.pool = v.session.Hub().DocsFiles(v.session.Scope().ProjectID, entry.vaultID)
+	v.selectedID = 0
 
 	snap := v.pool.Get()
 	if snap.Usable() {
@@ -415,6 +504,8 @@ func (v *DocsFiles) goBackFolder() tea.Cmd {
 	}
 	if v.loading {
 		cmds = append(cmds, v.spinner.Tick)
+	} else {
+		cmds = append(cmds, v.previewSelected())
 	}
 	return tea.Batch(cmds...)
 }
@@ -527,6 +618,7 @@ func (v *DocsFiles) View() string {
 			Render(v.spinner.View() + " Loading docs & files…")
 	}
 
+	left := v.list.View()
 	if v.creatingDoc || v.creatingFolder {
 		label := "New document: "
 		if v.creatingFolder {
@@ -538,11 +630,23 @@ func (v *DocsFiles) View() string {
 		// Crop the list output to leave room for the input line
 		listView := lipgloss.NewStyle().
 			MaxHeight(max(1, v.height-1)).
-			Render(v.list.View())
-		return inputLine + "\n" + listView
+			Render(left)
+		left = inputLine + "\n" + listView
+	}
+
+	var right string
+	if v.fetching != 0 {
+		right = lipgloss.NewStyle().
+			Padding(0, 1).
+			Width(v.split.RightWidth()).
+			Height(v.height).
+			Render(v.spinner.View() + " Loading preview…")
+	} else {
+		right = v.preview.View()
 	}
 
-	return v.list.View()
+	v.split.SetContent(left, right)
+	return v.split.View()
 }
 
 // -- Data sync
@@ -582,3 +686,154 @@ func (v *DocsFiles) syncList() {
 	}
 	v.list.SetItems(items)
 }
+
+// -- Preview
+
+// previewSelected shows the preview for the list's current selection,
+// fetching document or upload detail when it isn't cached.
+func (v *DocsFiles) previewSelected() tea.Cmd {
+	item := v.list.Selected()
+	if item == nil {
+		v.selectedID = 0
+		v.fetching = 0
+		v.clearPreview()
+		return nil
+	}
+	itemID, _ := strconv.ParseInt(item.ID, 10, 64)
+	if itemID == v.selectedID {
+		return nil
+	}
+	v.selectedID = itemID
+
+	var info data.DocsFilesItemInfo
+	for _, it := range v.items {
+		if it.ID == itemID {
+			info = it
+			break
+		}
+	}
+
+	// Folders are previewed from the list data; no fetch needed
+	if info.Type == "Folder" {
+		v.fetching = 0
+		v.showPreview(folderPreview(info))
+		return nil
+	}
+
+	if cached, ok := v.cachedPreview[itemID]; ok {
+		v.fetching = 0
+		v.showPreview(cached)
+		return nil
+	}
+
+	v.fetching = itemID
+	v.clearPreview()
+	return tea.Batch(v.spinner.Tick, v.fetchPreview(itemID, info.Type))
+}
+
+func folderPreview(info data.DocsFilesItemInfo) *docsFilesPreviewMsg {
+	return &docsFilesPreviewMsg{
+		itemID: info.ID,
+		title:  info.Title,
+		fields: []widget.PreviewField{
+			{Key: "Type", Value: "Folder"},
+			{Key: "Folders", Value: strconv.Itoa(info.VaultsCount)},
+			{Key: "Docs", Value: strconv.Itoa(info.DocsCount)},
+			{Key: "Uploads", Value: strconv.Itoa(info.UploadsCount)},
+		},
+	}
+}
+
+func (v *DocsFiles) showPreview(p *docsFilesPreviewMsg) {
+	v.preview.SetTitle(p.title)
+	if p.appURL != "" {
+		v.preview.SetTitleURL(p.appURL)
+	}
+	v.preview.SetFields(p.fields)
+	v.preview.SetBody(p.body)
+
+	// Re-apply size so the preview recalculates content height
+	v.preview.SetSize(v.split.RightWidth(), v.height)
+}
+
+func (v *DocsFiles) clearPreview() {
+	v.preview.SetTitle("")
+	v.preview.SetFields(nil)
+	v.preview.SetBody("")
+}
+
+func (v *DocsFiles) fetchPreview(itemID int64, itemType string) tea.Cmd {
+	session := v.session
+	ctx := session.Hub().ProjectContext()
+	return func() tea.Msg {
+		client := session.AccountClient()
+		if itemType == "Upload" {
+			u, err := client.Uploads().Get(ctx, itemID)
+			if err != nil {
+				return docsFilesPreviewMsg{itemID: itemID, err: err}
+			}
+			return uploadPreview(u)
+		}
+		d, err := client.Documents().Get(ctx, itemID)
+		if err != nil {
+			return docsFilesPreviewMsg{itemID: itemID, err: err}
+		}
+		return documentPreview(d)
+	}
+}
+
+func documentPreview(d *basecamp.Document) docsFilesPreviewMsg {
+	fields := []widget.PreviewField{{Key: "Type", Value: "Document"}}
+	if d.Creator != nil {
+		fields = append(fields, widget.PreviewField{Key: "By", Value: d.Creator.Name})
+	}
+	fields = append(fields, widget.PreviewField{Key: "Updated", Value: d.UpdatedAt.Format("Jan 2, 2006")})
+	return docsFilesPreviewMsg{
+		itemID: d.ID,
+		title:  d.Title,
+		fields: fields,
+		body:   d.Content,
+		appURL: d.AppURL,
+	}
+}
+
+func uploadPreview(u *basecamp.Upload) docsFilesPreviewMsg {
+	title := u.Filename
+	if title == "" {
+		title = u.Title
+	}
+	fields := []widget.PreviewField{{Key: "Type", Value: "Upload"}}
+	if u.ContentType != "" {
+		fields = append(fields, widget.PreviewField{Key: "Content", Value: u.ContentType})
+	}
+	fields = append(fields, widget.PreviewField{Key: "Size", Value: formatByteSize(u.ByteSize)})
+	if u.Width > 0 && u.Height > 0 {
+		fields = append(fields, widget.PreviewField{Key: "Dimensions", Value: fmt.Sprintf("%d×%d", u.Width, u.Height)})
+	}
+	if u.Creator != nil {
+		fields = append(fields, widget.PreviewField{Key: "By", Value: u.Creator.Name})
+	}
+	fields = append(fields, widget.PreviewField{Key: "Uploaded", Value: u.CreatedAt.Format("Jan 2, 2006")})
+	return docsFilesPreviewMsg{
+		itemID:      u.ID,
+		title:       title,
+		fields:      fields,
+		body:        u.Description,
+		appURL:      u.AppURL,
+		downloadURL: u.DownloadURL,
+	}
+}
+
+// formatByteSize renders a byte count as a short human-readable size.
+func formatByteSize(n int64) string {
+	const unit = 1024
+	if n < unit {
+		return fmt.Sprintf("%d B", n)
+	}
+	div, exp := int64(unit), 0
+	for m := n / unit; m >= unit; m /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %cB", float64(n)/float64(div), "KMGTPE"[exp])
+}
@@ -44,11 +44,17 @@ func testDocsFilesView() *DocsFiles {
 	list.SetFocused(true)
 	list.SetSize(80, 24)
 
+	split := widget.NewSplitPane(styles, 0.4)
+	split.SetSize(120, 24)
+
 	v := &DocsFiles{
 		session:        session,
 		pool:           pool,
 		styles:         styles,
+		split:          split,
 		list:           list,
+		preview:        widget.NewPreview(styles),
+		cachedPreview:  make(map[int64]*docsFilesPreviewMsg),
 		currentVaultID: 100,
 		currentTitle:   "Docs & Files",
 		items:          sampleDocsFiles(),
@@ -387,3 +393,76 @@ func TestDocsFiles_Title(t *testing.T) {
 	v := testDocsFilesView()
 	assert.Equal(t, "Docs & Files", v.Title())
 }
+
+// --- Preview pane ---
+
+func TestDocsFiles_PreviewFolder_UsesListCounts(t *testing.T) {
+	v := testDocsFilesView()
+
+	cmd := v.previewSelected()
+	assert.Nil(t, cmd, "folders preview from list data without fetching")
+	assert.Equal(t, int64(1), v.selectedID)
+	assert.Zero(t, v.fetching)
+
+	fields := v.preview.Fields()
+	require.Len(t, fields, 4)
+	assert.Equal(t, "Folders", fields[1].Key)
+	assert.Equal(t, "2", fields[1].Value)
+	assert.Equal(t, "3", fields[2].Value)
+	assert.Equal(t, "1", fields[3].Value)
+}
+
+func TestDocsFiles_CursorMove_FetchesPreview(t *testing.T) {
+	v := testDocsFilesView()
+	v.previewSelected()
+
+	cmd := v.handleKey(tea.KeyPressMsg{Code: 'j', Text: "j"})
+	require.NotNil(t, cmd, "moving onto a document should start a preview fetch")
+	assert.Equal(t, int64(2), v.selectedID)
+	assert.Equal(t, int64(2), v.fetching)
+}
+
+func TestDocsFiles_PreviewLoaded_CachesAndShows(t *testing.T) {
+	v := testDocsFilesView()
+	v.list.SelectIndex(2)
+	v.previewSelected()
+	require.Equal(t, int64(3), v.fetching)
+
+	_, cmd := v.Update(docsFilesPreviewMsg{
+		itemID:      3,
+		title:       "logo.png",
+		fields:      []widget.PreviewField{{Key: "Type", Value: "Upload"}},
+		downloadURL: "https://storage.3.basecamp.com/1/blobs/abc/download/logo.png",
+	})
+	assert.Nil(t, cmd)
+	assert.Zero(t, v.fetching)
+	assert.Equal(t, "https://storage.3.basecamp.com/1/blobs/abc/download/logo.png", v.FocusedURL())
+	assert.Equal(t, int64(3), v.FocusedItem().RecordingID)
+
+	// Revisiting a cached item shows it without another fetch
+	v.list.SelectIndex(0)
+	v.previewSelected()
+	v.list.SelectIndex(2)
+	assert.Nil(t, v.previewSelected())
+}
+
+func TestDocsFiles_PreviewLoaded_StaleSelectionIgnored(t *testing.T) {
+	v := testDocsFilesView()
+	v.list.SelectIndex(1)
+	v.previewSelected()
+
+	// Move away before the document preview arrives
+	v.list.SelectIndex(0)
+	v.previewSelected()
+
+	v.Update(docsFilesPreviewMsg{itemID: 2, title: "README"})
+	assert.Equal(t, int64(1), v.selectedID)
+	assert.Empty(t, v.FocusedURL(), "documents and folders have no download URL")
+	assert.Contains(t, v.cachedPreview, int64(2))
+}
+
+func TestFormatByteSize(t *testing.T) {
+	assert.Equal(t, "512 B", formatByteSize(512))
+	assert.Equal(t, "1.5 KB", formatByteSize(1536))
+	assert.Equal(t, "2.0 MB", formatByteSize(2*1024*1024))
+}
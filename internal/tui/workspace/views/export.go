@@ -0,0 +1,117 @@
+package views
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/basecamp/basecamp-cli/internal/richtext"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
+)
+
+// errNothingToExport is returned by Export before a view has loaded any data.
+var errNothingToExport = errors.New("nothing to export yet")
+
+// exportedItem is one list row in a JSON export. Section is the header the
+// row sits under; Data is the view's metadata for the row, when it has any.
+type exportedItem struct {
+	ID          string `json:"id"`
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Extra       string `json:"extra,omitempty"`
+	Section     string `json:"section,omitempty"`
+	Data        any    `json:"data,omitempty"`
+}
+
+// exportList exports the list's visible rows, in display order, as a JSON
+// array. Rows found in meta carry their metadata along.
+func exportList[T any](name string, list *widget.List, meta map[string]T) (workspace.ViewExport, error) {
+	items := list.Items()
+	out := make([]exportedItem, 0, len(items))
+	section := ""
+	for _, item := range items {
+		if item.Header {
+			section = item.Title
+			continue
+		}
+		row := exportedItem{
+			ID:          item.ID,
+			Title:       item.Title,
+			Description: item.Description,
+			Extra:       item.Extra,
+			Section:     section,
+		}
+		if m, ok := meta[item.ID]; ok {
+			row.Data = m
+		}
+		out = append(out, row)
+	}
+	if len(out) == 0 {
+		return workspace.ViewExport{}, errNothingToExport
+	}
+	content, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return workspace.ViewExport{}, err
+	}
+	return workspace.ViewExport{Name: name, Format: "json", Content: append(content, '\n')}, nil
+}
+
+// exportItems exports a list that keeps no per-row metadata.
+func exportItems(name string, list *widget.List) (workspace.ViewExport, error) {
+	return exportList[any](name, list, nil)
+}
+
+// detailMarkdown renders a recording and its comments as a Markdown document.
+func detailMarkdown(d *detailData) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "# %s\n\n", d.title)
+
+	var fields []string
+	if d.recordType != "" {
+		fields = append(fields, "Type: "+d.recordType)
+	}
+	if d.creator != "" {
+		fields = append(fields, "By: "+d.creator)
+	}
+	if !d.createdAt.IsZero() {
+		fields = append(fields, "Created: "+d.createdAt.Format("Jan 2, 2006"))
+	}
+	if d.dueOn != "" {
+		fields = append(fields, "Due: "+d.dueOn)
+	}
+	if d.category != "" {
+		fields = append(fields, "Category: "+d.category)
+	}
+	if len(d.assignees) > 0 {
+		fields = append(fields, "Assigned: "+strings.Join(d.assignees, ", "))
+	}
+	if d.completed {
+		fields = append(fields, "Status: Completed")
+	}
+	if d.appURL != "" {
+		fields = append(fields, "URL: "+d.appURL)
+	}
+	for _, f := range fields {
+		fmt.Fprintf(&b, "- %s\n", f)
+	}
+	if len(fields) > 0 {
+		b.WriteString("\n")
+	}
+
+	if body := strings.TrimSpace(richtext.HTMLToMarkdown(d.content)); body != "" {
+		b.WriteString(body)
+		b.WriteString("\n")
+	}
+
+	if len(d.comments) > 0 {
+		b.WriteString("\n## Comments\n")
+		for _, c := range d.comments {
+			fmt.Fprintf(&b, "\n### %s · %s\n\n", c.creator, c.createdAt.Format("Jan 2, 2006 3:04 PM"))
+			b.WriteString(strings.TrimSpace(richtext.HTMLToMarkdown(c.content)))
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,70 @@
+package views
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
+)
+
+func TestExportList_VisibleRowsWithSectionsAndMeta(t *testing.T) {
+	v := testHey(testHeyEntries)
+
+	exp, err := v.Export()
+	require.NoError(t, err)
+	assert.Equal(t, "hey", exp.Name)
+	assert.Equal(t, "json", exp.Format)
+
+	var rows []struct {
+		ID      string         `json:"id"`
+		Title   string         `json:"title"`
+		Section string         `json:"section"`
+		Data    map[string]any `json:"data"`
+	}
+	require.NoError(t, json.Unmarshal(exp.Content, &rows))
+	require.Len(t, rows, 2, "headers are folded into section, not exported as rows")
+	assert.Equal(t, "acct1:1", rows[0].ID)
+	assert.Equal(t, "Fix login", rows[0].Title)
+	assert.NotEmpty(t, rows[0].Section)
+	assert.Equal(t, "Todo", rows[0].Data["Type"])
+	assert.Equal(t, "Web", rows[0].Data["Project"])
+}
+
+func TestExportList_EmptyListErrors(t *testing.T) {
+	list := widget.NewList(tui.NewStyles())
+	_, err := exportItems("projects", list)
+	assert.ErrorIs(t, err, errNothingToExport)
+}
+
+func TestDetail_ExportMarkdown(t *testing.T) {
+	v := detailWithComments()
+	v.data.content = "<p>Ship the <strong>login</strong> fix.</p>"
+	v.data.assignees = []string{"Bob"}
+	v.data.appURL = "https://3.basecamp.com/1/buckets/42/todos/100"
+
+	exp, err := v.Export()
+	require.NoError(t, err)
+	assert.Equal(t, "recording-100", exp.Name)
+	assert.Equal(t, "md", exp.Format)
+
+	md := string(exp.Content)
+	assert.Contains(t, md, "# Test Todo\n")
+	assert.Contains(t, md, "- By: Alice\n")
+	assert.Contains(t, md, "- Assigned: Bob\n")
+	assert.Contains(t, md, "- URL: https://3.basecamp.com/1/buckets/42/todos/100\n")
+	assert.Contains(t, md, "**login**")
+	assert.Contains(t, md, "## Comments")
+	assert.Contains(t, md, "First comment")
+	assert.Contains(t, md, "Second comment")
+}
+
+func TestDetail_ExportBeforeLoadErrors(t *testing.T) {
+	v := testDetail("", "")
+	v.data = nil
+	_, err := v.Export()
+	assert.ErrorIs(t, err, errNothingToExport)
+}
@@ -56,6 +56,11 @@ func NewForwards(session *workspace.Session) *Forwards {
 
 func (v *Forwards) Title() string { return "Email Forwards" }
 
+// Export implements workspace.Exporter.
+func (v *Forwards) Export() (workspace.ViewExport, error) {
+	return exportItems("forwards", v.list)
+}
+
 func (v *Forwards) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
 		return filterHints()
@@ -71,6 +76,12 @@ func (v *Forwards) FullHelp() [][]key.Binding { return [][]key.Binding{v.ShortHe
 // StartFilter implements workspace.Filterable.
 func (v *Forwards) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Forwards) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Forwards) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Forwards) InputActive() bool { return v.list.Filtering() }
 
@@ -369,6 +369,12 @@ func (f *FrontPage) FullHelp() [][]key.Binding {
 // StartFilter implements Filterable.
 func (f *FrontPage) StartFilter() { f.list.StartFilter() }
 
+// FilterState implements FilterReporter.
+func (f *FrontPage) FilterState() workspace.FilterState { return f.list.FilterState() }
+
+// ClearFilter implements FilterReporter.
+func (f *FrontPage) ClearFilter() { f.list.StopFilter() }
+
 // InputActive implements InputCapturer.
 func (f *FrontPage) InputActive() bool { return f.list.Filtering() }
 
@@ -0,0 +1,129 @@
+package views
+
+import (
+	"strings"
+	"time"
+
+	"charm.land/bubbles/v2/key"
+	"charm.land/bubbles/v2/textinput"
+	tea "charm.land/bubbletea/v2"
+	"charm.land/lipgloss/v2"
+
+	"github.com/basecamp/basecamp-cli/internal/dateparse"
+	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
+)
+
+// dateJump is the ":" goto-date prompt shared by time-ordered views
+// (Activity, Schedule). Input is parsed with dateparse, so "last monday",
+// "3 days ago", and "2024-06-15" all work.
+type dateJump struct {
+	active bool
+	input  textinput.Model
+}
+
+// start opens the prompt.
+func (j *dateJump) start() tea.Cmd {
+	j.active = true
+	j.input = textinput.New()
+	j.input.Placeholder = "Go to date (yesterday, last monday, 2024-06-15)…"
+	j.input.CharLimit = 64
+	j.input.Focus()
+	return textinput.Blink
+}
+
+// handleKey feeds a key press to the open prompt. On enter with a
+// recognized date it closes the prompt and returns local midnight of that
+// day with ok=true. Esc or an empty submit closes without a date; an
+// unrecognized date keeps the prompt open and reports it in the status bar.
+func (j *dateJump) handleKey(msg tea.KeyPressMsg) (day time.Time, ok bool, cmd tea.Cmd) {
+	switch msg.String() {
+	case "enter":
+		val := strings.TrimSpace(j.input.Value())
+		if val == "" {
+			j.active = false
+			return time.Time{}, false, nil
+		}
+		if !dateparse.IsValid(val) {
+			return time.Time{}, false, workspace.SetStatus("Unrecognized date: "+val, true)
+		}
+		day, err := time.ParseInLocation("2006-01-02", dateparse.Parse(val), time.Local)
+		if err != nil {
+			return time.Time{}, false, workspace.SetStatus("Unrecognized date: "+val, true)
+		}
+		j.active = false
+		return day, true, nil
+	case "esc":
+		j.active = false
+		return time.Time{}, false, nil
+	default:
+		var cmd tea.Cmd
+		j.input, cmd = j.input.Update(msg)
+		return time.Time{}, false, cmd
+	}
+}
+
+// view renders the prompt line shown beneath the list.
+func (j *dateJump) view(styles *tui.Styles) string {
+	return lipgloss.NewStyle().Foreground(styles.Theme().Muted).Render("  Go to: ") + j.input.View()
+}
+
+// dateJumpHints returns the key hints shown while the prompt is open.
+func dateJumpHints() []key.Binding {
+	return []key.Binding{
+		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "jump")),
+		key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
+	}
+}
+
+// jumpToDate moves the list cursor to the item closest to day. ts maps an
+// item ID to its unix timestamp; items without one (headers, unknown IDs)
+// are skipped. Returns exact=true when the chosen item falls on day itself,
+// and found=false when no item carries a timestamp.
+func jumpToDate(list *widget.List, day time.Time, ts func(id string) (int64, bool)) (found, exact bool) {
+	start, end := day.Unix(), day.AddDate(0, 0, 1).Unix()
+
+	bestID := ""
+	var bestDist int64
+	for _, item := range list.Items() {
+		if item.Header {
+			continue
+		}
+		t, ok := ts(item.ID)
+		if !ok {
+			continue
+		}
+		var dist int64
+		switch {
+		case t < start:
+			dist = start - t
+		case t >= end:
+			dist = t - end + 1
+		}
+		if bestID == "" || dist < bestDist {
+			bestID, bestDist = item.ID, dist
+		}
+		if dist == 0 {
+			break
+		}
+	}
+	if bestID == "" {
+		return false, false
+	}
+	list.SelectByID(bestID)
+	return true, bestDist == 0
+}
+
+// dateJumpStatus reports where a jump landed.
+func dateJumpStatus(day time.Time, found, exact bool, noun string) tea.Cmd {
+	label := day.Format("Jan 2, 2006")
+	switch {
+	case !found:
+		return workspace.SetStatus("No "+noun+" loaded", false)
+	case exact:
+		return workspace.SetStatus("Jumped to "+label, false)
+	default:
+		return workspace.SetStatus("No "+noun+" on "+label+" — showing nearest", false)
+	}
+}
@@ -2,6 +2,7 @@ package views
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -28,13 +29,19 @@ type heyTrashResultMsg struct {
 	err    error
 }
 type heyTrashTimeoutMsg struct{}
+type heyReadResultMsg struct {
+	itemIDs []string
+	err     error
+}
 
 // Hey is the activity feed view showing recently updated recordings across
 // all accounts. It replaces the empty notifications stub with real data
-// from Recordings().List() fan-out.
+// from Recordings().List() fan-out, merged with the unread Hey! readings:
+// unread items are marked and can be marked read.
 type Hey struct {
 	session *workspace.Session
 	pool    *data.Pool[[]data.ActivityEntryInfo]
+	unreads *data.Pool[[]data.ActivityEntryInfo]
 	styles  *tui.Styles
 
 	list    *widget.List
@@ -44,6 +51,11 @@ type Hey struct {
 	// Entries metadata for navigation
 	entryMeta map[string]workspace.ActivityEntryInfo
 	excluded  map[string]bool // items completed/trashed, pending pool refresh
+	read      map[string]bool // items marked read, pending unreads refresh
+	unread    int             // unread items listed, shown in the title
+
+	// Group by project instead of by time
+	byProject bool
 
 	// Double-press trash confirmation
 	trashPending   bool
@@ -65,21 +77,34 @@ func NewHey(session *workspace.Session) *Hey {
 	list.SetEmptyMessage(empty.NoRecordings("activity"))
 	list.SetFocused(true)
 
-	pool := session.Hub().HeyActivity()
+	hub := session.Hub()
 
 	return &Hey{
 		session:   session,
-		pool:      pool,
+		pool:      hub.HeyActivity(),
+		unreads:   hub.HeyUnreads(),
 		styles:    styles,
 		list:      list,
 		spinner:   s,
 		loading:   true,
 		entryMeta: make(map[string]workspace.ActivityEntryInfo),
 		excluded:  make(map[string]bool),
+		read:      make(map[string]bool),
+	}
+}
+
+// Title carries the unread count, so the breadcrumb shows it.
+func (v *Hey) Title() string {
+	if v.unread > 0 {
+		return fmt.Sprintf("Hey! (%d)", v.unread)
 	}
+	return "Hey!"
 }
 
-func (v *Hey) Title() string { return "Hey!" }
+// Export implements workspace.Exporter.
+func (v *Hey) Export() (workspace.ViewExport, error) {
+	return exportList("hey", v.list, v.entryMeta)
+}
 
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Hey) FocusedItem() workspace.FocusedItemScope {
@@ -105,9 +130,12 @@ func (v *Hey) ShortHelp() []key.Binding {
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("j/k"), key.WithHelp("j/k", "navigate")),
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
-		key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "complete")),
+		key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "mark read")),
+		key.NewBinding(key.WithKeys("X"), key.WithHelp("X", "mark all read")),
+		key.NewBinding(key.WithKeys("c"), key.WithHelp("c", "complete")),
 		key.NewBinding(key.WithKeys("b"), key.WithHelp("b", "boost")),
 		key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "trash")),
+		key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "group by project/time")),
 	}
 }
 
@@ -118,6 +146,12 @@ func (v *Hey) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Hey) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Hey) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Hey) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Hey) InputActive() bool { return v.list.Filtering() }
 
@@ -129,13 +163,17 @@ func (v *Hey) SetSize(w, h int) {
 
 func (v *Hey) Init() tea.Cmd {
 	cmds := []tea.Cmd{v.spinner.Tick}
+	ctx := v.session.Hub().Global().Context()
 	snap := v.pool.Get()
 	if snap.Usable() {
 		v.syncEntries(snap.Data)
 		v.loading = false
 	}
 	if !snap.Fresh() {
-		cmds = append(cmds, v.pool.FetchIfStale(v.session.Hub().Global().Context()))
+		cmds = append(cmds, v.pool.FetchIfStale(ctx))
+	}
+	if v.unreads != nil && !v.unreads.Get().Fresh() {
+		cmds = append(cmds, v.unreads.FetchIfStale(ctx))
 	}
 	cmds = append(cmds, v.schedulePoll())
 	return tea.Batch(cmds...)
@@ -167,8 +205,37 @@ func (v *Hey) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 				v.loading = true
 			}
 		}
+		if v.unreads != nil && msg.Key == v.unreads.Key() {
+			snap := v.unreads.Get()
+			if snap.State == data.StateFresh {
+				v.read = make(map[string]bool) // API response reflects mark-read
+			}
+			// A failed readings fetch leaves the feed as it is: unread marks
+			// are a bonus on top of the activity, not worth an error.
+			if activity := v.pool.Get(); activity.Usable() {
+				v.syncEntries(activity.Data)
+			}
+			return v, chromeSync
+		}
 		return v, nil
 
+	case heyReadResultMsg:
+		if msg.err != nil {
+			return v, workspace.ReportError(msg.err, "marking read")
+		}
+		for _, id := range msg.itemIDs {
+			v.read[id] = true
+		}
+		if snap := v.pool.Get(); snap.Usable() {
+			v.syncEntries(snap.Data)
+		}
+		cmds := []tea.Cmd{chromeSync, workspace.SetStatus(fmt.Sprintf("Marked %d read", len(msg.itemIDs)), false)}
+		if v.unreads != nil {
+			v.unreads.Invalidate()
+			cmds = append(cmds, v.unreads.Fetch(v.session.Hub().Global().Context()))
+		}
+		return v, tea.Batch(cmds...)
+
 	case heyCompleteResultMsg:
 		if msg.err != nil {
 			return v, workspace.ReportError(msg.err, "completing todo")
@@ -197,23 +264,37 @@ func (v *Hey) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		return v, nil
 
 	case workspace.RefreshMsg:
+		ctx := v.session.Hub().Global().Context()
 		v.pool.Invalidate()
 		v.loading = true
-		return v, tea.Batch(v.spinner.Tick, v.pool.Fetch(v.session.Hub().Global().Context()))
+		cmds := []tea.Cmd{v.spinner.Tick, v.pool.Fetch(ctx)}
+		if v.unreads != nil {
+			v.unreads.Invalidate()
+			cmds = append(cmds, v.unreads.Fetch(ctx))
+		}
+		return v, tea.Batch(cmds...)
 
 	case data.PollMsg:
 		if msg.Tag == v.pool.Key() && msg.Gen == v.pollGen {
-			return v, tea.Batch(
-				v.pool.FetchIfStale(v.session.Hub().Global().Context()),
-				v.schedulePoll(),
-			)
+			ctx := v.session.Hub().Global().Context()
+			cmds := []tea.Cmd{v.pool.FetchIfStale(ctx), v.schedulePoll()}
+			if v.unreads != nil {
+				cmds = append(cmds, v.unreads.FetchIfStale(ctx))
+			}
+			return v, tea.Batch(cmds...)
 		}
 
 	case workspace.FocusMsg:
 		v.pool.SetFocused(true)
+		if v.unreads != nil {
+			v.unreads.SetFocused(true)
+		}
 
 	case workspace.BlurMsg:
 		v.pool.SetFocused(false)
+		if v.unreads != nil {
+			v.unreads.SetFocused(false)
+		}
 
 	case workspace.TerminalFocusMsg:
 		return v, v.schedulePoll()
@@ -240,7 +321,20 @@ func (v *Hey) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		if !v.list.Filtering() {
 			switch msg.String() {
 			case "x":
+				return v, v.markSelectedRead()
+			case "X":
+				return v, v.markVisibleRead()
+			case "c":
 				return v, v.completeSelected()
+			case "g":
+				v.byProject = !v.byProject
+				if snap := v.pool.Get(); snap.Usable() {
+					v.syncEntries(snap.Data)
+				}
+				if v.byProject {
+					return v, workspace.SetStatus("Grouped by project", false)
+				}
+				return v, workspace.SetStatus("Grouped by time", false)
 			case "b", "B":
 				return v, v.boostSelected()
 			case "t":
@@ -271,41 +365,26 @@ func (v *Hey) View() string {
 }
 
 func (v *Hey) syncEntries(entries []workspace.ActivityEntryInfo) {
+	entries = mergeHeyUnreads(entries, v.unreadEntries(), v.read)
 	v.entryMeta = make(map[string]workspace.ActivityEntryInfo, len(entries))
-	items := make([]widget.ListItem, 0, len(entries)+4) // room for time headers
+	v.unread = 0
+	items := make([]widget.ListItem, 0, len(entries)+4) // room for group headers
 	accounts := sessionAccounts(v.session)
 
-	// Group by time bucket
-	now := time.Now()
-	var justNow, hourAgo, today, yesterday, older []workspace.ActivityEntryInfo
-
-	for _, e := range entries {
-		age := now.Unix() - e.UpdatedAtTS
-		switch {
-		case age < 600: // 10 min
-			justNow = append(justNow, e)
-		case age < 3600: // 1 hour
-			hourAgo = append(hourAgo, e)
-		case age < 86400 && now.Day() == time.Unix(e.UpdatedAtTS, 0).Day():
-			today = append(today, e)
-		case age < 172800:
-			yesterday = append(yesterday, e)
-		default:
-			older = append(older, e)
-		}
-	}
-
 	addGroup := func(label string, group []workspace.ActivityEntryInfo) {
 		if len(group) == 0 {
 			return
 		}
 		items = append(items, widget.ListItem{Title: label, Header: true})
 		for _, e := range group {
-			id := fmt.Sprintf("%s:%d", e.AccountID, e.ID)
+			id := heyEntryID(e)
 			if v.excluded[id] {
 				continue
 			}
 			v.entryMeta[id] = e
+			if e.Unread {
+				v.unread++
+			}
 			desc := e.Account
 			if e.Project != "" {
 				desc += " > " + e.Project
@@ -315,10 +394,40 @@ func (v *Hey) syncEntries(entries []workspace.ActivityEntryInfo) {
 				Title:       e.Title,
 				Description: desc,
 				Extra:       accountExtra(accounts, e.AccountID, e.Type),
+				Account:     accountNumber(accounts, e.AccountID),
+				Marked:      e.Unread,
 			})
 		}
 	}
 
+	if v.byProject {
+		for _, g := range groupHeyByProject(entries) {
+			addGroup(g.label, g.entries)
+		}
+		v.list.SetItems(items)
+		return
+	}
+
+	// Group by time bucket
+	now := time.Now()
+	var justNow, hourAgo, today, yesterday, older []workspace.ActivityEntryInfo
+
+	for _, e := range entries {
+		age := now.Unix() - e.UpdatedAtTS
+		switch {
+		case age < 600: // 10 min
+			justNow = append(justNow, e)
+		case age < 3600: // 1 hour
+			hourAgo = append(hourAgo, e)
+		case age < 86400 && now.Day() == time.Unix(e.UpdatedAtTS, 0).Day():
+			today = append(today, e)
+		case age < 172800:
+			yesterday = append(yesterday, e)
+		default:
+			older = append(older, e)
+		}
+	}
+
 	addGroup("Just Now", justNow)
 	addGroup("1 Hour Ago", hourAgo)
 	addGroup("Today", today)
@@ -328,6 +437,87 @@ func (v *Hey) syncEntries(entries []workspace.ActivityEntryInfo) {
 	v.list.SetItems(items)
 }
 
+// unreadEntries returns the unread readings, when loaded.
+func (v *Hey) unreadEntries() []workspace.ActivityEntryInfo {
+	if v.unreads == nil {
+		return nil
+	}
+	if snap := v.unreads.Get(); snap.Usable() {
+		return snap.Data
+	}
+	return nil
+}
+
+// heyEntryID is the list item ID of an entry: unique across accounts.
+func heyEntryID(e workspace.ActivityEntryInfo) string {
+	return fmt.Sprintf("%s:%d", e.AccountID, e.ID)
+}
+
+// mergeHeyUnreads marks activity entries that have an unread reading, and
+// adds unread readings for recordings the feed doesn't list, newest first.
+// Items in read (marked read locally) stay read.
+func mergeHeyUnreads(entries, unreads []workspace.ActivityEntryInfo, read map[string]bool) []workspace.ActivityEntryInfo {
+	if len(unreads) == 0 {
+		return entries
+	}
+	pending := make(map[string]workspace.ActivityEntryInfo, len(unreads))
+	for _, u := range unreads {
+		id := heyEntryID(u)
+		if _, dup := pending[id]; !dup && !read[id] {
+			pending[id] = u
+		}
+	}
+
+	merged := make([]workspace.ActivityEntryInfo, 0, len(entries)+len(pending))
+	for _, e := range entries {
+		id := heyEntryID(e)
+		if u, ok := pending[id]; ok {
+			e.Unread = true
+			e.ReadableSGID = u.ReadableSGID
+			delete(pending, id)
+		}
+		merged = append(merged, e)
+	}
+	for _, u := range unreads {
+		if _, ok := pending[heyEntryID(u)]; ok {
+			merged = append(merged, u)
+			delete(pending, heyEntryID(u))
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].UpdatedAtTS > merged[j].UpdatedAtTS
+	})
+	return merged
+}
+
+// heyGroup is one project's entries in the by-project grouping.
+type heyGroup struct {
+	label   string
+	entries []workspace.ActivityEntryInfo
+}
+
+// groupHeyByProject groups entries by account and project, keeping the
+// feed's order: the project with the newest activity comes first.
+func groupHeyByProject(entries []workspace.ActivityEntryInfo) []heyGroup {
+	var groups []heyGroup
+	index := make(map[string]int)
+	for _, e := range entries {
+		key := fmt.Sprintf("%s:%d", e.AccountID, e.ProjectID)
+		i, ok := index[key]
+		if !ok {
+			label := e.Project
+			if label == "" {
+				label = e.Account
+			}
+			i = len(groups)
+			index[key] = i
+			groups = append(groups, heyGroup{label: label})
+		}
+		groups[i].entries = append(groups[i].entries, e)
+	}
+	return groups
+}
+
 func (v *Hey) openSelected() tea.Cmd {
 	item := v.list.Selected()
 	if item == nil {
@@ -360,6 +550,62 @@ func (v *Hey) openSelected() tea.Cmd {
 	return workspace.Navigate(workspace.ViewDetail, scope)
 }
 
+// markSelectedRead marks the selected item's reading read.
+func (v *Hey) markSelectedRead() tea.Cmd {
+	item := v.list.Selected()
+	if item == nil {
+		return nil
+	}
+	meta, ok := v.entryMeta[item.ID]
+	if !ok {
+		return nil
+	}
+	if !meta.Unread {
+		return workspace.SetStatus("Already read", false)
+	}
+	return v.markRead([]workspace.ActivityEntryInfo{meta})
+}
+
+// markVisibleRead marks every unread item the list shows (after any
+// filter) read.
+func (v *Hey) markVisibleRead() tea.Cmd {
+	var entries []workspace.ActivityEntryInfo
+	for _, item := range v.list.Items() {
+		if meta, ok := v.entryMeta[item.ID]; ok && meta.Unread {
+			entries = append(entries, meta)
+		}
+	}
+	if len(entries) == 0 {
+		return workspace.SetStatus("Nothing unread", false)
+	}
+	return v.markRead(entries)
+}
+
+// markRead marks entries' readings read, one readings request per account.
+func (v *Hey) markRead(entries []workspace.ActivityEntryInfo) tea.Cmd {
+	sgids := make(map[string][]string)
+	var accounts []string
+	itemIDs := make([]string, 0, len(entries))
+	for _, e := range entries {
+		if _, ok := sgids[e.AccountID]; !ok {
+			accounts = append(accounts, e.AccountID)
+		}
+		sgids[e.AccountID] = append(sgids[e.AccountID], e.ReadableSGID)
+		itemIDs = append(itemIDs, heyEntryID(e))
+	}
+
+	hub := v.session.Hub()
+	ctx := hub.Global().Context()
+	return func() tea.Msg {
+		for _, accountID := range accounts {
+			if err := hub.MarkRead(ctx, accountID, sgids[accountID]); err != nil {
+				return heyReadResultMsg{err: err}
+			}
+		}
+		return heyReadResultMsg{itemIDs: itemIDs}
+	}
+}
+
 func (v *Hey) completeSelected() tea.Cmd {
 	item := v.list.Selected()
 	if item == nil {
@@ -432,6 +678,9 @@ func (v *Hey) boostSelected() tea.Cmd {
 	}
 }
 
+// chromeSync re-renders the breadcrumb, which carries the unread count.
+func chromeSync() tea.Msg { return workspace.ChromeSyncMsg{} }
+
 func (v *Hey) schedulePoll() tea.Cmd {
 	interval := v.pool.PollInterval()
 	if interval == 0 {
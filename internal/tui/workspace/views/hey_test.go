@@ -16,6 +16,10 @@ import (
 )
 
 func testHey(entries []data.ActivityEntryInfo) *Hey {
+	return testHeyWithUnreads(entries, nil)
+}
+
+func testHeyWithUnreads(entries, unreads []data.ActivityEntryInfo) *Hey {
 	styles := tui.NewStyles()
 	list := widget.NewList(styles)
 	list.SetEmptyText("No recent activity.")
@@ -27,19 +31,21 @@ func testHey(entries []data.ActivityEntryInfo) *Hey {
 	v := &Hey{
 		session:   session,
 		pool:      pool,
+		unreads:   testPool("hey:unreads", unreads, true),
 		styles:    styles,
 		list:      list,
 		loading:   false,
 		entryMeta: make(map[string]workspace.ActivityEntryInfo),
 		excluded:  make(map[string]bool),
+		read:      make(map[string]bool),
 	}
 	v.syncEntries(entries)
 	return v
 }
 
 var testHeyEntries = []data.ActivityEntryInfo{
-	{ID: 1, Title: "Fix login", Type: "Todo", AccountID: "acct1", ProjectID: 10, UpdatedAtTS: 100},
-	{ID: 2, Title: "Weekly update", Type: "Message", AccountID: "acct1", ProjectID: 10, UpdatedAtTS: 90},
+	{ID: 1, Title: "Fix login", Type: "Todo", AccountID: "acct1", ProjectID: 10, Project: "Web", UpdatedAtTS: 100},
+	{ID: 2, Title: "Weekly update", Type: "Message", AccountID: "acct1", ProjectID: 10, Project: "Web", UpdatedAtTS: 90},
 }
 
 func TestHey_CompleteSelected_Todo(t *testing.T) {
@@ -92,11 +98,11 @@ func TestHey_FilterBlocksMutationKeys(t *testing.T) {
 	v := testHey(testHeyEntries)
 	v.list.StartFilter()
 
-	_, cmd := v.Update(tea.KeyPressMsg{Code: 'x', Text: "x"})
+	_, cmd := v.Update(tea.KeyPressMsg{Code: 'c', Text: "c"})
 	if cmd != nil {
 		msg := cmd()
 		_, isComplete := msg.(heyCompleteResultMsg)
-		assert.False(t, isComplete, "x during filter should not trigger completion")
+		assert.False(t, isComplete, "c during filter should not trigger completion")
 	}
 }
 
@@ -116,7 +122,9 @@ func TestHey_ShortHelp_IncludesActions(t *testing.T) {
 	for _, h := range hints {
 		keys[h.Help().Key] = h.Help().Desc
 	}
-	assert.Equal(t, "complete", keys["x"])
+	assert.Equal(t, "mark read", keys["x"])
+	assert.Equal(t, "mark all read", keys["X"])
+	assert.Equal(t, "complete", keys["c"])
 	assert.Equal(t, "trash", keys["t"])
 }
 
@@ -147,6 +155,7 @@ func testPollingHey() *Hey {
 		loading:   false,
 		entryMeta: make(map[string]workspace.ActivityEntryInfo),
 		excluded:  make(map[string]bool),
+		read:      make(map[string]bool),
 	}
 	v.syncEntries(entries)
 	return v
@@ -169,3 +178,79 @@ func TestHey_StalePollGen_Dropped(t *testing.T) {
 	_, cmd = v.Update(data.PollMsg{Tag: poolKey, Gen: 2})
 	assert.NotNil(t, cmd, "current gen PollMsg should be processed")
 }
+
+var testHeyUnreads = []data.ActivityEntryInfo{
+	{ID: 2, Title: "Weekly update", Type: "Message", AccountID: "acct1", ProjectID: 10, UpdatedAtTS: 95, Unread: true, ReadableSGID: "sgid-2"},
+	{ID: 3, Title: "Launch plan", Type: "Document", AccountID: "acct1", ProjectID: 20, Project: "Launch", UpdatedAtTS: 80, Unread: true, ReadableSGID: "sgid-3"},
+}
+
+func TestMergeHeyUnreads(t *testing.T) {
+	merged := mergeHeyUnreads(testHeyEntries, testHeyUnreads, nil)
+	require.Len(t, merged, 3)
+
+	assert.False(t, merged[0].Unread, "todo 1 has no reading")
+	assert.True(t, merged[1].Unread, "message 2 is marked from its reading")
+	assert.Equal(t, "sgid-2", merged[1].ReadableSGID)
+	assert.Equal(t, int64(3), merged[2].ID, "unread reading outside the feed is added")
+
+	merged = mergeHeyUnreads(testHeyEntries, testHeyUnreads, map[string]bool{"acct1:2": true, "acct1:3": true})
+	require.Len(t, merged, 2)
+	assert.False(t, merged[1].Unread, "items marked read locally stay read")
+}
+
+func TestHey_TitleShowsUnreadCount(t *testing.T) {
+	v := testHeyWithUnreads(testHeyEntries, testHeyUnreads)
+	assert.Equal(t, "Hey! (2)", v.Title())
+
+	v = testHey(testHeyEntries)
+	assert.Equal(t, "Hey!", v.Title())
+}
+
+func TestHey_MarkSelectedRead(t *testing.T) {
+	v := testHeyWithUnreads(testHeyEntries, testHeyUnreads)
+
+	// First item (todo 1) has no reading
+	msg := v.markSelectedRead()()
+	status, ok := msg.(workspace.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, status.Text, "Already read")
+
+	v.list.SelectByID("acct1:2")
+	msg = v.markSelectedRead()()
+	_, ok = msg.(heyReadResultMsg)
+	assert.True(t, ok, "unread item should be marked via the readings endpoint")
+}
+
+func TestHey_ReadResultClearsUnread(t *testing.T) {
+	v := testHeyWithUnreads(testHeyEntries, testHeyUnreads)
+
+	_, cmd := v.Update(heyReadResultMsg{itemIDs: []string{"acct1:2", "acct1:3"}})
+	assert.NotNil(t, cmd)
+	assert.Equal(t, "Hey!", v.Title())
+	for _, item := range v.list.Items() {
+		assert.False(t, item.Marked, "%s should no longer be marked", item.ID)
+	}
+}
+
+func TestHey_MarkVisibleRead_NothingUnread(t *testing.T) {
+	v := testHey(testHeyEntries)
+	msg := v.markVisibleRead()()
+	status, ok := msg.(workspace.StatusMsg)
+	require.True(t, ok)
+	assert.Contains(t, status.Text, "Nothing unread")
+}
+
+func TestHey_GroupByProjectToggle(t *testing.T) {
+	v := testHeyWithUnreads(testHeyEntries, testHeyUnreads)
+
+	v.Update(tea.KeyPressMsg{Code: 'g', Text: "g"})
+	require.True(t, v.byProject)
+
+	var headers []string
+	for _, item := range v.list.Items() {
+		if item.Header {
+			headers = append(headers, item.Title)
+		}
+	}
+	assert.Equal(t, []string{"Web", "Launch"}, headers, "one header per project, newest first")
+}
@@ -88,6 +88,11 @@ func NewHome(session *workspace.Session) *Home {
 
 func (v *Home) Title() string { return "Home" }
 
+// Export implements workspace.Exporter.
+func (v *Home) Export() (workspace.ViewExport, error) {
+	return exportItems("home", v.list)
+}
+
 func (v *Home) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
 		return filterHints()
@@ -168,6 +173,12 @@ func (v *Home) SetSize(w, h int) {
 // StartFilter implements Filterable.
 func (v *Home) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements FilterReporter.
+func (v *Home) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements FilterReporter.
+func (v *Home) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements InputCapturer.
 func (v *Home) InputActive() bool { return v.list.Filtering() }
 
@@ -375,6 +386,7 @@ func (v *Home) syncRecents() {
 			Title:       p.Title,
 			Description: p.Description,
 			Extra:       accountExtra(accounts, p.AccountID, "project"),
+			Account:     accountNumber(accounts, p.AccountID),
 		})
 
 		pid, _ := strconv.ParseInt(p.ID, 10, 64)
@@ -396,6 +408,7 @@ func (v *Home) syncRecents() {
 			Title:       r.Title,
 			Description: desc,
 			Extra:       accountExtra(accounts, r.AccountID, "recent"),
+			Account:     accountNumber(accounts, r.AccountID),
 		})
 
 		rid, _ := strconv.ParseInt(r.ID, 10, 64)
@@ -436,6 +449,7 @@ func (v *Home) syncHeyEntries(entries []workspace.ActivityEntryInfo) {
 			Title:       e.Title,
 			Description: desc,
 			Extra:       accountExtra(accounts, e.AccountID, e.Type),
+			Account:     accountNumber(accounts, e.AccountID),
 		})
 		v.itemMeta[id] = homeItemMeta{
 			accountID:   e.AccountID,
@@ -475,6 +489,7 @@ func (v *Home) syncAssignments(assignments []workspace.AssignmentInfo) {
 			Title:       a.Content,
 			Description: desc,
 			Extra:       extra,
+			Account:     accountNumber(accounts, a.AccountID),
 			Marked:      a.Overdue,
 		})
 		v.itemMeta[id] = homeItemMeta{
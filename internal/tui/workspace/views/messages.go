@@ -96,6 +96,11 @@ func (v *Messages) Title() string {
 	return "Message Board"
 }
 
+// Export implements workspace.Exporter.
+func (v *Messages) Export() (workspace.ViewExport, error) {
+	return exportItems("messages", v.list)
+}
+
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Messages) FocusedItem() workspace.FocusedItemScope {
 	item := v.list.Selected()
@@ -142,6 +147,12 @@ func (v *Messages) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Messages) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Messages) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Messages) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Messages) InputActive() bool { return v.list.Filtering() }
 
@@ -0,0 +1,22 @@
+package views
+
+import (
+	"strings"
+
+	tea "charm.land/bubbletea/v2"
+
+	"github.com/basecamp/basecamp-cli/internal/tui/resolve"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
+)
+
+// persistMutes saves the session's mute list to the global config
+// (tui_mute) so it survives restarts.
+func persistMutes(session *workspace.Session) tea.Cmd {
+	entries := strings.Join(session.Mutes().Entries(), ",")
+	return func() tea.Msg {
+		if err := resolve.PersistValue("tui_mute", entries, "global"); err != nil {
+			return workspace.ErrorMsg{Err: err, Context: "saving muted projects"}
+		}
+		return nil
+	}
+}
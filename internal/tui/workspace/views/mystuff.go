@@ -54,6 +54,11 @@ func (v *MyStuff) Title() string {
 	return "My Stuff"
 }
 
+// Export implements workspace.Exporter.
+func (v *MyStuff) Export() (workspace.ViewExport, error) {
+	return exportItems("my-stuff", v.list)
+}
+
 // ShortHelp implements View.
 func (v *MyStuff) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
@@ -73,6 +78,12 @@ func (v *MyStuff) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *MyStuff) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *MyStuff) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *MyStuff) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *MyStuff) InputActive() bool { return v.list.Filtering() }
 
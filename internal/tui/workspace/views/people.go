@@ -55,6 +55,11 @@ func NewPeople(session *workspace.Session) *People {
 // Title implements View.
 func (v *People) Title() string { return "People" }
 
+// Export implements workspace.Exporter.
+func (v *People) Export() (workspace.ViewExport, error) {
+	return exportItems("people", v.list)
+}
+
 // ShortHelp implements View.
 func (v *People) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
@@ -74,6 +79,12 @@ func (v *People) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *People) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *People) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *People) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *People) InputActive() bool { return v.list.Filtering() }
 
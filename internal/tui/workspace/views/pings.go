@@ -58,6 +58,11 @@ func NewPings(session *workspace.Session) *Pings {
 
 func (v *Pings) Title() string { return "Pings" }
 
+// Export implements workspace.Exporter.
+func (v *Pings) Export() (workspace.ViewExport, error) {
+	return exportList("pings", v.list, v.roomMeta)
+}
+
 func (v *Pings) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
 		return filterHints()
@@ -75,6 +80,12 @@ func (v *Pings) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Pings) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Pings) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Pings) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Pings) InputActive() bool { return v.list.Filtering() }
 
@@ -187,6 +198,7 @@ func (v *Pings) syncRooms(rooms []workspace.PingRoomInfo) {
 				Title:       r.PersonName,
 				Description: r.LastMessage,
 				Extra:       accountExtra(accounts, r.AccountID, r.LastAt),
+				Account:     accountNumber(accounts, r.AccountID),
 			})
 		}
 	}
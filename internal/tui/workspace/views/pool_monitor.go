@@ -305,6 +305,9 @@ func (v *PoolMonitor) View() string {
 		case data.PoolInvalidated:
 			indicator = secondaryStyle.Render("↻")
 			desc = ev.PoolKey + " stale"
+		case data.MutationCoalesced:
+			indicator = mutedStyle.Render("⇢")
+			desc = ev.PoolKey + " coalesced"
 		}
 
 		line := mutedStyle.Render(ts) + " " + indicator + " " + desc
@@ -97,6 +97,11 @@ func (v *Projects) Title() string {
 	return "Projects"
 }
 
+// Export implements workspace.Exporter.
+func (v *Projects) Export() (workspace.ViewExport, error) {
+	return exportItems("projects", v.list)
+}
+
 // ShortHelp implements View.
 func (v *Projects) ShortHelp() []key.Binding {
 	if v.list.Filtering() || v.toolList.Filtering() {
@@ -117,6 +122,7 @@ func (v *Projects) ShortHelp() []key.Binding {
 		key.NewBinding(key.WithKeys("j/k"), key.WithHelp("j/k", "navigate")),
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
 		key.NewBinding(key.WithKeys("b"), key.WithHelp("b", "bookmark")),
+		key.NewBinding(key.WithKeys("M"), key.WithHelp("M", "mute")),
 	}
 }
 
@@ -125,15 +131,23 @@ func (v *Projects) FullHelp() [][]key.Binding {
 	return [][]key.Binding{v.ShortHelp()}
 }
 
-// StartFilter implements workspace.Filterable.
-func (v *Projects) StartFilter() {
+// filterList returns the focused pane's list, which / filters.
+func (v *Projects) filterList() *widget.List {
 	if v.focusRight {
-		v.toolList.StartFilter()
-	} else {
-		v.list.StartFilter()
+		return v.toolList
 	}
+	return v.list
 }
 
+// StartFilter implements workspace.Filterable.
+func (v *Projects) StartFilter() { v.filterList().StartFilter() }
+
+// FilterState implements workspace.FilterReporter.
+func (v *Projects) FilterState() workspace.FilterState { return v.filterList().FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Projects) ClearFilter() { v.filterList().StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Projects) InputActive() bool {
 	return v.list.Filtering() || v.toolList.Filtering()
@@ -266,6 +280,8 @@ func (v *Projects) handleProjectKey(msg tea.KeyPressMsg) tea.Cmd {
 		return nil
 	case msg.String() == "b":
 		return v.toggleBookmark()
+	case msg.String() == "M":
+		return v.toggleMute()
 	default:
 		prevIdx := v.list.SelectedIndex()
 		cmd := v.list.Update(msg)
@@ -476,7 +492,7 @@ func (v *Projects) syncProjectList() {
 			for _, p := range append(bm, reg...) {
 				id := fmt.Sprintf("%d", p.ID)
 				v.projectAccounts[id] = p.AccountID
-				items = append(items, projectInfoToListItem(p))
+				items = append(items, v.projectListItem(p))
 			}
 		}
 	} else {
@@ -492,7 +508,7 @@ func (v *Projects) syncProjectList() {
 		for _, p := range append(bm, reg...) {
 			id := fmt.Sprintf("%d", p.ID)
 			v.projectAccounts[id] = p.AccountID
-			items = append(items, projectInfoToListItem(p))
+			items = append(items, v.projectListItem(p))
 		}
 	}
 
@@ -513,6 +529,15 @@ func projectInfoToListItem(p data.ProjectInfo) widget.ListItem {
 	}
 }
 
+// projectListItem builds a project row, flagging projects that are muted.
+func (v *Projects) projectListItem(p data.ProjectInfo) widget.ListItem {
+	item := projectInfoToListItem(p)
+	if mutes := v.session.Mutes(); mutes != nil && mutes.Muted(p.ID, "") {
+		item.Extra = "muted"
+	}
+	return item
+}
+
 func (v *Projects) findProject(projectID int64) *data.ProjectInfo {
 	for i := range v.projects {
 		if v.projects[i].ID == projectID {
@@ -742,6 +767,29 @@ func (v *Projects) toggleBookmark() tea.Cmd {
 	return v.setBookmark(projectID, newBookmarked)
 }
 
+// toggleMute mutes or unmutes the selected project's toasts and badges and
+// saves the choice.
+func (v *Projects) toggleMute() tea.Cmd {
+	item := v.list.Selected()
+	if item == nil || item.Header {
+		return nil
+	}
+	var projectID int64
+	fmt.Sscanf(item.ID, "%d", &projectID)
+	if v.findProject(projectID) == nil {
+		return nil
+	}
+
+	muted := v.session.Mutes().Toggle(projectID, "")
+	v.syncProjectList()
+
+	status := "Unmuted " + item.Title
+	if muted {
+		status = "Muted " + item.Title
+	}
+	return tea.Batch(persistMutes(v.session), workspace.SetStatus(status, false))
+}
+
 func (v *Projects) setBookmark(projectID int64, bookmarked bool) tea.Cmd {
 	accountID := v.session.Scope().AccountID
 	if aid, ok := v.projectAccounts[fmt.Sprintf("%d", projectID)]; ok && aid != "" {
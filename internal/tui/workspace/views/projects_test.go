@@ -9,13 +9,14 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/basecamp/basecamp-cli/internal/tui"
+	"github.com/basecamp/basecamp-cli/internal/tui/workspace"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace/data"
 	"github.com/basecamp/basecamp-cli/internal/tui/workspace/widget"
 )
 
 // testProjectsView builds a Projects view with pre-populated data for unit
-// testing focus management and key routing. Session is nil — tests that
-// trigger navigation (openTool, navigateToTool) are not covered here.
+// testing focus management and key routing. The session has no Hub — tests
+// that trigger navigation (openTool, navigateToTool) are not covered here.
 func testProjectsView(projects []data.ProjectInfo) *Projects {
 	styles := tui.NewStyles()
 
@@ -35,6 +36,7 @@ func testProjectsView(projects []data.ProjectInfo) *Projects {
 	pool := testPool("projects", projects, true)
 
 	v := &Projects{
+		session:         workspace.NewTestSession(),
 		pool:            pool,
 		styles:          styles,
 		list:            list,
@@ -317,10 +319,11 @@ func TestProjects_ShortHelp_LeftPanel(t *testing.T) {
 	v := testProjectsView(sampleProjects())
 
 	hints := v.ShortHelp()
-	require.Len(t, hints, 3)
+	require.Len(t, hints, 4)
 	assert.Equal(t, "navigate", hints[0].Help().Desc)
 	assert.Equal(t, "open", hints[1].Help().Desc)
 	assert.Equal(t, "bookmark", hints[2].Help().Desc)
+	assert.Equal(t, "mute", hints[3].Help().Desc)
 }
 
 func TestProjects_ShortHelp_RightPanel(t *testing.T) {
@@ -417,3 +420,20 @@ func TestToolNameToView(t *testing.T) {
 		assert.Equal(t, tt.ok, ok, "toolNameToView(%q)", tt.name)
 	}
 }
+
+// --- Mute ---
+
+func TestProjects_MuteKeyTogglesProject(t *testing.T) {
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+	v := testProjectsView(sampleProjects())
+	require.Equal(t, "1", v.list.Selected().ID)
+
+	cmd := v.handleProjectKey(tea.KeyPressMsg{Code: 'M', Text: "M"})
+	require.NotNil(t, cmd)
+	assert.Equal(t, []string{"1"}, v.session.Mutes().Entries())
+	assert.Equal(t, "muted", v.list.Selected().Extra)
+
+	v.handleProjectKey(tea.KeyPressMsg{Code: 'M', Text: "M"})
+	assert.Empty(t, v.session.Mutes().Entries())
+	assert.Empty(t, v.list.Selected().Extra)
+}
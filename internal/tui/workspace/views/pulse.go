@@ -58,6 +58,11 @@ func NewPulse(session *workspace.Session) *Pulse {
 
 func (v *Pulse) Title() string { return "Pulse" }
 
+// Export implements workspace.Exporter.
+func (v *Pulse) Export() (workspace.ViewExport, error) {
+	return exportList("pulse", v.list, v.entryMeta)
+}
+
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Pulse) FocusedItem() workspace.FocusedItemScope {
 	item := v.list.Selected()
@@ -93,6 +98,12 @@ func (v *Pulse) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Pulse) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Pulse) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Pulse) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Pulse) InputActive() bool { return v.list.Filtering() }
 
@@ -222,6 +233,7 @@ func (v *Pulse) syncEntries(entries []workspace.ActivityEntryInfo) {
 				Title:       e.Title,
 				Description: desc,
 				Extra:       accountExtra(accounts, e.AccountID, e.UpdatedAt),
+				Account:     accountNumber(accounts, e.AccountID),
 			})
 		}
 	}
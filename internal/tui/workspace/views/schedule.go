@@ -56,6 +56,9 @@ type Schedule struct {
 	// Trash (double-press)
 	trashPending   bool
 	trashPendingID string
+
+	// ":" goto-date prompt
+	jump dateJump
 }
 
 // NewSchedule creates the schedule view.
@@ -88,6 +91,11 @@ func (v *Schedule) Title() string {
 	return "Schedule"
 }
 
+// Export implements workspace.Exporter.
+func (v *Schedule) Export() (workspace.ViewExport, error) {
+	return exportItems("schedule", v.list)
+}
+
 // ShortHelp implements View.
 func (v *Schedule) ShortHelp() []key.Binding {
 	if v.list.Filtering() {
@@ -99,11 +107,15 @@ func (v *Schedule) ShortHelp() []key.Binding {
 			key.NewBinding(key.WithKeys("esc"), key.WithHelp("esc", "cancel")),
 		}
 	}
+	if v.jump.active {
+		return dateJumpHints()
+	}
 	return []key.Binding{
 		key.NewBinding(key.WithKeys("j/k"), key.WithHelp("j/k", "navigate")),
 		key.NewBinding(key.WithKeys("enter"), key.WithHelp("enter", "open")),
 		key.NewBinding(key.WithKeys("n"), key.WithHelp("n", "new event")),
 		key.NewBinding(key.WithKeys("t"), key.WithHelp("t", "trash")),
+		key.NewBinding(key.WithKeys(":"), key.WithHelp(":", "go to date")),
 	}
 }
 
@@ -115,11 +127,17 @@ func (v *Schedule) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Schedule) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Schedule) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Schedule) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
-func (v *Schedule) InputActive() bool { return v.list.Filtering() || v.creating }
+func (v *Schedule) InputActive() bool { return v.list.Filtering() || v.creating || v.jump.active }
 
 // IsModal implements workspace.ModalActive.
-func (v *Schedule) IsModal() bool { return v.creating }
+func (v *Schedule) IsModal() bool { return v.creating || v.jump.active }
 
 // SetSize implements View.
 func (v *Schedule) SetSize(w, h int) {
@@ -210,6 +228,9 @@ func (v *Schedule) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		if v.creating {
 			return v, v.handleCreateKey(msg)
 		}
+		if v.jump.active {
+			return v, v.handleJumpKey(msg)
+		}
 		return v, v.handleKey(msg)
 	}
 	return v, nil
@@ -235,6 +256,8 @@ func (v *Schedule) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		return v.startCreate()
 	case msg.String() == "t":
 		return v.trashSelected()
+	case msg.String() == ":":
+		return v.jump.start()
 	case key.Matches(msg, keys.Open):
 		return v.openSelectedEntry()
 	default:
@@ -242,6 +265,26 @@ func (v *Schedule) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 	}
 }
 
+// -- Goto date
+
+// handleJumpKey routes keys to the goto-date prompt and, on submit, moves
+// the cursor to the entry starting nearest the requested day.
+func (v *Schedule) handleJumpKey(msg tea.KeyPressMsg) tea.Cmd {
+	day, ok, cmd := v.jump.handleKey(msg)
+	if !ok {
+		return cmd
+	}
+	starts := make(map[string]int64, len(v.entries))
+	for _, e := range v.entries {
+		starts[fmt.Sprintf("%d", e.ID)] = e.StartsAtTS
+	}
+	found, exact := jumpToDate(v.list, day, func(id string) (int64, bool) {
+		ts, ok := starts[id]
+		return ts, ok
+	})
+	return dateJumpStatus(day, found, exact, "events")
+}
+
 // -- Create (multi-step inline)
 
 func (v *Schedule) startCreate() tea.Cmd {
@@ -422,6 +465,10 @@ func (v *Schedule) View() string {
 		}
 		b.WriteString(lipgloss.NewStyle().Foreground(theme.Muted).Render(prefix) + v.createInput.View())
 	}
+	if v.jump.active {
+		b.WriteString("\n")
+		b.WriteString(v.jump.view(v.styles))
+	}
 
 	return b.String()
 }
@@ -295,3 +295,33 @@ func TestSchedule_ShortHelp_IncludesNewAndTrash(t *testing.T) {
 	assert.Equal(t, "new event", keys["n"])
 	assert.Equal(t, "trash", keys["t"])
 }
+
+// --- Goto date ---
+
+func TestSchedule_GotoDate_SelectsNearestEntry(t *testing.T) {
+	v := testScheduleView()
+	v.entries[0].StartsAtTS = time.Date(2026, 3, 1, 0, 0, 0, 0, time.Local).Unix()
+	v.entries[1].StartsAtTS = time.Date(2026, 3, 5, 0, 0, 0, 0, time.Local).Unix()
+	v.syncList()
+
+	v.handleKey(runeKey(':'))
+	require.True(t, v.jump.active)
+	assert.True(t, v.InputActive())
+	assert.True(t, v.IsModal())
+
+	v.jump.input.SetValue("2026-03-04")
+	v.handleJumpKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	assert.False(t, v.jump.active)
+	require.NotNil(t, v.list.Selected())
+	assert.Equal(t, "2", v.list.Selected().ID)
+}
+
+func TestSchedule_GotoDate_InvalidKeepsPromptOpen(t *testing.T) {
+	v := testScheduleView()
+	v.handleKey(runeKey(':'))
+	v.jump.input.SetValue("someday")
+	cmd := v.handleJumpKey(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotNil(t, cmd)
+	assert.True(t, v.jump.active)
+	assert.Equal(t, "1", v.list.Selected().ID)
+}
@@ -111,11 +111,28 @@ func NewSearch(session *workspace.Session) *Search {
 	}
 }
 
+// NewSearchWithQuery creates the search view with query already entered.
+// Init runs the search immediately. An empty query behaves like NewSearch.
+func NewSearchWithQuery(session *workspace.Session, query string) *Search {
+	v := NewSearch(session)
+	if q := strings.TrimSpace(query); q != "" {
+		v.textInput.SetValue(q)
+		v.query = q
+		v.searching = true
+	}
+	return v
+}
+
 // Title implements View.
 func (v *Search) Title() string {
 	return "Search"
 }
 
+// Export implements workspace.Exporter.
+func (v *Search) Export() (workspace.ViewExport, error) {
+	return exportList("search", v.list, v.resultMeta)
+}
+
 // IsModal implements workspace.ModalActive.
 func (v *Search) IsModal() bool {
 	return v.focus == searchFocusList
@@ -131,6 +148,12 @@ func (v *Search) InputActive() bool {
 // StartFilter implements workspace.Filterable.
 func (v *Search) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Search) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Search) ClearFilter() { v.list.StopFilter() }
+
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Search) FocusedItem() workspace.FocusedItemScope {
 	item := v.list.Selected()
@@ -186,6 +209,9 @@ func (v *Search) SetSize(w, h int) {
 
 // Init implements tea.Model.
 func (v *Search) Init() tea.Cmd {
+	if v.searching {
+		return tea.Batch(textinput.Blink, v.spinner.Tick, v.fetchResults(v.query))
+	}
 	return textinput.Blink
 }
 
@@ -257,6 +283,7 @@ func (v *Search) handleResults(msg workspace.SearchResultsMsg) tea.Cmd {
 			Title:       r.Title,
 			Description: desc,
 			Extra:       accountExtra(accounts, r.AccountID, r.Type),
+			Account:     accountNumber(accounts, r.AccountID),
 		})
 	}
 	v.list.SetItems(items)
@@ -64,6 +64,11 @@ func NewTimeline(session *workspace.Session, projectID int64) *Timeline {
 
 func (v *Timeline) Title() string { return "Project Activity" }
 
+// Export implements workspace.Exporter.
+func (v *Timeline) Export() (workspace.ViewExport, error) {
+	return exportList("project-activity", v.list, v.entryMeta)
+}
+
 // FocusedItem implements workspace.FocusedRecording.
 func (v *Timeline) FocusedItem() workspace.FocusedItemScope {
 	item := v.list.Selected()
@@ -98,6 +103,12 @@ func (v *Timeline) FullHelp() [][]key.Binding {
 // StartFilter implements workspace.Filterable.
 func (v *Timeline) StartFilter() { v.list.StartFilter() }
 
+// FilterState implements workspace.FilterReporter.
+func (v *Timeline) FilterState() workspace.FilterState { return v.list.FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Timeline) ClearFilter() { v.list.StopFilter() }
+
 // InputActive implements workspace.InputCapturer.
 func (v *Timeline) InputActive() bool { return v.list.Filtering() }
 
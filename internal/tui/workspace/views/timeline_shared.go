@@ -78,6 +78,7 @@ func syncTimelineEntries(
 				Title:       title,
 				Description: desc,
 				Extra:       accountExtra(accounts, e.AccountID, extra),
+				Account:     accountNumber(accounts, e.AccountID),
 			})
 		}
 	}
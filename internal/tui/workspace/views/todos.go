@@ -41,6 +41,8 @@ type todosKeyMap struct {
 	RenameList    key.Binding
 	TrashList     key.Binding
 	ShowCompleted key.Binding
+	MoveDown      key.Binding
+	MoveUp        key.Binding
 }
 
 func defaultTodosKeyMap() todosKeyMap {
@@ -90,6 +92,14 @@ func defaultTodosKeyMap() todosKeyMap {
 			key.WithKeys("c"),
 			key.WithHelp("c", "completed"),
 		),
+		MoveDown: key.NewBinding(
+			key.WithKeys("J"),
+			key.WithHelp("J", "move down"),
+		),
+		MoveUp: key.NewBinding(
+			key.WithKeys("K"),
+			key.WithHelp("K", "move up"),
+		),
 	}
 }
 
@@ -120,6 +130,7 @@ type Todos struct {
 	loadingLists   bool
 	loadingTodos   bool
 	selectedListID int64
+	pollGen        uint64
 
 	// Inline creation
 	creating  bool
@@ -259,6 +270,11 @@ func (v *Todos) Title() string {
 	return "Todos"
 }
 
+// Export implements workspace.Exporter.
+func (v *Todos) Export() (workspace.ViewExport, error) {
+	return exportItems("todos", v.listTodos)
+}
+
 // HasSplitPane implements workspace.SplitPaneFocuser.
 func (v *Todos) HasSplitPane() bool { return true }
 
@@ -269,15 +285,23 @@ func (v *Todos) InputActive() bool {
 		v.listLists.Filtering() || v.listTodos.Filtering()
 }
 
-// StartFilter implements workspace.Filterable.
-func (v *Todos) StartFilter() {
+// filterList returns the focused pane's list, which / filters.
+func (v *Todos) filterList() *widget.List {
 	if v.focus == todosPaneLeft {
-		v.listLists.StartFilter()
-	} else {
-		v.listTodos.StartFilter()
+		return v.listLists
 	}
+	return v.listTodos
 }
 
+// StartFilter implements workspace.Filterable.
+func (v *Todos) StartFilter() { v.filterList().StartFilter() }
+
+// FilterState implements workspace.FilterReporter.
+func (v *Todos) FilterState() workspace.FilterState { return v.filterList().FilterState() }
+
+// ClearFilter implements workspace.FilterReporter.
+func (v *Todos) ClearFilter() { v.filterList().StopFilter() }
+
 // IsModal implements workspace.ModalActive.
 func (v *Todos) IsModal() bool {
 	return v.editingDesc || v.settingDue || v.assigning || v.creatingList || v.renamingList
@@ -360,6 +384,10 @@ func (v *Todos) FullHelp() [][]key.Binding {
 			v.keys.Unassign,
 			v.keys.Boost,
 		},
+		{
+			v.keys.MoveDown,
+			v.keys.MoveUp,
+		},
 	}
 }
 
@@ -380,12 +408,12 @@ func (v *Todos) Init() tea.Cmd {
 		v.loadingLists = false
 		if snap.Fresh() {
 			if item := v.listLists.Selected(); item != nil {
-				return v.selectTodolist(item.ID)
+				return tea.Batch(v.selectTodolist(item.ID), v.schedulePoll())
 			}
-			return nil
+			return v.schedulePoll()
 		}
 	}
-	return tea.Batch(v.spinner.Tick, v.todolistPool.FetchIfStale(v.session.Hub().ProjectContext()))
+	return tea.Batch(v.spinner.Tick, v.todolistPool.FetchIfStale(v.session.Hub().ProjectContext()), v.schedulePoll())
 }
 
 // Update implements tea.Model.
@@ -458,6 +486,23 @@ func (v *Todos) Update(msg tea.Msg) (workspace.View, tea.Cmd) {
 		v.loadingLists = true
 		return v, tea.Batch(v.spinner.Tick, v.todolistPool.Fetch(v.session.Hub().ProjectContext()))
 
+	case data.PollMsg:
+		if msg.Tag == v.todolistPool.Key() && msg.Gen == v.pollGen {
+			// Polling is off unless refresh.todos is configured. Completed
+			// todos aren't polled: they rarely change under you.
+			ctx := v.session.Hub().ProjectContext()
+			cmds := []tea.Cmd{v.todolistPool.FetchIfStale(ctx), v.schedulePoll()}
+			if v.selectedListID != 0 && !v.showCompleted {
+				pool := v.session.Hub().Todos(v.session.Scope().ProjectID, v.selectedListID)
+				cmds = append(cmds, pool.FetchIfStale(ctx))
+			}
+			return v, tea.Batch(cmds...)
+		}
+		return v, nil
+
+	case workspace.TerminalFocusMsg:
+		return v, v.schedulePoll()
+
 	case workspace.FocusMsg:
 		ctx := v.session.Hub().ProjectContext()
 		cmds := []tea.Cmd{v.todolistPool.FetchIfStale(ctx)}
@@ -735,6 +780,16 @@ func (v *Todos) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 			return v.boostSelectedTodo()
 		}
 
+	case key.Matches(msg, v.keys.MoveDown):
+		if v.focus == todosPaneRight && !v.showCompleted {
+			return v.moveSelectedTodo(1)
+		}
+
+	case key.Matches(msg, v.keys.MoveUp):
+		if v.focus == todosPaneRight && !v.showCompleted {
+			return v.moveSelectedTodo(-1)
+		}
+
 	case key.Matches(msg, listKeys.Open):
 		if v.focus == todosPaneRight {
 			return v.openSelectedTodo()
@@ -935,6 +990,19 @@ func (v *Todos) loadPendingTodos(listID int64) tea.Cmd {
 	return tea.Batch(v.spinner.Tick, todosPool.FetchIfStale(v.session.Hub().ProjectContext()))
 }
 
+func (v *Todos) schedulePoll() tea.Cmd {
+	interval := v.todolistPool.PollInterval()
+	if interval == 0 {
+		return nil
+	}
+	v.pollGen++
+	key := v.todolistPool.Key()
+	gen := v.pollGen
+	return tea.Tick(interval, func(time.Time) tea.Msg {
+		return data.PollMsg{Tag: key, Gen: gen}
+	})
+}
+
 func (v *Todos) loadCompletedTodos(listID int64) tea.Cmd {
 	completedPool := v.session.Hub().CompletedTodos(v.session.Scope().ProjectID, listID)
 	snap := completedPool.Get()
@@ -1251,6 +1319,62 @@ func (v *Todos) createTodo(content string) tea.Cmd {
 	return cmd
 }
 
+// moveSelectedTodo swaps the selected todo with its neighbor delta places
+// away, optimistically; the pool rolls the order back if the API refuses.
+func (v *Todos) moveSelectedTodo(delta int) tea.Cmd {
+	item := v.listTodos.Selected()
+	if item == nil {
+		return nil
+	}
+	// Neighbors in a filtered list aren't neighbors in the todolist.
+	if v.listTodos.FilterState().Query != "" {
+		return workspace.SetStatus("Clear the filter to reorder todos", false)
+	}
+	todoID, err := strconv.ParseInt(item.ID, 10, 64)
+	if err != nil {
+		return nil
+	}
+
+	scope := v.session.Scope()
+	todosPool := v.session.Hub().Todos(scope.ProjectID, v.selectedListID)
+	snap := todosPool.Get()
+	if !snap.Usable() {
+		return nil
+	}
+	from := -1
+	for i, t := range snap.Data {
+		if t.ID == todoID {
+			from = i
+			break
+		}
+	}
+	to := from + delta
+	if from < 0 || to < 0 || to >= len(snap.Data) {
+		return nil
+	}
+
+	// Take the neighbor's place; fall back to the list index when the
+	// API didn't report positions.
+	position := snap.Data[to].Position
+	if position < 1 {
+		position = to + 1
+	}
+
+	cmd := todosPool.Apply(v.session.Hub().ProjectContext(), data.TodoRepositionMutation{
+		TodoID:   todoID,
+		Index:    to,
+		Position: position,
+		Client:   v.session.AccountClient(),
+	})
+
+	if snap := todosPool.Get(); snap.Usable() {
+		v.syncTodos(v.selectedListID, snap.Data)
+		v.listTodos.SelectByID(item.ID)
+	}
+
+	return cmd
+}
+
 func (v *Todos) boostSelectedTodo() tea.Cmd {
 	item := v.listTodos.Selected()
 	if item == nil {
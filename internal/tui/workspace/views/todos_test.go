@@ -983,3 +983,51 @@ func newTextInputWithValue(val string) textinput.Model {
 	ti.SetValue(val)
 	return ti
 }
+
+// --- Reorder ---
+
+func TestTodos_MoveDown_ReordersOptimistically(t *testing.T) {
+	v := testTodosViewWithTodos()
+	v.session = workspace.NewTestSessionWithSDK(workspace.Scope{AccountID: "1", ProjectID: 42, ToolID: 10})
+	todosPool := v.session.Hub().Todos(42, 10)
+	todosPool.Set(sampleTodos())
+
+	cmd := v.handleKey(runeKey('J'))
+	require.NotNil(t, cmd, "J should dispatch the reposition")
+
+	snap := todosPool.Get()
+	require.True(t, snap.Usable())
+	assert.Equal(t, int64(101), snap.Data[0].ID)
+	assert.Equal(t, int64(100), snap.Data[1].ID)
+	assert.Equal(t, 2, snap.Data[1].Position, "takes the neighbor's position")
+
+	item := v.listTodos.Selected()
+	require.NotNil(t, item)
+	assert.Equal(t, "100", item.ID, "selection follows the moved todo")
+}
+
+func TestTodos_MoveUp_AtTopIsNoop(t *testing.T) {
+	v := testTodosViewWithTodos()
+	v.session.Hub().Todos(42, 10).Set(sampleTodos())
+
+	assert.Nil(t, v.handleKey(runeKey('K')))
+	assert.Equal(t, int64(100), v.session.Hub().Todos(42, 10).Get().Data[0].ID)
+}
+
+func TestTodos_Move_RequiresRightPaneAndNoFilter(t *testing.T) {
+	v := testTodosView()
+	v.session.Hub().Todos(42, 10).Set(sampleTodos())
+	assert.Nil(t, v.handleKey(runeKey('J')), "left pane: no reorder")
+
+	v = testTodosViewWithTodos()
+	v.session.Hub().Todos(42, 10).Set(sampleTodos())
+	v.listTodos.StartFilter()
+	for _, r := range "docs" {
+		v.listTodos.Update(runeKey(r))
+	}
+	v.listTodos.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.NotEmpty(t, v.listTodos.FilterState().Query)
+
+	v.handleKey(runeKey('J'))
+	assert.Equal(t, int64(100), v.session.Hub().Todos(42, 10).Get().Data[0].ID, "filtered list is not reordered")
+}
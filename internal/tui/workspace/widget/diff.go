@@ -0,0 +1,247 @@
+package widget
+
+import (
+	"fmt"
+	"strings"
+
+	"charm.land/lipgloss/v2"
+
+	"github.com/basecamp/basecamp-cli/internal/tui"
+)
+
+// DiffOp is the kind of change a diff line records.
+type DiffOp int
+
+const (
+	DiffKeep DiffOp = iota
+	DiffInsert
+	DiffDelete
+)
+
+// DiffLine is one line of a line-level diff.
+type DiffLine struct {
+	Op   DiffOp
+	Text string
+}
+
+// maxDiffCells bounds the LCS table for the changed middle of two texts.
+// Past it the middle is shown as a whole delete then insert, which is still
+// an honest (if coarse) diff.
+const maxDiffCells = 4_000_000
+
+// DiffLines returns a line-level diff turning a into b. Common leading and
+// trailing lines are matched first, so a local edit in a long document only
+// pays for the lines around it.
+func DiffLines(a, b []string) []DiffLine {
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	out := make([]DiffLine, 0, len(a)+len(b)-prefix-suffix)
+	for _, s := range a[:prefix] {
+		out = append(out, DiffLine{Op: DiffKeep, Text: s})
+	}
+	out = append(out, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, s := range a[len(a)-suffix:] {
+		out = append(out, DiffLine{Op: DiffKeep, Text: s})
+	}
+	return out
+}
+
+// diffMiddle diffs two slices by longest common subsequence.
+func diffMiddle(a, b []string) []DiffLine {
+	var out []DiffLine
+	if len(a)*len(b) > maxDiffCells {
+		for _, s := range a {
+			out = append(out, DiffLine{Op: DiffDelete, Text: s})
+		}
+		for _, s := range b {
+			out = append(out, DiffLine{Op: DiffInsert, Text: s})
+		}
+		return out
+	}
+
+	// lcs[i][j] is the LCS length of a[i:] and b[j:].
+	lcs := make([][]int32, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			out = append(out, DiffLine{Op: DiffKeep, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			out = append(out, DiffLine{Op: DiffDelete, Text: a[i]})
+			i++
+		default:
+			out = append(out, DiffLine{Op: DiffInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		out = append(out, DiffLine{Op: DiffDelete, Text: a[i]})
+	}
+	for ; j < len(b); j++ {
+		out = append(out, DiffLine{Op: DiffInsert, Text: b[j]})
+	}
+	return out
+}
+
+// diffContext is how many unchanged lines surround each change.
+const diffContext = 3
+
+// Diff renders a scrollable unified diff between an original text and an
+// edited one, headed by added/removed line counts so a large accidental
+// deletion stands out before it is saved.
+type Diff struct {
+	styles *tui.Styles
+
+	lines          []DiffLine
+	added, removed int
+
+	width, height int
+	offset        int
+}
+
+// NewDiff creates an empty diff view.
+func NewDiff(styles *tui.Styles) *Diff {
+	return &Diff{styles: styles}
+}
+
+// SetText recomputes the diff from original to edited, keeping the scroll
+// position where it still fits.
+func (d *Diff) SetText(original, edited string) {
+	d.lines = DiffLines(splitDiffLines(original), splitDiffLines(edited))
+	d.added, d.removed = 0, 0
+	for _, l := range d.lines {
+		switch l.Op {
+		case DiffInsert:
+			d.added++
+		case DiffDelete:
+			d.removed++
+		}
+	}
+	d.clampOffset()
+}
+
+// Stats returns the number of added and removed lines.
+func (d *Diff) Stats() (added, removed int) {
+	return d.added, d.removed
+}
+
+// SetSize sets the rendering dimensions.
+func (d *Diff) SetSize(w, h int) {
+	d.width, d.height = w, h
+	d.clampOffset()
+}
+
+// ScrollDown scrolls the diff body down by n lines.
+func (d *Diff) ScrollDown(n int) {
+	d.offset += n
+	d.clampOffset()
+}
+
+// ScrollUp scrolls the diff body up by n lines.
+func (d *Diff) ScrollUp(n int) {
+	d.offset -= n
+	d.clampOffset()
+}
+
+func (d *Diff) clampOffset() {
+	limit := max(0, len(d.rows())-d.bodyHeight())
+	d.offset = max(0, min(d.offset, limit))
+}
+
+func (d *Diff) bodyHeight() int {
+	return max(1, d.height-1) // one line for the header
+}
+
+// diffRow is a rendered line: a change, context, or a hunk separator.
+type diffRow struct {
+	line DiffLine
+	gap  bool
+}
+
+// rows trims unchanged runs down to diffContext lines around each change.
+func (d *Diff) rows() []diffRow {
+	near := make([]bool, len(d.lines))
+	for i, l := range d.lines {
+		if l.Op == DiffKeep {
+			continue
+		}
+		for k := max(0, i-diffContext); k <= min(len(d.lines)-1, i+diffContext); k++ {
+			near[k] = true
+		}
+	}
+	var rows []diffRow
+	skipped := false
+	for i, l := range d.lines {
+		if !near[i] {
+			skipped = true
+			continue
+		}
+		if skipped && len(rows) > 0 {
+			rows = append(rows, diffRow{gap: true})
+		}
+		skipped = false
+		rows = append(rows, diffRow{line: l})
+	}
+	return rows
+}
+
+// View renders the header and the visible part of the diff.
+func (d *Diff) View() string {
+	theme := d.styles.Theme()
+	added := lipgloss.NewStyle().Foreground(theme.Success)
+	removed := lipgloss.NewStyle().Foreground(theme.Error)
+	muted := lipgloss.NewStyle().Foreground(theme.Muted)
+
+	header := muted.Render("No changes")
+	if d.added > 0 || d.removed > 0 {
+		header = added.Render(fmt.Sprintf("+%d", d.added)) + " " +
+			removed.Render(fmt.Sprintf("−%d", d.removed)) + muted.Render(" lines")
+	}
+
+	out := []string{header}
+	rows := d.rows()
+	end := min(len(rows), d.offset+d.bodyHeight())
+	for _, r := range rows[d.offset:end] {
+		switch {
+		case r.gap:
+			out = append(out, muted.Render("⋯"))
+		case r.line.Op == DiffInsert:
+			out = append(out, added.Render(Truncate("+ "+r.line.Text, d.width)))
+		case r.line.Op == DiffDelete:
+			out = append(out, removed.Render(Truncate("- "+r.line.Text, d.width)))
+		default:
+			out = append(out, muted.Render(Truncate("  "+r.line.Text, d.width)))
+		}
+	}
+	return lipgloss.NewStyle().Width(d.width).Height(d.height).Render(strings.Join(out, "\n"))
+}
+
+func splitDiffLines(s string) []string {
+	s = strings.TrimRight(s, "\n")
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
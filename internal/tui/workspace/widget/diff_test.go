@@ -0,0 +1,81 @@
+package widget
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/basecamp/basecamp-cli/internal/tui"
+)
+
+func TestDiffLines(t *testing.T) {
+	a := []string{"title", "one", "two", "three", "end"}
+	b := []string{"title", "one", "2", "three", "four", "end"}
+
+	assert.Equal(t, []DiffLine{
+		{DiffKeep, "title"},
+		{DiffKeep, "one"},
+		{DiffDelete, "two"},
+		{DiffInsert, "2"},
+		{DiffKeep, "three"},
+		{DiffInsert, "four"},
+		{DiffKeep, "end"},
+	}, DiffLines(a, b))
+}
+
+func TestDiffLines_Identical(t *testing.T) {
+	lines := []string{"a", "b"}
+	for _, l := range DiffLines(lines, lines) {
+		assert.Equal(t, DiffKeep, l.Op)
+	}
+	assert.Empty(t, DiffLines(nil, nil))
+}
+
+func TestDiff_StatsAndHeader(t *testing.T) {
+	d := NewDiff(tui.NewStyles())
+	d.SetSize(60, 20)
+
+	d.SetText("same\n", "same")
+	assert.Contains(t, d.View(), "No changes", "a trailing newline is not a change")
+
+	d.SetText("keep\ncut one\ncut two\n", "keep\nnew\n")
+	added, removed := d.Stats()
+	assert.Equal(t, 1, added)
+	assert.Equal(t, 2, removed)
+	view := d.View()
+	assert.Contains(t, view, "+1")
+	assert.Contains(t, view, "−2")
+	assert.Contains(t, view, "- cut one")
+	assert.Contains(t, view, "+ new")
+}
+
+func TestDiff_TrimsContextBetweenHunks(t *testing.T) {
+	var orig []string
+	for i := range 30 {
+		orig = append(orig, "line "+string(rune('A'+i)))
+	}
+	edited := append([]string{}, orig...)
+	edited[1] = "changed near top"
+	edited[28] = "changed near bottom"
+
+	d := NewDiff(tui.NewStyles())
+	d.SetSize(60, 40)
+	d.SetText(strings.Join(orig, "\n"), strings.Join(edited, "\n"))
+
+	view := d.View()
+	assert.Contains(t, view, "⋯", "unchanged middle is collapsed")
+	assert.NotContains(t, view, "line P", "lines far from a change are hidden")
+	assert.Contains(t, view, "line E", "context lines are kept")
+}
+
+func TestDiff_ScrollClamps(t *testing.T) {
+	d := NewDiff(tui.NewStyles())
+	d.SetSize(40, 4)
+	d.SetText("", "a\nb\nc\nd\ne\nf")
+
+	d.ScrollDown(100)
+	assert.Equal(t, 3, d.offset, "6 rows with 3 visible stop at 3")
+	d.ScrollUp(100)
+	assert.Equal(t, 0, d.offset)
+}
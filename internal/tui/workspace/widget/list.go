@@ -23,6 +23,7 @@ type ListItem struct {
 	Boosts      int    // number of boosts
 	Marked      bool   // visual mark (star, check, etc.)
 	Header      bool   // section header (non-selectable, rendered differently)
+	Account     int    // 1-based account number: colors Extra in the account's color (0 = Muted)
 }
 
 // FilterValue returns the string used for filtering.
@@ -186,6 +187,21 @@ func (l *List) StopFilter() {
 	l.skipHeaders(1)
 }
 
+// FilterState reports the current filter query with match counts. Section
+// headers are not counted.
+func (l *List) FilterState() workspace.FilterState {
+	if l.filter == "" {
+		return workspace.FilterState{}
+	}
+	total := 0
+	for _, item := range l.items {
+		if !item.Header {
+			total++
+		}
+	}
+	return workspace.FilterState{Query: l.filter, Matched: len(l.filtered), Total: total}
+}
+
 // Filtering returns whether interactive filter mode is active.
 func (l *List) Filtering() bool {
 	return l.filtering
@@ -521,7 +537,11 @@ func (l *List) renderItem(item ListItem, selected bool, theme tui.Theme) string
 
 	// Add extra (right-aligned) if space permits
 	if item.Extra != "" {
-		extra := descStyle.Render(item.Extra)
+		extraStyle := descStyle
+		if item.Account > 0 {
+			extraStyle = lipgloss.NewStyle().Foreground(theme.AccountColor(item.Account))
+		}
+		extra := extraStyle.Render(item.Extra)
 		titleWidth := lipgloss.Width(line)
 		extraWidth := lipgloss.Width(extra)
 		gap := l.width - titleWidth - extraWidth
@@ -425,3 +425,54 @@ func TestList_LongFilter_NoOverflow(t *testing.T) {
 		assert.LessOrEqual(t, w, 40, "list line %d overflows: width %d > 40", i, w)
 	}
 }
+
+func TestList_FilterStateSurvivesRefresh(t *testing.T) {
+	l := testList()
+	items := []ListItem{
+		{ID: "h", Title: "Section", Header: true},
+		{ID: "1", Title: "api docs"},
+		{ID: "2", Title: "Billing"},
+		{ID: "3", Title: "API keys"},
+	}
+	l.SetItems(items)
+	assert.Empty(t, l.FilterState().Query, "no filter applied yet")
+
+	l.StartFilter()
+	for _, r := range "api" {
+		l.Update(tea.KeyPressMsg{Code: r, Text: string(r)})
+	}
+	l.Update(tea.KeyPressMsg{Code: tea.KeyEnter})
+	require.False(t, l.Filtering())
+
+	f := l.FilterState()
+	assert.Equal(t, "api", f.Query)
+	assert.Equal(t, 2, f.Matched)
+	assert.Equal(t, 3, f.Total, "headers are not counted")
+
+	// A refresh keeps the filter applied to the new items
+	l.SetItems(append(items, ListItem{ID: "4", Title: "rapid"}))
+	f = l.FilterState()
+	assert.Equal(t, "api", f.Query)
+	assert.Equal(t, 3, f.Matched)
+	assert.Equal(t, 4, f.Total)
+
+	l.StopFilter()
+	assert.Empty(t, l.FilterState().Query)
+}
+
+func TestList_AccountColorsExtra(t *testing.T) {
+	styles := tui.NewStyles()
+	theme := styles.Theme()
+	l := NewList(styles)
+	l.SetSize(80, 10)
+	l.SetItems([]ListItem{
+		{ID: "1", Title: "Fix login bug", Extra: "2·Todo", Account: 2},
+		{ID: "2", Title: "Write docs", Extra: "Todo"},
+	})
+
+	view := l.View()
+	assert.Contains(t, view, lipgloss.NewStyle().Foreground(theme.AccountColor(2)).Render("2·Todo"),
+		"Extra should take the account's color")
+	assert.Contains(t, view, lipgloss.NewStyle().Foreground(theme.Muted).Render("Todo"),
+		"Extra without an account stays muted")
+}
@@ -54,6 +54,7 @@ type Workspace struct {
 	pickingBoost    bool
 	boostTarget     BoostTarget
 	quickJump       chrome.QuickJump
+	errorLog        chrome.ErrorLog
 
 	// Multi-account
 	accountList []AccountInfo
@@ -76,20 +77,30 @@ type Workspace struct {
 	showPalette         bool
 	showAccountSwitcher bool
 	showQuickJump       bool
+	showErrorLog        bool
 	quitting            bool
 	confirmQuit         bool
 	windowTitle         string
 
+	// Export awaiting a destination: the next key saves it to a file (f),
+	// copies it (c), or cancels.
+	pendingExport *ViewExport
+
 	// Theme file watcher for live reloading
 	themeWatcher *fsnotify.Watcher
 
 	// Ambient digest polling (feeds sidebar and views)
 	digestPollGen uint64
 
+	// Newest Hey! activity already announced (unix seconds); 0 until the
+	// first load sets the baseline.
+	activitySeenTS int64
+
 	// ViewFactory builds views from targets — set by the command that creates the workspace.
 	viewFactory        ViewFactory
 	poolMonitorFactory func() View // creates the pool monitor view
 	openFunc           func(Scope) tea.Cmd
+	openURLFunc        func(string) tea.Cmd
 
 	// createBoostFunc is the function called to create a boost. Defaults to
 	// createBoost; tests can replace it with a spy.
@@ -117,15 +128,9 @@ func New(session *Session, factory ViewFactory, poolMonitorFactory func() View,
 	styles := session.Styles()
 	registry := DefaultActions()
 
-	keys := DefaultGlobalKeyMap()
-	if configDir, err := os.UserConfigDir(); err == nil {
-		overrides, err := LoadKeyOverrides(filepath.Join(filepath.Clean(configDir), "basecamp", "keybindings.json"))
-		if err != nil {
-			log.Printf("keybindings: %v", err)
-		}
-		if len(overrides) > 0 {
-			ApplyOverrides(&keys, overrides)
-		}
+	keys, err := EffectiveGlobalKeyMap()
+	if err != nil {
+		log.Printf("keybindings: %v", err)
 	}
 
 	w := &Workspace{
@@ -138,6 +143,7 @@ func New(session *Session, factory ViewFactory, poolMonitorFactory func() View,
 		breadcrumb:         chrome.NewBreadcrumb(styles),
 		toast:              chrome.NewToast(styles),
 		help:               chrome.NewHelp(styles),
+		errorLog:           chrome.NewErrorLog(styles),
 		palette:            chrome.NewPalette(styles),
 		accountSwitcher:    chrome.NewAccountSwitcher(styles),
 		quickJump:          chrome.NewQuickJump(styles),
@@ -145,6 +151,7 @@ func New(session *Session, factory ViewFactory, poolMonitorFactory func() View,
 		viewFactory:        factory,
 		poolMonitorFactory: poolMonitorFactory,
 		openFunc:           openInBrowser,
+		openURLFunc:        OpenURL,
 		sidebarTargets:     defaultSidebarTargets(session),
 		sidebarIndex:       -1,
 		sidebarRatio:       0.30,
@@ -442,6 +449,9 @@ func (w *Workspace) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return w, w.goToDepth(msg.Depth)
 
 	case StatusMsg:
+		if msg.IsError {
+			w.recordError("", msg.Text)
+		}
 		w.statusBar.SetStatus(msg.Text, msg.IsError)
 		gen := w.statusBar.StatusGen()
 		return w, tea.Tick(4*time.Second, func(time.Time) tea.Msg {
@@ -455,12 +465,19 @@ func (w *Workspace) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return w, nil
 
 	case ErrorMsg:
+		w.recordError(msg.Context, msg.Err.Error())
 		if isAuthError(msg.Err) {
 			w.statusBar.SetStatus("Session expired — run: basecamp auth login", true)
 			return w, nil
 		}
 		return w, w.toast.Show(msg.Context+": "+humanizeError(msg.Err), true)
 
+	case chrome.ErrorLogCopiedMsg:
+		if msg.Count == 1 {
+			return w, w.toast.Show("Copied error to clipboard", false)
+		}
+		return w, w.toast.Show(fmt.Sprintf("Copied %d errors to clipboard", msg.Count), false)
+
 	case data.PoolUpdatedMsg:
 		// Refresh status bar metrics on every pool update
 		if hub := w.session.Hub(); hub != nil {
@@ -469,9 +486,13 @@ func (w *Workspace) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				ActivePools: summary.ActivePools,
 				P50Latency:  summary.P50Latency,
 				ErrorRate:   summary.ErrorRate,
+				LastFetch:   summary.LastFetch,
 			})
 		}
 		var extraCmds []tea.Cmd
+		if text := w.activityToast(msg.Key); text != "" {
+			extraCmds = append(extraCmds, w.toast.Show(text, false))
+		}
 		// Forward to left sidebar if active
 		if w.sidebarActive() {
 			updated, sc := w.sidebarView.Update(msg)
@@ -524,6 +545,13 @@ func (w *Workspace) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return w, nil
 
+	case chrome.HelpRunMsg:
+		// A binding picked in the help overlay runs as if pressed.
+		if press, ok := keyPressFor(msg.Key); ok {
+			return w, w.handleKey(press)
+		}
+		return w, nil
+
 	case chrome.AccountSwitchedMsg:
 		w.showAccountSwitcher = false
 		w.accountSwitcher.Blur()
@@ -624,6 +652,14 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		return cmd
 	}
 
+	if w.showErrorLog {
+		shouldClose, cmd := w.errorLog.Update(msg)
+		if shouldClose {
+			w.showErrorLog = false
+		}
+		return cmd
+	}
+
 	if w.showHelp {
 		shouldClose, cmd := w.help.Update(msg)
 		if shouldClose {
@@ -648,6 +684,11 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		return w.stampCmd(w.quickJump.Update(msg))
 	}
 
+	// A pending export consumes the next key as its destination
+	if w.pendingExport != nil {
+		return w.finishExport(msg)
+	}
+
 	// When a view is capturing text input, only allow ctrl-chord globals
 	// (ctrl+p, ctrl+a, ctrl+y, ctrl+s). Skip single-key globals (q, r, ?, /, 1-9)
 	// so they reach the view's text input.
@@ -685,6 +726,8 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 			}
 		case key.Matches(msg, w.keys.Metrics):
 			return w.togglePoolMonitor()
+		case key.Matches(msg, w.keys.ErrorLog):
+			return w.openErrorLog()
 		case key.Matches(msg, w.keys.SidebarFocus):
 			if w.sidebarActive() || w.poolMonitorActive() {
 				return w.switchSidebarFocus()
@@ -746,6 +789,12 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 				w.replaceCurrentView(updated)
 				return w.stampCmd(cmd)
 			}
+			// An applied list filter is cleared before Esc navigates back
+			if fr, ok := view.(FilterReporter); ok && fr.FilterState().Query != "" {
+				fr.ClearFilter()
+				w.replaceCurrentView(view)
+				return nil
+			}
 		}
 		if w.router.CanGoBack() {
 			return w.goBack()
@@ -765,6 +814,9 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 			return w.stampCmd(cmd)
 		}
 
+	case key.Matches(msg, w.keys.RefreshAll):
+		return w.refreshAll()
+
 	case key.Matches(msg, w.keys.Search):
 		// Forward to filterable views first — "/" filters lists locally
 		if view := w.router.Current(); view != nil {
@@ -801,6 +853,11 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		return nil
 
 	case key.Matches(msg, w.keys.Open):
+		if fu, ok := w.router.Current().(FocusedURLOpener); ok {
+			if url := fu.FocusedURL(); url != "" {
+				return w.openURLFunc(url)
+			}
+		}
 		scope := w.session.Scope()
 		if fr, ok := w.router.Current().(FocusedRecording); ok {
 			fi := fr.FocusedItem()
@@ -847,6 +904,14 @@ func (w *Workspace) handleKey(msg tea.KeyPressMsg) tea.Cmd {
 		if w.bonfireEnabled() && !w.isBonfireView() {
 			return w.navigate(ViewFrontPage, w.session.Scope())
 		}
+
+	case key.Matches(msg, w.keys.Export):
+		if _, ok := w.router.Current().(Exporter); ok {
+			return w.startExport()
+		}
+
+	case key.Matches(msg, w.keys.ErrorLog):
+		return w.openErrorLog()
 	}
 
 	// Forward to focused panel — panels consume all non-global keys.
@@ -899,8 +964,10 @@ func (w *Workspace) navigate(target ViewTarget, scope Scope) tea.Cmd {
 	// Origin is meaningful only for the target view, not session state.
 	originView := scope.OriginView
 	originHint := scope.OriginHint
+	searchQuery := scope.SearchQuery
 	scope.OriginView = ""
 	scope.OriginHint = ""
+	scope.SearchQuery = ""
 
 	prevAccountID := w.session.Scope().AccountID
 	w.session.SetScope(scope)
@@ -936,6 +1003,7 @@ func (w *Workspace) navigate(target ViewTarget, scope Scope) tea.Cmd {
 	viewScope := scope
 	viewScope.OriginView = originView
 	viewScope.OriginHint = originHint
+	viewScope.SearchQuery = searchQuery
 
 	view := w.viewFactory(target, w.session, viewScope)
 	w.router.Push(view, viewScope, target)
@@ -1134,6 +1202,59 @@ func (w *Workspace) openPalette() tea.Cmd {
 	return w.palette.Focus()
 }
 
+// startExport snapshots the current view's data and asks where to send it.
+func (w *Workspace) startExport() tea.Cmd {
+	exp, err := w.router.Current().(Exporter).Export()
+	if err != nil {
+		w.recordError("exporting view", err.Error())
+		return w.toast.Show("Export failed: "+err.Error(), true)
+	}
+	w.trace("export.start", "name", exp.Name, "format", exp.Format, "bytes", len(exp.Content))
+	w.pendingExport = &exp
+	return w.toast.Show("Export: f save to file · c copy to clipboard · esc cancel", false)
+}
+
+// finishExport sends the pending export to the destination picked by msg:
+// a timestamped file in the working directory or the clipboard.
+func (w *Workspace) finishExport(msg tea.KeyPressMsg) tea.Cmd {
+	exp := *w.pendingExport
+	w.pendingExport = nil
+
+	switch msg.String() {
+	case "f":
+		name := fmt.Sprintf("basecamp-%s-%s.%s", exp.Name, time.Now().Format("20060102-150405"), exp.Format)
+		if err := os.WriteFile(name, exp.Content, 0o600); err != nil {
+			w.recordError("exporting view", err.Error())
+			return w.toast.Show("Export failed: "+err.Error(), true)
+		}
+		return w.toast.Show("Exported to "+name, false)
+	case "c":
+		return tea.Batch(
+			tea.SetClipboard(string(exp.Content)),
+			w.toast.Show("Copied export to clipboard", false),
+		)
+	}
+	return w.toast.Show("Export canceled", false)
+}
+
+// recordError keeps an error for the ctrl+x overlay, noting which view was
+// showing.
+func (w *Workspace) recordError(context, message string) {
+	entry := chrome.ErrorEntry{At: time.Now(), Context: context, Message: message}
+	if view := w.router.Current(); view != nil {
+		entry.View = view.Title()
+	}
+	w.errorLog.Record(entry)
+}
+
+// openErrorLog shows the recent errors, newest selected.
+func (w *Workspace) openErrorLog() tea.Cmd {
+	w.trace("errorlog.open", "count", len(w.errorLog.Entries()))
+	w.errorLog.ResetScroll()
+	w.showErrorLog = true
+	return nil
+}
+
 func (w *Workspace) openQuickJump() tea.Cmd {
 	w.trace("quickjump.open")
 	w.showQuickJump = true
@@ -1195,6 +1316,71 @@ func (w *Workspace) openAccountSwitcher() tea.Cmd {
 	return w.accountSwitcher.Focus(entries)
 }
 
+// refreshAll marks every active pool stale and re-fetches what's on screen:
+// the current view and, when shown, the sidebar. Pools behind other views
+// re-fetch the next time those views load.
+func (w *Workspace) refreshAll() tea.Cmd {
+	if hub := w.session.Hub(); hub != nil {
+		hub.InvalidateAll()
+	}
+	var cmds []tea.Cmd
+	if w.sidebarActive() {
+		updated, cmd := w.sidebarView.Update(RefreshMsg{})
+		w.sidebarView = updated
+		cmds = append(cmds, w.stampCmd(cmd))
+	}
+	if view := w.router.Current(); view != nil {
+		updated, cmd := view.Update(RefreshMsg{})
+		w.replaceCurrentView(updated)
+		cmds = append(cmds, w.stampCmd(cmd))
+	}
+	return tea.Batch(cmds...)
+}
+
+// activityToast announces Hey! activity that arrived since the pool last
+// updated, leaving out muted projects and tools. The first load only sets
+// the baseline, and nothing is announced while the Hey! view is open.
+func (w *Workspace) activityToast(poolKey string) string {
+	hub := w.session.Hub()
+	if hub == nil || poolKey != data.HeyActivityKey {
+		return ""
+	}
+	snap := hub.HeyActivity().Get()
+	if !snap.Usable() {
+		return ""
+	}
+
+	seen := w.activitySeenTS
+	var fresh []data.ActivityEntryInfo
+	for _, e := range snap.Data {
+		if e.UpdatedAtTS > w.activitySeenTS {
+			w.activitySeenTS = e.UpdatedAtTS
+		}
+		if seen == 0 || e.UpdatedAtTS <= seen {
+			continue
+		}
+		if mutes := w.session.Mutes(); mutes != nil && mutes.Muted(e.ProjectID, data.ToolForRecordingType(e.Type)) {
+			continue
+		}
+		fresh = append(fresh, e)
+	}
+	if len(fresh) == 0 || w.router.CurrentTarget() == ViewHey {
+		return ""
+	}
+
+	if len(fresh) == 1 {
+		return fmt.Sprintf("New %s in %s: %s", strings.ToLower(fresh[0].Type), fresh[0].Project, fresh[0].Title)
+	}
+	projects := make(map[int64]bool)
+	for _, e := range fresh {
+		projects[e.ProjectID] = true
+	}
+	if len(projects) == 1 {
+		return fmt.Sprintf("%d new updates in %s", len(fresh), fresh[0].Project)
+	}
+	return fmt.Sprintf("%d new updates across %d projects", len(fresh), len(projects))
+}
+
 func (w *Workspace) toggleSidebar() tea.Cmd {
 	w.trace("sidebar.toggle", "wasOpen", w.showSidebar, "index", w.sidebarIndex)
 	if w.showSidebar && w.sidebarView != nil {
@@ -1500,6 +1686,16 @@ func (w *Workspace) replaceCurrentView(updated View) {
 		w.router.stack[len(w.router.stack)-1].view = updated
 	}
 	w.statusBar.SetKeyHints(updated.ShortHelp())
+	w.syncFilterChip(updated)
+}
+
+// syncFilterChip pins the view's applied list filter to the status bar.
+func (w *Workspace) syncFilterChip(view View) {
+	var f FilterState
+	if fr, ok := view.(FilterReporter); ok {
+		f = fr.FilterState()
+	}
+	w.statusBar.SetFilter(f.Query, f.Matched, f.Total)
 }
 
 // recordNavigation logs a navigation event for Apdex tracking.
@@ -1530,6 +1726,7 @@ func (w *Workspace) syncChrome() {
 		globalHints = filtered
 	}
 	w.statusBar.SetGlobalHints(globalHints)
+	w.syncFilterChip(w.router.Current())
 	if view := w.router.Current(); view != nil {
 		w.statusBar.SetKeyHints(view.ShortHelp())
 		w.help.SetViewTitle(view.Title())
@@ -1546,6 +1743,7 @@ func (w *Workspace) relayout() {
 	w.statusBar.SetWidth(w.width)
 	w.toast.SetWidth(w.width)
 	w.help.SetSize(w.width, w.viewHeight())
+	w.errorLog.SetSize(w.width, w.viewHeight())
 	w.palette.SetSize(w.width, w.viewHeight())
 	w.accountSwitcher.SetSize(w.width, w.viewHeight())
 	w.quickJump.SetSize(w.width, w.viewHeight())
@@ -1694,7 +1892,9 @@ func (w *Workspace) View() tea.View {
 	sections = append(sections, divider)
 
 	// Main view
-	if w.showAccountSwitcher {
+	if w.showErrorLog {
+		sections = append(sections, w.errorLog.View())
+	} else if w.showAccountSwitcher {
 		sections = append(sections, w.accountSwitcher.View())
 	} else if w.showQuickJump {
 		sections = append(sections, w.quickJump.View())
@@ -2,7 +2,10 @@ package workspace
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"testing"
@@ -11,6 +14,7 @@ import (
 
 	"charm.land/bubbles/v2/key"
 	tea "charm.land/bubbletea/v2"
+	"github.com/charmbracelet/x/ansi"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -73,6 +77,7 @@ func testWorkspace() (w *Workspace, viewLog *[]*testView) {
 		breadcrumb:      chrome.NewBreadcrumb(styles),
 		toast:           chrome.NewToast(styles),
 		help:            chrome.NewHelp(styles),
+		errorLog:        chrome.NewErrorLog(styles),
 		palette:         chrome.NewPalette(styles),
 		accountSwitcher: chrome.NewAccountSwitcher(styles),
 		boostPicker:     NewBoostPicker(styles),
@@ -127,6 +132,12 @@ func keyMsg(k string) tea.KeyPressMsg {
 		return tea.KeyPressMsg{Code: 'd', Mod: tea.ModCtrl}
 	case "ctrl+u":
 		return tea.KeyPressMsg{Code: 'u', Mod: tea.ModCtrl}
+	case "ctrl+r":
+		return tea.KeyPressMsg{Code: 'r', Mod: tea.ModCtrl}
+	case "ctrl+e":
+		return tea.KeyPressMsg{Code: 'e', Mod: tea.ModCtrl}
+	case "ctrl+x":
+		return tea.KeyPressMsg{Code: 'x', Mod: tea.ModCtrl}
 	case "esc":
 		return tea.KeyPressMsg{Code: tea.KeyEscape}
 	case "backspace":
@@ -274,6 +285,34 @@ func TestWorkspace_ModalEscGoesToView(t *testing.T) {
 	assert.Equal(t, tea.KeyEscape, km.Code)
 }
 
+// filterTestView is a testView with an applied list filter.
+type filterTestView struct {
+	testView
+	filter FilterState
+}
+
+func (v *filterTestView) FilterState() FilterState { return v.filter }
+func (v *filterTestView) ClearFilter()             { v.filter = FilterState{} }
+
+func TestWorkspace_EscClearsAppliedFilterBeforeBack(t *testing.T) {
+	w, _ := testWorkspace()
+	pushTestView(w, "Root")
+	child := &filterTestView{testView: testView{title: "Child"}, filter: FilterState{Query: "api", Matched: 12, Total: 240}}
+	w.router.Push(child, Scope{}, 0)
+	w.syncChrome()
+	w.statusBar.SetWidth(w.width)
+
+	assert.Contains(t, ansi.Strip(w.statusBar.View()), "filter: api · 12/240")
+
+	w.handleKey(keyMsg("esc"))
+	assert.Equal(t, 2, w.router.Depth(), "first Esc should clear the filter, not pop the stack")
+	assert.Empty(t, child.filter.Query)
+	assert.NotContains(t, w.statusBar.View(), "filter:")
+
+	w.handleKey(keyMsg("esc"))
+	assert.Equal(t, 1, w.router.Depth(), "Esc without a filter navigates back")
+}
+
 func TestWorkspace_CtrlCAlwaysQuits(t *testing.T) {
 	w, _ := testWorkspace()
 	v := pushTestView(w, "Root")
@@ -316,6 +355,53 @@ func TestWorkspace_RefreshForwards(t *testing.T) {
 	assert.True(t, isRefresh, "RefreshMsg should be forwarded to current view")
 }
 
+func TestWorkspace_RefreshAllInvalidatesPools(t *testing.T) {
+	w := testWorkspaceWithSession(testSessionWithContext("1", "Acme"))
+	v := pushTestView(w, "Root")
+
+	pool := data.NewPool[int]("test", data.PoolConfig{}, nil)
+	w.session.Hub().Global().Register("test", pool)
+	pool.Set(1)
+
+	w.Update(keyMsg("ctrl+r"))
+
+	assert.Equal(t, data.StateStale, pool.Get().State, "ctrl+r should mark every pool stale")
+	require.NotEmpty(t, v.msgs)
+	_, isRefresh := v.msgs[len(v.msgs)-1].(RefreshMsg)
+	assert.True(t, isRefresh, "ctrl+r should refresh the current view")
+}
+
+func TestWorkspace_ActivityToastSkipsMuted(t *testing.T) {
+	session := testSessionWithContext("1", "Acme")
+	session.mutes = data.NewMuteList([]string{"9", "8/chat"})
+	w := testWorkspaceWithSession(session)
+	pushTestView(w, "Root")
+
+	pool := session.Hub().HeyActivity()
+	pool.Set([]data.ActivityEntryInfo{{ID: 1, Type: "Todo", Project: "Launch", ProjectID: 7, UpdatedAtTS: 100}})
+	w.Update(data.PoolUpdatedMsg{Key: data.HeyActivityKey})
+	assert.False(t, w.toast.Visible(), "first load only sets the baseline")
+
+	pool.Set([]data.ActivityEntryInfo{
+		{ID: 2, Type: "Message", Title: "Kickoff", Project: "Launch", ProjectID: 7, UpdatedAtTS: 200},
+		{ID: 3, Type: "Todo", Title: "Muted project", Project: "Ops", ProjectID: 9, UpdatedAtTS: 200},
+		{ID: 4, Type: "Chat::Line", Title: "Muted tool", Project: "Web", ProjectID: 8, UpdatedAtTS: 200},
+		{ID: 1, Type: "Todo", Project: "Launch", ProjectID: 7, UpdatedAtTS: 100},
+	})
+	w.Update(data.PoolUpdatedMsg{Key: data.HeyActivityKey})
+	assert.True(t, w.toast.Visible())
+	assert.Contains(t, w.toast.View(), "New message in Launch: Kickoff")
+
+	// Already announced: nothing new.
+	assert.Empty(t, w.activityToast(data.HeyActivityKey))
+
+	pool.Set([]data.ActivityEntryInfo{
+		{ID: 5, Type: "Todo", Project: "Launch", ProjectID: 7, UpdatedAtTS: 300},
+		{ID: 6, Type: "Document", Project: "Web", ProjectID: 8, UpdatedAtTS: 300},
+	})
+	assert.Equal(t, "2 new updates across 2 projects", w.activityToast(data.HeyActivityKey))
+}
+
 func TestWorkspace_FocusBlurOnNav(t *testing.T) {
 	w, viewLog := testWorkspace()
 	root := pushTestView(w, "Root")
@@ -491,6 +577,7 @@ func testWorkspaceWithSession(session *Session) *Workspace {
 		breadcrumb:      chrome.NewBreadcrumb(styles),
 		toast:           chrome.NewToast(styles),
 		help:            chrome.NewHelp(styles),
+		errorLog:        chrome.NewErrorLog(styles),
 		palette:         chrome.NewPalette(styles),
 		accountSwitcher: chrome.NewAccountSwitcher(styles),
 		boostPicker:     NewBoostPicker(styles),
@@ -1108,6 +1195,7 @@ func TestWorkspace_Navigate_ViewScopeRetainsOrigin(t *testing.T) {
 		breadcrumb:      chrome.NewBreadcrumb(styles),
 		toast:           chrome.NewToast(styles),
 		help:            chrome.NewHelp(styles),
+		errorLog:        chrome.NewErrorLog(styles),
 		palette:         chrome.NewPalette(styles),
 		accountSwitcher: chrome.NewAccountSwitcher(styles),
 		boostPicker:     NewBoostPicker(styles),
@@ -1152,6 +1240,7 @@ func TestWorkspace_OriginDoesNotLeakAcrossNavigations(t *testing.T) {
 		breadcrumb:      chrome.NewBreadcrumb(styles),
 		toast:           chrome.NewToast(styles),
 		help:            chrome.NewHelp(styles),
+		errorLog:        chrome.NewErrorLog(styles),
 		palette:         chrome.NewPalette(styles),
 		accountSwitcher: chrome.NewAccountSwitcher(styles),
 		boostPicker:     NewBoostPicker(styles),
@@ -1591,6 +1680,52 @@ func TestWorkspace_OpenInBrowser_FallsBackToSessionScope(t *testing.T) {
 		"RecordingID should be zero when no focused item")
 }
 
+// testURLView satisfies View, FocusedRecording, and FocusedURLOpener.
+type testURLView struct {
+	testFocusedView
+	url string
+}
+
+func (v *testURLView) FocusedURL() string { return v.url }
+
+func TestWorkspace_OpenInBrowser_PrefersFocusedURL(t *testing.T) {
+	session := testSessionWithContext("sess-acct", "Session")
+	session.SetScope(Scope{AccountID: "sess-acct", ProjectID: 7})
+	w := testWorkspaceWithSession(session)
+
+	scopeOpened := false
+	w.openFunc = func(Scope) tea.Cmd {
+		scopeOpened = true
+		return nil
+	}
+	var openedURL string
+	w.openURLFunc = func(url string) tea.Cmd {
+		openedURL = url
+		return nil
+	}
+
+	uv := &testURLView{
+		testFocusedView: testFocusedView{
+			testView: testView{title: "Docs & Files"},
+			focused:  FocusedItemScope{RecordingID: 100},
+		},
+		url: "https://storage.3.basecamp.com/1/blobs/abc/download/logo.png",
+	}
+	w.router.Push(uv, Scope{}, 0)
+	w.syncChrome()
+
+	w.handleKey(keyMsg("o"))
+	assert.Equal(t, uv.url, openedURL)
+	assert.False(t, scopeOpened, "focused URL should take precedence over the recording page")
+
+	// An empty URL falls back to the recording scope.
+	uv.url = ""
+	openedURL = ""
+	w.handleKey(keyMsg("o"))
+	assert.Empty(t, openedURL)
+	assert.True(t, scopeOpened)
+}
+
 func TestWorkspace_OpenInBrowser_PartialFocusedOverride(t *testing.T) {
 	session := testSessionWithContext("sess-acct", "Session")
 	session.SetScope(Scope{AccountID: "sess-acct", ProjectID: 7})
@@ -2248,3 +2383,130 @@ func TestDuplicateNavigationGuardsInputActive(t *testing.T) {
 	w.handleKey(tea.KeyPressMsg{Code: 't', Mod: tea.ModCtrl})
 	assert.Equal(t, depth, w.router.Depth(), "duplicate Activity during inputActive should not grow stack")
 }
+
+func TestWorkspace_HelpRunMsgRunsBinding(t *testing.T) {
+	w, _ := testWorkspace()
+	pushTestView(w, "Root")
+
+	w.Update(chrome.HelpRunMsg{Key: "ctrl+p"})
+	assert.True(t, w.showPalette, "running ctrl+p from help opens the palette")
+}
+
+func TestWorkspace_HelpRunMsgReachesView(t *testing.T) {
+	w, _ := testWorkspace()
+	v := pushTestView(w, "Root")
+
+	w.Update(chrome.HelpRunMsg{Key: "x"})
+	require.NotEmpty(t, v.msgs)
+	press, ok := v.msgs[len(v.msgs)-1].(tea.KeyPressMsg)
+	require.True(t, ok)
+	assert.Equal(t, "x", press.String())
+}
+
+// testExportView satisfies View and Exporter.
+type testExportView struct {
+	testView
+	export ViewExport
+	err    error
+}
+
+func (v *testExportView) Export() (ViewExport, error) { return v.export, v.err }
+
+func pushExportView(w *Workspace) *testExportView {
+	ev := &testExportView{
+		testView: testView{title: "Hey!"},
+		export:   ViewExport{Name: "hey", Format: "json", Content: []byte(`[{"id":"1"}]`)},
+	}
+	w.router.Push(ev, Scope{}, 0)
+	w.syncChrome()
+	return ev
+}
+
+func TestWorkspace_Export_SavesToFile(t *testing.T) {
+	t.Chdir(t.TempDir())
+	w, _ := testWorkspace()
+	ev := pushExportView(w)
+
+	w.handleKey(keyMsg("ctrl+e"))
+	require.NotNil(t, w.pendingExport)
+	assert.Contains(t, w.toast.View(), "f save to file")
+
+	w.handleKey(keyMsg("f"))
+	assert.Nil(t, w.pendingExport)
+
+	files, err := filepath.Glob("basecamp-hey-*.json")
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	content, err := os.ReadFile(files[0])
+	require.NoError(t, err)
+	assert.Equal(t, ev.export.Content, content)
+	assert.Contains(t, w.toast.View(), "Exported to "+files[0])
+	assert.Empty(t, ev.msgs, "the destination key must not reach the view")
+}
+
+func TestWorkspace_Export_CopiesToClipboard(t *testing.T) {
+	w, _ := testWorkspace()
+	pushExportView(w)
+
+	w.handleKey(keyMsg("ctrl+e"))
+	cmd := w.handleKey(keyMsg("c"))
+	require.NotNil(t, cmd)
+	assert.Nil(t, w.pendingExport)
+	assert.Contains(t, w.toast.View(), "Copied export to clipboard")
+}
+
+func TestWorkspace_Export_OtherKeyCancels(t *testing.T) {
+	t.Chdir(t.TempDir())
+	w, _ := testWorkspace()
+	ev := pushExportView(w)
+
+	w.handleKey(keyMsg("ctrl+e"))
+	w.handleKey(keyMsg("esc"))
+	assert.Nil(t, w.pendingExport)
+	assert.Equal(t, 1, w.router.Depth(), "esc cancels the export instead of navigating back")
+	assert.Contains(t, w.toast.View(), "Export canceled")
+	assert.Empty(t, ev.msgs)
+
+	files, _ := filepath.Glob("basecamp-*")
+	assert.Empty(t, files)
+}
+
+func TestWorkspace_Export_ErrorShowsToast(t *testing.T) {
+	w, _ := testWorkspace()
+	ev := pushExportView(w)
+	ev.err = errors.New("nothing to export yet")
+
+	w.handleKey(keyMsg("ctrl+e"))
+	assert.Nil(t, w.pendingExport)
+	assert.Contains(t, w.toast.View(), "Export failed: nothing to export yet")
+}
+
+func TestWorkspace_Export_NonExporterForwardsKey(t *testing.T) {
+	w, _ := testWorkspace()
+	v := pushTestView(w, "Cards")
+
+	w.handleKey(keyMsg("ctrl+e"))
+	assert.Nil(t, w.pendingExport)
+	require.Len(t, v.msgs, 1, "ctrl+e reaches views that cannot export")
+}
+
+func TestWorkspace_ErrorLog_RecordsErrorsAndCopies(t *testing.T) {
+	w, _ := testWorkspace()
+	w.router.Push(&testView{title: "Todos"}, Scope{}, 0)
+	w.Update(tea.WindowSizeMsg{Width: 120, Height: 40})
+
+	w.Update(ErrorMsg{Err: errors.New("HTTP 500: upstream timed out"), Context: "loading todos"})
+
+	w.handleKey(keyMsg("ctrl+x"))
+	require.True(t, w.showErrorLog)
+	view := w.View().Content
+	assert.Contains(t, view, "Todos › loading todos")
+	assert.Contains(t, view, "HTTP 500: upstream timed out")
+
+	cmd := w.handleKey(keyMsg("c"))
+	require.NotNil(t, cmd)
+
+	w.handleKey(keyMsg("esc"))
+	assert.False(t, w.showErrorLog)
+	assert.Equal(t, 1, w.router.Depth(), "esc closes the overlay instead of navigating back")
+}
@@ -13,6 +13,13 @@ import (
 // and normalizes it to chats/{chatID}/lines/{lineID} before routing.
 var chatAtRe = regexp.MustCompile(`(/chats/\d+)@(\d+)`)
 
+// bucketOnlyRe matches project-level bucket URLs without a recording path.
+// Parse doesn't match these since the SDK router expects a resource type.
+// Only accepts /buckets/{id} with optional trailing slash, query string, or fragment.
+var bucketOnlyRe = regexp.MustCompile(
+	`^https?://(?:3\.)?basecamp\.com/(\d+)/buckets/(\d+)/?(?:\?[^/]*)?(?:#.*)?$`,
+)
+
 // Parsed represents components extracted from a Basecamp URL.
 type Parsed struct {
 	AccountID      string
@@ -136,6 +143,26 @@ func ExtractProjectID(arg string) string {
 	return arg
 }
 
+// ParseBucket extracts the account and project IDs from a bare bucket URL
+// (https://3.basecamp.com/{account}/buckets/{bucket}).
+func ParseBucket(input string) (accountID, projectID string, ok bool) {
+	matches := bucketOnlyRe.FindStringSubmatch(input)
+	if matches == nil {
+		return "", "", false
+	}
+	return matches[1], matches[2], true
+}
+
+// ParseProject extracts the account and project IDs from any Basecamp URL
+// within a project: the project itself, its bare bucket URL, or anything
+// in it. Returns false if input is not such a URL.
+func ParseProject(input string) (accountID, projectID string, ok bool) {
+	if parsed := Parse(input); parsed != nil && parsed.ProjectID != "" {
+		return parsed.AccountID, parsed.ProjectID, true
+	}
+	return ParseBucket(input)
+}
+
 // ExtractWithProject extracts both the recording ID and project ID from an argument.
 // Returns (recordingID, projectID). If projectID is empty, it wasn't in the URL.
 func ExtractWithProject(arg string) (recordingID, projectID string) {
@@ -337,6 +337,33 @@ func TestExtractProjectID(t *testing.T) {
 	}
 }
 
+func TestParseProject(t *testing.T) {
+	tests := []struct {
+		input   string
+		account string
+		project string
+		ok      bool
+	}{
+		{"https://3.basecamp.com/123/projects/456", "123", "456", true},
+		{"https://3.basecamp.com/123/buckets/456", "123", "456", true},
+		{"https://3.basecamp.com/123/buckets/456/?tab=docs", "123", "456", true},
+		{"https://3.basecamp.com/123/buckets/456/todos/789", "123", "456", true},
+		{"https://3.basecamp.com/123/my/assignments", "", "", false},
+		{"456", "", "", false},
+		{"my-project", "", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			account, project, ok := ParseProject(tt.input)
+			if account != tt.account || project != tt.project || ok != tt.ok {
+				t.Errorf("ParseProject(%q) = (%q, %q, %v), want (%q, %q, %v)",
+					tt.input, account, project, ok, tt.account, tt.project, tt.ok)
+			}
+		})
+	}
+}
+
 func TestExtractWithProject(t *testing.T) {
 	tests := []struct {
 		input           string
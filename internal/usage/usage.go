@@ -0,0 +1,240 @@
+// Package usage records local, per-command usage statistics.
+//
+// Stats are written only when the user opts in (usage_stats config key) and
+// never leave the machine. They exist so users and maintainers can see which
+// commands are used most, which are slow, and which fail.
+package usage
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"time"
+
+	"github.com/gofrs/flock"
+)
+
+const (
+	// FileName is the stats file name within the store directory.
+	FileName = "usage.json"
+
+	// DirName is the subdirectory within the cache dir.
+	DirName = "usage"
+
+	// LockTimeout bounds how long Record waits for the file lock before
+	// proceeding without it, so stats never slow a command down noticeably.
+	LockTimeout = 100 * time.Millisecond
+)
+
+// CommandStats aggregates every recorded run of a single command.
+type CommandStats struct {
+	Count    int       `json:"count"`
+	Errors   int       `json:"errors"`
+	TotalMs  int64     `json:"total_ms"`
+	MaxMs    int64     `json:"max_ms"`
+	LastUsed time.Time `json:"last_used"`
+
+	// ErrorCodes counts failures by structured error code (e.g. "not_found").
+	ErrorCodes map[string]int `json:"error_codes,omitempty"`
+}
+
+// AvgMs returns the mean run duration in milliseconds.
+func (c CommandStats) AvgMs() int64 {
+	if c.Count == 0 {
+		return 0
+	}
+	return c.TotalMs / int64(c.Count)
+}
+
+// ErrorRate returns the fraction of runs that failed, in [0, 1].
+func (c CommandStats) ErrorRate() float64 {
+	if c.Count == 0 {
+		return 0
+	}
+	return float64(c.Errors) / float64(c.Count)
+}
+
+// Stats is the persisted stats file.
+type Stats struct {
+	Since    time.Time                `json:"since"`
+	Commands map[string]*CommandStats `json:"commands"`
+}
+
+// Event is one completed command invocation.
+type Event struct {
+	Command   string // command path without the root name, e.g. "todos list"
+	Duration  time.Duration
+	ErrorCode string // empty on success
+	At        time.Time
+}
+
+// Entry pairs a command with its aggregated stats.
+type Entry struct {
+	Command string
+	CommandStats
+}
+
+// Store reads and writes the stats file with file locking.
+type Store struct {
+	dir string
+}
+
+// NewStore creates a store rooted at dir (typically <cache_dir>/usage).
+func NewStore(dir string) *Store {
+	return &Store{dir: dir}
+}
+
+// Path returns the full path to the stats file.
+func (s *Store) Path() string {
+	return filepath.Join(s.dir, FileName)
+}
+
+// Record folds ev into the stored stats.
+func (s *Store) Record(ev Event) error {
+	if ev.Command == "" {
+		return nil
+	}
+	if ev.At.IsZero() {
+		ev.At = time.Now()
+	}
+
+	return s.withLock(func() error {
+		stats := s.loadUnsafe()
+		if stats.Since.IsZero() {
+			stats.Since = ev.At
+		}
+
+		c := stats.Commands[ev.Command]
+		if c == nil {
+			c = &CommandStats{}
+			stats.Commands[ev.Command] = c
+		}
+		ms := ev.Duration.Milliseconds()
+		c.Count++
+		c.TotalMs += ms
+		if ms > c.MaxMs {
+			c.MaxMs = ms
+		}
+		c.LastUsed = ev.At
+		if ev.ErrorCode != "" {
+			c.Errors++
+			if c.ErrorCodes == nil {
+				c.ErrorCodes = make(map[string]int)
+			}
+			c.ErrorCodes[ev.ErrorCode]++
+		}
+
+		return s.saveUnsafe(stats)
+	})
+}
+
+// Load returns the stored stats, or empty stats if none have been recorded.
+func (s *Store) Load() (*Stats, error) {
+	var stats *Stats
+	err := s.withLock(func() error {
+		stats = s.loadUnsafe()
+		return nil
+	})
+	return stats, err
+}
+
+// Reset removes all recorded stats.
+func (s *Store) Reset() error {
+	return s.withLock(func() error {
+		if err := os.Remove(s.Path()); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	})
+}
+
+// Top returns up to limit commands ordered by run count (most used first).
+// Ties break on command name so output is stable. A limit <= 0 returns all.
+func (st *Stats) Top(limit int) []Entry {
+	entries := make([]Entry, 0, len(st.Commands))
+	for name, c := range st.Commands {
+		entries = append(entries, Entry{Command: name, CommandStats: *c})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Count != entries[j].Count {
+			return entries[i].Count > entries[j].Count
+		}
+		return entries[i].Command < entries[j].Command
+	})
+	if limit > 0 && len(entries) > limit {
+		entries = entries[:limit]
+	}
+	return entries
+}
+
+// Totals returns the overall run and error counts.
+func (st *Stats) Totals() (runs, errors int) {
+	for _, c := range st.Commands {
+		runs += c.Count
+		errors += c.Errors
+	}
+	return runs, errors
+}
+
+// withLock runs fn while holding the store lock. Like the resilience store,
+// it fails open: if the lock isn't acquired within LockTimeout, fn runs anyway.
+func (s *Store) withLock(fn func() error) error {
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+
+	fl := flock.New(filepath.Join(s.dir, ".lock"))
+	ctx, cancel := context.WithTimeout(context.Background(), LockTimeout)
+	defer cancel()
+
+	locked, err := fl.TryLockContext(ctx, 10*time.Millisecond)
+	if err != nil && ctx.Err() != context.DeadlineExceeded {
+		return err
+	}
+	if locked {
+		defer func() { _ = fl.Unlock() }()
+	}
+
+	return fn()
+}
+
+// loadUnsafe reads the stats file without locking. Missing or corrupt files
+// yield empty stats: these are best-effort counters, not data worth failing on.
+func (s *Store) loadUnsafe() *Stats {
+	stats := &Stats{}
+	if data, err := os.ReadFile(s.Path()); err == nil {
+		_ = json.Unmarshal(data, stats)
+	}
+	if stats.Commands == nil {
+		stats.Commands = make(map[string]*CommandStats)
+	}
+	return stats
+}
+
+// saveUnsafe writes the stats file atomically without locking.
+func (s *Store) saveUnsafe(stats *Stats) error {
+	data, err := json.MarshalIndent(stats, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmpPath := fmt.Sprintf("%s.%d.%d.tmp", s.Path(), os.Getpid(), time.Now().UnixNano())
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return err
+	}
+
+	// On Windows, os.Rename fails if the destination exists.
+	if runtime.GOOS == "windows" {
+		_ = os.Remove(s.Path())
+	}
+
+	if err := os.Rename(tmpPath, s.Path()); err != nil {
+		_ = os.Remove(tmpPath)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package usage
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordAggregatesPerCommand(t *testing.T) {
+	s := NewStore(t.TempDir())
+	at := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	require.NoError(t, s.Record(Event{Command: "todos list", Duration: 200 * time.Millisecond, At: at}))
+	require.NoError(t, s.Record(Event{Command: "todos list", Duration: 400 * time.Millisecond, ErrorCode: "network", At: at.Add(time.Minute)}))
+	require.NoError(t, s.Record(Event{Command: "projects list", Duration: 50 * time.Millisecond, At: at}))
+
+	stats, err := s.Load()
+	require.NoError(t, err)
+	assert.Equal(t, at, stats.Since)
+
+	todos := stats.Commands["todos list"]
+	require.NotNil(t, todos)
+	assert.Equal(t, 2, todos.Count)
+	assert.Equal(t, 1, todos.Errors)
+	assert.Equal(t, int64(300), todos.AvgMs())
+	assert.Equal(t, int64(400), todos.MaxMs)
+	assert.InDelta(t, 0.5, todos.ErrorRate(), 0.001)
+	assert.Equal(t, map[string]int{"network": 1}, todos.ErrorCodes)
+	assert.Equal(t, at.Add(time.Minute), todos.LastUsed)
+
+	runs, errors := stats.Totals()
+	assert.Equal(t, 3, runs)
+	assert.Equal(t, 1, errors)
+}
+
+func TestTopOrdersByCountThenName(t *testing.T) {
+	st := &Stats{Commands: map[string]*CommandStats{
+		"b": {Count: 2},
+		"a": {Count: 2},
+		"c": {Count: 5},
+		"d": {Count: 1},
+	}}
+
+	var names []string
+	for _, e := range st.Top(3) {
+		names = append(names, e.Command)
+	}
+	assert.Equal(t, []string{"c", "a", "b"}, names)
+	assert.Len(t, st.Top(0), 4)
+}
+
+func TestLoadToleratesMissingAndCorruptFiles(t *testing.T) {
+	s := NewStore(t.TempDir())
+
+	stats, err := s.Load()
+	require.NoError(t, err)
+	assert.Empty(t, stats.Commands)
+
+	require.NoError(t, os.WriteFile(s.Path(), []byte("{not json"), 0600))
+	stats, err = s.Load()
+	require.NoError(t, err)
+	assert.Empty(t, stats.Commands)
+}
+
+func TestReset(t *testing.T) {
+	s := NewStore(t.TempDir())
+	require.NoError(t, s.Record(Event{Command: "me", Duration: time.Millisecond}))
+	assert.FileExists(t, s.Path())
+
+	require.NoError(t, s.Reset())
+	assert.NoFileExists(t, s.Path())
+
+	// Resetting twice is fine.
+	require.NoError(t, s.Reset())
+}
+
+func TestRecordIgnoresEmptyCommand(t *testing.T) {
+	s := NewStore(t.TempDir())
+	require.NoError(t, s.Record(Event{Duration: time.Second}))
+	assert.NoFileExists(t, s.Path())
+}